@@ -5,6 +5,7 @@ import (
 	"log"
 	"os/signal"
 	"syscall"
+	_ "time/tzdata"
 
 	initializer "github.com/alishashelby/Samok-Aah-t/backend/internal/app/config"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
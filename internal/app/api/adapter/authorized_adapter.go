@@ -8,25 +8,63 @@ import (
 )
 
 type AuthorizedAdapter struct {
-	User         *handler.UserHandler
-	ModelService *handler.ModelServiceHandler
-	Slot         *handler.SlotHandler
-	Booking      *handler.BookingHandler
-	Order        *handler.OrderHandler
-	Admin        *handler.AdminHandler
+	User           *handler.UserHandler
+	ModelService   *handler.ModelServiceHandler
+	AddOn          *handler.AddOnHandler
+	Slot           *handler.SlotHandler
+	Booking        *handler.BookingHandler
+	Order          *handler.OrderHandler
+	OrderTracking  *handler.OrderTrackingHandler
+	Dispute        *handler.DisputeHandler
+	OrderExtension *handler.OrderExtensionHandler
+	Payment        *handler.PaymentHandler
+	Ledger         *handler.LedgerHandler
+	PricingRule    *handler.PricingRuleHandler
+	Availability   *handler.AvailabilityTemplateHandler
+	PromoCode      *handler.PromoCodeHandler
+	Receipt        *handler.ReceiptHandler
+	CalendarFeed   *handler.CalendarFeedHandler
+	BusyCalendar   *handler.BusyCalendarHandler
+	TravelBuffer   *handler.TravelBufferHandler
+	BookingRules   *handler.BookingRulesHandler
+	TimeOff        *handler.TimeOffHandler
+	Search         *handler.AvailabilitySearchHandler
+	Admin          *handler.AdminHandler
 }
 
 func NewAuthorizedAdapter(user *handler.UserHandler, modelService *handler.ModelServiceHandler,
-	slot *handler.SlotHandler, booking *handler.BookingHandler,
-	order *handler.OrderHandler, admin *handler.AdminHandler) *AuthorizedAdapter {
+	addOn *handler.AddOnHandler, slot *handler.SlotHandler, booking *handler.BookingHandler,
+	order *handler.OrderHandler, orderTracking *handler.OrderTrackingHandler,
+	dispute *handler.DisputeHandler, orderExtension *handler.OrderExtensionHandler,
+	payment *handler.PaymentHandler, ledger *handler.LedgerHandler, pricingRule *handler.PricingRuleHandler,
+	availability *handler.AvailabilityTemplateHandler, promoCode *handler.PromoCodeHandler, receipt *handler.ReceiptHandler,
+	calendarFeed *handler.CalendarFeedHandler, busyCalendar *handler.BusyCalendarHandler,
+	travelBuffer *handler.TravelBufferHandler, bookingRules *handler.BookingRulesHandler, timeOff *handler.TimeOffHandler,
+	search *handler.AvailabilitySearchHandler, admin *handler.AdminHandler) *AuthorizedAdapter {
 
 	return &AuthorizedAdapter{
-		User:         user,
-		ModelService: modelService,
-		Slot:         slot,
-		Booking:      booking,
-		Order:        order,
-		Admin:        admin,
+		User:           user,
+		ModelService:   modelService,
+		AddOn:          addOn,
+		Slot:           slot,
+		Booking:        booking,
+		Order:          order,
+		OrderTracking:  orderTracking,
+		Dispute:        dispute,
+		OrderExtension: orderExtension,
+		Payment:        payment,
+		Ledger:         ledger,
+		PricingRule:    pricingRule,
+		Availability:   availability,
+		PromoCode:      promoCode,
+		Receipt:        receipt,
+		CalendarFeed:   calendarFeed,
+		BusyCalendar:   busyCalendar,
+		TravelBuffer:   travelBuffer,
+		BookingRules:   bookingRules,
+		TimeOff:        timeOff,
+		Search:         search,
+		Admin:          admin,
 	}
 
 }
@@ -52,6 +90,42 @@ func (a *AuthorizedAdapter) PatchAdminBookingsIdStatus(ctx context.Context,
 	return a.Admin.UpdateBookingStatus(ctx, request)
 }
 
+func (a *AuthorizedAdapter) GetAdminDisputes(ctx context.Context,
+	request authorized.GetAdminDisputesRequestObject,
+) (authorized.GetAdminDisputesResponseObject, error) {
+	return a.Dispute.GetAllDisputes(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetAdminDisputesId(ctx context.Context,
+	request authorized.GetAdminDisputesIdRequestObject,
+) (authorized.GetAdminDisputesIdResponseObject, error) {
+	return a.Dispute.GetDisputeByID(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PatchAdminDisputesIdAssign(ctx context.Context,
+	request authorized.PatchAdminDisputesIdAssignRequestObject,
+) (authorized.PatchAdminDisputesIdAssignResponseObject, error) {
+	return a.Dispute.AssignDispute(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetAdminDisputesIdMessages(ctx context.Context,
+	request authorized.GetAdminDisputesIdMessagesRequestObject,
+) (authorized.GetAdminDisputesIdMessagesResponseObject, error) {
+	return a.Dispute.GetAdminDisputeMessages(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostAdminDisputesIdMessages(ctx context.Context,
+	request authorized.PostAdminDisputesIdMessagesRequestObject,
+) (authorized.PostAdminDisputesIdMessagesResponseObject, error) {
+	return a.Dispute.AddAdminDisputeMessage(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PatchAdminDisputesIdResolve(ctx context.Context,
+	request authorized.PatchAdminDisputesIdResolveRequestObject,
+) (authorized.PatchAdminDisputesIdResolveResponseObject, error) {
+	return a.Dispute.ResolveDispute(ctx, request)
+}
+
 func (a *AuthorizedAdapter) GetAdminOrders(ctx context.Context,
 	request authorized.GetAdminOrdersRequestObject) (authorized.GetAdminOrdersResponseObject, error) {
 	return a.Admin.GetAllOrders(ctx, request)
@@ -62,6 +136,42 @@ func (a *AuthorizedAdapter) GetAdminOrdersId(ctx context.Context,
 	return a.Admin.GetOrderByID(ctx, request)
 }
 
+func (a *AuthorizedAdapter) GetAdminLedgerTrialBalance(ctx context.Context,
+	request authorized.GetAdminLedgerTrialBalanceRequestObject,
+) (authorized.GetAdminLedgerTrialBalanceResponseObject, error) {
+	return a.Ledger.GetTrialBalance(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetAdminPromoCodes(ctx context.Context,
+	request authorized.GetAdminPromoCodesRequestObject,
+) (authorized.GetAdminPromoCodesResponseObject, error) {
+	return a.PromoCode.GetPromoCodes(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostAdminPromoCodes(ctx context.Context,
+	request authorized.PostAdminPromoCodesRequestObject,
+) (authorized.PostAdminPromoCodesResponseObject, error) {
+	return a.PromoCode.CreatePromoCode(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetAdminPromoCodesId(ctx context.Context,
+	request authorized.GetAdminPromoCodesIdRequestObject,
+) (authorized.GetAdminPromoCodesIdResponseObject, error) {
+	return a.PromoCode.GetPromoCodeByID(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PatchAdminPromoCodesIdDeactivate(ctx context.Context,
+	request authorized.PatchAdminPromoCodesIdDeactivateRequestObject,
+) (authorized.PatchAdminPromoCodesIdDeactivateResponseObject, error) {
+	return a.PromoCode.DeactivatePromoCode(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetAdminOrdersIdPayment(ctx context.Context,
+	request authorized.GetAdminOrdersIdPaymentRequestObject,
+) (authorized.GetAdminOrdersIdPaymentResponseObject, error) {
+	return a.Payment.GetOrderPayment(ctx, request)
+}
+
 func (a *AuthorizedAdapter) PatchAdminOrdersIdStatus(ctx context.Context,
 	request authorized.PatchAdminOrdersIdStatusRequestObject,
 ) (authorized.PatchAdminOrdersIdStatusResponseObject, error) {
@@ -94,18 +204,83 @@ func (a *AuthorizedAdapter) PostClientBookings(ctx context.Context,
 	return a.Booking.CreateBooking(ctx, request)
 }
 
+func (a *AuthorizedAdapter) PostClientBookingsQuote(ctx context.Context,
+	request authorized.PostClientBookingsQuoteRequestObject) (authorized.PostClientBookingsQuoteResponseObject, error) {
+	return a.Booking.QuoteBooking(ctx, request)
+}
+
 func (a *AuthorizedAdapter) PatchClientBookingsIdCancel(ctx context.Context,
 	request authorized.PatchClientBookingsIdCancelRequestObject,
 ) (authorized.PatchClientBookingsIdCancelResponseObject, error) {
 	return a.Booking.CancelBookingByClient(ctx, request)
 }
 
+func (a *AuthorizedAdapter) GetClientDisputesId(ctx context.Context,
+	request authorized.GetClientDisputesIdRequestObject,
+) (authorized.GetClientDisputesIdResponseObject, error) {
+	return a.Dispute.GetClientDispute(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetClientDisputesIdMessages(ctx context.Context,
+	request authorized.GetClientDisputesIdMessagesRequestObject,
+) (authorized.GetClientDisputesIdMessagesResponseObject, error) {
+	return a.Dispute.GetClientDisputeMessages(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostClientDisputesIdMessages(ctx context.Context,
+	request authorized.PostClientDisputesIdMessagesRequestObject,
+) (authorized.PostClientDisputesIdMessagesResponseObject, error) {
+	return a.Dispute.AddClientDisputeMessage(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostClientOrdersIdDisputes(ctx context.Context,
+	request authorized.PostClientOrdersIdDisputesRequestObject,
+) (authorized.PostClientOrdersIdDisputesResponseObject, error) {
+	return a.Dispute.OpenDisputeByClient(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetClientOrdersIdExtensions(ctx context.Context,
+	request authorized.GetClientOrdersIdExtensionsRequestObject,
+) (authorized.GetClientOrdersIdExtensionsResponseObject, error) {
+	return a.OrderExtension.GetClientOrderExtensions(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostClientOrdersIdExtensions(ctx context.Context,
+	request authorized.PostClientOrdersIdExtensionsRequestObject,
+) (authorized.PostClientOrdersIdExtensionsResponseObject, error) {
+	return a.OrderExtension.RequestExtension(ctx, request)
+}
+
 func (a *AuthorizedAdapter) PatchClientOrdersIdCancel(ctx context.Context,
 	request authorized.PatchClientOrdersIdCancelRequestObject,
 ) (authorized.PatchClientOrdersIdCancelResponseObject, error) {
 	return a.Order.CancelOrderByClient(ctx, request)
 }
 
+func (a *AuthorizedAdapter) PatchClientOrdersIdConfirm(ctx context.Context,
+	request authorized.PatchClientOrdersIdConfirmRequestObject,
+) (authorized.PatchClientOrdersIdConfirmResponseObject, error) {
+	return a.Order.ConfirmOrder(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetClientOrdersIdReceipt(ctx context.Context,
+	request authorized.GetClientOrdersIdReceiptRequestObject,
+) (authorized.GetClientOrdersIdReceiptResponseObject, error) {
+	return a.Receipt.GetOrderReceipt(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PatchClientOrdersIdIssue(ctx context.Context,
+	request authorized.PatchClientOrdersIdIssueRequestObject,
+) (authorized.PatchClientOrdersIdIssueResponseObject, error) {
+	return a.Order.RaiseOrderIssue(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetClientOrdersIdEvents(ctx context.Context,
+	request authorized.GetClientOrdersIdEventsRequestObject,
+) (authorized.GetClientOrdersIdEventsResponseObject, error) {
+	return a.OrderTracking.GetOrderEvents(ctx, request)
+}
+
 func (a *AuthorizedAdapter) GetClientServices(ctx context.Context,
 	request authorized.GetClientServicesRequestObject,
 ) (authorized.GetClientServicesResponseObject, error) {
@@ -135,11 +310,58 @@ func (a *AuthorizedAdapter) PatchModelBookingsIdReject(ctx context.Context,
 	return a.Booking.RejectBooking(ctx, request)
 }
 
+func (a *AuthorizedAdapter) GetModelDisputesId(ctx context.Context,
+	request authorized.GetModelDisputesIdRequestObject,
+) (authorized.GetModelDisputesIdResponseObject, error) {
+	return a.Dispute.GetModelDispute(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetModelDisputesIdMessages(ctx context.Context,
+	request authorized.GetModelDisputesIdMessagesRequestObject,
+) (authorized.GetModelDisputesIdMessagesResponseObject, error) {
+	return a.Dispute.GetModelDisputeMessages(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelDisputesIdMessages(ctx context.Context,
+	request authorized.PostModelDisputesIdMessagesRequestObject,
+) (authorized.PostModelDisputesIdMessagesResponseObject, error) {
+	return a.Dispute.AddModelDisputeMessage(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelOrdersIdDisputes(ctx context.Context,
+	request authorized.PostModelOrdersIdDisputesRequestObject,
+) (authorized.PostModelOrdersIdDisputesResponseObject, error) {
+	return a.Dispute.OpenDisputeByModel(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetModelEarnings(ctx context.Context,
+	request authorized.GetModelEarningsRequestObject) (authorized.GetModelEarningsResponseObject, error) {
+	return a.Ledger.GetModelEarnings(ctx, request)
+}
+
 func (a *AuthorizedAdapter) GetModelOrders(ctx context.Context,
 	request authorized.GetModelOrdersRequestObject) (authorized.GetModelOrdersResponseObject, error) {
 	return a.Order.GetModelOrders(ctx, request)
 }
 
+func (a *AuthorizedAdapter) GetModelOrdersIdExtensions(ctx context.Context,
+	request authorized.GetModelOrdersIdExtensionsRequestObject,
+) (authorized.GetModelOrdersIdExtensionsResponseObject, error) {
+	return a.OrderExtension.GetModelOrderExtensions(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PatchModelOrdersIdExtensionsExtensionIdAccept(ctx context.Context,
+	request authorized.PatchModelOrdersIdExtensionsExtensionIdAcceptRequestObject,
+) (authorized.PatchModelOrdersIdExtensionsExtensionIdAcceptResponseObject, error) {
+	return a.OrderExtension.AcceptExtension(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PatchModelOrdersIdExtensionsExtensionIdReject(ctx context.Context,
+	request authorized.PatchModelOrdersIdExtensionsExtensionIdRejectRequestObject,
+) (authorized.PatchModelOrdersIdExtensionsExtensionIdRejectResponseObject, error) {
+	return a.OrderExtension.RejectExtension(ctx, request)
+}
+
 func (a *AuthorizedAdapter) PatchModelOrdersIdCancel(ctx context.Context,
 	request authorized.PatchModelOrdersIdCancelRequestObject,
 ) (authorized.PatchModelOrdersIdCancelResponseObject, error) {
@@ -152,6 +374,114 @@ func (a *AuthorizedAdapter) PatchModelOrdersIdComplete(ctx context.Context,
 	return a.Order.CompleteOrder(ctx, request)
 }
 
+func (a *AuthorizedAdapter) PostModelOrdersIdLocation(ctx context.Context,
+	request authorized.PostModelOrdersIdLocationRequestObject,
+) (authorized.PostModelOrdersIdLocationResponseObject, error) {
+	return a.OrderTracking.PostOrderLocation(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetModelPricingRules(ctx context.Context,
+	request authorized.GetModelPricingRulesRequestObject,
+) (authorized.GetModelPricingRulesResponseObject, error) {
+	return a.PricingRule.GetPricingRules(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelPricingRules(ctx context.Context,
+	request authorized.PostModelPricingRulesRequestObject,
+) (authorized.PostModelPricingRulesResponseObject, error) {
+	return a.PricingRule.CreatePricingRule(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetModelPricingRulesId(ctx context.Context,
+	request authorized.GetModelPricingRulesIdRequestObject,
+) (authorized.GetModelPricingRulesIdResponseObject, error) {
+	return a.PricingRule.GetPricingRuleByID(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PutModelPricingRulesId(ctx context.Context,
+	request authorized.PutModelPricingRulesIdRequestObject,
+) (authorized.PutModelPricingRulesIdResponseObject, error) {
+	return a.PricingRule.UpdatePricingRule(ctx, request)
+}
+
+func (a *AuthorizedAdapter) DeleteModelPricingRulesId(ctx context.Context,
+	request authorized.DeleteModelPricingRulesIdRequestObject,
+) (authorized.DeleteModelPricingRulesIdResponseObject, error) {
+	return a.PricingRule.DeletePricingRule(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetModelAvailabilityTemplates(ctx context.Context,
+	request authorized.GetModelAvailabilityTemplatesRequestObject,
+) (authorized.GetModelAvailabilityTemplatesResponseObject, error) {
+	return a.Availability.GetTemplates(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelAvailabilityTemplates(ctx context.Context,
+	request authorized.PostModelAvailabilityTemplatesRequestObject,
+) (authorized.PostModelAvailabilityTemplatesResponseObject, error) {
+	return a.Availability.CreateTemplate(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetModelAvailabilityTemplatesId(ctx context.Context,
+	request authorized.GetModelAvailabilityTemplatesIdRequestObject,
+) (authorized.GetModelAvailabilityTemplatesIdResponseObject, error) {
+	return a.Availability.GetTemplateByID(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PutModelAvailabilityTemplatesId(ctx context.Context,
+	request authorized.PutModelAvailabilityTemplatesIdRequestObject,
+) (authorized.PutModelAvailabilityTemplatesIdResponseObject, error) {
+	return a.Availability.UpdateTemplate(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetModelAvailabilityTemplatesIdPreview(ctx context.Context,
+	request authorized.GetModelAvailabilityTemplatesIdPreviewRequestObject,
+) (authorized.GetModelAvailabilityTemplatesIdPreviewResponseObject, error) {
+	return a.Availability.PreviewTemplate(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelAvailabilityTemplatesIdPublish(ctx context.Context,
+	request authorized.PostModelAvailabilityTemplatesIdPublishRequestObject,
+) (authorized.PostModelAvailabilityTemplatesIdPublishResponseObject, error) {
+	return a.Availability.PublishTemplate(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelAvailabilityTemplatesIdUnpublish(ctx context.Context,
+	request authorized.PostModelAvailabilityTemplatesIdUnpublishRequestObject,
+) (authorized.PostModelAvailabilityTemplatesIdUnpublishResponseObject, error) {
+	return a.Availability.UnpublishTemplate(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetModelServicesIdAddOns(ctx context.Context,
+	request authorized.GetModelServicesIdAddOnsRequestObject,
+) (authorized.GetModelServicesIdAddOnsResponseObject, error) {
+	return a.AddOn.GetAddOnsByServiceID(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelServicesIdAddOns(ctx context.Context,
+	request authorized.PostModelServicesIdAddOnsRequestObject,
+) (authorized.PostModelServicesIdAddOnsResponseObject, error) {
+	return a.AddOn.CreateAddOn(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PatchModelAddOnsId(ctx context.Context,
+	request authorized.PatchModelAddOnsIdRequestObject,
+) (authorized.PatchModelAddOnsIdResponseObject, error) {
+	return a.AddOn.UpdateAddOn(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PatchModelAddOnsIdDeactivate(ctx context.Context,
+	request authorized.PatchModelAddOnsIdDeactivateRequestObject,
+) (authorized.PatchModelAddOnsIdDeactivateResponseObject, error) {
+	return a.AddOn.DeactivateAddOn(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetClientServicesIdAddOns(ctx context.Context,
+	request authorized.GetClientServicesIdAddOnsRequestObject,
+) (authorized.GetClientServicesIdAddOnsResponseObject, error) {
+	return a.AddOn.GetActiveAddOns(ctx, request)
+}
+
 func (a *AuthorizedAdapter) GetModelServices(ctx context.Context,
 	request authorized.GetModelServicesRequestObject) (authorized.GetModelServicesResponseObject, error) {
 	return a.ModelService.GetModelServices(ctx, request)
@@ -195,6 +525,30 @@ func (a *AuthorizedAdapter) PatchModelSlotsSlotIdDisable(ctx context.Context,
 	return a.Slot.DeactivateSlot(ctx, request)
 }
 
+func (a *AuthorizedAdapter) PostModelSlotsBatch(ctx context.Context,
+	request authorized.PostModelSlotsBatchRequestObject,
+) (authorized.PostModelSlotsBatchResponseObject, error) {
+	return a.Slot.CreateSlots(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PatchModelSlotsDisableRange(ctx context.Context,
+	request authorized.PatchModelSlotsDisableRangeRequestObject,
+) (authorized.PatchModelSlotsDisableRangeResponseObject, error) {
+	return a.Slot.DisableSlotsInRange(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelSlotsSlotIdSplit(ctx context.Context,
+	request authorized.PostModelSlotsSlotIdSplitRequestObject,
+) (authorized.PostModelSlotsSlotIdSplitResponseObject, error) {
+	return a.Slot.SplitSlot(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelSlotsMerge(ctx context.Context,
+	request authorized.PostModelSlotsMergeRequestObject,
+) (authorized.PostModelSlotsMergeResponseObject, error) {
+	return a.Slot.MergeSlots(ctx, request)
+}
+
 func (a *AuthorizedAdapter) PostUsers(ctx context.Context,
 	request authorized.PostUsersRequestObject) (authorized.PostUsersResponseObject, error) {
 	return a.User.CreateProfile(ctx, request)
@@ -214,3 +568,105 @@ func (a *AuthorizedAdapter) GetUsersId(ctx context.Context,
 	request authorized.GetUsersIdRequestObject) (authorized.GetUsersIdResponseObject, error) {
 	return a.User.GetSomeoneProfile(ctx, request)
 }
+
+func (a *AuthorizedAdapter) GetModelCalendarFeed(ctx context.Context,
+	request authorized.GetModelCalendarFeedRequestObject,
+) (authorized.GetModelCalendarFeedResponseObject, error) {
+	return a.CalendarFeed.GetModelCalendarFeed(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelCalendarFeedRegenerate(ctx context.Context,
+	request authorized.PostModelCalendarFeedRegenerateRequestObject,
+) (authorized.PostModelCalendarFeedRegenerateResponseObject, error) {
+	return a.CalendarFeed.RegenerateModelCalendarFeed(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetClientCalendarFeed(ctx context.Context,
+	request authorized.GetClientCalendarFeedRequestObject,
+) (authorized.GetClientCalendarFeedResponseObject, error) {
+	return a.CalendarFeed.GetClientCalendarFeed(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostClientCalendarFeedRegenerate(ctx context.Context,
+	request authorized.PostClientCalendarFeedRegenerateRequestObject,
+) (authorized.PostClientCalendarFeedRegenerateResponseObject, error) {
+	return a.CalendarFeed.RegenerateClientCalendarFeed(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetModelBusyCalendars(ctx context.Context,
+	request authorized.GetModelBusyCalendarsRequestObject,
+) (authorized.GetModelBusyCalendarsResponseObject, error) {
+	return a.BusyCalendar.GetCalendars(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelBusyCalendars(ctx context.Context,
+	request authorized.PostModelBusyCalendarsRequestObject,
+) (authorized.PostModelBusyCalendarsResponseObject, error) {
+	return a.BusyCalendar.CreateCalendar(ctx, request)
+}
+
+func (a *AuthorizedAdapter) DeleteModelBusyCalendarsId(ctx context.Context,
+	request authorized.DeleteModelBusyCalendarsIdRequestObject,
+) (authorized.DeleteModelBusyCalendarsIdResponseObject, error) {
+	return a.BusyCalendar.DeleteCalendar(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PutModelBusyCalendarsIdEvents(ctx context.Context,
+	request authorized.PutModelBusyCalendarsIdEventsRequestObject,
+) (authorized.PutModelBusyCalendarsIdEventsResponseObject, error) {
+	return a.BusyCalendar.UploadEvents(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelBusyCalendarsIdSync(ctx context.Context,
+	request authorized.PostModelBusyCalendarsIdSyncRequestObject,
+) (authorized.PostModelBusyCalendarsIdSyncResponseObject, error) {
+	return a.BusyCalendar.SyncCalendar(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetModelTravelBuffer(ctx context.Context,
+	request authorized.GetModelTravelBufferRequestObject,
+) (authorized.GetModelTravelBufferResponseObject, error) {
+	return a.TravelBuffer.GetBuffer(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PutModelTravelBuffer(ctx context.Context,
+	request authorized.PutModelTravelBufferRequestObject,
+) (authorized.PutModelTravelBufferResponseObject, error) {
+	return a.TravelBuffer.UpdateBuffer(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetModelBookingRules(ctx context.Context,
+	request authorized.GetModelBookingRulesRequestObject,
+) (authorized.GetModelBookingRulesResponseObject, error) {
+	return a.BookingRules.GetRules(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PutModelBookingRules(ctx context.Context,
+	request authorized.PutModelBookingRulesRequestObject,
+) (authorized.PutModelBookingRulesResponseObject, error) {
+	return a.BookingRules.UpdateRules(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetModelTimeOffs(ctx context.Context,
+	request authorized.GetModelTimeOffsRequestObject,
+) (authorized.GetModelTimeOffsResponseObject, error) {
+	return a.TimeOff.GetTimeOffs(ctx, request)
+}
+
+func (a *AuthorizedAdapter) PostModelTimeOffs(ctx context.Context,
+	request authorized.PostModelTimeOffsRequestObject,
+) (authorized.PostModelTimeOffsResponseObject, error) {
+	return a.TimeOff.CreateTimeOff(ctx, request)
+}
+
+func (a *AuthorizedAdapter) DeleteModelTimeOffsId(ctx context.Context,
+	request authorized.DeleteModelTimeOffsIdRequestObject,
+) (authorized.DeleteModelTimeOffsIdResponseObject, error) {
+	return a.TimeOff.DeleteTimeOff(ctx, request)
+}
+
+func (a *AuthorizedAdapter) GetClientAvailability(ctx context.Context,
+	request authorized.GetClientAvailabilityRequestObject,
+) (authorized.GetClientAvailabilityResponseObject, error) {
+	return a.Search.SearchAvailability(ctx, request)
+}
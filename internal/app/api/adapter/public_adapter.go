@@ -8,12 +8,17 @@ import (
 )
 
 type PublicAdapter struct {
-	Auth *handler.AuthHandler
+	Auth         *handler.AuthHandler
+	Payment      *handler.PaymentHandler
+	CalendarFeed *handler.CalendarFeedHandler
 }
 
-func NewPublicAdapter(auth *handler.AuthHandler) *PublicAdapter {
+func NewPublicAdapter(auth *handler.AuthHandler, payment *handler.PaymentHandler,
+	calendarFeed *handler.CalendarFeedHandler) *PublicAdapter {
 	return &PublicAdapter{
-		Auth: auth,
+		Auth:         auth,
+		Payment:      payment,
+		CalendarFeed: calendarFeed,
 	}
 }
 
@@ -26,3 +31,13 @@ func (p *PublicAdapter) PostAuthRegister(ctx context.Context,
 	request public.PostAuthRegisterRequestObject) (public.PostAuthRegisterResponseObject, error) {
 	return p.Auth.Register(ctx, request)
 }
+
+func (p *PublicAdapter) PostPaymentsWebhook(ctx context.Context,
+	request public.PostPaymentsWebhookRequestObject) (public.PostPaymentsWebhookResponseObject, error) {
+	return p.Payment.HandleWebhook(ctx, request)
+}
+
+func (p *PublicAdapter) GetCalendarToken(ctx context.Context,
+	request public.GetCalendarTokenRequestObject) (public.GetCalendarTokenResponseObject, error) {
+	return p.CalendarFeed.ExportFeed(ctx, request)
+}
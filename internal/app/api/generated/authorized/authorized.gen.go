@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -30,12 +31,35 @@ type PostAdminJSONBody struct {
 type GetAdminBookingsParams struct {
 	Page  *int64 `form:"page,omitempty" json:"page,omitempty"`
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+	// Archived List the bookings the retention job has moved to the archive instead of the live ones
+	Archived *bool `form:"archived,omitempty" json:"archived,omitempty"`
+}
+
+// GetAdminDisputesParams defines parameters for GetAdminDisputes.
+type GetAdminDisputesParams struct {
+	Status *externalRef0.DisputeStatus `form:"status,omitempty" json:"status,omitempty"`
+	Page   *int64                      `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int64                      `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAdminDisputesIdMessagesParams defines parameters for GetAdminDisputesIdMessages.
+type GetAdminDisputesIdMessagesParams struct {
+	Page  *int64 `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // GetAdminOrdersParams defines parameters for GetAdminOrders.
 type GetAdminOrdersParams struct {
 	Page  *int64 `form:"page,omitempty" json:"page,omitempty"`
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+	// Archived List the orders the retention job has moved to the archive instead of the live ones
+	Archived *bool `form:"archived,omitempty" json:"archived,omitempty"`
+}
+
+// GetAdminPromoCodesParams defines parameters for GetAdminPromoCodes.
+type GetAdminPromoCodesParams struct {
+	Page  *int64 `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // GetAdminUsersParams defines parameters for GetAdminUsers.
@@ -49,24 +73,103 @@ type PatchAdminIdJSONBody struct {
 	Permissions map[string]bool `json:"permissions"`
 }
 
+// GetClientAvailabilityParams defines parameters for GetClientAvailability.
+type GetClientAvailabilityParams struct {
+	From time.Time `form:"from" json:"from"`
+	// To The window lasts at most 31 days
+	To time.Time `form:"to" json:"to"`
+	// ServiceQuery Text the title or the description of the service contains
+	ServiceQuery *string  `form:"service_query,omitempty" json:"service_query,omitempty"`
+	MaxPrice     *float64 `form:"max_price,omitempty" json:"max_price,omitempty"`
+	// Sort price sorts by the cheapest matching service, start_time by the earliest slot
+	Sort  *externalRef0.AvailabilitySort `form:"sort,omitempty" json:"sort,omitempty"`
+	Page  *int64                         `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int64                         `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetClientDisputesIdMessagesParams defines parameters for GetClientDisputesIdMessages.
+type GetClientDisputesIdMessagesParams struct {
+	Page  *int64 `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetClientModelsModelIdSlotsParams defines parameters for GetClientModelsModelIdSlots.
+type GetClientModelsModelIdSlotsParams struct {
+	// From Slots starting at this time or later, now by default
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	// To Slots starting before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	// Status Slots with this status, AVAILABLE by default, DISABLED is rejected
+	Status *externalRef0.SlotStatus `form:"status,omitempty" json:"status,omitempty"`
+	Page   *int64                   `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int64                   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetClientOrdersIdEventsParams defines parameters for GetClientOrdersIdEvents.
+type GetClientOrdersIdEventsParams struct {
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
+// GetClientOrdersIdReceiptParams defines parameters for GetClientOrdersIdReceipt.
+type GetClientOrdersIdReceiptParams struct {
+	// Format html returns a printable document, json is the default
+	Format *externalRef0.ReceiptFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
 // GetClientServicesParams defines parameters for GetClientServices.
 type GetClientServicesParams struct {
 	Page  *int64 `form:"page,omitempty" json:"page,omitempty"`
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetModelAvailabilityTemplatesParams defines parameters for GetModelAvailabilityTemplates.
+type GetModelAvailabilityTemplatesParams struct {
+	Page  *int64 `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetModelDisputesIdMessagesParams defines parameters for GetModelDisputesIdMessages.
+type GetModelDisputesIdMessagesParams struct {
+	Page  *int64 `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetModelEarningsParams defines parameters for GetModelEarnings.
+type GetModelEarningsParams struct {
+	Page  *int64 `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetModelOrdersParams defines parameters for GetModelOrders.
 type GetModelOrdersParams struct {
 	Page  *int64 `form:"page,omitempty" json:"page,omitempty"`
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetModelPricingRulesParams defines parameters for GetModelPricingRules.
+type GetModelPricingRulesParams struct {
+	Page  *int64 `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetModelServicesParams defines parameters for GetModelServices.
 type GetModelServicesParams struct {
 	Page  *int64 `form:"page,omitempty" json:"page,omitempty"`
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetModelSlotsParams defines parameters for GetModelSlots.
+type GetModelSlotsParams struct {
+	// From Slots starting at this time or later
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	// To Slots starting before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	// Status Slots with this status, all statuses by default
+	Status *externalRef0.SlotStatus `form:"status,omitempty" json:"status,omitempty"`
+	Page   *int64                   `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int64                   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // PostModelSlotsJSONBody defines parameters for PostModelSlots.
 type PostModelSlotsJSONBody struct {
 	End   time.Time `json:"end"`
@@ -85,27 +188,105 @@ type PostAdminJSONRequestBody PostAdminJSONBody
 // PatchAdminBookingsIdStatusJSONRequestBody defines body for PatchAdminBookingsIdStatus for application/json ContentType.
 type PatchAdminBookingsIdStatusJSONRequestBody = externalRef0.UpdateStatusRequest
 
+// PatchAdminDisputesIdAssignJSONRequestBody defines body for PatchAdminDisputesIdAssign for application/json ContentType.
+type PatchAdminDisputesIdAssignJSONRequestBody = externalRef0.DisputeAssignRequest
+
+// PostAdminDisputesIdMessagesJSONRequestBody defines body for PostAdminDisputesIdMessages for application/json ContentType.
+type PostAdminDisputesIdMessagesJSONRequestBody = externalRef0.DisputeMessageRequest
+
+// PatchAdminDisputesIdResolveJSONRequestBody defines body for PatchAdminDisputesIdResolve for application/json ContentType.
+type PatchAdminDisputesIdResolveJSONRequestBody = externalRef0.DisputeResolveRequest
+
 // PatchAdminOrdersIdStatusJSONRequestBody defines body for PatchAdminOrdersIdStatus for application/json ContentType.
 type PatchAdminOrdersIdStatusJSONRequestBody = externalRef0.UpdateStatusRequest
 
+// PostAdminPromoCodesJSONRequestBody defines body for PostAdminPromoCodes for application/json ContentType.
+type PostAdminPromoCodesJSONRequestBody = externalRef0.PromoCodeRequest
+
 // PatchAdminIdJSONRequestBody defines body for PatchAdminId for application/json ContentType.
 type PatchAdminIdJSONRequestBody PatchAdminIdJSONBody
 
 // PostClientBookingsJSONRequestBody defines body for PostClientBookings for application/json ContentType.
 type PostClientBookingsJSONRequestBody = externalRef0.BookingRequest
 
+// PostClientBookingsQuoteJSONRequestBody defines body for PostClientBookingsQuote for application/json ContentType.
+type PostClientBookingsQuoteJSONRequestBody = externalRef0.BookingQuoteRequest
+
+// PostClientDisputesIdMessagesJSONRequestBody defines body for PostClientDisputesIdMessages for application/json ContentType.
+type PostClientDisputesIdMessagesJSONRequestBody = externalRef0.DisputeMessageRequest
+
+// PostClientOrdersIdDisputesJSONRequestBody defines body for PostClientOrdersIdDisputes for application/json ContentType.
+type PostClientOrdersIdDisputesJSONRequestBody = externalRef0.DisputeCreateRequest
+
+// PostClientOrdersIdExtensionsJSONRequestBody defines body for PostClientOrdersIdExtensions for application/json ContentType.
+type PostClientOrdersIdExtensionsJSONRequestBody = externalRef0.OrderExtensionRequest
+
+// PatchClientOrdersIdIssueJSONRequestBody defines body for PatchClientOrdersIdIssue for application/json ContentType.
+type PatchClientOrdersIdIssueJSONRequestBody = externalRef0.OrderIssueRequest
+
+// PatchModelAddOnsIdJSONRequestBody defines body for PatchModelAddOnsId for application/json ContentType.
+type PatchModelAddOnsIdJSONRequestBody = externalRef0.AddOnUpdateRequest
+
+// PostModelAvailabilityTemplatesJSONRequestBody defines body for PostModelAvailabilityTemplates for application/json ContentType.
+type PostModelAvailabilityTemplatesJSONRequestBody = externalRef0.AvailabilityTemplateRequest
+
+// PutModelAvailabilityTemplatesIdJSONRequestBody defines body for PutModelAvailabilityTemplatesId for application/json ContentType.
+type PutModelAvailabilityTemplatesIdJSONRequestBody = externalRef0.AvailabilityTemplateRequest
+
+// PutModelBookingRulesJSONRequestBody defines body for PutModelBookingRules for application/json ContentType.
+type PutModelBookingRulesJSONRequestBody = externalRef0.BookingRulesRequest
+
+// PostModelBusyCalendarsJSONRequestBody defines body for PostModelBusyCalendars for application/json ContentType.
+type PostModelBusyCalendarsJSONRequestBody = externalRef0.BusyCalendarRequest
+
+// PostModelDisputesIdMessagesJSONRequestBody defines body for PostModelDisputesIdMessages for application/json ContentType.
+type PostModelDisputesIdMessagesJSONRequestBody = externalRef0.DisputeMessageRequest
+
+// PostModelOrdersIdDisputesJSONRequestBody defines body for PostModelOrdersIdDisputes for application/json ContentType.
+type PostModelOrdersIdDisputesJSONRequestBody = externalRef0.DisputeCreateRequest
+
+// PostModelOrdersIdLocationJSONRequestBody defines body for PostModelOrdersIdLocation for application/json ContentType.
+type PostModelOrdersIdLocationJSONRequestBody = externalRef0.OrderLocationRequest
+
+// PostModelPricingRulesJSONRequestBody defines body for PostModelPricingRules for application/json ContentType.
+type PostModelPricingRulesJSONRequestBody = externalRef0.PricingRuleRequest
+
+// PutModelPricingRulesIdJSONRequestBody defines body for PutModelPricingRulesId for application/json ContentType.
+type PutModelPricingRulesIdJSONRequestBody = externalRef0.PricingRuleRequest
+
 // PostModelServicesJSONRequestBody defines body for PostModelServices for application/json ContentType.
 type PostModelServicesJSONRequestBody = externalRef0.ModelServiceCreateDTO
 
 // PatchModelServicesIdJSONRequestBody defines body for PatchModelServicesId for application/json ContentType.
 type PatchModelServicesIdJSONRequestBody = externalRef0.ModelServiceUpdateDTO
 
+// PostModelServicesIdAddOnsJSONRequestBody defines body for PostModelServicesIdAddOns for application/json ContentType.
+type PostModelServicesIdAddOnsJSONRequestBody = externalRef0.AddOnCreateRequest
+
 // PostModelSlotsJSONRequestBody defines body for PostModelSlots for application/json ContentType.
 type PostModelSlotsJSONRequestBody PostModelSlotsJSONBody
 
+// PostModelSlotsBatchJSONRequestBody defines body for PostModelSlotsBatch for application/json ContentType.
+type PostModelSlotsBatchJSONRequestBody = externalRef0.SlotBatchRequest
+
+// PatchModelSlotsDisableRangeJSONRequestBody defines body for PatchModelSlotsDisableRange for application/json ContentType.
+type PatchModelSlotsDisableRangeJSONRequestBody = externalRef0.SlotRangeRequest
+
+// PostModelSlotsMergeJSONRequestBody defines body for PostModelSlotsMerge for application/json ContentType.
+type PostModelSlotsMergeJSONRequestBody = externalRef0.SlotMergeRequest
+
 // PatchModelSlotsSlotIdJSONRequestBody defines body for PatchModelSlotsSlotId for application/json ContentType.
 type PatchModelSlotsSlotIdJSONRequestBody PatchModelSlotsSlotIdJSONBody
 
+// PostModelSlotsSlotIdSplitJSONRequestBody defines body for PostModelSlotsSlotIdSplit for application/json ContentType.
+type PostModelSlotsSlotIdSplitJSONRequestBody = externalRef0.SlotSplitRequest
+
+// PostModelTimeOffsJSONRequestBody defines body for PostModelTimeOffs for application/json ContentType.
+type PostModelTimeOffsJSONRequestBody = externalRef0.TimeOffRequest
+
+// PutModelTravelBufferJSONRequestBody defines body for PutModelTravelBuffer for application/json ContentType.
+type PutModelTravelBufferJSONRequestBody = externalRef0.TravelBufferRequest
+
 // PostUsersJSONRequestBody defines body for PostUsers for application/json ContentType.
 type PostUsersJSONRequestBody = externalRef0.UserDTO
 
@@ -126,15 +307,51 @@ type ServerInterface interface {
 	// Admin can update booking status (including cancellation <24h)
 	// (PATCH /admin/bookings/{id}/status)
 	PatchAdminBookingsIdStatus(w http.ResponseWriter, r *http.Request, id int64)
+	// Admin gets all disputes
+	// (GET /admin/disputes)
+	GetAdminDisputes(w http.ResponseWriter, r *http.Request, params GetAdminDisputesParams)
+	// Admin gets dispute by id
+	// (GET /admin/disputes/{id})
+	GetAdminDisputesId(w http.ResponseWriter, r *http.Request, id int64)
+	// Admin assigns the dispute to himself or to another admin
+	// (PATCH /admin/disputes/{id}/assign)
+	PatchAdminDisputesIdAssign(w http.ResponseWriter, r *http.Request, id int64)
+	// Admin gets the dispute message thread
+	// (GET /admin/disputes/{id}/messages)
+	GetAdminDisputesIdMessages(w http.ResponseWriter, r *http.Request, id int64, params GetAdminDisputesIdMessagesParams)
+	// Admin writes to the dispute message thread
+	// (POST /admin/disputes/{id}/messages)
+	PostAdminDisputesIdMessages(w http.ResponseWriter, r *http.Request, id int64)
+	// Admin resolves the dispute, the resolution moves the order to its final status
+	// (PATCH /admin/disputes/{id}/resolve)
+	PatchAdminDisputesIdResolve(w http.ResponseWriter, r *http.Request, id int64)
+	// Admin gets the ledger trial balance
+	// (GET /admin/ledger/trial-balance)
+	GetAdminLedgerTrialBalance(w http.ResponseWriter, r *http.Request)
 	// Admin gets all orders
 	// (GET /admin/orders)
 	GetAdminOrders(w http.ResponseWriter, r *http.Request, params GetAdminOrdersParams)
 	// Admin gets order by id
 	// (GET /admin/orders/{id})
 	GetAdminOrdersId(w http.ResponseWriter, r *http.Request, id int64)
+	// Admin gets the payment of the order
+	// (GET /admin/orders/{id}/payment)
+	GetAdminOrdersIdPayment(w http.ResponseWriter, r *http.Request, id int64)
 	// Admin can update order status (including cancellation <24h)
 	// (PATCH /admin/orders/{id}/status)
 	PatchAdminOrdersIdStatus(w http.ResponseWriter, r *http.Request, id int64)
+	// Admin gets all promo codes
+	// (GET /admin/promo-codes)
+	GetAdminPromoCodes(w http.ResponseWriter, r *http.Request, params GetAdminPromoCodesParams)
+	// Admin creates a promo code
+	// (POST /admin/promo-codes)
+	PostAdminPromoCodes(w http.ResponseWriter, r *http.Request)
+	// Admin gets promo code by id
+	// (GET /admin/promo-codes/{id})
+	GetAdminPromoCodesId(w http.ResponseWriter, r *http.Request, id int64)
+	// Admin deactivates promo code, bookings already made keep their discount
+	// (PATCH /admin/promo-codes/{id}/deactivate)
+	PatchAdminPromoCodesIdDeactivate(w http.ResponseWriter, r *http.Request, id int64)
 	// Admin gets all users with full personal information
 	// (GET /admin/users)
 	GetAdminUsers(w http.ResponseWriter, r *http.Request, params GetAdminUsersParams)
@@ -147,39 +364,180 @@ type ServerInterface interface {
 	// Admin can update another admin permissions
 	// (PATCH /admin/{id})
 	PatchAdminId(w http.ResponseWriter, r *http.Request, id int64)
+	// Client finds the models free in a time window with their available slots and matching active services
+	// (GET /client/availability)
+	GetClientAvailability(w http.ResponseWriter, r *http.Request, params GetClientAvailabilityParams)
 	// Client creates booking - books a slot
 	// (POST /client/bookings)
 	PostClientBookings(w http.ResponseWriter, r *http.Request)
+	// Client gets the itemized price of a booking - nothing is reserved
+	// (POST /client/bookings/quote)
+	PostClientBookingsQuote(w http.ResponseWriter, r *http.Request)
 	// Client cancels a booking - only their own Pending booking
 	// (PATCH /client/bookings/{id}/cancel)
 	PatchClientBookingsIdCancel(w http.ResponseWriter, r *http.Request, id int64)
-	// Client can get available slots of a given model. Disabled slots are filtered out.
+	// Client gets the secret iCalendar feed of upcoming orders, it is created on the first request
+	// (GET /client/calendar-feed)
+	GetClientCalendarFeed(w http.ResponseWriter, r *http.Request)
+	// Client replaces the token of the calendar feed, the old feed URL stops working
+	// (POST /client/calendar-feed/regenerate)
+	PostClientCalendarFeedRegenerate(w http.ResponseWriter, r *http.Request)
+	// Client gets own dispute by id
+	// (GET /client/disputes/{id})
+	GetClientDisputesId(w http.ResponseWriter, r *http.Request, id int64)
+	// Client gets the dispute message thread
+	// (GET /client/disputes/{id}/messages)
+	GetClientDisputesIdMessages(w http.ResponseWriter, r *http.Request, id int64, params GetClientDisputesIdMessagesParams)
+	// Client writes to the dispute message thread
+	// (POST /client/disputes/{id}/messages)
+	PostClientDisputesIdMessages(w http.ResponseWriter, r *http.Request, id int64)
+	// Client can get slots of a given model by start time. Disabled slots are never shown.
 	// (GET /client/models/{modelId}/slots)
-	GetClientModelsModelIdSlots(w http.ResponseWriter, r *http.Request, modelId int64)
+	GetClientModelsModelIdSlots(w http.ResponseWriter, r *http.Request, modelId int64, params GetClientModelsModelIdSlotsParams)
 	// Client can cancel their order
 	// (PATCH /client/orders/{id}/cancel)
 	PatchClientOrdersIdCancel(w http.ResponseWriter, r *http.Request, id int64)
+	// Client confirms the order completed by the model, its payment is captured then
+	// (PATCH /client/orders/{id}/confirm)
+	PatchClientOrdersIdConfirm(w http.ResponseWriter, r *http.Request, id int64)
+	// Client opens a dispute on the order within the window after the slot ends
+	// (POST /client/orders/{id}/disputes)
+	PostClientOrdersIdDisputes(w http.ResponseWriter, r *http.Request, id int64)
+	// Client follows live status changes, ETA and location of their order (Server-Sent Events)
+	// (GET /client/orders/{id}/events)
+	GetClientOrdersIdEvents(w http.ResponseWriter, r *http.Request, id int64, params GetClientOrdersIdEventsParams)
+	// Client gets extensions requested for the order
+	// (GET /client/orders/{id}/extensions)
+	GetClientOrdersIdExtensions(w http.ResponseWriter, r *http.Request, id int64)
+	// Client requests to extend the order by several minutes
+	// (POST /client/orders/{id}/extensions)
+	PostClientOrdersIdExtensions(w http.ResponseWriter, r *http.Request, id int64)
+	// Client raises an issue instead of confirming the order, it opens a dispute case with the reason
+	// (PATCH /client/orders/{id}/issue)
+	PatchClientOrdersIdIssue(w http.ResponseWriter, r *http.Request, id int64)
+	// Client gets the receipt of a completed order, it is issued when the payment is captured
+	// (GET /client/orders/{id}/receipt)
+	GetClientOrdersIdReceipt(w http.ResponseWriter, r *http.Request, id int64, params GetClientOrdersIdReceiptParams)
 	// Client gets all active services with pagination
 	// (GET /client/services)
 	GetClientServices(w http.ResponseWriter, r *http.Request, params GetClientServicesParams)
 	// Client gets service by id
 	// (GET /client/services/{id})
 	GetClientServicesId(w http.ResponseWriter, r *http.Request, id int64)
+	// Client gets active add-ons of the service
+	// (GET /client/services/{id}/add-ons)
+	GetClientServicesIdAddOns(w http.ResponseWriter, r *http.Request, id int64)
+	// Model updates their add-on, existing bookings keep the chosen add-ons as they were
+	// (PATCH /model/add-ons/{id})
+	PatchModelAddOnsId(w http.ResponseWriter, r *http.Request, id int64)
+	// Model deactivates their add-on
+	// (PATCH /model/add-ons/{id}/deactivate)
+	PatchModelAddOnsIdDeactivate(w http.ResponseWriter, r *http.Request, id int64)
+	// Model gets their availability templates
+	// (GET /model/availability-templates)
+	GetModelAvailabilityTemplates(w http.ResponseWriter, r *http.Request, params GetModelAvailabilityTemplatesParams)
+	// Model creates a draft availability template, slots are generated once it is published
+	// (POST /model/availability-templates)
+	PostModelAvailabilityTemplates(w http.ResponseWriter, r *http.Request)
+	// Model gets their availability template by id
+	// (GET /model/availability-templates/{id})
+	GetModelAvailabilityTemplatesId(w http.ResponseWriter, r *http.Request, id int64)
+	// Model replaces their availability template, the slots of a published one are generated anew
+	// (PUT /model/availability-templates/{id})
+	PutModelAvailabilityTemplatesId(w http.ResponseWriter, r *http.Request, id int64)
+	// Model previews the slots the template would generate if it were published now
+	// (GET /model/availability-templates/{id}/preview)
+	GetModelAvailabilityTemplatesIdPreview(w http.ResponseWriter, r *http.Request, id int64)
+	// Model publishes the availability template, its slots are generated ahead from now on
+	// (POST /model/availability-templates/{id}/publish)
+	PostModelAvailabilityTemplatesIdPublish(w http.ResponseWriter, r *http.Request, id int64)
+	// Model stops the availability template, its available slots are taken back
+	// (POST /model/availability-templates/{id}/unpublish)
+	PostModelAvailabilityTemplatesIdUnpublish(w http.ResponseWriter, r *http.Request, id int64)
+	// Model gets the notice, horizon and slot duration rules for their slots
+	// (GET /model/booking-rules)
+	GetModelBookingRules(w http.ResponseWriter, r *http.Request)
+	// Model tightens the platform booking rules, existing slots and bookings are left as they are
+	// (PUT /model/booking-rules)
+	PutModelBookingRules(w http.ResponseWriter, r *http.Request)
 	// Model approves a booking - a Pending booking if they own the service
 	// (PATCH /model/bookings/{id}/approve)
 	PatchModelBookingsIdApprove(w http.ResponseWriter, r *http.Request, id int64)
 	// Model rejects a booking - a Pending booking if they own the service
 	// (PATCH /model/bookings/{id}/reject)
 	PatchModelBookingsIdReject(w http.ResponseWriter, r *http.Request, id int64)
+	// Model gets the outside calendars whose busy events block the slots
+	// (GET /model/busy-calendars)
+	GetModelBusyCalendars(w http.ResponseWriter, r *http.Request)
+	// Model adds an outside calendar, a calendar with URL is fetched at once and then regularly
+	// (POST /model/busy-calendars)
+	PostModelBusyCalendars(w http.ResponseWriter, r *http.Request)
+	// Model removes the outside calendar, the slots blocked only by it become available again
+	// (DELETE /model/busy-calendars/{id})
+	DeleteModelBusyCalendarsId(w http.ResponseWriter, r *http.Request, id int64)
+	// Model uploads the .ics file of a calendar without URL, its events replace the uploaded before
+	// (PUT /model/busy-calendars/{id}/events)
+	PutModelBusyCalendarsIdEvents(w http.ResponseWriter, r *http.Request, id int64)
+	// Model fetches the calendar from its URL again and applies its events to the slots
+	// (POST /model/busy-calendars/{id}/sync)
+	PostModelBusyCalendarsIdSync(w http.ResponseWriter, r *http.Request, id int64)
+	// Model gets the secret iCalendar feed of slots and orders, it is created on the first request
+	// (GET /model/calendar-feed)
+	GetModelCalendarFeed(w http.ResponseWriter, r *http.Request)
+	// Model replaces the token of the calendar feed, the old feed URL stops working
+	// (POST /model/calendar-feed/regenerate)
+	PostModelCalendarFeedRegenerate(w http.ResponseWriter, r *http.Request)
+	// Model gets own dispute by id
+	// (GET /model/disputes/{id})
+	GetModelDisputesId(w http.ResponseWriter, r *http.Request, id int64)
+	// Model gets the dispute message thread
+	// (GET /model/disputes/{id}/messages)
+	GetModelDisputesIdMessages(w http.ResponseWriter, r *http.Request, id int64, params GetModelDisputesIdMessagesParams)
+	// Model writes to the dispute message thread
+	// (POST /model/disputes/{id}/messages)
+	PostModelDisputesIdMessages(w http.ResponseWriter, r *http.Request, id int64)
+	// Model gets the earnings balance and ledger entries
+	// (GET /model/earnings)
+	GetModelEarnings(w http.ResponseWriter, r *http.Request, params GetModelEarningsParams)
 	// Model gets all their orders
 	// (GET /model/orders)
 	GetModelOrders(w http.ResponseWriter, r *http.Request, params GetModelOrdersParams)
 	// Model can cancel their order
 	// (PATCH /model/orders/{id}/cancel)
 	PatchModelOrdersIdCancel(w http.ResponseWriter, r *http.Request, id int64)
-	// Model completes their order
+	// Model completes their order, then it waits for the client confirmation
 	// (PATCH /model/orders/{id}/complete)
 	PatchModelOrdersIdComplete(w http.ResponseWriter, r *http.Request, id int64)
+	// Model opens a dispute on the order within the window after the slot ends
+	// (POST /model/orders/{id}/disputes)
+	PostModelOrdersIdDisputes(w http.ResponseWriter, r *http.Request, id int64)
+	// Model gets extensions requested for the order
+	// (GET /model/orders/{id}/extensions)
+	GetModelOrdersIdExtensions(w http.ResponseWriter, r *http.Request, id int64)
+	// Model accepts the extension, the slot is extended and the extra time is held on the payment pro-rata
+	// (PATCH /model/orders/{id}/extensions/{extensionId}/accept)
+	PatchModelOrdersIdExtensionsExtensionIdAccept(w http.ResponseWriter, r *http.Request, id int64, extensionId int64)
+	// Model rejects the extension
+	// (PATCH /model/orders/{id}/extensions/{extensionId}/reject)
+	PatchModelOrdersIdExtensionsExtensionIdReject(w http.ResponseWriter, r *http.Request, id int64, extensionId int64)
+	// Model posts their current location and ETA for the order
+	// (POST /model/orders/{id}/location)
+	PostModelOrdersIdLocation(w http.ResponseWriter, r *http.Request, id int64)
+	// Model gets their pricing rules
+	// (GET /model/pricing-rules)
+	GetModelPricingRules(w http.ResponseWriter, r *http.Request, params GetModelPricingRulesParams)
+	// Model creates a pricing rule, it applies to the bookings made after it
+	// (POST /model/pricing-rules)
+	PostModelPricingRules(w http.ResponseWriter, r *http.Request)
+	// Model deletes their pricing rule, existing bookings keep their price
+	// (DELETE /model/pricing-rules/{id})
+	DeleteModelPricingRulesId(w http.ResponseWriter, r *http.Request, id int64)
+	// Model gets their pricing rule by id
+	// (GET /model/pricing-rules/{id})
+	GetModelPricingRulesId(w http.ResponseWriter, r *http.Request, id int64)
+	// Model replaces their pricing rule, existing bookings keep their price
+	// (PUT /model/pricing-rules/{id})
+	PutModelPricingRulesId(w http.ResponseWriter, r *http.Request, id int64)
 	// Model gets all their services
 	// (GET /model/services)
 	GetModelServices(w http.ResponseWriter, r *http.Request, params GetModelServicesParams)
@@ -189,21 +547,54 @@ type ServerInterface interface {
 	// Model updates service by id
 	// (PATCH /model/services/{id})
 	PatchModelServicesId(w http.ResponseWriter, r *http.Request, id int64)
+	// Model gets all add-ons of their service
+	// (GET /model/services/{id}/add-ons)
+	GetModelServicesIdAddOns(w http.ResponseWriter, r *http.Request, id int64)
+	// Model adds an add-on to their service
+	// (POST /model/services/{id}/add-ons)
+	PostModelServicesIdAddOns(w http.ResponseWriter, r *http.Request, id int64)
 	// Model deactivates service by id
 	// (PATCH /model/services/{id}/deactivate)
 	PatchModelServicesIdDeactivate(w http.ResponseWriter, r *http.Request, id int64)
-	// Model can get all of their slots
+	// Model can get their slots by start time
 	// (GET /model/slots)
-	GetModelSlots(w http.ResponseWriter, r *http.Request)
+	GetModelSlots(w http.ResponseWriter, r *http.Request, params GetModelSlotsParams)
 	// Model can create a slot for booking
 	// (POST /model/slots)
 	PostModelSlots(w http.ResponseWriter, r *http.Request)
+	// Model creates many slots at once, either all of them or none
+	// (POST /model/slots/batch)
+	PostModelSlotsBatch(w http.ResponseWriter, r *http.Request)
+	// Model disables their available slots lying inside the range, the other slots are skipped
+	// (PATCH /model/slots/disable-range)
+	PatchModelSlotsDisableRange(w http.ResponseWriter, r *http.Request)
+	// Model joins their available slots following each other without gaps into the earliest one
+	// (POST /model/slots/merge)
+	PostModelSlotsMerge(w http.ResponseWriter, r *http.Request)
 	// Model can update start, end of their own slot.
 	// (PATCH /model/slots/{slotId})
 	PatchModelSlotsSlotId(w http.ResponseWriter, r *http.Request, slotId int64)
 	// Model can deactivate a slot.
 	// (PATCH /model/slots/{slotId}/disable)
 	PatchModelSlotsSlotIdDisable(w http.ResponseWriter, r *http.Request, slotId int64)
+	// Model cuts their available slot either into pieces of a length or at the given points
+	// (POST /model/slots/{slotId}/split)
+	PostModelSlotsSlotIdSplit(w http.ResponseWriter, r *http.Request, slotId int64)
+	// Model gets their time-offs that are not over yet
+	// (GET /model/time-offs)
+	GetModelTimeOffs(w http.ResponseWriter, r *http.Request)
+	// Model takes time off, the available slots within it are disabled and no slot can be added there
+	// (POST /model/time-offs)
+	PostModelTimeOffs(w http.ResponseWriter, r *http.Request)
+	// Model deletes the time-off, the slots it has disabled become available again
+	// (DELETE /model/time-offs/{id})
+	DeleteModelTimeOffsId(w http.ResponseWriter, r *http.Request, id int64)
+	// Model gets the time kept free before and after every slot and the travel fee
+	// (GET /model/travel-buffer)
+	GetModelTravelBuffer(w http.ResponseWriter, r *http.Request)
+	// Model sets the time kept free before and after every slot and the travel fee, existing slots and bookings are left as they are
+	// (PUT /model/travel-buffer)
+	PutModelTravelBuffer(w http.ResponseWriter, r *http.Request)
 	// Give client/model more personal info
 	// (POST /users)
 	PostUsers(w http.ResponseWriter, r *http.Request)
@@ -277,6 +668,14 @@ func (siw *ServerInterfaceWrapper) GetAdminBookings(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Optional query parameter "archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "archived", r.URL.Query(), &params.Archived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "archived", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetAdminBookings(w, r, params)
 	}))
@@ -350,8 +749,8 @@ func (siw *ServerInterfaceWrapper) PatchAdminBookingsIdStatus(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminOrders operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminOrders(w http.ResponseWriter, r *http.Request) {
+// GetAdminDisputes operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminDisputes(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -362,7 +761,15 @@ func (siw *ServerInterfaceWrapper) GetAdminOrders(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAdminOrdersParams
+	var params GetAdminDisputesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -381,7 +788,7 @@ func (siw *ServerInterfaceWrapper) GetAdminOrders(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminOrders(w, r, params)
+		siw.Handler.GetAdminDisputes(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -391,8 +798,8 @@ func (siw *ServerInterfaceWrapper) GetAdminOrders(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminOrdersId operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminOrdersId(w http.ResponseWriter, r *http.Request) {
+// GetAdminDisputesId operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminDisputesId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -412,7 +819,7 @@ func (siw *ServerInterfaceWrapper) GetAdminOrdersId(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminOrdersId(w, r, id)
+		siw.Handler.GetAdminDisputesId(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -422,8 +829,8 @@ func (siw *ServerInterfaceWrapper) GetAdminOrdersId(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// PatchAdminOrdersIdStatus operation middleware
-func (siw *ServerInterfaceWrapper) PatchAdminOrdersIdStatus(w http.ResponseWriter, r *http.Request) {
+// PatchAdminDisputesIdAssign operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminDisputesIdAssign(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -443,7 +850,7 @@ func (siw *ServerInterfaceWrapper) PatchAdminOrdersIdStatus(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchAdminOrdersIdStatus(w, r, id)
+		siw.Handler.PatchAdminDisputesIdAssign(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -453,11 +860,20 @@ func (siw *ServerInterfaceWrapper) PatchAdminOrdersIdStatus(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminUsers operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminUsers(w http.ResponseWriter, r *http.Request) {
+// GetAdminDisputesIdMessages operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminDisputesIdMessages(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -465,7 +881,7 @@ func (siw *ServerInterfaceWrapper) GetAdminUsers(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAdminUsersParams
+	var params GetAdminDisputesIdMessagesParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -484,7 +900,7 @@ func (siw *ServerInterfaceWrapper) GetAdminUsers(w http.ResponseWriter, r *http.
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminUsers(w, r, params)
+		siw.Handler.GetAdminDisputesIdMessages(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -494,8 +910,8 @@ func (siw *ServerInterfaceWrapper) GetAdminUsers(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// PatchAdminUsersIdVerify operation middleware
-func (siw *ServerInterfaceWrapper) PatchAdminUsersIdVerify(w http.ResponseWriter, r *http.Request) {
+// PostAdminDisputesIdMessages operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminDisputesIdMessages(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -515,7 +931,7 @@ func (siw *ServerInterfaceWrapper) PatchAdminUsersIdVerify(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchAdminUsersIdVerify(w, r, id)
+		siw.Handler.PostAdminDisputesIdMessages(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -525,8 +941,8 @@ func (siw *ServerInterfaceWrapper) PatchAdminUsersIdVerify(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminId operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminId(w http.ResponseWriter, r *http.Request) {
+// PatchAdminDisputesIdResolve operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminDisputesIdResolve(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -546,7 +962,7 @@ func (siw *ServerInterfaceWrapper) GetAdminId(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminId(w, r, id)
+		siw.Handler.PatchAdminDisputesIdResolve(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -556,19 +972,8 @@ func (siw *ServerInterfaceWrapper) GetAdminId(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// PatchAdminId operation middleware
-func (siw *ServerInterfaceWrapper) PatchAdminId(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id int64
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// GetAdminLedgerTrialBalance operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminLedgerTrialBalance(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -577,7 +982,7 @@ func (siw *ServerInterfaceWrapper) PatchAdminId(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchAdminId(w, r, id)
+		siw.Handler.GetAdminLedgerTrialBalance(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -587,8 +992,10 @@ func (siw *ServerInterfaceWrapper) PatchAdminId(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// PostClientBookings operation middleware
-func (siw *ServerInterfaceWrapper) PostClientBookings(w http.ResponseWriter, r *http.Request) {
+// GetAdminOrders operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminOrders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -596,8 +1003,35 @@ func (siw *ServerInterfaceWrapper) PostClientBookings(w http.ResponseWriter, r *
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminOrdersParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "archived", r.URL.Query(), &params.Archived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "archived", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostClientBookings(w, r)
+		siw.Handler.GetAdminOrders(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -607,8 +1041,8 @@ func (siw *ServerInterfaceWrapper) PostClientBookings(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// PatchClientBookingsIdCancel operation middleware
-func (siw *ServerInterfaceWrapper) PatchClientBookingsIdCancel(w http.ResponseWriter, r *http.Request) {
+// GetAdminOrdersId operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminOrdersId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -628,7 +1062,7 @@ func (siw *ServerInterfaceWrapper) PatchClientBookingsIdCancel(w http.ResponseWr
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchClientBookingsIdCancel(w, r, id)
+		siw.Handler.GetAdminOrdersId(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -638,17 +1072,17 @@ func (siw *ServerInterfaceWrapper) PatchClientBookingsIdCancel(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// GetClientModelsModelIdSlots operation middleware
-func (siw *ServerInterfaceWrapper) GetClientModelsModelIdSlots(w http.ResponseWriter, r *http.Request) {
+// GetAdminOrdersIdPayment operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminOrdersIdPayment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "modelId" -------------
-	var modelId int64
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "modelId", mux.Vars(r)["modelId"], &modelId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "modelId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -659,7 +1093,7 @@ func (siw *ServerInterfaceWrapper) GetClientModelsModelIdSlots(w http.ResponseWr
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetClientModelsModelIdSlots(w, r, modelId)
+		siw.Handler.GetAdminOrdersIdPayment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -669,8 +1103,8 @@ func (siw *ServerInterfaceWrapper) GetClientModelsModelIdSlots(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// PatchClientOrdersIdCancel operation middleware
-func (siw *ServerInterfaceWrapper) PatchClientOrdersIdCancel(w http.ResponseWriter, r *http.Request) {
+// PatchAdminOrdersIdStatus operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminOrdersIdStatus(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -690,7 +1124,7 @@ func (siw *ServerInterfaceWrapper) PatchClientOrdersIdCancel(w http.ResponseWrit
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchClientOrdersIdCancel(w, r, id)
+		siw.Handler.PatchAdminOrdersIdStatus(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -700,8 +1134,8 @@ func (siw *ServerInterfaceWrapper) PatchClientOrdersIdCancel(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetClientServices operation middleware
-func (siw *ServerInterfaceWrapper) GetClientServices(w http.ResponseWriter, r *http.Request) {
+// GetAdminPromoCodes operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminPromoCodes(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -712,7 +1146,7 @@ func (siw *ServerInterfaceWrapper) GetClientServices(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetClientServicesParams
+	var params GetAdminPromoCodesParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -731,7 +1165,7 @@ func (siw *ServerInterfaceWrapper) GetClientServices(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetClientServices(w, r, params)
+		siw.Handler.GetAdminPromoCodes(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -741,19 +1175,8 @@ func (siw *ServerInterfaceWrapper) GetClientServices(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetClientServicesId operation middleware
-func (siw *ServerInterfaceWrapper) GetClientServicesId(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id int64
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// PostAdminPromoCodes operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminPromoCodes(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -762,7 +1185,7 @@ func (siw *ServerInterfaceWrapper) GetClientServicesId(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetClientServicesId(w, r, id)
+		siw.Handler.PostAdminPromoCodes(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -772,8 +1195,8 @@ func (siw *ServerInterfaceWrapper) GetClientServicesId(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PatchModelBookingsIdApprove operation middleware
-func (siw *ServerInterfaceWrapper) PatchModelBookingsIdApprove(w http.ResponseWriter, r *http.Request) {
+// GetAdminPromoCodesId operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminPromoCodesId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -793,7 +1216,7 @@ func (siw *ServerInterfaceWrapper) PatchModelBookingsIdApprove(w http.ResponseWr
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchModelBookingsIdApprove(w, r, id)
+		siw.Handler.GetAdminPromoCodesId(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -803,8 +1226,8 @@ func (siw *ServerInterfaceWrapper) PatchModelBookingsIdApprove(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// PatchModelBookingsIdReject operation middleware
-func (siw *ServerInterfaceWrapper) PatchModelBookingsIdReject(w http.ResponseWriter, r *http.Request) {
+// PatchAdminPromoCodesIdDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminPromoCodesIdDeactivate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -824,7 +1247,7 @@ func (siw *ServerInterfaceWrapper) PatchModelBookingsIdReject(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchModelBookingsIdReject(w, r, id)
+		siw.Handler.PatchAdminPromoCodesIdDeactivate(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -834,8 +1257,8 @@ func (siw *ServerInterfaceWrapper) PatchModelBookingsIdReject(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// GetModelOrders operation middleware
-func (siw *ServerInterfaceWrapper) GetModelOrders(w http.ResponseWriter, r *http.Request) {
+// GetAdminUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminUsers(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -846,7 +1269,7 @@ func (siw *ServerInterfaceWrapper) GetModelOrders(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetModelOrdersParams
+	var params GetAdminUsersParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -865,7 +1288,7 @@ func (siw *ServerInterfaceWrapper) GetModelOrders(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetModelOrders(w, r, params)
+		siw.Handler.GetAdminUsers(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -875,8 +1298,8 @@ func (siw *ServerInterfaceWrapper) GetModelOrders(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// PatchModelOrdersIdCancel operation middleware
-func (siw *ServerInterfaceWrapper) PatchModelOrdersIdCancel(w http.ResponseWriter, r *http.Request) {
+// PatchAdminUsersIdVerify operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminUsersIdVerify(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -896,7 +1319,7 @@ func (siw *ServerInterfaceWrapper) PatchModelOrdersIdCancel(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchModelOrdersIdCancel(w, r, id)
+		siw.Handler.PatchAdminUsersIdVerify(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -906,8 +1329,8 @@ func (siw *ServerInterfaceWrapper) PatchModelOrdersIdCancel(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// PatchModelOrdersIdComplete operation middleware
-func (siw *ServerInterfaceWrapper) PatchModelOrdersIdComplete(w http.ResponseWriter, r *http.Request) {
+// GetAdminId operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -927,7 +1350,7 @@ func (siw *ServerInterfaceWrapper) PatchModelOrdersIdComplete(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchModelOrdersIdComplete(w, r, id)
+		siw.Handler.GetAdminId(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -937,38 +1360,28 @@ func (siw *ServerInterfaceWrapper) PatchModelOrdersIdComplete(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// GetModelServices operation middleware
-func (siw *ServerInterfaceWrapper) GetModelServices(w http.ResponseWriter, r *http.Request) {
+// PatchAdminId operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetModelServicesParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetModelServices(w, r, params)
+		siw.Handler.PatchAdminId(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -978,8 +1391,10 @@ func (siw *ServerInterfaceWrapper) GetModelServices(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// PostModelServices operation middleware
-func (siw *ServerInterfaceWrapper) PostModelServices(w http.ResponseWriter, r *http.Request) {
+// GetClientAvailability operation middleware
+func (siw *ServerInterfaceWrapper) GetClientAvailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -987,9 +1402,82 @@ func (siw *ServerInterfaceWrapper) PostModelServices(w http.ResponseWriter, r *h
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostModelServices(w, r)
-	}))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClientAvailabilityParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "service_query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_query", r.URL.Query(), &params.ServiceQuery)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_price" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_price", r.URL.Query(), &params.MaxPrice)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_price", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClientAvailability(w, r, params)
+	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
@@ -998,20 +1486,29 @@ func (siw *ServerInterfaceWrapper) PostModelServices(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PatchModelServicesId operation middleware
-func (siw *ServerInterfaceWrapper) PatchModelServicesId(w http.ResponseWriter, r *http.Request) {
+// PostClientBookings operation middleware
+func (siw *ServerInterfaceWrapper) PostClientBookings(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostClientBookings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// PostClientBookingsQuote operation middleware
+func (siw *ServerInterfaceWrapper) PostClientBookingsQuote(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1019,7 +1516,7 @@ func (siw *ServerInterfaceWrapper) PatchModelServicesId(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchModelServicesId(w, r, id)
+		siw.Handler.PostClientBookingsQuote(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1029,8 +1526,8 @@ func (siw *ServerInterfaceWrapper) PatchModelServicesId(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PatchModelServicesIdDeactivate operation middleware
-func (siw *ServerInterfaceWrapper) PatchModelServicesIdDeactivate(w http.ResponseWriter, r *http.Request) {
+// PatchClientBookingsIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PatchClientBookingsIdCancel(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1050,7 +1547,7 @@ func (siw *ServerInterfaceWrapper) PatchModelServicesIdDeactivate(w http.Respons
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchModelServicesIdDeactivate(w, r, id)
+		siw.Handler.PatchClientBookingsIdCancel(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1060,8 +1557,8 @@ func (siw *ServerInterfaceWrapper) PatchModelServicesIdDeactivate(w http.Respons
 	handler.ServeHTTP(w, r)
 }
 
-// GetModelSlots operation middleware
-func (siw *ServerInterfaceWrapper) GetModelSlots(w http.ResponseWriter, r *http.Request) {
+// GetClientCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetClientCalendarFeed(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1070,7 +1567,7 @@ func (siw *ServerInterfaceWrapper) GetModelSlots(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetModelSlots(w, r)
+		siw.Handler.GetClientCalendarFeed(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1080,8 +1577,8 @@ func (siw *ServerInterfaceWrapper) GetModelSlots(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// PostModelSlots operation middleware
-func (siw *ServerInterfaceWrapper) PostModelSlots(w http.ResponseWriter, r *http.Request) {
+// PostClientCalendarFeedRegenerate operation middleware
+func (siw *ServerInterfaceWrapper) PostClientCalendarFeedRegenerate(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1090,7 +1587,7 @@ func (siw *ServerInterfaceWrapper) PostModelSlots(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostModelSlots(w, r)
+		siw.Handler.PostClientCalendarFeedRegenerate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1100,17 +1597,17 @@ func (siw *ServerInterfaceWrapper) PostModelSlots(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// PatchModelSlotsSlotId operation middleware
-func (siw *ServerInterfaceWrapper) PatchModelSlotsSlotId(w http.ResponseWriter, r *http.Request) {
+// GetClientDisputesId operation middleware
+func (siw *ServerInterfaceWrapper) GetClientDisputesId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "slotId" -------------
-	var slotId int64
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "slotId", mux.Vars(r)["slotId"], &slotId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slotId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -1121,7 +1618,7 @@ func (siw *ServerInterfaceWrapper) PatchModelSlotsSlotId(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchModelSlotsSlotId(w, r, slotId)
+		siw.Handler.GetClientDisputesId(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1131,17 +1628,17 @@ func (siw *ServerInterfaceWrapper) PatchModelSlotsSlotId(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PatchModelSlotsSlotIdDisable operation middleware
-func (siw *ServerInterfaceWrapper) PatchModelSlotsSlotIdDisable(w http.ResponseWriter, r *http.Request) {
+// GetClientDisputesIdMessages operation middleware
+func (siw *ServerInterfaceWrapper) GetClientDisputesIdMessages(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "slotId" -------------
-	var slotId int64
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "slotId", mux.Vars(r)["slotId"], &slotId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slotId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -1151,8 +1648,27 @@ func (siw *ServerInterfaceWrapper) PatchModelSlotsSlotIdDisable(w http.ResponseW
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClientDisputesIdMessagesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchModelSlotsSlotIdDisable(w, r, slotId)
+		siw.Handler.GetClientDisputesIdMessages(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1162,8 +1678,19 @@ func (siw *ServerInterfaceWrapper) PatchModelSlotsSlotIdDisable(w http.ResponseW
 	handler.ServeHTTP(w, r)
 }
 
-// PostUsers operation middleware
-func (siw *ServerInterfaceWrapper) PostUsers(w http.ResponseWriter, r *http.Request) {
+// PostClientDisputesIdMessages operation middleware
+func (siw *ServerInterfaceWrapper) PostClientDisputesIdMessages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1172,7 +1699,7 @@ func (siw *ServerInterfaceWrapper) PostUsers(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsers(w, r)
+		siw.Handler.PostClientDisputesIdMessages(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1182,8 +1709,19 @@ func (siw *ServerInterfaceWrapper) PostUsers(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersMe operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersMe(w http.ResponseWriter, r *http.Request) {
+// GetClientModelsModelIdSlots operation middleware
+func (siw *ServerInterfaceWrapper) GetClientModelsModelIdSlots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "modelId" -------------
+	var modelId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "modelId", mux.Vars(r)["modelId"], &modelId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "modelId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1191,8 +1729,51 @@ func (siw *ServerInterfaceWrapper) GetUsersMe(w http.ResponseWriter, r *http.Req
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClientModelsModelIdSlotsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersMe(w, r)
+		siw.Handler.GetClientModelsModelIdSlots(w, r, modelId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1202,8 +1783,19 @@ func (siw *ServerInterfaceWrapper) GetUsersMe(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// PatchUsersMe operation middleware
-func (siw *ServerInterfaceWrapper) PatchUsersMe(w http.ResponseWriter, r *http.Request) {
+// PatchClientOrdersIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PatchClientOrdersIdCancel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1212,7 +1804,7 @@ func (siw *ServerInterfaceWrapper) PatchUsersMe(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchUsersMe(w, r)
+		siw.Handler.PatchClientOrdersIdCancel(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1222,8 +1814,8 @@ func (siw *ServerInterfaceWrapper) PatchUsersMe(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersId operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersId(w http.ResponseWriter, r *http.Request) {
+// PatchClientOrdersIdConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchClientOrdersIdConfirm(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1243,7 +1835,7 @@ func (siw *ServerInterfaceWrapper) GetUsersId(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersId(w, r, id)
+		siw.Handler.PatchClientOrdersIdConfirm(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1253,1537 +1845,6953 @@ func (siw *ServerInterfaceWrapper) GetUsersId(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// PostClientOrdersIdDisputes operation middleware
+func (siw *ServerInterfaceWrapper) PostClientOrdersIdDisputes(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	ctx := r.Context()
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RequiredParamError struct {
-	ParamName string
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostClientOrdersIdDisputes(w, r, id)
+	}))
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
-
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r)
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+// GetClientOrdersIdEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetClientOrdersIdEvents(w http.ResponseWriter, r *http.Request) {
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	var err error
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	ctx := r.Context()
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{})
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GorillaServerOptions struct {
-	BaseURL          string
-	BaseRouter       *mux.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	r = r.WithContext(ctx)
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseRouter: r,
-	})
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClientOrdersIdEventsParams
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	headers := r.Header
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
-	r := options.BaseRouter
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
 
-	if r == nil {
-		r = mux.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
 		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
 
-	r.HandleFunc(options.BaseURL+"/admin", wrapper.PostAdmin).Methods("POST")
+		params.LastEventID = &LastEventID
 
-	r.HandleFunc(options.BaseURL+"/admin/bookings", wrapper.GetAdminBookings).Methods("GET")
+	}
 
-	r.HandleFunc(options.BaseURL+"/admin/bookings/{id}", wrapper.GetAdminBookingsId).Methods("GET")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClientOrdersIdEvents(w, r, id, params)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/admin/bookings/{id}/status", wrapper.PatchAdminBookingsIdStatus).Methods("PATCH")
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/admin/orders", wrapper.GetAdminOrders).Methods("GET")
+	handler.ServeHTTP(w, r)
+}
 
-	r.HandleFunc(options.BaseURL+"/admin/orders/{id}", wrapper.GetAdminOrdersId).Methods("GET")
+// GetClientOrdersIdExtensions operation middleware
+func (siw *ServerInterfaceWrapper) GetClientOrdersIdExtensions(w http.ResponseWriter, r *http.Request) {
 
-	r.HandleFunc(options.BaseURL+"/admin/orders/{id}/status", wrapper.PatchAdminOrdersIdStatus).Methods("PATCH")
+	var err error
 
-	r.HandleFunc(options.BaseURL+"/admin/users", wrapper.GetAdminUsers).Methods("GET")
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	r.HandleFunc(options.BaseURL+"/admin/users/{id}/verify", wrapper.PatchAdminUsersIdVerify).Methods("PATCH")
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/admin/{id}", wrapper.GetAdminId).Methods("GET")
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/admin/{id}", wrapper.PatchAdminId).Methods("PATCH")
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/client/bookings", wrapper.PostClientBookings).Methods("POST")
+	r = r.WithContext(ctx)
 
-	r.HandleFunc(options.BaseURL+"/client/bookings/{id}/cancel", wrapper.PatchClientBookingsIdCancel).Methods("PATCH")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClientOrdersIdExtensions(w, r, id)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/client/models/{modelId}/slots", wrapper.GetClientModelsModelIdSlots).Methods("GET")
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/client/orders/{id}/cancel", wrapper.PatchClientOrdersIdCancel).Methods("PATCH")
+	handler.ServeHTTP(w, r)
+}
 
-	r.HandleFunc(options.BaseURL+"/client/services", wrapper.GetClientServices).Methods("GET")
+// PostClientOrdersIdExtensions operation middleware
+func (siw *ServerInterfaceWrapper) PostClientOrdersIdExtensions(w http.ResponseWriter, r *http.Request) {
 
-	r.HandleFunc(options.BaseURL+"/client/services/{id}", wrapper.GetClientServicesId).Methods("GET")
+	var err error
 
-	r.HandleFunc(options.BaseURL+"/model/bookings/{id}/approve", wrapper.PatchModelBookingsIdApprove).Methods("PATCH")
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	r.HandleFunc(options.BaseURL+"/model/bookings/{id}/reject", wrapper.PatchModelBookingsIdReject).Methods("PATCH")
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/model/orders", wrapper.GetModelOrders).Methods("GET")
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/model/orders/{id}/cancel", wrapper.PatchModelOrdersIdCancel).Methods("PATCH")
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/model/orders/{id}/complete", wrapper.PatchModelOrdersIdComplete).Methods("PATCH")
+	r = r.WithContext(ctx)
 
-	r.HandleFunc(options.BaseURL+"/model/services", wrapper.GetModelServices).Methods("GET")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostClientOrdersIdExtensions(w, r, id)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/model/services", wrapper.PostModelServices).Methods("POST")
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/model/services/{id}", wrapper.PatchModelServicesId).Methods("PATCH")
+	handler.ServeHTTP(w, r)
+}
 
-	r.HandleFunc(options.BaseURL+"/model/services/{id}/deactivate", wrapper.PatchModelServicesIdDeactivate).Methods("PATCH")
+// PatchClientOrdersIdIssue operation middleware
+func (siw *ServerInterfaceWrapper) PatchClientOrdersIdIssue(w http.ResponseWriter, r *http.Request) {
 
-	r.HandleFunc(options.BaseURL+"/model/slots", wrapper.GetModelSlots).Methods("GET")
+	var err error
 
-	r.HandleFunc(options.BaseURL+"/model/slots", wrapper.PostModelSlots).Methods("POST")
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	r.HandleFunc(options.BaseURL+"/model/slots/{slotId}", wrapper.PatchModelSlotsSlotId).Methods("PATCH")
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/model/slots/{slotId}/disable", wrapper.PatchModelSlotsSlotIdDisable).Methods("PATCH")
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/users", wrapper.PostUsers).Methods("POST")
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/users/me", wrapper.GetUsersMe).Methods("GET")
+	r = r.WithContext(ctx)
 
-	r.HandleFunc(options.BaseURL+"/users/me", wrapper.PatchUsersMe).Methods("PATCH")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchClientOrdersIdIssue(w, r, id)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/users/{id}", wrapper.GetUsersId).Methods("GET")
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return r
+	handler.ServeHTTP(w, r)
 }
 
-type PostAdminRequestObject struct {
-	Body *PostAdminJSONRequestBody
-}
+// GetClientOrdersIdReceipt operation middleware
+func (siw *ServerInterfaceWrapper) GetClientOrdersIdReceipt(w http.ResponseWriter, r *http.Request) {
 
-type PostAdminResponseObject interface {
-	VisitPostAdminResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type PostAdmin201JSONResponse externalRef0.Admin
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-func (response PostAdmin201JSONResponse) VisitPostAdminResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PostAdmin403JSONResponse externalRef0.ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PostAdmin403JSONResponse) VisitPostAdminResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClientOrdersIdReceiptParams
 
-type GetAdminBookingsRequestObject struct {
-	Params GetAdminBookingsParams
-}
+	// ------------- Optional query parameter "format" -------------
 
-type GetAdminBookingsResponseObject interface {
-	VisitGetAdminBookingsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
 
-type GetAdminBookings200JSONResponse []externalRef0.BookingResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClientOrdersIdReceipt(w, r, id, params)
+	}))
 
-func (response GetAdminBookings200JSONResponse) VisitGetAdminBookingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetAdminBookings403JSONResponse externalRef0.ErrorResponse
+// GetClientServices operation middleware
+func (siw *ServerInterfaceWrapper) GetClientServices(w http.ResponseWriter, r *http.Request) {
 
-func (response GetAdminBookings403JSONResponse) VisitGetAdminBookingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetAdminBookingsIdRequestObject struct {
-	Id int64 `json:"id"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetAdminBookingsIdResponseObject interface {
-	VisitGetAdminBookingsIdResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetAdminBookingsId200JSONResponse externalRef0.BookingResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClientServicesParams
 
-func (response GetAdminBookingsId200JSONResponse) VisitGetAdminBookingsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "page" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-type GetAdminBookingsId403JSONResponse externalRef0.ErrorResponse
-
-func (response GetAdminBookingsId403JSONResponse) VisitGetAdminBookingsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type GetAdminBookingsId404JSONResponse externalRef0.ErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response GetAdminBookingsId404JSONResponse) VisitGetAdminBookingsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClientServices(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchAdminBookingsIdStatusRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *PatchAdminBookingsIdStatusJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PatchAdminBookingsIdStatusResponseObject interface {
-	VisitPatchAdminBookingsIdStatusResponse(w http.ResponseWriter) error
-}
+// GetClientServicesId operation middleware
+func (siw *ServerInterfaceWrapper) GetClientServicesId(w http.ResponseWriter, r *http.Request) {
 
-type PatchAdminBookingsIdStatus200JSONResponse externalRef0.BookingResponse
+	var err error
 
-func (response PatchAdminBookingsIdStatus200JSONResponse) VisitPatchAdminBookingsIdStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type PatchAdminBookingsIdStatus403JSONResponse externalRef0.ErrorResponse
+	ctx := r.Context()
 
-func (response PatchAdminBookingsIdStatus403JSONResponse) VisitPatchAdminBookingsIdStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PatchAdminBookingsIdStatus404JSONResponse externalRef0.ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClientServicesId(w, r, id)
+	}))
 
-func (response PatchAdminBookingsIdStatus404JSONResponse) VisitPatchAdminBookingsIdStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetAdminOrdersRequestObject struct {
-	Params GetAdminOrdersParams
-}
+// GetClientServicesIdAddOns operation middleware
+func (siw *ServerInterfaceWrapper) GetClientServicesIdAddOns(w http.ResponseWriter, r *http.Request) {
 
-type GetAdminOrdersResponseObject interface {
-	VisitGetAdminOrdersResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetAdminOrders200JSONResponse []externalRef0.OrderResponse
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-func (response GetAdminOrders200JSONResponse) VisitGetAdminOrdersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetAdminOrders403JSONResponse externalRef0.ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetAdminOrders403JSONResponse) VisitGetAdminOrdersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClientServicesIdAddOns(w, r, id)
+	}))
 
-type GetAdminOrdersIdRequestObject struct {
-	Id int64 `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetAdminOrdersIdResponseObject interface {
-	VisitGetAdminOrdersIdResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetAdminOrdersId200JSONResponse externalRef0.OrderResponse
+// PatchModelAddOnsId operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelAddOnsId(w http.ResponseWriter, r *http.Request) {
 
-func (response GetAdminOrdersId200JSONResponse) VisitGetAdminOrdersIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type GetAdminOrdersId403JSONResponse externalRef0.ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetAdminOrdersId403JSONResponse) VisitGetAdminOrdersIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetAdminOrdersId404JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response GetAdminOrdersId404JSONResponse) VisitGetAdminOrdersIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelAddOnsId(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchAdminOrdersIdStatusRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *PatchAdminOrdersIdStatusJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PatchAdminOrdersIdStatusResponseObject interface {
-	VisitPatchAdminOrdersIdStatusResponse(w http.ResponseWriter) error
-}
+// PatchModelAddOnsIdDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelAddOnsIdDeactivate(w http.ResponseWriter, r *http.Request) {
 
-type PatchAdminOrdersIdStatus200JSONResponse externalRef0.OrderResponse
+	var err error
 
-func (response PatchAdminOrdersIdStatus200JSONResponse) VisitPatchAdminOrdersIdStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type PatchAdminOrdersIdStatus403JSONResponse externalRef0.ErrorResponse
+	ctx := r.Context()
 
-func (response PatchAdminOrdersIdStatus403JSONResponse) VisitPatchAdminOrdersIdStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PatchAdminOrdersIdStatus404JSONResponse externalRef0.ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelAddOnsIdDeactivate(w, r, id)
+	}))
 
-func (response PatchAdminOrdersIdStatus404JSONResponse) VisitPatchAdminOrdersIdStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetAdminUsersRequestObject struct {
-	Params GetAdminUsersParams
-}
+// GetModelAvailabilityTemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetModelAvailabilityTemplates(w http.ResponseWriter, r *http.Request) {
 
-type GetAdminUsersResponseObject interface {
-	VisitGetAdminUsersResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetAdminUsers200JSONResponse []externalRef0.UserResponse
+	ctx := r.Context()
 
-func (response GetAdminUsers200JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetAdminUsers403JSONResponse externalRef0.ErrorResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetModelAvailabilityTemplatesParams
 
-func (response GetAdminUsers403JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Optional query parameter "page" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-type PatchAdminUsersIdVerifyRequestObject struct {
-	Id int64 `json:"id"`
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type PatchAdminUsersIdVerifyResponseObject interface {
-	VisitPatchAdminUsersIdVerifyResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type PatchAdminUsersIdVerify200JSONResponse externalRef0.UserResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelAvailabilityTemplates(w, r, params)
+	}))
 
-func (response PatchAdminUsersIdVerify200JSONResponse) VisitPatchAdminUsersIdVerifyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PatchAdminUsersIdVerify403JSONResponse externalRef0.ErrorResponse
+// PostModelAvailabilityTemplates operation middleware
+func (siw *ServerInterfaceWrapper) PostModelAvailabilityTemplates(w http.ResponseWriter, r *http.Request) {
 
-func (response PatchAdminUsersIdVerify403JSONResponse) VisitPatchAdminUsersIdVerifyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PatchAdminUsersIdVerify404JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response PatchAdminUsersIdVerify404JSONResponse) VisitPatchAdminUsersIdVerifyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelAvailabilityTemplates(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetAdminIdRequestObject struct {
-	Id int64 `json:"id"`
-}
-
-type GetAdminIdResponseObject interface {
-	VisitGetAdminIdResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetAdminId200JSONResponse externalRef0.Admin
-
-func (response GetAdminId200JSONResponse) VisitGetAdminIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetModelAvailabilityTemplatesId operation middleware
+func (siw *ServerInterfaceWrapper) GetModelAvailabilityTemplatesId(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetAdminId403JSONResponse externalRef0.ErrorResponse
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-func (response GetAdminId403JSONResponse) VisitGetAdminIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetAdminId404JSONResponse externalRef0.ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetAdminId404JSONResponse) VisitGetAdminIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelAvailabilityTemplatesId(w, r, id)
+	}))
 
-type PatchAdminIdRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *PatchAdminIdJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchAdminIdResponseObject interface {
-	VisitPatchAdminIdResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PatchAdminId200JSONResponse externalRef0.Admin
-
-func (response PatchAdminId200JSONResponse) VisitPatchAdminIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PutModelAvailabilityTemplatesId operation middleware
+func (siw *ServerInterfaceWrapper) PutModelAvailabilityTemplatesId(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PatchAdminId403JSONResponse externalRef0.ErrorResponse
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-func (response PatchAdminId403JSONResponse) VisitPatchAdminIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PatchAdminId404JSONResponse externalRef0.ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PatchAdminId404JSONResponse) VisitPatchAdminIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutModelAvailabilityTemplatesId(w, r, id)
+	}))
 
-type PostClientBookingsRequestObject struct {
-	Body *PostClientBookingsJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostClientBookingsResponseObject interface {
-	VisitPostClientBookingsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PostClientBookings201JSONResponse externalRef0.BookingResponse
-
-func (response PostClientBookings201JSONResponse) VisitPostClientBookingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// GetModelAvailabilityTemplatesIdPreview operation middleware
+func (siw *ServerInterfaceWrapper) GetModelAvailabilityTemplatesIdPreview(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PostClientBookings409JSONResponse externalRef0.ErrorResponse
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-func (response PostClientBookings409JSONResponse) VisitPostClientBookingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PostClientBookings422JSONResponse externalRef0.ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PostClientBookings422JSONResponse) VisitPostClientBookingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelAvailabilityTemplatesIdPreview(w, r, id)
+	}))
 
-type PatchClientBookingsIdCancelRequestObject struct {
-	Id int64 `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchClientBookingsIdCancelResponseObject interface {
-	VisitPatchClientBookingsIdCancelResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PatchClientBookingsIdCancel200JSONResponse externalRef0.BookingResponse
-
-func (response PatchClientBookingsIdCancel200JSONResponse) VisitPatchClientBookingsIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostModelAvailabilityTemplatesIdPublish operation middleware
+func (siw *ServerInterfaceWrapper) PostModelAvailabilityTemplatesIdPublish(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PatchClientBookingsIdCancel403JSONResponse externalRef0.ErrorResponse
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-func (response PatchClientBookingsIdCancel403JSONResponse) VisitPatchClientBookingsIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PatchClientBookingsIdCancel409JSONResponse externalRef0.ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PatchClientBookingsIdCancel409JSONResponse) VisitPatchClientBookingsIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelAvailabilityTemplatesIdPublish(w, r, id)
+	}))
 
-type GetClientModelsModelIdSlotsRequestObject struct {
-	ModelId int64 `json:"modelId"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetClientModelsModelIdSlotsResponseObject interface {
-	VisitGetClientModelsModelIdSlotsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetClientModelsModelIdSlots200JSONResponse []externalRef0.SlotResponse
+// PostModelAvailabilityTemplatesIdUnpublish operation middleware
+func (siw *ServerInterfaceWrapper) PostModelAvailabilityTemplatesIdUnpublish(w http.ResponseWriter, r *http.Request) {
 
-func (response GetClientModelsModelIdSlots200JSONResponse) VisitGetClientModelsModelIdSlotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type GetClientModelsModelIdSlots403JSONResponse externalRef0.ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetClientModelsModelIdSlots403JSONResponse) VisitGetClientModelsModelIdSlotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetClientModelsModelIdSlots404JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response GetClientModelsModelIdSlots404JSONResponse) VisitGetClientModelsModelIdSlotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelAvailabilityTemplatesIdUnpublish(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchClientOrdersIdCancelRequestObject struct {
-	Id int64 `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type PatchClientOrdersIdCancelResponseObject interface {
-	VisitPatchClientOrdersIdCancelResponse(w http.ResponseWriter) error
-}
+// GetModelBookingRules operation middleware
+func (siw *ServerInterfaceWrapper) GetModelBookingRules(w http.ResponseWriter, r *http.Request) {
 
-type PatchClientOrdersIdCancel200JSONResponse externalRef0.OrderResponse
+	ctx := r.Context()
 
-func (response PatchClientOrdersIdCancel200JSONResponse) VisitPatchClientOrdersIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PatchClientOrdersIdCancel401JSONResponse externalRef0.ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelBookingRules(w, r)
+	}))
 
-func (response PatchClientOrdersIdCancel401JSONResponse) VisitPatchClientOrdersIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PatchClientOrdersIdCancel403JSONResponse externalRef0.ErrorResponse
+// PutModelBookingRules operation middleware
+func (siw *ServerInterfaceWrapper) PutModelBookingRules(w http.ResponseWriter, r *http.Request) {
 
-func (response PatchClientOrdersIdCancel403JSONResponse) VisitPatchClientOrdersIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PatchClientOrdersIdCancel404JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response PatchClientOrdersIdCancel404JSONResponse) VisitPatchClientOrdersIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutModelBookingRules(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PatchClientOrdersIdCancel409JSONResponse externalRef0.ErrorResponse
+// PatchModelBookingsIdApprove operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelBookingsIdApprove(w http.ResponseWriter, r *http.Request) {
 
-func (response PatchClientOrdersIdCancel409JSONResponse) VisitPatchClientOrdersIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetClientServicesRequestObject struct {
-	Params GetClientServicesParams
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type GetClientServicesResponseObject interface {
-	VisitGetClientServicesResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetClientServices200JSONResponse []externalRef0.ModelServiceResponse
+	ctx := r.Context()
 
-func (response GetClientServices200JSONResponse) VisitGetClientServicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetClientServices400JSONResponse externalRef0.ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelBookingsIdApprove(w, r, id)
+	}))
 
-func (response GetClientServices400JSONResponse) VisitGetClientServicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetClientServices500Response struct {
-}
+// PatchModelBookingsIdReject operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelBookingsIdReject(w http.ResponseWriter, r *http.Request) {
 
-func (response GetClientServices500Response) VisitGetClientServicesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	var err error
 
-type GetClientServicesIdRequestObject struct {
-	Id int64 `json:"id"`
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type GetClientServicesIdResponseObject interface {
-	VisitGetClientServicesIdResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetClientServicesId200JSONResponse externalRef0.ModelServiceResponse
+	ctx := r.Context()
 
-func (response GetClientServicesId200JSONResponse) VisitGetClientServicesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetClientServicesId400JSONResponse externalRef0.ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelBookingsIdReject(w, r, id)
+	}))
 
-func (response GetClientServicesId400JSONResponse) VisitGetClientServicesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetClientServicesId404JSONResponse externalRef0.ErrorResponse
+// GetModelBusyCalendars operation middleware
+func (siw *ServerInterfaceWrapper) GetModelBusyCalendars(w http.ResponseWriter, r *http.Request) {
 
-func (response GetClientServicesId404JSONResponse) VisitGetClientServicesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetClientServicesId500Response struct {
-}
+	r = r.WithContext(ctx)
 
-func (response GetClientServicesId500Response) VisitGetClientServicesIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelBusyCalendars(w, r)
+	}))
 
-type PatchModelBookingsIdApproveRequestObject struct {
-	Id int64 `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchModelBookingsIdApproveResponseObject interface {
-	VisitPatchModelBookingsIdApproveResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PatchModelBookingsIdApprove200JSONResponse externalRef0.BookingResponse
+// PostModelBusyCalendars operation middleware
+func (siw *ServerInterfaceWrapper) PostModelBusyCalendars(w http.ResponseWriter, r *http.Request) {
 
-func (response PatchModelBookingsIdApprove200JSONResponse) VisitPatchModelBookingsIdApproveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PatchModelBookingsIdApprove403JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response PatchModelBookingsIdApprove403JSONResponse) VisitPatchModelBookingsIdApproveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelBusyCalendars(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchModelBookingsIdApprove409JSONResponse externalRef0.ErrorResponse
+	handler.ServeHTTP(w, r)
+}
 
-func (response PatchModelBookingsIdApprove409JSONResponse) VisitPatchModelBookingsIdApproveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// DeleteModelBusyCalendarsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteModelBusyCalendarsId(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PatchModelBookingsIdRejectRequestObject struct {
-	Id int64 `json:"id"`
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type PatchModelBookingsIdRejectResponseObject interface {
-	VisitPatchModelBookingsIdRejectResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type PatchModelBookingsIdReject200JSONResponse externalRef0.BookingResponse
+	ctx := r.Context()
 
-func (response PatchModelBookingsIdReject200JSONResponse) VisitPatchModelBookingsIdRejectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PatchModelBookingsIdReject403JSONResponse externalRef0.ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteModelBusyCalendarsId(w, r, id)
+	}))
 
-func (response PatchModelBookingsIdReject403JSONResponse) VisitPatchModelBookingsIdRejectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PatchModelBookingsIdReject409JSONResponse externalRef0.ErrorResponse
-
-func (response PatchModelBookingsIdReject409JSONResponse) VisitPatchModelBookingsIdRejectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// PutModelBusyCalendarsIdEvents operation middleware
+func (siw *ServerInterfaceWrapper) PutModelBusyCalendarsIdEvents(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetModelOrdersRequestObject struct {
-	Params GetModelOrdersParams
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type GetModelOrdersResponseObject interface {
-	VisitGetModelOrdersResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetModelOrders200JSONResponse []externalRef0.OrderResponse
+	ctx := r.Context()
 
-func (response GetModelOrders200JSONResponse) VisitGetModelOrdersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetModelOrders401JSONResponse externalRef0.ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutModelBusyCalendarsIdEvents(w, r, id)
+	}))
 
-func (response GetModelOrders401JSONResponse) VisitGetModelOrdersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetModelOrders403JSONResponse externalRef0.ErrorResponse
-
-func (response GetModelOrders403JSONResponse) VisitGetModelOrdersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// PostModelBusyCalendarsIdSync operation middleware
+func (siw *ServerInterfaceWrapper) PostModelBusyCalendarsIdSync(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PatchModelOrdersIdCancelRequestObject struct {
-	Id int64 `json:"id"`
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type PatchModelOrdersIdCancelResponseObject interface {
-	VisitPatchModelOrdersIdCancelResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type PatchModelOrdersIdCancel200JSONResponse externalRef0.OrderResponse
+	ctx := r.Context()
 
-func (response PatchModelOrdersIdCancel200JSONResponse) VisitPatchModelOrdersIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PatchModelOrdersIdCancel401JSONResponse externalRef0.ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelBusyCalendarsIdSync(w, r, id)
+	}))
 
-func (response PatchModelOrdersIdCancel401JSONResponse) VisitPatchModelOrdersIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PatchModelOrdersIdCancel403JSONResponse externalRef0.ErrorResponse
+// GetModelCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetModelCalendarFeed(w http.ResponseWriter, r *http.Request) {
 
-func (response PatchModelOrdersIdCancel403JSONResponse) VisitPatchModelOrdersIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PatchModelOrdersIdCancel404JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response PatchModelOrdersIdCancel404JSONResponse) VisitPatchModelOrdersIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelCalendarFeed(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchModelOrdersIdCancel409JSONResponse externalRef0.ErrorResponse
+	handler.ServeHTTP(w, r)
+}
 
-func (response PatchModelOrdersIdCancel409JSONResponse) VisitPatchModelOrdersIdCancelResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// PostModelCalendarFeedRegenerate operation middleware
+func (siw *ServerInterfaceWrapper) PostModelCalendarFeedRegenerate(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PatchModelOrdersIdCompleteRequestObject struct {
-	Id int64 `json:"id"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PatchModelOrdersIdCompleteResponseObject interface {
-	VisitPatchModelOrdersIdCompleteResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type PatchModelOrdersIdComplete200JSONResponse externalRef0.OrderResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelCalendarFeedRegenerate(w, r)
+	}))
 
-func (response PatchModelOrdersIdComplete200JSONResponse) VisitPatchModelOrdersIdCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PatchModelOrdersIdComplete400JSONResponse externalRef0.ErrorResponse
+// GetModelDisputesId operation middleware
+func (siw *ServerInterfaceWrapper) GetModelDisputesId(w http.ResponseWriter, r *http.Request) {
 
-func (response PatchModelOrdersIdComplete400JSONResponse) VisitPatchModelOrdersIdCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type PatchModelOrdersIdComplete401JSONResponse externalRef0.ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response PatchModelOrdersIdComplete401JSONResponse) VisitPatchModelOrdersIdCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PatchModelOrdersIdComplete403JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response PatchModelOrdersIdComplete403JSONResponse) VisitPatchModelOrdersIdCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelDisputesId(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PatchModelOrdersIdComplete404JSONResponse externalRef0.ErrorResponse
+// GetModelDisputesIdMessages operation middleware
+func (siw *ServerInterfaceWrapper) GetModelDisputesIdMessages(w http.ResponseWriter, r *http.Request) {
 
-func (response PatchModelOrdersIdComplete404JSONResponse) VisitPatchModelOrdersIdCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type GetModelServicesRequestObject struct {
-	Params GetModelServicesParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetModelServicesResponseObject interface {
-	VisitGetModelServicesResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type GetModelServices200JSONResponse []externalRef0.ModelServiceResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetModelServices200JSONResponse) VisitGetModelServicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetModelDisputesIdMessagesParams
 
-type GetModelServices400JSONResponse externalRef0.ErrorResponse
+	// ------------- Optional query parameter "page" -------------
 
-func (response GetModelServices400JSONResponse) VisitGetModelServicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type GetModelServices401JSONResponse externalRef0.ErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response GetModelServices401JSONResponse) VisitGetModelServicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelDisputesIdMessages(w, r, id, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetModelServices500Response struct {
+	handler.ServeHTTP(w, r)
 }
 
-func (response GetModelServices500Response) VisitGetModelServicesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+// PostModelDisputesIdMessages operation middleware
+func (siw *ServerInterfaceWrapper) PostModelDisputesIdMessages(w http.ResponseWriter, r *http.Request) {
 
-type PostModelServicesRequestObject struct {
-	Body *PostModelServicesJSONRequestBody
-}
+	var err error
 
-type PostModelServicesResponseObject interface {
-	VisitPostModelServicesResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type PostModelServices201JSONResponse externalRef0.ModelServiceResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response PostModelServices201JSONResponse) VisitPostModelServicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostModelServices400JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response PostModelServices400JSONResponse) VisitPostModelServicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelDisputesIdMessages(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostModelServices401JSONResponse externalRef0.ErrorResponse
+// GetModelEarnings operation middleware
+func (siw *ServerInterfaceWrapper) GetModelEarnings(w http.ResponseWriter, r *http.Request) {
 
-func (response PostModelServices401JSONResponse) VisitPostModelServicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PostModelServices422JSONResponse externalRef0.ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PostModelServices422JSONResponse) VisitPostModelServicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetModelEarningsParams
 
-type PostModelServices500Response struct {
-}
+	// ------------- Optional query parameter "page" -------------
 
-func (response PostModelServices500Response) VisitPostModelServicesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-type PatchModelServicesIdRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *PatchModelServicesIdJSONRequestBody
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type PatchModelServicesIdResponseObject interface {
-	VisitPatchModelServicesIdResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type PatchModelServicesId200JSONResponse externalRef0.ModelServiceResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelEarnings(w, r, params)
+	}))
 
-func (response PatchModelServicesId200JSONResponse) VisitPatchModelServicesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PatchModelServicesId400JSONResponse externalRef0.ErrorResponse
+// GetModelOrders operation middleware
+func (siw *ServerInterfaceWrapper) GetModelOrders(w http.ResponseWriter, r *http.Request) {
 
-func (response PatchModelServicesId400JSONResponse) VisitPatchModelServicesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PatchModelServicesId401JSONResponse externalRef0.ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PatchModelServicesId401JSONResponse) VisitPatchModelServicesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetModelOrdersParams
 
-type PatchModelServicesId403JSONResponse externalRef0.ErrorResponse
+	// ------------- Optional query parameter "page" -------------
 
-func (response PatchModelServicesId403JSONResponse) VisitPatchModelServicesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type PatchModelServicesId409JSONResponse externalRef0.ErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response PatchModelServicesId409JSONResponse) VisitPatchModelServicesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelOrders(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchModelServicesId500Response struct {
+	handler.ServeHTTP(w, r)
 }
 
-func (response PatchModelServicesId500Response) VisitPatchModelServicesIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+// PatchModelOrdersIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelOrdersIdCancel(w http.ResponseWriter, r *http.Request) {
 
-type PatchModelServicesIdDeactivateRequestObject struct {
-	Id int64 `json:"id"`
-}
+	var err error
 
-type PatchModelServicesIdDeactivateResponseObject interface {
-	VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type PatchModelServicesIdDeactivate200JSONResponse externalRef0.StatusResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response PatchModelServicesIdDeactivate200JSONResponse) VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PatchModelServicesIdDeactivate400JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response PatchModelServicesIdDeactivate400JSONResponse) VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelOrdersIdCancel(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PatchModelServicesIdDeactivate401JSONResponse externalRef0.ErrorResponse
+// PatchModelOrdersIdComplete operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelOrdersIdComplete(w http.ResponseWriter, r *http.Request) {
 
-func (response PatchModelServicesIdDeactivate401JSONResponse) VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type PatchModelServicesIdDeactivate403JSONResponse externalRef0.ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response PatchModelServicesIdDeactivate403JSONResponse) VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PatchModelServicesIdDeactivate409JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response PatchModelServicesIdDeactivate409JSONResponse) VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelOrdersIdComplete(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchModelServicesIdDeactivate500Response struct {
+	handler.ServeHTTP(w, r)
 }
 
-func (response PatchModelServicesIdDeactivate500Response) VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+// PostModelOrdersIdDisputes operation middleware
+func (siw *ServerInterfaceWrapper) PostModelOrdersIdDisputes(w http.ResponseWriter, r *http.Request) {
 
-type GetModelSlotsRequestObject struct {
-}
+	var err error
 
-type GetModelSlotsResponseObject interface {
-	VisitGetModelSlotsResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type GetModelSlots200JSONResponse []externalRef0.SlotResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetModelSlots200JSONResponse) VisitGetModelSlotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetModelSlots403JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response GetModelSlots403JSONResponse) VisitGetModelSlotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelOrdersIdDisputes(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostModelSlotsRequestObject struct {
-	Body *PostModelSlotsJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PostModelSlotsResponseObject interface {
-	VisitPostModelSlotsResponse(w http.ResponseWriter) error
-}
+// GetModelOrdersIdExtensions operation middleware
+func (siw *ServerInterfaceWrapper) GetModelOrdersIdExtensions(w http.ResponseWriter, r *http.Request) {
 
-type PostModelSlots201JSONResponse externalRef0.SlotResponse
+	var err error
 
-func (response PostModelSlots201JSONResponse) VisitPostModelSlotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type PostModelSlots403JSONResponse externalRef0.ErrorResponse
+	ctx := r.Context()
 
-func (response PostModelSlots403JSONResponse) VisitPostModelSlotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PostModelSlots409JSONResponse externalRef0.ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelOrdersIdExtensions(w, r, id)
+	}))
 
-func (response PostModelSlots409JSONResponse) VisitPostModelSlotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PatchModelSlotsSlotIdRequestObject struct {
-	SlotId int64 `json:"slotId"`
-	Body   *PatchModelSlotsSlotIdJSONRequestBody
-}
+// PatchModelOrdersIdExtensionsExtensionIdAccept operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelOrdersIdExtensionsExtensionIdAccept(w http.ResponseWriter, r *http.Request) {
 
-type PatchModelSlotsSlotIdResponseObject interface {
-	VisitPatchModelSlotsSlotIdResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type PatchModelSlotsSlotId200JSONResponse externalRef0.SlotResponse
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-func (response PatchModelSlotsSlotId200JSONResponse) VisitPatchModelSlotsSlotIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "extensionId" -------------
+	var extensionId int64
 
-type PatchModelSlotsSlotId403JSONResponse externalRef0.ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "extensionId", mux.Vars(r)["extensionId"], &extensionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "extensionId", Err: err})
+		return
+	}
 
-func (response PatchModelSlotsSlotId403JSONResponse) VisitPatchModelSlotsSlotIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PatchModelSlotsSlotId409JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response PatchModelSlotsSlotId409JSONResponse) VisitPatchModelSlotsSlotIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelOrdersIdExtensionsExtensionIdAccept(w, r, id, extensionId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchModelSlotsSlotIdDisableRequestObject struct {
-	SlotId int64 `json:"slotId"`
+	handler.ServeHTTP(w, r)
 }
 
-type PatchModelSlotsSlotIdDisableResponseObject interface {
-	VisitPatchModelSlotsSlotIdDisableResponse(w http.ResponseWriter) error
-}
+// PatchModelOrdersIdExtensionsExtensionIdReject operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelOrdersIdExtensionsExtensionIdReject(w http.ResponseWriter, r *http.Request) {
 
-type PatchModelSlotsSlotIdDisable200JSONResponse externalRef0.SlotResponse
+	var err error
 
-func (response PatchModelSlotsSlotIdDisable200JSONResponse) VisitPatchModelSlotsSlotIdDisableResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type PatchModelSlotsSlotIdDisable403JSONResponse externalRef0.ErrorResponse
+	// ------------- Path parameter "extensionId" -------------
+	var extensionId int64
 
-func (response PatchModelSlotsSlotIdDisable403JSONResponse) VisitPatchModelSlotsSlotIdDisableResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindStyledParameterWithOptions("simple", "extensionId", mux.Vars(r)["extensionId"], &extensionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "extensionId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PatchModelSlotsSlotIdDisable409JSONResponse externalRef0.ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PatchModelSlotsSlotIdDisable409JSONResponse) VisitPatchModelSlotsSlotIdDisableResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelOrdersIdExtensionsExtensionIdReject(w, r, id, extensionId)
+	}))
 
-type PostUsersRequestObject struct {
-	Body *PostUsersJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostUsersResponseObject interface {
-	VisitPostUsersResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsers201JSONResponse externalRef0.UserResponse
+// PostModelOrdersIdLocation operation middleware
+func (siw *ServerInterfaceWrapper) PostModelOrdersIdLocation(w http.ResponseWriter, r *http.Request) {
 
-func (response PostUsers201JSONResponse) VisitPostUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type PostUsers400JSONResponse externalRef0.ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response PostUsers400JSONResponse) VisitPostUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostUsers401JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response PostUsers401JSONResponse) VisitPostUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelOrdersIdLocation(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsers422JSONResponse externalRef0.ErrorResponse
+// GetModelPricingRules operation middleware
+func (siw *ServerInterfaceWrapper) GetModelPricingRules(w http.ResponseWriter, r *http.Request) {
 
-func (response PostUsers422JSONResponse) VisitPostUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PostUsers500Response struct {
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PostUsers500Response) VisitPostUsersResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type GetUsersMeRequestObject struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetModelPricingRulesParams
 
-type GetUsersMeResponseObject interface {
-	VisitGetUsersMeResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "page" -------------
 
-type GetUsersMe200JSONResponse externalRef0.UserResponse
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-func (response GetUsersMe200JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type GetUsersMe401JSONResponse externalRef0.ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelPricingRules(w, r, params)
+	}))
 
-func (response GetUsersMe401JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetUsersMe404JSONResponse externalRef0.ErrorResponse
+// PostModelPricingRules operation middleware
+func (siw *ServerInterfaceWrapper) PostModelPricingRules(w http.ResponseWriter, r *http.Request) {
 
-func (response GetUsersMe404JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetUsersMe500Response struct {
-}
+	r = r.WithContext(ctx)
 
-func (response GetUsersMe500Response) VisitGetUsersMeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelPricingRules(w, r)
+	}))
 
-type PatchUsersMeRequestObject struct {
-	Body *PatchUsersMeJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PatchUsersMeResponseObject interface {
-	VisitPatchUsersMeResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PatchUsersMe200JSONResponse externalRef0.UserResponse
+// DeleteModelPricingRulesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteModelPricingRulesId(w http.ResponseWriter, r *http.Request) {
 
-func (response PatchUsersMe200JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type PatchUsersMe400JSONResponse externalRef0.ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response PatchUsersMe400JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PatchUsersMe401JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response PatchUsersMe401JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteModelPricingRulesId(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PatchUsersMe404JSONResponse externalRef0.ErrorResponse
+// GetModelPricingRulesId operation middleware
+func (siw *ServerInterfaceWrapper) GetModelPricingRulesId(w http.ResponseWriter, r *http.Request) {
 
-func (response PatchUsersMe404JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type PatchUsersMe422JSONResponse externalRef0.ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response PatchUsersMe422JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PatchUsersMe500Response struct {
-}
+	r = r.WithContext(ctx)
 
-func (response PatchUsersMe500Response) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelPricingRulesId(w, r, id)
+	}))
 
-type GetUsersIdRequestObject struct {
-	Id int64 `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetUsersIdResponseObject interface {
-	VisitGetUsersIdResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetUsersId200JSONResponse externalRef0.AnotherUserResponse
+// PutModelPricingRulesId operation middleware
+func (siw *ServerInterfaceWrapper) PutModelPricingRulesId(w http.ResponseWriter, r *http.Request) {
 
-func (response GetUsersId200JSONResponse) VisitGetUsersIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-type GetUsersId401JSONResponse externalRef0.ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetUsersId401JSONResponse) VisitGetUsersIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetUsersId404JSONResponse externalRef0.ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response GetUsersId404JSONResponse) VisitGetUsersIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutModelPricingRulesId(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetUsersId500Response struct {
+	handler.ServeHTTP(w, r)
 }
 
-func (response GetUsersId500Response) VisitGetUsersIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+// GetModelServices operation middleware
+func (siw *ServerInterfaceWrapper) GetModelServices(w http.ResponseWriter, r *http.Request) {
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetModelServicesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelServices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostModelServices operation middleware
+func (siw *ServerInterfaceWrapper) PostModelServices(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelServices(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchModelServicesId operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelServicesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelServicesId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetModelServicesIdAddOns operation middleware
+func (siw *ServerInterfaceWrapper) GetModelServicesIdAddOns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelServicesIdAddOns(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostModelServicesIdAddOns operation middleware
+func (siw *ServerInterfaceWrapper) PostModelServicesIdAddOns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelServicesIdAddOns(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchModelServicesIdDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelServicesIdDeactivate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelServicesIdDeactivate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetModelSlots operation middleware
+func (siw *ServerInterfaceWrapper) GetModelSlots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetModelSlotsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelSlots(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostModelSlots operation middleware
+func (siw *ServerInterfaceWrapper) PostModelSlots(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelSlots(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostModelSlotsBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostModelSlotsBatch(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelSlotsBatch(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchModelSlotsDisableRange operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelSlotsDisableRange(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelSlotsDisableRange(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostModelSlotsMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostModelSlotsMerge(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelSlotsMerge(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchModelSlotsSlotId operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelSlotsSlotId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slotId" -------------
+	var slotId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slotId", mux.Vars(r)["slotId"], &slotId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slotId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelSlotsSlotId(w, r, slotId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchModelSlotsSlotIdDisable operation middleware
+func (siw *ServerInterfaceWrapper) PatchModelSlotsSlotIdDisable(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slotId" -------------
+	var slotId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slotId", mux.Vars(r)["slotId"], &slotId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slotId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchModelSlotsSlotIdDisable(w, r, slotId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostModelSlotsSlotIdSplit operation middleware
+func (siw *ServerInterfaceWrapper) PostModelSlotsSlotIdSplit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slotId" -------------
+	var slotId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slotId", mux.Vars(r)["slotId"], &slotId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slotId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelSlotsSlotIdSplit(w, r, slotId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetModelTimeOffs operation middleware
+func (siw *ServerInterfaceWrapper) GetModelTimeOffs(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelTimeOffs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostModelTimeOffs operation middleware
+func (siw *ServerInterfaceWrapper) PostModelTimeOffs(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostModelTimeOffs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteModelTimeOffsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteModelTimeOffsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteModelTimeOffsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetModelTravelBuffer operation middleware
+func (siw *ServerInterfaceWrapper) GetModelTravelBuffer(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetModelTravelBuffer(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutModelTravelBuffer operation middleware
+func (siw *ServerInterfaceWrapper) PutModelTravelBuffer(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutModelTravelBuffer(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsers operation middleware
+func (siw *ServerInterfaceWrapper) PostUsers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersMe operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchUsersMe operation middleware
+func (siw *ServerInterfaceWrapper) PatchUsersMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchUsersMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersId operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{})
+}
+
+type GorillaServerOptions struct {
+	BaseURL          string
+	BaseRouter       *mux.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = mux.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.HandleFunc(options.BaseURL+"/admin", wrapper.PostAdmin).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/admin/bookings", wrapper.GetAdminBookings).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/bookings/{id}", wrapper.GetAdminBookingsId).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/bookings/{id}/status", wrapper.PatchAdminBookingsIdStatus).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/admin/disputes", wrapper.GetAdminDisputes).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/disputes/{id}", wrapper.GetAdminDisputesId).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/disputes/{id}/assign", wrapper.PatchAdminDisputesIdAssign).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/admin/disputes/{id}/messages", wrapper.GetAdminDisputesIdMessages).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/disputes/{id}/messages", wrapper.PostAdminDisputesIdMessages).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/admin/disputes/{id}/resolve", wrapper.PatchAdminDisputesIdResolve).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/admin/ledger/trial-balance", wrapper.GetAdminLedgerTrialBalance).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/orders", wrapper.GetAdminOrders).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/orders/{id}", wrapper.GetAdminOrdersId).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/orders/{id}/payment", wrapper.GetAdminOrdersIdPayment).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/orders/{id}/status", wrapper.PatchAdminOrdersIdStatus).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/admin/promo-codes", wrapper.GetAdminPromoCodes).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/promo-codes", wrapper.PostAdminPromoCodes).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/admin/promo-codes/{id}", wrapper.GetAdminPromoCodesId).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/promo-codes/{id}/deactivate", wrapper.PatchAdminPromoCodesIdDeactivate).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/admin/users", wrapper.GetAdminUsers).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/users/{id}/verify", wrapper.PatchAdminUsersIdVerify).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/admin/{id}", wrapper.GetAdminId).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/{id}", wrapper.PatchAdminId).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/client/availability", wrapper.GetClientAvailability).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/client/bookings", wrapper.PostClientBookings).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/client/bookings/quote", wrapper.PostClientBookingsQuote).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/client/bookings/{id}/cancel", wrapper.PatchClientBookingsIdCancel).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/client/calendar-feed", wrapper.GetClientCalendarFeed).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/client/calendar-feed/regenerate", wrapper.PostClientCalendarFeedRegenerate).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/client/disputes/{id}", wrapper.GetClientDisputesId).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/client/disputes/{id}/messages", wrapper.GetClientDisputesIdMessages).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/client/disputes/{id}/messages", wrapper.PostClientDisputesIdMessages).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/client/models/{modelId}/slots", wrapper.GetClientModelsModelIdSlots).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/client/orders/{id}/cancel", wrapper.PatchClientOrdersIdCancel).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/client/orders/{id}/confirm", wrapper.PatchClientOrdersIdConfirm).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/client/orders/{id}/disputes", wrapper.PostClientOrdersIdDisputes).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/client/orders/{id}/events", wrapper.GetClientOrdersIdEvents).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/client/orders/{id}/extensions", wrapper.GetClientOrdersIdExtensions).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/client/orders/{id}/extensions", wrapper.PostClientOrdersIdExtensions).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/client/orders/{id}/issue", wrapper.PatchClientOrdersIdIssue).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/client/orders/{id}/receipt", wrapper.GetClientOrdersIdReceipt).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/client/services", wrapper.GetClientServices).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/client/services/{id}", wrapper.GetClientServicesId).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/client/services/{id}/add-ons", wrapper.GetClientServicesIdAddOns).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/add-ons/{id}", wrapper.PatchModelAddOnsId).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/add-ons/{id}/deactivate", wrapper.PatchModelAddOnsIdDeactivate).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/availability-templates", wrapper.GetModelAvailabilityTemplates).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/availability-templates", wrapper.PostModelAvailabilityTemplates).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/availability-templates/{id}", wrapper.GetModelAvailabilityTemplatesId).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/availability-templates/{id}", wrapper.PutModelAvailabilityTemplatesId).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/model/availability-templates/{id}/preview", wrapper.GetModelAvailabilityTemplatesIdPreview).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/availability-templates/{id}/publish", wrapper.PostModelAvailabilityTemplatesIdPublish).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/availability-templates/{id}/unpublish", wrapper.PostModelAvailabilityTemplatesIdUnpublish).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/booking-rules", wrapper.GetModelBookingRules).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/booking-rules", wrapper.PutModelBookingRules).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/model/bookings/{id}/approve", wrapper.PatchModelBookingsIdApprove).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/bookings/{id}/reject", wrapper.PatchModelBookingsIdReject).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/busy-calendars", wrapper.GetModelBusyCalendars).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/busy-calendars", wrapper.PostModelBusyCalendars).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/busy-calendars/{id}", wrapper.DeleteModelBusyCalendarsId).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/model/busy-calendars/{id}/events", wrapper.PutModelBusyCalendarsIdEvents).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/model/busy-calendars/{id}/sync", wrapper.PostModelBusyCalendarsIdSync).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/calendar-feed", wrapper.GetModelCalendarFeed).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/calendar-feed/regenerate", wrapper.PostModelCalendarFeedRegenerate).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/disputes/{id}", wrapper.GetModelDisputesId).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/disputes/{id}/messages", wrapper.GetModelDisputesIdMessages).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/disputes/{id}/messages", wrapper.PostModelDisputesIdMessages).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/earnings", wrapper.GetModelEarnings).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/orders", wrapper.GetModelOrders).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/orders/{id}/cancel", wrapper.PatchModelOrdersIdCancel).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/orders/{id}/complete", wrapper.PatchModelOrdersIdComplete).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/orders/{id}/disputes", wrapper.PostModelOrdersIdDisputes).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/orders/{id}/extensions", wrapper.GetModelOrdersIdExtensions).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/orders/{id}/extensions/{extensionId}/accept", wrapper.PatchModelOrdersIdExtensionsExtensionIdAccept).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/orders/{id}/extensions/{extensionId}/reject", wrapper.PatchModelOrdersIdExtensionsExtensionIdReject).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/orders/{id}/location", wrapper.PostModelOrdersIdLocation).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/pricing-rules", wrapper.GetModelPricingRules).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/pricing-rules", wrapper.PostModelPricingRules).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/pricing-rules/{id}", wrapper.DeleteModelPricingRulesId).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/model/pricing-rules/{id}", wrapper.GetModelPricingRulesId).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/pricing-rules/{id}", wrapper.PutModelPricingRulesId).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/model/services", wrapper.GetModelServices).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/services", wrapper.PostModelServices).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/services/{id}", wrapper.PatchModelServicesId).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/services/{id}/add-ons", wrapper.GetModelServicesIdAddOns).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/services/{id}/add-ons", wrapper.PostModelServicesIdAddOns).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/services/{id}/deactivate", wrapper.PatchModelServicesIdDeactivate).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/slots", wrapper.GetModelSlots).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/slots", wrapper.PostModelSlots).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/slots/batch", wrapper.PostModelSlotsBatch).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/slots/disable-range", wrapper.PatchModelSlotsDisableRange).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/slots/merge", wrapper.PostModelSlotsMerge).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/slots/{slotId}", wrapper.PatchModelSlotsSlotId).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/slots/{slotId}/disable", wrapper.PatchModelSlotsSlotIdDisable).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/model/slots/{slotId}/split", wrapper.PostModelSlotsSlotIdSplit).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/time-offs", wrapper.GetModelTimeOffs).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/time-offs", wrapper.PostModelTimeOffs).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/model/time-offs/{id}", wrapper.DeleteModelTimeOffsId).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/model/travel-buffer", wrapper.GetModelTravelBuffer).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/model/travel-buffer", wrapper.PutModelTravelBuffer).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/users", wrapper.PostUsers).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/users/me", wrapper.GetUsersMe).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/users/me", wrapper.PatchUsersMe).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/users/{id}", wrapper.GetUsersId).Methods("GET")
+
+	return r
+}
+
+type PostAdminRequestObject struct {
+	Body *PostAdminJSONRequestBody
+}
+
+type PostAdminResponseObject interface {
+	VisitPostAdminResponse(w http.ResponseWriter) error
+}
+
+type PostAdmin201JSONResponse externalRef0.Admin
+
+func (response PostAdmin201JSONResponse) VisitPostAdminResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdmin403JSONResponse externalRef0.ErrorResponse
+
+func (response PostAdmin403JSONResponse) VisitPostAdminResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBookingsRequestObject struct {
+	Params GetAdminBookingsParams
+}
+
+type GetAdminBookingsResponseObject interface {
+	VisitGetAdminBookingsResponse(w http.ResponseWriter) error
+}
+
+type GetAdminBookings200JSONResponse []externalRef0.BookingResponse
+
+func (response GetAdminBookings200JSONResponse) VisitGetAdminBookingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBookings403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminBookings403JSONResponse) VisitGetAdminBookingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBookingsIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetAdminBookingsIdResponseObject interface {
+	VisitGetAdminBookingsIdResponse(w http.ResponseWriter) error
+}
+
+type GetAdminBookingsId200JSONResponse externalRef0.BookingResponse
+
+func (response GetAdminBookingsId200JSONResponse) VisitGetAdminBookingsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBookingsId403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminBookingsId403JSONResponse) VisitGetAdminBookingsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBookingsId404JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminBookingsId404JSONResponse) VisitGetAdminBookingsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminBookingsIdStatusRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PatchAdminBookingsIdStatusJSONRequestBody
+}
+
+type PatchAdminBookingsIdStatusResponseObject interface {
+	VisitPatchAdminBookingsIdStatusResponse(w http.ResponseWriter) error
+}
+
+type PatchAdminBookingsIdStatus200JSONResponse externalRef0.BookingResponse
+
+func (response PatchAdminBookingsIdStatus200JSONResponse) VisitPatchAdminBookingsIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminBookingsIdStatus403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminBookingsIdStatus403JSONResponse) VisitPatchAdminBookingsIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminBookingsIdStatus404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminBookingsIdStatus404JSONResponse) VisitPatchAdminBookingsIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminDisputesRequestObject struct {
+	Params GetAdminDisputesParams
+}
+
+type GetAdminDisputesResponseObject interface {
+	VisitGetAdminDisputesResponse(w http.ResponseWriter) error
+}
+
+type GetAdminDisputes200JSONResponse []externalRef0.DisputeResponse
+
+func (response GetAdminDisputes200JSONResponse) VisitGetAdminDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminDisputes403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminDisputes403JSONResponse) VisitGetAdminDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminDisputesIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetAdminDisputesIdResponseObject interface {
+	VisitGetAdminDisputesIdResponse(w http.ResponseWriter) error
+}
+
+type GetAdminDisputesId200JSONResponse externalRef0.DisputeResponse
+
+func (response GetAdminDisputesId200JSONResponse) VisitGetAdminDisputesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminDisputesId403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminDisputesId403JSONResponse) VisitGetAdminDisputesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminDisputesId404JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminDisputesId404JSONResponse) VisitGetAdminDisputesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminDisputesIdAssignRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PatchAdminDisputesIdAssignJSONRequestBody
+}
+
+type PatchAdminDisputesIdAssignResponseObject interface {
+	VisitPatchAdminDisputesIdAssignResponse(w http.ResponseWriter) error
+}
+
+type PatchAdminDisputesIdAssign200JSONResponse externalRef0.DisputeResponse
+
+func (response PatchAdminDisputesIdAssign200JSONResponse) VisitPatchAdminDisputesIdAssignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminDisputesIdAssign400JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminDisputesIdAssign400JSONResponse) VisitPatchAdminDisputesIdAssignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminDisputesIdAssign403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminDisputesIdAssign403JSONResponse) VisitPatchAdminDisputesIdAssignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminDisputesIdAssign404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminDisputesIdAssign404JSONResponse) VisitPatchAdminDisputesIdAssignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminDisputesIdAssign409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminDisputesIdAssign409JSONResponse) VisitPatchAdminDisputesIdAssignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminDisputesIdMessagesRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetAdminDisputesIdMessagesParams
+}
+
+type GetAdminDisputesIdMessagesResponseObject interface {
+	VisitGetAdminDisputesIdMessagesResponse(w http.ResponseWriter) error
+}
+
+type GetAdminDisputesIdMessages200JSONResponse []externalRef0.DisputeMessageResponse
+
+func (response GetAdminDisputesIdMessages200JSONResponse) VisitGetAdminDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminDisputesIdMessages403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminDisputesIdMessages403JSONResponse) VisitGetAdminDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminDisputesIdMessages404JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminDisputesIdMessages404JSONResponse) VisitGetAdminDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminDisputesIdMessagesRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PostAdminDisputesIdMessagesJSONRequestBody
+}
+
+type PostAdminDisputesIdMessagesResponseObject interface {
+	VisitPostAdminDisputesIdMessagesResponse(w http.ResponseWriter) error
+}
+
+type PostAdminDisputesIdMessages201JSONResponse externalRef0.DisputeMessageResponse
+
+func (response PostAdminDisputesIdMessages201JSONResponse) VisitPostAdminDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminDisputesIdMessages400JSONResponse externalRef0.ErrorResponse
+
+func (response PostAdminDisputesIdMessages400JSONResponse) VisitPostAdminDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminDisputesIdMessages403JSONResponse externalRef0.ErrorResponse
+
+func (response PostAdminDisputesIdMessages403JSONResponse) VisitPostAdminDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminDisputesIdMessages404JSONResponse externalRef0.ErrorResponse
+
+func (response PostAdminDisputesIdMessages404JSONResponse) VisitPostAdminDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminDisputesIdMessages409JSONResponse externalRef0.ErrorResponse
+
+func (response PostAdminDisputesIdMessages409JSONResponse) VisitPostAdminDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminDisputesIdResolveRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PatchAdminDisputesIdResolveJSONRequestBody
+}
+
+type PatchAdminDisputesIdResolveResponseObject interface {
+	VisitPatchAdminDisputesIdResolveResponse(w http.ResponseWriter) error
+}
+
+type PatchAdminDisputesIdResolve200JSONResponse externalRef0.DisputeResponse
+
+func (response PatchAdminDisputesIdResolve200JSONResponse) VisitPatchAdminDisputesIdResolveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminDisputesIdResolve400JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminDisputesIdResolve400JSONResponse) VisitPatchAdminDisputesIdResolveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminDisputesIdResolve403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminDisputesIdResolve403JSONResponse) VisitPatchAdminDisputesIdResolveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminDisputesIdResolve404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminDisputesIdResolve404JSONResponse) VisitPatchAdminDisputesIdResolveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminDisputesIdResolve409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminDisputesIdResolve409JSONResponse) VisitPatchAdminDisputesIdResolveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminLedgerTrialBalanceRequestObject struct {
+}
+
+type GetAdminLedgerTrialBalanceResponseObject interface {
+	VisitGetAdminLedgerTrialBalanceResponse(w http.ResponseWriter) error
+}
+
+type GetAdminLedgerTrialBalance200JSONResponse externalRef0.TrialBalanceResponse
+
+func (response GetAdminLedgerTrialBalance200JSONResponse) VisitGetAdminLedgerTrialBalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminLedgerTrialBalance401JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminLedgerTrialBalance401JSONResponse) VisitGetAdminLedgerTrialBalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminLedgerTrialBalance403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminLedgerTrialBalance403JSONResponse) VisitGetAdminLedgerTrialBalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminOrdersRequestObject struct {
+	Params GetAdminOrdersParams
+}
+
+type GetAdminOrdersResponseObject interface {
+	VisitGetAdminOrdersResponse(w http.ResponseWriter) error
+}
+
+type GetAdminOrders200JSONResponse []externalRef0.OrderResponse
+
+func (response GetAdminOrders200JSONResponse) VisitGetAdminOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminOrders403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminOrders403JSONResponse) VisitGetAdminOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminOrdersIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetAdminOrdersIdResponseObject interface {
+	VisitGetAdminOrdersIdResponse(w http.ResponseWriter) error
+}
+
+type GetAdminOrdersId200JSONResponse externalRef0.OrderResponse
+
+func (response GetAdminOrdersId200JSONResponse) VisitGetAdminOrdersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminOrdersId403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminOrdersId403JSONResponse) VisitGetAdminOrdersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminOrdersId404JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminOrdersId404JSONResponse) VisitGetAdminOrdersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminOrdersIdPaymentRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetAdminOrdersIdPaymentResponseObject interface {
+	VisitGetAdminOrdersIdPaymentResponse(w http.ResponseWriter) error
+}
+
+type GetAdminOrdersIdPayment200JSONResponse externalRef0.PaymentResponse
+
+func (response GetAdminOrdersIdPayment200JSONResponse) VisitGetAdminOrdersIdPaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminOrdersIdPayment401JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminOrdersIdPayment401JSONResponse) VisitGetAdminOrdersIdPaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminOrdersIdPayment403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminOrdersIdPayment403JSONResponse) VisitGetAdminOrdersIdPaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminOrdersIdPayment404JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminOrdersIdPayment404JSONResponse) VisitGetAdminOrdersIdPaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminOrdersIdStatusRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PatchAdminOrdersIdStatusJSONRequestBody
+}
+
+type PatchAdminOrdersIdStatusResponseObject interface {
+	VisitPatchAdminOrdersIdStatusResponse(w http.ResponseWriter) error
+}
+
+type PatchAdminOrdersIdStatus200JSONResponse externalRef0.OrderResponse
+
+func (response PatchAdminOrdersIdStatus200JSONResponse) VisitPatchAdminOrdersIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminOrdersIdStatus403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminOrdersIdStatus403JSONResponse) VisitPatchAdminOrdersIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminOrdersIdStatus404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminOrdersIdStatus404JSONResponse) VisitPatchAdminOrdersIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminOrdersIdStatus409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminOrdersIdStatus409JSONResponse) VisitPatchAdminOrdersIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminPromoCodesRequestObject struct {
+	Params GetAdminPromoCodesParams
+}
+
+type GetAdminPromoCodesResponseObject interface {
+	VisitGetAdminPromoCodesResponse(w http.ResponseWriter) error
+}
+
+type GetAdminPromoCodes200JSONResponse []externalRef0.PromoCodeResponse
+
+func (response GetAdminPromoCodes200JSONResponse) VisitGetAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminPromoCodes403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminPromoCodes403JSONResponse) VisitGetAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminPromoCodesRequestObject struct {
+	Body *PostAdminPromoCodesJSONRequestBody
+}
+
+type PostAdminPromoCodesResponseObject interface {
+	VisitPostAdminPromoCodesResponse(w http.ResponseWriter) error
+}
+
+type PostAdminPromoCodes201JSONResponse externalRef0.PromoCodeResponse
+
+func (response PostAdminPromoCodes201JSONResponse) VisitPostAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminPromoCodes400JSONResponse externalRef0.ErrorResponse
+
+func (response PostAdminPromoCodes400JSONResponse) VisitPostAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminPromoCodes403JSONResponse externalRef0.ErrorResponse
+
+func (response PostAdminPromoCodes403JSONResponse) VisitPostAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminPromoCodes409JSONResponse externalRef0.ErrorResponse
+
+func (response PostAdminPromoCodes409JSONResponse) VisitPostAdminPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminPromoCodesIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetAdminPromoCodesIdResponseObject interface {
+	VisitGetAdminPromoCodesIdResponse(w http.ResponseWriter) error
+}
+
+type GetAdminPromoCodesId200JSONResponse externalRef0.PromoCodeResponse
+
+func (response GetAdminPromoCodesId200JSONResponse) VisitGetAdminPromoCodesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminPromoCodesId403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminPromoCodesId403JSONResponse) VisitGetAdminPromoCodesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminPromoCodesId404JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminPromoCodesId404JSONResponse) VisitGetAdminPromoCodesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminPromoCodesIdDeactivateRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PatchAdminPromoCodesIdDeactivateResponseObject interface {
+	VisitPatchAdminPromoCodesIdDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PatchAdminPromoCodesIdDeactivate200JSONResponse externalRef0.PromoCodeResponse
+
+func (response PatchAdminPromoCodesIdDeactivate200JSONResponse) VisitPatchAdminPromoCodesIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminPromoCodesIdDeactivate403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminPromoCodesIdDeactivate403JSONResponse) VisitPatchAdminPromoCodesIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminPromoCodesIdDeactivate404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminPromoCodesIdDeactivate404JSONResponse) VisitPatchAdminPromoCodesIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminUsersRequestObject struct {
+	Params GetAdminUsersParams
+}
+
+type GetAdminUsersResponseObject interface {
+	VisitGetAdminUsersResponse(w http.ResponseWriter) error
+}
+
+type GetAdminUsers200JSONResponse []externalRef0.UserResponse
+
+func (response GetAdminUsers200JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminUsers403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminUsers403JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminUsersIdVerifyRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PatchAdminUsersIdVerifyResponseObject interface {
+	VisitPatchAdminUsersIdVerifyResponse(w http.ResponseWriter) error
+}
+
+type PatchAdminUsersIdVerify200JSONResponse externalRef0.UserResponse
+
+func (response PatchAdminUsersIdVerify200JSONResponse) VisitPatchAdminUsersIdVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminUsersIdVerify403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminUsersIdVerify403JSONResponse) VisitPatchAdminUsersIdVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminUsersIdVerify404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminUsersIdVerify404JSONResponse) VisitPatchAdminUsersIdVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetAdminIdResponseObject interface {
+	VisitGetAdminIdResponse(w http.ResponseWriter) error
+}
+
+type GetAdminId200JSONResponse externalRef0.Admin
+
+func (response GetAdminId200JSONResponse) VisitGetAdminIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminId403JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminId403JSONResponse) VisitGetAdminIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminId404JSONResponse externalRef0.ErrorResponse
+
+func (response GetAdminId404JSONResponse) VisitGetAdminIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminIdRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PatchAdminIdJSONRequestBody
+}
+
+type PatchAdminIdResponseObject interface {
+	VisitPatchAdminIdResponse(w http.ResponseWriter) error
+}
+
+type PatchAdminId200JSONResponse externalRef0.Admin
+
+func (response PatchAdminId200JSONResponse) VisitPatchAdminIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminId403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminId403JSONResponse) VisitPatchAdminIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAdminId404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchAdminId404JSONResponse) VisitPatchAdminIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientAvailabilityRequestObject struct {
+	Params GetClientAvailabilityParams
+}
+
+type GetClientAvailabilityResponseObject interface {
+	VisitGetClientAvailabilityResponse(w http.ResponseWriter) error
+}
+
+type GetClientAvailability200JSONResponse []externalRef0.ModelAvailabilityResponse
+
+func (response GetClientAvailability200JSONResponse) VisitGetClientAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientAvailability400JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientAvailability400JSONResponse) VisitGetClientAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientAvailability401JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientAvailability401JSONResponse) VisitGetClientAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientAvailability403JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientAvailability403JSONResponse) VisitGetClientAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientBookingsRequestObject struct {
+	Body *PostClientBookingsJSONRequestBody
+}
+
+type PostClientBookingsResponseObject interface {
+	VisitPostClientBookingsResponse(w http.ResponseWriter) error
+}
+
+type PostClientBookings201JSONResponse externalRef0.BookingResponse
+
+func (response PostClientBookings201JSONResponse) VisitPostClientBookingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientBookings404JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientBookings404JSONResponse) VisitPostClientBookingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientBookings409JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientBookings409JSONResponse) VisitPostClientBookingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientBookings422JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientBookings422JSONResponse) VisitPostClientBookingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientBookingsQuoteRequestObject struct {
+	Body *PostClientBookingsQuoteJSONRequestBody
+}
+
+type PostClientBookingsQuoteResponseObject interface {
+	VisitPostClientBookingsQuoteResponse(w http.ResponseWriter) error
+}
+
+type PostClientBookingsQuote200JSONResponse externalRef0.BookingQuoteResponse
+
+func (response PostClientBookingsQuote200JSONResponse) VisitPostClientBookingsQuoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientBookingsQuote404JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientBookingsQuote404JSONResponse) VisitPostClientBookingsQuoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientBookingsQuote409JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientBookingsQuote409JSONResponse) VisitPostClientBookingsQuoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientBookingsQuote422JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientBookingsQuote422JSONResponse) VisitPostClientBookingsQuoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientBookingsIdCancelRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PatchClientBookingsIdCancelResponseObject interface {
+	VisitPatchClientBookingsIdCancelResponse(w http.ResponseWriter) error
+}
+
+type PatchClientBookingsIdCancel200JSONResponse externalRef0.BookingResponse
+
+func (response PatchClientBookingsIdCancel200JSONResponse) VisitPatchClientBookingsIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientBookingsIdCancel403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientBookingsIdCancel403JSONResponse) VisitPatchClientBookingsIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientBookingsIdCancel409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientBookingsIdCancel409JSONResponse) VisitPatchClientBookingsIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientCalendarFeedRequestObject struct {
+}
+
+type GetClientCalendarFeedResponseObject interface {
+	VisitGetClientCalendarFeedResponse(w http.ResponseWriter) error
+}
+
+type GetClientCalendarFeed200JSONResponse externalRef0.CalendarFeedResponse
+
+func (response GetClientCalendarFeed200JSONResponse) VisitGetClientCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientCalendarFeed401JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientCalendarFeed401JSONResponse) VisitGetClientCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientCalendarFeed403JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientCalendarFeed403JSONResponse) VisitGetClientCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientCalendarFeedRegenerateRequestObject struct {
+}
+
+type PostClientCalendarFeedRegenerateResponseObject interface {
+	VisitPostClientCalendarFeedRegenerateResponse(w http.ResponseWriter) error
+}
+
+type PostClientCalendarFeedRegenerate200JSONResponse externalRef0.CalendarFeedResponse
+
+func (response PostClientCalendarFeedRegenerate200JSONResponse) VisitPostClientCalendarFeedRegenerateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientCalendarFeedRegenerate401JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientCalendarFeedRegenerate401JSONResponse) VisitPostClientCalendarFeedRegenerateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientCalendarFeedRegenerate403JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientCalendarFeedRegenerate403JSONResponse) VisitPostClientCalendarFeedRegenerateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientDisputesIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetClientDisputesIdResponseObject interface {
+	VisitGetClientDisputesIdResponse(w http.ResponseWriter) error
+}
+
+type GetClientDisputesId200JSONResponse externalRef0.DisputeResponse
+
+func (response GetClientDisputesId200JSONResponse) VisitGetClientDisputesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientDisputesId401JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientDisputesId401JSONResponse) VisitGetClientDisputesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientDisputesId403JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientDisputesId403JSONResponse) VisitGetClientDisputesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientDisputesId404JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientDisputesId404JSONResponse) VisitGetClientDisputesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientDisputesIdMessagesRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetClientDisputesIdMessagesParams
+}
+
+type GetClientDisputesIdMessagesResponseObject interface {
+	VisitGetClientDisputesIdMessagesResponse(w http.ResponseWriter) error
+}
+
+type GetClientDisputesIdMessages200JSONResponse []externalRef0.DisputeMessageResponse
+
+func (response GetClientDisputesIdMessages200JSONResponse) VisitGetClientDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientDisputesIdMessages401JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientDisputesIdMessages401JSONResponse) VisitGetClientDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientDisputesIdMessages403JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientDisputesIdMessages403JSONResponse) VisitGetClientDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientDisputesIdMessages404JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientDisputesIdMessages404JSONResponse) VisitGetClientDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientDisputesIdMessagesRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PostClientDisputesIdMessagesJSONRequestBody
+}
+
+type PostClientDisputesIdMessagesResponseObject interface {
+	VisitPostClientDisputesIdMessagesResponse(w http.ResponseWriter) error
+}
+
+type PostClientDisputesIdMessages201JSONResponse externalRef0.DisputeMessageResponse
+
+func (response PostClientDisputesIdMessages201JSONResponse) VisitPostClientDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientDisputesIdMessages400JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientDisputesIdMessages400JSONResponse) VisitPostClientDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientDisputesIdMessages401JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientDisputesIdMessages401JSONResponse) VisitPostClientDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientDisputesIdMessages403JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientDisputesIdMessages403JSONResponse) VisitPostClientDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientDisputesIdMessages404JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientDisputesIdMessages404JSONResponse) VisitPostClientDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientDisputesIdMessages409JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientDisputesIdMessages409JSONResponse) VisitPostClientDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientModelsModelIdSlotsRequestObject struct {
+	ModelId int64 `json:"modelId"`
+	Params  GetClientModelsModelIdSlotsParams
+}
+
+type GetClientModelsModelIdSlotsResponseObject interface {
+	VisitGetClientModelsModelIdSlotsResponse(w http.ResponseWriter) error
+}
+
+type GetClientModelsModelIdSlots200JSONResponse []externalRef0.SlotResponse
+
+func (response GetClientModelsModelIdSlots200JSONResponse) VisitGetClientModelsModelIdSlotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientModelsModelIdSlots400JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientModelsModelIdSlots400JSONResponse) VisitGetClientModelsModelIdSlotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientModelsModelIdSlots403JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientModelsModelIdSlots403JSONResponse) VisitGetClientModelsModelIdSlotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientModelsModelIdSlots404JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientModelsModelIdSlots404JSONResponse) VisitGetClientModelsModelIdSlotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdCancelRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PatchClientOrdersIdCancelResponseObject interface {
+	VisitPatchClientOrdersIdCancelResponse(w http.ResponseWriter) error
+}
+
+type PatchClientOrdersIdCancel200JSONResponse externalRef0.OrderResponse
+
+func (response PatchClientOrdersIdCancel200JSONResponse) VisitPatchClientOrdersIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdCancel401JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdCancel401JSONResponse) VisitPatchClientOrdersIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdCancel403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdCancel403JSONResponse) VisitPatchClientOrdersIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdCancel404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdCancel404JSONResponse) VisitPatchClientOrdersIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdCancel409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdCancel409JSONResponse) VisitPatchClientOrdersIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdConfirmRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PatchClientOrdersIdConfirmResponseObject interface {
+	VisitPatchClientOrdersIdConfirmResponse(w http.ResponseWriter) error
+}
+
+type PatchClientOrdersIdConfirm200JSONResponse externalRef0.OrderResponse
+
+func (response PatchClientOrdersIdConfirm200JSONResponse) VisitPatchClientOrdersIdConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdConfirm401JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdConfirm401JSONResponse) VisitPatchClientOrdersIdConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdConfirm403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdConfirm403JSONResponse) VisitPatchClientOrdersIdConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdConfirm404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdConfirm404JSONResponse) VisitPatchClientOrdersIdConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdConfirm409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdConfirm409JSONResponse) VisitPatchClientOrdersIdConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientOrdersIdDisputesRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PostClientOrdersIdDisputesJSONRequestBody
+}
+
+type PostClientOrdersIdDisputesResponseObject interface {
+	VisitPostClientOrdersIdDisputesResponse(w http.ResponseWriter) error
+}
+
+type PostClientOrdersIdDisputes201JSONResponse externalRef0.DisputeResponse
+
+func (response PostClientOrdersIdDisputes201JSONResponse) VisitPostClientOrdersIdDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientOrdersIdDisputes400JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientOrdersIdDisputes400JSONResponse) VisitPostClientOrdersIdDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientOrdersIdDisputes401JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientOrdersIdDisputes401JSONResponse) VisitPostClientOrdersIdDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientOrdersIdDisputes403JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientOrdersIdDisputes403JSONResponse) VisitPostClientOrdersIdDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientOrdersIdDisputes404JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientOrdersIdDisputes404JSONResponse) VisitPostClientOrdersIdDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientOrdersIdDisputes409JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientOrdersIdDisputes409JSONResponse) VisitPostClientOrdersIdDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdEventsRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetClientOrdersIdEventsParams
+}
+
+type GetClientOrdersIdEventsResponseObject interface {
+	VisitGetClientOrdersIdEventsResponse(w http.ResponseWriter) error
+}
+
+type GetClientOrdersIdEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetClientOrdersIdEvents200TexteventStreamResponse) VisitGetClientOrdersIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetClientOrdersIdEvents401JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientOrdersIdEvents401JSONResponse) VisitGetClientOrdersIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdEvents403JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientOrdersIdEvents403JSONResponse) VisitGetClientOrdersIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdEvents404JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientOrdersIdEvents404JSONResponse) VisitGetClientOrdersIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdEvents503JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientOrdersIdEvents503JSONResponse) VisitGetClientOrdersIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdExtensionsRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetClientOrdersIdExtensionsResponseObject interface {
+	VisitGetClientOrdersIdExtensionsResponse(w http.ResponseWriter) error
+}
+
+type GetClientOrdersIdExtensions200JSONResponse []externalRef0.OrderExtensionResponse
+
+func (response GetClientOrdersIdExtensions200JSONResponse) VisitGetClientOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdExtensions401JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientOrdersIdExtensions401JSONResponse) VisitGetClientOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdExtensions403JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientOrdersIdExtensions403JSONResponse) VisitGetClientOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdExtensions404JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientOrdersIdExtensions404JSONResponse) VisitGetClientOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientOrdersIdExtensionsRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PostClientOrdersIdExtensionsJSONRequestBody
+}
+
+type PostClientOrdersIdExtensionsResponseObject interface {
+	VisitPostClientOrdersIdExtensionsResponse(w http.ResponseWriter) error
+}
+
+type PostClientOrdersIdExtensions201JSONResponse externalRef0.OrderExtensionResponse
+
+func (response PostClientOrdersIdExtensions201JSONResponse) VisitPostClientOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientOrdersIdExtensions400JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientOrdersIdExtensions400JSONResponse) VisitPostClientOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientOrdersIdExtensions401JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientOrdersIdExtensions401JSONResponse) VisitPostClientOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientOrdersIdExtensions403JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientOrdersIdExtensions403JSONResponse) VisitPostClientOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientOrdersIdExtensions404JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientOrdersIdExtensions404JSONResponse) VisitPostClientOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostClientOrdersIdExtensions409JSONResponse externalRef0.ErrorResponse
+
+func (response PostClientOrdersIdExtensions409JSONResponse) VisitPostClientOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdIssueRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PatchClientOrdersIdIssueJSONRequestBody
+}
+
+type PatchClientOrdersIdIssueResponseObject interface {
+	VisitPatchClientOrdersIdIssueResponse(w http.ResponseWriter) error
+}
+
+type PatchClientOrdersIdIssue200JSONResponse externalRef0.OrderResponse
+
+func (response PatchClientOrdersIdIssue200JSONResponse) VisitPatchClientOrdersIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdIssue400JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdIssue400JSONResponse) VisitPatchClientOrdersIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdIssue401JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdIssue401JSONResponse) VisitPatchClientOrdersIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdIssue403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdIssue403JSONResponse) VisitPatchClientOrdersIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdIssue404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdIssue404JSONResponse) VisitPatchClientOrdersIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchClientOrdersIdIssue409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchClientOrdersIdIssue409JSONResponse) VisitPatchClientOrdersIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdReceiptRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetClientOrdersIdReceiptParams
+}
+
+type GetClientOrdersIdReceiptResponseObject interface {
+	VisitGetClientOrdersIdReceiptResponse(w http.ResponseWriter) error
+}
+
+type GetClientOrdersIdReceipt200JSONResponse externalRef0.ReceiptResponse
+
+func (response GetClientOrdersIdReceipt200JSONResponse) VisitGetClientOrdersIdReceiptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdReceipt200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetClientOrdersIdReceipt200TexthtmlResponse) VisitGetClientOrdersIdReceiptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetClientOrdersIdReceipt401JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientOrdersIdReceipt401JSONResponse) VisitGetClientOrdersIdReceiptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdReceipt403JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientOrdersIdReceipt403JSONResponse) VisitGetClientOrdersIdReceiptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdReceipt404JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientOrdersIdReceipt404JSONResponse) VisitGetClientOrdersIdReceiptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientOrdersIdReceipt409JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientOrdersIdReceipt409JSONResponse) VisitGetClientOrdersIdReceiptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientServicesRequestObject struct {
+	Params GetClientServicesParams
+}
+
+type GetClientServicesResponseObject interface {
+	VisitGetClientServicesResponse(w http.ResponseWriter) error
+}
+
+type GetClientServices200JSONResponse []externalRef0.ModelServiceResponse
+
+func (response GetClientServices200JSONResponse) VisitGetClientServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientServices400JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientServices400JSONResponse) VisitGetClientServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientServices500Response struct {
+}
+
+func (response GetClientServices500Response) VisitGetClientServicesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetClientServicesIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetClientServicesIdResponseObject interface {
+	VisitGetClientServicesIdResponse(w http.ResponseWriter) error
+}
+
+type GetClientServicesId200JSONResponse externalRef0.ModelServiceResponse
+
+func (response GetClientServicesId200JSONResponse) VisitGetClientServicesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientServicesId400JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientServicesId400JSONResponse) VisitGetClientServicesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientServicesId404JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientServicesId404JSONResponse) VisitGetClientServicesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientServicesId500Response struct {
+}
+
+func (response GetClientServicesId500Response) VisitGetClientServicesIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetClientServicesIdAddOnsRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetClientServicesIdAddOnsResponseObject interface {
+	VisitGetClientServicesIdAddOnsResponse(w http.ResponseWriter) error
+}
+
+type GetClientServicesIdAddOns200JSONResponse []externalRef0.AddOnResponse
+
+func (response GetClientServicesIdAddOns200JSONResponse) VisitGetClientServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientServicesIdAddOns403JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientServicesIdAddOns403JSONResponse) VisitGetClientServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientServicesIdAddOns404JSONResponse externalRef0.ErrorResponse
+
+func (response GetClientServicesIdAddOns404JSONResponse) VisitGetClientServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientServicesIdAddOns500Response struct {
+}
+
+func (response GetClientServicesIdAddOns500Response) VisitGetClientServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PatchModelAddOnsIdRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PatchModelAddOnsIdJSONRequestBody
+}
+
+type PatchModelAddOnsIdResponseObject interface {
+	VisitPatchModelAddOnsIdResponse(w http.ResponseWriter) error
+}
+
+type PatchModelAddOnsId200JSONResponse externalRef0.AddOnResponse
+
+func (response PatchModelAddOnsId200JSONResponse) VisitPatchModelAddOnsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelAddOnsId400JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelAddOnsId400JSONResponse) VisitPatchModelAddOnsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelAddOnsId403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelAddOnsId403JSONResponse) VisitPatchModelAddOnsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelAddOnsId404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelAddOnsId404JSONResponse) VisitPatchModelAddOnsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelAddOnsId409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelAddOnsId409JSONResponse) VisitPatchModelAddOnsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelAddOnsId500Response struct {
+}
+
+func (response PatchModelAddOnsId500Response) VisitPatchModelAddOnsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PatchModelAddOnsIdDeactivateRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PatchModelAddOnsIdDeactivateResponseObject interface {
+	VisitPatchModelAddOnsIdDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PatchModelAddOnsIdDeactivate200JSONResponse externalRef0.AddOnResponse
+
+func (response PatchModelAddOnsIdDeactivate200JSONResponse) VisitPatchModelAddOnsIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelAddOnsIdDeactivate403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelAddOnsIdDeactivate403JSONResponse) VisitPatchModelAddOnsIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelAddOnsIdDeactivate404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelAddOnsIdDeactivate404JSONResponse) VisitPatchModelAddOnsIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelAddOnsIdDeactivate409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelAddOnsIdDeactivate409JSONResponse) VisitPatchModelAddOnsIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelAddOnsIdDeactivate500Response struct {
+}
+
+func (response PatchModelAddOnsIdDeactivate500Response) VisitPatchModelAddOnsIdDeactivateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetModelAvailabilityTemplatesRequestObject struct {
+	Params GetModelAvailabilityTemplatesParams
+}
+
+type GetModelAvailabilityTemplatesResponseObject interface {
+	VisitGetModelAvailabilityTemplatesResponse(w http.ResponseWriter) error
+}
+
+type GetModelAvailabilityTemplates200JSONResponse []externalRef0.AvailabilityTemplateResponse
+
+func (response GetModelAvailabilityTemplates200JSONResponse) VisitGetModelAvailabilityTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelAvailabilityTemplates403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelAvailabilityTemplates403JSONResponse) VisitGetModelAvailabilityTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelAvailabilityTemplatesRequestObject struct {
+	Body *PostModelAvailabilityTemplatesJSONRequestBody
+}
+
+type PostModelAvailabilityTemplatesResponseObject interface {
+	VisitPostModelAvailabilityTemplatesResponse(w http.ResponseWriter) error
+}
+
+type PostModelAvailabilityTemplates201JSONResponse externalRef0.AvailabilityTemplateResponse
+
+func (response PostModelAvailabilityTemplates201JSONResponse) VisitPostModelAvailabilityTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelAvailabilityTemplates400JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelAvailabilityTemplates400JSONResponse) VisitPostModelAvailabilityTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelAvailabilityTemplates403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelAvailabilityTemplates403JSONResponse) VisitPostModelAvailabilityTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelAvailabilityTemplatesIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetModelAvailabilityTemplatesIdResponseObject interface {
+	VisitGetModelAvailabilityTemplatesIdResponse(w http.ResponseWriter) error
+}
+
+type GetModelAvailabilityTemplatesId200JSONResponse externalRef0.AvailabilityTemplateResponse
+
+func (response GetModelAvailabilityTemplatesId200JSONResponse) VisitGetModelAvailabilityTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelAvailabilityTemplatesId403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelAvailabilityTemplatesId403JSONResponse) VisitGetModelAvailabilityTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelAvailabilityTemplatesId404JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelAvailabilityTemplatesId404JSONResponse) VisitGetModelAvailabilityTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelAvailabilityTemplatesIdRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PutModelAvailabilityTemplatesIdJSONRequestBody
+}
+
+type PutModelAvailabilityTemplatesIdResponseObject interface {
+	VisitPutModelAvailabilityTemplatesIdResponse(w http.ResponseWriter) error
+}
+
+type PutModelAvailabilityTemplatesId200JSONResponse externalRef0.AvailabilityTemplateResponse
+
+func (response PutModelAvailabilityTemplatesId200JSONResponse) VisitPutModelAvailabilityTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelAvailabilityTemplatesId400JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelAvailabilityTemplatesId400JSONResponse) VisitPutModelAvailabilityTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelAvailabilityTemplatesId403JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelAvailabilityTemplatesId403JSONResponse) VisitPutModelAvailabilityTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelAvailabilityTemplatesId404JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelAvailabilityTemplatesId404JSONResponse) VisitPutModelAvailabilityTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelAvailabilityTemplatesIdPreviewRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetModelAvailabilityTemplatesIdPreviewResponseObject interface {
+	VisitGetModelAvailabilityTemplatesIdPreviewResponse(w http.ResponseWriter) error
+}
+
+type GetModelAvailabilityTemplatesIdPreview200JSONResponse []externalRef0.SlotPreviewResponse
+
+func (response GetModelAvailabilityTemplatesIdPreview200JSONResponse) VisitGetModelAvailabilityTemplatesIdPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelAvailabilityTemplatesIdPreview403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelAvailabilityTemplatesIdPreview403JSONResponse) VisitGetModelAvailabilityTemplatesIdPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelAvailabilityTemplatesIdPreview404JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelAvailabilityTemplatesIdPreview404JSONResponse) VisitGetModelAvailabilityTemplatesIdPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelAvailabilityTemplatesIdPublishRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PostModelAvailabilityTemplatesIdPublishResponseObject interface {
+	VisitPostModelAvailabilityTemplatesIdPublishResponse(w http.ResponseWriter) error
+}
+
+type PostModelAvailabilityTemplatesIdPublish200JSONResponse externalRef0.AvailabilityTemplateResponse
+
+func (response PostModelAvailabilityTemplatesIdPublish200JSONResponse) VisitPostModelAvailabilityTemplatesIdPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelAvailabilityTemplatesIdPublish403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelAvailabilityTemplatesIdPublish403JSONResponse) VisitPostModelAvailabilityTemplatesIdPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelAvailabilityTemplatesIdPublish404JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelAvailabilityTemplatesIdPublish404JSONResponse) VisitPostModelAvailabilityTemplatesIdPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelAvailabilityTemplatesIdUnpublishRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PostModelAvailabilityTemplatesIdUnpublishResponseObject interface {
+	VisitPostModelAvailabilityTemplatesIdUnpublishResponse(w http.ResponseWriter) error
+}
+
+type PostModelAvailabilityTemplatesIdUnpublish200JSONResponse externalRef0.AvailabilityTemplateResponse
+
+func (response PostModelAvailabilityTemplatesIdUnpublish200JSONResponse) VisitPostModelAvailabilityTemplatesIdUnpublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelAvailabilityTemplatesIdUnpublish403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelAvailabilityTemplatesIdUnpublish403JSONResponse) VisitPostModelAvailabilityTemplatesIdUnpublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelAvailabilityTemplatesIdUnpublish404JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelAvailabilityTemplatesIdUnpublish404JSONResponse) VisitPostModelAvailabilityTemplatesIdUnpublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelBookingRulesRequestObject struct {
+}
+
+type GetModelBookingRulesResponseObject interface {
+	VisitGetModelBookingRulesResponse(w http.ResponseWriter) error
+}
+
+type GetModelBookingRules200JSONResponse externalRef0.BookingRulesResponse
+
+func (response GetModelBookingRules200JSONResponse) VisitGetModelBookingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelBookingRules401JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelBookingRules401JSONResponse) VisitGetModelBookingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelBookingRules403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelBookingRules403JSONResponse) VisitGetModelBookingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelBookingRulesRequestObject struct {
+	Body *PutModelBookingRulesJSONRequestBody
+}
+
+type PutModelBookingRulesResponseObject interface {
+	VisitPutModelBookingRulesResponse(w http.ResponseWriter) error
+}
+
+type PutModelBookingRules200JSONResponse externalRef0.BookingRulesResponse
+
+func (response PutModelBookingRules200JSONResponse) VisitPutModelBookingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelBookingRules400JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelBookingRules400JSONResponse) VisitPutModelBookingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelBookingRules401JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelBookingRules401JSONResponse) VisitPutModelBookingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelBookingRules403JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelBookingRules403JSONResponse) VisitPutModelBookingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelBookingsIdApproveRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PatchModelBookingsIdApproveResponseObject interface {
+	VisitPatchModelBookingsIdApproveResponse(w http.ResponseWriter) error
+}
+
+type PatchModelBookingsIdApprove200JSONResponse externalRef0.BookingResponse
+
+func (response PatchModelBookingsIdApprove200JSONResponse) VisitPatchModelBookingsIdApproveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelBookingsIdApprove403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelBookingsIdApprove403JSONResponse) VisitPatchModelBookingsIdApproveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelBookingsIdApprove409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelBookingsIdApprove409JSONResponse) VisitPatchModelBookingsIdApproveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelBookingsIdRejectRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PatchModelBookingsIdRejectResponseObject interface {
+	VisitPatchModelBookingsIdRejectResponse(w http.ResponseWriter) error
+}
+
+type PatchModelBookingsIdReject200JSONResponse externalRef0.BookingResponse
+
+func (response PatchModelBookingsIdReject200JSONResponse) VisitPatchModelBookingsIdRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelBookingsIdReject403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelBookingsIdReject403JSONResponse) VisitPatchModelBookingsIdRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelBookingsIdReject409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelBookingsIdReject409JSONResponse) VisitPatchModelBookingsIdRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelBusyCalendarsRequestObject struct {
+}
+
+type GetModelBusyCalendarsResponseObject interface {
+	VisitGetModelBusyCalendarsResponse(w http.ResponseWriter) error
+}
+
+type GetModelBusyCalendars200JSONResponse []externalRef0.BusyCalendarResponse
+
+func (response GetModelBusyCalendars200JSONResponse) VisitGetModelBusyCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelBusyCalendars401JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelBusyCalendars401JSONResponse) VisitGetModelBusyCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelBusyCalendars403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelBusyCalendars403JSONResponse) VisitGetModelBusyCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelBusyCalendarsRequestObject struct {
+	Body *PostModelBusyCalendarsJSONRequestBody
+}
+
+type PostModelBusyCalendarsResponseObject interface {
+	VisitPostModelBusyCalendarsResponse(w http.ResponseWriter) error
+}
+
+type PostModelBusyCalendars201JSONResponse externalRef0.BusyCalendarSyncResponse
+
+func (response PostModelBusyCalendars201JSONResponse) VisitPostModelBusyCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelBusyCalendars400JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelBusyCalendars400JSONResponse) VisitPostModelBusyCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelBusyCalendars401JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelBusyCalendars401JSONResponse) VisitPostModelBusyCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelBusyCalendars403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelBusyCalendars403JSONResponse) VisitPostModelBusyCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelBusyCalendars422JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelBusyCalendars422JSONResponse) VisitPostModelBusyCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteModelBusyCalendarsIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteModelBusyCalendarsIdResponseObject interface {
+	VisitDeleteModelBusyCalendarsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteModelBusyCalendarsId200JSONResponse externalRef0.BusyCalendarSyncResponse
+
+func (response DeleteModelBusyCalendarsId200JSONResponse) VisitDeleteModelBusyCalendarsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteModelBusyCalendarsId401JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteModelBusyCalendarsId401JSONResponse) VisitDeleteModelBusyCalendarsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteModelBusyCalendarsId403JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteModelBusyCalendarsId403JSONResponse) VisitDeleteModelBusyCalendarsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteModelBusyCalendarsId404JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteModelBusyCalendarsId404JSONResponse) VisitDeleteModelBusyCalendarsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelBusyCalendarsIdEventsRequestObject struct {
+	Id   int64 `json:"id"`
+	Body io.Reader
+}
+
+type PutModelBusyCalendarsIdEventsResponseObject interface {
+	VisitPutModelBusyCalendarsIdEventsResponse(w http.ResponseWriter) error
+}
+
+type PutModelBusyCalendarsIdEvents200JSONResponse externalRef0.BusyCalendarSyncResponse
+
+func (response PutModelBusyCalendarsIdEvents200JSONResponse) VisitPutModelBusyCalendarsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelBusyCalendarsIdEvents400JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelBusyCalendarsIdEvents400JSONResponse) VisitPutModelBusyCalendarsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelBusyCalendarsIdEvents401JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelBusyCalendarsIdEvents401JSONResponse) VisitPutModelBusyCalendarsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelBusyCalendarsIdEvents403JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelBusyCalendarsIdEvents403JSONResponse) VisitPutModelBusyCalendarsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelBusyCalendarsIdEvents404JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelBusyCalendarsIdEvents404JSONResponse) VisitPutModelBusyCalendarsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelBusyCalendarsIdEvents409JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelBusyCalendarsIdEvents409JSONResponse) VisitPutModelBusyCalendarsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelBusyCalendarsIdSyncRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PostModelBusyCalendarsIdSyncResponseObject interface {
+	VisitPostModelBusyCalendarsIdSyncResponse(w http.ResponseWriter) error
+}
+
+type PostModelBusyCalendarsIdSync200JSONResponse externalRef0.BusyCalendarSyncResponse
+
+func (response PostModelBusyCalendarsIdSync200JSONResponse) VisitPostModelBusyCalendarsIdSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelBusyCalendarsIdSync401JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelBusyCalendarsIdSync401JSONResponse) VisitPostModelBusyCalendarsIdSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelBusyCalendarsIdSync403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelBusyCalendarsIdSync403JSONResponse) VisitPostModelBusyCalendarsIdSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelBusyCalendarsIdSync404JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelBusyCalendarsIdSync404JSONResponse) VisitPostModelBusyCalendarsIdSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelBusyCalendarsIdSync422JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelBusyCalendarsIdSync422JSONResponse) VisitPostModelBusyCalendarsIdSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelCalendarFeedRequestObject struct {
+}
+
+type GetModelCalendarFeedResponseObject interface {
+	VisitGetModelCalendarFeedResponse(w http.ResponseWriter) error
+}
+
+type GetModelCalendarFeed200JSONResponse externalRef0.CalendarFeedResponse
+
+func (response GetModelCalendarFeed200JSONResponse) VisitGetModelCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelCalendarFeed401JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelCalendarFeed401JSONResponse) VisitGetModelCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelCalendarFeed403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelCalendarFeed403JSONResponse) VisitGetModelCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelCalendarFeedRegenerateRequestObject struct {
+}
+
+type PostModelCalendarFeedRegenerateResponseObject interface {
+	VisitPostModelCalendarFeedRegenerateResponse(w http.ResponseWriter) error
+}
+
+type PostModelCalendarFeedRegenerate200JSONResponse externalRef0.CalendarFeedResponse
+
+func (response PostModelCalendarFeedRegenerate200JSONResponse) VisitPostModelCalendarFeedRegenerateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelCalendarFeedRegenerate401JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelCalendarFeedRegenerate401JSONResponse) VisitPostModelCalendarFeedRegenerateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelCalendarFeedRegenerate403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelCalendarFeedRegenerate403JSONResponse) VisitPostModelCalendarFeedRegenerateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelDisputesIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetModelDisputesIdResponseObject interface {
+	VisitGetModelDisputesIdResponse(w http.ResponseWriter) error
+}
+
+type GetModelDisputesId200JSONResponse externalRef0.DisputeResponse
+
+func (response GetModelDisputesId200JSONResponse) VisitGetModelDisputesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelDisputesId401JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelDisputesId401JSONResponse) VisitGetModelDisputesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelDisputesId403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelDisputesId403JSONResponse) VisitGetModelDisputesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelDisputesId404JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelDisputesId404JSONResponse) VisitGetModelDisputesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelDisputesIdMessagesRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetModelDisputesIdMessagesParams
+}
+
+type GetModelDisputesIdMessagesResponseObject interface {
+	VisitGetModelDisputesIdMessagesResponse(w http.ResponseWriter) error
+}
+
+type GetModelDisputesIdMessages200JSONResponse []externalRef0.DisputeMessageResponse
+
+func (response GetModelDisputesIdMessages200JSONResponse) VisitGetModelDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelDisputesIdMessages401JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelDisputesIdMessages401JSONResponse) VisitGetModelDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelDisputesIdMessages403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelDisputesIdMessages403JSONResponse) VisitGetModelDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelDisputesIdMessages404JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelDisputesIdMessages404JSONResponse) VisitGetModelDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelDisputesIdMessagesRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PostModelDisputesIdMessagesJSONRequestBody
+}
+
+type PostModelDisputesIdMessagesResponseObject interface {
+	VisitPostModelDisputesIdMessagesResponse(w http.ResponseWriter) error
+}
+
+type PostModelDisputesIdMessages201JSONResponse externalRef0.DisputeMessageResponse
+
+func (response PostModelDisputesIdMessages201JSONResponse) VisitPostModelDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelDisputesIdMessages400JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelDisputesIdMessages400JSONResponse) VisitPostModelDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelDisputesIdMessages401JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelDisputesIdMessages401JSONResponse) VisitPostModelDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelDisputesIdMessages403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelDisputesIdMessages403JSONResponse) VisitPostModelDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelDisputesIdMessages404JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelDisputesIdMessages404JSONResponse) VisitPostModelDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelDisputesIdMessages409JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelDisputesIdMessages409JSONResponse) VisitPostModelDisputesIdMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelEarningsRequestObject struct {
+	Params GetModelEarningsParams
+}
+
+type GetModelEarningsResponseObject interface {
+	VisitGetModelEarningsResponse(w http.ResponseWriter) error
+}
+
+type GetModelEarnings200JSONResponse externalRef0.ModelEarningsResponse
+
+func (response GetModelEarnings200JSONResponse) VisitGetModelEarningsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelEarnings401JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelEarnings401JSONResponse) VisitGetModelEarningsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelEarnings403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelEarnings403JSONResponse) VisitGetModelEarningsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelOrdersRequestObject struct {
+	Params GetModelOrdersParams
+}
+
+type GetModelOrdersResponseObject interface {
+	VisitGetModelOrdersResponse(w http.ResponseWriter) error
+}
+
+type GetModelOrders200JSONResponse []externalRef0.OrderResponse
+
+func (response GetModelOrders200JSONResponse) VisitGetModelOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelOrders401JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelOrders401JSONResponse) VisitGetModelOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelOrders403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelOrders403JSONResponse) VisitGetModelOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdCancelRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PatchModelOrdersIdCancelResponseObject interface {
+	VisitPatchModelOrdersIdCancelResponse(w http.ResponseWriter) error
+}
+
+type PatchModelOrdersIdCancel200JSONResponse externalRef0.OrderResponse
+
+func (response PatchModelOrdersIdCancel200JSONResponse) VisitPatchModelOrdersIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdCancel401JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdCancel401JSONResponse) VisitPatchModelOrdersIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdCancel403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdCancel403JSONResponse) VisitPatchModelOrdersIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdCancel404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdCancel404JSONResponse) VisitPatchModelOrdersIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdCancel409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdCancel409JSONResponse) VisitPatchModelOrdersIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdCompleteRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PatchModelOrdersIdCompleteResponseObject interface {
+	VisitPatchModelOrdersIdCompleteResponse(w http.ResponseWriter) error
+}
+
+type PatchModelOrdersIdComplete200JSONResponse externalRef0.OrderResponse
+
+func (response PatchModelOrdersIdComplete200JSONResponse) VisitPatchModelOrdersIdCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdComplete400JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdComplete400JSONResponse) VisitPatchModelOrdersIdCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdComplete401JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdComplete401JSONResponse) VisitPatchModelOrdersIdCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdComplete403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdComplete403JSONResponse) VisitPatchModelOrdersIdCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdComplete404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdComplete404JSONResponse) VisitPatchModelOrdersIdCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelOrdersIdDisputesRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PostModelOrdersIdDisputesJSONRequestBody
+}
+
+type PostModelOrdersIdDisputesResponseObject interface {
+	VisitPostModelOrdersIdDisputesResponse(w http.ResponseWriter) error
+}
+
+type PostModelOrdersIdDisputes201JSONResponse externalRef0.DisputeResponse
+
+func (response PostModelOrdersIdDisputes201JSONResponse) VisitPostModelOrdersIdDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelOrdersIdDisputes400JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelOrdersIdDisputes400JSONResponse) VisitPostModelOrdersIdDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelOrdersIdDisputes401JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelOrdersIdDisputes401JSONResponse) VisitPostModelOrdersIdDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelOrdersIdDisputes403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelOrdersIdDisputes403JSONResponse) VisitPostModelOrdersIdDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelOrdersIdDisputes404JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelOrdersIdDisputes404JSONResponse) VisitPostModelOrdersIdDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelOrdersIdDisputes409JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelOrdersIdDisputes409JSONResponse) VisitPostModelOrdersIdDisputesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelOrdersIdExtensionsRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetModelOrdersIdExtensionsResponseObject interface {
+	VisitGetModelOrdersIdExtensionsResponse(w http.ResponseWriter) error
+}
+
+type GetModelOrdersIdExtensions200JSONResponse []externalRef0.OrderExtensionResponse
+
+func (response GetModelOrdersIdExtensions200JSONResponse) VisitGetModelOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelOrdersIdExtensions401JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelOrdersIdExtensions401JSONResponse) VisitGetModelOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelOrdersIdExtensions403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelOrdersIdExtensions403JSONResponse) VisitGetModelOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelOrdersIdExtensions404JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelOrdersIdExtensions404JSONResponse) VisitGetModelOrdersIdExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdAcceptRequestObject struct {
+	Id          int64 `json:"id"`
+	ExtensionId int64 `json:"extensionId"`
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdAcceptResponseObject interface {
+	VisitPatchModelOrdersIdExtensionsExtensionIdAcceptResponse(w http.ResponseWriter) error
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdAccept200JSONResponse externalRef0.OrderExtensionResponse
+
+func (response PatchModelOrdersIdExtensionsExtensionIdAccept200JSONResponse) VisitPatchModelOrdersIdExtensionsExtensionIdAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdAccept401JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdExtensionsExtensionIdAccept401JSONResponse) VisitPatchModelOrdersIdExtensionsExtensionIdAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdAccept402JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdExtensionsExtensionIdAccept402JSONResponse) VisitPatchModelOrdersIdExtensionsExtensionIdAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(402)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdAccept403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdExtensionsExtensionIdAccept403JSONResponse) VisitPatchModelOrdersIdExtensionsExtensionIdAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdAccept404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdExtensionsExtensionIdAccept404JSONResponse) VisitPatchModelOrdersIdExtensionsExtensionIdAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdAccept409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdExtensionsExtensionIdAccept409JSONResponse) VisitPatchModelOrdersIdExtensionsExtensionIdAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdRejectRequestObject struct {
+	Id          int64 `json:"id"`
+	ExtensionId int64 `json:"extensionId"`
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdRejectResponseObject interface {
+	VisitPatchModelOrdersIdExtensionsExtensionIdRejectResponse(w http.ResponseWriter) error
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdReject200JSONResponse externalRef0.OrderExtensionResponse
+
+func (response PatchModelOrdersIdExtensionsExtensionIdReject200JSONResponse) VisitPatchModelOrdersIdExtensionsExtensionIdRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdReject401JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdExtensionsExtensionIdReject401JSONResponse) VisitPatchModelOrdersIdExtensionsExtensionIdRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdReject403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdExtensionsExtensionIdReject403JSONResponse) VisitPatchModelOrdersIdExtensionsExtensionIdRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdReject404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdExtensionsExtensionIdReject404JSONResponse) VisitPatchModelOrdersIdExtensionsExtensionIdRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelOrdersIdExtensionsExtensionIdReject409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelOrdersIdExtensionsExtensionIdReject409JSONResponse) VisitPatchModelOrdersIdExtensionsExtensionIdRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelOrdersIdLocationRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PostModelOrdersIdLocationJSONRequestBody
+}
+
+type PostModelOrdersIdLocationResponseObject interface {
+	VisitPostModelOrdersIdLocationResponse(w http.ResponseWriter) error
+}
+
+type PostModelOrdersIdLocation200JSONResponse externalRef0.OrderEventResponse
+
+func (response PostModelOrdersIdLocation200JSONResponse) VisitPostModelOrdersIdLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelOrdersIdLocation400JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelOrdersIdLocation400JSONResponse) VisitPostModelOrdersIdLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelOrdersIdLocation401JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelOrdersIdLocation401JSONResponse) VisitPostModelOrdersIdLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelOrdersIdLocation403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelOrdersIdLocation403JSONResponse) VisitPostModelOrdersIdLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelOrdersIdLocation404JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelOrdersIdLocation404JSONResponse) VisitPostModelOrdersIdLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelOrdersIdLocation409JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelOrdersIdLocation409JSONResponse) VisitPostModelOrdersIdLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelPricingRulesRequestObject struct {
+	Params GetModelPricingRulesParams
+}
+
+type GetModelPricingRulesResponseObject interface {
+	VisitGetModelPricingRulesResponse(w http.ResponseWriter) error
+}
+
+type GetModelPricingRules200JSONResponse []externalRef0.PricingRuleResponse
+
+func (response GetModelPricingRules200JSONResponse) VisitGetModelPricingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelPricingRules403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelPricingRules403JSONResponse) VisitGetModelPricingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelPricingRulesRequestObject struct {
+	Body *PostModelPricingRulesJSONRequestBody
+}
+
+type PostModelPricingRulesResponseObject interface {
+	VisitPostModelPricingRulesResponse(w http.ResponseWriter) error
+}
+
+type PostModelPricingRules201JSONResponse externalRef0.PricingRuleResponse
+
+func (response PostModelPricingRules201JSONResponse) VisitPostModelPricingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelPricingRules400JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelPricingRules400JSONResponse) VisitPostModelPricingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelPricingRules403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelPricingRules403JSONResponse) VisitPostModelPricingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteModelPricingRulesIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteModelPricingRulesIdResponseObject interface {
+	VisitDeleteModelPricingRulesIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteModelPricingRulesId204Response struct {
+}
+
+func (response DeleteModelPricingRulesId204Response) VisitDeleteModelPricingRulesIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteModelPricingRulesId403JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteModelPricingRulesId403JSONResponse) VisitDeleteModelPricingRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteModelPricingRulesId404JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteModelPricingRulesId404JSONResponse) VisitDeleteModelPricingRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelPricingRulesIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetModelPricingRulesIdResponseObject interface {
+	VisitGetModelPricingRulesIdResponse(w http.ResponseWriter) error
+}
+
+type GetModelPricingRulesId200JSONResponse externalRef0.PricingRuleResponse
+
+func (response GetModelPricingRulesId200JSONResponse) VisitGetModelPricingRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelPricingRulesId403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelPricingRulesId403JSONResponse) VisitGetModelPricingRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelPricingRulesId404JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelPricingRulesId404JSONResponse) VisitGetModelPricingRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelPricingRulesIdRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PutModelPricingRulesIdJSONRequestBody
+}
+
+type PutModelPricingRulesIdResponseObject interface {
+	VisitPutModelPricingRulesIdResponse(w http.ResponseWriter) error
+}
+
+type PutModelPricingRulesId200JSONResponse externalRef0.PricingRuleResponse
+
+func (response PutModelPricingRulesId200JSONResponse) VisitPutModelPricingRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelPricingRulesId400JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelPricingRulesId400JSONResponse) VisitPutModelPricingRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelPricingRulesId403JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelPricingRulesId403JSONResponse) VisitPutModelPricingRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelPricingRulesId404JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelPricingRulesId404JSONResponse) VisitPutModelPricingRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelServicesRequestObject struct {
+	Params GetModelServicesParams
+}
+
+type GetModelServicesResponseObject interface {
+	VisitGetModelServicesResponse(w http.ResponseWriter) error
+}
+
+type GetModelServices200JSONResponse []externalRef0.ModelServiceResponse
+
+func (response GetModelServices200JSONResponse) VisitGetModelServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelServices400JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelServices400JSONResponse) VisitGetModelServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelServices401JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelServices401JSONResponse) VisitGetModelServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelServices500Response struct {
+}
+
+func (response GetModelServices500Response) VisitGetModelServicesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostModelServicesRequestObject struct {
+	Body *PostModelServicesJSONRequestBody
+}
+
+type PostModelServicesResponseObject interface {
+	VisitPostModelServicesResponse(w http.ResponseWriter) error
+}
+
+type PostModelServices201JSONResponse externalRef0.ModelServiceResponse
+
+func (response PostModelServices201JSONResponse) VisitPostModelServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelServices400JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelServices400JSONResponse) VisitPostModelServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelServices401JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelServices401JSONResponse) VisitPostModelServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelServices422JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelServices422JSONResponse) VisitPostModelServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelServices500Response struct {
+}
+
+func (response PostModelServices500Response) VisitPostModelServicesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PatchModelServicesIdRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PatchModelServicesIdJSONRequestBody
+}
+
+type PatchModelServicesIdResponseObject interface {
+	VisitPatchModelServicesIdResponse(w http.ResponseWriter) error
+}
+
+type PatchModelServicesId200JSONResponse externalRef0.ModelServiceResponse
+
+func (response PatchModelServicesId200JSONResponse) VisitPatchModelServicesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelServicesId400JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelServicesId400JSONResponse) VisitPatchModelServicesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelServicesId401JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelServicesId401JSONResponse) VisitPatchModelServicesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelServicesId403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelServicesId403JSONResponse) VisitPatchModelServicesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelServicesId409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelServicesId409JSONResponse) VisitPatchModelServicesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelServicesId500Response struct {
+}
+
+func (response PatchModelServicesId500Response) VisitPatchModelServicesIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetModelServicesIdAddOnsRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetModelServicesIdAddOnsResponseObject interface {
+	VisitGetModelServicesIdAddOnsResponse(w http.ResponseWriter) error
+}
+
+type GetModelServicesIdAddOns200JSONResponse []externalRef0.AddOnResponse
+
+func (response GetModelServicesIdAddOns200JSONResponse) VisitGetModelServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelServicesIdAddOns403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelServicesIdAddOns403JSONResponse) VisitGetModelServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelServicesIdAddOns404JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelServicesIdAddOns404JSONResponse) VisitGetModelServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelServicesIdAddOns500Response struct {
+}
+
+func (response GetModelServicesIdAddOns500Response) VisitGetModelServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostModelServicesIdAddOnsRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *PostModelServicesIdAddOnsJSONRequestBody
+}
+
+type PostModelServicesIdAddOnsResponseObject interface {
+	VisitPostModelServicesIdAddOnsResponse(w http.ResponseWriter) error
+}
+
+type PostModelServicesIdAddOns201JSONResponse externalRef0.AddOnResponse
+
+func (response PostModelServicesIdAddOns201JSONResponse) VisitPostModelServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelServicesIdAddOns400JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelServicesIdAddOns400JSONResponse) VisitPostModelServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelServicesIdAddOns403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelServicesIdAddOns403JSONResponse) VisitPostModelServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelServicesIdAddOns404JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelServicesIdAddOns404JSONResponse) VisitPostModelServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelServicesIdAddOns409JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelServicesIdAddOns409JSONResponse) VisitPostModelServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelServicesIdAddOns500Response struct {
+}
+
+func (response PostModelServicesIdAddOns500Response) VisitPostModelServicesIdAddOnsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PatchModelServicesIdDeactivateRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type PatchModelServicesIdDeactivateResponseObject interface {
+	VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PatchModelServicesIdDeactivate200JSONResponse externalRef0.StatusResponse
+
+func (response PatchModelServicesIdDeactivate200JSONResponse) VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelServicesIdDeactivate400JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelServicesIdDeactivate400JSONResponse) VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelServicesIdDeactivate401JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelServicesIdDeactivate401JSONResponse) VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelServicesIdDeactivate403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelServicesIdDeactivate403JSONResponse) VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelServicesIdDeactivate409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelServicesIdDeactivate409JSONResponse) VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelServicesIdDeactivate500Response struct {
+}
+
+func (response PatchModelServicesIdDeactivate500Response) VisitPatchModelServicesIdDeactivateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetModelSlotsRequestObject struct {
+	Params GetModelSlotsParams
+}
+
+type GetModelSlotsResponseObject interface {
+	VisitGetModelSlotsResponse(w http.ResponseWriter) error
+}
+
+type GetModelSlots200JSONResponse []externalRef0.SlotResponse
+
+func (response GetModelSlots200JSONResponse) VisitGetModelSlotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelSlots400JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelSlots400JSONResponse) VisitGetModelSlotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelSlots403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelSlots403JSONResponse) VisitGetModelSlotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsRequestObject struct {
+	Body *PostModelSlotsJSONRequestBody
+}
+
+type PostModelSlotsResponseObject interface {
+	VisitPostModelSlotsResponse(w http.ResponseWriter) error
+}
+
+type PostModelSlots201JSONResponse externalRef0.SlotResponse
+
+func (response PostModelSlots201JSONResponse) VisitPostModelSlotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlots403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelSlots403JSONResponse) VisitPostModelSlotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlots409JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelSlots409JSONResponse) VisitPostModelSlotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsBatchRequestObject struct {
+	Body *PostModelSlotsBatchJSONRequestBody
+}
+
+type PostModelSlotsBatchResponseObject interface {
+	VisitPostModelSlotsBatchResponse(w http.ResponseWriter) error
+}
+
+type PostModelSlotsBatch201JSONResponse []externalRef0.SlotResponse
+
+func (response PostModelSlotsBatch201JSONResponse) VisitPostModelSlotsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsBatch400JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelSlotsBatch400JSONResponse) VisitPostModelSlotsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsBatch403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelSlotsBatch403JSONResponse) VisitPostModelSlotsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsBatch409JSONResponse externalRef0.SlotBatchErrorResponse
+
+func (response PostModelSlotsBatch409JSONResponse) VisitPostModelSlotsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelSlotsDisableRangeRequestObject struct {
+	Body *PatchModelSlotsDisableRangeJSONRequestBody
+}
+
+type PatchModelSlotsDisableRangeResponseObject interface {
+	VisitPatchModelSlotsDisableRangeResponse(w http.ResponseWriter) error
+}
+
+type PatchModelSlotsDisableRange200JSONResponse externalRef0.SlotRangeDisableResponse
+
+func (response PatchModelSlotsDisableRange200JSONResponse) VisitPatchModelSlotsDisableRangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelSlotsDisableRange400JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelSlotsDisableRange400JSONResponse) VisitPatchModelSlotsDisableRangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelSlotsDisableRange403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelSlotsDisableRange403JSONResponse) VisitPatchModelSlotsDisableRangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsMergeRequestObject struct {
+	Body *PostModelSlotsMergeJSONRequestBody
+}
+
+type PostModelSlotsMergeResponseObject interface {
+	VisitPostModelSlotsMergeResponse(w http.ResponseWriter) error
+}
+
+type PostModelSlotsMerge200JSONResponse externalRef0.SlotResponse
+
+func (response PostModelSlotsMerge200JSONResponse) VisitPostModelSlotsMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsMerge400JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelSlotsMerge400JSONResponse) VisitPostModelSlotsMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsMerge403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelSlotsMerge403JSONResponse) VisitPostModelSlotsMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsMerge404JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelSlotsMerge404JSONResponse) VisitPostModelSlotsMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsMerge409JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelSlotsMerge409JSONResponse) VisitPostModelSlotsMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelSlotsSlotIdRequestObject struct {
+	SlotId int64 `json:"slotId"`
+	Body   *PatchModelSlotsSlotIdJSONRequestBody
+}
+
+type PatchModelSlotsSlotIdResponseObject interface {
+	VisitPatchModelSlotsSlotIdResponse(w http.ResponseWriter) error
+}
+
+type PatchModelSlotsSlotId200JSONResponse externalRef0.SlotResponse
+
+func (response PatchModelSlotsSlotId200JSONResponse) VisitPatchModelSlotsSlotIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelSlotsSlotId403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelSlotsSlotId403JSONResponse) VisitPatchModelSlotsSlotIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelSlotsSlotId409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelSlotsSlotId409JSONResponse) VisitPatchModelSlotsSlotIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelSlotsSlotIdDisableRequestObject struct {
+	SlotId int64 `json:"slotId"`
+}
+
+type PatchModelSlotsSlotIdDisableResponseObject interface {
+	VisitPatchModelSlotsSlotIdDisableResponse(w http.ResponseWriter) error
+}
+
+type PatchModelSlotsSlotIdDisable200JSONResponse externalRef0.SlotResponse
+
+func (response PatchModelSlotsSlotIdDisable200JSONResponse) VisitPatchModelSlotsSlotIdDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelSlotsSlotIdDisable403JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelSlotsSlotIdDisable403JSONResponse) VisitPatchModelSlotsSlotIdDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchModelSlotsSlotIdDisable409JSONResponse externalRef0.ErrorResponse
+
+func (response PatchModelSlotsSlotIdDisable409JSONResponse) VisitPatchModelSlotsSlotIdDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsSlotIdSplitRequestObject struct {
+	SlotId int64 `json:"slotId"`
+	Body   *PostModelSlotsSlotIdSplitJSONRequestBody
+}
+
+type PostModelSlotsSlotIdSplitResponseObject interface {
+	VisitPostModelSlotsSlotIdSplitResponse(w http.ResponseWriter) error
+}
+
+type PostModelSlotsSlotIdSplit200JSONResponse []externalRef0.SlotResponse
+
+func (response PostModelSlotsSlotIdSplit200JSONResponse) VisitPostModelSlotsSlotIdSplitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsSlotIdSplit400JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelSlotsSlotIdSplit400JSONResponse) VisitPostModelSlotsSlotIdSplitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsSlotIdSplit403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelSlotsSlotIdSplit403JSONResponse) VisitPostModelSlotsSlotIdSplitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsSlotIdSplit404JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelSlotsSlotIdSplit404JSONResponse) VisitPostModelSlotsSlotIdSplitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelSlotsSlotIdSplit409JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelSlotsSlotIdSplit409JSONResponse) VisitPostModelSlotsSlotIdSplitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelTimeOffsRequestObject struct {
+}
+
+type GetModelTimeOffsResponseObject interface {
+	VisitGetModelTimeOffsResponse(w http.ResponseWriter) error
+}
+
+type GetModelTimeOffs200JSONResponse []externalRef0.TimeOffResponse
+
+func (response GetModelTimeOffs200JSONResponse) VisitGetModelTimeOffsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelTimeOffs401JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelTimeOffs401JSONResponse) VisitGetModelTimeOffsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelTimeOffs403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelTimeOffs403JSONResponse) VisitGetModelTimeOffsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelTimeOffsRequestObject struct {
+	Body *PostModelTimeOffsJSONRequestBody
+}
+
+type PostModelTimeOffsResponseObject interface {
+	VisitPostModelTimeOffsResponse(w http.ResponseWriter) error
+}
+
+type PostModelTimeOffs201JSONResponse externalRef0.TimeOffChangeResponse
+
+func (response PostModelTimeOffs201JSONResponse) VisitPostModelTimeOffsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelTimeOffs400JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelTimeOffs400JSONResponse) VisitPostModelTimeOffsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelTimeOffs401JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelTimeOffs401JSONResponse) VisitPostModelTimeOffsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelTimeOffs403JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelTimeOffs403JSONResponse) VisitPostModelTimeOffsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModelTimeOffs409JSONResponse externalRef0.ErrorResponse
+
+func (response PostModelTimeOffs409JSONResponse) VisitPostModelTimeOffsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteModelTimeOffsIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteModelTimeOffsIdResponseObject interface {
+	VisitDeleteModelTimeOffsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteModelTimeOffsId200JSONResponse externalRef0.TimeOffChangeResponse
+
+func (response DeleteModelTimeOffsId200JSONResponse) VisitDeleteModelTimeOffsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteModelTimeOffsId401JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteModelTimeOffsId401JSONResponse) VisitDeleteModelTimeOffsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteModelTimeOffsId403JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteModelTimeOffsId403JSONResponse) VisitDeleteModelTimeOffsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteModelTimeOffsId404JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteModelTimeOffsId404JSONResponse) VisitDeleteModelTimeOffsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelTravelBufferRequestObject struct {
+}
+
+type GetModelTravelBufferResponseObject interface {
+	VisitGetModelTravelBufferResponse(w http.ResponseWriter) error
+}
+
+type GetModelTravelBuffer200JSONResponse externalRef0.TravelBufferResponse
+
+func (response GetModelTravelBuffer200JSONResponse) VisitGetModelTravelBufferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelTravelBuffer401JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelTravelBuffer401JSONResponse) VisitGetModelTravelBufferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelTravelBuffer403JSONResponse externalRef0.ErrorResponse
+
+func (response GetModelTravelBuffer403JSONResponse) VisitGetModelTravelBufferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelTravelBufferRequestObject struct {
+	Body *PutModelTravelBufferJSONRequestBody
+}
+
+type PutModelTravelBufferResponseObject interface {
+	VisitPutModelTravelBufferResponse(w http.ResponseWriter) error
+}
+
+type PutModelTravelBuffer200JSONResponse externalRef0.TravelBufferResponse
+
+func (response PutModelTravelBuffer200JSONResponse) VisitPutModelTravelBufferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelTravelBuffer400JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelTravelBuffer400JSONResponse) VisitPutModelTravelBufferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelTravelBuffer401JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelTravelBuffer401JSONResponse) VisitPutModelTravelBufferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutModelTravelBuffer403JSONResponse externalRef0.ErrorResponse
+
+func (response PutModelTravelBuffer403JSONResponse) VisitPutModelTravelBufferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersRequestObject struct {
+	Body *PostUsersJSONRequestBody
+}
+
+type PostUsersResponseObject interface {
+	VisitPostUsersResponse(w http.ResponseWriter) error
+}
+
+type PostUsers201JSONResponse externalRef0.UserResponse
+
+func (response PostUsers201JSONResponse) VisitPostUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsers400JSONResponse externalRef0.ErrorResponse
+
+func (response PostUsers400JSONResponse) VisitPostUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsers401JSONResponse externalRef0.ErrorResponse
+
+func (response PostUsers401JSONResponse) VisitPostUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsers422JSONResponse externalRef0.ErrorResponse
+
+func (response PostUsers422JSONResponse) VisitPostUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsers500Response struct {
+}
+
+func (response PostUsers500Response) VisitPostUsersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetUsersMeRequestObject struct {
+}
+
+type GetUsersMeResponseObject interface {
+	VisitGetUsersMeResponse(w http.ResponseWriter) error
+}
+
+type GetUsersMe200JSONResponse externalRef0.UserResponse
+
+func (response GetUsersMe200JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMe401JSONResponse externalRef0.ErrorResponse
+
+func (response GetUsersMe401JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMe404JSONResponse externalRef0.ErrorResponse
+
+func (response GetUsersMe404JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMe500Response struct {
+}
+
+func (response GetUsersMe500Response) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PatchUsersMeRequestObject struct {
+	Body *PatchUsersMeJSONRequestBody
+}
+
+type PatchUsersMeResponseObject interface {
+	VisitPatchUsersMeResponse(w http.ResponseWriter) error
+}
+
+type PatchUsersMe200JSONResponse externalRef0.UserResponse
+
+func (response PatchUsersMe200JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMe400JSONResponse externalRef0.ErrorResponse
+
+func (response PatchUsersMe400JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMe401JSONResponse externalRef0.ErrorResponse
+
+func (response PatchUsersMe401JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMe404JSONResponse externalRef0.ErrorResponse
+
+func (response PatchUsersMe404JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMe422JSONResponse externalRef0.ErrorResponse
+
+func (response PatchUsersMe422JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMe500Response struct {
+}
+
+func (response PatchUsersMe500Response) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetUsersIdRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetUsersIdResponseObject interface {
+	VisitGetUsersIdResponse(w http.ResponseWriter) error
+}
+
+type GetUsersId200JSONResponse externalRef0.AnotherUserResponse
+
+func (response GetUsersId200JSONResponse) VisitGetUsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersId401JSONResponse externalRef0.ErrorResponse
+
+func (response GetUsersId401JSONResponse) VisitGetUsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersId404JSONResponse externalRef0.ErrorResponse
+
+func (response GetUsersId404JSONResponse) VisitGetUsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersId500Response struct {
+}
+
+func (response GetUsersId500Response) VisitGetUsersIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
 	// Admin can create a new admin with permissions
 	// (POST /admin)
 	PostAdmin(ctx context.Context, request PostAdminRequestObject) (PostAdminResponseObject, error)
@@ -2796,15 +8804,51 @@ type StrictServerInterface interface {
 	// Admin can update booking status (including cancellation <24h)
 	// (PATCH /admin/bookings/{id}/status)
 	PatchAdminBookingsIdStatus(ctx context.Context, request PatchAdminBookingsIdStatusRequestObject) (PatchAdminBookingsIdStatusResponseObject, error)
+	// Admin gets all disputes
+	// (GET /admin/disputes)
+	GetAdminDisputes(ctx context.Context, request GetAdminDisputesRequestObject) (GetAdminDisputesResponseObject, error)
+	// Admin gets dispute by id
+	// (GET /admin/disputes/{id})
+	GetAdminDisputesId(ctx context.Context, request GetAdminDisputesIdRequestObject) (GetAdminDisputesIdResponseObject, error)
+	// Admin assigns the dispute to himself or to another admin
+	// (PATCH /admin/disputes/{id}/assign)
+	PatchAdminDisputesIdAssign(ctx context.Context, request PatchAdminDisputesIdAssignRequestObject) (PatchAdminDisputesIdAssignResponseObject, error)
+	// Admin gets the dispute message thread
+	// (GET /admin/disputes/{id}/messages)
+	GetAdminDisputesIdMessages(ctx context.Context, request GetAdminDisputesIdMessagesRequestObject) (GetAdminDisputesIdMessagesResponseObject, error)
+	// Admin writes to the dispute message thread
+	// (POST /admin/disputes/{id}/messages)
+	PostAdminDisputesIdMessages(ctx context.Context, request PostAdminDisputesIdMessagesRequestObject) (PostAdminDisputesIdMessagesResponseObject, error)
+	// Admin resolves the dispute, the resolution moves the order to its final status
+	// (PATCH /admin/disputes/{id}/resolve)
+	PatchAdminDisputesIdResolve(ctx context.Context, request PatchAdminDisputesIdResolveRequestObject) (PatchAdminDisputesIdResolveResponseObject, error)
+	// Admin gets the ledger trial balance
+	// (GET /admin/ledger/trial-balance)
+	GetAdminLedgerTrialBalance(ctx context.Context, request GetAdminLedgerTrialBalanceRequestObject) (GetAdminLedgerTrialBalanceResponseObject, error)
 	// Admin gets all orders
 	// (GET /admin/orders)
 	GetAdminOrders(ctx context.Context, request GetAdminOrdersRequestObject) (GetAdminOrdersResponseObject, error)
 	// Admin gets order by id
 	// (GET /admin/orders/{id})
 	GetAdminOrdersId(ctx context.Context, request GetAdminOrdersIdRequestObject) (GetAdminOrdersIdResponseObject, error)
+	// Admin gets the payment of the order
+	// (GET /admin/orders/{id}/payment)
+	GetAdminOrdersIdPayment(ctx context.Context, request GetAdminOrdersIdPaymentRequestObject) (GetAdminOrdersIdPaymentResponseObject, error)
 	// Admin can update order status (including cancellation <24h)
 	// (PATCH /admin/orders/{id}/status)
 	PatchAdminOrdersIdStatus(ctx context.Context, request PatchAdminOrdersIdStatusRequestObject) (PatchAdminOrdersIdStatusResponseObject, error)
+	// Admin gets all promo codes
+	// (GET /admin/promo-codes)
+	GetAdminPromoCodes(ctx context.Context, request GetAdminPromoCodesRequestObject) (GetAdminPromoCodesResponseObject, error)
+	// Admin creates a promo code
+	// (POST /admin/promo-codes)
+	PostAdminPromoCodes(ctx context.Context, request PostAdminPromoCodesRequestObject) (PostAdminPromoCodesResponseObject, error)
+	// Admin gets promo code by id
+	// (GET /admin/promo-codes/{id})
+	GetAdminPromoCodesId(ctx context.Context, request GetAdminPromoCodesIdRequestObject) (GetAdminPromoCodesIdResponseObject, error)
+	// Admin deactivates promo code, bookings already made keep their discount
+	// (PATCH /admin/promo-codes/{id}/deactivate)
+	PatchAdminPromoCodesIdDeactivate(ctx context.Context, request PatchAdminPromoCodesIdDeactivateRequestObject) (PatchAdminPromoCodesIdDeactivateResponseObject, error)
 	// Admin gets all users with full personal information
 	// (GET /admin/users)
 	GetAdminUsers(ctx context.Context, request GetAdminUsersRequestObject) (GetAdminUsersResponseObject, error)
@@ -2817,39 +8861,180 @@ type StrictServerInterface interface {
 	// Admin can update another admin permissions
 	// (PATCH /admin/{id})
 	PatchAdminId(ctx context.Context, request PatchAdminIdRequestObject) (PatchAdminIdResponseObject, error)
+	// Client finds the models free in a time window with their available slots and matching active services
+	// (GET /client/availability)
+	GetClientAvailability(ctx context.Context, request GetClientAvailabilityRequestObject) (GetClientAvailabilityResponseObject, error)
 	// Client creates booking - books a slot
 	// (POST /client/bookings)
 	PostClientBookings(ctx context.Context, request PostClientBookingsRequestObject) (PostClientBookingsResponseObject, error)
+	// Client gets the itemized price of a booking - nothing is reserved
+	// (POST /client/bookings/quote)
+	PostClientBookingsQuote(ctx context.Context, request PostClientBookingsQuoteRequestObject) (PostClientBookingsQuoteResponseObject, error)
 	// Client cancels a booking - only their own Pending booking
 	// (PATCH /client/bookings/{id}/cancel)
 	PatchClientBookingsIdCancel(ctx context.Context, request PatchClientBookingsIdCancelRequestObject) (PatchClientBookingsIdCancelResponseObject, error)
-	// Client can get available slots of a given model. Disabled slots are filtered out.
+	// Client gets the secret iCalendar feed of upcoming orders, it is created on the first request
+	// (GET /client/calendar-feed)
+	GetClientCalendarFeed(ctx context.Context, request GetClientCalendarFeedRequestObject) (GetClientCalendarFeedResponseObject, error)
+	// Client replaces the token of the calendar feed, the old feed URL stops working
+	// (POST /client/calendar-feed/regenerate)
+	PostClientCalendarFeedRegenerate(ctx context.Context, request PostClientCalendarFeedRegenerateRequestObject) (PostClientCalendarFeedRegenerateResponseObject, error)
+	// Client gets own dispute by id
+	// (GET /client/disputes/{id})
+	GetClientDisputesId(ctx context.Context, request GetClientDisputesIdRequestObject) (GetClientDisputesIdResponseObject, error)
+	// Client gets the dispute message thread
+	// (GET /client/disputes/{id}/messages)
+	GetClientDisputesIdMessages(ctx context.Context, request GetClientDisputesIdMessagesRequestObject) (GetClientDisputesIdMessagesResponseObject, error)
+	// Client writes to the dispute message thread
+	// (POST /client/disputes/{id}/messages)
+	PostClientDisputesIdMessages(ctx context.Context, request PostClientDisputesIdMessagesRequestObject) (PostClientDisputesIdMessagesResponseObject, error)
+	// Client can get slots of a given model by start time. Disabled slots are never shown.
 	// (GET /client/models/{modelId}/slots)
 	GetClientModelsModelIdSlots(ctx context.Context, request GetClientModelsModelIdSlotsRequestObject) (GetClientModelsModelIdSlotsResponseObject, error)
 	// Client can cancel their order
 	// (PATCH /client/orders/{id}/cancel)
 	PatchClientOrdersIdCancel(ctx context.Context, request PatchClientOrdersIdCancelRequestObject) (PatchClientOrdersIdCancelResponseObject, error)
+	// Client confirms the order completed by the model, its payment is captured then
+	// (PATCH /client/orders/{id}/confirm)
+	PatchClientOrdersIdConfirm(ctx context.Context, request PatchClientOrdersIdConfirmRequestObject) (PatchClientOrdersIdConfirmResponseObject, error)
+	// Client opens a dispute on the order within the window after the slot ends
+	// (POST /client/orders/{id}/disputes)
+	PostClientOrdersIdDisputes(ctx context.Context, request PostClientOrdersIdDisputesRequestObject) (PostClientOrdersIdDisputesResponseObject, error)
+	// Client follows live status changes, ETA and location of their order (Server-Sent Events)
+	// (GET /client/orders/{id}/events)
+	GetClientOrdersIdEvents(ctx context.Context, request GetClientOrdersIdEventsRequestObject) (GetClientOrdersIdEventsResponseObject, error)
+	// Client gets extensions requested for the order
+	// (GET /client/orders/{id}/extensions)
+	GetClientOrdersIdExtensions(ctx context.Context, request GetClientOrdersIdExtensionsRequestObject) (GetClientOrdersIdExtensionsResponseObject, error)
+	// Client requests to extend the order by several minutes
+	// (POST /client/orders/{id}/extensions)
+	PostClientOrdersIdExtensions(ctx context.Context, request PostClientOrdersIdExtensionsRequestObject) (PostClientOrdersIdExtensionsResponseObject, error)
+	// Client raises an issue instead of confirming the order, it opens a dispute case with the reason
+	// (PATCH /client/orders/{id}/issue)
+	PatchClientOrdersIdIssue(ctx context.Context, request PatchClientOrdersIdIssueRequestObject) (PatchClientOrdersIdIssueResponseObject, error)
+	// Client gets the receipt of a completed order, it is issued when the payment is captured
+	// (GET /client/orders/{id}/receipt)
+	GetClientOrdersIdReceipt(ctx context.Context, request GetClientOrdersIdReceiptRequestObject) (GetClientOrdersIdReceiptResponseObject, error)
 	// Client gets all active services with pagination
 	// (GET /client/services)
 	GetClientServices(ctx context.Context, request GetClientServicesRequestObject) (GetClientServicesResponseObject, error)
 	// Client gets service by id
 	// (GET /client/services/{id})
 	GetClientServicesId(ctx context.Context, request GetClientServicesIdRequestObject) (GetClientServicesIdResponseObject, error)
+	// Client gets active add-ons of the service
+	// (GET /client/services/{id}/add-ons)
+	GetClientServicesIdAddOns(ctx context.Context, request GetClientServicesIdAddOnsRequestObject) (GetClientServicesIdAddOnsResponseObject, error)
+	// Model updates their add-on, existing bookings keep the chosen add-ons as they were
+	// (PATCH /model/add-ons/{id})
+	PatchModelAddOnsId(ctx context.Context, request PatchModelAddOnsIdRequestObject) (PatchModelAddOnsIdResponseObject, error)
+	// Model deactivates their add-on
+	// (PATCH /model/add-ons/{id}/deactivate)
+	PatchModelAddOnsIdDeactivate(ctx context.Context, request PatchModelAddOnsIdDeactivateRequestObject) (PatchModelAddOnsIdDeactivateResponseObject, error)
+	// Model gets their availability templates
+	// (GET /model/availability-templates)
+	GetModelAvailabilityTemplates(ctx context.Context, request GetModelAvailabilityTemplatesRequestObject) (GetModelAvailabilityTemplatesResponseObject, error)
+	// Model creates a draft availability template, slots are generated once it is published
+	// (POST /model/availability-templates)
+	PostModelAvailabilityTemplates(ctx context.Context, request PostModelAvailabilityTemplatesRequestObject) (PostModelAvailabilityTemplatesResponseObject, error)
+	// Model gets their availability template by id
+	// (GET /model/availability-templates/{id})
+	GetModelAvailabilityTemplatesId(ctx context.Context, request GetModelAvailabilityTemplatesIdRequestObject) (GetModelAvailabilityTemplatesIdResponseObject, error)
+	// Model replaces their availability template, the slots of a published one are generated anew
+	// (PUT /model/availability-templates/{id})
+	PutModelAvailabilityTemplatesId(ctx context.Context, request PutModelAvailabilityTemplatesIdRequestObject) (PutModelAvailabilityTemplatesIdResponseObject, error)
+	// Model previews the slots the template would generate if it were published now
+	// (GET /model/availability-templates/{id}/preview)
+	GetModelAvailabilityTemplatesIdPreview(ctx context.Context, request GetModelAvailabilityTemplatesIdPreviewRequestObject) (GetModelAvailabilityTemplatesIdPreviewResponseObject, error)
+	// Model publishes the availability template, its slots are generated ahead from now on
+	// (POST /model/availability-templates/{id}/publish)
+	PostModelAvailabilityTemplatesIdPublish(ctx context.Context, request PostModelAvailabilityTemplatesIdPublishRequestObject) (PostModelAvailabilityTemplatesIdPublishResponseObject, error)
+	// Model stops the availability template, its available slots are taken back
+	// (POST /model/availability-templates/{id}/unpublish)
+	PostModelAvailabilityTemplatesIdUnpublish(ctx context.Context, request PostModelAvailabilityTemplatesIdUnpublishRequestObject) (PostModelAvailabilityTemplatesIdUnpublishResponseObject, error)
+	// Model gets the notice, horizon and slot duration rules for their slots
+	// (GET /model/booking-rules)
+	GetModelBookingRules(ctx context.Context, request GetModelBookingRulesRequestObject) (GetModelBookingRulesResponseObject, error)
+	// Model tightens the platform booking rules, existing slots and bookings are left as they are
+	// (PUT /model/booking-rules)
+	PutModelBookingRules(ctx context.Context, request PutModelBookingRulesRequestObject) (PutModelBookingRulesResponseObject, error)
 	// Model approves a booking - a Pending booking if they own the service
 	// (PATCH /model/bookings/{id}/approve)
 	PatchModelBookingsIdApprove(ctx context.Context, request PatchModelBookingsIdApproveRequestObject) (PatchModelBookingsIdApproveResponseObject, error)
 	// Model rejects a booking - a Pending booking if they own the service
 	// (PATCH /model/bookings/{id}/reject)
 	PatchModelBookingsIdReject(ctx context.Context, request PatchModelBookingsIdRejectRequestObject) (PatchModelBookingsIdRejectResponseObject, error)
+	// Model gets the outside calendars whose busy events block the slots
+	// (GET /model/busy-calendars)
+	GetModelBusyCalendars(ctx context.Context, request GetModelBusyCalendarsRequestObject) (GetModelBusyCalendarsResponseObject, error)
+	// Model adds an outside calendar, a calendar with URL is fetched at once and then regularly
+	// (POST /model/busy-calendars)
+	PostModelBusyCalendars(ctx context.Context, request PostModelBusyCalendarsRequestObject) (PostModelBusyCalendarsResponseObject, error)
+	// Model removes the outside calendar, the slots blocked only by it become available again
+	// (DELETE /model/busy-calendars/{id})
+	DeleteModelBusyCalendarsId(ctx context.Context, request DeleteModelBusyCalendarsIdRequestObject) (DeleteModelBusyCalendarsIdResponseObject, error)
+	// Model uploads the .ics file of a calendar without URL, its events replace the uploaded before
+	// (PUT /model/busy-calendars/{id}/events)
+	PutModelBusyCalendarsIdEvents(ctx context.Context, request PutModelBusyCalendarsIdEventsRequestObject) (PutModelBusyCalendarsIdEventsResponseObject, error)
+	// Model fetches the calendar from its URL again and applies its events to the slots
+	// (POST /model/busy-calendars/{id}/sync)
+	PostModelBusyCalendarsIdSync(ctx context.Context, request PostModelBusyCalendarsIdSyncRequestObject) (PostModelBusyCalendarsIdSyncResponseObject, error)
+	// Model gets the secret iCalendar feed of slots and orders, it is created on the first request
+	// (GET /model/calendar-feed)
+	GetModelCalendarFeed(ctx context.Context, request GetModelCalendarFeedRequestObject) (GetModelCalendarFeedResponseObject, error)
+	// Model replaces the token of the calendar feed, the old feed URL stops working
+	// (POST /model/calendar-feed/regenerate)
+	PostModelCalendarFeedRegenerate(ctx context.Context, request PostModelCalendarFeedRegenerateRequestObject) (PostModelCalendarFeedRegenerateResponseObject, error)
+	// Model gets own dispute by id
+	// (GET /model/disputes/{id})
+	GetModelDisputesId(ctx context.Context, request GetModelDisputesIdRequestObject) (GetModelDisputesIdResponseObject, error)
+	// Model gets the dispute message thread
+	// (GET /model/disputes/{id}/messages)
+	GetModelDisputesIdMessages(ctx context.Context, request GetModelDisputesIdMessagesRequestObject) (GetModelDisputesIdMessagesResponseObject, error)
+	// Model writes to the dispute message thread
+	// (POST /model/disputes/{id}/messages)
+	PostModelDisputesIdMessages(ctx context.Context, request PostModelDisputesIdMessagesRequestObject) (PostModelDisputesIdMessagesResponseObject, error)
+	// Model gets the earnings balance and ledger entries
+	// (GET /model/earnings)
+	GetModelEarnings(ctx context.Context, request GetModelEarningsRequestObject) (GetModelEarningsResponseObject, error)
 	// Model gets all their orders
 	// (GET /model/orders)
 	GetModelOrders(ctx context.Context, request GetModelOrdersRequestObject) (GetModelOrdersResponseObject, error)
 	// Model can cancel their order
 	// (PATCH /model/orders/{id}/cancel)
 	PatchModelOrdersIdCancel(ctx context.Context, request PatchModelOrdersIdCancelRequestObject) (PatchModelOrdersIdCancelResponseObject, error)
-	// Model completes their order
+	// Model completes their order, then it waits for the client confirmation
 	// (PATCH /model/orders/{id}/complete)
 	PatchModelOrdersIdComplete(ctx context.Context, request PatchModelOrdersIdCompleteRequestObject) (PatchModelOrdersIdCompleteResponseObject, error)
+	// Model opens a dispute on the order within the window after the slot ends
+	// (POST /model/orders/{id}/disputes)
+	PostModelOrdersIdDisputes(ctx context.Context, request PostModelOrdersIdDisputesRequestObject) (PostModelOrdersIdDisputesResponseObject, error)
+	// Model gets extensions requested for the order
+	// (GET /model/orders/{id}/extensions)
+	GetModelOrdersIdExtensions(ctx context.Context, request GetModelOrdersIdExtensionsRequestObject) (GetModelOrdersIdExtensionsResponseObject, error)
+	// Model accepts the extension, the slot is extended and the extra time is held on the payment pro-rata
+	// (PATCH /model/orders/{id}/extensions/{extensionId}/accept)
+	PatchModelOrdersIdExtensionsExtensionIdAccept(ctx context.Context, request PatchModelOrdersIdExtensionsExtensionIdAcceptRequestObject) (PatchModelOrdersIdExtensionsExtensionIdAcceptResponseObject, error)
+	// Model rejects the extension
+	// (PATCH /model/orders/{id}/extensions/{extensionId}/reject)
+	PatchModelOrdersIdExtensionsExtensionIdReject(ctx context.Context, request PatchModelOrdersIdExtensionsExtensionIdRejectRequestObject) (PatchModelOrdersIdExtensionsExtensionIdRejectResponseObject, error)
+	// Model posts their current location and ETA for the order
+	// (POST /model/orders/{id}/location)
+	PostModelOrdersIdLocation(ctx context.Context, request PostModelOrdersIdLocationRequestObject) (PostModelOrdersIdLocationResponseObject, error)
+	// Model gets their pricing rules
+	// (GET /model/pricing-rules)
+	GetModelPricingRules(ctx context.Context, request GetModelPricingRulesRequestObject) (GetModelPricingRulesResponseObject, error)
+	// Model creates a pricing rule, it applies to the bookings made after it
+	// (POST /model/pricing-rules)
+	PostModelPricingRules(ctx context.Context, request PostModelPricingRulesRequestObject) (PostModelPricingRulesResponseObject, error)
+	// Model deletes their pricing rule, existing bookings keep their price
+	// (DELETE /model/pricing-rules/{id})
+	DeleteModelPricingRulesId(ctx context.Context, request DeleteModelPricingRulesIdRequestObject) (DeleteModelPricingRulesIdResponseObject, error)
+	// Model gets their pricing rule by id
+	// (GET /model/pricing-rules/{id})
+	GetModelPricingRulesId(ctx context.Context, request GetModelPricingRulesIdRequestObject) (GetModelPricingRulesIdResponseObject, error)
+	// Model replaces their pricing rule, existing bookings keep their price
+	// (PUT /model/pricing-rules/{id})
+	PutModelPricingRulesId(ctx context.Context, request PutModelPricingRulesIdRequestObject) (PutModelPricingRulesIdResponseObject, error)
 	// Model gets all their services
 	// (GET /model/services)
 	GetModelServices(ctx context.Context, request GetModelServicesRequestObject) (GetModelServicesResponseObject, error)
@@ -2859,21 +9044,54 @@ type StrictServerInterface interface {
 	// Model updates service by id
 	// (PATCH /model/services/{id})
 	PatchModelServicesId(ctx context.Context, request PatchModelServicesIdRequestObject) (PatchModelServicesIdResponseObject, error)
+	// Model gets all add-ons of their service
+	// (GET /model/services/{id}/add-ons)
+	GetModelServicesIdAddOns(ctx context.Context, request GetModelServicesIdAddOnsRequestObject) (GetModelServicesIdAddOnsResponseObject, error)
+	// Model adds an add-on to their service
+	// (POST /model/services/{id}/add-ons)
+	PostModelServicesIdAddOns(ctx context.Context, request PostModelServicesIdAddOnsRequestObject) (PostModelServicesIdAddOnsResponseObject, error)
 	// Model deactivates service by id
 	// (PATCH /model/services/{id}/deactivate)
 	PatchModelServicesIdDeactivate(ctx context.Context, request PatchModelServicesIdDeactivateRequestObject) (PatchModelServicesIdDeactivateResponseObject, error)
-	// Model can get all of their slots
+	// Model can get their slots by start time
 	// (GET /model/slots)
 	GetModelSlots(ctx context.Context, request GetModelSlotsRequestObject) (GetModelSlotsResponseObject, error)
 	// Model can create a slot for booking
 	// (POST /model/slots)
 	PostModelSlots(ctx context.Context, request PostModelSlotsRequestObject) (PostModelSlotsResponseObject, error)
+	// Model creates many slots at once, either all of them or none
+	// (POST /model/slots/batch)
+	PostModelSlotsBatch(ctx context.Context, request PostModelSlotsBatchRequestObject) (PostModelSlotsBatchResponseObject, error)
+	// Model disables their available slots lying inside the range, the other slots are skipped
+	// (PATCH /model/slots/disable-range)
+	PatchModelSlotsDisableRange(ctx context.Context, request PatchModelSlotsDisableRangeRequestObject) (PatchModelSlotsDisableRangeResponseObject, error)
+	// Model joins their available slots following each other without gaps into the earliest one
+	// (POST /model/slots/merge)
+	PostModelSlotsMerge(ctx context.Context, request PostModelSlotsMergeRequestObject) (PostModelSlotsMergeResponseObject, error)
 	// Model can update start, end of their own slot.
 	// (PATCH /model/slots/{slotId})
 	PatchModelSlotsSlotId(ctx context.Context, request PatchModelSlotsSlotIdRequestObject) (PatchModelSlotsSlotIdResponseObject, error)
 	// Model can deactivate a slot.
 	// (PATCH /model/slots/{slotId}/disable)
 	PatchModelSlotsSlotIdDisable(ctx context.Context, request PatchModelSlotsSlotIdDisableRequestObject) (PatchModelSlotsSlotIdDisableResponseObject, error)
+	// Model cuts their available slot either into pieces of a length or at the given points
+	// (POST /model/slots/{slotId}/split)
+	PostModelSlotsSlotIdSplit(ctx context.Context, request PostModelSlotsSlotIdSplitRequestObject) (PostModelSlotsSlotIdSplitResponseObject, error)
+	// Model gets their time-offs that are not over yet
+	// (GET /model/time-offs)
+	GetModelTimeOffs(ctx context.Context, request GetModelTimeOffsRequestObject) (GetModelTimeOffsResponseObject, error)
+	// Model takes time off, the available slots within it are disabled and no slot can be added there
+	// (POST /model/time-offs)
+	PostModelTimeOffs(ctx context.Context, request PostModelTimeOffsRequestObject) (PostModelTimeOffsResponseObject, error)
+	// Model deletes the time-off, the slots it has disabled become available again
+	// (DELETE /model/time-offs/{id})
+	DeleteModelTimeOffsId(ctx context.Context, request DeleteModelTimeOffsIdRequestObject) (DeleteModelTimeOffsIdResponseObject, error)
+	// Model gets the time kept free before and after every slot and the travel fee
+	// (GET /model/travel-buffer)
+	GetModelTravelBuffer(ctx context.Context, request GetModelTravelBufferRequestObject) (GetModelTravelBufferResponseObject, error)
+	// Model sets the time kept free before and after every slot and the travel fee, existing slots and bookings are left as they are
+	// (PUT /model/travel-buffer)
+	PutModelTravelBuffer(ctx context.Context, request PutModelTravelBufferRequestObject) (PutModelTravelBufferResponseObject, error)
 	// Give client/model more personal info
 	// (POST /users)
 	PostUsers(ctx context.Context, request PostUsersRequestObject) (PostUsersResponseObject, error)
@@ -2888,40 +9106,1871 @@ type StrictServerInterface interface {
 	GetUsersId(ctx context.Context, request GetUsersIdRequestObject) (GetUsersIdResponseObject, error)
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// PostAdmin operation middleware
+func (sh *strictHandler) PostAdmin(w http.ResponseWriter, r *http.Request) {
+	var request PostAdminRequestObject
+
+	var body PostAdminJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdmin(ctx, request.(PostAdminRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdmin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAdminResponseObject); ok {
+		if err := validResponse.VisitPostAdminResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminBookings operation middleware
+func (sh *strictHandler) GetAdminBookings(w http.ResponseWriter, r *http.Request, params GetAdminBookingsParams) {
+	var request GetAdminBookingsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminBookings(ctx, request.(GetAdminBookingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminBookings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminBookingsResponseObject); ok {
+		if err := validResponse.VisitGetAdminBookingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminBookingsId operation middleware
+func (sh *strictHandler) GetAdminBookingsId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetAdminBookingsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminBookingsId(ctx, request.(GetAdminBookingsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminBookingsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminBookingsIdResponseObject); ok {
+		if err := validResponse.VisitGetAdminBookingsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchAdminBookingsIdStatus operation middleware
+func (sh *strictHandler) PatchAdminBookingsIdStatus(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchAdminBookingsIdStatusRequestObject
+
+	request.Id = id
+
+	var body PatchAdminBookingsIdStatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchAdminBookingsIdStatus(ctx, request.(PatchAdminBookingsIdStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchAdminBookingsIdStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchAdminBookingsIdStatusResponseObject); ok {
+		if err := validResponse.VisitPatchAdminBookingsIdStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminDisputes operation middleware
+func (sh *strictHandler) GetAdminDisputes(w http.ResponseWriter, r *http.Request, params GetAdminDisputesParams) {
+	var request GetAdminDisputesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminDisputes(ctx, request.(GetAdminDisputesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminDisputes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminDisputesResponseObject); ok {
+		if err := validResponse.VisitGetAdminDisputesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminDisputesId operation middleware
+func (sh *strictHandler) GetAdminDisputesId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetAdminDisputesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminDisputesId(ctx, request.(GetAdminDisputesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminDisputesId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminDisputesIdResponseObject); ok {
+		if err := validResponse.VisitGetAdminDisputesIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchAdminDisputesIdAssign operation middleware
+func (sh *strictHandler) PatchAdminDisputesIdAssign(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchAdminDisputesIdAssignRequestObject
+
+	request.Id = id
+
+	var body PatchAdminDisputesIdAssignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchAdminDisputesIdAssign(ctx, request.(PatchAdminDisputesIdAssignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchAdminDisputesIdAssign")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchAdminDisputesIdAssignResponseObject); ok {
+		if err := validResponse.VisitPatchAdminDisputesIdAssignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminDisputesIdMessages operation middleware
+func (sh *strictHandler) GetAdminDisputesIdMessages(w http.ResponseWriter, r *http.Request, id int64, params GetAdminDisputesIdMessagesParams) {
+	var request GetAdminDisputesIdMessagesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminDisputesIdMessages(ctx, request.(GetAdminDisputesIdMessagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminDisputesIdMessages")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminDisputesIdMessagesResponseObject); ok {
+		if err := validResponse.VisitGetAdminDisputesIdMessagesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminDisputesIdMessages operation middleware
+func (sh *strictHandler) PostAdminDisputesIdMessages(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PostAdminDisputesIdMessagesRequestObject
+
+	request.Id = id
+
+	var body PostAdminDisputesIdMessagesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminDisputesIdMessages(ctx, request.(PostAdminDisputesIdMessagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminDisputesIdMessages")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAdminDisputesIdMessagesResponseObject); ok {
+		if err := validResponse.VisitPostAdminDisputesIdMessagesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchAdminDisputesIdResolve operation middleware
+func (sh *strictHandler) PatchAdminDisputesIdResolve(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchAdminDisputesIdResolveRequestObject
+
+	request.Id = id
+
+	var body PatchAdminDisputesIdResolveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchAdminDisputesIdResolve(ctx, request.(PatchAdminDisputesIdResolveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchAdminDisputesIdResolve")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchAdminDisputesIdResolveResponseObject); ok {
+		if err := validResponse.VisitPatchAdminDisputesIdResolveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminLedgerTrialBalance operation middleware
+func (sh *strictHandler) GetAdminLedgerTrialBalance(w http.ResponseWriter, r *http.Request) {
+	var request GetAdminLedgerTrialBalanceRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminLedgerTrialBalance(ctx, request.(GetAdminLedgerTrialBalanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminLedgerTrialBalance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminLedgerTrialBalanceResponseObject); ok {
+		if err := validResponse.VisitGetAdminLedgerTrialBalanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminOrders operation middleware
+func (sh *strictHandler) GetAdminOrders(w http.ResponseWriter, r *http.Request, params GetAdminOrdersParams) {
+	var request GetAdminOrdersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminOrders(ctx, request.(GetAdminOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminOrders")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminOrdersResponseObject); ok {
+		if err := validResponse.VisitGetAdminOrdersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminOrdersId operation middleware
+func (sh *strictHandler) GetAdminOrdersId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetAdminOrdersIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminOrdersId(ctx, request.(GetAdminOrdersIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminOrdersId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminOrdersIdResponseObject); ok {
+		if err := validResponse.VisitGetAdminOrdersIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminOrdersIdPayment operation middleware
+func (sh *strictHandler) GetAdminOrdersIdPayment(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetAdminOrdersIdPaymentRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminOrdersIdPayment(ctx, request.(GetAdminOrdersIdPaymentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminOrdersIdPayment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminOrdersIdPaymentResponseObject); ok {
+		if err := validResponse.VisitGetAdminOrdersIdPaymentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchAdminOrdersIdStatus operation middleware
+func (sh *strictHandler) PatchAdminOrdersIdStatus(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchAdminOrdersIdStatusRequestObject
+
+	request.Id = id
+
+	var body PatchAdminOrdersIdStatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchAdminOrdersIdStatus(ctx, request.(PatchAdminOrdersIdStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchAdminOrdersIdStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchAdminOrdersIdStatusResponseObject); ok {
+		if err := validResponse.VisitPatchAdminOrdersIdStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminPromoCodes operation middleware
+func (sh *strictHandler) GetAdminPromoCodes(w http.ResponseWriter, r *http.Request, params GetAdminPromoCodesParams) {
+	var request GetAdminPromoCodesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminPromoCodes(ctx, request.(GetAdminPromoCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminPromoCodes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminPromoCodesResponseObject); ok {
+		if err := validResponse.VisitGetAdminPromoCodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminPromoCodes operation middleware
+func (sh *strictHandler) PostAdminPromoCodes(w http.ResponseWriter, r *http.Request) {
+	var request PostAdminPromoCodesRequestObject
+
+	var body PostAdminPromoCodesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminPromoCodes(ctx, request.(PostAdminPromoCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminPromoCodes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAdminPromoCodesResponseObject); ok {
+		if err := validResponse.VisitPostAdminPromoCodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminPromoCodesId operation middleware
+func (sh *strictHandler) GetAdminPromoCodesId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetAdminPromoCodesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminPromoCodesId(ctx, request.(GetAdminPromoCodesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminPromoCodesId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminPromoCodesIdResponseObject); ok {
+		if err := validResponse.VisitGetAdminPromoCodesIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchAdminPromoCodesIdDeactivate operation middleware
+func (sh *strictHandler) PatchAdminPromoCodesIdDeactivate(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchAdminPromoCodesIdDeactivateRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchAdminPromoCodesIdDeactivate(ctx, request.(PatchAdminPromoCodesIdDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchAdminPromoCodesIdDeactivate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchAdminPromoCodesIdDeactivateResponseObject); ok {
+		if err := validResponse.VisitPatchAdminPromoCodesIdDeactivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminUsers operation middleware
+func (sh *strictHandler) GetAdminUsers(w http.ResponseWriter, r *http.Request, params GetAdminUsersParams) {
+	var request GetAdminUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminUsers(ctx, request.(GetAdminUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminUsers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminUsersResponseObject); ok {
+		if err := validResponse.VisitGetAdminUsersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchAdminUsersIdVerify operation middleware
+func (sh *strictHandler) PatchAdminUsersIdVerify(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchAdminUsersIdVerifyRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchAdminUsersIdVerify(ctx, request.(PatchAdminUsersIdVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchAdminUsersIdVerify")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchAdminUsersIdVerifyResponseObject); ok {
+		if err := validResponse.VisitPatchAdminUsersIdVerifyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminId operation middleware
+func (sh *strictHandler) GetAdminId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetAdminIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminId(ctx, request.(GetAdminIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminIdResponseObject); ok {
+		if err := validResponse.VisitGetAdminIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchAdminId operation middleware
+func (sh *strictHandler) PatchAdminId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchAdminIdRequestObject
+
+	request.Id = id
+
+	var body PatchAdminIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchAdminId(ctx, request.(PatchAdminIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchAdminId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchAdminIdResponseObject); ok {
+		if err := validResponse.VisitPatchAdminIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClientAvailability operation middleware
+func (sh *strictHandler) GetClientAvailability(w http.ResponseWriter, r *http.Request, params GetClientAvailabilityParams) {
+	var request GetClientAvailabilityRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientAvailability(ctx, request.(GetClientAvailabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientAvailability")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientAvailabilityResponseObject); ok {
+		if err := validResponse.VisitGetClientAvailabilityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostClientBookings operation middleware
+func (sh *strictHandler) PostClientBookings(w http.ResponseWriter, r *http.Request) {
+	var request PostClientBookingsRequestObject
+
+	var body PostClientBookingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostClientBookings(ctx, request.(PostClientBookingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostClientBookings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostClientBookingsResponseObject); ok {
+		if err := validResponse.VisitPostClientBookingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostClientBookingsQuote operation middleware
+func (sh *strictHandler) PostClientBookingsQuote(w http.ResponseWriter, r *http.Request) {
+	var request PostClientBookingsQuoteRequestObject
+
+	var body PostClientBookingsQuoteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostClientBookingsQuote(ctx, request.(PostClientBookingsQuoteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostClientBookingsQuote")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostClientBookingsQuoteResponseObject); ok {
+		if err := validResponse.VisitPostClientBookingsQuoteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchClientBookingsIdCancel operation middleware
+func (sh *strictHandler) PatchClientBookingsIdCancel(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchClientBookingsIdCancelRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchClientBookingsIdCancel(ctx, request.(PatchClientBookingsIdCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchClientBookingsIdCancel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchClientBookingsIdCancelResponseObject); ok {
+		if err := validResponse.VisitPatchClientBookingsIdCancelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClientCalendarFeed operation middleware
+func (sh *strictHandler) GetClientCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	var request GetClientCalendarFeedRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientCalendarFeed(ctx, request.(GetClientCalendarFeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientCalendarFeed")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientCalendarFeedResponseObject); ok {
+		if err := validResponse.VisitGetClientCalendarFeedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostClientCalendarFeedRegenerate operation middleware
+func (sh *strictHandler) PostClientCalendarFeedRegenerate(w http.ResponseWriter, r *http.Request) {
+	var request PostClientCalendarFeedRegenerateRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostClientCalendarFeedRegenerate(ctx, request.(PostClientCalendarFeedRegenerateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostClientCalendarFeedRegenerate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostClientCalendarFeedRegenerateResponseObject); ok {
+		if err := validResponse.VisitPostClientCalendarFeedRegenerateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClientDisputesId operation middleware
+func (sh *strictHandler) GetClientDisputesId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetClientDisputesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientDisputesId(ctx, request.(GetClientDisputesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientDisputesId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientDisputesIdResponseObject); ok {
+		if err := validResponse.VisitGetClientDisputesIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClientDisputesIdMessages operation middleware
+func (sh *strictHandler) GetClientDisputesIdMessages(w http.ResponseWriter, r *http.Request, id int64, params GetClientDisputesIdMessagesParams) {
+	var request GetClientDisputesIdMessagesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientDisputesIdMessages(ctx, request.(GetClientDisputesIdMessagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientDisputesIdMessages")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientDisputesIdMessagesResponseObject); ok {
+		if err := validResponse.VisitGetClientDisputesIdMessagesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostClientDisputesIdMessages operation middleware
+func (sh *strictHandler) PostClientDisputesIdMessages(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PostClientDisputesIdMessagesRequestObject
+
+	request.Id = id
+
+	var body PostClientDisputesIdMessagesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostClientDisputesIdMessages(ctx, request.(PostClientDisputesIdMessagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostClientDisputesIdMessages")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostClientDisputesIdMessagesResponseObject); ok {
+		if err := validResponse.VisitPostClientDisputesIdMessagesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClientModelsModelIdSlots operation middleware
+func (sh *strictHandler) GetClientModelsModelIdSlots(w http.ResponseWriter, r *http.Request, modelId int64, params GetClientModelsModelIdSlotsParams) {
+	var request GetClientModelsModelIdSlotsRequestObject
+
+	request.ModelId = modelId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientModelsModelIdSlots(ctx, request.(GetClientModelsModelIdSlotsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientModelsModelIdSlots")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientModelsModelIdSlotsResponseObject); ok {
+		if err := validResponse.VisitGetClientModelsModelIdSlotsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchClientOrdersIdCancel operation middleware
+func (sh *strictHandler) PatchClientOrdersIdCancel(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchClientOrdersIdCancelRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchClientOrdersIdCancel(ctx, request.(PatchClientOrdersIdCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchClientOrdersIdCancel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchClientOrdersIdCancelResponseObject); ok {
+		if err := validResponse.VisitPatchClientOrdersIdCancelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchClientOrdersIdConfirm operation middleware
+func (sh *strictHandler) PatchClientOrdersIdConfirm(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchClientOrdersIdConfirmRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchClientOrdersIdConfirm(ctx, request.(PatchClientOrdersIdConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchClientOrdersIdConfirm")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchClientOrdersIdConfirmResponseObject); ok {
+		if err := validResponse.VisitPatchClientOrdersIdConfirmResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostClientOrdersIdDisputes operation middleware
+func (sh *strictHandler) PostClientOrdersIdDisputes(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PostClientOrdersIdDisputesRequestObject
+
+	request.Id = id
+
+	var body PostClientOrdersIdDisputesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostClientOrdersIdDisputes(ctx, request.(PostClientOrdersIdDisputesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostClientOrdersIdDisputes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostClientOrdersIdDisputesResponseObject); ok {
+		if err := validResponse.VisitPostClientOrdersIdDisputesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClientOrdersIdEvents operation middleware
+func (sh *strictHandler) GetClientOrdersIdEvents(w http.ResponseWriter, r *http.Request, id int64, params GetClientOrdersIdEventsParams) {
+	var request GetClientOrdersIdEventsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientOrdersIdEvents(ctx, request.(GetClientOrdersIdEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientOrdersIdEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientOrdersIdEventsResponseObject); ok {
+		if err := validResponse.VisitGetClientOrdersIdEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClientOrdersIdExtensions operation middleware
+func (sh *strictHandler) GetClientOrdersIdExtensions(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetClientOrdersIdExtensionsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientOrdersIdExtensions(ctx, request.(GetClientOrdersIdExtensionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientOrdersIdExtensions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientOrdersIdExtensionsResponseObject); ok {
+		if err := validResponse.VisitGetClientOrdersIdExtensionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostClientOrdersIdExtensions operation middleware
+func (sh *strictHandler) PostClientOrdersIdExtensions(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PostClientOrdersIdExtensionsRequestObject
+
+	request.Id = id
+
+	var body PostClientOrdersIdExtensionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostClientOrdersIdExtensions(ctx, request.(PostClientOrdersIdExtensionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostClientOrdersIdExtensions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostClientOrdersIdExtensionsResponseObject); ok {
+		if err := validResponse.VisitPostClientOrdersIdExtensionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchClientOrdersIdIssue operation middleware
+func (sh *strictHandler) PatchClientOrdersIdIssue(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchClientOrdersIdIssueRequestObject
+
+	request.Id = id
+
+	var body PatchClientOrdersIdIssueJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchClientOrdersIdIssue(ctx, request.(PatchClientOrdersIdIssueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchClientOrdersIdIssue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchClientOrdersIdIssueResponseObject); ok {
+		if err := validResponse.VisitPatchClientOrdersIdIssueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClientOrdersIdReceipt operation middleware
+func (sh *strictHandler) GetClientOrdersIdReceipt(w http.ResponseWriter, r *http.Request, id int64, params GetClientOrdersIdReceiptParams) {
+	var request GetClientOrdersIdReceiptRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientOrdersIdReceipt(ctx, request.(GetClientOrdersIdReceiptRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientOrdersIdReceipt")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientOrdersIdReceiptResponseObject); ok {
+		if err := validResponse.VisitGetClientOrdersIdReceiptResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClientServices operation middleware
+func (sh *strictHandler) GetClientServices(w http.ResponseWriter, r *http.Request, params GetClientServicesParams) {
+	var request GetClientServicesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientServices(ctx, request.(GetClientServicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientServices")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientServicesResponseObject); ok {
+		if err := validResponse.VisitGetClientServicesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClientServicesId operation middleware
+func (sh *strictHandler) GetClientServicesId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetClientServicesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientServicesId(ctx, request.(GetClientServicesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientServicesId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientServicesIdResponseObject); ok {
+		if err := validResponse.VisitGetClientServicesIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClientServicesIdAddOns operation middleware
+func (sh *strictHandler) GetClientServicesIdAddOns(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetClientServicesIdAddOnsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientServicesIdAddOns(ctx, request.(GetClientServicesIdAddOnsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientServicesIdAddOns")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientServicesIdAddOnsResponseObject); ok {
+		if err := validResponse.VisitGetClientServicesIdAddOnsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchModelAddOnsId operation middleware
+func (sh *strictHandler) PatchModelAddOnsId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchModelAddOnsIdRequestObject
+
+	request.Id = id
+
+	var body PatchModelAddOnsIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchModelAddOnsId(ctx, request.(PatchModelAddOnsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchModelAddOnsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchModelAddOnsIdResponseObject); ok {
+		if err := validResponse.VisitPatchModelAddOnsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchModelAddOnsIdDeactivate operation middleware
+func (sh *strictHandler) PatchModelAddOnsIdDeactivate(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchModelAddOnsIdDeactivateRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchModelAddOnsIdDeactivate(ctx, request.(PatchModelAddOnsIdDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchModelAddOnsIdDeactivate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchModelAddOnsIdDeactivateResponseObject); ok {
+		if err := validResponse.VisitPatchModelAddOnsIdDeactivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetModelAvailabilityTemplates operation middleware
+func (sh *strictHandler) GetModelAvailabilityTemplates(w http.ResponseWriter, r *http.Request, params GetModelAvailabilityTemplatesParams) {
+	var request GetModelAvailabilityTemplatesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModelAvailabilityTemplates(ctx, request.(GetModelAvailabilityTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModelAvailabilityTemplates")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetModelAvailabilityTemplatesResponseObject); ok {
+		if err := validResponse.VisitGetModelAvailabilityTemplatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostModelAvailabilityTemplates operation middleware
+func (sh *strictHandler) PostModelAvailabilityTemplates(w http.ResponseWriter, r *http.Request) {
+	var request PostModelAvailabilityTemplatesRequestObject
+
+	var body PostModelAvailabilityTemplatesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostModelAvailabilityTemplates(ctx, request.(PostModelAvailabilityTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostModelAvailabilityTemplates")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostModelAvailabilityTemplatesResponseObject); ok {
+		if err := validResponse.VisitPostModelAvailabilityTemplatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetModelAvailabilityTemplatesId operation middleware
+func (sh *strictHandler) GetModelAvailabilityTemplatesId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetModelAvailabilityTemplatesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModelAvailabilityTemplatesId(ctx, request.(GetModelAvailabilityTemplatesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModelAvailabilityTemplatesId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetModelAvailabilityTemplatesIdResponseObject); ok {
+		if err := validResponse.VisitGetModelAvailabilityTemplatesIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutModelAvailabilityTemplatesId operation middleware
+func (sh *strictHandler) PutModelAvailabilityTemplatesId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PutModelAvailabilityTemplatesIdRequestObject
+
+	request.Id = id
+
+	var body PutModelAvailabilityTemplatesIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutModelAvailabilityTemplatesId(ctx, request.(PutModelAvailabilityTemplatesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutModelAvailabilityTemplatesId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutModelAvailabilityTemplatesIdResponseObject); ok {
+		if err := validResponse.VisitPutModelAvailabilityTemplatesIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetModelAvailabilityTemplatesIdPreview operation middleware
+func (sh *strictHandler) GetModelAvailabilityTemplatesIdPreview(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetModelAvailabilityTemplatesIdPreviewRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModelAvailabilityTemplatesIdPreview(ctx, request.(GetModelAvailabilityTemplatesIdPreviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModelAvailabilityTemplatesIdPreview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetModelAvailabilityTemplatesIdPreviewResponseObject); ok {
+		if err := validResponse.VisitGetModelAvailabilityTemplatesIdPreviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostModelAvailabilityTemplatesIdPublish operation middleware
+func (sh *strictHandler) PostModelAvailabilityTemplatesIdPublish(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PostModelAvailabilityTemplatesIdPublishRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostModelAvailabilityTemplatesIdPublish(ctx, request.(PostModelAvailabilityTemplatesIdPublishRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostModelAvailabilityTemplatesIdPublish")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostModelAvailabilityTemplatesIdPublishResponseObject); ok {
+		if err := validResponse.VisitPostModelAvailabilityTemplatesIdPublishResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostModelAvailabilityTemplatesIdUnpublish operation middleware
+func (sh *strictHandler) PostModelAvailabilityTemplatesIdUnpublish(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PostModelAvailabilityTemplatesIdUnpublishRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostModelAvailabilityTemplatesIdUnpublish(ctx, request.(PostModelAvailabilityTemplatesIdUnpublishRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostModelAvailabilityTemplatesIdUnpublish")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostModelAvailabilityTemplatesIdUnpublishResponseObject); ok {
+		if err := validResponse.VisitPostModelAvailabilityTemplatesIdUnpublishResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetModelBookingRules operation middleware
+func (sh *strictHandler) GetModelBookingRules(w http.ResponseWriter, r *http.Request) {
+	var request GetModelBookingRulesRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModelBookingRules(ctx, request.(GetModelBookingRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModelBookingRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetModelBookingRulesResponseObject); ok {
+		if err := validResponse.VisitGetModelBookingRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutModelBookingRules operation middleware
+func (sh *strictHandler) PutModelBookingRules(w http.ResponseWriter, r *http.Request) {
+	var request PutModelBookingRulesRequestObject
+
+	var body PutModelBookingRulesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutModelBookingRules(ctx, request.(PutModelBookingRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutModelBookingRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutModelBookingRulesResponseObject); ok {
+		if err := validResponse.VisitPutModelBookingRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchModelBookingsIdApprove operation middleware
+func (sh *strictHandler) PatchModelBookingsIdApprove(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchModelBookingsIdApproveRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchModelBookingsIdApprove(ctx, request.(PatchModelBookingsIdApproveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchModelBookingsIdApprove")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchModelBookingsIdApproveResponseObject); ok {
+		if err := validResponse.VisitPatchModelBookingsIdApproveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchModelBookingsIdReject operation middleware
+func (sh *strictHandler) PatchModelBookingsIdReject(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchModelBookingsIdRejectRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchModelBookingsIdReject(ctx, request.(PatchModelBookingsIdRejectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchModelBookingsIdReject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchModelBookingsIdRejectResponseObject); ok {
+		if err := validResponse.VisitPatchModelBookingsIdRejectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetModelBusyCalendars operation middleware
+func (sh *strictHandler) GetModelBusyCalendars(w http.ResponseWriter, r *http.Request) {
+	var request GetModelBusyCalendarsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModelBusyCalendars(ctx, request.(GetModelBusyCalendarsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModelBusyCalendars")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetModelBusyCalendarsResponseObject); ok {
+		if err := validResponse.VisitGetModelBusyCalendarsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostModelBusyCalendars operation middleware
+func (sh *strictHandler) PostModelBusyCalendars(w http.ResponseWriter, r *http.Request) {
+	var request PostModelBusyCalendarsRequestObject
+
+	var body PostModelBusyCalendarsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostModelBusyCalendars(ctx, request.(PostModelBusyCalendarsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostModelBusyCalendars")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostModelBusyCalendarsResponseObject); ok {
+		if err := validResponse.VisitPostModelBusyCalendarsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteModelBusyCalendarsId operation middleware
+func (sh *strictHandler) DeleteModelBusyCalendarsId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request DeleteModelBusyCalendarsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteModelBusyCalendarsId(ctx, request.(DeleteModelBusyCalendarsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteModelBusyCalendarsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteModelBusyCalendarsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteModelBusyCalendarsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutModelBusyCalendarsIdEvents operation middleware
+func (sh *strictHandler) PutModelBusyCalendarsIdEvents(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PutModelBusyCalendarsIdEventsRequestObject
+
+	request.Id = id
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutModelBusyCalendarsIdEvents(ctx, request.(PutModelBusyCalendarsIdEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutModelBusyCalendarsIdEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutModelBusyCalendarsIdEventsResponseObject); ok {
+		if err := validResponse.VisitPutModelBusyCalendarsIdEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostModelBusyCalendarsIdSync operation middleware
+func (sh *strictHandler) PostModelBusyCalendarsIdSync(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PostModelBusyCalendarsIdSyncRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostModelBusyCalendarsIdSync(ctx, request.(PostModelBusyCalendarsIdSyncRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostModelBusyCalendarsIdSync")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostModelBusyCalendarsIdSyncResponseObject); ok {
+		if err := validResponse.VisitPostModelBusyCalendarsIdSyncResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetModelCalendarFeed operation middleware
+func (sh *strictHandler) GetModelCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	var request GetModelCalendarFeedRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModelCalendarFeed(ctx, request.(GetModelCalendarFeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModelCalendarFeed")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetModelCalendarFeedResponseObject); ok {
+		if err := validResponse.VisitGetModelCalendarFeedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostModelCalendarFeedRegenerate operation middleware
+func (sh *strictHandler) PostModelCalendarFeedRegenerate(w http.ResponseWriter, r *http.Request) {
+	var request PostModelCalendarFeedRegenerateRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostModelCalendarFeedRegenerate(ctx, request.(PostModelCalendarFeedRegenerateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostModelCalendarFeedRegenerate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostModelCalendarFeedRegenerateResponseObject); ok {
+		if err := validResponse.VisitPostModelCalendarFeedRegenerateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetModelDisputesId operation middleware
+func (sh *strictHandler) GetModelDisputesId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetModelDisputesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModelDisputesId(ctx, request.(GetModelDisputesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModelDisputesId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetModelDisputesIdResponseObject); ok {
+		if err := validResponse.VisitGetModelDisputesIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+// GetModelDisputesIdMessages operation middleware
+func (sh *strictHandler) GetModelDisputesIdMessages(w http.ResponseWriter, r *http.Request, id int64, params GetModelDisputesIdMessagesParams) {
+	var request GetModelDisputesIdMessagesRequestObject
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	request.Id = id
+	request.Params = params
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModelDisputesIdMessages(ctx, request.(GetModelDisputesIdMessagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModelDisputesIdMessages")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetModelDisputesIdMessagesResponseObject); ok {
+		if err := validResponse.VisitGetModelDisputesIdMessagesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostAdmin operation middleware
-func (sh *strictHandler) PostAdmin(w http.ResponseWriter, r *http.Request) {
-	var request PostAdminRequestObject
+// PostModelDisputesIdMessages operation middleware
+func (sh *strictHandler) PostModelDisputesIdMessages(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PostModelDisputesIdMessagesRequestObject
 
-	var body PostAdminJSONRequestBody
+	request.Id = id
+
+	var body PostModelDisputesIdMessagesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2929,18 +10978,18 @@ func (sh *strictHandler) PostAdmin(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAdmin(ctx, request.(PostAdminRequestObject))
+		return sh.ssi.PostModelDisputesIdMessages(ctx, request.(PostModelDisputesIdMessagesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAdmin")
+		handler = middleware(handler, "PostModelDisputesIdMessages")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostAdminResponseObject); ok {
-		if err := validResponse.VisitPostAdminResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostModelDisputesIdMessagesResponseObject); ok {
+		if err := validResponse.VisitPostModelDisputesIdMessagesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2948,25 +10997,25 @@ func (sh *strictHandler) PostAdmin(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetAdminBookings operation middleware
-func (sh *strictHandler) GetAdminBookings(w http.ResponseWriter, r *http.Request, params GetAdminBookingsParams) {
-	var request GetAdminBookingsRequestObject
+// GetModelEarnings operation middleware
+func (sh *strictHandler) GetModelEarnings(w http.ResponseWriter, r *http.Request, params GetModelEarningsParams) {
+	var request GetModelEarningsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAdminBookings(ctx, request.(GetAdminBookingsRequestObject))
+		return sh.ssi.GetModelEarnings(ctx, request.(GetModelEarningsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAdminBookings")
+		handler = middleware(handler, "GetModelEarnings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAdminBookingsResponseObject); ok {
-		if err := validResponse.VisitGetAdminBookingsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetModelEarningsResponseObject); ok {
+		if err := validResponse.VisitGetModelEarningsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2974,25 +11023,51 @@ func (sh *strictHandler) GetAdminBookings(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetAdminBookingsId operation middleware
-func (sh *strictHandler) GetAdminBookingsId(w http.ResponseWriter, r *http.Request, id int64) {
-	var request GetAdminBookingsIdRequestObject
+// GetModelOrders operation middleware
+func (sh *strictHandler) GetModelOrders(w http.ResponseWriter, r *http.Request, params GetModelOrdersParams) {
+	var request GetModelOrdersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModelOrders(ctx, request.(GetModelOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModelOrders")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetModelOrdersResponseObject); ok {
+		if err := validResponse.VisitGetModelOrdersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchModelOrdersIdCancel operation middleware
+func (sh *strictHandler) PatchModelOrdersIdCancel(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchModelOrdersIdCancelRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAdminBookingsId(ctx, request.(GetAdminBookingsIdRequestObject))
+		return sh.ssi.PatchModelOrdersIdCancel(ctx, request.(PatchModelOrdersIdCancelRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAdminBookingsId")
+		handler = middleware(handler, "PatchModelOrdersIdCancel")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAdminBookingsIdResponseObject); ok {
-		if err := validResponse.VisitGetAdminBookingsIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchModelOrdersIdCancelResponseObject); ok {
+		if err := validResponse.VisitPatchModelOrdersIdCancelResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3000,13 +11075,39 @@ func (sh *strictHandler) GetAdminBookingsId(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// PatchAdminBookingsIdStatus operation middleware
-func (sh *strictHandler) PatchAdminBookingsIdStatus(w http.ResponseWriter, r *http.Request, id int64) {
-	var request PatchAdminBookingsIdStatusRequestObject
+// PatchModelOrdersIdComplete operation middleware
+func (sh *strictHandler) PatchModelOrdersIdComplete(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchModelOrdersIdCompleteRequestObject
 
 	request.Id = id
 
-	var body PatchAdminBookingsIdStatusJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchModelOrdersIdComplete(ctx, request.(PatchModelOrdersIdCompleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchModelOrdersIdComplete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchModelOrdersIdCompleteResponseObject); ok {
+		if err := validResponse.VisitPatchModelOrdersIdCompleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostModelOrdersIdDisputes operation middleware
+func (sh *strictHandler) PostModelOrdersIdDisputes(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PostModelOrdersIdDisputesRequestObject
+
+	request.Id = id
+
+	var body PostModelOrdersIdDisputesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3014,18 +11115,18 @@ func (sh *strictHandler) PatchAdminBookingsIdStatus(w http.ResponseWriter, r *ht
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchAdminBookingsIdStatus(ctx, request.(PatchAdminBookingsIdStatusRequestObject))
+		return sh.ssi.PostModelOrdersIdDisputes(ctx, request.(PostModelOrdersIdDisputesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchAdminBookingsIdStatus")
+		handler = middleware(handler, "PostModelOrdersIdDisputes")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchAdminBookingsIdStatusResponseObject); ok {
-		if err := validResponse.VisitPatchAdminBookingsIdStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostModelOrdersIdDisputesResponseObject); ok {
+		if err := validResponse.VisitPostModelOrdersIdDisputesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3033,25 +11134,25 @@ func (sh *strictHandler) PatchAdminBookingsIdStatus(w http.ResponseWriter, r *ht
 	}
 }
 
-// GetAdminOrders operation middleware
-func (sh *strictHandler) GetAdminOrders(w http.ResponseWriter, r *http.Request, params GetAdminOrdersParams) {
-	var request GetAdminOrdersRequestObject
+// GetModelOrdersIdExtensions operation middleware
+func (sh *strictHandler) GetModelOrdersIdExtensions(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetModelOrdersIdExtensionsRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAdminOrders(ctx, request.(GetAdminOrdersRequestObject))
+		return sh.ssi.GetModelOrdersIdExtensions(ctx, request.(GetModelOrdersIdExtensionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAdminOrders")
+		handler = middleware(handler, "GetModelOrdersIdExtensions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAdminOrdersResponseObject); ok {
-		if err := validResponse.VisitGetAdminOrdersResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetModelOrdersIdExtensionsResponseObject); ok {
+		if err := validResponse.VisitGetModelOrdersIdExtensionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3059,25 +11160,26 @@ func (sh *strictHandler) GetAdminOrders(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetAdminOrdersId operation middleware
-func (sh *strictHandler) GetAdminOrdersId(w http.ResponseWriter, r *http.Request, id int64) {
-	var request GetAdminOrdersIdRequestObject
+// PatchModelOrdersIdExtensionsExtensionIdAccept operation middleware
+func (sh *strictHandler) PatchModelOrdersIdExtensionsExtensionIdAccept(w http.ResponseWriter, r *http.Request, id int64, extensionId int64) {
+	var request PatchModelOrdersIdExtensionsExtensionIdAcceptRequestObject
 
 	request.Id = id
+	request.ExtensionId = extensionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAdminOrdersId(ctx, request.(GetAdminOrdersIdRequestObject))
+		return sh.ssi.PatchModelOrdersIdExtensionsExtensionIdAccept(ctx, request.(PatchModelOrdersIdExtensionsExtensionIdAcceptRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAdminOrdersId")
+		handler = middleware(handler, "PatchModelOrdersIdExtensionsExtensionIdAccept")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAdminOrdersIdResponseObject); ok {
-		if err := validResponse.VisitGetAdminOrdersIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchModelOrdersIdExtensionsExtensionIdAcceptResponseObject); ok {
+		if err := validResponse.VisitPatchModelOrdersIdExtensionsExtensionIdAcceptResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3085,13 +11187,40 @@ func (sh *strictHandler) GetAdminOrdersId(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// PatchAdminOrdersIdStatus operation middleware
-func (sh *strictHandler) PatchAdminOrdersIdStatus(w http.ResponseWriter, r *http.Request, id int64) {
-	var request PatchAdminOrdersIdStatusRequestObject
+// PatchModelOrdersIdExtensionsExtensionIdReject operation middleware
+func (sh *strictHandler) PatchModelOrdersIdExtensionsExtensionIdReject(w http.ResponseWriter, r *http.Request, id int64, extensionId int64) {
+	var request PatchModelOrdersIdExtensionsExtensionIdRejectRequestObject
 
 	request.Id = id
+	request.ExtensionId = extensionId
 
-	var body PatchAdminOrdersIdStatusJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchModelOrdersIdExtensionsExtensionIdReject(ctx, request.(PatchModelOrdersIdExtensionsExtensionIdRejectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchModelOrdersIdExtensionsExtensionIdReject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchModelOrdersIdExtensionsExtensionIdRejectResponseObject); ok {
+		if err := validResponse.VisitPatchModelOrdersIdExtensionsExtensionIdRejectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostModelOrdersIdLocation operation middleware
+func (sh *strictHandler) PostModelOrdersIdLocation(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PostModelOrdersIdLocationRequestObject
+
+	request.Id = id
+
+	var body PostModelOrdersIdLocationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3099,18 +11228,18 @@ func (sh *strictHandler) PatchAdminOrdersIdStatus(w http.ResponseWriter, r *http
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchAdminOrdersIdStatus(ctx, request.(PatchAdminOrdersIdStatusRequestObject))
+		return sh.ssi.PostModelOrdersIdLocation(ctx, request.(PostModelOrdersIdLocationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchAdminOrdersIdStatus")
+		handler = middleware(handler, "PostModelOrdersIdLocation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchAdminOrdersIdStatusResponseObject); ok {
-		if err := validResponse.VisitPatchAdminOrdersIdStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostModelOrdersIdLocationResponseObject); ok {
+		if err := validResponse.VisitPostModelOrdersIdLocationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3118,25 +11247,25 @@ func (sh *strictHandler) PatchAdminOrdersIdStatus(w http.ResponseWriter, r *http
 	}
 }
 
-// GetAdminUsers operation middleware
-func (sh *strictHandler) GetAdminUsers(w http.ResponseWriter, r *http.Request, params GetAdminUsersParams) {
-	var request GetAdminUsersRequestObject
+// GetModelPricingRules operation middleware
+func (sh *strictHandler) GetModelPricingRules(w http.ResponseWriter, r *http.Request, params GetModelPricingRulesParams) {
+	var request GetModelPricingRulesRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAdminUsers(ctx, request.(GetAdminUsersRequestObject))
+		return sh.ssi.GetModelPricingRules(ctx, request.(GetModelPricingRulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAdminUsers")
+		handler = middleware(handler, "GetModelPricingRules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAdminUsersResponseObject); ok {
-		if err := validResponse.VisitGetAdminUsersResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetModelPricingRulesResponseObject); ok {
+		if err := validResponse.VisitGetModelPricingRulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3144,25 +11273,56 @@ func (sh *strictHandler) GetAdminUsers(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// PatchAdminUsersIdVerify operation middleware
-func (sh *strictHandler) PatchAdminUsersIdVerify(w http.ResponseWriter, r *http.Request, id int64) {
-	var request PatchAdminUsersIdVerifyRequestObject
+// PostModelPricingRules operation middleware
+func (sh *strictHandler) PostModelPricingRules(w http.ResponseWriter, r *http.Request) {
+	var request PostModelPricingRulesRequestObject
+
+	var body PostModelPricingRulesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostModelPricingRules(ctx, request.(PostModelPricingRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostModelPricingRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostModelPricingRulesResponseObject); ok {
+		if err := validResponse.VisitPostModelPricingRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteModelPricingRulesId operation middleware
+func (sh *strictHandler) DeleteModelPricingRulesId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request DeleteModelPricingRulesIdRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchAdminUsersIdVerify(ctx, request.(PatchAdminUsersIdVerifyRequestObject))
+		return sh.ssi.DeleteModelPricingRulesId(ctx, request.(DeleteModelPricingRulesIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchAdminUsersIdVerify")
+		handler = middleware(handler, "DeleteModelPricingRulesId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchAdminUsersIdVerifyResponseObject); ok {
-		if err := validResponse.VisitPatchAdminUsersIdVerifyResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteModelPricingRulesIdResponseObject); ok {
+		if err := validResponse.VisitDeleteModelPricingRulesIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3170,25 +11330,25 @@ func (sh *strictHandler) PatchAdminUsersIdVerify(w http.ResponseWriter, r *http.
 	}
 }
 
-// GetAdminId operation middleware
-func (sh *strictHandler) GetAdminId(w http.ResponseWriter, r *http.Request, id int64) {
-	var request GetAdminIdRequestObject
+// GetModelPricingRulesId operation middleware
+func (sh *strictHandler) GetModelPricingRulesId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetModelPricingRulesIdRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAdminId(ctx, request.(GetAdminIdRequestObject))
+		return sh.ssi.GetModelPricingRulesId(ctx, request.(GetModelPricingRulesIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAdminId")
+		handler = middleware(handler, "GetModelPricingRulesId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAdminIdResponseObject); ok {
-		if err := validResponse.VisitGetAdminIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetModelPricingRulesIdResponseObject); ok {
+		if err := validResponse.VisitGetModelPricingRulesIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3196,13 +11356,13 @@ func (sh *strictHandler) GetAdminId(w http.ResponseWriter, r *http.Request, id i
 	}
 }
 
-// PatchAdminId operation middleware
-func (sh *strictHandler) PatchAdminId(w http.ResponseWriter, r *http.Request, id int64) {
-	var request PatchAdminIdRequestObject
+// PutModelPricingRulesId operation middleware
+func (sh *strictHandler) PutModelPricingRulesId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PutModelPricingRulesIdRequestObject
 
 	request.Id = id
 
-	var body PatchAdminIdJSONRequestBody
+	var body PutModelPricingRulesIdJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3210,18 +11370,18 @@ func (sh *strictHandler) PatchAdminId(w http.ResponseWriter, r *http.Request, id
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchAdminId(ctx, request.(PatchAdminIdRequestObject))
+		return sh.ssi.PutModelPricingRulesId(ctx, request.(PutModelPricingRulesIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchAdminId")
+		handler = middleware(handler, "PutModelPricingRulesId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchAdminIdResponseObject); ok {
-		if err := validResponse.VisitPatchAdminIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutModelPricingRulesIdResponseObject); ok {
+		if err := validResponse.VisitPutModelPricingRulesIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3229,30 +11389,25 @@ func (sh *strictHandler) PatchAdminId(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// PostClientBookings operation middleware
-func (sh *strictHandler) PostClientBookings(w http.ResponseWriter, r *http.Request) {
-	var request PostClientBookingsRequestObject
+// GetModelServices operation middleware
+func (sh *strictHandler) GetModelServices(w http.ResponseWriter, r *http.Request, params GetModelServicesParams) {
+	var request GetModelServicesRequestObject
 
-	var body PostClientBookingsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostClientBookings(ctx, request.(PostClientBookingsRequestObject))
+		return sh.ssi.GetModelServices(ctx, request.(GetModelServicesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostClientBookings")
+		handler = middleware(handler, "GetModelServices")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostClientBookingsResponseObject); ok {
-		if err := validResponse.VisitPostClientBookingsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetModelServicesResponseObject); ok {
+		if err := validResponse.VisitGetModelServicesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3260,25 +11415,30 @@ func (sh *strictHandler) PostClientBookings(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// PatchClientBookingsIdCancel operation middleware
-func (sh *strictHandler) PatchClientBookingsIdCancel(w http.ResponseWriter, r *http.Request, id int64) {
-	var request PatchClientBookingsIdCancelRequestObject
+// PostModelServices operation middleware
+func (sh *strictHandler) PostModelServices(w http.ResponseWriter, r *http.Request) {
+	var request PostModelServicesRequestObject
 
-	request.Id = id
+	var body PostModelServicesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchClientBookingsIdCancel(ctx, request.(PatchClientBookingsIdCancelRequestObject))
+		return sh.ssi.PostModelServices(ctx, request.(PostModelServicesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchClientBookingsIdCancel")
+		handler = middleware(handler, "PostModelServices")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchClientBookingsIdCancelResponseObject); ok {
-		if err := validResponse.VisitPatchClientBookingsIdCancelResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostModelServicesResponseObject); ok {
+		if err := validResponse.VisitPostModelServicesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3286,25 +11446,32 @@ func (sh *strictHandler) PatchClientBookingsIdCancel(w http.ResponseWriter, r *h
 	}
 }
 
-// GetClientModelsModelIdSlots operation middleware
-func (sh *strictHandler) GetClientModelsModelIdSlots(w http.ResponseWriter, r *http.Request, modelId int64) {
-	var request GetClientModelsModelIdSlotsRequestObject
+// PatchModelServicesId operation middleware
+func (sh *strictHandler) PatchModelServicesId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchModelServicesIdRequestObject
 
-	request.ModelId = modelId
+	request.Id = id
+
+	var body PatchModelServicesIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetClientModelsModelIdSlots(ctx, request.(GetClientModelsModelIdSlotsRequestObject))
+		return sh.ssi.PatchModelServicesId(ctx, request.(PatchModelServicesIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetClientModelsModelIdSlots")
+		handler = middleware(handler, "PatchModelServicesId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetClientModelsModelIdSlotsResponseObject); ok {
-		if err := validResponse.VisitGetClientModelsModelIdSlotsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchModelServicesIdResponseObject); ok {
+		if err := validResponse.VisitPatchModelServicesIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3312,25 +11479,25 @@ func (sh *strictHandler) GetClientModelsModelIdSlots(w http.ResponseWriter, r *h
 	}
 }
 
-// PatchClientOrdersIdCancel operation middleware
-func (sh *strictHandler) PatchClientOrdersIdCancel(w http.ResponseWriter, r *http.Request, id int64) {
-	var request PatchClientOrdersIdCancelRequestObject
+// GetModelServicesIdAddOns operation middleware
+func (sh *strictHandler) GetModelServicesIdAddOns(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetModelServicesIdAddOnsRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchClientOrdersIdCancel(ctx, request.(PatchClientOrdersIdCancelRequestObject))
+		return sh.ssi.GetModelServicesIdAddOns(ctx, request.(GetModelServicesIdAddOnsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchClientOrdersIdCancel")
+		handler = middleware(handler, "GetModelServicesIdAddOns")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchClientOrdersIdCancelResponseObject); ok {
-		if err := validResponse.VisitPatchClientOrdersIdCancelResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetModelServicesIdAddOnsResponseObject); ok {
+		if err := validResponse.VisitGetModelServicesIdAddOnsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3338,25 +11505,32 @@ func (sh *strictHandler) PatchClientOrdersIdCancel(w http.ResponseWriter, r *htt
 	}
 }
 
-// GetClientServices operation middleware
-func (sh *strictHandler) GetClientServices(w http.ResponseWriter, r *http.Request, params GetClientServicesParams) {
-	var request GetClientServicesRequestObject
+// PostModelServicesIdAddOns operation middleware
+func (sh *strictHandler) PostModelServicesIdAddOns(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PostModelServicesIdAddOnsRequestObject
 
-	request.Params = params
+	request.Id = id
+
+	var body PostModelServicesIdAddOnsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetClientServices(ctx, request.(GetClientServicesRequestObject))
+		return sh.ssi.PostModelServicesIdAddOns(ctx, request.(PostModelServicesIdAddOnsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetClientServices")
+		handler = middleware(handler, "PostModelServicesIdAddOns")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetClientServicesResponseObject); ok {
-		if err := validResponse.VisitGetClientServicesResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostModelServicesIdAddOnsResponseObject); ok {
+		if err := validResponse.VisitPostModelServicesIdAddOnsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3364,25 +11538,25 @@ func (sh *strictHandler) GetClientServices(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetClientServicesId operation middleware
-func (sh *strictHandler) GetClientServicesId(w http.ResponseWriter, r *http.Request, id int64) {
-	var request GetClientServicesIdRequestObject
+// PatchModelServicesIdDeactivate operation middleware
+func (sh *strictHandler) PatchModelServicesIdDeactivate(w http.ResponseWriter, r *http.Request, id int64) {
+	var request PatchModelServicesIdDeactivateRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetClientServicesId(ctx, request.(GetClientServicesIdRequestObject))
+		return sh.ssi.PatchModelServicesIdDeactivate(ctx, request.(PatchModelServicesIdDeactivateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetClientServicesId")
+		handler = middleware(handler, "PatchModelServicesIdDeactivate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetClientServicesIdResponseObject); ok {
-		if err := validResponse.VisitGetClientServicesIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchModelServicesIdDeactivateResponseObject); ok {
+		if err := validResponse.VisitPatchModelServicesIdDeactivateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3390,25 +11564,25 @@ func (sh *strictHandler) GetClientServicesId(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// PatchModelBookingsIdApprove operation middleware
-func (sh *strictHandler) PatchModelBookingsIdApprove(w http.ResponseWriter, r *http.Request, id int64) {
-	var request PatchModelBookingsIdApproveRequestObject
+// GetModelSlots operation middleware
+func (sh *strictHandler) GetModelSlots(w http.ResponseWriter, r *http.Request, params GetModelSlotsParams) {
+	var request GetModelSlotsRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchModelBookingsIdApprove(ctx, request.(PatchModelBookingsIdApproveRequestObject))
+		return sh.ssi.GetModelSlots(ctx, request.(GetModelSlotsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchModelBookingsIdApprove")
+		handler = middleware(handler, "GetModelSlots")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchModelBookingsIdApproveResponseObject); ok {
-		if err := validResponse.VisitPatchModelBookingsIdApproveResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetModelSlotsResponseObject); ok {
+		if err := validResponse.VisitGetModelSlotsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3416,25 +11590,30 @@ func (sh *strictHandler) PatchModelBookingsIdApprove(w http.ResponseWriter, r *h
 	}
 }
 
-// PatchModelBookingsIdReject operation middleware
-func (sh *strictHandler) PatchModelBookingsIdReject(w http.ResponseWriter, r *http.Request, id int64) {
-	var request PatchModelBookingsIdRejectRequestObject
+// PostModelSlots operation middleware
+func (sh *strictHandler) PostModelSlots(w http.ResponseWriter, r *http.Request) {
+	var request PostModelSlotsRequestObject
 
-	request.Id = id
+	var body PostModelSlotsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchModelBookingsIdReject(ctx, request.(PatchModelBookingsIdRejectRequestObject))
+		return sh.ssi.PostModelSlots(ctx, request.(PostModelSlotsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchModelBookingsIdReject")
+		handler = middleware(handler, "PostModelSlots")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchModelBookingsIdRejectResponseObject); ok {
-		if err := validResponse.VisitPatchModelBookingsIdRejectResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostModelSlotsResponseObject); ok {
+		if err := validResponse.VisitPostModelSlotsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3442,25 +11621,30 @@ func (sh *strictHandler) PatchModelBookingsIdReject(w http.ResponseWriter, r *ht
 	}
 }
 
-// GetModelOrders operation middleware
-func (sh *strictHandler) GetModelOrders(w http.ResponseWriter, r *http.Request, params GetModelOrdersParams) {
-	var request GetModelOrdersRequestObject
+// PostModelSlotsBatch operation middleware
+func (sh *strictHandler) PostModelSlotsBatch(w http.ResponseWriter, r *http.Request) {
+	var request PostModelSlotsBatchRequestObject
 
-	request.Params = params
+	var body PostModelSlotsBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetModelOrders(ctx, request.(GetModelOrdersRequestObject))
+		return sh.ssi.PostModelSlotsBatch(ctx, request.(PostModelSlotsBatchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetModelOrders")
+		handler = middleware(handler, "PostModelSlotsBatch")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetModelOrdersResponseObject); ok {
-		if err := validResponse.VisitGetModelOrdersResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostModelSlotsBatchResponseObject); ok {
+		if err := validResponse.VisitPostModelSlotsBatchResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3468,25 +11652,30 @@ func (sh *strictHandler) GetModelOrders(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// PatchModelOrdersIdCancel operation middleware
-func (sh *strictHandler) PatchModelOrdersIdCancel(w http.ResponseWriter, r *http.Request, id int64) {
-	var request PatchModelOrdersIdCancelRequestObject
+// PatchModelSlotsDisableRange operation middleware
+func (sh *strictHandler) PatchModelSlotsDisableRange(w http.ResponseWriter, r *http.Request) {
+	var request PatchModelSlotsDisableRangeRequestObject
 
-	request.Id = id
+	var body PatchModelSlotsDisableRangeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchModelOrdersIdCancel(ctx, request.(PatchModelOrdersIdCancelRequestObject))
+		return sh.ssi.PatchModelSlotsDisableRange(ctx, request.(PatchModelSlotsDisableRangeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchModelOrdersIdCancel")
+		handler = middleware(handler, "PatchModelSlotsDisableRange")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchModelOrdersIdCancelResponseObject); ok {
-		if err := validResponse.VisitPatchModelOrdersIdCancelResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchModelSlotsDisableRangeResponseObject); ok {
+		if err := validResponse.VisitPatchModelSlotsDisableRangeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3494,25 +11683,30 @@ func (sh *strictHandler) PatchModelOrdersIdCancel(w http.ResponseWriter, r *http
 	}
 }
 
-// PatchModelOrdersIdComplete operation middleware
-func (sh *strictHandler) PatchModelOrdersIdComplete(w http.ResponseWriter, r *http.Request, id int64) {
-	var request PatchModelOrdersIdCompleteRequestObject
+// PostModelSlotsMerge operation middleware
+func (sh *strictHandler) PostModelSlotsMerge(w http.ResponseWriter, r *http.Request) {
+	var request PostModelSlotsMergeRequestObject
 
-	request.Id = id
+	var body PostModelSlotsMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchModelOrdersIdComplete(ctx, request.(PatchModelOrdersIdCompleteRequestObject))
+		return sh.ssi.PostModelSlotsMerge(ctx, request.(PostModelSlotsMergeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchModelOrdersIdComplete")
+		handler = middleware(handler, "PostModelSlotsMerge")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchModelOrdersIdCompleteResponseObject); ok {
-		if err := validResponse.VisitPatchModelOrdersIdCompleteResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostModelSlotsMergeResponseObject); ok {
+		if err := validResponse.VisitPostModelSlotsMergeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3520,25 +11714,32 @@ func (sh *strictHandler) PatchModelOrdersIdComplete(w http.ResponseWriter, r *ht
 	}
 }
 
-// GetModelServices operation middleware
-func (sh *strictHandler) GetModelServices(w http.ResponseWriter, r *http.Request, params GetModelServicesParams) {
-	var request GetModelServicesRequestObject
+// PatchModelSlotsSlotId operation middleware
+func (sh *strictHandler) PatchModelSlotsSlotId(w http.ResponseWriter, r *http.Request, slotId int64) {
+	var request PatchModelSlotsSlotIdRequestObject
 
-	request.Params = params
+	request.SlotId = slotId
+
+	var body PatchModelSlotsSlotIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetModelServices(ctx, request.(GetModelServicesRequestObject))
+		return sh.ssi.PatchModelSlotsSlotId(ctx, request.(PatchModelSlotsSlotIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetModelServices")
+		handler = middleware(handler, "PatchModelSlotsSlotId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetModelServicesResponseObject); ok {
-		if err := validResponse.VisitGetModelServicesResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchModelSlotsSlotIdResponseObject); ok {
+		if err := validResponse.VisitPatchModelSlotsSlotIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3546,30 +11747,25 @@ func (sh *strictHandler) GetModelServices(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// PostModelServices operation middleware
-func (sh *strictHandler) PostModelServices(w http.ResponseWriter, r *http.Request) {
-	var request PostModelServicesRequestObject
+// PatchModelSlotsSlotIdDisable operation middleware
+func (sh *strictHandler) PatchModelSlotsSlotIdDisable(w http.ResponseWriter, r *http.Request, slotId int64) {
+	var request PatchModelSlotsSlotIdDisableRequestObject
 
-	var body PostModelServicesJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.SlotId = slotId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostModelServices(ctx, request.(PostModelServicesRequestObject))
+		return sh.ssi.PatchModelSlotsSlotIdDisable(ctx, request.(PatchModelSlotsSlotIdDisableRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostModelServices")
+		handler = middleware(handler, "PatchModelSlotsSlotIdDisable")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostModelServicesResponseObject); ok {
-		if err := validResponse.VisitPostModelServicesResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchModelSlotsSlotIdDisableResponseObject); ok {
+		if err := validResponse.VisitPatchModelSlotsSlotIdDisableResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3577,13 +11773,13 @@ func (sh *strictHandler) PostModelServices(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// PatchModelServicesId operation middleware
-func (sh *strictHandler) PatchModelServicesId(w http.ResponseWriter, r *http.Request, id int64) {
-	var request PatchModelServicesIdRequestObject
+// PostModelSlotsSlotIdSplit operation middleware
+func (sh *strictHandler) PostModelSlotsSlotIdSplit(w http.ResponseWriter, r *http.Request, slotId int64) {
+	var request PostModelSlotsSlotIdSplitRequestObject
 
-	request.Id = id
+	request.SlotId = slotId
 
-	var body PatchModelServicesIdJSONRequestBody
+	var body PostModelSlotsSlotIdSplitJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3591,18 +11787,18 @@ func (sh *strictHandler) PatchModelServicesId(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchModelServicesId(ctx, request.(PatchModelServicesIdRequestObject))
+		return sh.ssi.PostModelSlotsSlotIdSplit(ctx, request.(PostModelSlotsSlotIdSplitRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchModelServicesId")
+		handler = middleware(handler, "PostModelSlotsSlotIdSplit")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchModelServicesIdResponseObject); ok {
-		if err := validResponse.VisitPatchModelServicesIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostModelSlotsSlotIdSplitResponseObject); ok {
+		if err := validResponse.VisitPostModelSlotsSlotIdSplitResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3610,25 +11806,23 @@ func (sh *strictHandler) PatchModelServicesId(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// PatchModelServicesIdDeactivate operation middleware
-func (sh *strictHandler) PatchModelServicesIdDeactivate(w http.ResponseWriter, r *http.Request, id int64) {
-	var request PatchModelServicesIdDeactivateRequestObject
-
-	request.Id = id
+// GetModelTimeOffs operation middleware
+func (sh *strictHandler) GetModelTimeOffs(w http.ResponseWriter, r *http.Request) {
+	var request GetModelTimeOffsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchModelServicesIdDeactivate(ctx, request.(PatchModelServicesIdDeactivateRequestObject))
+		return sh.ssi.GetModelTimeOffs(ctx, request.(GetModelTimeOffsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchModelServicesIdDeactivate")
+		handler = middleware(handler, "GetModelTimeOffs")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchModelServicesIdDeactivateResponseObject); ok {
-		if err := validResponse.VisitPatchModelServicesIdDeactivateResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetModelTimeOffsResponseObject); ok {
+		if err := validResponse.VisitGetModelTimeOffsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3636,23 +11830,30 @@ func (sh *strictHandler) PatchModelServicesIdDeactivate(w http.ResponseWriter, r
 	}
 }
 
-// GetModelSlots operation middleware
-func (sh *strictHandler) GetModelSlots(w http.ResponseWriter, r *http.Request) {
-	var request GetModelSlotsRequestObject
+// PostModelTimeOffs operation middleware
+func (sh *strictHandler) PostModelTimeOffs(w http.ResponseWriter, r *http.Request) {
+	var request PostModelTimeOffsRequestObject
+
+	var body PostModelTimeOffsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetModelSlots(ctx, request.(GetModelSlotsRequestObject))
+		return sh.ssi.PostModelTimeOffs(ctx, request.(PostModelTimeOffsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetModelSlots")
+		handler = middleware(handler, "PostModelTimeOffs")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetModelSlotsResponseObject); ok {
-		if err := validResponse.VisitGetModelSlotsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostModelTimeOffsResponseObject); ok {
+		if err := validResponse.VisitPostModelTimeOffsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3660,30 +11861,25 @@ func (sh *strictHandler) GetModelSlots(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PostModelSlots operation middleware
-func (sh *strictHandler) PostModelSlots(w http.ResponseWriter, r *http.Request) {
-	var request PostModelSlotsRequestObject
+// DeleteModelTimeOffsId operation middleware
+func (sh *strictHandler) DeleteModelTimeOffsId(w http.ResponseWriter, r *http.Request, id int64) {
+	var request DeleteModelTimeOffsIdRequestObject
 
-	var body PostModelSlotsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostModelSlots(ctx, request.(PostModelSlotsRequestObject))
+		return sh.ssi.DeleteModelTimeOffsId(ctx, request.(DeleteModelTimeOffsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostModelSlots")
+		handler = middleware(handler, "DeleteModelTimeOffsId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostModelSlotsResponseObject); ok {
-		if err := validResponse.VisitPostModelSlotsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteModelTimeOffsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteModelTimeOffsIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3691,32 +11887,23 @@ func (sh *strictHandler) PostModelSlots(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// PatchModelSlotsSlotId operation middleware
-func (sh *strictHandler) PatchModelSlotsSlotId(w http.ResponseWriter, r *http.Request, slotId int64) {
-	var request PatchModelSlotsSlotIdRequestObject
-
-	request.SlotId = slotId
-
-	var body PatchModelSlotsSlotIdJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetModelTravelBuffer operation middleware
+func (sh *strictHandler) GetModelTravelBuffer(w http.ResponseWriter, r *http.Request) {
+	var request GetModelTravelBufferRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchModelSlotsSlotId(ctx, request.(PatchModelSlotsSlotIdRequestObject))
+		return sh.ssi.GetModelTravelBuffer(ctx, request.(GetModelTravelBufferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchModelSlotsSlotId")
+		handler = middleware(handler, "GetModelTravelBuffer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchModelSlotsSlotIdResponseObject); ok {
-		if err := validResponse.VisitPatchModelSlotsSlotIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetModelTravelBufferResponseObject); ok {
+		if err := validResponse.VisitGetModelTravelBufferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3724,25 +11911,30 @@ func (sh *strictHandler) PatchModelSlotsSlotId(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// PatchModelSlotsSlotIdDisable operation middleware
-func (sh *strictHandler) PatchModelSlotsSlotIdDisable(w http.ResponseWriter, r *http.Request, slotId int64) {
-	var request PatchModelSlotsSlotIdDisableRequestObject
+// PutModelTravelBuffer operation middleware
+func (sh *strictHandler) PutModelTravelBuffer(w http.ResponseWriter, r *http.Request) {
+	var request PutModelTravelBufferRequestObject
 
-	request.SlotId = slotId
+	var body PutModelTravelBufferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchModelSlotsSlotIdDisable(ctx, request.(PatchModelSlotsSlotIdDisableRequestObject))
+		return sh.ssi.PutModelTravelBuffer(ctx, request.(PutModelTravelBufferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchModelSlotsSlotIdDisable")
+		handler = middleware(handler, "PutModelTravelBuffer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchModelSlotsSlotIdDisableResponseObject); ok {
-		if err := validResponse.VisitPatchModelSlotsSlotIdDisableResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutModelTravelBufferResponseObject); ok {
+		if err := validResponse.VisitPutModelTravelBufferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
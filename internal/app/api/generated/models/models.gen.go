@@ -9,6 +9,12 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for AvailabilitySort.
+const (
+	Price     AvailabilitySort = "price"
+	StartTime AvailabilitySort = "start_time"
+)
+
 // Defines values for BookingStatus.
 const (
 	BookingStatusAPPROVED  BookingStatus = "APPROVED"
@@ -17,46 +23,222 @@ const (
 	BookingStatusREJECTED  BookingStatus = "REJECTED"
 )
 
+// Defines values for DiscountType.
+const (
+	FIXED   DiscountType = "FIXED"
+	PERCENT DiscountType = "PERCENT"
+)
+
+// Defines values for DisputeCategory.
+const (
+	DisputeCategoryBEHAVIOUR   DisputeCategory = "BEHAVIOUR"
+	DisputeCategoryLATEARRIVAL DisputeCategory = "LATE_ARRIVAL"
+	DisputeCategoryNOSHOW      DisputeCategory = "NO_SHOW"
+	DisputeCategoryOTHER       DisputeCategory = "OTHER"
+	DisputeCategoryPAYMENT     DisputeCategory = "PAYMENT"
+	DisputeCategoryQUALITY     DisputeCategory = "QUALITY"
+)
+
+// Defines values for DisputeCreateRequestCategory.
+const (
+	BEHAVIOUR   DisputeCreateRequestCategory = "BEHAVIOUR"
+	LATEARRIVAL DisputeCreateRequestCategory = "LATE_ARRIVAL"
+	NOSHOW      DisputeCreateRequestCategory = "NO_SHOW"
+	OTHER       DisputeCreateRequestCategory = "OTHER"
+	PAYMENT     DisputeCreateRequestCategory = "PAYMENT"
+	QUALITY     DisputeCreateRequestCategory = "QUALITY"
+)
+
+// Defines values for DisputeResolution.
+const (
+	DisputeResolutionFULLREFUND     DisputeResolution = "FULL_REFUND"
+	DisputeResolutionNOACTION       DisputeResolution = "NO_ACTION"
+	DisputeResolutionPARTIALREFUND  DisputeResolution = "PARTIAL_REFUND"
+	DisputeResolutionPENALIZECLIENT DisputeResolution = "PENALIZE_CLIENT"
+	DisputeResolutionPENALIZEMODEL  DisputeResolution = "PENALIZE_MODEL"
+)
+
+// Defines values for DisputeResolveRequestResolution.
+const (
+	FULLREFUND     DisputeResolveRequestResolution = "FULL_REFUND"
+	NOACTION       DisputeResolveRequestResolution = "NO_ACTION"
+	PARTIALREFUND  DisputeResolveRequestResolution = "PARTIAL_REFUND"
+	PENALIZECLIENT DisputeResolveRequestResolution = "PENALIZE_CLIENT"
+	PENALIZEMODEL  DisputeResolveRequestResolution = "PENALIZE_MODEL"
+)
+
+// Defines values for DisputeStatus.
+const (
+	INREVIEW DisputeStatus = "IN_REVIEW"
+	OPEN     DisputeStatus = "OPEN"
+	RESOLVED DisputeStatus = "RESOLVED"
+)
+
 // Defines values for ErrorResponseCode.
 const (
-	BADREQUEST                  ErrorResponseCode = "BAD_REQUEST"
-	BOOKINGALREADYPROCESSED     ErrorResponseCode = "BOOKING_ALREADY_PROCESSED"
-	BOOKINGEXPIRED              ErrorResponseCode = "BOOKING_EXPIRED"
-	BOOKINGNOTFOUND             ErrorResponseCode = "BOOKING_NOT_FOUND"
-	CANNOTCANCELORDER           ErrorResponseCode = "CANNOT_CANCEL_ORDER"
-	CANNOTCOMPLETEORDER         ErrorResponseCode = "CANNOT_COMPLETE_ORDER"
-	DESCRIPTIONTOOLONG          ErrorResponseCode = "DESCRIPTION_TOO_LONG"
-	EMAILALREADYEXISTS          ErrorResponseCode = "EMAIL_ALREADY_EXISTS"
-	FORBIDDEN                   ErrorResponseCode = "FORBIDDEN"
-	INCORRECTSLOTTIME           ErrorResponseCode = "INCORRECT_SLOT_TIME"
-	INTERNALERROR               ErrorResponseCode = "INTERNAL_ERROR"
-	INVALIDBOOKINGSTATE         ErrorResponseCode = "INVALID_BOOKING_STATE"
-	INVALIDCREDENTIALS          ErrorResponseCode = "INVALID_CREDENTIALS"
-	INVALIDPRICE                ErrorResponseCode = "INVALID_PRICE"
-	INVALIDSLOTSTATUSTRANSITION ErrorResponseCode = "INVALID_SLOT_STATUS_TRANSITION"
-	NOTADMIN                    ErrorResponseCode = "NOT_ADMIN"
-	NOTAMODEL                   ErrorResponseCode = "NOT_A_MODEL"
-	NOTCLIENT                   ErrorResponseCode = "NOTCLIENT"
-	NOTFOUND                    ErrorResponseCode = "NOT_FOUND"
-	NOTSERVICEOWNER             ErrorResponseCode = "NOT_SERVICE_OWNER"
-	NOTSLOTOWNER                ErrorResponseCode = "NOT_SLOT_OWNER"
-	ORDERNOTFOUND               ErrorResponseCode = "ORDER_NOT_FOUND"
-	SERVICENOTACTIVE            ErrorResponseCode = "SERVICE_NOT_ACTIVE"
-	SERVICENOTFOUND             ErrorResponseCode = "SERVICE_NOT_FOUND"
-	SLOTNOTAVAILABLE            ErrorResponseCode = "SLOT_NOT_AVAILABLE"
-	SLOTNOTFOUND                ErrorResponseCode = "SLOTNOTFOUND"
-	SLOTOVERLAP                 ErrorResponseCode = "SLOT_OVERLAP"
-	UNAUTHORIZED                ErrorResponseCode = "UNAUTHORIZED"
-	USERISNOTANADULT            ErrorResponseCode = "USERISNOTANADULT"
-	VALIDATIONERROR             ErrorResponseCode = "VALIDATION_ERROR"
+	ADDONNOTACTIVE                 ErrorResponseCode = "ADD_ON_NOT_ACTIVE"
+	ADDONNOTAPPLICABLE             ErrorResponseCode = "ADD_ON_NOT_APPLICABLE"
+	ADDONNOTFOUND                  ErrorResponseCode = "ADD_ON_NOT_FOUND"
+	BADREQUEST                     ErrorResponseCode = "BAD_REQUEST"
+	BOOKINGALREADYPROCESSED        ErrorResponseCode = "BOOKING_ALREADY_PROCESSED"
+	BOOKINGBEYONDHORIZON           ErrorResponseCode = "BOOKING_BEYOND_HORIZON"
+	BOOKINGEXPIRED                 ErrorResponseCode = "BOOKING_EXPIRED"
+	BOOKINGNOTFOUND                ErrorResponseCode = "BOOKING_NOT_FOUND"
+	BOOKINGTOOSOON                 ErrorResponseCode = "BOOKING_TOO_SOON"
+	BUSYCALENDARHASURL             ErrorResponseCode = "BUSY_CALENDAR_HAS_URL"
+	BUSYCALENDARNOTFOUND           ErrorResponseCode = "BUSY_CALENDAR_NOT_FOUND"
+	CALENDARFEEDNOTFOUND           ErrorResponseCode = "CALENDAR_FEED_NOT_FOUND"
+	CALENDARUNREACHABLE            ErrorResponseCode = "CALENDAR_UNREACHABLE"
+	CANNOTCANCELORDER              ErrorResponseCode = "CANNOT_CANCEL_ORDER"
+	CANNOTCOMPLETEORDER            ErrorResponseCode = "CANNOT_COMPLETE_ORDER"
+	CANNOTCONFIRMORDER             ErrorResponseCode = "CANNOT_CONFIRM_ORDER"
+	CANNOTEXTENDORDER              ErrorResponseCode = "CANNOT_EXTEND_ORDER"
+	CANNOTOPENDISPUTE              ErrorResponseCode = "CANNOT_OPEN_DISPUTE"
+	CANNOTRAISEORDERISSUE          ErrorResponseCode = "CANNOT_RAISE_ORDER_ISSUE"
+	CANNOTTRACKORDER               ErrorResponseCode = "CANNOT_TRACK_ORDER"
+	DESCRIPTIONTOOLONG             ErrorResponseCode = "DESCRIPTION_TOO_LONG"
+	DISPUTEALREADYEXISTS           ErrorResponseCode = "DISPUTE_ALREADY_EXISTS"
+	DISPUTEALREADYRESOLVED         ErrorResponseCode = "DISPUTE_ALREADY_RESOLVED"
+	DISPUTENOTFOUND                ErrorResponseCode = "DISPUTE_NOT_FOUND"
+	EMAILALREADYEXISTS             ErrorResponseCode = "EMAIL_ALREADY_EXISTS"
+	FORBIDDEN                      ErrorResponseCode = "FORBIDDEN"
+	INCORRECTSLOTTIME              ErrorResponseCode = "INCORRECT_SLOT_TIME"
+	INTERNALERROR                  ErrorResponseCode = "INTERNAL_ERROR"
+	INVALIDADDON                   ErrorResponseCode = "INVALID_ADD_ON"
+	INVALIDAVAILABILITYSEARCH      ErrorResponseCode = "INVALID_AVAILABILITY_SEARCH"
+	INVALIDBOOKINGRULES            ErrorResponseCode = "INVALID_BOOKING_RULES"
+	INVALIDBOOKINGSTATE            ErrorResponseCode = "INVALID_BOOKING_STATE"
+	INVALIDCALENDARFILE            ErrorResponseCode = "INVALID_CALENDAR_FILE"
+	INVALIDCREDENTIALS             ErrorResponseCode = "INVALID_CREDENTIALS"
+	INVALIDORDERSTATUSTRANSITION   ErrorResponseCode = "INVALID_ORDER_STATUS_TRANSITION"
+	INVALIDPAYMENTSTATE            ErrorResponseCode = "INVALID_PAYMENT_STATE"
+	INVALIDPRICE                   ErrorResponseCode = "INVALID_PRICE"
+	INVALIDPRICINGRULE             ErrorResponseCode = "INVALID_PRICING_RULE"
+	INVALIDPROMOCODE               ErrorResponseCode = "INVALID_PROMO_CODE"
+	INVALIDQUOTE                   ErrorResponseCode = "INVALID_QUOTE"
+	INVALIDREFUNDAMOUNT            ErrorResponseCode = "INVALID_REFUND_AMOUNT"
+	INVALIDSLOTBATCH               ErrorResponseCode = "INVALID_SLOT_BATCH"
+	INVALIDSLOTDURATION            ErrorResponseCode = "INVALID_SLOT_DURATION"
+	INVALIDSLOTMERGE               ErrorResponseCode = "INVALID_SLOT_MERGE"
+	INVALIDSLOTSPLIT               ErrorResponseCode = "INVALID_SLOT_SPLIT"
+	INVALIDSLOTSTATUSFILTER        ErrorResponseCode = "INVALID_SLOT_STATUS_FILTER"
+	INVALIDSLOTSTATUSTRANSITION    ErrorResponseCode = "INVALID_SLOT_STATUS_TRANSITION"
+	INVALIDTEMPLATE                ErrorResponseCode = "INVALID_TEMPLATE"
+	INVALIDTIMEOFF                 ErrorResponseCode = "INVALID_TIME_OFF"
+	INVALIDTIMEZONE                ErrorResponseCode = "INVALID_TIME_ZONE"
+	INVALIDTRAVELBUFFER            ErrorResponseCode = "INVALID_TRAVEL_BUFFER"
+	INVALIDWEBHOOKSECRET           ErrorResponseCode = "INVALID_WEBHOOK_SECRET"
+	NOCALENDARFEED                 ErrorResponseCode = "NO_CALENDAR_FEED"
+	NOTADMIN                       ErrorResponseCode = "NOT_ADMIN"
+	NOTAMODEL                      ErrorResponseCode = "NOT_A_MODEL"
+	NOTBUSYCALENDAROWNER           ErrorResponseCode = "NOT_BUSY_CALENDAR_OWNER"
+	NOTCLIENT                      ErrorResponseCode = "NOTCLIENT"
+	NOTDISPUTEASSIGNEE             ErrorResponseCode = "NOT_DISPUTE_ASSIGNEE"
+	NOTDISPUTEPARTICIPANT          ErrorResponseCode = "NOT_DISPUTE_PARTICIPANT"
+	NOTFOUND                       ErrorResponseCode = "NOT_FOUND"
+	NOTPRICINGRULEOWNER            ErrorResponseCode = "NOT_PRICING_RULE_OWNER"
+	NOTSERVICEOWNER                ErrorResponseCode = "NOT_SERVICE_OWNER"
+	NOTSLOTOWNER                   ErrorResponseCode = "NOT_SLOT_OWNER"
+	NOTTEMPLATEOWNER               ErrorResponseCode = "NOT_TEMPLATE_OWNER"
+	NOTTIMEOFFOWNER                ErrorResponseCode = "NOT_TIME_OFF_OWNER"
+	ORDEREXTENSIONALREADYPROCESSED ErrorResponseCode = "ORDER_EXTENSION_ALREADY_PROCESSED"
+	ORDEREXTENSIONALREADYREQUESTED ErrorResponseCode = "ORDER_EXTENSION_ALREADY_REQUESTED"
+	ORDEREXTENSIONNOTFOUND         ErrorResponseCode = "ORDER_EXTENSION_NOT_FOUND"
+	ORDERNOTFOUND                  ErrorResponseCode = "ORDER_NOT_FOUND"
+	ORDERTRACKINGUNAVAILABLE       ErrorResponseCode = "ORDER_TRACKING_UNAVAILABLE"
+	PAYMENTDECLINED                ErrorResponseCode = "PAYMENT_DECLINED"
+	PAYMENTNOTFOUND                ErrorResponseCode = "PAYMENT_NOT_FOUND"
+	PAYMENTPROVIDERERROR           ErrorResponseCode = "PAYMENT_PROVIDER_ERROR"
+	PRICINGRULENOTFOUND            ErrorResponseCode = "PRICING_RULE_NOT_FOUND"
+	PROMOCODEALREADYEXISTS         ErrorResponseCode = "PROMO_CODE_ALREADY_EXISTS"
+	PROMOCODECLIENTLIMITREACHED    ErrorResponseCode = "PROMO_CODE_CLIENT_LIMIT_REACHED"
+	PROMOCODENOTAPPLICABLE         ErrorResponseCode = "PROMO_CODE_NOT_APPLICABLE"
+	PROMOCODENOTFOUND              ErrorResponseCode = "PROMO_CODE_NOT_FOUND"
+	PROMOCODENOTVALID              ErrorResponseCode = "PROMO_CODE_NOT_VALID"
+	PROMOCODEUSAGELIMITREACHED     ErrorResponseCode = "PROMO_CODE_USAGE_LIMIT_REACHED"
+	QUOTEEXPIRED                   ErrorResponseCode = "QUOTE_EXPIRED"
+	QUOTEMISMATCH                  ErrorResponseCode = "QUOTE_MISMATCH"
+	RECEIPTNOTAVAILABLE            ErrorResponseCode = "RECEIPT_NOT_AVAILABLE"
+	SERVICENOTACTIVE               ErrorResponseCode = "SERVICE_NOT_ACTIVE"
+	SERVICENOTFOUND                ErrorResponseCode = "SERVICE_NOT_FOUND"
+	SLOTBATCHREJECTED              ErrorResponseCode = "SLOT_BATCH_REJECTED"
+	SLOTBEYONDHORIZON              ErrorResponseCode = "SLOT_BEYOND_HORIZON"
+	SLOTHASBOOKINGS                ErrorResponseCode = "SLOT_HAS_BOOKINGS"
+	SLOTINTHEPAST                  ErrorResponseCode = "SLOT_IN_THE_PAST"
+	SLOTNOTAVAILABLE               ErrorResponseCode = "SLOT_NOT_AVAILABLE"
+	SLOTNOTFOUND                   ErrorResponseCode = "SLOTNOTFOUND"
+	SLOTOVERLAP                    ErrorResponseCode = "SLOT_OVERLAP"
+	SLOTSNOTADJACENT               ErrorResponseCode = "SLOTS_NOT_ADJACENT"
+	SLOTWITHINTIMEOFF              ErrorResponseCode = "SLOT_WITHIN_TIME_OFF"
+	SLOTWITHINTRAVELBUFFER         ErrorResponseCode = "SLOT_WITHIN_TRAVEL_BUFFER"
+	TEMPLATENOTFOUND               ErrorResponseCode = "TEMPLATE_NOT_FOUND"
+	TIMEOFFNOTFOUND                ErrorResponseCode = "TIME_OFF_NOT_FOUND"
+	TIMEOFFOVERLAP                 ErrorResponseCode = "TIME_OFF_OVERLAP"
+	UNAUTHORIZED                   ErrorResponseCode = "UNAUTHORIZED"
+	UNSUPPORTEDCURRENCY            ErrorResponseCode = "UNSUPPORTED_CURRENCY"
+	USERISNOTANADULT               ErrorResponseCode = "USERISNOTANADULT"
+	VALIDATIONERROR                ErrorResponseCode = "VALIDATION_ERROR"
+)
+
+// Defines values for LedgerAccountType.
+const (
+	CLIENTPAYABLE   LedgerAccountType = "CLIENT_PAYABLE"
+	MODELEARNINGS   LedgerAccountType = "MODEL_EARNINGS"
+	PLATFORMREVENUE LedgerAccountType = "PLATFORM_REVENUE"
+)
+
+// Defines values for LedgerTransactionType.
+const (
+	ORDERPAYMENT LedgerTransactionType = "ORDER_PAYMENT"
+	PENALTY      LedgerTransactionType = "PENALTY"
+	REFUND       LedgerTransactionType = "REFUND"
+)
+
+// Defines values for OrderExtensionStatus.
+const (
+	OrderExtensionStatusACCEPTED OrderExtensionStatus = "ACCEPTED"
+	OrderExtensionStatusPENDING  OrderExtensionStatus = "PENDING"
+	OrderExtensionStatusREJECTED OrderExtensionStatus = "REJECTED"
 )
 
 // Defines values for OrderStatus.
 const (
-	OrderStatusCANCELLED OrderStatus = "CANCELLED"
-	OrderStatusCOMPLETED OrderStatus = "COMPLETED"
-	OrderStatusCONFIRMED OrderStatus = "CONFIRMED"
-	OrderStatusINTRANSIT OrderStatus = "INTRANSIT"
+	OrderStatusCANCELLED           OrderStatus = "CANCELLED"
+	OrderStatusCOMPLETED           OrderStatus = "COMPLETED"
+	OrderStatusCONFIRMED           OrderStatus = "CONFIRMED"
+	OrderStatusDISPUTED            OrderStatus = "DISPUTED"
+	OrderStatusINTRANSIT           OrderStatus = "INTRANSIT"
+	OrderStatusPENDINGCONFIRMATION OrderStatus = "PENDING_CONFIRMATION"
+)
+
+// Defines values for PaymentEventType.
+const (
+	PaymentEventTypeCAPTURED PaymentEventType = "CAPTURED"
+	PaymentEventTypeFAILED   PaymentEventType = "FAILED"
+	PaymentEventTypeREFUNDED PaymentEventType = "REFUNDED"
+	PaymentEventTypeVOIDED   PaymentEventType = "VOIDED"
+)
+
+// Defines values for PaymentStatus.
+const (
+	PaymentStatusAUTHORIZED        PaymentStatus = "AUTHORIZED"
+	PaymentStatusCAPTURED          PaymentStatus = "CAPTURED"
+	PaymentStatusFAILED            PaymentStatus = "FAILED"
+	PaymentStatusPARTIALLYREFUNDED PaymentStatus = "PARTIALLY_REFUNDED"
+	PaymentStatusREFUNDED          PaymentStatus = "REFUNDED"
+	PaymentStatusVOIDED            PaymentStatus = "VOIDED"
+)
+
+// Defines values for PricingRuleType.
+const (
+	MULTIPLIER PricingRuleType = "MULTIPLIER"
+	SURCHARGE  PricingRuleType = "SURCHARGE"
+)
+
+// Defines values for ReceiptFormat.
+const (
+	Html ReceiptFormat = "html"
+	Json ReceiptFormat = "json"
 )
 
 // Defines values for RegisterDTORole.
@@ -80,6 +262,35 @@ const (
 	REJECTED UpdateBookingStatusRequestStatus = "REJECTED"
 )
 
+// AddOnCreateRequest defines model for AddOnCreateRequest.
+type AddOnCreateRequest struct {
+	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	// ExtraMinutes Time the add-on takes on top of the service
+	ExtraMinutes *int    `json:"extraMinutes,omitempty" validate:"omitempty,min=0,max=1440"`
+	Price        float64 `json:"price" validate:"required,gt=0"`
+	Title        string  `json:"title" validate:"required,min=3,max=100"`
+}
+
+// AddOnResponse defines model for AddOnResponse.
+type AddOnResponse struct {
+	CreatedAt      time.Time `json:"createdAt"`
+	Description    string    `json:"description"`
+	ExtraMinutes   int       `json:"extraMinutes"`
+	Id             int64     `json:"id"`
+	IsActive       bool      `json:"isActive"`
+	ModelServiceID int64     `json:"modelServiceID"`
+	Price          float64   `json:"price"`
+	Title          string    `json:"title"`
+}
+
+// AddOnUpdateRequest defines model for AddOnUpdateRequest.
+type AddOnUpdateRequest struct {
+	Description  *string  `json:"description,omitempty" validate:"omitempty,max=1000"`
+	ExtraMinutes *int     `json:"extraMinutes,omitempty" validate:"omitempty,min=0,max=1440"`
+	Price        *float64 `json:"price,omitempty" validate:"omitempty,gt=0"`
+	Title        *string  `json:"title,omitempty" validate:"omitempty,min=3,max=100"`
+}
+
 // Address defines model for Address.
 type Address struct {
 	Apartment *int    `json:"apartment" validate:"omitempty,gt=0"`
@@ -108,28 +319,273 @@ type AuthTokenResponse struct {
 	AccessToken string `json:"access_token"`
 }
 
+// AvailabilitySort defines model for AvailabilitySort.
+type AvailabilitySort string
+
+// AvailabilityTemplateRequest defines model for AvailabilityTemplateRequest.
+type AvailabilityTemplateRequest struct {
+	EffectiveFrom openapi_types.Date `json:"effectiveFrom"`
+	// EffectiveTo The last day of the template, it lasts until changed when omitted
+	EffectiveTo *openapi_types.Date `json:"effectiveTo,omitempty"`
+	// EndTime Local end of the window, an end before the start runs past midnight
+	EndTime string `json:"endTime"`
+	// Exceptions No slots are generated for the windows starting on these dates
+	Exceptions *[]openapi_types.Date `json:"exceptions,omitempty"`
+	Name       string                `json:"name" validate:"required,min=1,max=100"`
+	// SlotMinutes The window is cut into slots of this length, a shorter rest is dropped
+	SlotMinutes int `json:"slotMinutes"`
+	// StartTime Local start of the window
+	StartTime string `json:"startTime"`
+	// Weekdays Days the window starts on, 0 is Sunday
+	Weekdays []int `json:"weekdays" validate:"required,min=1"`
+}
+
+// AvailabilityTemplateResponse defines model for AvailabilityTemplateResponse.
+type AvailabilityTemplateResponse struct {
+	CreatedAt     time.Time            `json:"createdAt"`
+	EffectiveFrom openapi_types.Date   `json:"effectiveFrom"`
+	EffectiveTo   *openapi_types.Date  `json:"effectiveTo"`
+	EndTime       string               `json:"endTime"`
+	Exceptions    []openapi_types.Date `json:"exceptions"`
+	Id            int64                `json:"id"`
+	IsPublished   bool                 `json:"isPublished"`
+	ModelID       int64                `json:"modelID"`
+	Name          string               `json:"name"`
+	SlotMinutes   int                  `json:"slotMinutes"`
+	StartTime     string               `json:"startTime"`
+	UpdatedAt     time.Time            `json:"updatedAt"`
+	Weekdays      []int                `json:"weekdays"`
+}
+
+// BookingAddOnResponse defines model for BookingAddOnResponse.
+type BookingAddOnResponse struct {
+	AddOnID      int64   `json:"addOnID"`
+	ExtraMinutes int     `json:"extraMinutes"`
+	Price        float64 `json:"price"`
+	Title        string  `json:"title"`
+}
+
+// BookingQuoteRequest defines model for BookingQuoteRequest.
+type BookingQuoteRequest struct {
+	// AddOnIDs Active add-ons of the booked service
+	AddOnIDs       *[]int64 `json:"addOnIDs,omitempty" validate:"omitempty,max=20,dive,gt=0"`
+	Address        Address  `json:"address"`
+	ModelServiceID int64    `json:"modelServiceID" validate:"required,gt=0"`
+	PromoCode      *string  `json:"promoCode,omitempty" validate:"omitempty,max=50"`
+	SlotID         int64    `json:"slotID" validate:"required,gt=0"`
+}
+
+// BookingQuoteResponse defines model for BookingQuoteResponse.
+type BookingQuoteResponse struct {
+	AddOns []BookingAddOnResponse `json:"addOns"`
+	// BasePrice Current service price
+	BasePrice float64 `json:"basePrice"`
+	Discount  float64 `json:"discount"`
+	// DurationScaling Added to the base price for a slot longer than the service is priced for, negative for a shorter one
+	DurationScaling float64   `json:"durationScaling"`
+	ExpiresAt       time.Time `json:"expiresAt"`
+	ModelServiceID  int64     `json:"modelServiceID"`
+	// Price Total, the duration scaling, surcharges, add-ons and travel fee are added and the discount is taken off
+	Price      float64                  `json:"price"`
+	SlotID     int64                    `json:"slotID"`
+	Surcharges []PriceComponentResponse `json:"surcharges"`
+	// Token Pass it as quoteToken when creating the booking to keep this price
+	Token string `json:"token"`
+	// TravelFee Fee of the model for getting to the address
+	TravelFee float64 `json:"travelFee"`
+}
+
 // BookingRequest defines model for BookingRequest.
 type BookingRequest struct {
-	Address        Address `json:"address"`
-	ModelServiceID int64   `json:"modelServiceID" validate:"required,gt=0"`
-	SlotID         int64   `json:"slotID" validate:"required,gt=0"`
+	// AddOnIDs Active add-ons of the booked service
+	AddOnIDs       *[]int64 `json:"addOnIDs,omitempty" validate:"omitempty,max=20,dive,gt=0"`
+	Address        Address  `json:"address"`
+	ModelServiceID int64    `json:"modelServiceID" validate:"required,gt=0"`
+	PromoCode      *string  `json:"promoCode,omitempty" validate:"omitempty,max=50"`
+	// QuoteToken Token of a quote made for this request, the booking gets exactly the quoted price
+	QuoteToken *string `json:"quoteToken,omitempty" validate:"omitempty,max=4096"`
+	SlotID     int64   `json:"slotID" validate:"required,gt=0"`
 }
 
 // BookingResponse defines model for BookingResponse.
 type BookingResponse struct {
-	Address        Address       `json:"address"`
-	ClientID       int64         `json:"clientID"`
-	CreatedAt      time.Time     `json:"createdAt"`
-	ExpiresAt      time.Time     `json:"expiresAt"`
-	Id             int64         `json:"id"`
-	ModelServiceID int64         `json:"modelServiceID"`
-	SlotID         int64         `json:"slotID"`
-	Status         BookingStatus `json:"status"`
+	AddOns  []BookingAddOnResponse `json:"addOns"`
+	Address Address                `json:"address"`
+	// ArchivedAt Set only for a booking read from the archive
+	ArchivedAt *time.Time `json:"archivedAt,omitempty"`
+	// BasePrice Service price snapshotted at booking time
+	BasePrice float64   `json:"basePrice"`
+	ClientID  int64     `json:"clientID"`
+	CreatedAt time.Time `json:"createdAt"`
+	Discount  float64   `json:"discount"`
+	// DurationScaling Added to the base price for a slot longer than the service is priced for, negative for a shorter one
+	DurationScaling float64   `json:"durationScaling"`
+	ExpiresAt       time.Time `json:"expiresAt"`
+	Id              int64     `json:"id"`
+	ModelServiceID  int64     `json:"modelServiceID"`
+	// Price Final price, the duration scaling, surcharges, add-ons and travel fee are added and the discount is taken off
+	Price       float64                  `json:"price"`
+	PromoCodeID *int64                   `json:"promoCodeID"`
+	SlotID      int64                    `json:"slotID"`
+	Status      BookingStatus            `json:"status"`
+	Surcharges  []PriceComponentResponse `json:"surcharges"`
+	// TravelFee Fee of the model for getting to the address
+	TravelFee float64 `json:"travelFee"`
+}
+
+// BookingRules defines model for BookingRules.
+type BookingRules struct {
+	HorizonDays      int `json:"horizonDays"`
+	MaxSlotMinutes   int `json:"maxSlotMinutes"`
+	MinNoticeMinutes int `json:"minNoticeMinutes"`
+	MinSlotMinutes   int `json:"minSlotMinutes"`
+}
+
+// BookingRulesRequest defines model for BookingRulesRequest.
+type BookingRulesRequest struct {
+	// HorizonDays How far ahead slots may start, 0 keeps the platform rule
+	HorizonDays int `json:"horizonDays" validate:"min=0"`
+	// MaxSlotMinutes Longest slot, 0 keeps the platform rule
+	MaxSlotMinutes int `json:"maxSlotMinutes" validate:"min=0"`
+	// MinNoticeMinutes Time before the start of a slot after which it cannot be booked, 0 keeps the platform rule
+	MinNoticeMinutes int `json:"minNoticeMinutes" validate:"min=0"`
+	// MinSlotMinutes Shortest slot, 0 keeps the platform rule
+	MinSlotMinutes int `json:"minSlotMinutes" validate:"min=0"`
+}
+
+// BookingRulesResponse Own rules are set by the model, 0 where the platform rule is kept; effective rules hold for the slots of the model, the stricter of the platform and model ones
+type BookingRulesResponse struct {
+	Effective BookingRules `json:"effective"`
+	Own       BookingRules `json:"own"`
+	// UpdatedAt Absent until the model sets the rules
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
 
 // BookingStatus defines model for BookingStatus.
 type BookingStatus string
 
+// BusyCalendarRequest defines model for BusyCalendarRequest.
+type BusyCalendarRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+	// Url http(s) URL of the .ics file, without it the events are uploaded
+	Url *string `json:"url,omitempty" validate:"omitempty,url,max=2048"`
+}
+
+// BusyCalendarResponse defines model for BusyCalendarResponse.
+type BusyCalendarResponse struct {
+	// Conflicts Reserved and booked slots under the busy events, they are not changed
+	Conflicts []BusyConflictResponse `json:"conflicts"`
+	CreatedAt time.Time              `json:"createdAt"`
+	Id        int64                  `json:"id"`
+	Name      string                 `json:"name"`
+	SyncedAt  *time.Time             `json:"syncedAt,omitempty"`
+	Url       *string                `json:"url,omitempty"`
+}
+
+// BusyCalendarSyncResponse defines model for BusyCalendarSyncResponse.
+type BusyCalendarSyncResponse struct {
+	// Blocked Available slots disabled because of the busy events
+	Blocked   []SlotResponse         `json:"blocked"`
+	Calendar  BusyCalendarResponse   `json:"calendar"`
+	Conflicts []BusyConflictResponse `json:"conflicts"`
+	// Released Slots available again because their busy events are gone
+	Released []SlotResponse `json:"released"`
+}
+
+// BusyConflictResponse defines model for BusyConflictResponse.
+type BusyConflictResponse struct {
+	EventEnd   time.Time  `json:"eventEnd"`
+	EventStart time.Time  `json:"eventStart"`
+	EventUID   string     `json:"eventUID"`
+	SlotEnd    time.Time  `json:"slotEnd"`
+	SlotID     int64      `json:"slotID"`
+	SlotStart  time.Time  `json:"slotStart"`
+	SlotStatus SlotStatus `json:"slotStatus"`
+}
+
+// CalendarFeedResponse defines model for CalendarFeedResponse.
+type CalendarFeedResponse struct {
+	// CreatedAt Time the current token was issued
+	CreatedAt time.Time `json:"createdAt"`
+	// Path Path of the iCalendar feed to subscribe to, relative to the server URL
+	Path string `json:"path"`
+	// Token Secret of the feed, anyone who has it can read the schedule
+	Token string `json:"token"`
+}
+
+// DiscountType defines model for DiscountType.
+type DiscountType string
+
+// DisputeAssignRequest defines model for DisputeAssignRequest.
+type DisputeAssignRequest struct {
+	// AdminID Admin to assign, the current admin is assigned when it is omitted
+	AdminID *int64 `json:"adminID,omitempty" validate:"omitempty,gt=0"`
+}
+
+// DisputeCategory defines model for DisputeCategory.
+type DisputeCategory string
+
+// DisputeCreateRequest defines model for DisputeCreateRequest.
+type DisputeCreateRequest struct {
+	Category    DisputeCreateRequestCategory `json:"category" validate:"required,oneof=NO_SHOW LATE_ARRIVAL QUALITY BEHAVIOUR PAYMENT OTHER"`
+	Description string                       `json:"description" validate:"required,min=1,max=2000"`
+}
+
+// DisputeCreateRequestCategory defines model for DisputeCreateRequest.Category.
+type DisputeCreateRequestCategory string
+
+// DisputeMessageRequest defines model for DisputeMessageRequest.
+type DisputeMessageRequest struct {
+	Body string `json:"body" validate:"required,min=1,max=2000"`
+}
+
+// DisputeMessageResponse defines model for DisputeMessageResponse.
+type DisputeMessageResponse struct {
+	AuthorAuthID int64     `json:"authorAuthID"`
+	AuthorRole   string    `json:"authorRole"`
+	Body         string    `json:"body"`
+	CreatedAt    time.Time `json:"createdAt"`
+	DisputeID    int64     `json:"disputeID"`
+	Id           int64     `json:"id"`
+}
+
+// DisputeResolution defines model for DisputeResolution.
+type DisputeResolution string
+
+// DisputeResolveRequest defines model for DisputeResolveRequest.
+type DisputeResolveRequest struct {
+	Comment *string `json:"comment,omitempty" validate:"omitempty,max=1000"`
+	// RefundAmount Required for PARTIAL_REFUND, must be less than the booking price
+	RefundAmount *float64                        `json:"refundAmount,omitempty" validate:"omitempty,gt=0"`
+	Resolution   DisputeResolveRequestResolution `json:"resolution" validate:"required,oneof=FULL_REFUND PARTIAL_REFUND NO_ACTION PENALIZE_CLIENT PENALIZE_MODEL"`
+}
+
+// DisputeResolveRequestResolution defines model for DisputeResolveRequest.Resolution.
+type DisputeResolveRequestResolution string
+
+// DisputeResponse defines model for DisputeResponse.
+type DisputeResponse struct {
+	AssignedAdminID   *int64             `json:"assignedAdminID,omitempty"`
+	Category          DisputeCategory    `json:"category"`
+	ClientID          int64              `json:"clientID"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	Description       string             `json:"description"`
+	Id                int64              `json:"id"`
+	ModelID           int64              `json:"modelID"`
+	OpenedBy          string             `json:"openedBy"`
+	OrderID           int64              `json:"orderID"`
+	PenalizedUserID   *int64             `json:"penalizedUserID,omitempty"`
+	RefundAmount      *float64           `json:"refundAmount,omitempty"`
+	Resolution        *DisputeResolution `json:"resolution,omitempty"`
+	ResolutionComment *string            `json:"resolutionComment,omitempty"`
+	ResolvedAt        *time.Time         `json:"resolvedAt,omitempty"`
+	Status            DisputeStatus      `json:"status"`
+	UpdatedAt         time.Time          `json:"updatedAt"`
+}
+
+// DisputeStatus defines model for DisputeStatus.
+type DisputeStatus string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Code    ErrorResponseCode `json:"code"`
@@ -139,48 +595,285 @@ type ErrorResponse struct {
 // ErrorResponseCode defines model for ErrorResponse.Code.
 type ErrorResponseCode string
 
+// LedgerAccountType defines model for LedgerAccountType.
+type LedgerAccountType string
+
+// LedgerEntryResponse defines model for LedgerEntryResponse.
+type LedgerEntryResponse struct {
+	// Amount Positive for a credit and negative for a debit
+	Amount        float64               `json:"amount"`
+	CreatedAt     time.Time             `json:"createdAt"`
+	Id            int64                 `json:"id"`
+	OrderID       int64                 `json:"orderID"`
+	TransactionID int64                 `json:"transactionID"`
+	Type          LedgerTransactionType `json:"type"`
+}
+
+// LedgerTransactionType defines model for LedgerTransactionType.
+type LedgerTransactionType string
+
 // LoginDTO defines model for LoginDTO.
 type LoginDTO struct {
 	Email    openapi_types.Email `json:"email" validate:"required,email"`
 	Password string              `json:"password" validate:"required,min=8,max=15"`
 }
 
+// ModelAvailabilityResponse defines model for ModelAvailabilityResponse.
+type ModelAvailabilityResponse struct {
+	ModelId int64  `json:"modelId"`
+	Name    string `json:"name"`
+	// Services Active services matching the search, by price
+	Services []ModelServiceResponse `json:"services"`
+	// Slots Available slots lying entirely inside the window, by start time
+	Slots []SlotResponse `json:"slots"`
+}
+
+// ModelEarningsResponse defines model for ModelEarningsResponse.
+type ModelEarningsResponse struct {
+	// Balance Amount owed to the model
+	Balance float64               `json:"balance"`
+	Entries []LedgerEntryResponse `json:"entries"`
+}
+
 // ModelServiceCreateDTO defines model for ModelServiceCreateDTO.
 type ModelServiceCreateDTO struct {
 	Description string  `json:"description" validate:"required,max=1000"`
-	Price       float32 `json:"price" validate:"required,gt=0"`
+	Price       float64 `json:"price" validate:"required,gt=0"`
 	Title       string  `json:"title" validate:"required,min=3,max=100"`
 }
 
 // ModelServiceResponse defines model for ModelServiceResponse.
 type ModelServiceResponse struct {
-	CreatedAt   time.Time `json:"created_at"`
-	Description string    `json:"description"`
-	Id          int64     `json:"id"`
-	IsActive    bool      `json:"is_active"`
-	ModelId     int64     `json:"model_id"`
-	Price       float32   `json:"price"`
-	Title       string    `json:"title"`
+	CreatedAt time.Time `json:"created_at"`
+	// Currency ISO 4217 code of the price currency
+	Currency    string  `json:"currency"`
+	Description string  `json:"description"`
+	Id          int64   `json:"id"`
+	IsActive    bool    `json:"is_active"`
+	ModelId     int64   `json:"model_id"`
+	Price       float64 `json:"price"`
+	Title       string  `json:"title"`
 }
 
 // ModelServiceUpdateDTO defines model for ModelServiceUpdateDTO.
 type ModelServiceUpdateDTO struct {
 	Description *string  `json:"description,omitempty" validate:"required,max=1000"`
-	Price       *float32 `json:"price,omitempty" validate:"required,gt=0"`
+	Price       *float64 `json:"price,omitempty" validate:"required,gt=0"`
 	Title       *string  `json:"title,omitempty" validate:"required,min=3,max=100"`
 }
 
-// OrderResponse defines model for OrderResponse.
-type OrderResponse struct {
-	BookingID int64       `json:"bookingID"`
+// OrderEventResponse defines model for OrderEventResponse.
+type OrderEventResponse struct {
 	CreatedAt time.Time   `json:"createdAt"`
+	Eta       *time.Time  `json:"eta,omitempty"`
 	Id        int64       `json:"id"`
+	Latitude  *float64    `json:"latitude,omitempty"`
+	Longitude *float64    `json:"longitude,omitempty"`
+	OrderID   int64       `json:"orderID"`
 	Status    OrderStatus `json:"status"`
+	Type      string      `json:"type"`
+}
+
+// OrderExtensionRequest defines model for OrderExtensionRequest.
+type OrderExtensionRequest struct {
+	Minutes int `json:"minutes" validate:"required,min=15,max=240"`
+}
+
+// OrderExtensionResponse defines model for OrderExtensionResponse.
+type OrderExtensionResponse struct {
+	// Amount Pro-rata price of the extension, set once it is accepted
+	Amount    *float64             `json:"amount,omitempty"`
+	CreatedAt time.Time            `json:"createdAt"`
+	DecidedAt *time.Time           `json:"decidedAt,omitempty"`
+	Id        int64                `json:"id"`
+	Minutes   int                  `json:"minutes"`
+	OrderID   int64                `json:"orderID"`
+	Status    OrderExtensionStatus `json:"status"`
+}
+
+// OrderExtensionStatus defines model for OrderExtensionStatus.
+type OrderExtensionStatus string
+
+// OrderIssueRequest defines model for OrderIssueRequest.
+type OrderIssueRequest struct {
+	Reason string `json:"reason" validate:"required,min=1,max=1000"`
+}
+
+// OrderLocationRequest defines model for OrderLocationRequest.
+type OrderLocationRequest struct {
+	Eta       *time.Time `json:"eta,omitempty"`
+	Latitude  float64    `json:"latitude" validate:"min=-90,max=90"`
+	Longitude float64    `json:"longitude" validate:"min=-180,max=180"`
+}
+
+// OrderResponse defines model for OrderResponse.
+type OrderResponse struct {
+	// ArchivedAt Set only for an order read from the archive
+	ArchivedAt *time.Time `json:"archivedAt,omitempty"`
+	BookingID  int64      `json:"bookingID"`
+	// CompletedAt When the model marked the order as completed
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// ConfirmationDeadline Until this moment client can confirm the order or raise an issue, then it is confirmed automatically
+	ConfirmationDeadline *time.Time `json:"confirmationDeadline,omitempty"`
+	ConfirmedAt          *time.Time `json:"confirmedAt,omitempty"`
+	CreatedAt            time.Time  `json:"createdAt"`
+	// ExtensionAmount Price of all accepted extensions
+	ExtensionAmount float64 `json:"extensionAmount"`
+	// ExtensionMinutes Minutes added to the order by accepted extensions
+	ExtensionMinutes int         `json:"extensionMinutes"`
+	Id               int64       `json:"id"`
+	IssueReason      *string     `json:"issueReason,omitempty"`
+	Status           OrderStatus `json:"status"`
 }
 
 // OrderStatus defines model for OrderStatus.
 type OrderStatus string
 
+// PaymentEventType defines model for PaymentEventType.
+type PaymentEventType string
+
+// PaymentResponse defines model for PaymentResponse.
+type PaymentResponse struct {
+	// Amount Authorized amount
+	Amount            float64       `json:"amount"`
+	CapturedAmount    float64       `json:"capturedAmount"`
+	CreatedAt         time.Time     `json:"createdAt"`
+	FailureReason     *string       `json:"failureReason,omitempty"`
+	Id                int64         `json:"id"`
+	OrderID           int64         `json:"orderID"`
+	Provider          string        `json:"provider"`
+	ProviderPaymentID string        `json:"providerPaymentID"`
+	RefundedAmount    float64       `json:"refundedAmount"`
+	Status            PaymentStatus `json:"status"`
+	UpdatedAt         time.Time     `json:"updatedAt"`
+}
+
+// PaymentStatus defines model for PaymentStatus.
+type PaymentStatus string
+
+// PaymentWebhookRequest defines model for PaymentWebhookRequest.
+type PaymentWebhookRequest struct {
+	// Amount Captured or refunded amount, the whole remaining amount is used when it is omitted
+	Amount *float64 `json:"amount,omitempty" validate:"omitempty,gt=0"`
+	// EventID Unique id of the event, repeated deliveries with the same id are ignored
+	EventID           string           `json:"eventID" validate:"required,min=1,max=255"`
+	ProviderPaymentID string           `json:"providerPaymentID" validate:"required,min=1,max=255"`
+	Reason            *string          `json:"reason,omitempty" validate:"omitempty,max=1000"`
+	Type              PaymentEventType `json:"type"`
+}
+
+// PriceComponentResponse defines model for PriceComponentResponse.
+type PriceComponentResponse struct {
+	Amount        float64 `json:"amount"`
+	Name          string  `json:"name"`
+	PricingRuleID int64   `json:"pricingRuleID"`
+}
+
+// PricingRuleRequest defines model for PricingRuleRequest.
+type PricingRuleRequest struct {
+	// Amount Required for SURCHARGE, added to the price
+	Amount *float64 `json:"amount,omitempty"`
+	// EndTime Local end of the window, an end before the start runs past midnight
+	EndTime *string `json:"endTime,omitempty"`
+	// Holidays The rule applies on these dates only when set
+	Holidays *[]openapi_types.Date `json:"holidays,omitempty"`
+	IsActive *bool                 `json:"isActive,omitempty"`
+	// Multiplier Required for MULTIPLIER, 1.5 makes the price one and a half times higher
+	Multiplier *float64 `json:"multiplier,omitempty"`
+	Name       string   `json:"name" validate:"required,min=1,max=100"`
+	// StartTime Local start of the window, the whole day when omitted
+	StartTime *string         `json:"startTime,omitempty"`
+	Type      PricingRuleType `json:"type"`
+	// Weekdays Days the rule window starts on, 0 is Sunday, any day when omitted
+	Weekdays *[]int `json:"weekdays,omitempty"`
+}
+
+// PricingRuleResponse defines model for PricingRuleResponse.
+type PricingRuleResponse struct {
+	Amount     *float64             `json:"amount"`
+	CreatedAt  time.Time            `json:"createdAt"`
+	EndTime    *string              `json:"endTime"`
+	Holidays   []openapi_types.Date `json:"holidays"`
+	Id         int64                `json:"id"`
+	IsActive   bool                 `json:"isActive"`
+	ModelID    int64                `json:"modelID"`
+	Multiplier *float64             `json:"multiplier"`
+	Name       string               `json:"name"`
+	StartTime  *string              `json:"startTime"`
+	Type       PricingRuleType      `json:"type"`
+	UpdatedAt  time.Time            `json:"updatedAt"`
+	Weekdays   []int                `json:"weekdays"`
+}
+
+// PricingRuleType defines model for PricingRuleType.
+type PricingRuleType string
+
+// PromoCodeRequest defines model for PromoCodeRequest.
+type PromoCodeRequest struct {
+	// AmountOff Required for FIXED
+	AmountOff    *float64     `json:"amountOff,omitempty"`
+	Code         string       `json:"code" validate:"required,min=3,max=50,alphanum"`
+	DiscountType DiscountType `json:"discountType"`
+	// MaxUses Limit for all clients, unlimited when omitted
+	MaxUses *int `json:"maxUses,omitempty"`
+	// MaxUsesPerClient Limit for one client, unlimited when omitted
+	MaxUsesPerClient *int `json:"maxUsesPerClient,omitempty"`
+	// ModelIDs The code applies only to services of these models when set
+	ModelIDs *[]int64 `json:"modelIDs,omitempty"`
+	// PercentOff Required for PERCENT
+	PercentOff *int `json:"percentOff,omitempty"`
+	// ServiceIDs The code applies only to these services when set
+	ServiceIDs *[]int64   `json:"serviceIDs,omitempty"`
+	ValidFrom  *time.Time `json:"validFrom,omitempty"`
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+// PromoCodeResponse defines model for PromoCodeResponse.
+type PromoCodeResponse struct {
+	AmountOff        *float64     `json:"amountOff"`
+	Code             string       `json:"code"`
+	CreatedAt        time.Time    `json:"createdAt"`
+	DiscountType     DiscountType `json:"discountType"`
+	Id               int64        `json:"id"`
+	IsActive         bool         `json:"isActive"`
+	MaxUses          *int         `json:"maxUses"`
+	MaxUsesPerClient *int         `json:"maxUsesPerClient"`
+	ModelIDs         []int64      `json:"modelIDs"`
+	PercentOff       *int         `json:"percentOff"`
+	ServiceIDs       []int64      `json:"serviceIDs"`
+	UsedCount        int          `json:"usedCount"`
+	ValidFrom        *time.Time   `json:"validFrom"`
+	ValidUntil       *time.Time   `json:"validUntil"`
+}
+
+// ReceiptFormat defines model for ReceiptFormat.
+type ReceiptFormat string
+
+// ReceiptLineResponse defines model for ReceiptLineResponse.
+type ReceiptLineResponse struct {
+	// Amount Negative for the discount and refunds
+	Amount float64 `json:"amount"`
+	Title  string  `json:"title"`
+}
+
+// ReceiptResponse defines model for ReceiptResponse.
+type ReceiptResponse struct {
+	ClientID  int64                 `json:"clientID"`
+	Currency  string                `json:"currency"`
+	Id        int64                 `json:"id"`
+	IssuedAt  time.Time             `json:"issuedAt"`
+	Lines     []ReceiptLineResponse `json:"lines"`
+	ModelID   int64                 `json:"modelID"`
+	ModelName string                `json:"modelName"`
+	// Number Sequential receipt number without gaps
+	Number        string `json:"number"`
+	OrderID       int64  `json:"orderID"`
+	PaymentMethod string `json:"paymentMethod"`
+	ServiceTitle  string `json:"serviceTitle"`
+	// Total Money paid by the client, refunds are taken off
+	Total float64 `json:"total"`
+}
+
 // RegisterDTO defines model for RegisterDTO.
 type RegisterDTO struct {
 	Email    openapi_types.Email `json:"email" validate:"required,email"`
@@ -191,6 +884,58 @@ type RegisterDTO struct {
 // RegisterDTORole defines model for RegisterDTO.Role.
 type RegisterDTORole string
 
+// SlotBatchErrorResponse defines model for SlotBatchErrorResponse.
+type SlotBatchErrorResponse struct {
+	Code    string               `json:"code"`
+	Items   []SlotBatchItemError `json:"items"`
+	Message string               `json:"message"`
+}
+
+// SlotBatchItemError defines model for SlotBatchItemError.
+type SlotBatchItemError struct {
+	Code string `json:"code"`
+	// Index Position of the slot in the request
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+// SlotBatchRequest defines model for SlotBatchRequest.
+type SlotBatchRequest struct {
+	Slots []SlotPeriodRequest `json:"slots" validate:"required,min=1,max=100"`
+}
+
+// SlotMergeRequest defines model for SlotMergeRequest.
+type SlotMergeRequest struct {
+	SlotIds []int64 `json:"slotIds" validate:"required,min=2,max=100"`
+}
+
+// SlotPeriodRequest defines model for SlotPeriodRequest.
+type SlotPeriodRequest struct {
+	End   time.Time `json:"end"`
+	Start time.Time `json:"start"`
+}
+
+// SlotPreviewResponse defines model for SlotPreviewResponse.
+type SlotPreviewResponse struct {
+	EndTime time.Time `json:"endTime"`
+	// Skipped The slot overlaps a slot the model already has and will not be generated
+	Skipped   bool      `json:"skipped"`
+	StartTime time.Time `json:"startTime"`
+}
+
+// SlotRangeDisableResponse defines model for SlotRangeDisableResponse.
+type SlotRangeDisableResponse struct {
+	Disabled []SlotResponse `json:"disabled"`
+	// Skipped Slots in the range that are not available, their status tells why
+	Skipped []SlotResponse `json:"skipped"`
+}
+
+// SlotRangeRequest defines model for SlotRangeRequest.
+type SlotRangeRequest struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
 // SlotResponse defines model for SlotResponse.
 type SlotResponse struct {
 	CreatedAt time.Time  `json:"createdAt"`
@@ -201,6 +946,14 @@ type SlotResponse struct {
 	Status    SlotStatus `json:"status"`
 }
 
+// SlotSplitRequest Either the length of the pieces or the cut points
+type SlotSplitRequest struct {
+	// Cuts Ascending points inside the slot
+	Cuts *[]time.Time `json:"cuts,omitempty" validate:"omitempty,max=99"`
+	// LengthMinutes Length of every piece, the last one keeps the rest
+	LengthMinutes *int `json:"lengthMinutes,omitempty" validate:"omitempty,min=1"`
+}
+
 // SlotStatus defines model for SlotStatus.
 type SlotStatus string
 
@@ -209,6 +962,70 @@ type StatusResponse struct {
 	Status string `json:"status"`
 }
 
+// TimeOffChangeResponse defines model for TimeOffChangeResponse.
+type TimeOffChangeResponse struct {
+	// Conflicts Reserved and booked slots within the time-off, they are not changed and need attention
+	Conflicts []SlotResponse `json:"conflicts"`
+	// Disabled Available slots disabled because of the time-off
+	Disabled []SlotResponse `json:"disabled"`
+	// Enabled Slots available again because their time-off is deleted
+	Enabled []SlotResponse  `json:"enabled"`
+	TimeOff TimeOffResponse `json:"timeOff"`
+}
+
+// TimeOffRequest defines model for TimeOffRequest.
+type TimeOffRequest struct {
+	EndTime   time.Time `json:"endTime"`
+	Reason    *string   `json:"reason,omitempty" validate:"omitempty,max=255"`
+	StartTime time.Time `json:"startTime"`
+}
+
+// TimeOffResponse defines model for TimeOffResponse.
+type TimeOffResponse struct {
+	CreatedAt time.Time `json:"createdAt"`
+	EndTime   time.Time `json:"endTime"`
+	Id        int64     `json:"id"`
+	Reason    *string   `json:"reason,omitempty"`
+	StartTime time.Time `json:"startTime"`
+}
+
+// TravelBufferRequest defines model for TravelBufferRequest.
+type TravelBufferRequest struct {
+	// AfterMinutes Time kept free after every slot
+	AfterMinutes int `json:"afterMinutes" validate:"min=0,max=720"`
+	// BeforeMinutes Time kept free before every slot
+	BeforeMinutes int `json:"beforeMinutes" validate:"min=0,max=720"`
+	// Fee Added to the price of every booking for getting to the client, 0 when absent
+	Fee *float64 `json:"fee,omitempty" validate:"omitempty,min=0"`
+}
+
+// TravelBufferResponse defines model for TravelBufferResponse.
+type TravelBufferResponse struct {
+	AfterMinutes  int     `json:"afterMinutes"`
+	BeforeMinutes int     `json:"beforeMinutes"`
+	Fee           float64 `json:"fee"`
+	// UpdatedAt Absent until the model sets the buffer
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// TrialBalanceLineResponse defines model for TrialBalanceLineResponse.
+type TrialBalanceLineResponse struct {
+	AccountType LedgerAccountType `json:"accountType"`
+	// Balance Credit minus debit
+	Balance float64 `json:"balance"`
+	Credit  float64 `json:"credit"`
+	Debit   float64 `json:"debit"`
+}
+
+// TrialBalanceResponse defines model for TrialBalanceResponse.
+type TrialBalanceResponse struct {
+	// Balanced True when all the entries of the ledger sum to zero
+	Balanced    bool                       `json:"balanced"`
+	Lines       []TrialBalanceLineResponse `json:"lines"`
+	TotalCredit float64                    `json:"totalCredit"`
+	TotalDebit  float64                    `json:"totalDebit"`
+}
+
 // UpdateBookingStatusRequest defines model for UpdateBookingStatusRequest.
 type UpdateBookingStatusRequest struct {
 	Status UpdateBookingStatusRequestStatus `json:"status" validate:"required,oneof=APPROVED REJECTED"`
@@ -224,8 +1041,9 @@ type UpdateStatusRequest struct {
 
 // UserDTO defines model for UserDTO.
 type UserDTO struct {
-	BirthDate string `json:"birth_date" validate:"required,datetime=2006-01-02"`
-	Name      string `json:"name" validate:"required,min=2,max=30"`
+	BirthDate string  `json:"birth_date" validate:"required,datetime=2006-01-02"`
+	Name      string  `json:"name" validate:"required,min=2,max=30"`
+	TimeZone  *string `json:"time_zone,omitempty" validate:"omitempty,max=64"`
 }
 
 // UserResponse defines model for UserResponse.
@@ -234,9 +1052,11 @@ type UserResponse struct {
 	Id         int64              `json:"id"`
 	IsVerified bool               `json:"is_verified"`
 	Name       string             `json:"name"`
+	TimeZone   string             `json:"time_zone"`
 }
 
 // UserUpdateDTO defines model for UserUpdateDTO.
 type UserUpdateDTO struct {
-	Name string `json:"name" validate:"required,min=2,max=30"`
+	Name     string  `json:"name" validate:"required,min=2,max=30"`
+	TimeZone *string `json:"time_zone,omitempty" validate:"omitempty,max=64"`
 }
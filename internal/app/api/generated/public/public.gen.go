@@ -7,19 +7,29 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	externalRef0 "github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/models"
 	"github.com/gorilla/mux"
+	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 )
 
+// PostPaymentsWebhookParams defines parameters for PostPaymentsWebhook.
+type PostPaymentsWebhookParams struct {
+	XWebhookSecret string `json:"X-Webhook-Secret"`
+}
+
 // PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
 type PostAuthLoginJSONRequestBody = externalRef0.LoginDTO
 
 // PostAuthRegisterJSONRequestBody defines body for PostAuthRegister for application/json ContentType.
 type PostAuthRegisterJSONRequestBody = externalRef0.RegisterDTO
 
+// PostPaymentsWebhookJSONRequestBody defines body for PostPaymentsWebhook for application/json ContentType.
+type PostPaymentsWebhookJSONRequestBody = externalRef0.PaymentWebhookRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Login
@@ -28,6 +38,12 @@ type ServerInterface interface {
 	// Register anyone(client/model/admin)
 	// (POST /auth/register)
 	PostAuthRegister(w http.ResponseWriter, r *http.Request)
+	// iCalendar (RFC 5545) feed of a model or a client, calendar apps subscribe to it without JWT
+	// (GET /calendar/{token})
+	GetCalendarToken(w http.ResponseWriter, r *http.Request, token string)
+	// Payment provider callback, every event is applied once
+	// (POST /payments/webhook)
+	PostPaymentsWebhook(w http.ResponseWriter, r *http.Request, params PostPaymentsWebhookParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -67,6 +83,75 @@ func (siw *ServerInterfaceWrapper) PostAuthRegister(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
+// GetCalendarToken operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", mux.Vars(r)["token"], &token, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendarToken(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPaymentsWebhook operation middleware
+func (siw *ServerInterfaceWrapper) PostPaymentsWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPaymentsWebhookParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Webhook-Secret" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Webhook-Secret")]; found {
+		var XWebhookSecret string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Webhook-Secret", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Webhook-Secret", valueList[0], &XWebhookSecret, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Webhook-Secret", Err: err})
+			return
+		}
+
+		params.XWebhookSecret = XWebhookSecret
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Webhook-Secret is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Webhook-Secret", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPaymentsWebhook(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -184,6 +269,10 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/auth/register", wrapper.PostAuthRegister).Methods("POST")
 
+	r.HandleFunc(options.BaseURL+"/calendar/{token}", wrapper.GetCalendarToken).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/payments/webhook", wrapper.PostPaymentsWebhook).Methods("POST")
+
 	return r
 }
 
@@ -291,6 +380,87 @@ func (response PostAuthRegister500Response) VisitPostAuthRegisterResponse(w http
 	return nil
 }
 
+type GetCalendarTokenRequestObject struct {
+	Token string `json:"token"`
+}
+
+type GetCalendarTokenResponseObject interface {
+	VisitGetCalendarTokenResponse(w http.ResponseWriter) error
+}
+
+type GetCalendarToken200TextcalendarResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetCalendarToken200TextcalendarResponse) VisitGetCalendarTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/calendar")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCalendarToken404JSONResponse externalRef0.ErrorResponse
+
+func (response GetCalendarToken404JSONResponse) VisitGetCalendarTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPaymentsWebhookRequestObject struct {
+	Params PostPaymentsWebhookParams
+	Body   *PostPaymentsWebhookJSONRequestBody
+}
+
+type PostPaymentsWebhookResponseObject interface {
+	VisitPostPaymentsWebhookResponse(w http.ResponseWriter) error
+}
+
+type PostPaymentsWebhook200JSONResponse externalRef0.PaymentResponse
+
+func (response PostPaymentsWebhook200JSONResponse) VisitPostPaymentsWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPaymentsWebhook400JSONResponse externalRef0.ErrorResponse
+
+func (response PostPaymentsWebhook400JSONResponse) VisitPostPaymentsWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPaymentsWebhook401JSONResponse externalRef0.ErrorResponse
+
+func (response PostPaymentsWebhook401JSONResponse) VisitPostPaymentsWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPaymentsWebhook404JSONResponse externalRef0.ErrorResponse
+
+func (response PostPaymentsWebhook404JSONResponse) VisitPostPaymentsWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Login
@@ -299,6 +469,12 @@ type StrictServerInterface interface {
 	// Register anyone(client/model/admin)
 	// (POST /auth/register)
 	PostAuthRegister(ctx context.Context, request PostAuthRegisterRequestObject) (PostAuthRegisterResponseObject, error)
+	// iCalendar (RFC 5545) feed of a model or a client, calendar apps subscribe to it without JWT
+	// (GET /calendar/{token})
+	GetCalendarToken(ctx context.Context, request GetCalendarTokenRequestObject) (GetCalendarTokenResponseObject, error)
+	// Payment provider callback, every event is applied once
+	// (POST /payments/webhook)
+	PostPaymentsWebhook(ctx context.Context, request PostPaymentsWebhookRequestObject) (PostPaymentsWebhookResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -391,3 +567,62 @@ func (sh *strictHandler) PostAuthRegister(w http.ResponseWriter, r *http.Request
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// GetCalendarToken operation middleware
+func (sh *strictHandler) GetCalendarToken(w http.ResponseWriter, r *http.Request, token string) {
+	var request GetCalendarTokenRequestObject
+
+	request.Token = token
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCalendarToken(ctx, request.(GetCalendarTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCalendarToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCalendarTokenResponseObject); ok {
+		if err := validResponse.VisitGetCalendarTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPaymentsWebhook operation middleware
+func (sh *strictHandler) PostPaymentsWebhook(w http.ResponseWriter, r *http.Request, params PostPaymentsWebhookParams) {
+	var request PostPaymentsWebhookRequestObject
+
+	request.Params = params
+
+	var body PostPaymentsWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPaymentsWebhook(ctx, request.(PostPaymentsWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPaymentsWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPaymentsWebhookResponseObject); ok {
+		if err := validResponse.VisitPostPaymentsWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
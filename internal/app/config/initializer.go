@@ -3,17 +3,22 @@ package config
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/adapter"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/config/http_handler"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/handler"
 	metrics2 "github.com/alishashelby/Samok-Aah-t/backend/internal/app/metrics"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/worker"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/metrics"
 	service2 "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/broker"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/ical"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/payment"
 	persistence "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence/postgres"
 	env "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/config"
 	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
@@ -23,6 +28,13 @@ type Initializer struct {
 	server         *http.Server
 	Logger         pkg.Logger
 	metricsUpdater *metrics2.MetricsUpdater
+	eventBroker    *broker.OrderEventBroker
+
+	orderConfirmationWorker *worker.OrderConfirmationWorker
+	bookingExpiryWorker     *worker.BookingExpiryWorker
+	slotGenerationWorker    *worker.SlotGenerationWorker
+	busyCalendarSyncWorker  *worker.BusyCalendarSyncWorker
+	retentionWorker         *worker.RetentionWorker
 }
 
 func New(envConfig *env.EnvConfig, db *postgres.PostgresDb,
@@ -37,53 +49,175 @@ func New(envConfig *env.EnvConfig, db *postgres.PostgresDb,
 		return nil, err
 	}
 
+	addOnRepo := persistence.NewDefaultAddOnRepository(db)
 	adminRepo := persistence.NewDefaultAdminRepository(db)
 	authRepo := persistence.NewDefaultAuthRepository(db)
+	availabilityRepo := persistence.NewDefaultAvailabilityRepository(db)
+	availabilityTemplateRepo := persistence.NewDefaultAvailabilityTemplateRepository(db)
+	calendarFeedRepo := persistence.NewDefaultCalendarFeedRepository(db)
 	bookingRepo := persistence.NewDefaultBookingRepository(db)
+	bookingRulesRepo := persistence.NewDefaultBookingRulesRepository(db)
+	busyCalendarRepo := persistence.NewDefaultBusyCalendarRepository(db)
+	disputeRepo := persistence.NewDefaultDisputeRepository(db)
+	ledgerRepo := persistence.NewDefaultLedgerRepository(db)
 	modelServiceRepo := persistence.NewDefaultModelServiceRepository(db)
+	orderExtensionRepo := persistence.NewDefaultOrderExtensionRepository(db)
 	orderRepo := persistence.NewDefaultOrderRepository(db)
+	paymentRepo := persistence.NewDefaultPaymentRepository(db)
+	pricingRuleRepo := persistence.NewDefaultPricingRuleRepository(db)
+	promoCodeRepo := persistence.NewDefaultPromoCodeRepository(db)
+	receiptRepo := persistence.NewDefaultReceiptRepository(db)
+	retentionRepo := persistence.NewDefaultRetentionRepository(db)
 	slotRepo := persistence.NewDefaultSlotRepository(db)
+	timeOffRepo := persistence.NewDefaultTimeOffRepository(db)
+	travelBufferRepo := persistence.NewDefaultTravelBufferRepository(db)
 	userRepo := persistence.NewDefaultUserRepository(db)
 
+	eventBroker := broker.NewOrderEventBroker(0, log)
+	paymentProvider := payment.NewFakeProvider()
+	calendarReader := ical.NewReader(&http.Client{Timeout: 10 * time.Second})
+
+	travelBufferService := service2.NewDefaultTravelBufferService(travelBufferRepo, userRepo, log)
+	bookingRulesService, err := service2.NewDefaultBookingRulesService(bookingRulesRepo, userRepo, log)
+	if err != nil {
+		return nil, err
+	}
+
 	jwtService, err := service2.NewJWTService()
 	if err != nil {
 		return nil, err
 	}
 
-	adminService := service2.NewDefaultAdminService(
-		adminRepo, userRepo, bookingRepo, orderRepo, txManager, log)
+	ledgerService, err := service2.NewDefaultLedgerService(
+		ledgerRepo, orderRepo, bookingRepo, modelServiceRepo, userRepo, txManager, log)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentService, err := service2.NewDefaultPaymentService(
+		paymentRepo, paymentProvider, ledgerService, txManager, log)
+	if err != nil {
+		return nil, err
+	}
+
 	authService := service2.NewDefaultAuthService(
 		authRepo, jwtService, txManager, log)
 
+	pricingRuleService, err := service2.NewDefaultPricingRuleService(pricingRuleRepo, userRepo, log)
+	if err != nil {
+		return nil, err
+	}
+
+	promoCodeService := service2.NewDefaultPromoCodeService(promoCodeRepo, txManager, log)
+	receiptService := service2.NewDefaultReceiptService(
+		receiptRepo, orderRepo, bookingRepo, modelServiceRepo, userRepo, log)
+	quoteTokenService, err := service2.NewQuoteTokenService()
+	if err != nil {
+		return nil, err
+	}
 	bookingService, err := service2.NewDefaultBookingService(
-		bookingRepo, slotRepo, userRepo, modelServiceRepo, orderRepo, txManager, log)
+		bookingRepo, slotRepo, userRepo, modelServiceRepo, addOnRepo, orderRepo, paymentService, pricingRuleService,
+		promoCodeService, quoteTokenService, travelBufferService, bookingRulesService, txManager, log)
 	if err != nil {
 		return nil, err
 	}
+	bookingExpiryWorker := worker.NewBookingExpiryWorker(
+		bookingService, envConfig.BookingExpiryInterval, log)
 
 	m := metrics.NewMetrics()
 	metricsUpdater := metrics2.NewMetricsUpdater(
 		userRepo, m, envConfig.MetricsInterval, log)
 
+	disputeService, err := service2.NewDefaultDisputeService(
+		disputeRepo, orderRepo, bookingRepo, slotRepo, userRepo, adminRepo, modelServiceRepo, eventBroker,
+		paymentService, ledgerService, receiptService, txManager, log)
+	if err != nil {
+		return nil, err
+	}
+
+	addOnService := service2.NewDefaultAddOnService(addOnRepo, modelServiceRepo, userRepo, log)
 	modelServiceService := service2.NewDefaultModelServiceService(
-		modelServiceRepo, userRepo, txManager, log)
-	orderService := service2.NewDefaultOrderService(
-		orderRepo, bookingRepo, slotRepo, userRepo, modelServiceRepo, txManager, log, m)
+		modelServiceRepo, addOnRepo, userRepo, txManager, log)
+	orderService, err := service2.NewDefaultOrderService(
+		orderRepo, bookingRepo, slotRepo, userRepo, modelServiceRepo, eventBroker, paymentService, promoCodeService,
+		receiptService, disputeService, txManager, log, m)
+	if err != nil {
+		return nil, err
+	}
+	adminService := service2.NewDefaultAdminService(
+		adminRepo, userRepo, bookingRepo, orderRepo, eventBroker, orderService, log)
+	orderConfirmationWorker := worker.NewOrderConfirmationWorker(
+		orderService, envConfig.OrderConfirmationInterval, log)
+
+	orderExtensionService := service2.NewDefaultOrderExtensionService(
+		orderExtensionRepo, orderRepo, bookingRepo, slotRepo, userRepo, modelServiceRepo, paymentService,
+		travelBufferService, txManager, log)
+	orderTrackingService := service2.NewDefaultOrderTrackingService(
+		orderRepo, bookingRepo, userRepo, modelServiceRepo, eventBroker, log)
 	slotService := service2.NewDefaultSlotService(
-		slotRepo, bookingRepo, userRepo, txManager, log)
-	userService := service2.NewDefaultUserService(userRepo, txManager, log)
+		slotRepo, bookingRepo, userRepo, timeOffRepo, travelBufferService, bookingRulesService, txManager, log)
+	userService, err := service2.NewDefaultUserService(userRepo, txManager, log)
+	if err != nil {
+		return nil, err
+	}
+	calendarFeedService := service2.NewDefaultCalendarFeedService(calendarFeedRepo, slotRepo, userRepo, log)
+	availabilityService := service2.NewDefaultAvailabilityService(availabilityRepo, userRepo, log)
+
+	availabilityTemplateService, err := service2.NewDefaultAvailabilityTemplateService(
+		availabilityTemplateRepo, slotRepo, userRepo, timeOffRepo, bookingRulesService, txManager, log)
+	if err != nil {
+		return nil, err
+	}
+	slotGenerationWorker := worker.NewSlotGenerationWorker(
+		availabilityTemplateService, envConfig.SlotGenerationInterval, log)
+
+	busyCalendarService, err := service2.NewDefaultBusyCalendarService(
+		busyCalendarRepo, slotRepo, userRepo, calendarReader, txManager, log)
+	if err != nil {
+		return nil, err
+	}
+	busyCalendarSyncWorker := worker.NewBusyCalendarSyncWorker(
+		busyCalendarService, envConfig.BusyCalendarSyncInterval, log)
+	timeOffService := service2.NewDefaultTimeOffService(timeOffRepo, slotRepo, userRepo, txManager, log)
 
-	adminHandler := handler.NewAdminHandler(adminService, log)
+	retentionService, err := service2.NewDefaultRetentionService(retentionRepo, txManager, log, m)
+	if err != nil {
+		return nil, err
+	}
+	retentionWorker := worker.NewRetentionWorker(
+		retentionService, envConfig.RetentionInterval, log)
+
+	addOnHandler := handler.NewAddOnHandler(addOnService, log)
+	adminHandler := handler.NewAdminHandler(adminService, userService, log)
 	authHandler := handler.NewAuthHandler(authService, log)
+	availabilityTemplateHandler := handler.NewAvailabilityTemplateHandler(availabilityTemplateService, userService, log)
+	availabilitySearchHandler := handler.NewAvailabilitySearchHandler(availabilityService, userService, log)
 	bookingHandler := handler.NewBookingHandler(bookingService, log)
-	orderHandler := handler.NewOrderHandler(orderService, log)
+	busyCalendarHandler := handler.NewBusyCalendarHandler(busyCalendarService, userService, log)
+	calendarFeedHandler := handler.NewCalendarFeedHandler(calendarFeedService, log)
+	disputeHandler := handler.NewDisputeHandler(disputeService, log)
+	ledgerHandler := handler.NewLedgerHandler(ledgerService, log)
+	orderHandler := handler.NewOrderHandler(orderService, userService, log)
+	orderExtensionHandler := handler.NewOrderExtensionHandler(orderExtensionService, log)
+	paymentHandler := handler.NewPaymentHandler(paymentService, log)
+	pricingRuleHandler := handler.NewPricingRuleHandler(pricingRuleService, log)
+	promoCodeHandler := handler.NewPromoCodeHandler(promoCodeService, log)
+	receiptHandler := handler.NewReceiptHandler(receiptService, log)
+	orderTrackingHandler := handler.NewOrderTrackingHandler(orderTrackingService, userService,
+		envConfig.SSEHeartbeat, log)
 	modelServiceHandler := handler.NewModelServiceHandler(modelServiceService, log)
-	slotHandler := handler.NewSlotHandler(slotService, log)
+	slotHandler := handler.NewSlotHandler(slotService, userService, log)
+	timeOffHandler := handler.NewTimeOffHandler(timeOffService, userService, log)
+	travelBufferHandler := handler.NewTravelBufferHandler(travelBufferService, log)
+	bookingRulesHandler := handler.NewBookingRulesHandler(bookingRulesService, log)
 	userHandler := handler.NewUserHandler(userService, log)
 
-	publicAdapter := adapter.NewPublicAdapter(authHandler)
+	publicAdapter := adapter.NewPublicAdapter(authHandler, paymentHandler, calendarFeedHandler)
 	authorizedAdapter := adapter.NewAuthorizedAdapter(
-		userHandler, modelServiceHandler, slotHandler, bookingHandler, &orderHandler, adminHandler)
+		userHandler, modelServiceHandler, addOnHandler, slotHandler, bookingHandler, &orderHandler, orderTrackingHandler,
+		disputeHandler, orderExtensionHandler, paymentHandler, ledgerHandler, pricingRuleHandler,
+		availabilityTemplateHandler, promoCodeHandler, receiptHandler, calendarFeedHandler,
+		busyCalendarHandler, travelBufferHandler, bookingRulesHandler, timeOffHandler, availabilitySearchHandler, adminHandler)
 	r := http_handler.BuildHTTPHandler(publicAdapter, authorizedAdapter, jwtService, m, log)
 
 	return &Initializer{
@@ -93,11 +227,23 @@ func New(envConfig *env.EnvConfig, db *postgres.PostgresDb,
 		},
 		Logger:         log,
 		metricsUpdater: metricsUpdater,
+		eventBroker:    eventBroker,
+
+		orderConfirmationWorker: orderConfirmationWorker,
+		bookingExpiryWorker:     bookingExpiryWorker,
+		slotGenerationWorker:    slotGenerationWorker,
+		busyCalendarSyncWorker:  busyCalendarSyncWorker,
+		retentionWorker:         retentionWorker,
 	}, nil
 }
 
 func (i *Initializer) Run(ctx context.Context) error {
 	go i.metricsUpdater.Start(ctx)
+	go i.orderConfirmationWorker.Start(ctx)
+	go i.bookingExpiryWorker.Start(ctx)
+	go i.slotGenerationWorker.Start(ctx)
+	go i.busyCalendarSyncWorker.Start(ctx)
+	go i.retentionWorker.Start(ctx)
 
 	if err := i.server.ListenAndServe(); err != nil {
 		return err
@@ -107,5 +253,8 @@ func (i *Initializer) Run(ctx context.Context) error {
 }
 
 func (i *Initializer) Shutdown(ctx context.Context) error {
+	// open event streams never end by themselves, so they are closed before the server waits for requests
+	i.eventBroker.Close()
+
 	return i.server.Shutdown(ctx)
 }
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type AddOnService interface {
+	CreateAddOn(ctx context.Context, serviceID int64, title, description string,
+		price entity.Money, extraMinutes int) (*entity.AddOn, error)
+	GetAddOnsByServiceID(ctx context.Context, serviceID int64) ([]*entity.AddOn, error)
+	GetActiveAddOns(ctx context.Context, serviceID int64) ([]*entity.AddOn, error)
+	UpdateAddOn(ctx context.Context, id int64, title, description *string,
+		price *entity.Money, extraMinutes *int) (*entity.AddOn, error)
+	DeactivateAddOn(ctx context.Context, id int64) (*entity.AddOn, error)
+}
+
+type AddOnHandler struct {
+	service  AddOnService
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewAddOnHandler(service AddOnService, logger pkg.Logger) *AddOnHandler {
+	return &AddOnHandler{
+		service:  service,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *AddOnHandler) CreateAddOn(ctx context.Context,
+	request authorized.PostModelServicesIdAddOnsRequestObject,
+) (authorized.PostModelServicesIdAddOnsResponseObject, error) {
+
+	h.logger.Info(ctx, "AddOnHandler.CreateAddOn")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	var description string
+	if request.Body.Description != nil {
+		description = *request.Body.Description
+	}
+	var extraMinutes int
+	if request.Body.ExtraMinutes != nil {
+		extraMinutes = *request.Body.ExtraMinutes
+	}
+
+	res, err := h.service.CreateAddOn(ctx, request.Id, request.Body.Title, description,
+		entity.MoneyFromFloat(request.Body.Price), extraMinutes)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelServicesIdAddOns201JSONResponse(mapping.ToGeneratedAddOn(res)), nil
+}
+
+func (h *AddOnHandler) GetAddOnsByServiceID(ctx context.Context,
+	request authorized.GetModelServicesIdAddOnsRequestObject,
+) (authorized.GetModelServicesIdAddOnsResponseObject, error) {
+
+	h.logger.Info(ctx, "AddOnHandler.GetAddOnsByServiceID")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	addOns, err := h.service.GetAddOnsByServiceID(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(authorized.GetModelServicesIdAddOns200JSONResponse, len(addOns))
+	for i, a := range addOns {
+		res[i] = mapping.ToGeneratedAddOn(a)
+	}
+
+	return res, nil
+}
+
+func (h *AddOnHandler) GetActiveAddOns(ctx context.Context,
+	request authorized.GetClientServicesIdAddOnsRequestObject,
+) (authorized.GetClientServicesIdAddOnsResponseObject, error) {
+
+	h.logger.Info(ctx, "AddOnHandler.GetActiveAddOns")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	addOns, err := h.service.GetActiveAddOns(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(authorized.GetClientServicesIdAddOns200JSONResponse, len(addOns))
+	for i, a := range addOns {
+		res[i] = mapping.ToGeneratedAddOn(a)
+	}
+
+	return res, nil
+}
+
+func (h *AddOnHandler) UpdateAddOn(ctx context.Context,
+	request authorized.PatchModelAddOnsIdRequestObject,
+) (authorized.PatchModelAddOnsIdResponseObject, error) {
+
+	h.logger.Info(ctx, "AddOnHandler.UpdateAddOn")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.UpdateAddOn(ctx, request.Id, request.Body.Title, request.Body.Description,
+		mapping.FromGeneratedMoneyPtr(request.Body.Price), request.Body.ExtraMinutes)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PatchModelAddOnsId200JSONResponse(mapping.ToGeneratedAddOn(res)), nil
+}
+
+func (h *AddOnHandler) DeactivateAddOn(ctx context.Context,
+	request authorized.PatchModelAddOnsIdDeactivateRequestObject,
+) (authorized.PatchModelAddOnsIdDeactivateResponseObject, error) {
+
+	h.logger.Info(ctx, "AddOnHandler.DeactivateAddOn")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.DeactivateAddOn(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PatchModelAddOnsIdDeactivate200JSONResponse(mapping.ToGeneratedAddOn(res)), nil
+}
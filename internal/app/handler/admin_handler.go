@@ -25,19 +25,21 @@ type AdminService interface {
 	GetOrderByID(ctx context.Context, orderID int64) (*entity.Order, error)
 	UpdateOrderStatus(ctx context.Context, orderID int64, status entity.OrderStatus) (*entity.Order, error)
 	GetAllUsers(ctx context.Context, page, limit *int64) ([]*entity.User, error)
-	GetAllBookings(ctx context.Context, page, limit *int64) ([]*entity.Booking, error)
-	GetAllOrders(ctx context.Context, page, limit *int64) ([]*entity.Order, error)
+	GetAllBookings(ctx context.Context, page, limit *int64, archived *bool) ([]*entity.Booking, error)
+	GetAllOrders(ctx context.Context, page, limit *int64, archived *bool) ([]*entity.Order, error)
 }
 
 type AdminHandler struct {
 	service  AdminService
+	zones    ViewerZone
 	logger   pkg.Logger
 	validate *validator.Validate
 }
 
-func NewAdminHandler(service AdminService, logger pkg.Logger) *AdminHandler {
+func NewAdminHandler(service AdminService, zones ViewerZone, logger pkg.Logger) *AdminHandler {
 	return &AdminHandler{
 		service:  service,
+		zones:    zones,
 		logger:   logger,
 		validate: validator.New(),
 	}
@@ -191,23 +193,14 @@ func (h *AdminHandler) GetAllBookings(
 		return nil, err
 	}
 
-	bookings, err := h.service.GetAllBookings(ctx, request.Params.Page, request.Params.Limit)
+	bookings, err := h.service.GetAllBookings(ctx, request.Params.Page, request.Params.Limit, request.Params.Archived)
 	if err != nil {
 		return nil, err
 	}
 
 	res := make(authorized.GetAdminBookings200JSONResponse, len(bookings))
 	for i, b := range bookings {
-		res[i] = models.BookingResponse{
-			Id:             b.ID,
-			ModelServiceID: b.ModelServiceID,
-			ClientID:       b.ClientID,
-			SlotID:         b.SlotID,
-			Address:        mapping.ToGeneratedAddress(b.Address),
-			Status:         models.BookingStatus(b.Status),
-			ExpiresAt:      b.ExpiresAt,
-			CreatedAt:      b.CreatedAt,
-		}
+		res[i] = mapping.ToGeneratedBooking(b)
 	}
 
 	return res, nil
@@ -233,16 +226,7 @@ func (h *AdminHandler) UpdateBookingStatus(
 		return nil, err
 	}
 
-	return authorized.PatchAdminBookingsIdStatus200JSONResponse{
-		Id:             res.ID,
-		ModelServiceID: res.ModelServiceID,
-		ClientID:       res.ClientID,
-		SlotID:         res.SlotID,
-		Address:        mapping.ToGeneratedAddress(res.Address),
-		Status:         models.BookingStatus(res.Status),
-		ExpiresAt:      res.ExpiresAt,
-		CreatedAt:      res.CreatedAt,
-	}, nil
+	return authorized.PatchAdminBookingsIdStatus200JSONResponse(mapping.ToGeneratedBooking(res)), nil
 }
 
 func (h *AdminHandler) GetAllOrders(
@@ -259,19 +243,15 @@ func (h *AdminHandler) GetAllOrders(
 		return nil, err
 	}
 
-	orders, err := h.service.GetAllOrders(ctx, request.Params.Page, request.Params.Limit)
+	orders, err := h.service.GetAllOrders(ctx, request.Params.Page, request.Params.Limit, request.Params.Archived)
 	if err != nil {
 		return nil, err
 	}
 
+	location := viewerLocation(ctx, h.zones, h.logger)
 	res := make(authorized.GetAdminOrders200JSONResponse, len(orders))
 	for i, o := range orders {
-		res[i] = models.OrderResponse{
-			Id:        o.ID,
-			BookingID: o.BookingID,
-			Status:    models.OrderStatus(o.Status),
-			CreatedAt: o.CreatedAt,
-		}
+		res[i] = mapping.ToGeneratedOrder(o, location)
 	}
 
 	return res, nil
@@ -297,12 +277,8 @@ func (h *AdminHandler) UpdateOrderStatus(
 		return nil, err
 	}
 
-	return authorized.PatchAdminOrdersIdStatus200JSONResponse{
-		Id:        res.ID,
-		BookingID: res.BookingID,
-		Status:    models.OrderStatus(res.Status),
-		CreatedAt: res.CreatedAt,
-	}, nil
+	return authorized.PatchAdminOrdersIdStatus200JSONResponse(
+		mapping.ToGeneratedOrder(res, viewerLocation(ctx, h.zones, h.logger))), nil
 }
 
 func (h *AdminHandler) GetBookingByID(ctx context.Context,
@@ -331,6 +307,7 @@ func (h *AdminHandler) GetBookingByID(ctx context.Context,
 		Status:         models.BookingStatus(res.Status),
 		ExpiresAt:      res.ExpiresAt,
 		CreatedAt:      res.CreatedAt,
+		ArchivedAt:     res.ArchivedAt,
 	}, nil
 }
 
@@ -351,10 +328,6 @@ func (h *AdminHandler) GetOrderByID(ctx context.Context,
 		return nil, err
 	}
 
-	return authorized.GetAdminOrdersId200JSONResponse{
-		Id:        res.ID,
-		BookingID: res.BookingID,
-		Status:    models.OrderStatus(res.Status),
-		CreatedAt: res.CreatedAt,
-	}, nil
+	return authorized.GetAdminOrdersId200JSONResponse(
+		mapping.ToGeneratedOrder(res, viewerLocation(ctx, h.zones, h.logger))), nil
 }
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type AvailabilitySearchService interface {
+	SearchAvailability(ctx context.Context, filter *entity.AvailabilityFilter,
+		page, limit *int64) ([]*entity.ModelAvailability, error)
+}
+
+type AvailabilitySearchHandler struct {
+	service  AvailabilitySearchService
+	zones    ViewerZone
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewAvailabilitySearchHandler(service AvailabilitySearchService, zones ViewerZone,
+	logger pkg.Logger) *AvailabilitySearchHandler {
+	return &AvailabilitySearchHandler{
+		service:  service,
+		zones:    zones,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *AvailabilitySearchHandler) SearchAvailability(ctx context.Context,
+	request authorized.GetClientAvailabilityRequestObject,
+) (authorized.GetClientAvailabilityResponseObject, error) {
+
+	h.logger.Info(ctx, "AvailabilitySearchHandler.SearchAvailability")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	filter := &entity.AvailabilityFilter{
+		From: request.Params.From,
+		To:   request.Params.To,
+	}
+	if request.Params.ServiceQuery != nil {
+		filter.ServiceQuery = *request.Params.ServiceQuery
+	}
+	if request.Params.MaxPrice != nil {
+		maxPrice := entity.MoneyFromFloat(*request.Params.MaxPrice)
+		filter.MaxPrice = &maxPrice
+	}
+	if request.Params.Sort != nil {
+		filter.Sort = entity.AvailabilitySort(*request.Params.Sort)
+	}
+
+	res, err := h.service.SearchAvailability(ctx, filter, request.Params.Page, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	location := viewerLocation(ctx, h.zones, h.logger)
+	availability := make(authorized.GetClientAvailability200JSONResponse, len(res))
+	for i, a := range res {
+		availability[i] = mapping.ToGeneratedModelAvailability(a, location)
+	}
+
+	return availability, nil
+}
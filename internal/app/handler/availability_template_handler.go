@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type AvailabilityTemplateService interface {
+	CreateTemplate(ctx context.Context, name string, weekdays []int, startTime, endTime string, slotMinutes int,
+		effectiveFrom time.Time, effectiveTo *time.Time, exceptions []time.Time) (*entity.AvailabilityTemplate, error)
+	GetTemplates(ctx context.Context, page, limit *int64) ([]*entity.AvailabilityTemplate, error)
+	GetTemplateByID(ctx context.Context, id int64) (*entity.AvailabilityTemplate, error)
+	UpdateTemplate(ctx context.Context, id int64, name string, weekdays []int, startTime, endTime string,
+		slotMinutes int, effectiveFrom time.Time, effectiveTo *time.Time,
+		exceptions []time.Time) (*entity.AvailabilityTemplate, error)
+	PreviewTemplate(ctx context.Context, id int64) ([]entity.SlotPreview, error)
+	PublishTemplate(ctx context.Context, id int64) (*entity.AvailabilityTemplate, error)
+	UnpublishTemplate(ctx context.Context, id int64) (*entity.AvailabilityTemplate, error)
+}
+
+type AvailabilityTemplateHandler struct {
+	service  AvailabilityTemplateService
+	zones    ViewerZone
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewAvailabilityTemplateHandler(service AvailabilityTemplateService, zones ViewerZone,
+	logger pkg.Logger) *AvailabilityTemplateHandler {
+	return &AvailabilityTemplateHandler{
+		service:  service,
+		zones:    zones,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *AvailabilityTemplateHandler) CreateTemplate(ctx context.Context,
+	request authorized.PostModelAvailabilityTemplatesRequestObject,
+) (authorized.PostModelAvailabilityTemplatesResponseObject, error) {
+
+	h.logger.Info(ctx, "AvailabilityTemplateHandler.CreateTemplate")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	var effectiveTo *time.Time
+	if request.Body.EffectiveTo != nil {
+		effectiveTo = &request.Body.EffectiveTo.Time
+	}
+
+	res, err := h.service.CreateTemplate(ctx, request.Body.Name, request.Body.Weekdays, request.Body.StartTime,
+		request.Body.EndTime, request.Body.SlotMinutes, request.Body.EffectiveFrom.Time, effectiveTo,
+		mapping.FromGeneratedDates(request.Body.Exceptions))
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelAvailabilityTemplates201JSONResponse(mapping.ToGeneratedAvailabilityTemplate(res)), nil
+}
+
+func (h *AvailabilityTemplateHandler) GetTemplates(ctx context.Context,
+	request authorized.GetModelAvailabilityTemplatesRequestObject,
+) (authorized.GetModelAvailabilityTemplatesResponseObject, error) {
+
+	h.logger.Info(ctx, "AvailabilityTemplateHandler.GetTemplates")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	templates, err := h.service.GetTemplates(ctx, request.Params.Page, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(authorized.GetModelAvailabilityTemplates200JSONResponse, len(templates))
+	for i, t := range templates {
+		res[i] = mapping.ToGeneratedAvailabilityTemplate(t)
+	}
+
+	return res, nil
+}
+
+func (h *AvailabilityTemplateHandler) GetTemplateByID(ctx context.Context,
+	request authorized.GetModelAvailabilityTemplatesIdRequestObject,
+) (authorized.GetModelAvailabilityTemplatesIdResponseObject, error) {
+
+	h.logger.Info(ctx, "AvailabilityTemplateHandler.GetTemplateByID")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetTemplateByID(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetModelAvailabilityTemplatesId200JSONResponse(mapping.ToGeneratedAvailabilityTemplate(res)), nil
+}
+
+func (h *AvailabilityTemplateHandler) UpdateTemplate(ctx context.Context,
+	request authorized.PutModelAvailabilityTemplatesIdRequestObject,
+) (authorized.PutModelAvailabilityTemplatesIdResponseObject, error) {
+
+	h.logger.Info(ctx, "AvailabilityTemplateHandler.UpdateTemplate")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	var effectiveTo *time.Time
+	if request.Body.EffectiveTo != nil {
+		effectiveTo = &request.Body.EffectiveTo.Time
+	}
+
+	res, err := h.service.UpdateTemplate(ctx, request.Id, request.Body.Name, request.Body.Weekdays,
+		request.Body.StartTime, request.Body.EndTime, request.Body.SlotMinutes, request.Body.EffectiveFrom.Time,
+		effectiveTo, mapping.FromGeneratedDates(request.Body.Exceptions))
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PutModelAvailabilityTemplatesId200JSONResponse(mapping.ToGeneratedAvailabilityTemplate(res)), nil
+}
+
+func (h *AvailabilityTemplateHandler) PreviewTemplate(ctx context.Context,
+	request authorized.GetModelAvailabilityTemplatesIdPreviewRequestObject,
+) (authorized.GetModelAvailabilityTemplatesIdPreviewResponseObject, error) {
+
+	h.logger.Info(ctx, "AvailabilityTemplateHandler.PreviewTemplate")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	previews, err := h.service.PreviewTemplate(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	location := viewerLocation(ctx, h.zones, h.logger)
+	res := make(authorized.GetModelAvailabilityTemplatesIdPreview200JSONResponse, len(previews))
+	for i, p := range previews {
+		res[i] = mapping.ToGeneratedSlotPreview(p, location)
+	}
+
+	return res, nil
+}
+
+func (h *AvailabilityTemplateHandler) PublishTemplate(ctx context.Context,
+	request authorized.PostModelAvailabilityTemplatesIdPublishRequestObject,
+) (authorized.PostModelAvailabilityTemplatesIdPublishResponseObject, error) {
+
+	h.logger.Info(ctx, "AvailabilityTemplateHandler.PublishTemplate")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.PublishTemplate(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelAvailabilityTemplatesIdPublish200JSONResponse(
+		mapping.ToGeneratedAvailabilityTemplate(res)), nil
+}
+
+func (h *AvailabilityTemplateHandler) UnpublishTemplate(ctx context.Context,
+	request authorized.PostModelAvailabilityTemplatesIdUnpublishRequestObject,
+) (authorized.PostModelAvailabilityTemplatesIdUnpublishResponseObject, error) {
+
+	h.logger.Info(ctx, "AvailabilityTemplateHandler.UnpublishTemplate")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.UnpublishTemplate(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelAvailabilityTemplatesIdUnpublish200JSONResponse(
+		mapping.ToGeneratedAvailabilityTemplate(res)), nil
+}
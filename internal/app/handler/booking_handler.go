@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
-	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/models"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
 	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
@@ -14,7 +13,11 @@ import (
 
 type BookingService interface {
 	CreateBooking(ctx context.Context, modelServiceID,
-		slotID int64, street string, house int, apartment, entrance, floor *int, comment *string) (*entity.Booking, error)
+		slotID int64, street string, house int, apartment, entrance, floor *int, comment *string,
+		promoCode *string, addOnIDs []int64, quoteToken *string) (*entity.Booking, error)
+	QuoteBooking(ctx context.Context, modelServiceID, slotID int64,
+		street string, house int, apartment, entrance, floor *int, comment *string,
+		promoCode *string, addOnIDs []int64) (*entity.Quote, string, error)
 	ApproveBooking(ctx context.Context, bookingID int64) (*entity.Booking, error)
 	RejectBooking(ctx context.Context, bookingID int64) (*entity.Booking, error)
 	CancelBookingByClient(ctx context.Context, bookingID int64) (*entity.Booking, error)
@@ -46,24 +49,49 @@ func (h *BookingHandler) CreateBooking(ctx context.Context,
 		return nil, err
 	}
 
+	var addOnIDs []int64
+	if request.Body.AddOnIDs != nil {
+		addOnIDs = *request.Body.AddOnIDs
+	}
+
 	res, err := h.bookingService.CreateBooking(ctx, request.Body.ModelServiceID,
 		request.Body.SlotID, request.Body.Address.Street, request.Body.Address.House,
 		request.Body.Address.Apartment, request.Body.Address.Entrance, request.Body.Address.Floor,
-		request.Body.Address.Comment)
+		request.Body.Address.Comment, request.Body.PromoCode, addOnIDs, request.Body.QuoteToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostClientBookings201JSONResponse(mapping.ToGeneratedBooking(res)), nil
+}
+
+func (h *BookingHandler) QuoteBooking(ctx context.Context,
+	request authorized.PostClientBookingsQuoteRequestObject,
+) (authorized.PostClientBookingsQuoteResponseObject, error) {
+
+	h.logger.Info(ctx, "BookingHandler.QuoteBooking")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	var addOnIDs []int64
+	if request.Body.AddOnIDs != nil {
+		addOnIDs = *request.Body.AddOnIDs
+	}
+
+	quote, token, err := h.bookingService.QuoteBooking(ctx, request.Body.ModelServiceID, request.Body.SlotID,
+		request.Body.Address.Street, request.Body.Address.House, request.Body.Address.Apartment,
+		request.Body.Address.Entrance, request.Body.Address.Floor, request.Body.Address.Comment,
+		request.Body.PromoCode, addOnIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	return &authorized.PostClientBookings201JSONResponse{
-		Id:             res.ID,
-		ModelServiceID: res.ModelServiceID,
-		ClientID:       res.ClientID,
-		SlotID:         res.SlotID,
-		Address:        mapping.ToGeneratedAddress(res.Address),
-		Status:         models.BookingStatus(res.Status),
-		ExpiresAt:      res.ExpiresAt,
-		CreatedAt:      res.CreatedAt,
-	}, nil
+	return authorized.PostClientBookingsQuote200JSONResponse(mapping.ToGeneratedBookingQuote(quote, token)), nil
 }
 
 func (h *BookingHandler) CancelBookingByClient(ctx context.Context,
@@ -84,16 +112,7 @@ func (h *BookingHandler) CancelBookingByClient(ctx context.Context,
 		return nil, err
 	}
 
-	return &authorized.PatchClientBookingsIdCancel200JSONResponse{
-		Id:             res.ID,
-		ModelServiceID: res.ModelServiceID,
-		ClientID:       res.ClientID,
-		SlotID:         res.SlotID,
-		Address:        mapping.ToGeneratedAddress(res.Address),
-		Status:         models.BookingStatus(res.Status),
-		ExpiresAt:      res.ExpiresAt,
-		CreatedAt:      res.CreatedAt,
-	}, nil
+	return authorized.PatchClientBookingsIdCancel200JSONResponse(mapping.ToGeneratedBooking(res)), nil
 }
 
 func (h *BookingHandler) ApproveBooking(ctx context.Context,
@@ -114,16 +133,7 @@ func (h *BookingHandler) ApproveBooking(ctx context.Context,
 		return nil, err
 	}
 
-	return &authorized.PatchModelBookingsIdApprove200JSONResponse{
-		Id:             res.ID,
-		ModelServiceID: res.ModelServiceID,
-		ClientID:       res.ClientID,
-		SlotID:         res.SlotID,
-		Address:        mapping.ToGeneratedAddress(res.Address),
-		Status:         models.BookingStatus(res.Status),
-		ExpiresAt:      res.ExpiresAt,
-		CreatedAt:      res.CreatedAt,
-	}, nil
+	return authorized.PatchModelBookingsIdApprove200JSONResponse(mapping.ToGeneratedBooking(res)), nil
 }
 
 func (h *BookingHandler) RejectBooking(ctx context.Context,
@@ -144,14 +154,5 @@ func (h *BookingHandler) RejectBooking(ctx context.Context,
 		return nil, err
 	}
 
-	return &authorized.PatchModelBookingsIdReject200JSONResponse{
-		Id:             res.ID,
-		ModelServiceID: res.ModelServiceID,
-		ClientID:       res.ClientID,
-		SlotID:         res.SlotID,
-		Address:        mapping.ToGeneratedAddress(res.Address),
-		Status:         models.BookingStatus(res.Status),
-		ExpiresAt:      res.ExpiresAt,
-		CreatedAt:      res.CreatedAt,
-	}, nil
+	return authorized.PatchModelBookingsIdReject200JSONResponse(mapping.ToGeneratedBooking(res)), nil
 }
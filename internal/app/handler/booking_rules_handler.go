@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type BookingRulesService interface {
+	GetRules(ctx context.Context) (*entity.BookingRules, *entity.BookingRules, error)
+	UpdateRules(ctx context.Context, minNoticeMinutes, horizonDays, minSlotMinutes,
+		maxSlotMinutes int) (*entity.BookingRules, *entity.BookingRules, error)
+}
+
+type BookingRulesHandler struct {
+	service  BookingRulesService
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewBookingRulesHandler(service BookingRulesService, logger pkg.Logger) *BookingRulesHandler {
+	return &BookingRulesHandler{
+		service:  service,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *BookingRulesHandler) GetRules(ctx context.Context,
+	request authorized.GetModelBookingRulesRequestObject,
+) (authorized.GetModelBookingRulesResponseObject, error) {
+
+	h.logger.Info(ctx, "BookingRulesHandler.GetRules")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	own, effective, err := h.service.GetRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetModelBookingRules200JSONResponse(mapping.ToGeneratedBookingRules(own, effective)), nil
+}
+
+func (h *BookingRulesHandler) UpdateRules(ctx context.Context,
+	request authorized.PutModelBookingRulesRequestObject,
+) (authorized.PutModelBookingRulesResponseObject, error) {
+
+	h.logger.Info(ctx, "BookingRulesHandler.UpdateRules")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	own, effective, err := h.service.UpdateRules(ctx, request.Body.MinNoticeMinutes, request.Body.HorizonDays,
+		request.Body.MinSlotMinutes, request.Body.MaxSlotMinutes)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PutModelBookingRules200JSONResponse(mapping.ToGeneratedBookingRules(own, effective)), nil
+}
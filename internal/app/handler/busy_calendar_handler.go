@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"context"
+	"io"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type BusyCalendarService interface {
+	GetCalendars(ctx context.Context) ([]*entity.BusyCalendar, error)
+	CreateCalendar(ctx context.Context, name string, url *string) (*entity.BusyCalendarSync, error)
+	UploadEvents(ctx context.Context, id int64, src io.Reader) (*entity.BusyCalendarSync, error)
+	SyncCalendar(ctx context.Context, id int64) (*entity.BusyCalendarSync, error)
+	DeleteCalendar(ctx context.Context, id int64) (*entity.BusyCalendarSync, error)
+}
+
+type BusyCalendarHandler struct {
+	service  BusyCalendarService
+	zones    ViewerZone
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewBusyCalendarHandler(service BusyCalendarService, zones ViewerZone,
+	logger pkg.Logger) *BusyCalendarHandler {
+	return &BusyCalendarHandler{
+		service:  service,
+		zones:    zones,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *BusyCalendarHandler) GetCalendars(ctx context.Context,
+	request authorized.GetModelBusyCalendarsRequestObject,
+) (authorized.GetModelBusyCalendarsResponseObject, error) {
+
+	h.logger.Info(ctx, "BusyCalendarHandler.GetCalendars")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	calendars, err := h.service.GetCalendars(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	location := viewerLocation(ctx, h.zones, h.logger)
+	res := make(authorized.GetModelBusyCalendars200JSONResponse, len(calendars))
+	for i, c := range calendars {
+		res[i] = mapping.ToGeneratedBusyCalendar(c, location)
+	}
+
+	return res, nil
+}
+
+func (h *BusyCalendarHandler) CreateCalendar(ctx context.Context,
+	request authorized.PostModelBusyCalendarsRequestObject,
+) (authorized.PostModelBusyCalendarsResponseObject, error) {
+
+	h.logger.Info(ctx, "BusyCalendarHandler.CreateCalendar")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.CreateCalendar(ctx, request.Body.Name, request.Body.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelBusyCalendars201JSONResponse(
+		mapping.ToGeneratedBusyCalendarSync(res, viewerLocation(ctx, h.zones, h.logger))), nil
+}
+
+func (h *BusyCalendarHandler) UploadEvents(ctx context.Context,
+	request authorized.PutModelBusyCalendarsIdEventsRequestObject,
+) (authorized.PutModelBusyCalendarsIdEventsResponseObject, error) {
+
+	h.logger.Info(ctx, "BusyCalendarHandler.UploadEvents")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.UploadEvents(ctx, request.Id, request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PutModelBusyCalendarsIdEvents200JSONResponse(
+		mapping.ToGeneratedBusyCalendarSync(res, viewerLocation(ctx, h.zones, h.logger))), nil
+}
+
+func (h *BusyCalendarHandler) SyncCalendar(ctx context.Context,
+	request authorized.PostModelBusyCalendarsIdSyncRequestObject,
+) (authorized.PostModelBusyCalendarsIdSyncResponseObject, error) {
+
+	h.logger.Info(ctx, "BusyCalendarHandler.SyncCalendar")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.SyncCalendar(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelBusyCalendarsIdSync200JSONResponse(
+		mapping.ToGeneratedBusyCalendarSync(res, viewerLocation(ctx, h.zones, h.logger))), nil
+}
+
+func (h *BusyCalendarHandler) DeleteCalendar(ctx context.Context,
+	request authorized.DeleteModelBusyCalendarsIdRequestObject,
+) (authorized.DeleteModelBusyCalendarsIdResponseObject, error) {
+
+	h.logger.Info(ctx, "BusyCalendarHandler.DeleteCalendar")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.DeleteCalendar(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.DeleteModelBusyCalendarsId200JSONResponse(
+		mapping.ToGeneratedBusyCalendarSync(res, viewerLocation(ctx, h.zones, h.logger))), nil
+}
@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/public"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/render"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type CalendarFeedService interface {
+	GetFeed(ctx context.Context) (*entity.CalendarFeed, error)
+	RegenerateFeed(ctx context.Context) (*entity.CalendarFeed, error)
+	ExportFeed(ctx context.Context, token string) (*entity.Calendar, error)
+}
+
+type CalendarFeedHandler struct {
+	service  CalendarFeedService
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewCalendarFeedHandler(service CalendarFeedService, logger pkg.Logger) *CalendarFeedHandler {
+	return &CalendarFeedHandler{
+		service:  service,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *CalendarFeedHandler) GetModelCalendarFeed(ctx context.Context,
+	request authorized.GetModelCalendarFeedRequestObject,
+) (authorized.GetModelCalendarFeedResponseObject, error) {
+
+	h.logger.Info(ctx, "CalendarFeedHandler.GetModelCalendarFeed")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetFeed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetModelCalendarFeed200JSONResponse(mapping.ToGeneratedCalendarFeed(res)), nil
+}
+
+func (h *CalendarFeedHandler) RegenerateModelCalendarFeed(ctx context.Context,
+	request authorized.PostModelCalendarFeedRegenerateRequestObject,
+) (authorized.PostModelCalendarFeedRegenerateResponseObject, error) {
+
+	h.logger.Info(ctx, "CalendarFeedHandler.RegenerateModelCalendarFeed")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.RegenerateFeed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelCalendarFeedRegenerate200JSONResponse(mapping.ToGeneratedCalendarFeed(res)), nil
+}
+
+func (h *CalendarFeedHandler) GetClientCalendarFeed(ctx context.Context,
+	request authorized.GetClientCalendarFeedRequestObject,
+) (authorized.GetClientCalendarFeedResponseObject, error) {
+
+	h.logger.Info(ctx, "CalendarFeedHandler.GetClientCalendarFeed")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetFeed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetClientCalendarFeed200JSONResponse(mapping.ToGeneratedCalendarFeed(res)), nil
+}
+
+func (h *CalendarFeedHandler) RegenerateClientCalendarFeed(ctx context.Context,
+	request authorized.PostClientCalendarFeedRegenerateRequestObject,
+) (authorized.PostClientCalendarFeedRegenerateResponseObject, error) {
+
+	h.logger.Info(ctx, "CalendarFeedHandler.RegenerateClientCalendarFeed")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.RegenerateFeed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostClientCalendarFeedRegenerate200JSONResponse(mapping.ToGeneratedCalendarFeed(res)), nil
+}
+
+func (h *CalendarFeedHandler) ExportFeed(ctx context.Context,
+	request public.GetCalendarTokenRequestObject,
+) (public.GetCalendarTokenResponseObject, error) {
+
+	h.logger.Info(ctx, "CalendarFeedHandler.ExportFeed")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.ExportFeed(ctx, request.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	var calendar bytes.Buffer
+	if err = render.Calendar(&calendar, res); err != nil {
+		h.logger.Error(ctx, "failed to render calendar",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return public.GetCalendarToken200TextcalendarResponse{
+		Body:          &calendar,
+		ContentLength: int64(calendar.Len()),
+	}, nil
+}
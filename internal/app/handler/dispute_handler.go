@@ -0,0 +1,357 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type DisputeService interface {
+	OpenDispute(ctx context.Context, orderID int64,
+		category entity.DisputeCategory, description string) (*entity.Dispute, error)
+	GetDispute(ctx context.Context, id int64) (*entity.Dispute, error)
+	GetDisputeMessages(ctx context.Context, id int64, page, limit *int64) ([]*entity.DisputeMessage, error)
+	AddDisputeMessage(ctx context.Context, id int64, body string) (*entity.DisputeMessage, error)
+	GetAllDisputes(ctx context.Context, status *entity.DisputeStatus, page, limit *int64) ([]*entity.Dispute, error)
+	AssignDispute(ctx context.Context, id int64, adminID *int64) (*entity.Dispute, error)
+	ResolveDispute(ctx context.Context, id int64, resolution entity.DisputeResolution,
+		refundAmount *entity.Money, comment *string) (*entity.Dispute, error)
+}
+
+type DisputeHandler struct {
+	service  DisputeService
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewDisputeHandler(service DisputeService, logger pkg.Logger) *DisputeHandler {
+	return &DisputeHandler{
+		service:  service,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *DisputeHandler) OpenDisputeByClient(ctx context.Context,
+	request authorized.PostClientOrdersIdDisputesRequestObject,
+) (authorized.PostClientOrdersIdDisputesResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.OpenDisputeByClient")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.OpenDispute(ctx, request.Id,
+		entity.DisputeCategory(request.Body.Category), request.Body.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostClientOrdersIdDisputes201JSONResponse(mapping.ToGeneratedDispute(res)), nil
+}
+
+func (h *DisputeHandler) GetClientDispute(ctx context.Context,
+	request authorized.GetClientDisputesIdRequestObject,
+) (authorized.GetClientDisputesIdResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.GetClientDispute")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetDispute(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetClientDisputesId200JSONResponse(mapping.ToGeneratedDispute(res)), nil
+}
+
+func (h *DisputeHandler) GetClientDisputeMessages(ctx context.Context,
+	request authorized.GetClientDisputesIdMessagesRequestObject,
+) (authorized.GetClientDisputesIdMessagesResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.GetClientDisputeMessages")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetDisputeMessages(ctx, request.Id, request.Params.Page, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make(authorized.GetClientDisputesIdMessages200JSONResponse, len(res))
+	for i, m := range res {
+		messages[i] = mapping.ToGeneratedDisputeMessage(m)
+	}
+
+	return messages, nil
+}
+
+func (h *DisputeHandler) AddClientDisputeMessage(ctx context.Context,
+	request authorized.PostClientDisputesIdMessagesRequestObject,
+) (authorized.PostClientDisputesIdMessagesResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.AddClientDisputeMessage")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.AddDisputeMessage(ctx, request.Id, request.Body.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostClientDisputesIdMessages201JSONResponse(mapping.ToGeneratedDisputeMessage(res)), nil
+}
+
+func (h *DisputeHandler) OpenDisputeByModel(ctx context.Context,
+	request authorized.PostModelOrdersIdDisputesRequestObject,
+) (authorized.PostModelOrdersIdDisputesResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.OpenDisputeByModel")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.OpenDispute(ctx, request.Id,
+		entity.DisputeCategory(request.Body.Category), request.Body.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelOrdersIdDisputes201JSONResponse(mapping.ToGeneratedDispute(res)), nil
+}
+
+func (h *DisputeHandler) GetModelDispute(ctx context.Context,
+	request authorized.GetModelDisputesIdRequestObject,
+) (authorized.GetModelDisputesIdResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.GetModelDispute")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetDispute(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetModelDisputesId200JSONResponse(mapping.ToGeneratedDispute(res)), nil
+}
+
+func (h *DisputeHandler) GetModelDisputeMessages(ctx context.Context,
+	request authorized.GetModelDisputesIdMessagesRequestObject,
+) (authorized.GetModelDisputesIdMessagesResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.GetModelDisputeMessages")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetDisputeMessages(ctx, request.Id, request.Params.Page, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make(authorized.GetModelDisputesIdMessages200JSONResponse, len(res))
+	for i, m := range res {
+		messages[i] = mapping.ToGeneratedDisputeMessage(m)
+	}
+
+	return messages, nil
+}
+
+func (h *DisputeHandler) AddModelDisputeMessage(ctx context.Context,
+	request authorized.PostModelDisputesIdMessagesRequestObject,
+) (authorized.PostModelDisputesIdMessagesResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.AddModelDisputeMessage")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.AddDisputeMessage(ctx, request.Id, request.Body.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelDisputesIdMessages201JSONResponse(mapping.ToGeneratedDisputeMessage(res)), nil
+}
+
+func (h *DisputeHandler) GetAllDisputes(ctx context.Context,
+	request authorized.GetAdminDisputesRequestObject,
+) (authorized.GetAdminDisputesResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.GetAllDisputes")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetAllDisputes(ctx, (*entity.DisputeStatus)(request.Params.Status),
+		request.Params.Page, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	disputes := make(authorized.GetAdminDisputes200JSONResponse, len(res))
+	for i, d := range res {
+		disputes[i] = mapping.ToGeneratedDispute(d)
+	}
+
+	return disputes, nil
+}
+
+func (h *DisputeHandler) GetDisputeByID(ctx context.Context,
+	request authorized.GetAdminDisputesIdRequestObject,
+) (authorized.GetAdminDisputesIdResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.GetDisputeByID")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetDispute(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetAdminDisputesId200JSONResponse(mapping.ToGeneratedDispute(res)), nil
+}
+
+func (h *DisputeHandler) AssignDispute(ctx context.Context,
+	request authorized.PatchAdminDisputesIdAssignRequestObject,
+) (authorized.PatchAdminDisputesIdAssignResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.AssignDispute")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.AssignDispute(ctx, request.Id, request.Body.AdminID)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PatchAdminDisputesIdAssign200JSONResponse(mapping.ToGeneratedDispute(res)), nil
+}
+
+func (h *DisputeHandler) GetAdminDisputeMessages(ctx context.Context,
+	request authorized.GetAdminDisputesIdMessagesRequestObject,
+) (authorized.GetAdminDisputesIdMessagesResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.GetAdminDisputeMessages")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetDisputeMessages(ctx, request.Id, request.Params.Page, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make(authorized.GetAdminDisputesIdMessages200JSONResponse, len(res))
+	for i, m := range res {
+		messages[i] = mapping.ToGeneratedDisputeMessage(m)
+	}
+
+	return messages, nil
+}
+
+func (h *DisputeHandler) AddAdminDisputeMessage(ctx context.Context,
+	request authorized.PostAdminDisputesIdMessagesRequestObject,
+) (authorized.PostAdminDisputesIdMessagesResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.AddAdminDisputeMessage")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.AddDisputeMessage(ctx, request.Id, request.Body.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostAdminDisputesIdMessages201JSONResponse(mapping.ToGeneratedDisputeMessage(res)), nil
+}
+
+func (h *DisputeHandler) ResolveDispute(ctx context.Context,
+	request authorized.PatchAdminDisputesIdResolveRequestObject,
+) (authorized.PatchAdminDisputesIdResolveResponseObject, error) {
+
+	h.logger.Info(ctx, "DisputeHandler.ResolveDispute")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.ResolveDispute(ctx, request.Id,
+		entity.DisputeResolution(request.Body.Resolution), mapping.FromGeneratedMoneyPtr(request.Body.RefundAmount),
+		request.Body.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PatchAdminDisputesIdResolve200JSONResponse(mapping.ToGeneratedDispute(res)), nil
+}
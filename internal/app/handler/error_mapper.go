@@ -21,38 +21,117 @@ type ErrorMapper struct {
 func NewErrorMapper() *ErrorMapper {
 	return &ErrorMapper{
 		registry: map[error]Error{
-			errors2.ErrEmailExists:                 {http.StatusConflict, models.EMAILALREADYEXISTS},
-			errors2.ErrAuthWithEmailDoesNotExists:  {http.StatusUnauthorized, models.INVALIDCREDENTIALS},
-			errors2.ErrInvalidPasswordOrEmail:      {http.StatusUnauthorized, models.INVALIDCREDENTIALS},
-			errors2.ErrUserNotFound:                {http.StatusNotFound, models.NOTFOUND},
-			errors2.ErrUnauthorized:                {http.StatusUnauthorized, models.UNAUTHORIZED},
-			errors2.ErrNotVerifiedModel:            {http.StatusForbidden, models.FORBIDDEN},
-			errors2.ErrNotVerifiedClient:           {http.StatusForbidden, models.FORBIDDEN},
-			errors2.ErrNotAModel:                   {http.StatusForbidden, models.NOTAMODEL},
-			errors2.ErrNotAdmin:                    {http.StatusForbidden, models.NOTADMIN},
-			errors2.ErrNotClient:                   {http.StatusForbidden, models.NOTCLIENT},
-			errors2.ErrBookingNotFound:             {http.StatusNotFound, models.BOOKINGNOTFOUND},
-			errors2.ErrOrderNotFound:               {http.StatusNotFound, models.ORDERNOTFOUND},
-			errors2.ErrAdminNotFound:               {http.StatusNotFound, models.NOTFOUND},
-			errors2.ErrClientIsNotOwnerOfBooking:   {http.StatusForbidden, models.FORBIDDEN},
-			errors2.ErrClientIsNotOwnerOfOrder:     {http.StatusForbidden, models.FORBIDDEN},
-			errors2.ErrBookingExpired:              {http.StatusConflict, models.BOOKINGEXPIRED},
-			errors2.ErrBookingAlreadyProcessed:     {http.StatusConflict, models.BOOKINGALREADYPROCESSED},
-			errors2.ErrInvalidBookingState:         {http.StatusConflict, models.INVALIDBOOKINGSTATE},
-			errors2.ErrCannotCancelOrderNow:        {http.StatusConflict, models.CANNOTCANCELORDER},
-			errors2.ErrCannotCompleteOrder:         {http.StatusConflict, models.CANNOTCOMPLETEORDER},
-			errors2.ErrServiceIsNotFound:           {http.StatusNotFound, models.SERVICENOTFOUND},
-			errors2.ErrModelIsNotAnOwnerOfService:  {http.StatusForbidden, models.NOTSERVICEOWNER},
-			errors2.ErrServiceIsNotActive:          {http.StatusConflict, models.SERVICENOTACTIVE},
-			errors2.ErrSlotOverlap:                 {http.StatusConflict, models.SLOTOVERLAP},
-			errors2.ErrInvalidSlotStatusTransition: {http.StatusConflict, models.INVALIDSLOTSTATUSTRANSITION},
-			errors2.ErrSlotNotAvailable:            {http.StatusConflict, models.SLOTNOTAVAILABLE},
-			errors2.ErrModelIsNotAnOwnerOfSlot:     {http.StatusForbidden, models.NOTSLOTOWNER},
-			errors2.ErrIncorrectSlotTime:           {http.StatusBadRequest, models.INCORRECTSLOTTIME},
-			errors2.ErrInvalidPrice:                {http.StatusBadRequest, models.INVALIDPRICE},
-			errors2.ErrDescriptionTooLong:          {http.StatusBadRequest, models.DESCRIPTIONTOOLONG},
-			errors2.ErrSlotIsNotFound:              {http.StatusNotFound, models.SLOTNOTFOUND},
-			errors2.ErrIsNotAnAdult:                {http.StatusBadRequest, models.USERISNOTANADULT},
+			errors2.ErrEmailExists:                    {http.StatusConflict, models.EMAILALREADYEXISTS},
+			errors2.ErrAuthWithEmailDoesNotExists:     {http.StatusUnauthorized, models.INVALIDCREDENTIALS},
+			errors2.ErrInvalidPasswordOrEmail:         {http.StatusUnauthorized, models.INVALIDCREDENTIALS},
+			errors2.ErrUserNotFound:                   {http.StatusNotFound, models.NOTFOUND},
+			errors2.ErrUnauthorized:                   {http.StatusUnauthorized, models.UNAUTHORIZED},
+			errors2.ErrNotVerifiedModel:               {http.StatusForbidden, models.FORBIDDEN},
+			errors2.ErrNotVerifiedClient:              {http.StatusForbidden, models.FORBIDDEN},
+			errors2.ErrNotAModel:                      {http.StatusForbidden, models.NOTAMODEL},
+			errors2.ErrNotAdmin:                       {http.StatusForbidden, models.NOTADMIN},
+			errors2.ErrNotClient:                      {http.StatusForbidden, models.NOTCLIENT},
+			errors2.ErrBookingNotFound:                {http.StatusNotFound, models.BOOKINGNOTFOUND},
+			errors2.ErrOrderNotFound:                  {http.StatusNotFound, models.ORDERNOTFOUND},
+			errors2.ErrAdminNotFound:                  {http.StatusNotFound, models.NOTFOUND},
+			errors2.ErrClientIsNotOwnerOfBooking:      {http.StatusForbidden, models.FORBIDDEN},
+			errors2.ErrClientIsNotOwnerOfOrder:        {http.StatusForbidden, models.FORBIDDEN},
+			errors2.ErrBookingExpired:                 {http.StatusConflict, models.BOOKINGEXPIRED},
+			errors2.ErrBookingAlreadyProcessed:        {http.StatusConflict, models.BOOKINGALREADYPROCESSED},
+			errors2.ErrInvalidBookingState:            {http.StatusConflict, models.INVALIDBOOKINGSTATE},
+			errors2.ErrCannotCancelOrderNow:           {http.StatusConflict, models.CANNOTCANCELORDER},
+			errors2.ErrCannotCompleteOrder:            {http.StatusConflict, models.CANNOTCOMPLETEORDER},
+			errors2.ErrServiceIsNotFound:              {http.StatusNotFound, models.SERVICENOTFOUND},
+			errors2.ErrModelIsNotAnOwnerOfService:     {http.StatusForbidden, models.NOTSERVICEOWNER},
+			errors2.ErrServiceIsNotActive:             {http.StatusConflict, models.SERVICENOTACTIVE},
+			errors2.ErrSlotOverlap:                    {http.StatusConflict, models.SLOTOVERLAP},
+			errors2.ErrInvalidSlotStatusTransition:    {http.StatusConflict, models.INVALIDSLOTSTATUSTRANSITION},
+			errors2.ErrSlotNotAvailable:               {http.StatusConflict, models.SLOTNOTAVAILABLE},
+			errors2.ErrModelIsNotAnOwnerOfSlot:        {http.StatusForbidden, models.NOTSLOTOWNER},
+			errors2.ErrIncorrectSlotTime:              {http.StatusBadRequest, models.INCORRECTSLOTTIME},
+			errors2.ErrInvalidPrice:                   {http.StatusBadRequest, models.INVALIDPRICE},
+			errors2.ErrUnsupportedCurrency:            {http.StatusBadRequest, models.UNSUPPORTEDCURRENCY},
+			errors2.ErrDescriptionTooLong:             {http.StatusBadRequest, models.DESCRIPTIONTOOLONG},
+			errors2.ErrSlotIsNotFound:                 {http.StatusNotFound, models.SLOTNOTFOUND},
+			errors2.ErrIsNotAnAdult:                   {http.StatusBadRequest, models.USERISNOTANADULT},
+			errors2.ErrCannotConfirmOrder:             {http.StatusConflict, models.CANNOTCONFIRMORDER},
+			errors2.ErrInvalidOrderStatusTransition:   {http.StatusConflict, models.INVALIDORDERSTATUSTRANSITION},
+			errors2.ErrCannotRaiseOrderIssue:          {http.StatusConflict, models.CANNOTRAISEORDERISSUE},
+			errors2.ErrCannotTrackOrder:               {http.StatusConflict, models.CANNOTTRACKORDER},
+			errors2.ErrOrderTrackingUnavailable:       {http.StatusServiceUnavailable, models.ORDERTRACKINGUNAVAILABLE},
+			errors2.ErrDisputeNotFound:                {http.StatusNotFound, models.DISPUTENOTFOUND},
+			errors2.ErrDisputeAlreadyExists:           {http.StatusConflict, models.DISPUTEALREADYEXISTS},
+			errors2.ErrCannotOpenDispute:              {http.StatusConflict, models.CANNOTOPENDISPUTE},
+			errors2.ErrNotDisputeParticipant:          {http.StatusForbidden, models.NOTDISPUTEPARTICIPANT},
+			errors2.ErrDisputeIsResolved:              {http.StatusConflict, models.DISPUTEALREADYRESOLVED},
+			errors2.ErrNotDisputeAssignee:             {http.StatusForbidden, models.NOTDISPUTEASSIGNEE},
+			errors2.ErrInvalidRefundAmount:            {http.StatusBadRequest, models.INVALIDREFUNDAMOUNT},
+			errors2.ErrCannotExtendOrder:              {http.StatusConflict, models.CANNOTEXTENDORDER},
+			errors2.ErrOrderExtensionNotFound:         {http.StatusNotFound, models.ORDEREXTENSIONNOTFOUND},
+			errors2.ErrOrderExtensionAlreadyRequested: {http.StatusConflict, models.ORDEREXTENSIONALREADYREQUESTED},
+			errors2.ErrOrderExtensionAlreadyProcessed: {http.StatusConflict, models.ORDEREXTENSIONALREADYPROCESSED},
+			errors2.ErrPaymentNotFound:                {http.StatusNotFound, models.PAYMENTNOTFOUND},
+			errors2.ErrPaymentDeclined:                {http.StatusPaymentRequired, models.PAYMENTDECLINED},
+			errors2.ErrPaymentProviderFailed:          {http.StatusBadGateway, models.PAYMENTPROVIDERERROR},
+			errors2.ErrInvalidPaymentState:            {http.StatusConflict, models.INVALIDPAYMENTSTATE},
+			errors2.ErrInvalidWebhookSecret:           {http.StatusUnauthorized, models.INVALIDWEBHOOKSECRET},
+			errors2.ErrPromoCodeNotFound:              {http.StatusNotFound, models.PROMOCODENOTFOUND},
+			errors2.ErrPromoCodeAlreadyExists:         {http.StatusConflict, models.PROMOCODEALREADYEXISTS},
+			errors2.ErrInvalidPromoCodeDiscount:       {http.StatusBadRequest, models.INVALIDPROMOCODE},
+			errors2.ErrInvalidPromoCodeWindow:         {http.StatusBadRequest, models.INVALIDPROMOCODE},
+			errors2.ErrInvalidPromoCodeLimit:          {http.StatusBadRequest, models.INVALIDPROMOCODE},
+			errors2.ErrPromoCodeNotValid:              {http.StatusUnprocessableEntity, models.PROMOCODENOTVALID},
+			errors2.ErrPromoCodeNotApplicable:         {http.StatusUnprocessableEntity, models.PROMOCODENOTAPPLICABLE},
+			errors2.ErrPromoCodeUsageLimitReached:     {http.StatusConflict, models.PROMOCODEUSAGELIMITREACHED},
+			errors2.ErrPromoCodeClientLimitReached:    {http.StatusConflict, models.PROMOCODECLIENTLIMITREACHED},
+			errors2.ErrPricingRuleNotFound:            {http.StatusNotFound, models.PRICINGRULENOTFOUND},
+			errors2.ErrModelIsNotAnOwnerOfPricingRule: {http.StatusForbidden, models.NOTPRICINGRULEOWNER},
+			errors2.ErrInvalidPricingRuleCharge:       {http.StatusBadRequest, models.INVALIDPRICINGRULE},
+			errors2.ErrInvalidPricingRuleWindow:       {http.StatusBadRequest, models.INVALIDPRICINGRULE},
+			errors2.ErrAddOnNotFound:                  {http.StatusNotFound, models.ADDONNOTFOUND},
+			errors2.ErrAddOnIsNotActive:               {http.StatusConflict, models.ADDONNOTACTIVE},
+			errors2.ErrAddOnNotOfService:              {http.StatusUnprocessableEntity, models.ADDONNOTAPPLICABLE},
+			errors2.ErrDuplicateAddOn:                 {http.StatusBadRequest, models.INVALIDADDON},
+			errors2.ErrInvalidAddOnDuration:           {http.StatusBadRequest, models.INVALIDADDON},
+			errors2.ErrInvalidQuoteToken:              {http.StatusBadRequest, models.INVALIDQUOTE},
+			errors2.ErrQuoteExpired:                   {http.StatusConflict, models.QUOTEEXPIRED},
+			errors2.ErrQuoteMismatch:                  {http.StatusUnprocessableEntity, models.QUOTEMISMATCH},
+			errors2.ErrReceiptNotAvailable:            {http.StatusConflict, models.RECEIPTNOTAVAILABLE},
+			errors2.ErrTemplateNotFound:               {http.StatusNotFound, models.TEMPLATENOTFOUND},
+			errors2.ErrModelIsNotAnOwnerOfTemplate:    {http.StatusForbidden, models.NOTTEMPLATEOWNER},
+			errors2.ErrInvalidTemplateSchedule:        {http.StatusBadRequest, models.INVALIDTEMPLATE},
+			errors2.ErrInvalidTemplatePeriod:          {http.StatusBadRequest, models.INVALIDTEMPLATE},
+			errors2.ErrSlotBatchRejected:              {http.StatusConflict, models.SLOTBATCHREJECTED},
+			errors2.ErrInvalidSlotBatchSize:           {http.StatusBadRequest, models.INVALIDSLOTBATCH},
+			errors2.ErrSlotOverlapInBatch:             {http.StatusConflict, models.SLOTOVERLAP},
+			errors2.ErrInvalidSlotRange:               {http.StatusBadRequest, models.INCORRECTSLOTTIME},
+			errors2.ErrCalendarFeedNotFound:           {http.StatusNotFound, models.CALENDARFEEDNOTFOUND},
+			errors2.ErrNoCalendarFeed:                 {http.StatusForbidden, models.NOCALENDARFEED},
+			errors2.ErrBusyCalendarNotFound:           {http.StatusNotFound, models.BUSYCALENDARNOTFOUND},
+			errors2.ErrModelIsNotAnOwnerOfCalendar:    {http.StatusForbidden, models.NOTBUSYCALENDAROWNER},
+			errors2.ErrInvalidBusyCalendarFile:        {http.StatusBadRequest, models.INVALIDCALENDARFILE},
+			errors2.ErrBusyCalendarUnreachable:        {http.StatusUnprocessableEntity, models.CALENDARUNREACHABLE},
+			errors2.ErrBusyCalendarHasURL:             {http.StatusConflict, models.BUSYCALENDARHASURL},
+			errors2.ErrSlotWithinTravelBuffer:         {http.StatusConflict, models.SLOTWITHINTRAVELBUFFER},
+			errors2.ErrInvalidTravelBuffer:            {http.StatusBadRequest, models.INVALIDTRAVELBUFFER},
+			errors2.ErrInvalidAvailabilitySearch:      {http.StatusBadRequest, models.INVALIDAVAILABILITYSEARCH},
+			errors2.ErrInvalidSlotStatusFilter:        {http.StatusBadRequest, models.INVALIDSLOTSTATUSFILTER},
+			errors2.ErrInvalidTimeZone:                {http.StatusBadRequest, models.INVALIDTIMEZONE},
+			errors2.ErrInvalidSlotSplit:               {http.StatusBadRequest, models.INVALIDSLOTSPLIT},
+			errors2.ErrInvalidSlotMerge:               {http.StatusBadRequest, models.INVALIDSLOTMERGE},
+			errors2.ErrSlotsNotAdjacent:               {http.StatusConflict, models.SLOTSNOTADJACENT},
+			errors2.ErrSlotHasBookings:                {http.StatusConflict, models.SLOTHASBOOKINGS},
+			errors2.ErrTimeOffNotFound:                {http.StatusNotFound, models.TIMEOFFNOTFOUND},
+			errors2.ErrModelIsNotAnOwnerOfTimeOff:     {http.StatusForbidden, models.NOTTIMEOFFOWNER},
+			errors2.ErrInvalidTimeOff:                 {http.StatusBadRequest, models.INVALIDTIMEOFF},
+			errors2.ErrTimeOffOverlap:                 {http.StatusConflict, models.TIMEOFFOVERLAP},
+			errors2.ErrSlotWithinTimeOff:              {http.StatusConflict, models.SLOTWITHINTIMEOFF},
+			errors2.ErrSlotInThePast:                  {http.StatusBadRequest, models.SLOTINTHEPAST},
+			errors2.ErrSlotBeyondHorizon:              {http.StatusBadRequest, models.SLOTBEYONDHORIZON},
+			errors2.ErrInvalidSlotDuration:            {http.StatusBadRequest, models.INVALIDSLOTDURATION},
+			errors2.ErrBookingTooSoon:                 {http.StatusConflict, models.BOOKINGTOOSOON},
+			errors2.ErrBookingBeyondHorizon:           {http.StatusConflict, models.BOOKINGBEYONDHORIZON},
+			errors2.ErrInvalidBookingRules:            {http.StatusBadRequest, models.INVALIDBOOKINGRULES},
 		},
 	}
 }
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type LedgerService interface {
+	GetModelEarnings(ctx context.Context, page, limit *int64) (*entity.LedgerStatement, error)
+	GetTrialBalance(ctx context.Context) (*entity.TrialBalance, error)
+}
+
+type LedgerHandler struct {
+	service  LedgerService
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewLedgerHandler(service LedgerService, logger pkg.Logger) *LedgerHandler {
+	return &LedgerHandler{
+		service:  service,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *LedgerHandler) GetModelEarnings(ctx context.Context,
+	request authorized.GetModelEarningsRequestObject,
+) (authorized.GetModelEarningsResponseObject, error) {
+
+	h.logger.Info(ctx, "LedgerHandler.GetModelEarnings")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetModelEarnings(ctx, request.Params.Page, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetModelEarnings200JSONResponse(mapping.ToGeneratedModelEarnings(res)), nil
+}
+
+func (h *LedgerHandler) GetTrialBalance(ctx context.Context,
+	request authorized.GetAdminLedgerTrialBalanceRequestObject,
+) (authorized.GetAdminLedgerTrialBalanceResponseObject, error) {
+
+	h.logger.Info(ctx, "LedgerHandler.GetTrialBalance")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetTrialBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetAdminLedgerTrialBalance200JSONResponse(mapping.ToGeneratedTrialBalance(res)), nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/models"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
 	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
@@ -13,12 +14,12 @@ import (
 
 type ModelServiceService interface {
 	CreateService(ctx context.Context,
-		title string, description string, price float32) (*entity.ModelService, error)
+		title string, description string, price entity.Money) (*entity.ModelService, error)
 	GetServiceByID(ctx context.Context, serviceID int64) (*entity.ModelService, error)
 	GetAllServices(ctx context.Context, page, limit *int64) ([]*entity.ModelService, error)
 	GetAllServicesByModelID(ctx context.Context, page, limit *int64) ([]*entity.ModelService, error)
 	UpdateService(ctx context.Context, serviceID int64,
-		title, description *string, price *float32) (*entity.ModelService, error)
+		title, description *string, price *entity.Money) (*entity.ModelService, error)
 	DeactivateService(ctx context.Context, serviceID int64) error
 }
 
@@ -51,7 +52,7 @@ func (h *ModelServiceHandler) CreateService(ctx context.Context,
 	}
 
 	res, err := h.modelServiceService.CreateService(
-		ctx, request.Body.Title, request.Body.Description, request.Body.Price)
+		ctx, request.Body.Title, request.Body.Description, entity.MoneyFromFloat(request.Body.Price))
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +62,8 @@ func (h *ModelServiceHandler) CreateService(ctx context.Context,
 		ModelId:     res.ModelID,
 		Title:       res.Title,
 		Description: res.Description,
-		Price:       res.Price,
+		Price:       res.Price.Float64(),
+		Currency:    string(res.Price.Currency),
 		IsActive:    res.IsActive,
 		CreatedAt:   res.CreatedAt,
 	}, nil
@@ -92,7 +94,8 @@ func (h *ModelServiceHandler) GetAllServices(ctx context.Context,
 			ModelId:     s.ModelID,
 			Title:       s.Title,
 			Description: s.Description,
-			Price:       s.Price,
+			Price:       s.Price.Float64(),
+			Currency:    string(s.Price.Currency),
 			IsActive:    s.IsActive,
 			CreatedAt:   s.CreatedAt,
 		}
@@ -123,7 +126,8 @@ func (h *ModelServiceHandler) GetServiceByID(ctx context.Context,
 		ModelId:     res.ModelID,
 		Title:       res.Title,
 		Description: res.Description,
-		Price:       res.Price,
+		Price:       res.Price.Float64(),
+		Currency:    string(res.Price.Currency),
 		IsActive:    res.IsActive,
 		CreatedAt:   res.CreatedAt,
 	}, nil
@@ -154,7 +158,8 @@ func (h *ModelServiceHandler) GetModelServices(ctx context.Context,
 			ModelId:     s.ModelID,
 			Title:       s.Title,
 			Description: s.Description,
-			Price:       s.Price,
+			Price:       s.Price.Float64(),
+			Currency:    string(s.Price.Currency),
 			IsActive:    s.IsActive,
 			CreatedAt:   s.CreatedAt,
 		}
@@ -176,7 +181,7 @@ func (h *ModelServiceHandler) UpdateService(ctx context.Context,
 	}
 
 	res, err := h.modelServiceService.UpdateService(
-		ctx, request.Id, request.Body.Title, request.Body.Description, request.Body.Price)
+		ctx, request.Id, request.Body.Title, request.Body.Description, mapping.FromGeneratedMoneyPtr(request.Body.Price))
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +191,8 @@ func (h *ModelServiceHandler) UpdateService(ctx context.Context,
 		ModelId:     res.ModelID,
 		Title:       res.Title,
 		Description: res.Description,
-		Price:       res.Price,
+		Price:       res.Price.Float64(),
+		Currency:    string(res.Price.Currency),
 		IsActive:    res.IsActive,
 		CreatedAt:   res.CreatedAt,
 	}, nil
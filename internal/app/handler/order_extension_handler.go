@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type OrderExtensionService interface {
+	RequestExtension(ctx context.Context, orderID int64, minutes int) (*entity.OrderExtension, error)
+	GetOrderExtensions(ctx context.Context, orderID int64) ([]*entity.OrderExtension, error)
+	AcceptExtension(ctx context.Context, orderID, extensionID int64) (*entity.OrderExtension, error)
+	RejectExtension(ctx context.Context, orderID, extensionID int64) (*entity.OrderExtension, error)
+}
+
+type OrderExtensionHandler struct {
+	service  OrderExtensionService
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewOrderExtensionHandler(service OrderExtensionService, logger pkg.Logger) *OrderExtensionHandler {
+	return &OrderExtensionHandler{
+		service:  service,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *OrderExtensionHandler) RequestExtension(ctx context.Context,
+	request authorized.PostClientOrdersIdExtensionsRequestObject,
+) (authorized.PostClientOrdersIdExtensionsResponseObject, error) {
+
+	h.logger.Info(ctx, "OrderExtensionHandler.RequestExtension")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.RequestExtension(ctx, request.Id, request.Body.Minutes)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostClientOrdersIdExtensions201JSONResponse(mapping.ToGeneratedOrderExtension(res)), nil
+}
+
+func (h *OrderExtensionHandler) GetClientOrderExtensions(ctx context.Context,
+	request authorized.GetClientOrdersIdExtensionsRequestObject,
+) (authorized.GetClientOrdersIdExtensionsResponseObject, error) {
+
+	h.logger.Info(ctx, "OrderExtensionHandler.GetClientOrderExtensions")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetOrderExtensions(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	extensions := make(authorized.GetClientOrdersIdExtensions200JSONResponse, len(res))
+	for i, e := range res {
+		extensions[i] = mapping.ToGeneratedOrderExtension(e)
+	}
+
+	return extensions, nil
+}
+
+func (h *OrderExtensionHandler) GetModelOrderExtensions(ctx context.Context,
+	request authorized.GetModelOrdersIdExtensionsRequestObject,
+) (authorized.GetModelOrdersIdExtensionsResponseObject, error) {
+
+	h.logger.Info(ctx, "OrderExtensionHandler.GetModelOrderExtensions")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetOrderExtensions(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	extensions := make(authorized.GetModelOrdersIdExtensions200JSONResponse, len(res))
+	for i, e := range res {
+		extensions[i] = mapping.ToGeneratedOrderExtension(e)
+	}
+
+	return extensions, nil
+}
+
+func (h *OrderExtensionHandler) AcceptExtension(ctx context.Context,
+	request authorized.PatchModelOrdersIdExtensionsExtensionIdAcceptRequestObject,
+) (authorized.PatchModelOrdersIdExtensionsExtensionIdAcceptResponseObject, error) {
+
+	h.logger.Info(ctx, "OrderExtensionHandler.AcceptExtension")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.AcceptExtension(ctx, request.Id, request.ExtensionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PatchModelOrdersIdExtensionsExtensionIdAccept200JSONResponse(
+		mapping.ToGeneratedOrderExtension(res)), nil
+}
+
+func (h *OrderExtensionHandler) RejectExtension(ctx context.Context,
+	request authorized.PatchModelOrdersIdExtensionsExtensionIdRejectRequestObject,
+) (authorized.PatchModelOrdersIdExtensionsExtensionIdRejectResponseObject, error) {
+
+	h.logger.Info(ctx, "OrderExtensionHandler.RejectExtension")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.RejectExtension(ctx, request.Id, request.ExtensionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PatchModelOrdersIdExtensionsExtensionIdReject200JSONResponse(
+		mapping.ToGeneratedOrderExtension(res)), nil
+}
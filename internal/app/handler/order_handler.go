@@ -4,7 +4,7 @@ import (
 	"context"
 
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
-	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/models"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
 	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
@@ -16,17 +16,21 @@ type OrderService interface {
 	CancelOrderByModel(ctx context.Context, orderID int64) (*entity.Order, error)
 	CompleteOrder(ctx context.Context, orderID int64) (*entity.Order, error)
 	CancelOrderByClient(ctx context.Context, orderID int64) (*entity.Order, error)
+	ConfirmOrder(ctx context.Context, orderID int64) (*entity.Order, error)
+	RaiseOrderIssue(ctx context.Context, orderID int64, reason string) (*entity.Order, error)
 }
 
 type OrderHandler struct {
 	orderService OrderService
+	zones        ViewerZone
 	logger       pkg.Logger
 	validate     *validator.Validate
 }
 
-func NewOrderHandler(orderService OrderService, logger pkg.Logger) OrderHandler {
+func NewOrderHandler(orderService OrderService, zones ViewerZone, logger pkg.Logger) OrderHandler {
 	return OrderHandler{
 		orderService: orderService,
+		zones:        zones,
 		logger:       logger,
 		validate:     validator.New(),
 	}
@@ -49,14 +53,10 @@ func (h *OrderHandler) GetModelOrders(ctx context.Context,
 		return nil, err
 	}
 
+	location := viewerLocation(ctx, h.zones, h.logger)
 	res := make(authorized.GetModelOrders200JSONResponse, len(orders))
 	for i, o := range orders {
-		res[i] = models.OrderResponse{
-			Id:        o.ID,
-			BookingID: o.BookingID,
-			Status:    models.OrderStatus(o.Status),
-			CreatedAt: o.CreatedAt,
-		}
+		res[i] = mapping.ToGeneratedOrder(o, location)
 	}
 
 	return res, nil
@@ -80,12 +80,8 @@ func (h *OrderHandler) CancelOrderByModel(ctx context.Context,
 		return nil, err
 	}
 
-	return authorized.PatchModelOrdersIdCancel200JSONResponse{
-		Id:        res.ID,
-		BookingID: res.BookingID,
-		Status:    models.OrderStatus(res.Status),
-		CreatedAt: res.CreatedAt,
-	}, nil
+	return authorized.PatchModelOrdersIdCancel200JSONResponse(
+		mapping.ToGeneratedOrder(res, viewerLocation(ctx, h.zones, h.logger))), nil
 }
 
 func (h *OrderHandler) CompleteOrder(ctx context.Context,
@@ -106,12 +102,8 @@ func (h *OrderHandler) CompleteOrder(ctx context.Context,
 		return nil, err
 	}
 
-	return authorized.PatchModelOrdersIdComplete200JSONResponse{
-		Id:        res.ID,
-		BookingID: res.BookingID,
-		Status:    models.OrderStatus(res.Status),
-		CreatedAt: res.CreatedAt,
-	}, nil
+	return authorized.PatchModelOrdersIdComplete200JSONResponse(
+		mapping.ToGeneratedOrder(res, viewerLocation(ctx, h.zones, h.logger))), nil
 }
 
 func (h *OrderHandler) CancelOrderByClient(ctx context.Context,
@@ -132,10 +124,50 @@ func (h *OrderHandler) CancelOrderByClient(ctx context.Context,
 		return nil, err
 	}
 
-	return authorized.PatchClientOrdersIdCancel200JSONResponse{
-		Id:        res.ID,
-		BookingID: res.BookingID,
-		Status:    models.OrderStatus(res.Status),
-		CreatedAt: res.CreatedAt,
-	}, nil
+	return authorized.PatchClientOrdersIdCancel200JSONResponse(
+		mapping.ToGeneratedOrder(res, viewerLocation(ctx, h.zones, h.logger))), nil
+}
+
+func (h *OrderHandler) ConfirmOrder(ctx context.Context,
+	request authorized.PatchClientOrdersIdConfirmRequestObject,
+) (authorized.PatchClientOrdersIdConfirmResponseObject, error) {
+
+	h.logger.Info(ctx, "OrderHandler.ConfirmOrder")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.orderService.ConfirmOrder(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PatchClientOrdersIdConfirm200JSONResponse(
+		mapping.ToGeneratedOrder(res, viewerLocation(ctx, h.zones, h.logger))), nil
+}
+
+func (h *OrderHandler) RaiseOrderIssue(ctx context.Context,
+	request authorized.PatchClientOrdersIdIssueRequestObject,
+) (authorized.PatchClientOrdersIdIssueResponseObject, error) {
+
+	h.logger.Info(ctx, "OrderHandler.RaiseOrderIssue")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.orderService.RaiseOrderIssue(ctx, request.Id, request.Body.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PatchClientOrdersIdIssue200JSONResponse(
+		mapping.ToGeneratedOrder(res, viewerLocation(ctx, h.zones, h.logger))), nil
 }
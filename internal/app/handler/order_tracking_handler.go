@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type OrderTrackingService interface {
+	SubscribeOrderEvents(ctx context.Context, orderID int64,
+		lastEventID *int64) (*entity.Order, <-chan *entity.OrderEvent, func(), error)
+	PostOrderLocation(ctx context.Context, orderID int64,
+		latitude, longitude float64, eta *time.Time) (*entity.OrderEvent, error)
+}
+
+type OrderTrackingHandler struct {
+	orderTrackingService OrderTrackingService
+	zones                ViewerZone
+	heartbeatInterval    time.Duration
+	logger               pkg.Logger
+	validate             *validator.Validate
+}
+
+func NewOrderTrackingHandler(orderTrackingService OrderTrackingService, zones ViewerZone,
+	heartbeatInterval time.Duration, logger pkg.Logger) *OrderTrackingHandler {
+	return &OrderTrackingHandler{
+		orderTrackingService: orderTrackingService,
+		zones:                zones,
+		heartbeatInterval:    heartbeatInterval,
+		logger:               logger,
+		validate:             validator.New(),
+	}
+}
+
+func (h *OrderTrackingHandler) GetOrderEvents(ctx context.Context,
+	request authorized.GetClientOrdersIdEventsRequestObject,
+) (authorized.GetClientOrdersIdEventsResponseObject, error) {
+
+	h.logger.Info(ctx, "OrderTrackingHandler.GetOrderEvents")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	order, events, unsubscribe, err := h.orderTrackingService.SubscribeOrderEvents(
+		ctx, request.Id, request.Params.LastEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return orderEventStream{
+		ctx:               ctx,
+		snapshot:          entity.NewOrderStatusEvent(order),
+		events:            events,
+		unsubscribe:       unsubscribe,
+		heartbeatInterval: h.heartbeatInterval,
+		logger:            h.logger,
+		location:          viewerLocation(ctx, h.zones, h.logger),
+	}, nil
+}
+
+func (h *OrderTrackingHandler) PostOrderLocation(ctx context.Context,
+	request authorized.PostModelOrdersIdLocationRequestObject,
+) (authorized.PostModelOrdersIdLocationResponseObject, error) {
+
+	h.logger.Info(ctx, "OrderTrackingHandler.PostOrderLocation")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.orderTrackingService.PostOrderLocation(ctx, request.Id,
+		request.Body.Latitude, request.Body.Longitude, request.Body.Eta)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelOrdersIdLocation200JSONResponse(
+		mapping.ToGeneratedOrderEvent(res, viewerLocation(ctx, h.zones, h.logger))), nil
+}
+
+// orderEventStream writes order events as Server-Sent Events until the client
+// goes away or the broker closes the subscription on shutdown.
+type orderEventStream struct {
+	ctx               context.Context
+	snapshot          *entity.OrderEvent
+	events            <-chan *entity.OrderEvent
+	unsubscribe       func()
+	heartbeatInterval time.Duration
+	logger            pkg.Logger
+	// location is the zone of the caller the event times are written in, nil leaves them as they are.
+	location *time.Location
+}
+
+func (s orderEventStream) VisitGetClientOrdersIdEventsResponse(w http.ResponseWriter) error {
+	defer s.unsubscribe()
+
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	// snapshot has no id, so it does not move the client's Last-Event-ID
+	if err := s.write(w, rc, "snapshot", s.snapshot, false); err != nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(s.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return nil
+
+		case event, ok := <-s.events:
+			if !ok {
+				return nil
+			}
+
+			if err := s.write(w, rc, string(event.Type), event, true); err != nil {
+				return nil
+			}
+
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+
+			if err := rc.Flush(); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+func (s orderEventStream) write(w http.ResponseWriter, rc *http.ResponseController,
+	name string, event *entity.OrderEvent, withID bool) error {
+
+	payload := mapping.ToGeneratedOrderEvent(event, s.location)
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		s.logger.Error(s.ctx, "failed to marshal order event",
+			option.Any("order_id", event.OrderID),
+			option.Error(err))
+
+		return err
+	}
+
+	if withID {
+		if _, err = fmt.Fprintf(w, "id: %d\n", event.ID); err != nil {
+			return err
+		}
+	}
+
+	if _, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data); err != nil {
+		return err
+	}
+
+	if err = rc.Flush(); err != nil {
+		s.logger.Error(s.ctx, "failed to flush order events stream",
+			option.Any("order_id", event.OrderID),
+			option.Error(err))
+
+		return err
+	}
+
+	return nil
+}
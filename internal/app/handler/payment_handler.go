@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/public"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type PaymentService interface {
+	GetOrderPayment(ctx context.Context, orderID int64) (*entity.Payment, error)
+	HandleWebhook(ctx context.Context, secret string, event *entity.PaymentEvent) (*entity.Payment, error)
+}
+
+type PaymentHandler struct {
+	service  PaymentService
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewPaymentHandler(service PaymentService, logger pkg.Logger) *PaymentHandler {
+	return &PaymentHandler{
+		service:  service,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *PaymentHandler) GetOrderPayment(ctx context.Context,
+	request authorized.GetAdminOrdersIdPaymentRequestObject,
+) (authorized.GetAdminOrdersIdPaymentResponseObject, error) {
+
+	h.logger.Info(ctx, "PaymentHandler.GetOrderPayment")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetOrderPayment(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetAdminOrdersIdPayment200JSONResponse(mapping.ToGeneratedPayment(res)), nil
+}
+
+func (h *PaymentHandler) HandleWebhook(ctx context.Context,
+	request public.PostPaymentsWebhookRequestObject,
+) (public.PostPaymentsWebhookResponseObject, error) {
+
+	h.logger.Info(ctx, "PaymentHandler.HandleWebhook")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	event := &entity.PaymentEvent{
+		EventID:           request.Body.EventID,
+		ProviderPaymentID: request.Body.ProviderPaymentID,
+		Type:              entity.PaymentEventType(request.Body.Type),
+		Amount:            mapping.FromGeneratedMoneyPtr(request.Body.Amount),
+		Reason:            request.Body.Reason,
+	}
+
+	res, err := h.service.HandleWebhook(ctx, request.Params.XWebhookSecret, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return public.PostPaymentsWebhook200JSONResponse(mapping.ToGeneratedPayment(res)), nil
+}
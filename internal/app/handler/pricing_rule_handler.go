@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type PricingRuleService interface {
+	CreatePricingRule(ctx context.Context, name string, ruleType entity.PricingRuleType, multiplier *float64,
+		amount *entity.Money, weekdays []int, startTime, endTime *string, holidays []time.Time,
+		isActive bool) (*entity.PricingRule, error)
+	GetPricingRules(ctx context.Context, page, limit *int64) ([]*entity.PricingRule, error)
+	GetPricingRuleByID(ctx context.Context, id int64) (*entity.PricingRule, error)
+	UpdatePricingRule(ctx context.Context, id int64, name string, ruleType entity.PricingRuleType,
+		multiplier *float64, amount *entity.Money, weekdays []int, startTime, endTime *string,
+		holidays []time.Time, isActive bool) (*entity.PricingRule, error)
+	DeletePricingRule(ctx context.Context, id int64) error
+}
+
+type PricingRuleHandler struct {
+	service  PricingRuleService
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewPricingRuleHandler(service PricingRuleService, logger pkg.Logger) *PricingRuleHandler {
+	return &PricingRuleHandler{
+		service:  service,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *PricingRuleHandler) CreatePricingRule(ctx context.Context,
+	request authorized.PostModelPricingRulesRequestObject,
+) (authorized.PostModelPricingRulesResponseObject, error) {
+
+	h.logger.Info(ctx, "PricingRuleHandler.CreatePricingRule")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	var weekdays []int
+	if request.Body.Weekdays != nil {
+		weekdays = *request.Body.Weekdays
+	}
+	isActive := true
+	if request.Body.IsActive != nil {
+		isActive = *request.Body.IsActive
+	}
+
+	res, err := h.service.CreatePricingRule(ctx, request.Body.Name, entity.PricingRuleType(request.Body.Type),
+		request.Body.Multiplier, mapping.FromGeneratedMoneyPtr(request.Body.Amount), weekdays,
+		request.Body.StartTime, request.Body.EndTime, mapping.FromGeneratedDates(request.Body.Holidays), isActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelPricingRules201JSONResponse(mapping.ToGeneratedPricingRule(res)), nil
+}
+
+func (h *PricingRuleHandler) GetPricingRules(ctx context.Context,
+	request authorized.GetModelPricingRulesRequestObject,
+) (authorized.GetModelPricingRulesResponseObject, error) {
+
+	h.logger.Info(ctx, "PricingRuleHandler.GetPricingRules")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	rules, err := h.service.GetPricingRules(ctx, request.Params.Page, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(authorized.GetModelPricingRules200JSONResponse, len(rules))
+	for i, r := range rules {
+		res[i] = mapping.ToGeneratedPricingRule(r)
+	}
+
+	return res, nil
+}
+
+func (h *PricingRuleHandler) GetPricingRuleByID(ctx context.Context,
+	request authorized.GetModelPricingRulesIdRequestObject,
+) (authorized.GetModelPricingRulesIdResponseObject, error) {
+
+	h.logger.Info(ctx, "PricingRuleHandler.GetPricingRuleByID")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetPricingRuleByID(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetModelPricingRulesId200JSONResponse(mapping.ToGeneratedPricingRule(res)), nil
+}
+
+func (h *PricingRuleHandler) UpdatePricingRule(ctx context.Context,
+	request authorized.PutModelPricingRulesIdRequestObject,
+) (authorized.PutModelPricingRulesIdResponseObject, error) {
+
+	h.logger.Info(ctx, "PricingRuleHandler.UpdatePricingRule")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	var weekdays []int
+	if request.Body.Weekdays != nil {
+		weekdays = *request.Body.Weekdays
+	}
+	isActive := true
+	if request.Body.IsActive != nil {
+		isActive = *request.Body.IsActive
+	}
+
+	res, err := h.service.UpdatePricingRule(ctx, request.Id, request.Body.Name,
+		entity.PricingRuleType(request.Body.Type), request.Body.Multiplier,
+		mapping.FromGeneratedMoneyPtr(request.Body.Amount), weekdays, request.Body.StartTime, request.Body.EndTime,
+		mapping.FromGeneratedDates(request.Body.Holidays), isActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PutModelPricingRulesId200JSONResponse(mapping.ToGeneratedPricingRule(res)), nil
+}
+
+func (h *PricingRuleHandler) DeletePricingRule(ctx context.Context,
+	request authorized.DeleteModelPricingRulesIdRequestObject,
+) (authorized.DeleteModelPricingRulesIdResponseObject, error) {
+
+	h.logger.Info(ctx, "PricingRuleHandler.DeletePricingRule")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if err := h.service.DeletePricingRule(ctx, request.Id); err != nil {
+		return nil, err
+	}
+
+	return authorized.DeleteModelPricingRulesId204Response{}, nil
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type PromoCodeService interface {
+	CreatePromoCode(ctx context.Context, code string, discountType entity.DiscountType,
+		percentOff *int, amountOff *entity.Money, validFrom, validUntil *time.Time, maxUses, maxUsesPerClient *int,
+		serviceIDs, modelIDs []int64) (*entity.PromoCode, error)
+	GetPromoCodes(ctx context.Context, page, limit *int64) ([]*entity.PromoCode, error)
+	GetPromoCodeByID(ctx context.Context, id int64) (*entity.PromoCode, error)
+	DeactivatePromoCode(ctx context.Context, id int64) (*entity.PromoCode, error)
+}
+
+type PromoCodeHandler struct {
+	service  PromoCodeService
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewPromoCodeHandler(service PromoCodeService, logger pkg.Logger) *PromoCodeHandler {
+	return &PromoCodeHandler{
+		service:  service,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *PromoCodeHandler) CreatePromoCode(ctx context.Context,
+	request authorized.PostAdminPromoCodesRequestObject,
+) (authorized.PostAdminPromoCodesResponseObject, error) {
+
+	h.logger.Info(ctx, "PromoCodeHandler.CreatePromoCode")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	var serviceIDs, modelIDs []int64
+	if request.Body.ServiceIDs != nil {
+		serviceIDs = *request.Body.ServiceIDs
+	}
+	if request.Body.ModelIDs != nil {
+		modelIDs = *request.Body.ModelIDs
+	}
+
+	res, err := h.service.CreatePromoCode(ctx, request.Body.Code, entity.DiscountType(request.Body.DiscountType),
+		request.Body.PercentOff, mapping.FromGeneratedMoneyPtr(request.Body.AmountOff),
+		request.Body.ValidFrom, request.Body.ValidUntil, request.Body.MaxUses, request.Body.MaxUsesPerClient,
+		serviceIDs, modelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostAdminPromoCodes201JSONResponse(mapping.ToGeneratedPromoCode(res)), nil
+}
+
+func (h *PromoCodeHandler) GetPromoCodes(ctx context.Context,
+	request authorized.GetAdminPromoCodesRequestObject,
+) (authorized.GetAdminPromoCodesResponseObject, error) {
+
+	h.logger.Info(ctx, "PromoCodeHandler.GetPromoCodes")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	promos, err := h.service.GetPromoCodes(ctx, request.Params.Page, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(authorized.GetAdminPromoCodes200JSONResponse, len(promos))
+	for i, p := range promos {
+		res[i] = mapping.ToGeneratedPromoCode(p)
+	}
+
+	return res, nil
+}
+
+func (h *PromoCodeHandler) GetPromoCodeByID(ctx context.Context,
+	request authorized.GetAdminPromoCodesIdRequestObject,
+) (authorized.GetAdminPromoCodesIdResponseObject, error) {
+
+	h.logger.Info(ctx, "PromoCodeHandler.GetPromoCodeByID")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetPromoCodeByID(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetAdminPromoCodesId200JSONResponse(mapping.ToGeneratedPromoCode(res)), nil
+}
+
+func (h *PromoCodeHandler) DeactivatePromoCode(ctx context.Context,
+	request authorized.PatchAdminPromoCodesIdDeactivateRequestObject,
+) (authorized.PatchAdminPromoCodesIdDeactivateResponseObject, error) {
+
+	h.logger.Info(ctx, "PromoCodeHandler.DeactivatePromoCode")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.DeactivatePromoCode(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PatchAdminPromoCodesIdDeactivate200JSONResponse(mapping.ToGeneratedPromoCode(res)), nil
+}
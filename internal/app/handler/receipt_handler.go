@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/models"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/render"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type ReceiptService interface {
+	GetOrderReceipt(ctx context.Context, orderID int64) (*entity.Receipt, error)
+}
+
+type ReceiptHandler struct {
+	service  ReceiptService
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewReceiptHandler(service ReceiptService, logger pkg.Logger) *ReceiptHandler {
+	return &ReceiptHandler{
+		service:  service,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *ReceiptHandler) GetOrderReceipt(ctx context.Context,
+	request authorized.GetClientOrdersIdReceiptRequestObject,
+) (authorized.GetClientOrdersIdReceiptResponseObject, error) {
+
+	h.logger.Info(ctx, "ReceiptHandler.GetOrderReceipt")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetOrderReceipt(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Params.Format != nil && *request.Params.Format == models.Html {
+		var page bytes.Buffer
+		if err = render.Receipt(&page, res); err != nil {
+			h.logger.Error(ctx, "failed to render receipt",
+				option.Any("order_id", request.Id),
+				option.Error(err))
+
+			return nil, err
+		}
+
+		return authorized.GetClientOrdersIdReceipt200TexthtmlResponse{
+			Body:          &page,
+			ContentLength: int64(page.Len()),
+		}, nil
+	}
+
+	return authorized.GetClientOrdersIdReceipt200JSONResponse(mapping.ToGeneratedReceipt(res)), nil
+}
@@ -2,11 +2,14 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/models"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
 	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
 	"github.com/go-playground/validator/v10"
@@ -17,21 +20,30 @@ type SlotService interface {
 	UpdateSlot(ctx context.Context, slotID int64,
 		start, end *time.Time) (*entity.Slot, error)
 	DeactivateSlot(ctx context.Context, slotID int64) (*entity.Slot, error)
-	GetSlotsWithModelIDByModel(ctx context.Context) ([]*entity.Slot, error)
-	GetSlotsWithModelIDByClient(ctx context.Context, modelID int64) ([]*entity.Slot, error)
+	CreateSlots(ctx context.Context, periods []entity.SlotPeriod) ([]*entity.Slot, error)
+	DisableSlotsInRange(ctx context.Context, from, to time.Time) ([]*entity.Slot, []*entity.Slot, error)
+	SplitSlot(ctx context.Context, slotID int64, length *time.Duration, cuts []time.Time) ([]*entity.Slot, error)
+	MergeSlots(ctx context.Context, slotIDs []int64) (*entity.Slot, error)
+	GetSlotsWithModelIDByModel(ctx context.Context, filter *entity.SlotFilter, page, limit *int64) ([]*entity.Slot, error)
+	GetSlotsWithModelIDByClient(ctx context.Context, modelID int64, filter *entity.SlotFilter,
+		page, limit *int64) ([]*entity.Slot, error)
 }
 
 type SlotHandler struct {
 	slotService SlotService
+	zones       ViewerZone
 	logger      pkg.Logger
 	validate    *validator.Validate
+	errorMapper *ErrorMapper
 }
 
-func NewSlotHandler(slotService SlotService, logger pkg.Logger) *SlotHandler {
+func NewSlotHandler(slotService SlotService, zones ViewerZone, logger pkg.Logger) *SlotHandler {
 	return &SlotHandler{
 		slotService: slotService,
+		zones:       zones,
 		logger:      logger,
 		validate:    validator.New(),
+		errorMapper: NewErrorMapper(),
 	}
 }
 
@@ -52,14 +64,10 @@ func (h *SlotHandler) CreateSlot(ctx context.Context,
 		return nil, err
 	}
 
-	return &authorized.PostModelSlots201JSONResponse{
-		Id:        res.ID,
-		ModelId:   res.ModelID,
-		StartTime: res.StartTime,
-		EndTime:   res.EndTime,
-		Status:    models.SlotStatus(res.Status),
-		CreatedAt: res.CreatedAt,
-	}, nil
+	slot := authorized.PostModelSlots201JSONResponse(
+		mapping.ToGeneratedSlot(res, viewerLocation(ctx, h.zones, h.logger)))
+
+	return &slot, nil
 }
 
 func (h *SlotHandler) GetOwnModelSlots(ctx context.Context,
@@ -74,24 +82,17 @@ func (h *SlotHandler) GetOwnModelSlots(ctx context.Context,
 		return nil, err
 	}
 
-	slots, err := h.slotService.GetSlotsWithModelIDByModel(ctx)
+	slots, err := h.slotService.GetSlotsWithModelIDByModel(ctx, &entity.SlotFilter{
+		From:   request.Params.From,
+		To:     request.Params.To,
+		Status: (*entity.SlotStatus)(request.Params.Status),
+	}, request.Params.Page, request.Params.Limit)
 	if err != nil {
 		return nil, err
 	}
 
-	res := make(authorized.GetModelSlots200JSONResponse, len(slots))
-	for i, s := range slots {
-		res[i] = models.SlotResponse{
-			Id:        s.ID,
-			ModelId:   s.ModelID,
-			StartTime: s.StartTime,
-			EndTime:   s.EndTime,
-			Status:    models.SlotStatus(s.Status),
-			CreatedAt: s.CreatedAt,
-		}
-	}
-
-	return res, nil
+	return authorized.GetModelSlots200JSONResponse(
+		mapping.ToGeneratedSlots(slots, viewerLocation(ctx, h.zones, h.logger))), nil
 }
 
 func (h *SlotHandler) UpdateSlot(ctx context.Context,
@@ -112,14 +113,8 @@ func (h *SlotHandler) UpdateSlot(ctx context.Context,
 		return nil, err
 	}
 
-	return authorized.PatchModelSlotsSlotId200JSONResponse{
-		Id:        res.ID,
-		ModelId:   res.ModelID,
-		StartTime: res.StartTime,
-		EndTime:   res.EndTime,
-		Status:    models.SlotStatus(res.Status),
-		CreatedAt: res.CreatedAt,
-	}, nil
+	return authorized.PatchModelSlotsSlotId200JSONResponse(
+		mapping.ToGeneratedSlot(res, viewerLocation(ctx, h.zones, h.logger))), nil
 }
 
 func (h *SlotHandler) DeactivateSlot(ctx context.Context,
@@ -140,13 +135,81 @@ func (h *SlotHandler) DeactivateSlot(ctx context.Context,
 		return nil, err
 	}
 
-	return authorized.PatchModelSlotsSlotIdDisable200JSONResponse{
-		Id:        res.ID,
-		ModelId:   res.ModelID,
-		StartTime: res.StartTime,
-		EndTime:   res.EndTime,
-		Status:    models.SlotStatus(res.Status),
-		CreatedAt: res.CreatedAt,
+	return authorized.PatchModelSlotsSlotIdDisable200JSONResponse(
+		mapping.ToGeneratedSlot(res, viewerLocation(ctx, h.zones, h.logger))), nil
+}
+
+// CreateSlots answers a rejected batch with the error of every rejected slot, coded as the single slot
+// endpoint would code it.
+func (h *SlotHandler) CreateSlots(ctx context.Context,
+	request authorized.PostModelSlotsBatchRequestObject) (authorized.PostModelSlotsBatchResponseObject, error) {
+
+	h.logger.Info(ctx, "SlotHandler.CreateSlots")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	periods := make([]entity.SlotPeriod, len(request.Body.Slots))
+	for i, s := range request.Body.Slots {
+		periods[i] = entity.SlotPeriod{Start: s.Start, End: s.End}
+	}
+
+	slots, err := h.slotService.CreateSlots(ctx, periods)
+	if err != nil {
+		var batchErr *service_errors.SlotBatchError
+		if !errors.As(err, &batchErr) {
+			return nil, err
+		}
+
+		_, code, msg := h.errorMapper.MapError(batchErr)
+		res := authorized.PostModelSlotsBatch409JSONResponse{
+			Code:    string(code),
+			Message: msg,
+			Items:   make([]models.SlotBatchItemError, len(batchErr.Items)),
+		}
+		for i, item := range batchErr.Items {
+			_, itemCode, itemMsg := h.errorMapper.MapError(item.Err)
+			res.Items[i] = models.SlotBatchItemError{
+				Index:   item.Index,
+				Code:    string(itemCode),
+				Message: itemMsg,
+			}
+		}
+
+		return res, nil
+	}
+
+	return authorized.PostModelSlotsBatch201JSONResponse(
+		mapping.ToGeneratedSlots(slots, viewerLocation(ctx, h.zones, h.logger))), nil
+}
+
+func (h *SlotHandler) DisableSlotsInRange(ctx context.Context,
+	request authorized.PatchModelSlotsDisableRangeRequestObject,
+) (authorized.PatchModelSlotsDisableRangeResponseObject, error) {
+
+	h.logger.Info(ctx, "SlotHandler.DisableSlotsInRange")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	disabled, skipped, err := h.slotService.DisableSlotsInRange(ctx, request.Body.From, request.Body.To)
+	if err != nil {
+		return nil, err
+	}
+
+	location := viewerLocation(ctx, h.zones, h.logger)
+
+	return authorized.PatchModelSlotsDisableRange200JSONResponse{
+		Disabled: mapping.ToGeneratedSlots(disabled, location),
+		Skipped:  mapping.ToGeneratedSlots(skipped, location),
 	}, nil
 }
 
@@ -163,22 +226,70 @@ func (h *SlotHandler) GetModelSlotsForClient(ctx context.Context,
 		return nil, err
 	}
 
-	slots, err := h.slotService.GetSlotsWithModelIDByClient(ctx, request.ModelId)
+	slots, err := h.slotService.GetSlotsWithModelIDByClient(ctx, request.ModelId, &entity.SlotFilter{
+		From:   request.Params.From,
+		To:     request.Params.To,
+		Status: (*entity.SlotStatus)(request.Params.Status),
+	}, request.Params.Page, request.Params.Limit)
 	if err != nil {
 		return nil, err
 	}
 
-	res := make(authorized.GetClientModelsModelIdSlots200JSONResponse, 0, len(slots))
-	for _, s := range slots {
-		res = append(res, models.SlotResponse{
-			Id:        s.ID,
-			ModelId:   s.ModelID,
-			StartTime: s.StartTime,
-			EndTime:   s.EndTime,
-			Status:    models.SlotStatus(s.Status),
-			CreatedAt: s.CreatedAt,
-		})
+	return authorized.GetClientModelsModelIdSlots200JSONResponse(
+		mapping.ToGeneratedSlots(slots, viewerLocation(ctx, h.zones, h.logger))), nil
+}
+
+func (h *SlotHandler) SplitSlot(ctx context.Context,
+	request authorized.PostModelSlotsSlotIdSplitRequestObject,
+) (authorized.PostModelSlotsSlotIdSplitResponseObject, error) {
+
+	h.logger.Info(ctx, "SlotHandler.SplitSlot")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	var length *time.Duration
+	if request.Body.LengthMinutes != nil {
+		d := time.Duration(*request.Body.LengthMinutes) * time.Minute
+		length = &d
+	}
+
+	var cuts []time.Time
+	if request.Body.Cuts != nil {
+		cuts = *request.Body.Cuts
+	}
+
+	res, err := h.slotService.SplitSlot(ctx, request.SlotId, length, cuts)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelSlotsSlotIdSplit200JSONResponse(
+		mapping.ToGeneratedSlots(res, viewerLocation(ctx, h.zones, h.logger))), nil
+}
+
+func (h *SlotHandler) MergeSlots(ctx context.Context,
+	request authorized.PostModelSlotsMergeRequestObject,
+) (authorized.PostModelSlotsMergeResponseObject, error) {
+
+	h.logger.Info(ctx, "SlotHandler.MergeSlots")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.slotService.MergeSlots(ctx, request.Body.SlotIds)
+	if err != nil {
+		return nil, err
 	}
 
-	return res, nil
+	return authorized.PostModelSlotsMerge200JSONResponse(
+		mapping.ToGeneratedSlot(res, viewerLocation(ctx, h.zones, h.logger))), nil
 }
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type TimeOffService interface {
+	GetTimeOffs(ctx context.Context) ([]*entity.TimeOff, error)
+	CreateTimeOff(ctx context.Context, start, end time.Time, reason *string) (*entity.TimeOffChange, error)
+	DeleteTimeOff(ctx context.Context, id int64) (*entity.TimeOffChange, error)
+}
+
+type TimeOffHandler struct {
+	service  TimeOffService
+	zones    ViewerZone
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewTimeOffHandler(service TimeOffService, zones ViewerZone,
+	logger pkg.Logger) *TimeOffHandler {
+	return &TimeOffHandler{
+		service:  service,
+		zones:    zones,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *TimeOffHandler) GetTimeOffs(ctx context.Context,
+	request authorized.GetModelTimeOffsRequestObject,
+) (authorized.GetModelTimeOffsResponseObject, error) {
+
+	h.logger.Info(ctx, "TimeOffHandler.GetTimeOffs")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	timeOffs, err := h.service.GetTimeOffs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	location := viewerLocation(ctx, h.zones, h.logger)
+	res := make(authorized.GetModelTimeOffs200JSONResponse, len(timeOffs))
+	for i, t := range timeOffs {
+		res[i] = mapping.ToGeneratedTimeOff(t, location)
+	}
+
+	return res, nil
+}
+
+func (h *TimeOffHandler) CreateTimeOff(ctx context.Context,
+	request authorized.PostModelTimeOffsRequestObject,
+) (authorized.PostModelTimeOffsResponseObject, error) {
+
+	h.logger.Info(ctx, "TimeOffHandler.CreateTimeOff")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.CreateTimeOff(ctx, request.Body.StartTime, request.Body.EndTime, request.Body.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PostModelTimeOffs201JSONResponse(
+		mapping.ToGeneratedTimeOffChange(res, viewerLocation(ctx, h.zones, h.logger))), nil
+}
+
+func (h *TimeOffHandler) DeleteTimeOff(ctx context.Context,
+	request authorized.DeleteModelTimeOffsIdRequestObject,
+) (authorized.DeleteModelTimeOffsIdResponseObject, error) {
+
+	h.logger.Info(ctx, "TimeOffHandler.DeleteTimeOff")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.DeleteTimeOff(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.DeleteModelTimeOffsId200JSONResponse(
+		mapping.ToGeneratedTimeOffChange(res, viewerLocation(ctx, h.zones, h.logger))), nil
+}
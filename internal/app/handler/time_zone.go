@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+// ViewerZone finds out the zone the times are shown to the caller in.
+type ViewerZone interface {
+	ViewerLocation(ctx context.Context) (*time.Location, error)
+}
+
+// viewerLocation is the zone of the caller. If the zone cannot be found out, it is nil and the times
+// are left as they are, the response itself is not failed over it.
+func viewerLocation(ctx context.Context, zones ViewerZone, logger pkg.Logger) *time.Location {
+	location, err := zones.ViewerLocation(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to get time zone of the caller",
+			option.Error(err))
+
+		return nil
+	}
+
+	return location
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/authorized"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/mapping"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+	"github.com/go-playground/validator/v10"
+)
+
+type TravelBufferService interface {
+	GetBuffer(ctx context.Context) (*entity.TravelBuffer, error)
+	UpdateBuffer(ctx context.Context, beforeMinutes, afterMinutes int, fee entity.Money) (*entity.TravelBuffer, error)
+}
+
+type TravelBufferHandler struct {
+	service  TravelBufferService
+	logger   pkg.Logger
+	validate *validator.Validate
+}
+
+func NewTravelBufferHandler(service TravelBufferService, logger pkg.Logger) *TravelBufferHandler {
+	return &TravelBufferHandler{
+		service:  service,
+		logger:   logger,
+		validate: validator.New(),
+	}
+}
+
+func (h *TravelBufferHandler) GetBuffer(ctx context.Context,
+	request authorized.GetModelTravelBufferRequestObject,
+) (authorized.GetModelTravelBufferResponseObject, error) {
+
+	h.logger.Info(ctx, "TravelBufferHandler.GetBuffer")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := h.service.GetBuffer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.GetModelTravelBuffer200JSONResponse(mapping.ToGeneratedTravelBuffer(res)), nil
+}
+
+func (h *TravelBufferHandler) UpdateBuffer(ctx context.Context,
+	request authorized.PutModelTravelBufferRequestObject,
+) (authorized.PutModelTravelBufferResponseObject, error) {
+
+	h.logger.Info(ctx, "TravelBufferHandler.UpdateBuffer")
+
+	if err := h.validate.Struct(request); err != nil {
+		h.logger.Error(ctx, "validation error",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	fee := entity.NewMoney(0, entity.CurrencyRUB)
+	if request.Body.Fee != nil {
+		fee = entity.MoneyFromFloat(*request.Body.Fee)
+	}
+
+	res, err := h.service.UpdateBuffer(ctx, request.Body.BeforeMinutes, request.Body.AfterMinutes, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorized.PutModelTravelBuffer200JSONResponse(mapping.ToGeneratedTravelBuffer(res)), nil
+}
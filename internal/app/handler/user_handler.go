@@ -14,10 +14,11 @@ import (
 )
 
 type UserService interface {
-	Create(ctx context.Context, name string, birthDate time.Time) (*entity.User, error)
+	Create(ctx context.Context, name string, birthDate time.Time, timeZone *string) (*entity.User, error)
 	GetByID(ctx context.Context, id int64) (*entity.User, error)
 	GetByAuthID(ctx context.Context) (*entity.User, error)
-	Update(ctx context.Context, name string) (*entity.User, error)
+	Update(ctx context.Context, name string, timeZone *string) (*entity.User, error)
+	Location(user *entity.User) *time.Location
 }
 
 type UserHandler struct {
@@ -51,7 +52,7 @@ func (h *UserHandler) CreateProfile(ctx context.Context,
 		return nil, fmt.Errorf("invalid birth_date format: %w", err)
 	}
 
-	res, err := h.userService.Create(ctx, request.Body.Name, birthDate)
+	res, err := h.userService.Create(ctx, request.Body.Name, birthDate, request.Body.TimeZone)
 	if err != nil {
 		return nil, err
 	}
@@ -63,6 +64,7 @@ func (h *UserHandler) CreateProfile(ctx context.Context,
 			Time: res.BirthDate,
 		},
 		IsVerified: res.IsVerified,
+		TimeZone:   h.userService.Location(res).String(),
 	}, nil
 }
 
@@ -78,7 +80,7 @@ func (h *UserHandler) UpdateProfile(ctx context.Context,
 		return nil, err
 	}
 
-	res, err := h.userService.Update(ctx, request.Body.Name)
+	res, err := h.userService.Update(ctx, request.Body.Name, request.Body.TimeZone)
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +92,7 @@ func (h *UserHandler) UpdateProfile(ctx context.Context,
 			Time: res.BirthDate,
 		},
 		IsVerified: res.IsVerified,
+		TimeZone:   h.userService.Location(res).String(),
 	}, nil
 }
 
@@ -117,6 +120,7 @@ func (h *UserHandler) GetOwnProfile(ctx context.Context,
 			Time: res.BirthDate,
 		},
 		IsVerified: res.IsVerified,
+		TimeZone:   h.userService.Location(res).String(),
 	}, nil
 }
 
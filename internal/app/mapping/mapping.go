@@ -1,8 +1,11 @@
 package mapping
 
 import (
+	"time"
+
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/app/api/generated/models"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 func ToGeneratedAddress(a entity.Address) models.Address {
@@ -15,3 +18,536 @@ func ToGeneratedAddress(a entity.Address) models.Address {
 		Comment:   &a.Comment,
 	}
 }
+
+// ToGeneratedMoneyPtr keeps an optional amount optional in the response.
+func ToGeneratedMoneyPtr(m *entity.Money) *float64 {
+	if m == nil {
+		return nil
+	}
+
+	res := m.Float64()
+
+	return &res
+}
+
+// FromGeneratedMoneyPtr reads an optional amount of the request in the platform currency.
+func FromGeneratedMoneyPtr(amount *float64) *entity.Money {
+	if amount == nil {
+		return nil
+	}
+
+	res := entity.MoneyFromFloat(*amount)
+
+	return &res
+}
+
+func ToGeneratedBooking(b *entity.Booking) models.BookingResponse {
+	return models.BookingResponse{
+		Id:              b.ID,
+		ModelServiceID:  b.ModelServiceID,
+		ClientID:        b.ClientID,
+		SlotID:          b.SlotID,
+		Address:         ToGeneratedAddress(b.Address),
+		Status:          models.BookingStatus(b.Status),
+		BasePrice:       b.BasePrice.Float64(),
+		DurationScaling: b.DurationScaling.Float64(),
+		Surcharges:      ToGeneratedPriceComponents(b.Surcharges),
+		AddOns:          ToGeneratedBookingAddOns(b.AddOns),
+		TravelFee:       b.TravelFee.Float64(),
+		Price:           b.Price.Float64(),
+		Discount:        b.Discount.Float64(),
+		PromoCodeID:     b.PromoCodeID,
+		ExpiresAt:       b.ExpiresAt,
+		CreatedAt:       b.CreatedAt,
+		ArchivedAt:      b.ArchivedAt,
+	}
+}
+
+func ToGeneratedBookingQuote(q *entity.Quote, token string) models.BookingQuoteResponse {
+	return models.BookingQuoteResponse{
+		ModelServiceID:  q.ModelServiceID,
+		SlotID:          q.SlotID,
+		BasePrice:       q.BasePrice.Float64(),
+		DurationScaling: q.DurationScaling.Float64(),
+		Surcharges:      ToGeneratedPriceComponents(q.Surcharges),
+		AddOns:          ToGeneratedBookingAddOns(q.AddOns),
+		TravelFee:       q.TravelFee.Float64(),
+		Discount:        q.Discount.Float64(),
+		Price:           q.Price.Float64(),
+		Token:           token,
+		ExpiresAt:       q.ExpiresAt,
+	}
+}
+
+func ToGeneratedPriceComponents(components []entity.PriceComponent) []models.PriceComponentResponse {
+	res := make([]models.PriceComponentResponse, len(components))
+	for i, c := range components {
+		res[i] = models.PriceComponentResponse{
+			PricingRuleID: c.PricingRuleID,
+			Name:          c.Name,
+			Amount:        c.Amount.Float64(),
+		}
+	}
+
+	return res
+}
+
+func ToGeneratedAddOn(a *entity.AddOn) models.AddOnResponse {
+	return models.AddOnResponse{
+		Id:             a.ID,
+		ModelServiceID: a.ModelServiceID,
+		Title:          a.Title,
+		Description:    a.Description,
+		Price:          a.Price.Float64(),
+		ExtraMinutes:   a.ExtraMinutes,
+		IsActive:       a.IsActive,
+		CreatedAt:      a.CreatedAt,
+	}
+}
+
+func ToGeneratedBookingAddOns(addOns []entity.BookingAddOn) []models.BookingAddOnResponse {
+	res := make([]models.BookingAddOnResponse, len(addOns))
+	for i, a := range addOns {
+		res[i] = models.BookingAddOnResponse{
+			AddOnID:      a.AddOnID,
+			Title:        a.Title,
+			Price:        a.Price.Float64(),
+			ExtraMinutes: a.ExtraMinutes,
+		}
+	}
+
+	return res
+}
+
+func ToGeneratedPricingRule(r *entity.PricingRule) models.PricingRuleResponse {
+	var startTime, endTime *string
+	if r.StartMinute != nil && r.EndMinute != nil {
+		start, end := entity.FormatTimeOfDay(*r.StartMinute), entity.FormatTimeOfDay(*r.EndMinute)
+		startTime, endTime = &start, &end
+	}
+
+	weekdays := r.Weekdays
+	if weekdays == nil {
+		weekdays = []int{}
+	}
+
+	holidays := make([]openapi_types.Date, len(r.Holidays))
+	for i, h := range r.Holidays {
+		holidays[i] = openapi_types.Date{Time: h}
+	}
+
+	return models.PricingRuleResponse{
+		Id:         r.ID,
+		ModelID:    r.ModelID,
+		Name:       r.Name,
+		Type:       models.PricingRuleType(r.Type),
+		Multiplier: r.Multiplier,
+		Amount:     ToGeneratedMoneyPtr(r.Amount),
+		Weekdays:   weekdays,
+		StartTime:  startTime,
+		EndTime:    endTime,
+		Holidays:   holidays,
+		IsActive:   r.IsActive,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
+
+// ToGeneratedSlot renders the start and the end of the slot in the location of the viewer.
+func ToGeneratedSlot(s *entity.Slot, location *time.Location) models.SlotResponse {
+	return models.SlotResponse{
+		Id:        s.ID,
+		ModelId:   s.ModelID,
+		StartTime: inLocation(s.StartTime, location),
+		EndTime:   inLocation(s.EndTime, location),
+		Status:    models.SlotStatus(s.Status),
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+func ToGeneratedSlots(slots []*entity.Slot, location *time.Location) []models.SlotResponse {
+	res := make([]models.SlotResponse, len(slots))
+	for i, s := range slots {
+		res[i] = ToGeneratedSlot(s, location)
+	}
+
+	return res
+}
+
+func ToGeneratedAvailabilityTemplate(t *entity.AvailabilityTemplate) models.AvailabilityTemplateResponse {
+	exceptions := make([]openapi_types.Date, len(t.Exceptions))
+	for i, e := range t.Exceptions {
+		exceptions[i] = openapi_types.Date{Time: e}
+	}
+
+	var effectiveTo *openapi_types.Date
+	if t.EffectiveTo != nil {
+		effectiveTo = &openapi_types.Date{Time: *t.EffectiveTo}
+	}
+
+	return models.AvailabilityTemplateResponse{
+		Id:            t.ID,
+		ModelID:       t.ModelID,
+		Name:          t.Name,
+		Weekdays:      t.Weekdays,
+		StartTime:     entity.FormatTimeOfDay(t.StartMinute),
+		EndTime:       entity.FormatTimeOfDay(t.EndMinute),
+		SlotMinutes:   t.SlotMinutes,
+		EffectiveFrom: openapi_types.Date{Time: t.EffectiveFrom},
+		EffectiveTo:   effectiveTo,
+		Exceptions:    exceptions,
+		IsPublished:   t.IsPublished,
+		CreatedAt:     t.CreatedAt,
+		UpdatedAt:     t.UpdatedAt,
+	}
+}
+
+func ToGeneratedSlotPreview(p entity.SlotPreview, location *time.Location) models.SlotPreviewResponse {
+	return models.SlotPreviewResponse{
+		StartTime: inLocation(p.StartTime, location),
+		EndTime:   inLocation(p.EndTime, location),
+		Skipped:   p.Skipped,
+	}
+}
+
+// FromGeneratedDates drops the zone of the calendar dates, holidays are compared by the date only.
+func FromGeneratedDates(dates *[]openapi_types.Date) []time.Time {
+	if dates == nil {
+		return nil
+	}
+
+	res := make([]time.Time, len(*dates))
+	for i, d := range *dates {
+		res[i] = d.Time
+	}
+
+	return res
+}
+
+func ToGeneratedPromoCode(p *entity.PromoCode) models.PromoCodeResponse {
+	return models.PromoCodeResponse{
+		Id:               p.ID,
+		Code:             p.Code,
+		DiscountType:     models.DiscountType(p.DiscountType),
+		PercentOff:       p.PercentOff,
+		AmountOff:        ToGeneratedMoneyPtr(p.AmountOff),
+		ValidFrom:        p.ValidFrom,
+		ValidUntil:       p.ValidUntil,
+		MaxUses:          p.MaxUses,
+		MaxUsesPerClient: p.MaxUsesPerClient,
+		UsedCount:        p.UsedCount,
+		ServiceIDs:       p.ServiceIDs,
+		ModelIDs:         p.ModelIDs,
+		IsActive:         p.IsActive,
+		CreatedAt:        p.CreatedAt,
+	}
+}
+
+// ToGeneratedOrderEvent renders the time of the event and the ETA in the location of the viewer.
+func ToGeneratedOrderEvent(e *entity.OrderEvent, location *time.Location) models.OrderEventResponse {
+	return models.OrderEventResponse{
+		Id:        e.ID,
+		OrderID:   e.OrderID,
+		Type:      string(e.Type),
+		Status:    models.OrderStatus(e.Status),
+		Latitude:  e.Latitude,
+		Longitude: e.Longitude,
+		Eta:       inLocationPtr(e.ETA, location),
+		CreatedAt: inLocation(e.CreatedAt, location),
+	}
+}
+
+// ToGeneratedOrder renders the confirmation deadline and the times the order was confirmed and completed
+// in the location of the viewer.
+func ToGeneratedOrder(o *entity.Order, location *time.Location) models.OrderResponse {
+	return models.OrderResponse{
+		Id:                   o.ID,
+		BookingID:            o.BookingID,
+		Status:               models.OrderStatus(o.Status),
+		CompletedAt:          inLocationPtr(o.CompletedAt, location),
+		ConfirmationDeadline: inLocationPtr(o.ConfirmationDeadline, location),
+		ConfirmedAt:          inLocationPtr(o.ConfirmedAt, location),
+		IssueReason:          o.IssueReason,
+		ExtensionMinutes:     o.ExtensionMinutes,
+		ExtensionAmount:      o.ExtensionAmount.Float64(),
+		CreatedAt:            o.CreatedAt,
+		ArchivedAt:           o.ArchivedAt,
+	}
+}
+
+func ToGeneratedOrderExtension(e *entity.OrderExtension) models.OrderExtensionResponse {
+	return models.OrderExtensionResponse{
+		Id:        e.ID,
+		OrderID:   e.OrderID,
+		Minutes:   e.Minutes,
+		Status:    models.OrderExtensionStatus(e.Status),
+		Amount:    ToGeneratedMoneyPtr(e.Amount),
+		DecidedAt: e.DecidedAt,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func ToGeneratedDispute(d *entity.Dispute) models.DisputeResponse {
+	return models.DisputeResponse{
+		Id:                d.ID,
+		OrderID:           d.OrderID,
+		ClientID:          d.ClientID,
+		ModelID:           d.ModelID,
+		OpenedBy:          d.OpenedBy.String(),
+		Category:          models.DisputeCategory(d.Category),
+		Description:       d.Description,
+		Status:            models.DisputeStatus(d.Status),
+		AssignedAdminID:   d.AssignedAdminID,
+		Resolution:        (*models.DisputeResolution)(d.Resolution),
+		RefundAmount:      ToGeneratedMoneyPtr(d.RefundAmount),
+		PenalizedUserID:   d.PenalizedUserID,
+		ResolutionComment: d.ResolutionComment,
+		ResolvedAt:        d.ResolvedAt,
+		CreatedAt:         d.CreatedAt,
+		UpdatedAt:         d.UpdatedAt,
+	}
+}
+
+func ToGeneratedDisputeMessage(m *entity.DisputeMessage) models.DisputeMessageResponse {
+	return models.DisputeMessageResponse{
+		Id:           m.ID,
+		DisputeID:    m.DisputeID,
+		AuthorAuthID: m.AuthorAuthID,
+		AuthorRole:   m.AuthorRole.String(),
+		Body:         m.Body,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+func ToGeneratedPayment(p *entity.Payment) models.PaymentResponse {
+	return models.PaymentResponse{
+		Id:                p.ID,
+		OrderID:           p.OrderID,
+		Provider:          p.Provider,
+		ProviderPaymentID: p.ProviderPaymentID,
+		Amount:            p.Amount.Float64(),
+		CapturedAmount:    p.CapturedAmount.Float64(),
+		RefundedAmount:    p.RefundedAmount.Float64(),
+		Status:            models.PaymentStatus(p.Status),
+		FailureReason:     p.FailureReason,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+}
+
+func ToGeneratedLedgerEntry(e *entity.LedgerEntry) models.LedgerEntryResponse {
+	return models.LedgerEntryResponse{
+		Id:            e.ID,
+		TransactionID: e.TransactionID,
+		OrderID:       e.OrderID,
+		Type:          models.LedgerTransactionType(e.TransactionType),
+		Amount:        e.Amount.Float64(),
+		CreatedAt:     e.CreatedAt,
+	}
+}
+
+func ToGeneratedModelEarnings(s *entity.LedgerStatement) models.ModelEarningsResponse {
+	entries := make([]models.LedgerEntryResponse, len(s.Entries))
+	for i, e := range s.Entries {
+		entries[i] = ToGeneratedLedgerEntry(e)
+	}
+
+	return models.ModelEarningsResponse{
+		Balance: s.Balance.Float64(),
+		Entries: entries,
+	}
+}
+
+func ToGeneratedTrialBalance(t *entity.TrialBalance) models.TrialBalanceResponse {
+	lines := make([]models.TrialBalanceLineResponse, len(t.Lines))
+	for i, l := range t.Lines {
+		lines[i] = models.TrialBalanceLineResponse{
+			AccountType: models.LedgerAccountType(l.AccountType),
+			Debit:       l.Debit.Float64(),
+			Credit:      l.Credit.Float64(),
+			Balance:     l.Balance.Float64(),
+		}
+	}
+
+	return models.TrialBalanceResponse{
+		Lines:       lines,
+		TotalDebit:  t.TotalDebit.Float64(),
+		TotalCredit: t.TotalCredit.Float64(),
+		Balanced:    t.Balanced,
+	}
+}
+
+func ToGeneratedReceipt(r *entity.Receipt) models.ReceiptResponse {
+	lines := make([]models.ReceiptLineResponse, len(r.Lines))
+	for i, l := range r.Lines {
+		lines[i] = models.ReceiptLineResponse{
+			Title:  l.Title,
+			Amount: l.Amount.Float64(),
+		}
+	}
+
+	return models.ReceiptResponse{
+		Id:            r.ID,
+		Number:        r.Code(),
+		OrderID:       r.OrderID,
+		ClientID:      r.ClientID,
+		ModelID:       r.ModelID,
+		ModelName:     r.ModelName,
+		ServiceTitle:  r.ServiceTitle,
+		Lines:         lines,
+		Total:         r.Total.Float64(),
+		Currency:      string(r.Total.Currency),
+		PaymentMethod: r.PaymentMethod,
+		IssuedAt:      r.IssuedAt,
+	}
+}
+
+func ToGeneratedCalendarFeed(f *entity.CalendarFeed) models.CalendarFeedResponse {
+	return models.CalendarFeedResponse{
+		Token:     f.Token,
+		Path:      "/calendar/" + f.Token,
+		CreatedAt: f.CreatedAt,
+	}
+}
+
+func ToGeneratedBusyCalendar(c *entity.BusyCalendar, location *time.Location) models.BusyCalendarResponse {
+	return models.BusyCalendarResponse{
+		Id:        c.ID,
+		Name:      c.Name,
+		Url:       c.URL,
+		Conflicts: ToGeneratedBusyConflicts(c.Conflicts, location),
+		SyncedAt:  c.SyncedAt,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+// ToGeneratedBusyConflicts renders the times of the slots and of the events in the location of the viewer.
+func ToGeneratedBusyConflicts(conflicts []entity.BusyConflict,
+	location *time.Location) []models.BusyConflictResponse {
+	res := make([]models.BusyConflictResponse, len(conflicts))
+	for i, c := range conflicts {
+		res[i] = models.BusyConflictResponse{
+			SlotID:     c.SlotID,
+			SlotStatus: models.SlotStatus(c.SlotStatus),
+			SlotStart:  inLocation(c.SlotStart, location),
+			SlotEnd:    inLocation(c.SlotEnd, location),
+			EventUID:   c.EventUID,
+			EventStart: inLocation(c.EventStart, location),
+			EventEnd:   inLocation(c.EventEnd, location),
+		}
+	}
+
+	return res
+}
+
+func ToGeneratedBusyCalendarSync(s *entity.BusyCalendarSync,
+	location *time.Location) models.BusyCalendarSyncResponse {
+	return models.BusyCalendarSyncResponse{
+		Calendar:  ToGeneratedBusyCalendar(s.Calendar, location),
+		Blocked:   ToGeneratedSlots(s.Blocked, location),
+		Released:  ToGeneratedSlots(s.Released, location),
+		Conflicts: ToGeneratedBusyConflicts(s.Conflicts, location),
+	}
+}
+
+// ToGeneratedTimeOff renders the period of the time off in the location of the viewer.
+func ToGeneratedTimeOff(t *entity.TimeOff, location *time.Location) models.TimeOffResponse {
+	return models.TimeOffResponse{
+		Id:        t.ID,
+		StartTime: inLocation(t.StartTime, location),
+		EndTime:   inLocation(t.EndTime, location),
+		Reason:    t.Reason,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func ToGeneratedTimeOffChange(c *entity.TimeOffChange, location *time.Location) models.TimeOffChangeResponse {
+	return models.TimeOffChangeResponse{
+		TimeOff:   ToGeneratedTimeOff(c.TimeOff, location),
+		Disabled:  ToGeneratedSlots(c.Disabled, location),
+		Enabled:   ToGeneratedSlots(c.Enabled, location),
+		Conflicts: ToGeneratedSlots(c.Conflicts, location),
+	}
+}
+
+func ToGeneratedTravelBuffer(b *entity.TravelBuffer) models.TravelBufferResponse {
+	res := models.TravelBufferResponse{
+		BeforeMinutes: int(b.Before / time.Minute),
+		AfterMinutes:  int(b.After / time.Minute),
+		Fee:           b.Fee.Float64(),
+	}
+	if !b.UpdatedAt.IsZero() {
+		res.UpdatedAt = &b.UpdatedAt
+	}
+
+	return res
+}
+
+func ToGeneratedBookingRules(own, effective *entity.BookingRules) models.BookingRulesResponse {
+	res := models.BookingRulesResponse{
+		Own:       toGeneratedBookingRuleValues(own),
+		Effective: toGeneratedBookingRuleValues(effective),
+	}
+	if !own.UpdatedAt.IsZero() {
+		res.UpdatedAt = &own.UpdatedAt
+	}
+
+	return res
+}
+
+func toGeneratedBookingRuleValues(r *entity.BookingRules) models.BookingRules {
+	return models.BookingRules{
+		MinNoticeMinutes: int(r.MinNotice / time.Minute),
+		HorizonDays:      int(r.Horizon / (24 * time.Hour)),
+		MinSlotMinutes:   int(r.MinSlotDuration / time.Minute),
+		MaxSlotMinutes:   int(r.MaxSlotDuration / time.Minute),
+	}
+}
+
+func ToGeneratedModelService(s *entity.ModelService) models.ModelServiceResponse {
+	return models.ModelServiceResponse{
+		Id:          s.ID,
+		ModelId:     s.ModelID,
+		Title:       s.Title,
+		Description: s.Description,
+		Price:       s.Price.Float64(),
+		Currency:    string(s.Price.Currency),
+		IsActive:    s.IsActive,
+		CreatedAt:   s.CreatedAt,
+	}
+}
+
+func ToGeneratedModelAvailability(a *entity.ModelAvailability,
+	location *time.Location) models.ModelAvailabilityResponse {
+	services := make([]models.ModelServiceResponse, len(a.Services))
+	for i, s := range a.Services {
+		services[i] = ToGeneratedModelService(s)
+	}
+
+	return models.ModelAvailabilityResponse{
+		ModelId:  a.Model.ID,
+		Name:     a.Model.Name,
+		Slots:    ToGeneratedSlots(a.Slots, location),
+		Services: services,
+	}
+}
+
+// inLocation moves the time to the location of the viewer, the instant stays the same.
+// The nil location leaves the time as it is.
+func inLocation(t time.Time, location *time.Location) time.Time {
+	if location == nil {
+		return t
+	}
+
+	return t.In(location)
+}
+
+func inLocationPtr(t *time.Time, location *time.Location) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	res := inLocation(*t, location)
+
+	return &res
+}
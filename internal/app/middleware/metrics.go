@@ -37,3 +37,8 @@ func (r *responseRecorder) WriteHeader(code int) {
 	r.StatusCode = code
 	r.ResponseWriter.WriteHeader(code)
 }
+
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to flush event streams.
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
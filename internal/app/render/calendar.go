@@ -0,0 +1,92 @@
+package render
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+const (
+	icsTimeLayout = "20060102T150405Z"
+	icsLineOctets = 75
+)
+
+var icsEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// Calendar writes the calendar as an iCalendar object (RFC 5545), times are written in UTC.
+func Calendar(w io.Writer, calendar *entity.Calendar) error {
+	buf := bufio.NewWriter(w)
+	stamp := time.Now()
+
+	writeICSLine(buf, "BEGIN:VCALENDAR")
+	writeICSLine(buf, "VERSION:2.0")
+	writeICSLine(buf, "PRODID:-//Samok-Aah-t//Schedule//EN")
+	writeICSLine(buf, "CALSCALE:GREGORIAN")
+	writeICSLine(buf, "METHOD:PUBLISH")
+	writeICSLine(buf, "X-WR-CALNAME:"+escapeICS(calendar.Name))
+
+	for _, e := range calendar.Events {
+		transparency := "OPAQUE"
+		if e.Free {
+			transparency = "TRANSPARENT"
+		}
+
+		writeICSLine(buf, "BEGIN:VEVENT")
+		writeICSLine(buf, "UID:"+e.UID)
+		writeICSLine(buf, "DTSTAMP:"+formatICSTime(stamp))
+		writeICSLine(buf, "DTSTART:"+formatICSTime(e.StartTime))
+		writeICSLine(buf, "DTEND:"+formatICSTime(e.EndTime))
+		writeICSLine(buf, "SUMMARY:"+escapeICS(e.Summary))
+		if e.Description != "" {
+			writeICSLine(buf, "DESCRIPTION:"+escapeICS(e.Description))
+		}
+		if e.Location != "" {
+			writeICSLine(buf, "LOCATION:"+escapeICS(e.Location))
+		}
+		writeICSLine(buf, "STATUS:"+string(e.Status))
+		writeICSLine(buf, "TRANSP:"+transparency)
+		writeICSLine(buf, "END:VEVENT")
+	}
+
+	writeICSLine(buf, "END:VCALENDAR")
+
+	return buf.Flush()
+}
+
+// writeICSLine ends the line with CRLF and folds it so that no line is longer than 75 octets,
+// a continuation line starts with a space. A multibyte character is never split.
+func writeICSLine(w *bufio.Writer, line string) {
+	limit := icsLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icsLineOctets - 1
+	}
+
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+func escapeICS(text string) string {
+	return icsEscaper.Replace(text)
+}
+
+func formatICSTime(t time.Time) string {
+	return t.UTC().Format(icsTimeLayout)
+}
@@ -0,0 +1,19 @@
+package render
+
+import (
+	_ "embed"
+	"html/template"
+	"io"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:embed templates/receipt.html
+var receiptHTML string
+
+var receiptTemplate = template.Must(template.New("receipt").Parse(receiptHTML))
+
+// Receipt writes the receipt as a standalone HTML page, it is laid out to be printed or saved as PDF.
+func Receipt(w io.Writer, receipt *entity.Receipt) error {
+	return receiptTemplate.Execute(w, receipt)
+}
@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type BookingExpirer interface {
+	ExpireBookings(ctx context.Context) (int, error)
+}
+
+// BookingExpiryWorker expires bookings the model did not approve or reject in time.
+type BookingExpiryWorker struct {
+	bookingService BookingExpirer
+	interval       time.Duration
+	logger         pkg.Logger
+}
+
+func NewBookingExpiryWorker(bookingService BookingExpirer,
+	interval time.Duration, logger pkg.Logger) *BookingExpiryWorker {
+	return &BookingExpiryWorker{
+		bookingService: bookingService,
+		interval:       interval,
+		logger:         logger,
+	}
+}
+
+func (w *BookingExpiryWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				w.logger.Info(ctx, "booking expiry worker stopped")
+				return
+
+			case <-ticker.C:
+				w.expire(ctx)
+			}
+		}
+	}()
+}
+
+func (w *BookingExpiryWorker) expire(ctx context.Context) {
+	expired, err := w.bookingService.ExpireBookings(ctx)
+	if err != nil {
+		w.logger.Error(ctx, "failed to expire bookings", option.Error(err))
+		return
+	}
+
+	if expired > 0 {
+		w.logger.Info(ctx, "bookings expired",
+			option.Any("count", expired))
+	}
+}
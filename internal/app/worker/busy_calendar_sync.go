@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type BusyCalendarSyncer interface {
+	SyncAll(ctx context.Context) (int, error)
+}
+
+// BusyCalendarSyncWorker refetches the outside calendars of the models and reapplies their events to the slots.
+type BusyCalendarSyncWorker struct {
+	calendarService BusyCalendarSyncer
+	interval        time.Duration
+	logger          pkg.Logger
+}
+
+func NewBusyCalendarSyncWorker(calendarService BusyCalendarSyncer,
+	interval time.Duration, logger pkg.Logger) *BusyCalendarSyncWorker {
+	return &BusyCalendarSyncWorker{
+		calendarService: calendarService,
+		interval:        interval,
+		logger:          logger,
+	}
+}
+
+func (w *BusyCalendarSyncWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				w.logger.Info(ctx, "busy calendar sync worker stopped")
+				return
+
+			case <-ticker.C:
+				w.sync(ctx)
+			}
+		}
+	}()
+}
+
+func (w *BusyCalendarSyncWorker) sync(ctx context.Context) {
+	synced, err := w.calendarService.SyncAll(ctx)
+	if err != nil {
+		w.logger.Error(ctx, "failed to sync busy calendars", option.Error(err))
+		return
+	}
+
+	if synced > 0 {
+		w.logger.Info(ctx, "busy calendars synced",
+			option.Any("count", synced))
+	}
+}
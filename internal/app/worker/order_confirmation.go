@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type OrderConfirmer interface {
+	AutoConfirmOrders(ctx context.Context) (int, error)
+}
+
+// OrderConfirmationWorker completes orders the client neither confirmed nor disputed in time.
+type OrderConfirmationWorker struct {
+	orderService OrderConfirmer
+	interval     time.Duration
+	logger       pkg.Logger
+}
+
+func NewOrderConfirmationWorker(orderService OrderConfirmer,
+	interval time.Duration, logger pkg.Logger) *OrderConfirmationWorker {
+	return &OrderConfirmationWorker{
+		orderService: orderService,
+		interval:     interval,
+		logger:       logger,
+	}
+}
+
+func (w *OrderConfirmationWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				w.logger.Info(ctx, "order confirmation worker stopped")
+				return
+
+			case <-ticker.C:
+				w.confirm(ctx)
+			}
+		}
+	}()
+}
+
+func (w *OrderConfirmationWorker) confirm(ctx context.Context) {
+	confirmed, err := w.orderService.AutoConfirmOrders(ctx)
+	if err != nil {
+		w.logger.Error(ctx, "failed to auto-confirm orders", option.Error(err))
+	}
+
+	if confirmed > 0 {
+		w.logger.Info(ctx, "orders auto-confirmed",
+			option.Any("count", confirmed))
+	}
+}
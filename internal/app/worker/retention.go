@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type Archiver interface {
+	Archive(ctx context.Context) (*entity.Archived, error)
+}
+
+// RetentionWorker moves the past slots and the finished bookings and orders out of the live tables.
+type RetentionWorker struct {
+	retentionService Archiver
+	interval         time.Duration
+	logger           pkg.Logger
+}
+
+func NewRetentionWorker(retentionService Archiver,
+	interval time.Duration, logger pkg.Logger) *RetentionWorker {
+	return &RetentionWorker{
+		retentionService: retentionService,
+		interval:         interval,
+		logger:           logger,
+	}
+}
+
+func (w *RetentionWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				w.logger.Info(ctx, "retention worker stopped")
+				return
+
+			case <-ticker.C:
+				w.archive(ctx)
+			}
+		}
+	}()
+}
+
+func (w *RetentionWorker) archive(ctx context.Context) {
+	archived, err := w.retentionService.Archive(ctx)
+	if err != nil {
+		w.logger.Error(ctx, "failed to archive stale data", option.Error(err))
+		return
+	}
+
+	if archived.Total() > 0 {
+		w.logger.Info(ctx, "stale data archived",
+			option.Any("orders", archived.Orders),
+			option.Any("bookings", archived.Bookings),
+			option.Any("slots", archived.Slots))
+	}
+}
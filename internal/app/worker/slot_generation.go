@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type SlotGenerator interface {
+	GenerateSlots(ctx context.Context) (int, error)
+}
+
+// SlotGenerationWorker keeps the slots of the published availability templates generated ahead.
+type SlotGenerationWorker struct {
+	templateService SlotGenerator
+	interval        time.Duration
+	logger          pkg.Logger
+}
+
+func NewSlotGenerationWorker(templateService SlotGenerator,
+	interval time.Duration, logger pkg.Logger) *SlotGenerationWorker {
+	return &SlotGenerationWorker{
+		templateService: templateService,
+		interval:        interval,
+		logger:          logger,
+	}
+}
+
+func (w *SlotGenerationWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				w.logger.Info(ctx, "slot generation worker stopped")
+				return
+
+			case <-ticker.C:
+				w.generate(ctx)
+			}
+		}
+	}()
+}
+
+func (w *SlotGenerationWorker) generate(ctx context.Context) {
+	generated, err := w.templateService.GenerateSlots(ctx)
+	if err != nil {
+		w.logger.Error(ctx, "failed to generate slots", option.Error(err))
+	}
+
+	if generated > 0 {
+		w.logger.Info(ctx, "slots generated",
+			option.Any("count", generated))
+	}
+}
@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+// AddOn is an optional extra of a model service with its own price and extra time.
+type AddOn struct {
+	ID             int64
+	ModelServiceID int64
+	Title          string
+	Description    string
+	Price          Money
+	ExtraMinutes   int
+	IsActive       bool
+	CreatedAt      time.Time
+}
+
+func NewAddOn(modelServiceID int64, title, description string, price Money, extraMinutes int) *AddOn {
+	return &AddOn{
+		ModelServiceID: modelServiceID,
+		Title:          title,
+		Description:    description,
+		Price:          price,
+		ExtraMinutes:   extraMinutes,
+		IsActive:       true,
+	}
+}
+
+// CloneFor copies the add-on to the service that replaced its own one.
+func (a *AddOn) CloneFor(modelServiceID int64) *AddOn {
+	return NewAddOn(modelServiceID, a.Title, a.Description, a.Price, a.ExtraMinutes)
+}
+
+// BookingAddOn is an add-on chosen for the booking. The title, price and extra time are copied,
+// because the add-on can be changed or moved to a new version of the service after the booking is made.
+type BookingAddOn struct {
+	AddOnID      int64  `json:"addOnID"`
+	Title        string `json:"title"`
+	Price        Money  `json:"price"`
+	ExtraMinutes int    `json:"extraMinutes"`
+}
+
+func NewBookingAddOn(addOn *AddOn) BookingAddOn {
+	return BookingAddOn{
+		AddOnID:      addOn.ID,
+		Title:        addOn.Title,
+		Price:        addOn.Price,
+		ExtraMinutes: addOn.ExtraMinutes,
+	}
+}
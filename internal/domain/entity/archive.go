@@ -0,0 +1,12 @@
+package entity
+
+// Archived is how many rows one retention run has moved from the live tables to the archive.
+type Archived struct {
+	Orders   int64
+	Bookings int64
+	Slots    int64
+}
+
+func (a *Archived) Total() int64 {
+	return a.Orders + a.Bookings + a.Slots
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+type AvailabilitySort string
+
+const (
+	AvailabilitySortPrice     AvailabilitySort = "price"
+	AvailabilitySortStartTime AvailabilitySort = "start_time"
+)
+
+func (s AvailabilitySort) IsValid() bool {
+	return s == AvailabilitySortPrice || s == AvailabilitySortStartTime
+}
+
+// AvailabilityFilter looks for the models that have available slots lying entirely inside [From, To]
+// and active services matching the query and the price.
+type AvailabilityFilter struct {
+	From         time.Time
+	To           time.Time
+	ServiceQuery string
+	MaxPrice     *Money
+	Sort         AvailabilitySort
+}
+
+// ModelAvailability is a model found by the filter with its available slots in the window and the services
+// matching the filter, the slots go by start time and the services by price.
+type ModelAvailability struct {
+	Model    *User
+	Slots    []*Slot
+	Services []*ModelService
+}
@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// AvailabilityTemplate is a weekly schedule the slots of a model are generated from, e.g. Friday and
+// Saturday 20:00-02:00 cut into one hour slots. The window belongs to the day it starts on and a window
+// ending before it starts runs past midnight. Slots are generated only while the template is published,
+// on the days between EffectiveFrom and EffectiveTo except the Exceptions.
+type AvailabilityTemplate struct {
+	ID            int64
+	ModelID       int64
+	Name          string
+	Weekdays      []int
+	StartMinute   int
+	EndMinute     int
+	SlotMinutes   int
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	Exceptions    []time.Time
+	IsPublished   bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewAvailabilityTemplate(modelID int64, name string, weekdays []int, startMinute, endMinute, slotMinutes int,
+	effectiveFrom time.Time, effectiveTo *time.Time, exceptions []time.Time) *AvailabilityTemplate {
+	return &AvailabilityTemplate{
+		ModelID:       modelID,
+		Name:          name,
+		Weekdays:      weekdays,
+		StartMinute:   startMinute,
+		EndMinute:     endMinute,
+		SlotMinutes:   slotMinutes,
+		EffectiveFrom: effectiveFrom,
+		EffectiveTo:   effectiveTo,
+		Exceptions:    exceptions,
+	}
+}
+
+// Slots cuts the windows into slots of SlotMinutes, a rest shorter than a slot is dropped.
+// Only the slots lying within [from, to] are returned, ordered by the start time.
+func (t AvailabilityTemplate) Slots(from, to time.Time, loc *time.Location) []*Slot {
+	from, to = from.In(loc), to.In(loc)
+	length := time.Duration(t.SlotMinutes) * time.Minute
+
+	var res []*Slot
+	day := time.Date(from.Year(), from.Month(), from.Day()-1, 0, 0, 0, 0, loc)
+	for day.Before(to) {
+		if t.matchesDay(day) {
+			start, end := t.window(day)
+			for ; !start.Add(length).After(end); start = start.Add(length) {
+				if start.Before(from) || start.Add(length).After(to) {
+					continue
+				}
+
+				slot := NewSlot(t.ModelID, start, start.Add(length))
+				slot.TemplateID = &t.ID
+				res = append(res, slot)
+			}
+		}
+
+		day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, loc)
+	}
+
+	return res
+}
+
+func (t AvailabilityTemplate) matchesDay(day time.Time) bool {
+	date := dateOf(day)
+	if date.Before(dateOf(t.EffectiveFrom)) || (t.EffectiveTo != nil && date.After(dateOf(*t.EffectiveTo))) {
+		return false
+	}
+
+	if slices.ContainsFunc(t.Exceptions, func(e time.Time) bool {
+		return dateOf(e).Equal(date)
+	}) {
+		return false
+	}
+
+	return slices.Contains(t.Weekdays, int(day.Weekday()))
+}
+
+// window is built from the wall clock, so the slots keep their local hours on daylight saving days.
+func (t AvailabilityTemplate) window(day time.Time) (time.Time, time.Time) {
+	endDay := day.Day()
+	if t.EndMinute <= t.StartMinute {
+		endDay++
+	}
+
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, t.StartMinute, 0, 0, day.Location())
+	to := time.Date(day.Year(), day.Month(), endDay, 0, t.EndMinute, 0, 0, day.Location())
+
+	return from, to
+}
+
+// dateOf drops the time and the zone, so calendar dates read from DATE columns compare with local days.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// SlotPreview is a slot the template would generate, a skipped one overlaps a slot the model already has.
+type SlotPreview struct {
+	StartTime time.Time
+	EndTime   time.Time
+	Skipped   bool
+}
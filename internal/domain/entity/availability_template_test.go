@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvailabilityTemplate_Slots_DaylightSaving(t *testing.T) {
+	// Berlin clocks go forward at 02:00 on 29 March 2026 and back at 03:00 on 25 October 2026.
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2026, month, day, hour, 0, 0, 0, berlin)
+	}
+	template := func(startHour, endHour int) AvailabilityTemplate {
+		return AvailabilityTemplate{
+			ID: 1, ModelID: 5, Weekdays: []int{0, 1, 2, 3, 4, 5, 6},
+			StartMinute: startHour * 60, EndMinute: endHour * 60, SlotMinutes: 60,
+			EffectiveFrom: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}
+	}
+	// 02:00 happens twice on the night the clocks go back
+	firstTwoOClock := at(time.October, 25, 1).Add(time.Hour)
+	secondTwoOClock := firstTwoOClock.Add(time.Hour)
+
+	tests := []struct {
+		name           string
+		template       AvailabilityTemplate
+		from           time.Time
+		to             time.Time
+		expectedStarts []time.Time
+	}{
+		{
+			name:     "day slots keep their local hours when clocks go back",
+			template: template(9, 11),
+			from:     at(time.October, 24, 0),
+			to:       at(time.October, 27, 0),
+			expectedStarts: []time.Time{
+				at(time.October, 24, 9), at(time.October, 24, 10),
+				at(time.October, 25, 9), at(time.October, 25, 10),
+				at(time.October, 26, 9), at(time.October, 26, 10),
+			},
+		},
+		{
+			name:     "day slots keep their local hours when clocks go forward",
+			template: template(9, 11),
+			from:     at(time.March, 28, 0),
+			to:       at(time.March, 30, 0),
+			expectedStarts: []time.Time{
+				at(time.March, 28, 9), at(time.March, 28, 10),
+				at(time.March, 29, 9), at(time.March, 29, 10),
+			},
+		},
+		{
+			name:     "repeated hour gives one more slot",
+			template: template(1, 4),
+			from:     at(time.October, 25, 0),
+			to:       at(time.October, 26, 0),
+			expectedStarts: []time.Time{
+				at(time.October, 25, 1), firstTwoOClock, secondTwoOClock, at(time.October, 25, 3),
+			},
+		},
+		{
+			name:     "skipped hour gives one slot less",
+			template: template(1, 4),
+			from:     at(time.March, 29, 0),
+			to:       at(time.March, 30, 0),
+			expectedStarts: []time.Time{
+				at(time.March, 29, 1), at(time.March, 29, 3),
+			},
+		},
+		{
+			name:     "night window crossing the change ends at its local hour",
+			template: template(22, 6),
+			from:     at(time.October, 24, 12),
+			to:       at(time.October, 25, 12),
+			expectedStarts: []time.Time{
+				at(time.October, 24, 22), at(time.October, 24, 23), at(time.October, 25, 0),
+				at(time.October, 25, 1), firstTwoOClock, secondTwoOClock,
+				at(time.October, 25, 3), at(time.October, 25, 4), at(time.October, 25, 5),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slots := tt.template.Slots(tt.from, tt.to, berlin)
+
+			assert.Len(t, slots, len(tt.expectedStarts))
+			for i, slot := range slots {
+				if i >= len(tt.expectedStarts) {
+					break
+				}
+
+				assert.True(t, tt.expectedStarts[i].Equal(slot.StartTime), "slot %d starts at %s", i, slot.StartTime)
+				assert.Equal(t, time.Hour, slot.EndTime.Sub(slot.StartTime))
+			}
+		})
+	}
+}
@@ -19,19 +19,36 @@ type Booking struct {
 	SlotID         int64
 	Address        Address
 	Status         BookingStatus
-	ExpiresAt      time.Time
-	CreatedAt      time.Time
+	BasePrice      Money
+	// DurationScaling is what the length of the slot adds to the base price or takes off it.
+	DurationScaling Money
+	Surcharges      []PriceComponent
+	AddOns          []BookingAddOn
+	TravelFee       Money
+	Price           Money
+	Discount        Money
+	PromoCodeID     *int64
+	ExpiresAt       time.Time
+	CreatedAt       time.Time
+	// ArchivedAt is set only for a booking read from the archive.
+	ArchivedAt *time.Time
 }
 
-func NewBooking(clientID, modelServiceID, slotID int64, address Address, ttl time.Duration) *Booking {
+// NewBooking snapshots the service price, later price changes do not affect the booking.
+func NewBooking(clientID, modelServiceID, slotID int64, address Address, price Money, ttl time.Duration) *Booking {
 	return &Booking{
-		ClientID:       clientID,
-		ModelServiceID: modelServiceID,
-		SlotID:         slotID,
-		Address:        address,
-		Status:         BookingPending,
-		ExpiresAt:      time.Now().Add(ttl),
-		CreatedAt:      time.Now(),
+		ClientID:        clientID,
+		ModelServiceID:  modelServiceID,
+		SlotID:          slotID,
+		Address:         address,
+		Status:          BookingPending,
+		BasePrice:       price,
+		DurationScaling: NewMoney(0, price.Currency),
+		TravelFee:       NewMoney(0, price.Currency),
+		Price:           price,
+		Discount:        NewMoney(0, price.Currency),
+		ExpiresAt:       time.Now().Add(ttl),
+		CreatedAt:       time.Now(),
 	}
 }
 
@@ -55,6 +72,11 @@ func NewAddress(street string, house int, apartment, entrance, floor int, commen
 	}
 }
 
+// Masked leaves only the street, it is shown where other people may see it, e.g. in a shared calendar.
+func (a Address) Masked() string {
+	return a.Street
+}
+
 func (b Booking) IsExpired(now time.Time) bool {
 	return b.Status == BookingPending && now.After(b.ExpiresAt)
 }
@@ -70,3 +92,82 @@ func (b Booking) CanBeRejected() bool {
 func (b Booking) CanBeCancelledByClient() bool {
 	return b.Status == BookingPending
 }
+
+// ScaleToDuration scales the base price, which is the price of the priced duration, to the length of the slot.
+// A zero priced duration keeps the price the same for a slot of any length.
+func (b *Booking) ScaleToDuration(slotDuration, pricedDuration time.Duration) error {
+	if slotDuration <= 0 || pricedDuration <= 0 {
+		return nil
+	}
+
+	scaling, err := b.BasePrice.MulRate(slotDuration.Minutes() / pricedDuration.Minutes()).Sub(b.BasePrice)
+	if err != nil {
+		return err
+	}
+
+	b.DurationScaling = scaling
+
+	return b.addToPrice(scaling)
+}
+
+// ApplySurcharges adds the time-based surcharges to the price, they are applied before the promo discount.
+func (b *Booking) ApplySurcharges(surcharges []PriceComponent) error {
+	b.Surcharges = surcharges
+	for _, s := range surcharges {
+		if err := b.addToPrice(s.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApplyAddOns adds the chosen extras to the price, they are applied before the promo discount.
+func (b *Booking) ApplyAddOns(addOns []*AddOn) error {
+	b.AddOns = make([]BookingAddOn, len(addOns))
+	for i, a := range addOns {
+		b.AddOns[i] = NewBookingAddOn(a)
+		if err := b.addToPrice(a.Price); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApplyTravelFee adds the fee the model charges for getting to the client, it is applied before the promo discount.
+func (b *Booking) ApplyTravelFee(fee Money) error {
+	b.TravelFee = fee
+
+	return b.addToPrice(fee)
+}
+
+// ApplyPromoCode takes the promo discount off the snapshotted price.
+func (b *Booking) ApplyPromoCode(promo *PromoCode) error {
+	discount, err := promo.Discount(b.Price)
+	if err != nil {
+		return err
+	}
+
+	price, err := b.Price.Sub(discount)
+	if err != nil {
+		return err
+	}
+
+	b.Discount = discount
+	b.Price = price
+	b.PromoCodeID = &promo.ID
+
+	return nil
+}
+
+func (b *Booking) addToPrice(amount Money) error {
+	price, err := b.Price.Add(amount)
+	if err != nil {
+		return err
+	}
+
+	b.Price = price
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package entity
+
+import "time"
+
+// BookingRules bound how soon before its start a slot may be booked, how far ahead slots may be
+// published and booked and how long a slot may last. The platform sets all of them, a model may set
+// its own to tighten them, a zero rule of the model leaves the one of the platform as it is.
+type BookingRules struct {
+	ModelID         int64
+	MinNotice       time.Duration
+	Horizon         time.Duration
+	MinSlotDuration time.Duration
+	MaxSlotDuration time.Duration
+	UpdatedAt       time.Time
+}
+
+func NewBookingRules(modelID int64, minNotice, horizon, minSlotDuration,
+	maxSlotDuration time.Duration) *BookingRules {
+	return &BookingRules{
+		ModelID:         modelID,
+		MinNotice:       minNotice,
+		Horizon:         horizon,
+		MinSlotDuration: minSlotDuration,
+		MaxSlotDuration: maxSlotDuration,
+		UpdatedAt:       time.Now(),
+	}
+}
+
+// Tighten combines the rules of the platform with the ones of the model, the stricter of each pair holds.
+func (r BookingRules) Tighten(model *BookingRules) *BookingRules {
+	return &BookingRules{
+		ModelID:         model.ModelID,
+		MinNotice:       max(r.MinNotice, model.MinNotice),
+		Horizon:         tighterLimit(r.Horizon, model.Horizon),
+		MinSlotDuration: max(r.MinSlotDuration, model.MinSlotDuration),
+		MaxSlotDuration: tighterLimit(r.MaxSlotDuration, model.MaxSlotDuration),
+		UpdatedAt:       model.UpdatedAt,
+	}
+}
+
+// IsConsistent tells whether a slot can be booked at all under the rules: the notice leaves some time
+// within the horizon and a slot can be both long and short enough.
+func (r BookingRules) IsConsistent() bool {
+	return r.MinNotice < r.Horizon && r.MinSlotDuration <= r.MaxSlotDuration
+}
+
+// GivesNotice reports whether a slot starting at the moment can still be booked now.
+func (r BookingRules) GivesNotice(start, now time.Time) bool {
+	return !start.Before(now.Add(r.MinNotice))
+}
+
+// WithinHorizon reports whether a slot starting at the moment is not too far ahead of now.
+func (r BookingRules) WithinHorizon(start, now time.Time) bool {
+	return !start.After(now.Add(r.Horizon))
+}
+
+func (r BookingRules) FitsDuration(start, end time.Time) bool {
+	duration := end.Sub(start)
+
+	return duration >= r.MinSlotDuration && duration <= r.MaxSlotDuration
+}
+
+// tighterLimit gives the smaller of the upper limits, a zero one is not set.
+func tighterLimit(platform, model time.Duration) time.Duration {
+	if model == 0 {
+		return platform
+	}
+
+	return min(platform, model)
+}
@@ -0,0 +1,106 @@
+package entity
+
+import "time"
+
+// BusyCalendar is an outside calendar of the model whose events block the slots at the same time.
+// Its events are uploaded as an .ics file or fetched from URL, a calendar without URL is only
+// changed by a new upload.
+type BusyCalendar struct {
+	ID        int64
+	ModelID   int64
+	Name      string
+	URL       *string
+	Conflicts []BusyConflict
+	SyncedAt  *time.Time
+	CreatedAt time.Time
+}
+
+func NewBusyCalendar(modelID int64, name string, url *string) *BusyCalendar {
+	return &BusyCalendar{
+		ModelID:   modelID,
+		Name:      name,
+		URL:       url,
+		Conflicts: []BusyConflict{},
+		CreatedAt: time.Now(),
+	}
+}
+
+// BusyEvent is a busy period read from an outside calendar, UID is the one given by the calendar.
+type BusyEvent struct {
+	CalendarID int64
+	UID        string
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+// BusyConflict is a reserved or booked slot overlapping a busy event, it is left as it is
+// and shown to the model, who has to settle it.
+type BusyConflict struct {
+	SlotID     int64      `json:"slotID"`
+	SlotStatus SlotStatus `json:"slotStatus"`
+	SlotStart  time.Time  `json:"slotStart"`
+	SlotEnd    time.Time  `json:"slotEnd"`
+	EventUID   string     `json:"eventUID"`
+	EventStart time.Time  `json:"eventStart"`
+	EventEnd   time.Time  `json:"eventEnd"`
+}
+
+// BusyPlan is what the busy events change in the slots of the model.
+type BusyPlan struct {
+	Block     []*Slot
+	Release   []*Slot
+	Conflicts map[int64][]BusyConflict
+}
+
+// BusyCalendarSync is the result of applying the busy events to the slots.
+type BusyCalendarSync struct {
+	Calendar  *BusyCalendar
+	Blocked   []*Slot
+	Released  []*Slot
+	Conflicts []BusyConflict
+}
+
+func (e BusyEvent) Overlaps(slot *Slot) bool {
+	return e.StartTime.Before(slot.EndTime) && slot.StartTime.Before(e.EndTime)
+}
+
+// PlanBusyBlocks compares the slots with the busy events of all the calendars of the model.
+// An available slot under an event is blocked and a slot blocked by import with no event over it
+// is released, both only if the slot allows the transition. Reserved and booked slots under
+// an event are conflicts of the calendar the event comes from.
+func PlanBusyBlocks(slots []*Slot, events []*BusyEvent) *BusyPlan {
+	plan := &BusyPlan{
+		Conflicts: make(map[int64][]BusyConflict),
+	}
+
+	for _, slot := range slots {
+		busy := false
+		for _, e := range events {
+			if !e.Overlaps(slot) {
+				continue
+			}
+
+			busy = true
+			if slot.Status == SlotReserved || slot.Status == SlotBooked {
+				plan.Conflicts[e.CalendarID] = append(plan.Conflicts[e.CalendarID], BusyConflict{
+					SlotID:     slot.ID,
+					SlotStatus: slot.Status,
+					SlotStart:  slot.StartTime,
+					SlotEnd:    slot.EndTime,
+					EventUID:   e.UID,
+					EventStart: e.StartTime,
+					EventEnd:   e.EndTime,
+				})
+			}
+		}
+
+		switch {
+		case busy && slot.IsAvailable() && slot.IsCorrectTransition(SlotDisabled):
+			plan.Block = append(plan.Block, slot)
+		case !busy && slot.ImportBlocked && slot.IsCorrectTransition(SlotAvailable):
+			plan.Release = append(plan.Release, slot)
+		}
+	}
+
+	return plan
+}
@@ -0,0 +1,137 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// CalendarFeed is the secret a calendar app subscribes with, anyone who knows the token
+// can read the schedule of the user, so the token is replaced when it leaks.
+// Role tells which schedule is exported: slots and orders of a model or orders of a client.
+type CalendarFeed struct {
+	ID        int64
+	UserID    int64
+	Role      Role
+	Token     string
+	CreatedAt time.Time
+}
+
+func NewCalendarFeed(userID int64, role Role, token string) *CalendarFeed {
+	return &CalendarFeed{
+		UserID:    userID,
+		Role:      role,
+		Token:     token,
+		CreatedAt: time.Now(),
+	}
+}
+
+// CalendarOrder is an order as it is put into a calendar, the end includes the extensions.
+type CalendarOrder struct {
+	OrderID          int64
+	SlotID           int64
+	Status           OrderStatus
+	ServiceTitle     string
+	ModelName        string
+	Address          Address
+	StartTime        time.Time
+	EndTime          time.Time
+	ExtensionMinutes int
+}
+
+func (o CalendarOrder) End() time.Time {
+	return o.EndTime.Add(time.Duration(o.ExtensionMinutes) * time.Minute)
+}
+
+type CalendarEventStatus string
+
+const (
+	CalendarEventTentative CalendarEventStatus = "TENTATIVE"
+	CalendarEventConfirmed CalendarEventStatus = "CONFIRMED"
+)
+
+// CalendarEvent is one VEVENT of the feed. Free events do not block the time in the calendar
+// app, so an available slot does not hide the free time of the model.
+type CalendarEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Status      CalendarEventStatus
+	Free        bool
+	StartTime   time.Time
+	EndTime     time.Time
+}
+
+type Calendar struct {
+	Name   string
+	Events []CalendarEvent
+}
+
+const calendarUIDDomain = "samok-aah-t"
+
+var slotEventSummaries = map[SlotStatus]string{
+	SlotAvailable: "Available slot",
+	SlotReserved:  "Reserved slot",
+	SlotBooked:    "Booked slot",
+	SlotDisabled:  "Disabled slot",
+}
+
+// NewModelCalendar puts the slots and the orders of the model into one calendar.
+// A slot taken by an order is shown as the order only.
+func NewModelCalendar(model *User, slots []*Slot, orders []*CalendarOrder) *Calendar {
+	ordered := make(map[int64]bool, len(orders))
+	events := make([]CalendarEvent, 0, len(slots)+len(orders))
+	for _, o := range orders {
+		ordered[o.SlotID] = true
+		events = append(events, o.event(o.ServiceTitle))
+	}
+
+	for _, s := range slots {
+		if ordered[s.ID] {
+			continue
+		}
+
+		status := CalendarEventConfirmed
+		if s.Status == SlotReserved {
+			status = CalendarEventTentative
+		}
+
+		events = append(events, CalendarEvent{
+			UID:       fmt.Sprintf("slot-%d@%s", s.ID, calendarUIDDomain),
+			Summary:   slotEventSummaries[s.Status],
+			Status:    status,
+			Free:      s.Status == SlotAvailable || s.Status == SlotDisabled,
+			StartTime: s.StartTime,
+			EndTime:   s.EndTime,
+		})
+	}
+
+	return &Calendar{
+		Name:   model.Name,
+		Events: events,
+	}
+}
+
+func NewClientCalendar(client *User, orders []*CalendarOrder) *Calendar {
+	events := make([]CalendarEvent, 0, len(orders))
+	for _, o := range orders {
+		events = append(events, o.event(fmt.Sprintf("%s with %s", o.ServiceTitle, o.ModelName)))
+	}
+
+	return &Calendar{
+		Name:   client.Name,
+		Events: events,
+	}
+}
+
+func (o CalendarOrder) event(summary string) CalendarEvent {
+	return CalendarEvent{
+		UID:         fmt.Sprintf("order-%d@%s", o.OrderID, calendarUIDDomain),
+		Summary:     summary,
+		Description: fmt.Sprintf("Order #%d, %s", o.OrderID, o.Status),
+		Location:    o.Address.Masked(),
+		Status:      CalendarEventConfirmed,
+		StartTime:   o.StartTime,
+		EndTime:     o.End(),
+	}
+}
@@ -0,0 +1,120 @@
+package entity
+
+import "time"
+
+type DisputeStatus string
+
+const (
+	DisputeOpen     DisputeStatus = "OPEN"
+	DisputeInReview DisputeStatus = "IN_REVIEW"
+	DisputeResolved DisputeStatus = "RESOLVED"
+)
+
+type DisputeCategory string
+
+const (
+	DisputeNoShow      DisputeCategory = "NO_SHOW"
+	DisputeLateArrival DisputeCategory = "LATE_ARRIVAL"
+	DisputeQuality     DisputeCategory = "QUALITY"
+	DisputeBehaviour   DisputeCategory = "BEHAVIOUR"
+	DisputePayment     DisputeCategory = "PAYMENT"
+	DisputeOther       DisputeCategory = "OTHER"
+)
+
+type DisputeResolution string
+
+const (
+	ResolutionFullRefund     DisputeResolution = "FULL_REFUND"
+	ResolutionPartialRefund  DisputeResolution = "PARTIAL_REFUND"
+	ResolutionNoAction       DisputeResolution = "NO_ACTION"
+	ResolutionPenalizeClient DisputeResolution = "PENALIZE_CLIENT"
+	ResolutionPenalizeModel  DisputeResolution = "PENALIZE_MODEL"
+)
+
+type Dispute struct {
+	ID                int64
+	OrderID           int64
+	ClientID          int64
+	ModelID           int64
+	OpenedBy          Role
+	Category          DisputeCategory
+	Description       string
+	Status            DisputeStatus
+	AssignedAdminID   *int64
+	Resolution        *DisputeResolution
+	RefundAmount      *Money
+	PenalizedUserID   *int64
+	ResolutionComment *string
+	ResolvedAt        *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func NewDispute(orderID, clientID, modelID int64, openedBy Role,
+	category DisputeCategory, description string) *Dispute {
+	return &Dispute{
+		OrderID:     orderID,
+		ClientID:    clientID,
+		ModelID:     modelID,
+		OpenedBy:    openedBy,
+		Category:    category,
+		Description: description,
+		Status:      DisputeOpen,
+	}
+}
+
+func (d Dispute) IsResolved() bool {
+	return d.Status == DisputeResolved
+}
+
+func (d Dispute) IsParticipant(userID int64) bool {
+	return d.ClientID == userID || d.ModelID == userID
+}
+
+func (d *Dispute) Assign(adminID int64) {
+	d.AssignedAdminID = &adminID
+	d.Status = DisputeInReview
+}
+
+// Resolve closes the dispute, refundAmount is the part of the service price returned to the client.
+func (d *Dispute) Resolve(now time.Time, resolution DisputeResolution, refundAmount *Money, comment *string) {
+	d.Status = DisputeResolved
+	d.Resolution = &resolution
+	d.RefundAmount = refundAmount
+	d.ResolutionComment = comment
+	d.ResolvedAt = &now
+
+	switch resolution {
+	case ResolutionPenalizeClient:
+		d.PenalizedUserID = &d.ClientID
+	case ResolutionPenalizeModel:
+		d.PenalizedUserID = &d.ModelID
+	}
+}
+
+// OrderStatusAfter returns the final order status the resolution leads to.
+func (r DisputeResolution) OrderStatusAfter() OrderStatus {
+	if r == ResolutionFullRefund {
+		return OrderCancelled
+	}
+
+	return OrderCompleted
+}
+
+type DisputeMessage struct {
+	ID           int64
+	DisputeID    int64
+	AuthorAuthID int64
+	AuthorRole   Role
+	Body         string
+	CreatedAt    time.Time
+}
+
+func NewDisputeMessage(disputeID, authorAuthID int64, authorRole Role, body string) *DisputeMessage {
+	return &DisputeMessage{
+		DisputeID:    disputeID,
+		AuthorAuthID: authorAuthID,
+		AuthorRole:   authorRole,
+		Body:         body,
+	}
+}
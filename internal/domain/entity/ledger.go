@@ -0,0 +1,145 @@
+package entity
+
+import "time"
+
+type LedgerAccountType string
+
+const (
+	LedgerModelEarnings   LedgerAccountType = "MODEL_EARNINGS"
+	LedgerPlatformRevenue LedgerAccountType = "PLATFORM_REVENUE"
+	LedgerClientPayable   LedgerAccountType = "CLIENT_PAYABLE"
+)
+
+// LedgerAccount belongs to a user for model earnings and client payables,
+// the platform revenue account has no owner.
+type LedgerAccount struct {
+	ID        int64
+	Type      LedgerAccountType
+	OwnerID   *int64
+	CreatedAt time.Time
+}
+
+type LedgerTransactionType string
+
+const (
+	LedgerOrderPayment LedgerTransactionType = "ORDER_PAYMENT"
+	LedgerRefund       LedgerTransactionType = "REFUND"
+	LedgerPenalty      LedgerTransactionType = "PENALTY"
+)
+
+// LedgerTransaction groups the entries of one money movement, their amounts always sum to zero.
+type LedgerTransaction struct {
+	ID        int64
+	OrderID   int64
+	Type      LedgerTransactionType
+	Entries   []*LedgerEntry
+	CreatedAt time.Time
+}
+
+// LedgerEntry amount is positive for a credit and negative for a debit.
+// OrderID and TransactionType are filled in when the entry is read back.
+type LedgerEntry struct {
+	ID              int64
+	TransactionID   int64
+	AccountID       int64
+	Amount          Money
+	OrderID         int64
+	TransactionType LedgerTransactionType
+	CreatedAt       time.Time
+}
+
+func NewLedgerTransaction(orderID int64, transactionType LedgerTransactionType) *LedgerTransaction {
+	return &LedgerTransaction{
+		OrderID: orderID,
+		Type:    transactionType,
+	}
+}
+
+// AddEntry posts a signed amount to the account, zero amounts are skipped,
+// e.g. the commission part of a payment when the rate is zero.
+func (t *LedgerTransaction) AddEntry(accountID int64, amount Money) {
+	if amount.IsZero() {
+		return
+	}
+
+	t.Entries = append(t.Entries, &LedgerEntry{
+		AccountID: accountID,
+		Amount:    amount,
+	})
+}
+
+func (t LedgerTransaction) IsBalanced() (bool, error) {
+	if len(t.Entries) < 2 {
+		return false, nil
+	}
+
+	var (
+		sum Money
+		err error
+	)
+	for _, e := range t.Entries {
+		if sum, err = sum.Add(e.Amount); err != nil {
+			return false, err
+		}
+	}
+
+	return sum.IsZero(), nil
+}
+
+// SplitCommission divides the amount into the platform commission and the model share,
+// the share is calculated as the rest so the parts always add up to the amount.
+func SplitCommission(amount Money, rate float64) (commission, share Money, err error) {
+	commission = amount.MulRate(rate)
+	if share, err = amount.Sub(commission); err != nil {
+		return Money{}, Money{}, err
+	}
+
+	return commission, share, nil
+}
+
+// LedgerStatement is the balance of an account with a page of its entries, newest first.
+type LedgerStatement struct {
+	Balance Money
+	Entries []*LedgerEntry
+}
+
+type TrialBalanceLine struct {
+	AccountType LedgerAccountType
+	Debit       Money
+	Credit      Money
+	// Balance is the credit less the debit, it is set by NewTrialBalance.
+	Balance Money
+}
+
+type TrialBalance struct {
+	Lines       []*TrialBalanceLine
+	TotalDebit  Money
+	TotalCredit Money
+	Balanced    bool
+}
+
+// NewTrialBalance sums the lines up, the ledger is balanced when the total debit equals the total credit.
+func NewTrialBalance(lines []*TrialBalanceLine) (*TrialBalance, error) {
+	res := &TrialBalance{
+		Lines: lines,
+	}
+
+	var err error
+	for _, l := range lines {
+		if l.Balance, err = l.Credit.Sub(l.Debit); err != nil {
+			return nil, err
+		}
+		if res.TotalDebit, err = res.TotalDebit.Add(l.Debit); err != nil {
+			return nil, err
+		}
+		if res.TotalCredit, err = res.TotalCredit.Add(l.Credit); err != nil {
+			return nil, err
+		}
+	}
+
+	if res.Balanced, err = res.TotalDebit.Equal(res.TotalCredit); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
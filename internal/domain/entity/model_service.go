@@ -7,12 +7,12 @@ type ModelService struct {
 	ModelID     int64
 	Title       string
 	Description string
-	Price       float32
+	Price       Money
 	IsActive    bool
 	CreatedAt   time.Time
 }
 
-func NewModelService(modelID int64, title, description string, price float32) *ModelService {
+func NewModelService(modelID int64, title, description string, price Money) *ModelService {
 	return &ModelService{
 		ModelID:     modelID,
 		Title:       title,
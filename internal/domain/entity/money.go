@@ -0,0 +1,253 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type Currency string
+
+const (
+	CurrencyRUB Currency = "RUB"
+
+	// DefaultCurrency is the currency all the prices of the platform are kept in.
+	DefaultCurrency = CurrencyRUB
+)
+
+const minorUnitsPerMajor = 100
+
+// MaxAmount is the largest amount in minor units the DECIMAL(9,2) columns hold, i.e. 9999999.99.
+const MaxAmount int64 = 999_999_999
+
+var (
+	ErrInvalidMoney     = errors.New("invalid money amount")
+	ErrCurrencyMismatch = errors.New("money amounts are in different currencies")
+)
+
+// Money is an amount in minor units (kopecks for RUB), so the sums never pick up float rounding errors.
+// Arithmetic and comparisons expect both operands in the same currency and return ErrCurrencyMismatch
+// otherwise, an amount without a currency, like the zero Money, goes with any of them.
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{
+		Amount:   amount,
+		Currency: currency,
+	}
+}
+
+// MoneyFromFloat rounds the amount to the nearest minor unit, it is meant for the API boundary only.
+func MoneyFromFloat(amount float64) Money {
+	return NewMoney(int64(math.Round(amount*minorUnitsPerMajor)), DefaultCurrency)
+}
+
+// ParseMoney reads a decimal amount like "1500", "99.9" or "-10.05" in the default currency.
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+
+	if amount, ok := parseMinorUnits(s); ok {
+		return NewMoney(amount, DefaultCurrency), nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+
+	return MoneyFromFloat(f), nil
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	currency, err := m.currencyWith(other)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(m.Amount+other.Amount, currency), nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	currency, err := m.currencyWith(other)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(m.Amount-other.Amount, currency), nil
+}
+
+func (m Money) Neg() Money {
+	return NewMoney(-m.Amount, m.Currency)
+}
+
+// MulRate multiplies the amount by the rate and rounds the result to the nearest minor unit.
+func (m Money) MulRate(rate float64) Money {
+	return NewMoney(int64(math.Round(float64(m.Amount)*rate)), m.Currency)
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+func (m Money) LessThan(other Money) (bool, error) {
+	if _, err := m.currencyWith(other); err != nil {
+		return false, err
+	}
+
+	return m.Amount < other.Amount, nil
+}
+
+func (m Money) Equal(other Money) (bool, error) {
+	if _, err := m.currencyWith(other); err != nil {
+		return false, err
+	}
+
+	return m.Amount == other.Amount, nil
+}
+
+func (m Money) Float64() float64 {
+	return float64(m.Amount) / minorUnitsPerMajor
+}
+
+// Decimal formats the amount with exactly two fraction digits, e.g. "1500.00".
+func (m Money) Decimal() string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, amount/minorUnitsPerMajor, amount%minorUnitsPerMajor)
+}
+
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Decimal()
+	}
+
+	return m.Decimal() + " " + string(m.Currency)
+}
+
+// MarshalJSON keeps money a plain JSON number, as the prices have always been sent.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.Decimal()), nil
+}
+
+// UnmarshalJSON accepts both a JSON number and a quoted decimal string.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	res, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// Scan reads a NUMERIC column, pgx hands it over as the decimal text, so no precision is lost.
+func (m *Money) Scan(src any) error {
+	var (
+		res Money
+		err error
+	)
+
+	switch v := src.(type) {
+	case string:
+		res, err = ParseMoney(v)
+	case []byte:
+		res, err = ParseMoney(string(v))
+	case int64:
+		res = NewMoney(v*minorUnitsPerMajor, DefaultCurrency)
+	case float64:
+		res = MoneyFromFloat(v)
+	case nil:
+		return fmt.Errorf("%w: NULL", ErrInvalidMoney)
+	default:
+		return fmt.Errorf("%w: unsupported type %T", ErrInvalidMoney, src)
+	}
+	if err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// Value writes the amount as decimal text, so it is stored in a NUMERIC column as is.
+func (m Money) Value() (driver.Value, error) {
+	return m.Decimal(), nil
+}
+
+// currencyWith gives the currency of the result of an operation on both amounts,
+// the amounts are never converted, so different currencies cannot be mixed.
+func (m Money) currencyWith(other Money) (Currency, error) {
+	switch {
+	case m.Currency == "":
+		return other.Currency, nil
+	case other.Currency == "" || other.Currency == m.Currency:
+		return m.Currency, nil
+	default:
+		return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+}
+
+func parseMinorUnits(s string) (int64, bool) {
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" || len(fraction) > 2 || !isDigits(whole) || !isDigits(fraction) {
+		return 0, false
+	}
+
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || major > math.MaxInt64/minorUnitsPerMajor {
+		return 0, false
+	}
+
+	fraction += strings.Repeat("0", 2-len(fraction))
+	minor, _ := strconv.ParseInt(fraction, 10, 64)
+
+	amount := major*minorUnitsPerMajor + minor
+	if negative {
+		amount = -amount
+	}
+
+	return amount, true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
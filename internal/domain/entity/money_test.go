@@ -0,0 +1,202 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expected      Money
+		expectedError error
+	}{
+		{name: "whole amount", input: "1500", expected: NewMoney(150000, CurrencyRUB)},
+		{name: "one fraction digit", input: "99.9", expected: NewMoney(9990, CurrencyRUB)},
+		{name: "negative amount", input: "-10.05", expected: NewMoney(-1005, CurrencyRUB)},
+		{name: "explicit plus sign", input: "+3.5", expected: NewMoney(350, CurrencyRUB)},
+		{name: "surrounding spaces", input: " 7 ", expected: NewMoney(700, CurrencyRUB)},
+		{name: "exponent", input: "1e3", expected: NewMoney(100000, CurrencyRUB)},
+		{name: "third fraction digit is rounded half away from zero", input: "0.125",
+			expected: NewMoney(13, CurrencyRUB)},
+		{name: "not a number", input: "abc", expectedError: ErrInvalidMoney},
+		{name: "empty", input: "", expectedError: ErrInvalidMoney},
+		{name: "NaN", input: "NaN", expectedError: ErrInvalidMoney},
+		{name: "infinity", input: "Inf", expectedError: ErrInvalidMoney},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := ParseMoney(tt.input)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Equal(t, Money{}, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func TestMoney_UnmarshalJSON(t *testing.T) {
+	initial := NewMoney(42, CurrencyRUB)
+
+	tests := []struct {
+		name          string
+		input         string
+		expected      Money
+		expectedError error
+	}{
+		{name: "number", input: `1500.5`, expected: NewMoney(150050, CurrencyRUB)},
+		{name: "quoted decimal", input: `"99.90"`, expected: NewMoney(9990, CurrencyRUB)},
+		{name: "null leaves the amount as it is", input: `null`, expected: initial},
+		{name: "quoted text", input: `"abc"`, expected: initial, expectedError: ErrInvalidMoney},
+		{name: "boolean", input: `true`, expected: initial, expectedError: ErrInvalidMoney},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := initial
+			err := res.UnmarshalJSON([]byte(tt.input))
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func TestMoney_Scan(t *testing.T) {
+	tests := []struct {
+		name          string
+		src           any
+		expected      Money
+		expectedError error
+	}{
+		{name: "numeric text", src: "1500.00", expected: NewMoney(150000, CurrencyRUB)},
+		{name: "numeric bytes", src: []byte("0.01"), expected: NewMoney(1, CurrencyRUB)},
+		{name: "integer", src: int64(15), expected: NewMoney(1500, CurrencyRUB)},
+		{name: "float", src: 2.5, expected: NewMoney(250, CurrencyRUB)},
+		{name: "NULL", src: nil, expectedError: ErrInvalidMoney},
+		{name: "unsupported type", src: true, expectedError: ErrInvalidMoney},
+		{name: "invalid text", src: "abc", expectedError: ErrInvalidMoney},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var res Money
+			err := res.Scan(tt.src)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Equal(t, Money{}, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func TestMoney_Decimal(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    Money
+		expected string
+	}{
+		{name: "zero", money: Money{}, expected: "0.00"},
+		{name: "whole amount", money: NewMoney(150000, CurrencyRUB), expected: "1500.00"},
+		{name: "kopecks only", money: NewMoney(7, CurrencyRUB), expected: "0.07"},
+		{name: "negative amount", money: NewMoney(-1005, CurrencyRUB), expected: "-10.05"},
+		{name: "negative kopecks", money: NewMoney(-5, CurrencyRUB), expected: "-0.05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.money.Decimal())
+
+			value, err := tt.money.Value()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+
+			var scanned Money
+			assert.NoError(t, scanned.Scan(value))
+			assert.Equal(t, tt.money.Amount, scanned.Amount)
+		})
+	}
+}
+
+func TestMoney_MulRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    Money
+		rate     float64
+		expected Money
+	}{
+		{name: "exact result", money: NewMoney(15000, CurrencyRUB), rate: 0.15, expected: NewMoney(2250, CurrencyRUB)},
+		{name: "half is rounded up", money: NewMoney(999, CurrencyRUB), rate: 0.5, expected: NewMoney(500, CurrencyRUB)},
+		{name: "negative half is rounded away from zero", money: NewMoney(-999, CurrencyRUB), rate: 0.5,
+			expected: NewMoney(-500, CurrencyRUB)},
+		{name: "less than half is rounded down", money: NewMoney(1001, CurrencyRUB), rate: 0.1,
+			expected: NewMoney(100, CurrencyRUB)},
+		{name: "zero rate", money: NewMoney(1001, CurrencyRUB), rate: 0, expected: NewMoney(0, CurrencyRUB)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.money.MulRate(tt.rate))
+		})
+	}
+}
+
+func TestMoney_Currency(t *testing.T) {
+	rub := NewMoney(100, CurrencyRUB)
+	usd := NewMoney(100, Currency("USD"))
+
+	tests := []struct {
+		name string
+		op   func(a, b Money) error
+	}{
+		{name: "add", op: func(a, b Money) error { _, err := a.Add(b); return err }},
+		{name: "sub", op: func(a, b Money) error { _, err := a.Sub(b); return err }},
+		{name: "less than", op: func(a, b Money) error { _, err := a.LessThan(b); return err }},
+		{name: "equal", op: func(a, b Money) error { _, err := a.Equal(b); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, tt.op(rub, Money{}))
+			assert.NoError(t, tt.op(Money{}, rub))
+			assert.NoError(t, tt.op(rub, rub))
+
+			err := tt.op(rub, usd)
+			assert.ErrorIs(t, err, ErrCurrencyMismatch)
+			assert.EqualError(t, err, "money amounts are in different currencies: RUB and USD")
+		})
+	}
+
+	sum, err := Money{}.Add(rub)
+	assert.NoError(t, err)
+	assert.Equal(t, CurrencyRUB, sum.Currency)
+
+	diff, err := rub.Sub(Money{})
+	assert.NoError(t, err)
+	assert.Equal(t, CurrencyRUB, diff.Currency)
+
+	equal, err := rub.Equal(NewMoney(100, ""))
+	assert.NoError(t, err)
+	assert.True(t, equal)
+
+	less, err := Money{}.LessThan(rub)
+	assert.NoError(t, err)
+	assert.True(t, less)
+}
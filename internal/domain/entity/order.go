@@ -9,13 +9,24 @@ const (
 	OrderInTransit OrderStatus = "INTRANSIT"
 	OrderCompleted OrderStatus = "COMPLETED"
 	OrderCancelled OrderStatus = "CANCELLED"
+
+	OrderPendingConfirmation OrderStatus = "PENDING_CONFIRMATION"
+	OrderDisputed            OrderStatus = "DISPUTED"
 )
 
 type Order struct {
-	ID        int64
-	BookingID int64
-	Status    OrderStatus
-	CreatedAt time.Time
+	ID                   int64
+	BookingID            int64
+	Status               OrderStatus
+	CompletedAt          *time.Time
+	ConfirmationDeadline *time.Time
+	ConfirmedAt          *time.Time
+	IssueReason          *string
+	ExtensionMinutes     int
+	ExtensionAmount      Money
+	CreatedAt            time.Time
+	// ArchivedAt is set only for an order read from the archive.
+	ArchivedAt *time.Time
 }
 
 func NewOrder(bookingID int64) *Order {
@@ -32,3 +43,82 @@ func (o Order) CanBeCancelled(now time.Time, slotStart time.Time) bool {
 func (o Order) CanBeCompleted(now time.Time, slotEnd time.Time) bool {
 	return o.Status == OrderInTransit && now.After(slotEnd)
 }
+
+// MarkCompletedByModel starts the confirmation window, the order is completed
+// only after the client confirms it or the window passes.
+func (o *Order) MarkCompletedByModel(now time.Time, confirmationTTL time.Duration) {
+	deadline := now.Add(confirmationTTL)
+
+	o.Status = OrderPendingConfirmation
+	o.CompletedAt = &now
+	o.ConfirmationDeadline = &deadline
+}
+
+func (o *Order) Confirm(now time.Time) {
+	o.Status = OrderCompleted
+	o.ConfirmedAt = &now
+}
+
+func (o *Order) RaiseIssue(reason string) {
+	o.IssueReason = &reason
+}
+
+func (o Order) CanBeConfirmed() bool {
+	return o.Status == OrderPendingConfirmation
+}
+
+func (o Order) CanRaiseIssue(now time.Time) bool {
+	return o.Status == OrderPendingConfirmation &&
+		o.ConfirmationDeadline != nil && now.Before(*o.ConfirmationDeadline)
+}
+
+// CanBeDisputed allows a dispute from the slot start until the window after the slot end passes,
+// while the order is not completed yet and its payment is not captured.
+func (o Order) CanBeDisputed(now, slotStart, slotEnd time.Time, window time.Duration) bool {
+	switch o.Status {
+	case OrderConfirmed, OrderInTransit, OrderPendingConfirmation:
+	default:
+		return false
+	}
+
+	return !now.Before(slotStart) && now.Before(slotEnd.Add(window))
+}
+
+func (o *Order) OpenDispute() {
+	o.Status = OrderDisputed
+}
+
+// CloseDispute moves the disputed order to its final status chosen by the resolution.
+func (o *Order) CloseDispute(now time.Time, status OrderStatus) {
+	o.Status = status
+	if status == OrderCompleted {
+		o.ConfirmedAt = &now
+	}
+}
+
+// IsCorrectAdminTransition reports whether an admin may move the order to the status,
+// a disputed order is closed only by resolving its dispute.
+func (o Order) IsCorrectAdminTransition(next OrderStatus) bool {
+	switch o.Status {
+	case OrderConfirmed:
+		return next == OrderInTransit || next == OrderCompleted || next == OrderCancelled
+	case OrderInTransit:
+		return next == OrderConfirmed || next == OrderCompleted || next == OrderCancelled
+	case OrderPendingConfirmation:
+		return next == OrderCompleted
+	default:
+		return false
+	}
+}
+
+func (o Order) CanBeExtended() bool {
+	return o.Status == OrderConfirmed || o.Status == OrderInTransit
+}
+
+func (o Order) IsFinished() bool {
+	return o.Status == OrderCompleted || o.Status == OrderCancelled
+}
+
+func (o Order) CanBeTracked() bool {
+	return o.Status == OrderConfirmed || o.Status == OrderInTransit
+}
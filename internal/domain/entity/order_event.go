@@ -0,0 +1,42 @@
+package entity
+
+import "time"
+
+type OrderEventType string
+
+const (
+	OrderEventStatus   OrderEventType = "status"
+	OrderEventLocation OrderEventType = "location"
+)
+
+type OrderEvent struct {
+	ID        int64
+	OrderID   int64
+	Type      OrderEventType
+	Status    OrderStatus
+	Latitude  *float64
+	Longitude *float64
+	ETA       *time.Time
+	CreatedAt time.Time
+}
+
+func NewOrderStatusEvent(order *Order) *OrderEvent {
+	return &OrderEvent{
+		OrderID:   order.ID,
+		Type:      OrderEventStatus,
+		Status:    order.Status,
+		CreatedAt: time.Now(),
+	}
+}
+
+func NewOrderLocationEvent(order *Order, latitude, longitude float64, eta *time.Time) *OrderEvent {
+	return &OrderEvent{
+		OrderID:   order.ID,
+		Type:      OrderEventLocation,
+		Status:    order.Status,
+		Latitude:  &latitude,
+		Longitude: &longitude,
+		ETA:       eta,
+		CreatedAt: time.Now(),
+	}
+}
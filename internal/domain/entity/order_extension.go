@@ -0,0 +1,57 @@
+package entity
+
+import "time"
+
+type OrderExtensionStatus string
+
+const (
+	OrderExtensionPending  OrderExtensionStatus = "PENDING"
+	OrderExtensionAccepted OrderExtensionStatus = "ACCEPTED"
+	OrderExtensionRejected OrderExtensionStatus = "REJECTED"
+)
+
+type OrderExtension struct {
+	ID        int64
+	OrderID   int64
+	Minutes   int
+	Status    OrderExtensionStatus
+	Amount    *Money
+	DecidedAt *time.Time
+	CreatedAt time.Time
+}
+
+func NewOrderExtension(orderID int64, minutes int) *OrderExtension {
+	return &OrderExtension{
+		OrderID: orderID,
+		Minutes: minutes,
+		Status:  OrderExtensionPending,
+	}
+}
+
+func (e OrderExtension) IsPending() bool {
+	return e.Status == OrderExtensionPending
+}
+
+func (e OrderExtension) Duration() time.Duration {
+	return time.Duration(e.Minutes) * time.Minute
+}
+
+func (e *OrderExtension) Accept(now time.Time, amount Money) {
+	e.Status = OrderExtensionAccepted
+	e.Amount = &amount
+	e.DecidedAt = &now
+}
+
+func (e *OrderExtension) Reject(now time.Time) {
+	e.Status = OrderExtensionRejected
+	e.DecidedAt = &now
+}
+
+// ExtensionPrice bills the extra minutes pro-rata to the price of the originally booked duration.
+func ExtensionPrice(price Money, bookedDuration time.Duration, minutes int) Money {
+	if bookedDuration <= 0 {
+		return NewMoney(0, price.Currency)
+	}
+
+	return price.MulRate(float64(minutes) / bookedDuration.Minutes())
+}
@@ -0,0 +1,177 @@
+package entity
+
+import "time"
+
+type PaymentStatus string
+
+const (
+	PaymentAuthorized        PaymentStatus = "AUTHORIZED"
+	PaymentCaptured          PaymentStatus = "CAPTURED"
+	PaymentPartiallyRefunded PaymentStatus = "PARTIALLY_REFUNDED"
+	PaymentRefunded          PaymentStatus = "REFUNDED"
+	PaymentVoided            PaymentStatus = "VOIDED"
+	PaymentFailed            PaymentStatus = "FAILED"
+)
+
+type Payment struct {
+	ID                int64
+	OrderID           int64
+	Provider          string
+	ProviderPaymentID string
+	Amount            Money
+	CapturedAmount    Money
+	RefundedAmount    Money
+	Status            PaymentStatus
+	FailureReason     *string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func NewPayment(orderID int64, provider, providerPaymentID string, amount Money) *Payment {
+	return &Payment{
+		OrderID:           orderID,
+		Provider:          provider,
+		ProviderPaymentID: providerPaymentID,
+		Amount:            amount,
+		Status:            PaymentAuthorized,
+	}
+}
+
+func (p Payment) IsAuthorized() bool {
+	return p.Status == PaymentAuthorized
+}
+
+func (p Payment) IsCaptured() bool {
+	return p.Status == PaymentCaptured || p.Status == PaymentPartiallyRefunded || p.Status == PaymentRefunded
+}
+
+// RefundableAmount is the captured money which has not been returned to the client yet.
+func (p Payment) RefundableAmount() (Money, error) {
+	if p.Status != PaymentCaptured && p.Status != PaymentPartiallyRefunded {
+		return NewMoney(0, p.Amount.Currency), nil
+	}
+
+	return p.CapturedAmount.Sub(p.RefundedAmount)
+}
+
+// AuthorizeExtra adds the amount held on top of the authorized one, it is captured together with it.
+func (p *Payment) AuthorizeExtra(amount Money) error {
+	total, err := p.Amount.Add(amount)
+	if err != nil {
+		return err
+	}
+
+	p.Amount = total
+
+	return nil
+}
+
+func (p *Payment) Capture(amount Money) {
+	p.Status = PaymentCaptured
+	p.CapturedAmount = amount
+}
+
+func (p *Payment) Void() {
+	p.Status = PaymentVoided
+}
+
+func (p *Payment) Refund(amount Money) error {
+	refunded, err := p.RefundedAmount.Add(amount)
+	if err != nil {
+		return err
+	}
+
+	partially, err := refunded.LessThan(p.CapturedAmount)
+	if err != nil {
+		return err
+	}
+
+	p.RefundedAmount = refunded
+	if !partially {
+		p.Status = PaymentRefunded
+		return nil
+	}
+
+	p.Status = PaymentPartiallyRefunded
+
+	return nil
+}
+
+func (p *Payment) Fail(reason *string) {
+	p.Status = PaymentFailed
+	p.FailureReason = reason
+}
+
+// Apply moves the payment by the provider callback. Events which do not fit the current state
+// are ignored, so a late or repeated callback never rolls the payment back.
+func (p *Payment) Apply(event *PaymentEvent) (bool, error) {
+	switch event.Type {
+	case PaymentEventCaptured:
+		if !p.IsAuthorized() {
+			return false, nil
+		}
+
+		amount := p.Amount
+		if event.Amount != nil {
+			amount = *event.Amount
+		}
+		p.Capture(amount)
+	case PaymentEventRefunded:
+		refundable, err := p.RefundableAmount()
+		if err != nil {
+			return false, err
+		}
+		if !refundable.IsPositive() {
+			return false, nil
+		}
+
+		amount := refundable
+		if event.Amount != nil {
+			partial, err := event.Amount.LessThan(refundable)
+			if err != nil {
+				return false, err
+			}
+			if partial {
+				amount = *event.Amount
+			}
+		}
+		if err = p.Refund(amount); err != nil {
+			return false, err
+		}
+	case PaymentEventVoided:
+		if !p.IsAuthorized() {
+			return false, nil
+		}
+		p.Void()
+	case PaymentEventFailed:
+		if !p.IsAuthorized() {
+			return false, nil
+		}
+		p.Fail(event.Reason)
+	default:
+		return false, nil
+	}
+
+	return true, nil
+}
+
+type PaymentEventType string
+
+const (
+	PaymentEventCaptured PaymentEventType = "CAPTURED"
+	PaymentEventRefunded PaymentEventType = "REFUNDED"
+	PaymentEventVoided   PaymentEventType = "VOIDED"
+	PaymentEventFailed   PaymentEventType = "FAILED"
+)
+
+// PaymentEvent is a callback from the payment provider, EventID is unique per provider.
+type PaymentEvent struct {
+	ID                int64
+	Provider          string
+	EventID           string
+	ProviderPaymentID string
+	Type              PaymentEventType
+	Amount            *Money
+	Reason            *string
+	CreatedAt         time.Time
+}
@@ -0,0 +1,153 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type PricingRuleType string
+
+const (
+	PricingRuleMultiplier PricingRuleType = "MULTIPLIER"
+	PricingRuleSurcharge  PricingRuleType = "SURCHARGE"
+)
+
+const MinutesInDay = 24 * 60
+
+var ErrInvalidTimeOfDay = errors.New("time of day should be given as HH:MM")
+
+// ParseTimeOfDay converts HH:MM into minutes since midnight, 24:00 is accepted as the end of the day.
+func ParseTimeOfDay(value string) (int, error) {
+	var hours, minutes int
+	if _, err := fmt.Sscanf(value, "%d:%d", &hours, &minutes); err != nil || len(value) != len("00:00") {
+		return 0, ErrInvalidTimeOfDay
+	}
+
+	res := hours*60 + minutes
+	if hours < 0 || minutes < 0 || minutes > 59 || res > MinutesInDay {
+		return 0, ErrInvalidTimeOfDay
+	}
+
+	return res, nil
+}
+
+func FormatTimeOfDay(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// PricingRule raises the service price of a model for bookings at a part of the week or on holidays.
+// Empty Weekdays mean any day, nil StartMinute and EndMinute mean the whole day and a window
+// ending before it starts runs past midnight. A rule with Holidays applies on these dates only.
+type PricingRule struct {
+	ID          int64
+	ModelID     int64
+	Name        string
+	Type        PricingRuleType
+	Multiplier  *float64
+	Amount      *Money
+	Weekdays    []int
+	StartMinute *int
+	EndMinute   *int
+	Holidays    []time.Time
+	IsActive    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewPricingRule(modelID int64, name string, ruleType PricingRuleType, multiplier *float64, amount *Money,
+	weekdays []int, startMinute, endMinute *int, holidays []time.Time) *PricingRule {
+	return &PricingRule{
+		ModelID:     modelID,
+		Name:        name,
+		Type:        ruleType,
+		Multiplier:  multiplier,
+		Amount:      amount,
+		Weekdays:    weekdays,
+		StartMinute: startMinute,
+		EndMinute:   endMinute,
+		Holidays:    holidays,
+		IsActive:    true,
+	}
+}
+
+// AppliesTo reports whether the rule window overlaps the slot. The window belongs to the day
+// it starts on, so a Friday 22:00-06:00 rule covers the early hours of Saturday as well.
+func (r PricingRule) AppliesTo(start, end time.Time, loc *time.Location) bool {
+	start, end = start.In(loc), end.In(loc)
+
+	day := time.Date(start.Year(), start.Month(), start.Day()-1, 0, 0, 0, 0, loc)
+	for day.Before(end) {
+		if r.matchesDay(day) {
+			from, to := r.window(day)
+			if from.Before(end) && start.Before(to) {
+				return true
+			}
+		}
+
+		day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, loc)
+	}
+
+	return false
+}
+
+// Charge is the amount the rule adds to the base price, multipliers are applied to the base price only,
+// so the order of the rules does not matter.
+func (r PricingRule) Charge(base Money) Money {
+	switch r.Type {
+	case PricingRuleMultiplier:
+		if r.Multiplier != nil {
+			return base.MulRate(*r.Multiplier - 1)
+		}
+	case PricingRuleSurcharge:
+		if r.Amount != nil {
+			return NewMoney(r.Amount.Amount, base.Currency)
+		}
+	}
+
+	return NewMoney(0, base.Currency)
+}
+
+func (r PricingRule) matchesDay(day time.Time) bool {
+	if len(r.Holidays) > 0 {
+		return slices.ContainsFunc(r.Holidays, func(h time.Time) bool {
+			return h.Year() == day.Year() && h.Month() == day.Month() && h.Day() == day.Day()
+		})
+	}
+
+	return len(r.Weekdays) == 0 || slices.Contains(r.Weekdays, int(day.Weekday()))
+}
+
+// window is built from the wall clock, so the rule keeps its local hours on daylight saving days.
+func (r PricingRule) window(day time.Time) (time.Time, time.Time) {
+	if r.StartMinute == nil || r.EndMinute == nil {
+		return day, time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, day.Location())
+	}
+
+	endDay := day.Day()
+	if *r.EndMinute <= *r.StartMinute {
+		endDay++
+	}
+
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, *r.StartMinute, 0, 0, day.Location())
+	to := time.Date(day.Year(), day.Month(), endDay, 0, *r.EndMinute, 0, 0, day.Location())
+
+	return from, to
+}
+
+// PriceComponent is one surcharge of the booking price. The rule name and amount are copied,
+// because the rule can be changed or deleted after the booking is made.
+type PriceComponent struct {
+	PricingRuleID int64  `json:"pricingRuleID"`
+	Name          string `json:"name"`
+	Amount        Money  `json:"amount"`
+}
+
+func NewPriceComponent(rule *PricingRule, base Money) PriceComponent {
+	return PriceComponent{
+		PricingRuleID: rule.ID,
+		Name:          rule.Name,
+		Amount:        rule.Charge(base),
+	}
+}
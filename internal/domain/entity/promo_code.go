@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+type DiscountType string
+
+const (
+	DiscountPercent DiscountType = "PERCENT"
+	DiscountFixed   DiscountType = "FIXED"
+)
+
+// PromoCode gives a discount on a booking. Empty ServiceIDs and ModelIDs mean the code
+// applies to any service, nil limits mean the code can be used any number of times.
+type PromoCode struct {
+	ID               int64
+	Code             string
+	DiscountType     DiscountType
+	PercentOff       *int
+	AmountOff        *Money
+	ValidFrom        *time.Time
+	ValidUntil       *time.Time
+	MaxUses          *int
+	MaxUsesPerClient *int
+	UsedCount        int
+	ServiceIDs       []int64
+	ModelIDs         []int64
+	IsActive         bool
+	CreatedAt        time.Time
+}
+
+func NewPromoCode(code string, discountType DiscountType, percentOff *int, amountOff *Money,
+	validFrom, validUntil *time.Time, maxUses, maxUsesPerClient *int, serviceIDs, modelIDs []int64) *PromoCode {
+	return &PromoCode{
+		Code:             NormalizePromoCode(code),
+		DiscountType:     discountType,
+		PercentOff:       percentOff,
+		AmountOff:        amountOff,
+		ValidFrom:        validFrom,
+		ValidUntil:       validUntil,
+		MaxUses:          maxUses,
+		MaxUsesPerClient: maxUsesPerClient,
+		ServiceIDs:       serviceIDs,
+		ModelIDs:         modelIDs,
+		IsActive:         true,
+	}
+}
+
+// NormalizePromoCode makes codes case-insensitive, they are stored upper-cased.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (p PromoCode) IsValidAt(now time.Time) bool {
+	if !p.IsActive {
+		return false
+	}
+
+	if p.ValidFrom != nil && now.Before(*p.ValidFrom) {
+		return false
+	}
+
+	return p.ValidUntil == nil || now.Before(*p.ValidUntil)
+}
+
+func (p PromoCode) IsApplicableTo(service *ModelService) bool {
+	if len(p.ServiceIDs) > 0 && !slices.Contains(p.ServiceIDs, service.ID) {
+		return false
+	}
+
+	return len(p.ModelIDs) == 0 || slices.Contains(p.ModelIDs, service.ModelID)
+}
+
+func (p PromoCode) IsExhausted() bool {
+	return p.MaxUses != nil && p.UsedCount >= *p.MaxUses
+}
+
+// Discount is the amount taken off the price, it never exceeds the price itself.
+func (p PromoCode) Discount(price Money) (Money, error) {
+	var discount Money
+	switch p.DiscountType {
+	case DiscountPercent:
+		if p.PercentOff != nil {
+			discount = price.MulRate(float64(*p.PercentOff) / 100)
+		}
+	case DiscountFixed:
+		if p.AmountOff != nil {
+			discount = *p.AmountOff
+		}
+	}
+
+	exceeds, err := price.LessThan(discount)
+	if err != nil {
+		return Money{}, err
+	}
+	if exceeds {
+		return price, nil
+	}
+
+	return NewMoney(discount.Amount, price.Currency), nil
+}
+
+// PromoRedemption is one use of a promo code, it is released when the booking does not go through.
+type PromoRedemption struct {
+	ID          int64
+	PromoCodeID int64
+	ClientID    int64
+	BookingID   int64
+	ReleasedAt  *time.Time
+	CreatedAt   time.Time
+}
+
+func NewPromoRedemption(promoCodeID, clientID, bookingID int64) *PromoRedemption {
+	return &PromoRedemption{
+		PromoCodeID: promoCodeID,
+		ClientID:    clientID,
+		BookingID:   bookingID,
+	}
+}
@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// Quote is the itemized price of a booking that is not made yet. It is handed to the client
+// in a signed token, so the booking made with the token gets exactly the quoted price.
+type Quote struct {
+	ClientID        int64            `json:"clientID"`
+	ModelServiceID  int64            `json:"modelServiceID"`
+	SlotID          int64            `json:"slotID"`
+	Address         Address          `json:"address"`
+	PromoCode       string           `json:"promoCode,omitempty"`
+	AddOnIDs        []int64          `json:"addOnIDs,omitempty"`
+	BasePrice       Money            `json:"basePrice"`
+	DurationScaling Money            `json:"durationScaling"`
+	Surcharges      []PriceComponent `json:"surcharges"`
+	AddOns          []BookingAddOn   `json:"addOns"`
+	TravelFee       Money            `json:"travelFee"`
+	Discount        Money            `json:"discount"`
+	Price           Money            `json:"price"`
+	ExpiresAt       time.Time        `json:"expiresAt"`
+}
+
+// NewQuote copies the price breakdown of a booking that is priced but not saved.
+func NewQuote(booking *Booking, promoCode string, addOnIDs []int64) *Quote {
+	return &Quote{
+		ClientID:        booking.ClientID,
+		ModelServiceID:  booking.ModelServiceID,
+		SlotID:          booking.SlotID,
+		Address:         booking.Address,
+		PromoCode:       promoCode,
+		AddOnIDs:        addOnIDs,
+		BasePrice:       booking.BasePrice,
+		DurationScaling: booking.DurationScaling,
+		Surcharges:      booking.Surcharges,
+		AddOns:          booking.AddOns,
+		TravelFee:       booking.TravelFee,
+		Discount:        booking.Discount,
+		Price:           booking.Price,
+	}
+}
+
+// Matches tells whether the booking request is the one the quote was made for.
+func (q Quote) Matches(clientID, modelServiceID, slotID int64, address Address, promoCode string,
+	addOnIDs []int64) bool {
+
+	return q.ClientID == clientID &&
+		q.ModelServiceID == modelServiceID &&
+		q.SlotID == slotID &&
+		q.Address == address &&
+		q.PromoCode == promoCode &&
+		slices.Equal(q.AddOnIDs, addOnIDs)
+}
+
+// ApplyTo replaces the current price breakdown of the booking with the quoted one.
+func (q Quote) ApplyTo(booking *Booking) {
+	booking.BasePrice = q.BasePrice
+	booking.DurationScaling = q.DurationScaling
+	booking.Surcharges = q.Surcharges
+	booking.AddOns = q.AddOns
+	booking.TravelFee = q.TravelFee
+	booking.Discount = q.Discount
+	booking.Price = q.Price
+}
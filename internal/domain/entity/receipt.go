@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Receipt is issued once for a paid order and never changes, so everything shown on it is copied.
+type Receipt struct {
+	ID            int64
+	Number        int64
+	OrderID       int64
+	ClientID      int64
+	ModelID       int64
+	ModelName     string
+	ServiceTitle  string
+	Lines         []ReceiptLine
+	Total         Money
+	PaymentMethod string
+	IssuedAt      time.Time
+}
+
+type ReceiptLine struct {
+	Title  string `json:"title"`
+	Amount Money  `json:"amount"`
+}
+
+// NewReceipt itemizes the booking price, the extensions and the refunds, the total is the money the client has paid.
+// The number is given by the repository when the receipt is saved.
+func NewReceipt(order *Order, booking *Booking, service *ModelService, model *User,
+	payment *Payment) (*Receipt, error) {
+
+	lines := []ReceiptLine{
+		{Title: service.Title, Amount: booking.BasePrice},
+	}
+	if !booking.DurationScaling.IsZero() {
+		lines = append(lines, ReceiptLine{Title: "Slot duration", Amount: booking.DurationScaling})
+	}
+	for _, s := range booking.Surcharges {
+		lines = append(lines, ReceiptLine{Title: s.Name, Amount: s.Amount})
+	}
+	for _, a := range booking.AddOns {
+		lines = append(lines, ReceiptLine{Title: a.Title, Amount: a.Price})
+	}
+	if booking.TravelFee.IsPositive() {
+		lines = append(lines, ReceiptLine{Title: "Travel fee", Amount: booking.TravelFee})
+	}
+	if booking.Discount.IsPositive() {
+		lines = append(lines, ReceiptLine{Title: "Promo discount", Amount: booking.Discount.Neg()})
+	}
+	if order.ExtensionAmount.IsPositive() {
+		lines = append(lines, ReceiptLine{
+			Title:  fmt.Sprintf("Extension, %d min", order.ExtensionMinutes),
+			Amount: order.ExtensionAmount,
+		})
+	}
+	if payment.RefundedAmount.IsPositive() {
+		lines = append(lines, ReceiptLine{Title: "Refund", Amount: payment.RefundedAmount.Neg()})
+	}
+
+	total, err := payment.CapturedAmount.Sub(payment.RefundedAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Receipt{
+		OrderID:       order.ID,
+		ClientID:      booking.ClientID,
+		ModelID:       model.ID,
+		ModelName:     model.Name,
+		ServiceTitle:  service.Title,
+		Lines:         lines,
+		Total:         total,
+		PaymentMethod: payment.Provider,
+		IssuedAt:      time.Now(),
+	}, nil
+}
+
+// CanHaveReceipt tells whether the client has paid for the order: it is completed
+// and the payment is captured and not returned in full.
+func CanHaveReceipt(order *Order, payment *Payment) bool {
+	return order.Status == OrderCompleted &&
+		(payment.Status == PaymentCaptured || payment.Status == PaymentPartiallyRefunded)
+}
+
+// Code is the receipt number as it is printed, e.g. "R-00000042".
+func (r Receipt) Code() string {
+	return fmt.Sprintf("R-%08d", r.Number)
+}
@@ -11,13 +11,43 @@ const (
 	SlotDisabled  SlotStatus = "DISABLED"
 )
 
+func (s SlotStatus) IsValid() bool {
+	switch s {
+	case SlotAvailable, SlotReserved, SlotBooked, SlotDisabled:
+		return true
+	default:
+		return false
+	}
+}
+
+// Slot is set by the model one at a time or generated from an availability template,
+// TemplateID is nil for the former. ImportBlocked marks a slot disabled because of a busy
+// event of an imported calendar, it is made available again when the event is gone. TimeOffID is the
+// time-off that disabled the slot, the slot is made available again when the time-off is deleted.
 type Slot struct {
-	ID        int64
-	ModelID   int64
-	StartTime time.Time
-	EndTime   time.Time
-	Status    SlotStatus
-	CreatedAt time.Time
+	ID            int64
+	ModelID       int64
+	TemplateID    *int64
+	StartTime     time.Time
+	EndTime       time.Time
+	Status        SlotStatus
+	ImportBlocked bool
+	TimeOffID     *int64
+	CreatedAt     time.Time
+}
+
+// SlotPeriod is the time a new slot is asked for.
+type SlotPeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+// SlotFilter narrows a slot listing to the slots starting within [From, To) with the status,
+// a nil field is not applied.
+type SlotFilter struct {
+	From   *time.Time
+	To     *time.Time
+	Status *SlotStatus
 }
 
 func NewSlot(modelID int64, start time.Time, end time.Time) *Slot {
@@ -47,3 +77,59 @@ func (s *Slot) IsCorrectTransition(next SlotStatus) bool {
 func (s *Slot) IsAvailable() bool {
 	return s.Status == SlotAvailable
 }
+
+// Within reports whether the slot lies entirely inside [from, to].
+func (s *Slot) Within(from, to time.Time) bool {
+	return !s.StartTime.Before(from) && !s.EndTime.After(to)
+}
+
+// Overlaps reports whether the slots share any time, touching slots do not overlap.
+func (s *Slot) Overlaps(other *Slot) bool {
+	return s.StartTime.Before(other.EndTime) && other.StartTime.Before(s.EndTime)
+}
+
+// ConsumeUntil gives the beginning of the available slot to an extended neighbour ending at end.
+// A fully covered slot is disabled instead of deleted, because old bookings still refer to it.
+func (s *Slot) ConsumeUntil(end time.Time) {
+	if !s.EndTime.After(end) {
+		s.Status = SlotDisabled
+		return
+	}
+
+	s.StartTime = end
+}
+
+// SplitPoints gives the points cutting the slot into pieces of the length, the last piece keeps the rest.
+func (s *Slot) SplitPoints(length time.Duration) []time.Time {
+	var res []time.Time
+	for cut := s.StartTime.Add(length); cut.Before(s.EndTime); cut = cut.Add(length) {
+		res = append(res, cut)
+	}
+
+	return res
+}
+
+// Split cuts the slot at the ascending points lying strictly inside it. The slot itself becomes the first
+// piece and keeps its id, the other pieces are new slots of the same template.
+func (s *Slot) Split(cuts []time.Time) []*Slot {
+	res := make([]*Slot, 0, len(cuts)+1)
+	res = append(res, s)
+
+	end := s.EndTime
+	for i, cut := range cuts {
+		pieceEnd := end
+		if i+1 < len(cuts) {
+			pieceEnd = cuts[i+1]
+		}
+
+		piece := NewSlot(s.ModelID, cut, pieceEnd)
+		piece.TemplateID = s.TemplateID
+		res = append(res, piece)
+	}
+
+	if len(cuts) > 0 {
+		s.EndTime = cuts[0]
+	}
+
+	return res
+}
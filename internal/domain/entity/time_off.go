@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+// TimeOff is a period the model is away, such as a vacation or a sick leave. The available slots under it
+// are disabled while it lasts and no slot can be added within it.
+type TimeOff struct {
+	ID        int64
+	ModelID   int64
+	StartTime time.Time
+	EndTime   time.Time
+	Reason    *string
+	CreatedAt time.Time
+}
+
+func NewTimeOff(modelID int64, start, end time.Time, reason *string) *TimeOff {
+	return &TimeOff{
+		ModelID:   modelID,
+		StartTime: start,
+		EndTime:   end,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}
+}
+
+// TimeOffChange is what creating or deleting the time-off did to the slots of the model. Conflicts are
+// the reserved and booked slots under the time-off, they are left as they are and shown to the model,
+// who has to settle them.
+type TimeOffChange struct {
+	TimeOff   *TimeOff
+	Disabled  []*Slot
+	Enabled   []*Slot
+	Conflicts []*Slot
+}
+
+func (t *TimeOff) Overlaps(slot *Slot) bool {
+	return t.StartTime.Before(slot.EndTime) && slot.StartTime.Before(t.EndTime)
+}
+
+// OverlapsAny tells whether the slot shares time with one of the time-offs.
+func OverlapsAny(slot *Slot, timeOffs []*TimeOff) bool {
+	for _, t := range timeOffs {
+		if t.Overlaps(slot) {
+			return true
+		}
+	}
+
+	return false
+}
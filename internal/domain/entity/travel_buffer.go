@@ -0,0 +1,47 @@
+package entity
+
+import "time"
+
+// TravelBuffer is the time the model keeps free before and after every slot to get to the client
+// and away, and the fee added to every booking for the way. The buffer and the fee are the same for
+// every slot, addresses have no coordinates to tell the distance between consecutive orders yet.
+type TravelBuffer struct {
+	ModelID   int64
+	Before    time.Duration
+	After     time.Duration
+	Fee       Money
+	UpdatedAt time.Time
+}
+
+func NewTravelBuffer(modelID int64, before, after time.Duration, fee Money) *TravelBuffer {
+	return &TravelBuffer{
+		ModelID:   modelID,
+		Before:    before,
+		After:     after,
+		Fee:       fee,
+		UpdatedAt: time.Now(),
+	}
+}
+
+// IsZero tells whether the model keeps no time free around the slots, the fee does not matter.
+func (b TravelBuffer) IsZero() bool {
+	return b.Before == 0 && b.After == 0
+}
+
+// Window widens [start, end) to the range in which a slot colliding with it through the buffer may lie.
+func (b TravelBuffer) Window(start, end time.Time) (time.Time, time.Time) {
+	reach := max(b.Before, b.After)
+
+	return start.Add(-reach), end.Add(reach)
+}
+
+// Collides reports whether one of the slots lies in the buffer of the other, so the gap between
+// consecutive slots must be at least the larger of the two buffers. Overlapping slots always collide.
+func (b TravelBuffer) Collides(slot, other *Slot) bool {
+	return b.occupies(slot, other) || b.occupies(other, slot)
+}
+
+// occupies tells whether the slot together with its buffer shares time with the other slot.
+func (b TravelBuffer) occupies(slot, other *Slot) bool {
+	return slot.StartTime.Add(-b.Before).Before(other.EndTime) && other.StartTime.Before(slot.EndTime.Add(b.After))
+}
@@ -8,6 +8,8 @@ type User struct {
 	Name       string
 	BirthDate  time.Time
 	IsVerified bool
+	// TimeZone is the IANA name of the zone the user lives in, nil stands for the platform zone.
+	TimeZone *string
 }
 
 func NewUser(authID int64, name string, birthDate time.Time) *User {
@@ -25,3 +27,28 @@ func (u User) IsAnAdult(now time.Time) bool {
 func (u User) IsUserVerified() bool {
 	return u.IsVerified
 }
+
+// Location is the zone the day boundaries of the user are drawn in, the platform one unless the user has chosen theirs.
+func (u User) Location(platform *time.Location) *time.Location {
+	if u.TimeZone == nil {
+		return platform
+	}
+
+	loc, err := time.LoadLocation(*u.TimeZone)
+	if err != nil {
+		return platform
+	}
+
+	return loc
+}
+
+// IsValidTimeZone accepts the IANA names only, the empty name and "Local" would depend on the server.
+func IsValidTimeZone(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+
+	_, err := time.LoadLocation(name)
+
+	return err == nil
+}
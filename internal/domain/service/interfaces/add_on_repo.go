@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=add_on_repo.go -destination=../mocks/add_on_repo_mock.go -package=mocks AddOnRepository
+type AddOnRepository interface {
+	Save(ctx context.Context, addOn *entity.AddOn) error
+	GetByID(ctx context.Context, id int64) (*entity.AddOn, error)
+	GetByServiceID(ctx context.Context, serviceID int64, includeInactive bool) ([]*entity.AddOn, error)
+	Update(ctx context.Context, addOn *entity.AddOn) (*entity.AddOn, error)
+	Deactivate(ctx context.Context, id int64) error
+}
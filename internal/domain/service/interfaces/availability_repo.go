@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=availability_repo.go -destination=../mocks/availability_repo_mock.go -package=mocks AvailabilityRepository
+type AvailabilityRepository interface {
+	Search(ctx context.Context, filter *entity.AvailabilityFilter, opts *entity.Options) ([]*entity.ModelAvailability, error)
+}
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=availability_template_repo.go -destination=../mocks/availability_template_repo_mock.go -package=mocks AvailabilityTemplateRepository
+type AvailabilityTemplateRepository interface {
+	Save(ctx context.Context, template *entity.AvailabilityTemplate) error
+	GetByID(ctx context.Context, id int64) (*entity.AvailabilityTemplate, error)
+	GetByModelID(ctx context.Context, modelID int64, opts *entity.Options) ([]*entity.AvailabilityTemplate, error)
+	GetPublished(ctx context.Context) ([]*entity.AvailabilityTemplate, error)
+	Update(ctx context.Context, template *entity.AvailabilityTemplate) (*entity.AvailabilityTemplate, error)
+}
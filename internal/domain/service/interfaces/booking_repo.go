@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
 )
@@ -12,4 +13,7 @@ type BookingRepository interface {
 	GetByID(ctx context.Context, id int64) (*entity.Booking, error)
 	Update(ctx context.Context, b *entity.Booking) (*entity.Booking, error)
 	GetAll(ctx context.Context, opts *entity.Options) ([]*entity.Booking, error)
+	ExpirePending(ctx context.Context, now time.Time) ([]*entity.Booking, error)
+	GetArchivedByID(ctx context.Context, id int64) (*entity.Booking, error)
+	GetAllArchived(ctx context.Context, opts *entity.Options) ([]*entity.Booking, error)
 }
@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=booking_rules_provider.go -destination=../mocks/booking_rules_provider_mock.go -package=mocks BookingRulesProvider
+type BookingRulesProvider interface {
+	RulesOf(ctx context.Context, modelID int64) (*entity.BookingRules, error)
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=booking_rules_repo.go -destination=../mocks/booking_rules_repo_mock.go -package=mocks BookingRulesRepository
+type BookingRulesRepository interface {
+	GetByModelID(ctx context.Context, modelID int64) (*entity.BookingRules, error)
+	Save(ctx context.Context, rules *entity.BookingRules) (*entity.BookingRules, error)
+}
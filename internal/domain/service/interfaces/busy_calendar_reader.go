@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=busy_calendar_reader.go -destination=../mocks/busy_calendar_reader_mock.go -package=mocks BusyCalendarReader
+type BusyCalendarReader interface {
+	Read(src io.Reader, loc *time.Location) ([]*entity.BusyEvent, error)
+	Fetch(ctx context.Context, url string, loc *time.Location) ([]*entity.BusyEvent, error)
+}
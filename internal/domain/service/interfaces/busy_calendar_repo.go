@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=busy_calendar_repo.go -destination=../mocks/busy_calendar_repo_mock.go -package=mocks BusyCalendarRepository
+type BusyCalendarRepository interface {
+	Save(ctx context.Context, calendar *entity.BusyCalendar) error
+	GetByID(ctx context.Context, id int64) (*entity.BusyCalendar, error)
+	GetByModelID(ctx context.Context, modelID int64) ([]*entity.BusyCalendar, error)
+	GetAll(ctx context.Context) ([]*entity.BusyCalendar, error)
+	UpdateSync(ctx context.Context, calendar *entity.BusyCalendar) (*entity.BusyCalendar, error)
+	Delete(ctx context.Context, id int64) error
+	ReplaceEvents(ctx context.Context, calendarID int64, events []*entity.BusyEvent) error
+	GetModelEvents(ctx context.Context, modelID int64, from time.Time) ([]*entity.BusyEvent, error)
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=calendar_feed_repo.go -destination=../mocks/calendar_feed_repo_mock.go -package=mocks CalendarFeedRepository
+type CalendarFeedRepository interface {
+	Save(ctx context.Context, feed *entity.CalendarFeed) error
+	GetByUserID(ctx context.Context, userID int64) (*entity.CalendarFeed, error)
+	GetByToken(ctx context.Context, token string) (*entity.CalendarFeed, error)
+	UpdateToken(ctx context.Context, feed *entity.CalendarFeed) (*entity.CalendarFeed, error)
+	GetModelOrders(ctx context.Context, modelID int64, from time.Time) ([]*entity.CalendarOrder, error)
+	GetClientOrders(ctx context.Context, clientID int64, from time.Time) ([]*entity.CalendarOrder, error)
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=dispute_opener.go -destination=../mocks/dispute_opener_mock.go -package=mocks DisputeOpener
+type DisputeOpener interface {
+	OpenDispute(ctx context.Context, orderID int64,
+		category entity.DisputeCategory, description string) (*entity.Dispute, error)
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=dispute_repo.go -destination=../mocks/dispute_repo_mock.go -package=mocks DisputeRepository
+type DisputeRepository interface {
+	Save(ctx context.Context, dispute *entity.Dispute) error
+	GetByID(ctx context.Context, id int64) (*entity.Dispute, error)
+	GetByOrderID(ctx context.Context, orderID int64) (*entity.Dispute, error)
+	GetAll(ctx context.Context, status *entity.DisputeStatus, opts *entity.Options) ([]*entity.Dispute, error)
+	Update(ctx context.Context, dispute *entity.Dispute) (*entity.Dispute, error)
+	SaveMessage(ctx context.Context, message *entity.DisputeMessage) error
+	GetMessages(ctx context.Context, disputeID int64, opts *entity.Options) ([]*entity.DisputeMessage, error)
+}
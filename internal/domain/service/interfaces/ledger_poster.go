@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=ledger_poster.go -destination=../mocks/ledger_poster_mock.go -package=mocks LedgerPoster
+type LedgerPoster interface {
+	PostOrderPayment(ctx context.Context, orderID int64, amount entity.Money) error
+	PostRefund(ctx context.Context, orderID int64, amount entity.Money) error
+	PostPenalty(ctx context.Context, orderID int64) error
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=ledger_repo.go -destination=../mocks/ledger_repo_mock.go -package=mocks LedgerRepository
+type LedgerRepository interface {
+	GetAccount(ctx context.Context, accountType entity.LedgerAccountType, ownerID *int64) (*entity.LedgerAccount, error)
+	GetOrCreateAccount(ctx context.Context, accountType entity.LedgerAccountType,
+		ownerID *int64) (*entity.LedgerAccount, error)
+	SaveTransaction(ctx context.Context, transaction *entity.LedgerTransaction) error
+	GetBalance(ctx context.Context, accountID int64) (entity.Money, error)
+	GetEntries(ctx context.Context, accountID int64, opts *entity.Options) ([]*entity.LedgerEntry, error)
+	GetTrialBalance(ctx context.Context) ([]*entity.TrialBalanceLine, error)
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=order_event_broker.go -destination=../mocks/order_event_broker_mock.go -package=mocks OrderEventBroker
+type OrderEventBroker interface {
+	Publish(ctx context.Context, event *entity.OrderEvent)
+	Subscribe(ctx context.Context, orderID int64, lastEventID *int64) (<-chan *entity.OrderEvent, func(), error)
+}
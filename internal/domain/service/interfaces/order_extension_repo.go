@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=order_extension_repo.go -destination=../mocks/order_extension_repo_mock.go -package=mocks OrderExtensionRepository
+type OrderExtensionRepository interface {
+	Save(ctx context.Context, extension *entity.OrderExtension) error
+	GetByID(ctx context.Context, id int64) (*entity.OrderExtension, error)
+	GetAllByOrderID(ctx context.Context, orderID int64) ([]*entity.OrderExtension, error)
+	GetPendingByOrderID(ctx context.Context, orderID int64) (*entity.OrderExtension, error)
+	Update(ctx context.Context, extension *entity.OrderExtension) (*entity.OrderExtension, error)
+}
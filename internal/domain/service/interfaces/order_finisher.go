@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=order_finisher.go -destination=../mocks/order_finisher_mock.go -package=mocks OrderFinisher
+type OrderFinisher interface {
+	CancelOrderByAdmin(ctx context.Context, order *entity.Order) (*entity.Order, error)
+	ConfirmOrderByAdmin(ctx context.Context, order *entity.Order) (*entity.Order, error)
+}
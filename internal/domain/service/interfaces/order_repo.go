@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
 )
@@ -15,4 +16,8 @@ type OrderRepository interface {
 	GetAllByModelID(ctx context.Context, modelID int64, opts *entity.Options) ([]*entity.Order, error)
 	GetAllByClientID(ctx context.Context, clientID int64) ([]*entity.Order, error)
 	GetAll(ctx context.Context, opts *entity.Options) ([]*entity.Order, error)
+	GetExpiredConfirmations(ctx context.Context, now time.Time) ([]*entity.Order, error)
+	AddExtension(ctx context.Context, orderID int64, minutes int, amount entity.Money) (*entity.Order, error)
+	GetArchivedByID(ctx context.Context, id int64) (*entity.Order, error)
+	GetAllArchived(ctx context.Context, opts *entity.Options) ([]*entity.Order, error)
 }
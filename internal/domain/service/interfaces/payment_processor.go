@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=payment_processor.go -destination=../mocks/payment_processor_mock.go -package=mocks PaymentProcessor
+type PaymentProcessor interface {
+	Authorize(ctx context.Context, orderID int64, amount entity.Money) (*entity.Payment, error)
+	AuthorizeExtra(ctx context.Context, orderID int64, amount entity.Money) (*entity.Payment, error)
+	Capture(ctx context.Context, orderID int64) (*entity.Payment, error)
+	Refund(ctx context.Context, orderID int64, amount entity.Money) (*entity.Payment, error)
+	Release(ctx context.Context, orderID int64) (*entity.Payment, error)
+	VoidAuthorization(ctx context.Context, payment *entity.Payment) error
+}
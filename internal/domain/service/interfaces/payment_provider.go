@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=payment_provider.go -destination=../mocks/payment_provider_mock.go -package=mocks PaymentProvider
+type PaymentProvider interface {
+	Name() string
+	Authorize(ctx context.Context, reference string, amount entity.Money) (string, error)
+	IncreaseAuthorization(ctx context.Context, providerPaymentID string, amount entity.Money) error
+	Capture(ctx context.Context, providerPaymentID string, amount entity.Money) error
+	Refund(ctx context.Context, providerPaymentID string, amount entity.Money) error
+	Void(ctx context.Context, providerPaymentID string) error
+}
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=payment_repo.go -destination=../mocks/payment_repo_mock.go -package=mocks PaymentRepository
+type PaymentRepository interface {
+	Save(ctx context.Context, payment *entity.Payment) error
+	GetByOrderID(ctx context.Context, orderID int64) (*entity.Payment, error)
+	GetByProviderPaymentID(ctx context.Context, provider, providerPaymentID string) (*entity.Payment, error)
+	Update(ctx context.Context, payment *entity.Payment) (*entity.Payment, error)
+	SaveEvent(ctx context.Context, event *entity.PaymentEvent) error
+}
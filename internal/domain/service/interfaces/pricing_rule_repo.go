@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=pricing_rule_repo.go -destination=../mocks/pricing_rule_repo_mock.go -package=mocks PricingRuleRepository
+type PricingRuleRepository interface {
+	Save(ctx context.Context, rule *entity.PricingRule) error
+	GetByID(ctx context.Context, id int64) (*entity.PricingRule, error)
+	GetByModelID(ctx context.Context, modelID int64, opts *entity.Options) ([]*entity.PricingRule, error)
+	GetActiveByModelID(ctx context.Context, modelID int64) ([]*entity.PricingRule, error)
+	Update(ctx context.Context, rule *entity.PricingRule) (*entity.PricingRule, error)
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=promo_code_redeemer.go -destination=../mocks/promo_code_redeemer_mock.go -package=mocks PromoCodeRedeemer
+type PromoCodeRedeemer interface {
+	Validate(ctx context.Context, code string, clientID int64, service *entity.ModelService) (*entity.PromoCode, error)
+	Redeem(ctx context.Context, promo *entity.PromoCode, clientID, bookingID int64) error
+	Release(ctx context.Context, bookingID int64) error
+}
@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=promo_code_repo.go -destination=../mocks/promo_code_repo_mock.go -package=mocks PromoCodeRepository
+type PromoCodeRepository interface {
+	Save(ctx context.Context, promo *entity.PromoCode) error
+	GetByID(ctx context.Context, id int64) (*entity.PromoCode, error)
+	GetByCode(ctx context.Context, code string) (*entity.PromoCode, error)
+	GetAll(ctx context.Context, opts *entity.Options) ([]*entity.PromoCode, error)
+	Update(ctx context.Context, promo *entity.PromoCode) (*entity.PromoCode, error)
+	IncrementUsage(ctx context.Context, id int64) error
+	CountActiveRedemptions(ctx context.Context, id, clientID int64) (int, error)
+	SaveRedemption(ctx context.Context, redemption *entity.PromoRedemption) error
+	ReleaseRedemption(ctx context.Context, bookingID int64, now time.Time) error
+}
@@ -0,0 +1,9 @@
+package interfaces
+
+import "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+
+//go:generate mockgen -source=quote_signer.go -destination=../mocks/quote_signer_mock.go -package=mocks QuoteSigner
+type QuoteSigner interface {
+	Sign(quote *entity.Quote) (string, error)
+	Parse(token string) (*entity.Quote, error)
+}
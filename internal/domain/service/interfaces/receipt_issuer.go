@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=receipt_issuer.go -destination=../mocks/receipt_issuer_mock.go -package=mocks ReceiptIssuer
+type ReceiptIssuer interface {
+	IssueReceipt(ctx context.Context, order *entity.Order, payment *entity.Payment) (*entity.Receipt, error)
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=receipt_repo.go -destination=../mocks/receipt_repo_mock.go -package=mocks ReceiptRepository
+type ReceiptRepository interface {
+	Save(ctx context.Context, receipt *entity.Receipt) error
+	GetByOrderID(ctx context.Context, orderID int64) (*entity.Receipt, error)
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=retention_repo.go -destination=../mocks/retention_repo_mock.go -package=mocks RetentionRepository
+type RetentionRepository interface {
+	ArchiveOrders(ctx context.Context, before time.Time) (int64, error)
+	ArchiveBookings(ctx context.Context, before time.Time) (int64, error)
+	ArchiveSlots(ctx context.Context, before time.Time) (int64, error)
+}
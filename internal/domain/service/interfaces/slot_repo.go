@@ -10,8 +10,20 @@ import (
 //go:generate mockgen -source=slot_repo.go -destination=../mocks/slot_repo_mock.go -package=mocks SlotRepository
 type SlotRepository interface {
 	Save(ctx context.Context, slot *entity.Slot) error
+	SaveAll(ctx context.Context, slots []*entity.Slot) error
 	GetByID(ctx context.Context, id int64) (*entity.Slot, error)
-	GetByModelID(ctx context.Context, modelID int64) ([]*entity.Slot, error)
+	GetByIDs(ctx context.Context, ids []int64) ([]*entity.Slot, error)
+	GetByModelID(ctx context.Context, modelID int64, filter *entity.SlotFilter, opts *entity.Options) ([]*entity.Slot, error)
 	GetOverlappingSlots(ctx context.Context, modelID int64, start, end time.Time) ([]*entity.Slot, error)
 	Update(ctx context.Context, slot *entity.Slot) (*entity.Slot, error)
+	UpdateAvailable(ctx context.Context, slot *entity.Slot) (*entity.Slot, error)
+	DisableAvailable(ctx context.Context, ids []int64) ([]*entity.Slot, error)
+	ReleaseTemplateSlots(ctx context.Context, templateID int64, from time.Time) (int64, error)
+	GetImportBlocked(ctx context.Context, modelID int64, from time.Time) ([]*entity.Slot, error)
+	BlockForImport(ctx context.Context, ids []int64) ([]*entity.Slot, error)
+	ReleaseImportBlock(ctx context.Context, ids []int64) ([]*entity.Slot, error)
+	DisableForTimeOff(ctx context.Context, timeOffID int64, ids []int64) ([]*entity.Slot, error)
+	ReleaseTimeOff(ctx context.Context, timeOffID int64) ([]*entity.Slot, error)
+	HasBookings(ctx context.Context, ids []int64) (bool, error)
+	DeleteUnbooked(ctx context.Context, ids []int64) (int64, error)
 }
@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=surcharge_calculator.go -destination=../mocks/surcharge_calculator_mock.go -package=mocks SurchargeCalculator
+type SurchargeCalculator interface {
+	Surcharges(ctx context.Context, service *entity.ModelService, slot *entity.Slot) ([]entity.PriceComponent, error)
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=time_off_repo.go -destination=../mocks/time_off_repo_mock.go -package=mocks TimeOffRepository
+type TimeOffRepository interface {
+	Save(ctx context.Context, timeOff *entity.TimeOff) error
+	GetByID(ctx context.Context, id int64) (*entity.TimeOff, error)
+	GetByModelID(ctx context.Context, modelID int64, from time.Time) ([]*entity.TimeOff, error)
+	GetOverlapping(ctx context.Context, modelID int64, start, end time.Time) ([]*entity.TimeOff, error)
+	Delete(ctx context.Context, id int64) error
+}
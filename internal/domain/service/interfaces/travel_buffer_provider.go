@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=travel_buffer_provider.go -destination=../mocks/travel_buffer_provider_mock.go -package=mocks TravelBufferProvider
+type TravelBufferProvider interface {
+	BufferOf(ctx context.Context, modelID int64) (*entity.TravelBuffer, error)
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -source=travel_buffer_repo.go -destination=../mocks/travel_buffer_repo_mock.go -package=mocks TravelBufferRepository
+type TravelBufferRepository interface {
+	GetByModelID(ctx context.Context, modelID int64) (*entity.TravelBuffer, error)
+	Save(ctx context.Context, buffer *entity.TravelBuffer) (*entity.TravelBuffer, error)
+}
@@ -16,6 +16,7 @@ type Metrics struct {
 	ClientsTotal    prometheus.Gauge
 	ModelsTotal     prometheus.Gauge
 	CompletedOrders prometheus.Counter
+	ArchivedRows    *prometheus.CounterVec
 }
 
 func NewMetrics() *Metrics {
@@ -51,6 +52,13 @@ func NewMetrics() *Metrics {
 			Name: "app_completed_orders_total",
 			Help: "Total number of completed orders",
 		}),
+		ArchivedRows: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "app_archived_rows_total",
+				Help: "Total number of rows the retention job has moved to the archive",
+			},
+			[]string{"table"},
+		),
 	}
 
 	prometheus.MustRegister(
@@ -60,6 +68,7 @@ func NewMetrics() *Metrics {
 		m.ClientsTotal,
 		m.ModelsTotal,
 		m.CompletedOrders,
+		m.ArchivedRows,
 	)
 
 	return m
@@ -85,3 +94,7 @@ func (m *Metrics) SetModels(count int64) {
 func (m *Metrics) IncCompletedOrders() {
 	m.CompletedOrders.Inc()
 }
+
+func (m *Metrics) AddArchived(table string, count int64) {
+	m.ArchivedRows.WithLabelValues(table).Add(float64(count))
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: add_on_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAddOnRepository is a mock of AddOnRepository interface.
+type MockAddOnRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddOnRepositoryMockRecorder
+}
+
+// MockAddOnRepositoryMockRecorder is the mock recorder for MockAddOnRepository.
+type MockAddOnRepositoryMockRecorder struct {
+	mock *MockAddOnRepository
+}
+
+// NewMockAddOnRepository creates a new mock instance.
+func NewMockAddOnRepository(ctrl *gomock.Controller) *MockAddOnRepository {
+	mock := &MockAddOnRepository{ctrl: ctrl}
+	mock.recorder = &MockAddOnRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddOnRepository) EXPECT() *MockAddOnRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Deactivate mocks base method.
+func (m *MockAddOnRepository) Deactivate(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockAddOnRepositoryMockRecorder) Deactivate(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockAddOnRepository)(nil).Deactivate), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockAddOnRepository) GetByID(ctx context.Context, id int64) (*entity.AddOn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.AddOn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAddOnRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAddOnRepository)(nil).GetByID), ctx, id)
+}
+
+// GetByServiceID mocks base method.
+func (m *MockAddOnRepository) GetByServiceID(ctx context.Context, serviceID int64, includeInactive bool) ([]*entity.AddOn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByServiceID", ctx, serviceID, includeInactive)
+	ret0, _ := ret[0].([]*entity.AddOn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByServiceID indicates an expected call of GetByServiceID.
+func (mr *MockAddOnRepositoryMockRecorder) GetByServiceID(ctx, serviceID, includeInactive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByServiceID", reflect.TypeOf((*MockAddOnRepository)(nil).GetByServiceID), ctx, serviceID, includeInactive)
+}
+
+// Save mocks base method.
+func (m *MockAddOnRepository) Save(ctx context.Context, addOn *entity.AddOn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, addOn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAddOnRepositoryMockRecorder) Save(ctx, addOn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAddOnRepository)(nil).Save), ctx, addOn)
+}
+
+// Update mocks base method.
+func (m *MockAddOnRepository) Update(ctx context.Context, addOn *entity.AddOn) (*entity.AddOn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, addOn)
+	ret0, _ := ret[0].(*entity.AddOn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAddOnRepositoryMockRecorder) Update(ctx, addOn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAddOnRepository)(nil).Update), ctx, addOn)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: availability_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAvailabilityRepository is a mock of AvailabilityRepository interface.
+type MockAvailabilityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAvailabilityRepositoryMockRecorder
+}
+
+// MockAvailabilityRepositoryMockRecorder is the mock recorder for MockAvailabilityRepository.
+type MockAvailabilityRepositoryMockRecorder struct {
+	mock *MockAvailabilityRepository
+}
+
+// NewMockAvailabilityRepository creates a new mock instance.
+func NewMockAvailabilityRepository(ctrl *gomock.Controller) *MockAvailabilityRepository {
+	mock := &MockAvailabilityRepository{ctrl: ctrl}
+	mock.recorder = &MockAvailabilityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAvailabilityRepository) EXPECT() *MockAvailabilityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockAvailabilityRepository) Search(ctx context.Context, filter *entity.AvailabilityFilter, opts *entity.Options) ([]*entity.ModelAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter, opts)
+	ret0, _ := ret[0].([]*entity.ModelAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockAvailabilityRepositoryMockRecorder) Search(ctx, filter, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockAvailabilityRepository)(nil).Search), ctx, filter, opts)
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: availability_template_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAvailabilityTemplateRepository is a mock of AvailabilityTemplateRepository interface.
+type MockAvailabilityTemplateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAvailabilityTemplateRepositoryMockRecorder
+}
+
+// MockAvailabilityTemplateRepositoryMockRecorder is the mock recorder for MockAvailabilityTemplateRepository.
+type MockAvailabilityTemplateRepositoryMockRecorder struct {
+	mock *MockAvailabilityTemplateRepository
+}
+
+// NewMockAvailabilityTemplateRepository creates a new mock instance.
+func NewMockAvailabilityTemplateRepository(ctrl *gomock.Controller) *MockAvailabilityTemplateRepository {
+	mock := &MockAvailabilityTemplateRepository{ctrl: ctrl}
+	mock.recorder = &MockAvailabilityTemplateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAvailabilityTemplateRepository) EXPECT() *MockAvailabilityTemplateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockAvailabilityTemplateRepository) GetByID(ctx context.Context, id int64) (*entity.AvailabilityTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.AvailabilityTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAvailabilityTemplateRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAvailabilityTemplateRepository)(nil).GetByID), ctx, id)
+}
+
+// GetByModelID mocks base method.
+func (m *MockAvailabilityTemplateRepository) GetByModelID(ctx context.Context, modelID int64, opts *entity.Options) ([]*entity.AvailabilityTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByModelID", ctx, modelID, opts)
+	ret0, _ := ret[0].([]*entity.AvailabilityTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByModelID indicates an expected call of GetByModelID.
+func (mr *MockAvailabilityTemplateRepositoryMockRecorder) GetByModelID(ctx, modelID, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByModelID", reflect.TypeOf((*MockAvailabilityTemplateRepository)(nil).GetByModelID), ctx, modelID, opts)
+}
+
+// GetPublished mocks base method.
+func (m *MockAvailabilityTemplateRepository) GetPublished(ctx context.Context) ([]*entity.AvailabilityTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublished", ctx)
+	ret0, _ := ret[0].([]*entity.AvailabilityTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublished indicates an expected call of GetPublished.
+func (mr *MockAvailabilityTemplateRepositoryMockRecorder) GetPublished(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublished", reflect.TypeOf((*MockAvailabilityTemplateRepository)(nil).GetPublished), ctx)
+}
+
+// Save mocks base method.
+func (m *MockAvailabilityTemplateRepository) Save(ctx context.Context, template *entity.AvailabilityTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAvailabilityTemplateRepositoryMockRecorder) Save(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAvailabilityTemplateRepository)(nil).Save), ctx, template)
+}
+
+// Update mocks base method.
+func (m *MockAvailabilityTemplateRepository) Update(ctx context.Context, template *entity.AvailabilityTemplate) (*entity.AvailabilityTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, template)
+	ret0, _ := ret[0].(*entity.AvailabilityTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAvailabilityTemplateRepositoryMockRecorder) Update(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAvailabilityTemplateRepository)(nil).Update), ctx, template)
+}
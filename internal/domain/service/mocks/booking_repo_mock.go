@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,21 @@ func (m *MockBookingRepository) EXPECT() *MockBookingRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ExpirePending mocks base method.
+func (m *MockBookingRepository) ExpirePending(ctx context.Context, now time.Time) ([]*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePending", ctx, now)
+	ret0, _ := ret[0].([]*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePending indicates an expected call of ExpirePending.
+func (mr *MockBookingRepositoryMockRecorder) ExpirePending(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePending", reflect.TypeOf((*MockBookingRepository)(nil).ExpirePending), ctx, now)
+}
+
 // GetAll mocks base method.
 func (m *MockBookingRepository) GetAll(ctx context.Context, opts *entity.Options) ([]*entity.Booking, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +66,36 @@ func (mr *MockBookingRepositoryMockRecorder) GetAll(ctx, opts interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBookingRepository)(nil).GetAll), ctx, opts)
 }
 
+// GetAllArchived mocks base method.
+func (m *MockBookingRepository) GetAllArchived(ctx context.Context, opts *entity.Options) ([]*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllArchived", ctx, opts)
+	ret0, _ := ret[0].([]*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllArchived indicates an expected call of GetAllArchived.
+func (mr *MockBookingRepositoryMockRecorder) GetAllArchived(ctx, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllArchived", reflect.TypeOf((*MockBookingRepository)(nil).GetAllArchived), ctx, opts)
+}
+
+// GetArchivedByID mocks base method.
+func (m *MockBookingRepository) GetArchivedByID(ctx context.Context, id int64) (*entity.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedByID indicates an expected call of GetArchivedByID.
+func (mr *MockBookingRepositoryMockRecorder) GetArchivedByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedByID", reflect.TypeOf((*MockBookingRepository)(nil).GetArchivedByID), ctx, id)
+}
+
 // GetByID mocks base method.
 func (m *MockBookingRepository) GetByID(ctx context.Context, id int64) (*entity.Booking, error) {
 	m.ctrl.T.Helper()
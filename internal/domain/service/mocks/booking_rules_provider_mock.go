@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: booking_rules_provider.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBookingRulesProvider is a mock of BookingRulesProvider interface.
+type MockBookingRulesProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookingRulesProviderMockRecorder
+}
+
+// MockBookingRulesProviderMockRecorder is the mock recorder for MockBookingRulesProvider.
+type MockBookingRulesProviderMockRecorder struct {
+	mock *MockBookingRulesProvider
+}
+
+// NewMockBookingRulesProvider creates a new mock instance.
+func NewMockBookingRulesProvider(ctrl *gomock.Controller) *MockBookingRulesProvider {
+	mock := &MockBookingRulesProvider{ctrl: ctrl}
+	mock.recorder = &MockBookingRulesProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookingRulesProvider) EXPECT() *MockBookingRulesProviderMockRecorder {
+	return m.recorder
+}
+
+// RulesOf mocks base method.
+func (m *MockBookingRulesProvider) RulesOf(ctx context.Context, modelID int64) (*entity.BookingRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RulesOf", ctx, modelID)
+	ret0, _ := ret[0].(*entity.BookingRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RulesOf indicates an expected call of RulesOf.
+func (mr *MockBookingRulesProviderMockRecorder) RulesOf(ctx, modelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RulesOf", reflect.TypeOf((*MockBookingRulesProvider)(nil).RulesOf), ctx, modelID)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: booking_rules_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBookingRulesRepository is a mock of BookingRulesRepository interface.
+type MockBookingRulesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookingRulesRepositoryMockRecorder
+}
+
+// MockBookingRulesRepositoryMockRecorder is the mock recorder for MockBookingRulesRepository.
+type MockBookingRulesRepositoryMockRecorder struct {
+	mock *MockBookingRulesRepository
+}
+
+// NewMockBookingRulesRepository creates a new mock instance.
+func NewMockBookingRulesRepository(ctrl *gomock.Controller) *MockBookingRulesRepository {
+	mock := &MockBookingRulesRepository{ctrl: ctrl}
+	mock.recorder = &MockBookingRulesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookingRulesRepository) EXPECT() *MockBookingRulesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByModelID mocks base method.
+func (m *MockBookingRulesRepository) GetByModelID(ctx context.Context, modelID int64) (*entity.BookingRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByModelID", ctx, modelID)
+	ret0, _ := ret[0].(*entity.BookingRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByModelID indicates an expected call of GetByModelID.
+func (mr *MockBookingRulesRepositoryMockRecorder) GetByModelID(ctx, modelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByModelID", reflect.TypeOf((*MockBookingRulesRepository)(nil).GetByModelID), ctx, modelID)
+}
+
+// Save mocks base method.
+func (m *MockBookingRulesRepository) Save(ctx context.Context, rules *entity.BookingRules) (*entity.BookingRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, rules)
+	ret0, _ := ret[0].(*entity.BookingRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockBookingRulesRepositoryMockRecorder) Save(ctx, rules interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockBookingRulesRepository)(nil).Save), ctx, rules)
+}
@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: busy_calendar_reader.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBusyCalendarReader is a mock of BusyCalendarReader interface.
+type MockBusyCalendarReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockBusyCalendarReaderMockRecorder
+}
+
+// MockBusyCalendarReaderMockRecorder is the mock recorder for MockBusyCalendarReader.
+type MockBusyCalendarReaderMockRecorder struct {
+	mock *MockBusyCalendarReader
+}
+
+// NewMockBusyCalendarReader creates a new mock instance.
+func NewMockBusyCalendarReader(ctrl *gomock.Controller) *MockBusyCalendarReader {
+	mock := &MockBusyCalendarReader{ctrl: ctrl}
+	mock.recorder = &MockBusyCalendarReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBusyCalendarReader) EXPECT() *MockBusyCalendarReaderMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *MockBusyCalendarReader) Fetch(ctx context.Context, url string, loc *time.Location) ([]*entity.BusyEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", ctx, url, loc)
+	ret0, _ := ret[0].([]*entity.BusyEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockBusyCalendarReaderMockRecorder) Fetch(ctx, url, loc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockBusyCalendarReader)(nil).Fetch), ctx, url, loc)
+}
+
+// Read mocks base method.
+func (m *MockBusyCalendarReader) Read(src io.Reader, loc *time.Location) ([]*entity.BusyEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", src, loc)
+	ret0, _ := ret[0].([]*entity.BusyEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockBusyCalendarReaderMockRecorder) Read(src, loc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockBusyCalendarReader)(nil).Read), src, loc)
+}
@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: busy_calendar_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBusyCalendarRepository is a mock of BusyCalendarRepository interface.
+type MockBusyCalendarRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBusyCalendarRepositoryMockRecorder
+}
+
+// MockBusyCalendarRepositoryMockRecorder is the mock recorder for MockBusyCalendarRepository.
+type MockBusyCalendarRepositoryMockRecorder struct {
+	mock *MockBusyCalendarRepository
+}
+
+// NewMockBusyCalendarRepository creates a new mock instance.
+func NewMockBusyCalendarRepository(ctrl *gomock.Controller) *MockBusyCalendarRepository {
+	mock := &MockBusyCalendarRepository{ctrl: ctrl}
+	mock.recorder = &MockBusyCalendarRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBusyCalendarRepository) EXPECT() *MockBusyCalendarRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBusyCalendarRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBusyCalendarRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBusyCalendarRepository)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockBusyCalendarRepository) GetAll(ctx context.Context) ([]*entity.BusyCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*entity.BusyCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockBusyCalendarRepositoryMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBusyCalendarRepository)(nil).GetAll), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockBusyCalendarRepository) GetByID(ctx context.Context, id int64) (*entity.BusyCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.BusyCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBusyCalendarRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBusyCalendarRepository)(nil).GetByID), ctx, id)
+}
+
+// GetByModelID mocks base method.
+func (m *MockBusyCalendarRepository) GetByModelID(ctx context.Context, modelID int64) ([]*entity.BusyCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByModelID", ctx, modelID)
+	ret0, _ := ret[0].([]*entity.BusyCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByModelID indicates an expected call of GetByModelID.
+func (mr *MockBusyCalendarRepositoryMockRecorder) GetByModelID(ctx, modelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByModelID", reflect.TypeOf((*MockBusyCalendarRepository)(nil).GetByModelID), ctx, modelID)
+}
+
+// GetModelEvents mocks base method.
+func (m *MockBusyCalendarRepository) GetModelEvents(ctx context.Context, modelID int64, from time.Time) ([]*entity.BusyEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelEvents", ctx, modelID, from)
+	ret0, _ := ret[0].([]*entity.BusyEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelEvents indicates an expected call of GetModelEvents.
+func (mr *MockBusyCalendarRepositoryMockRecorder) GetModelEvents(ctx, modelID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelEvents", reflect.TypeOf((*MockBusyCalendarRepository)(nil).GetModelEvents), ctx, modelID, from)
+}
+
+// ReplaceEvents mocks base method.
+func (m *MockBusyCalendarRepository) ReplaceEvents(ctx context.Context, calendarID int64, events []*entity.BusyEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceEvents", ctx, calendarID, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceEvents indicates an expected call of ReplaceEvents.
+func (mr *MockBusyCalendarRepositoryMockRecorder) ReplaceEvents(ctx, calendarID, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceEvents", reflect.TypeOf((*MockBusyCalendarRepository)(nil).ReplaceEvents), ctx, calendarID, events)
+}
+
+// Save mocks base method.
+func (m *MockBusyCalendarRepository) Save(ctx context.Context, calendar *entity.BusyCalendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, calendar)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockBusyCalendarRepositoryMockRecorder) Save(ctx, calendar interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockBusyCalendarRepository)(nil).Save), ctx, calendar)
+}
+
+// UpdateSync mocks base method.
+func (m *MockBusyCalendarRepository) UpdateSync(ctx context.Context, calendar *entity.BusyCalendar) (*entity.BusyCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSync", ctx, calendar)
+	ret0, _ := ret[0].(*entity.BusyCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSync indicates an expected call of UpdateSync.
+func (mr *MockBusyCalendarRepositoryMockRecorder) UpdateSync(ctx, calendar interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSync", reflect.TypeOf((*MockBusyCalendarRepository)(nil).UpdateSync), ctx, calendar)
+}
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: calendar_feed_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCalendarFeedRepository is a mock of CalendarFeedRepository interface.
+type MockCalendarFeedRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarFeedRepositoryMockRecorder
+}
+
+// MockCalendarFeedRepositoryMockRecorder is the mock recorder for MockCalendarFeedRepository.
+type MockCalendarFeedRepositoryMockRecorder struct {
+	mock *MockCalendarFeedRepository
+}
+
+// NewMockCalendarFeedRepository creates a new mock instance.
+func NewMockCalendarFeedRepository(ctrl *gomock.Controller) *MockCalendarFeedRepository {
+	mock := &MockCalendarFeedRepository{ctrl: ctrl}
+	mock.recorder = &MockCalendarFeedRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarFeedRepository) EXPECT() *MockCalendarFeedRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByToken mocks base method.
+func (m *MockCalendarFeedRepository) GetByToken(ctx context.Context, token string) (*entity.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByToken", ctx, token)
+	ret0, _ := ret[0].(*entity.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByToken indicates an expected call of GetByToken.
+func (mr *MockCalendarFeedRepositoryMockRecorder) GetByToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByToken", reflect.TypeOf((*MockCalendarFeedRepository)(nil).GetByToken), ctx, token)
+}
+
+// GetByUserID mocks base method.
+func (m *MockCalendarFeedRepository) GetByUserID(ctx context.Context, userID int64) (*entity.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].(*entity.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockCalendarFeedRepositoryMockRecorder) GetByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockCalendarFeedRepository)(nil).GetByUserID), ctx, userID)
+}
+
+// GetClientOrders mocks base method.
+func (m *MockCalendarFeedRepository) GetClientOrders(ctx context.Context, clientID int64, from time.Time) ([]*entity.CalendarOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientOrders", ctx, clientID, from)
+	ret0, _ := ret[0].([]*entity.CalendarOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientOrders indicates an expected call of GetClientOrders.
+func (mr *MockCalendarFeedRepositoryMockRecorder) GetClientOrders(ctx, clientID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientOrders", reflect.TypeOf((*MockCalendarFeedRepository)(nil).GetClientOrders), ctx, clientID, from)
+}
+
+// GetModelOrders mocks base method.
+func (m *MockCalendarFeedRepository) GetModelOrders(ctx context.Context, modelID int64, from time.Time) ([]*entity.CalendarOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelOrders", ctx, modelID, from)
+	ret0, _ := ret[0].([]*entity.CalendarOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelOrders indicates an expected call of GetModelOrders.
+func (mr *MockCalendarFeedRepositoryMockRecorder) GetModelOrders(ctx, modelID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelOrders", reflect.TypeOf((*MockCalendarFeedRepository)(nil).GetModelOrders), ctx, modelID, from)
+}
+
+// Save mocks base method.
+func (m *MockCalendarFeedRepository) Save(ctx context.Context, feed *entity.CalendarFeed) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, feed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockCalendarFeedRepositoryMockRecorder) Save(ctx, feed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockCalendarFeedRepository)(nil).Save), ctx, feed)
+}
+
+// UpdateToken mocks base method.
+func (m *MockCalendarFeedRepository) UpdateToken(ctx context.Context, feed *entity.CalendarFeed) (*entity.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateToken", ctx, feed)
+	ret0, _ := ret[0].(*entity.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateToken indicates an expected call of UpdateToken.
+func (mr *MockCalendarFeedRepositoryMockRecorder) UpdateToken(ctx, feed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateToken", reflect.TypeOf((*MockCalendarFeedRepository)(nil).UpdateToken), ctx, feed)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dispute_opener.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDisputeOpener is a mock of DisputeOpener interface.
+type MockDisputeOpener struct {
+	ctrl     *gomock.Controller
+	recorder *MockDisputeOpenerMockRecorder
+}
+
+// MockDisputeOpenerMockRecorder is the mock recorder for MockDisputeOpener.
+type MockDisputeOpenerMockRecorder struct {
+	mock *MockDisputeOpener
+}
+
+// NewMockDisputeOpener creates a new mock instance.
+func NewMockDisputeOpener(ctrl *gomock.Controller) *MockDisputeOpener {
+	mock := &MockDisputeOpener{ctrl: ctrl}
+	mock.recorder = &MockDisputeOpenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDisputeOpener) EXPECT() *MockDisputeOpenerMockRecorder {
+	return m.recorder
+}
+
+// OpenDispute mocks base method.
+func (m *MockDisputeOpener) OpenDispute(ctx context.Context, orderID int64, category entity.DisputeCategory, description string) (*entity.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDispute", ctx, orderID, category, description)
+	ret0, _ := ret[0].(*entity.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenDispute indicates an expected call of OpenDispute.
+func (mr *MockDisputeOpenerMockRecorder) OpenDispute(ctx, orderID, category, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDispute", reflect.TypeOf((*MockDisputeOpener)(nil).OpenDispute), ctx, orderID, category, description)
+}
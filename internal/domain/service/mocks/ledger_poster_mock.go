@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_poster.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLedgerPoster is a mock of LedgerPoster interface.
+type MockLedgerPoster struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerPosterMockRecorder
+}
+
+// MockLedgerPosterMockRecorder is the mock recorder for MockLedgerPoster.
+type MockLedgerPosterMockRecorder struct {
+	mock *MockLedgerPoster
+}
+
+// NewMockLedgerPoster creates a new mock instance.
+func NewMockLedgerPoster(ctrl *gomock.Controller) *MockLedgerPoster {
+	mock := &MockLedgerPoster{ctrl: ctrl}
+	mock.recorder = &MockLedgerPosterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerPoster) EXPECT() *MockLedgerPosterMockRecorder {
+	return m.recorder
+}
+
+// PostOrderPayment mocks base method.
+func (m *MockLedgerPoster) PostOrderPayment(ctx context.Context, orderID int64, amount entity.Money) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostOrderPayment", ctx, orderID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostOrderPayment indicates an expected call of PostOrderPayment.
+func (mr *MockLedgerPosterMockRecorder) PostOrderPayment(ctx, orderID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostOrderPayment", reflect.TypeOf((*MockLedgerPoster)(nil).PostOrderPayment), ctx, orderID, amount)
+}
+
+// PostPenalty mocks base method.
+func (m *MockLedgerPoster) PostPenalty(ctx context.Context, orderID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostPenalty", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostPenalty indicates an expected call of PostPenalty.
+func (mr *MockLedgerPosterMockRecorder) PostPenalty(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostPenalty", reflect.TypeOf((*MockLedgerPoster)(nil).PostPenalty), ctx, orderID)
+}
+
+// PostRefund mocks base method.
+func (m *MockLedgerPoster) PostRefund(ctx context.Context, orderID int64, amount entity.Money) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostRefund", ctx, orderID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostRefund indicates an expected call of PostRefund.
+func (mr *MockLedgerPosterMockRecorder) PostRefund(ctx, orderID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRefund", reflect.TypeOf((*MockLedgerPoster)(nil).PostRefund), ctx, orderID, amount)
+}
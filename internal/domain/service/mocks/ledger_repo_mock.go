@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ledger_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLedgerRepository is a mock of LedgerRepository interface.
+type MockLedgerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepositoryMockRecorder
+}
+
+// MockLedgerRepositoryMockRecorder is the mock recorder for MockLedgerRepository.
+type MockLedgerRepositoryMockRecorder struct {
+	mock *MockLedgerRepository
+}
+
+// NewMockLedgerRepository creates a new mock instance.
+func NewMockLedgerRepository(ctrl *gomock.Controller) *MockLedgerRepository {
+	mock := &MockLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepository) EXPECT() *MockLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAccount mocks base method.
+func (m *MockLedgerRepository) GetAccount(ctx context.Context, accountType entity.LedgerAccountType, ownerID *int64) (*entity.LedgerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, accountType, ownerID)
+	ret0, _ := ret[0].(*entity.LedgerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockLedgerRepositoryMockRecorder) GetAccount(ctx, accountType, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockLedgerRepository)(nil).GetAccount), ctx, accountType, ownerID)
+}
+
+// GetBalance mocks base method.
+func (m *MockLedgerRepository) GetBalance(ctx context.Context, accountID int64) (entity.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, accountID)
+	ret0, _ := ret[0].(entity.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockLedgerRepositoryMockRecorder) GetBalance(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockLedgerRepository)(nil).GetBalance), ctx, accountID)
+}
+
+// GetEntries mocks base method.
+func (m *MockLedgerRepository) GetEntries(ctx context.Context, accountID int64, opts *entity.Options) ([]*entity.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntries", ctx, accountID, opts)
+	ret0, _ := ret[0].([]*entity.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntries indicates an expected call of GetEntries.
+func (mr *MockLedgerRepositoryMockRecorder) GetEntries(ctx, accountID, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntries", reflect.TypeOf((*MockLedgerRepository)(nil).GetEntries), ctx, accountID, opts)
+}
+
+// GetOrCreateAccount mocks base method.
+func (m *MockLedgerRepository) GetOrCreateAccount(ctx context.Context, accountType entity.LedgerAccountType, ownerID *int64) (*entity.LedgerAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreateAccount", ctx, accountType, ownerID)
+	ret0, _ := ret[0].(*entity.LedgerAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrCreateAccount indicates an expected call of GetOrCreateAccount.
+func (mr *MockLedgerRepositoryMockRecorder) GetOrCreateAccount(ctx, accountType, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateAccount", reflect.TypeOf((*MockLedgerRepository)(nil).GetOrCreateAccount), ctx, accountType, ownerID)
+}
+
+// GetTrialBalance mocks base method.
+func (m *MockLedgerRepository) GetTrialBalance(ctx context.Context) ([]*entity.TrialBalanceLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrialBalance", ctx)
+	ret0, _ := ret[0].([]*entity.TrialBalanceLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrialBalance indicates an expected call of GetTrialBalance.
+func (mr *MockLedgerRepositoryMockRecorder) GetTrialBalance(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrialBalance", reflect.TypeOf((*MockLedgerRepository)(nil).GetTrialBalance), ctx)
+}
+
+// SaveTransaction mocks base method.
+func (m *MockLedgerRepository) SaveTransaction(ctx context.Context, transaction *entity.LedgerTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTransaction", ctx, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTransaction indicates an expected call of SaveTransaction.
+func (mr *MockLedgerRepositoryMockRecorder) SaveTransaction(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTransaction", reflect.TypeOf((*MockLedgerRepository)(nil).SaveTransaction), ctx, transaction)
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: order_event_broker.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrderEventBroker is a mock of OrderEventBroker interface.
+type MockOrderEventBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderEventBrokerMockRecorder
+}
+
+// MockOrderEventBrokerMockRecorder is the mock recorder for MockOrderEventBroker.
+type MockOrderEventBrokerMockRecorder struct {
+	mock *MockOrderEventBroker
+}
+
+// NewMockOrderEventBroker creates a new mock instance.
+func NewMockOrderEventBroker(ctrl *gomock.Controller) *MockOrderEventBroker {
+	mock := &MockOrderEventBroker{ctrl: ctrl}
+	mock.recorder = &MockOrderEventBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderEventBroker) EXPECT() *MockOrderEventBrokerMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockOrderEventBroker) Publish(ctx context.Context, event *entity.OrderEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", ctx, event)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockOrderEventBrokerMockRecorder) Publish(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockOrderEventBroker)(nil).Publish), ctx, event)
+}
+
+// Subscribe mocks base method.
+func (m *MockOrderEventBroker) Subscribe(ctx context.Context, orderID int64, lastEventID *int64) (<-chan *entity.OrderEvent, func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, orderID, lastEventID)
+	ret0, _ := ret[0].(<-chan *entity.OrderEvent)
+	ret1, _ := ret[1].(func())
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockOrderEventBrokerMockRecorder) Subscribe(ctx, orderID, lastEventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockOrderEventBroker)(nil).Subscribe), ctx, orderID, lastEventID)
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: order_extension_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrderExtensionRepository is a mock of OrderExtensionRepository interface.
+type MockOrderExtensionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderExtensionRepositoryMockRecorder
+}
+
+// MockOrderExtensionRepositoryMockRecorder is the mock recorder for MockOrderExtensionRepository.
+type MockOrderExtensionRepositoryMockRecorder struct {
+	mock *MockOrderExtensionRepository
+}
+
+// NewMockOrderExtensionRepository creates a new mock instance.
+func NewMockOrderExtensionRepository(ctrl *gomock.Controller) *MockOrderExtensionRepository {
+	mock := &MockOrderExtensionRepository{ctrl: ctrl}
+	mock.recorder = &MockOrderExtensionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderExtensionRepository) EXPECT() *MockOrderExtensionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAllByOrderID mocks base method.
+func (m *MockOrderExtensionRepository) GetAllByOrderID(ctx context.Context, orderID int64) ([]*entity.OrderExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByOrderID", ctx, orderID)
+	ret0, _ := ret[0].([]*entity.OrderExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByOrderID indicates an expected call of GetAllByOrderID.
+func (mr *MockOrderExtensionRepositoryMockRecorder) GetAllByOrderID(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByOrderID", reflect.TypeOf((*MockOrderExtensionRepository)(nil).GetAllByOrderID), ctx, orderID)
+}
+
+// GetByID mocks base method.
+func (m *MockOrderExtensionRepository) GetByID(ctx context.Context, id int64) (*entity.OrderExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.OrderExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockOrderExtensionRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOrderExtensionRepository)(nil).GetByID), ctx, id)
+}
+
+// GetPendingByOrderID mocks base method.
+func (m *MockOrderExtensionRepository) GetPendingByOrderID(ctx context.Context, orderID int64) (*entity.OrderExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingByOrderID", ctx, orderID)
+	ret0, _ := ret[0].(*entity.OrderExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingByOrderID indicates an expected call of GetPendingByOrderID.
+func (mr *MockOrderExtensionRepositoryMockRecorder) GetPendingByOrderID(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingByOrderID", reflect.TypeOf((*MockOrderExtensionRepository)(nil).GetPendingByOrderID), ctx, orderID)
+}
+
+// Save mocks base method.
+func (m *MockOrderExtensionRepository) Save(ctx context.Context, extension *entity.OrderExtension) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, extension)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockOrderExtensionRepositoryMockRecorder) Save(ctx, extension interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOrderExtensionRepository)(nil).Save), ctx, extension)
+}
+
+// Update mocks base method.
+func (m *MockOrderExtensionRepository) Update(ctx context.Context, extension *entity.OrderExtension) (*entity.OrderExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, extension)
+	ret0, _ := ret[0].(*entity.OrderExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOrderExtensionRepositoryMockRecorder) Update(ctx, extension interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrderExtensionRepository)(nil).Update), ctx, extension)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: order_finisher.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrderFinisher is a mock of OrderFinisher interface.
+type MockOrderFinisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderFinisherMockRecorder
+}
+
+// MockOrderFinisherMockRecorder is the mock recorder for MockOrderFinisher.
+type MockOrderFinisherMockRecorder struct {
+	mock *MockOrderFinisher
+}
+
+// NewMockOrderFinisher creates a new mock instance.
+func NewMockOrderFinisher(ctrl *gomock.Controller) *MockOrderFinisher {
+	mock := &MockOrderFinisher{ctrl: ctrl}
+	mock.recorder = &MockOrderFinisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderFinisher) EXPECT() *MockOrderFinisherMockRecorder {
+	return m.recorder
+}
+
+// CancelOrderByAdmin mocks base method.
+func (m *MockOrderFinisher) CancelOrderByAdmin(ctx context.Context, order *entity.Order) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrderByAdmin", ctx, order)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOrderByAdmin indicates an expected call of CancelOrderByAdmin.
+func (mr *MockOrderFinisherMockRecorder) CancelOrderByAdmin(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrderByAdmin", reflect.TypeOf((*MockOrderFinisher)(nil).CancelOrderByAdmin), ctx, order)
+}
+
+// ConfirmOrderByAdmin mocks base method.
+func (m *MockOrderFinisher) ConfirmOrderByAdmin(ctx context.Context, order *entity.Order) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmOrderByAdmin", ctx, order)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmOrderByAdmin indicates an expected call of ConfirmOrderByAdmin.
+func (mr *MockOrderFinisherMockRecorder) ConfirmOrderByAdmin(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmOrderByAdmin", reflect.TypeOf((*MockOrderFinisher)(nil).ConfirmOrderByAdmin), ctx, order)
+}
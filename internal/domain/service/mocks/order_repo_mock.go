@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,21 @@ func (m *MockOrderRepository) EXPECT() *MockOrderRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddExtension mocks base method.
+func (m *MockOrderRepository) AddExtension(ctx context.Context, orderID int64, minutes int, amount entity.Money) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddExtension", ctx, orderID, minutes, amount)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddExtension indicates an expected call of AddExtension.
+func (mr *MockOrderRepositoryMockRecorder) AddExtension(ctx, orderID, minutes, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExtension", reflect.TypeOf((*MockOrderRepository)(nil).AddExtension), ctx, orderID, minutes, amount)
+}
+
 // GetAll mocks base method.
 func (m *MockOrderRepository) GetAll(ctx context.Context, opts *entity.Options) ([]*entity.Order, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +66,21 @@ func (mr *MockOrderRepositoryMockRecorder) GetAll(ctx, opts interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockOrderRepository)(nil).GetAll), ctx, opts)
 }
 
+// GetAllArchived mocks base method.
+func (m *MockOrderRepository) GetAllArchived(ctx context.Context, opts *entity.Options) ([]*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllArchived", ctx, opts)
+	ret0, _ := ret[0].([]*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllArchived indicates an expected call of GetAllArchived.
+func (mr *MockOrderRepositoryMockRecorder) GetAllArchived(ctx, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllArchived", reflect.TypeOf((*MockOrderRepository)(nil).GetAllArchived), ctx, opts)
+}
+
 // GetAllByClientID mocks base method.
 func (m *MockOrderRepository) GetAllByClientID(ctx context.Context, clientID int64) ([]*entity.Order, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +111,21 @@ func (mr *MockOrderRepositoryMockRecorder) GetAllByModelID(ctx, modelID, opts in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByModelID", reflect.TypeOf((*MockOrderRepository)(nil).GetAllByModelID), ctx, modelID, opts)
 }
 
+// GetArchivedByID mocks base method.
+func (m *MockOrderRepository) GetArchivedByID(ctx context.Context, id int64) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedByID indicates an expected call of GetArchivedByID.
+func (mr *MockOrderRepositoryMockRecorder) GetArchivedByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedByID", reflect.TypeOf((*MockOrderRepository)(nil).GetArchivedByID), ctx, id)
+}
+
 // GetByBookingID mocks base method.
 func (m *MockOrderRepository) GetByBookingID(ctx context.Context, bookingID int64) (*entity.Order, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +156,21 @@ func (mr *MockOrderRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOrderRepository)(nil).GetByID), ctx, id)
 }
 
+// GetExpiredConfirmations mocks base method.
+func (m *MockOrderRepository) GetExpiredConfirmations(ctx context.Context, now time.Time) ([]*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredConfirmations", ctx, now)
+	ret0, _ := ret[0].([]*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredConfirmations indicates an expected call of GetExpiredConfirmations.
+func (mr *MockOrderRepositoryMockRecorder) GetExpiredConfirmations(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredConfirmations", reflect.TypeOf((*MockOrderRepository)(nil).GetExpiredConfirmations), ctx, now)
+}
+
 // Save mocks base method.
 func (m *MockOrderRepository) Save(ctx context.Context, order *entity.Order) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payment_processor.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentProcessor is a mock of PaymentProcessor interface.
+type MockPaymentProcessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentProcessorMockRecorder
+}
+
+// MockPaymentProcessorMockRecorder is the mock recorder for MockPaymentProcessor.
+type MockPaymentProcessorMockRecorder struct {
+	mock *MockPaymentProcessor
+}
+
+// NewMockPaymentProcessor creates a new mock instance.
+func NewMockPaymentProcessor(ctrl *gomock.Controller) *MockPaymentProcessor {
+	mock := &MockPaymentProcessor{ctrl: ctrl}
+	mock.recorder = &MockPaymentProcessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentProcessor) EXPECT() *MockPaymentProcessorMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockPaymentProcessor) Authorize(ctx context.Context, orderID int64, amount entity.Money) (*entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, orderID, amount)
+	ret0, _ := ret[0].(*entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPaymentProcessorMockRecorder) Authorize(ctx, orderID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPaymentProcessor)(nil).Authorize), ctx, orderID, amount)
+}
+
+// AuthorizeExtra mocks base method.
+func (m *MockPaymentProcessor) AuthorizeExtra(ctx context.Context, orderID int64, amount entity.Money) (*entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeExtra", ctx, orderID, amount)
+	ret0, _ := ret[0].(*entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeExtra indicates an expected call of AuthorizeExtra.
+func (mr *MockPaymentProcessorMockRecorder) AuthorizeExtra(ctx, orderID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeExtra", reflect.TypeOf((*MockPaymentProcessor)(nil).AuthorizeExtra), ctx, orderID, amount)
+}
+
+// Capture mocks base method.
+func (m *MockPaymentProcessor) Capture(ctx context.Context, orderID int64) (*entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", ctx, orderID)
+	ret0, _ := ret[0].(*entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockPaymentProcessorMockRecorder) Capture(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockPaymentProcessor)(nil).Capture), ctx, orderID)
+}
+
+// Refund mocks base method.
+func (m *MockPaymentProcessor) Refund(ctx context.Context, orderID int64, amount entity.Money) (*entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, orderID, amount)
+	ret0, _ := ret[0].(*entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockPaymentProcessorMockRecorder) Refund(ctx, orderID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockPaymentProcessor)(nil).Refund), ctx, orderID, amount)
+}
+
+// Release mocks base method.
+func (m *MockPaymentProcessor) Release(ctx context.Context, orderID int64) (*entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, orderID)
+	ret0, _ := ret[0].(*entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockPaymentProcessorMockRecorder) Release(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockPaymentProcessor)(nil).Release), ctx, orderID)
+}
+
+// VoidAuthorization mocks base method.
+func (m *MockPaymentProcessor) VoidAuthorization(ctx context.Context, payment *entity.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidAuthorization", ctx, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidAuthorization indicates an expected call of VoidAuthorization.
+func (mr *MockPaymentProcessorMockRecorder) VoidAuthorization(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidAuthorization", reflect.TypeOf((*MockPaymentProcessor)(nil).VoidAuthorization), ctx, payment)
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pricing_rule_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPricingRuleRepository is a mock of PricingRuleRepository interface.
+type MockPricingRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPricingRuleRepositoryMockRecorder
+}
+
+// MockPricingRuleRepositoryMockRecorder is the mock recorder for MockPricingRuleRepository.
+type MockPricingRuleRepositoryMockRecorder struct {
+	mock *MockPricingRuleRepository
+}
+
+// NewMockPricingRuleRepository creates a new mock instance.
+func NewMockPricingRuleRepository(ctrl *gomock.Controller) *MockPricingRuleRepository {
+	mock := &MockPricingRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockPricingRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPricingRuleRepository) EXPECT() *MockPricingRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockPricingRuleRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPricingRuleRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPricingRuleRepository)(nil).Delete), ctx, id)
+}
+
+// GetActiveByModelID mocks base method.
+func (m *MockPricingRuleRepository) GetActiveByModelID(ctx context.Context, modelID int64) ([]*entity.PricingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveByModelID", ctx, modelID)
+	ret0, _ := ret[0].([]*entity.PricingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveByModelID indicates an expected call of GetActiveByModelID.
+func (mr *MockPricingRuleRepositoryMockRecorder) GetActiveByModelID(ctx, modelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveByModelID", reflect.TypeOf((*MockPricingRuleRepository)(nil).GetActiveByModelID), ctx, modelID)
+}
+
+// GetByID mocks base method.
+func (m *MockPricingRuleRepository) GetByID(ctx context.Context, id int64) (*entity.PricingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.PricingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPricingRuleRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPricingRuleRepository)(nil).GetByID), ctx, id)
+}
+
+// GetByModelID mocks base method.
+func (m *MockPricingRuleRepository) GetByModelID(ctx context.Context, modelID int64, opts *entity.Options) ([]*entity.PricingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByModelID", ctx, modelID, opts)
+	ret0, _ := ret[0].([]*entity.PricingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByModelID indicates an expected call of GetByModelID.
+func (mr *MockPricingRuleRepositoryMockRecorder) GetByModelID(ctx, modelID, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByModelID", reflect.TypeOf((*MockPricingRuleRepository)(nil).GetByModelID), ctx, modelID, opts)
+}
+
+// Save mocks base method.
+func (m *MockPricingRuleRepository) Save(ctx context.Context, rule *entity.PricingRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPricingRuleRepositoryMockRecorder) Save(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPricingRuleRepository)(nil).Save), ctx, rule)
+}
+
+// Update mocks base method.
+func (m *MockPricingRuleRepository) Update(ctx context.Context, rule *entity.PricingRule) (*entity.PricingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, rule)
+	ret0, _ := ret[0].(*entity.PricingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPricingRuleRepositoryMockRecorder) Update(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPricingRuleRepository)(nil).Update), ctx, rule)
+}
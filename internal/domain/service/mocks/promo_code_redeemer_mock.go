@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: promo_code_redeemer.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPromoCodeRedeemer is a mock of PromoCodeRedeemer interface.
+type MockPromoCodeRedeemer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoCodeRedeemerMockRecorder
+}
+
+// MockPromoCodeRedeemerMockRecorder is the mock recorder for MockPromoCodeRedeemer.
+type MockPromoCodeRedeemerMockRecorder struct {
+	mock *MockPromoCodeRedeemer
+}
+
+// NewMockPromoCodeRedeemer creates a new mock instance.
+func NewMockPromoCodeRedeemer(ctrl *gomock.Controller) *MockPromoCodeRedeemer {
+	mock := &MockPromoCodeRedeemer{ctrl: ctrl}
+	mock.recorder = &MockPromoCodeRedeemerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoCodeRedeemer) EXPECT() *MockPromoCodeRedeemerMockRecorder {
+	return m.recorder
+}
+
+// Redeem mocks base method.
+func (m *MockPromoCodeRedeemer) Redeem(ctx context.Context, promo *entity.PromoCode, clientID, bookingID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, promo, clientID, bookingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockPromoCodeRedeemerMockRecorder) Redeem(ctx, promo, clientID, bookingID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockPromoCodeRedeemer)(nil).Redeem), ctx, promo, clientID, bookingID)
+}
+
+// Release mocks base method.
+func (m *MockPromoCodeRedeemer) Release(ctx context.Context, bookingID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, bookingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockPromoCodeRedeemerMockRecorder) Release(ctx, bookingID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockPromoCodeRedeemer)(nil).Release), ctx, bookingID)
+}
+
+// Validate mocks base method.
+func (m *MockPromoCodeRedeemer) Validate(ctx context.Context, code string, clientID int64, service *entity.ModelService) (*entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", ctx, code, clientID, service)
+	ret0, _ := ret[0].(*entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockPromoCodeRedeemerMockRecorder) Validate(ctx, code, clientID, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockPromoCodeRedeemer)(nil).Validate), ctx, code, clientID, service)
+}
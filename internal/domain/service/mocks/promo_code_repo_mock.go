@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: promo_code_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPromoCodeRepository is a mock of PromoCodeRepository interface.
+type MockPromoCodeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoCodeRepositoryMockRecorder
+}
+
+// MockPromoCodeRepositoryMockRecorder is the mock recorder for MockPromoCodeRepository.
+type MockPromoCodeRepositoryMockRecorder struct {
+	mock *MockPromoCodeRepository
+}
+
+// NewMockPromoCodeRepository creates a new mock instance.
+func NewMockPromoCodeRepository(ctrl *gomock.Controller) *MockPromoCodeRepository {
+	mock := &MockPromoCodeRepository{ctrl: ctrl}
+	mock.recorder = &MockPromoCodeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoCodeRepository) EXPECT() *MockPromoCodeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountActiveRedemptions mocks base method.
+func (m *MockPromoCodeRepository) CountActiveRedemptions(ctx context.Context, id, clientID int64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveRedemptions", ctx, id, clientID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveRedemptions indicates an expected call of CountActiveRedemptions.
+func (mr *MockPromoCodeRepositoryMockRecorder) CountActiveRedemptions(ctx, id, clientID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveRedemptions", reflect.TypeOf((*MockPromoCodeRepository)(nil).CountActiveRedemptions), ctx, id, clientID)
+}
+
+// GetAll mocks base method.
+func (m *MockPromoCodeRepository) GetAll(ctx context.Context, opts *entity.Options) ([]*entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, opts)
+	ret0, _ := ret[0].([]*entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockPromoCodeRepositoryMockRecorder) GetAll(ctx, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPromoCodeRepository)(nil).GetAll), ctx, opts)
+}
+
+// GetByCode mocks base method.
+func (m *MockPromoCodeRepository) GetByCode(ctx context.Context, code string) (*entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", ctx, code)
+	ret0, _ := ret[0].(*entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockPromoCodeRepositoryMockRecorder) GetByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockPromoCodeRepository)(nil).GetByCode), ctx, code)
+}
+
+// GetByID mocks base method.
+func (m *MockPromoCodeRepository) GetByID(ctx context.Context, id int64) (*entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPromoCodeRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPromoCodeRepository)(nil).GetByID), ctx, id)
+}
+
+// IncrementUsage mocks base method.
+func (m *MockPromoCodeRepository) IncrementUsage(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementUsage", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementUsage indicates an expected call of IncrementUsage.
+func (mr *MockPromoCodeRepositoryMockRecorder) IncrementUsage(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementUsage", reflect.TypeOf((*MockPromoCodeRepository)(nil).IncrementUsage), ctx, id)
+}
+
+// ReleaseRedemption mocks base method.
+func (m *MockPromoCodeRepository) ReleaseRedemption(ctx context.Context, bookingID int64, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseRedemption", ctx, bookingID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseRedemption indicates an expected call of ReleaseRedemption.
+func (mr *MockPromoCodeRepositoryMockRecorder) ReleaseRedemption(ctx, bookingID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseRedemption", reflect.TypeOf((*MockPromoCodeRepository)(nil).ReleaseRedemption), ctx, bookingID, now)
+}
+
+// Save mocks base method.
+func (m *MockPromoCodeRepository) Save(ctx context.Context, promo *entity.PromoCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, promo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPromoCodeRepositoryMockRecorder) Save(ctx, promo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPromoCodeRepository)(nil).Save), ctx, promo)
+}
+
+// SaveRedemption mocks base method.
+func (m *MockPromoCodeRepository) SaveRedemption(ctx context.Context, redemption *entity.PromoRedemption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRedemption", ctx, redemption)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRedemption indicates an expected call of SaveRedemption.
+func (mr *MockPromoCodeRepositoryMockRecorder) SaveRedemption(ctx, redemption interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRedemption", reflect.TypeOf((*MockPromoCodeRepository)(nil).SaveRedemption), ctx, redemption)
+}
+
+// Update mocks base method.
+func (m *MockPromoCodeRepository) Update(ctx context.Context, promo *entity.PromoCode) (*entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, promo)
+	ret0, _ := ret[0].(*entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPromoCodeRepositoryMockRecorder) Update(ctx, promo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPromoCodeRepository)(nil).Update), ctx, promo)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: quote_signer.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockQuoteSigner is a mock of QuoteSigner interface.
+type MockQuoteSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuoteSignerMockRecorder
+}
+
+// MockQuoteSignerMockRecorder is the mock recorder for MockQuoteSigner.
+type MockQuoteSignerMockRecorder struct {
+	mock *MockQuoteSigner
+}
+
+// NewMockQuoteSigner creates a new mock instance.
+func NewMockQuoteSigner(ctrl *gomock.Controller) *MockQuoteSigner {
+	mock := &MockQuoteSigner{ctrl: ctrl}
+	mock.recorder = &MockQuoteSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuoteSigner) EXPECT() *MockQuoteSignerMockRecorder {
+	return m.recorder
+}
+
+// Parse mocks base method.
+func (m *MockQuoteSigner) Parse(token string) (*entity.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parse", token)
+	ret0, _ := ret[0].(*entity.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MockQuoteSignerMockRecorder) Parse(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockQuoteSigner)(nil).Parse), token)
+}
+
+// Sign mocks base method.
+func (m *MockQuoteSigner) Sign(quote *entity.Quote) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", quote)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockQuoteSignerMockRecorder) Sign(quote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockQuoteSigner)(nil).Sign), quote)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: receipt_issuer.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReceiptIssuer is a mock of ReceiptIssuer interface.
+type MockReceiptIssuer struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceiptIssuerMockRecorder
+}
+
+// MockReceiptIssuerMockRecorder is the mock recorder for MockReceiptIssuer.
+type MockReceiptIssuerMockRecorder struct {
+	mock *MockReceiptIssuer
+}
+
+// NewMockReceiptIssuer creates a new mock instance.
+func NewMockReceiptIssuer(ctrl *gomock.Controller) *MockReceiptIssuer {
+	mock := &MockReceiptIssuer{ctrl: ctrl}
+	mock.recorder = &MockReceiptIssuerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceiptIssuer) EXPECT() *MockReceiptIssuerMockRecorder {
+	return m.recorder
+}
+
+// IssueReceipt mocks base method.
+func (m *MockReceiptIssuer) IssueReceipt(ctx context.Context, order *entity.Order, payment *entity.Payment) (*entity.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueReceipt", ctx, order, payment)
+	ret0, _ := ret[0].(*entity.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueReceipt indicates an expected call of IssueReceipt.
+func (mr *MockReceiptIssuerMockRecorder) IssueReceipt(ctx, order, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueReceipt", reflect.TypeOf((*MockReceiptIssuer)(nil).IssueReceipt), ctx, order, payment)
+}
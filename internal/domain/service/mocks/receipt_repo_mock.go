@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: receipt_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReceiptRepository is a mock of ReceiptRepository interface.
+type MockReceiptRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceiptRepositoryMockRecorder
+}
+
+// MockReceiptRepositoryMockRecorder is the mock recorder for MockReceiptRepository.
+type MockReceiptRepositoryMockRecorder struct {
+	mock *MockReceiptRepository
+}
+
+// NewMockReceiptRepository creates a new mock instance.
+func NewMockReceiptRepository(ctrl *gomock.Controller) *MockReceiptRepository {
+	mock := &MockReceiptRepository{ctrl: ctrl}
+	mock.recorder = &MockReceiptRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceiptRepository) EXPECT() *MockReceiptRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByOrderID mocks base method.
+func (m *MockReceiptRepository) GetByOrderID(ctx context.Context, orderID int64) (*entity.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOrderID", ctx, orderID)
+	ret0, _ := ret[0].(*entity.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOrderID indicates an expected call of GetByOrderID.
+func (mr *MockReceiptRepositoryMockRecorder) GetByOrderID(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOrderID", reflect.TypeOf((*MockReceiptRepository)(nil).GetByOrderID), ctx, orderID)
+}
+
+// Save mocks base method.
+func (m *MockReceiptRepository) Save(ctx context.Context, receipt *entity.Receipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, receipt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockReceiptRepositoryMockRecorder) Save(ctx, receipt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockReceiptRepository)(nil).Save), ctx, receipt)
+}
@@ -36,6 +36,66 @@ func (m *MockSlotRepository) EXPECT() *MockSlotRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BlockForImport mocks base method.
+func (m *MockSlotRepository) BlockForImport(ctx context.Context, ids []int64) ([]*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockForImport", ctx, ids)
+	ret0, _ := ret[0].([]*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockForImport indicates an expected call of BlockForImport.
+func (mr *MockSlotRepositoryMockRecorder) BlockForImport(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockForImport", reflect.TypeOf((*MockSlotRepository)(nil).BlockForImport), ctx, ids)
+}
+
+// DeleteUnbooked mocks base method.
+func (m *MockSlotRepository) DeleteUnbooked(ctx context.Context, ids []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnbooked", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUnbooked indicates an expected call of DeleteUnbooked.
+func (mr *MockSlotRepositoryMockRecorder) DeleteUnbooked(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnbooked", reflect.TypeOf((*MockSlotRepository)(nil).DeleteUnbooked), ctx, ids)
+}
+
+// DisableAvailable mocks base method.
+func (m *MockSlotRepository) DisableAvailable(ctx context.Context, ids []int64) ([]*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableAvailable", ctx, ids)
+	ret0, _ := ret[0].([]*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableAvailable indicates an expected call of DisableAvailable.
+func (mr *MockSlotRepositoryMockRecorder) DisableAvailable(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAvailable", reflect.TypeOf((*MockSlotRepository)(nil).DisableAvailable), ctx, ids)
+}
+
+// DisableForTimeOff mocks base method.
+func (m *MockSlotRepository) DisableForTimeOff(ctx context.Context, timeOffID int64, ids []int64) ([]*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableForTimeOff", ctx, timeOffID, ids)
+	ret0, _ := ret[0].([]*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableForTimeOff indicates an expected call of DisableForTimeOff.
+func (mr *MockSlotRepositoryMockRecorder) DisableForTimeOff(ctx, timeOffID, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableForTimeOff", reflect.TypeOf((*MockSlotRepository)(nil).DisableForTimeOff), ctx, timeOffID, ids)
+}
+
 // GetByID mocks base method.
 func (m *MockSlotRepository) GetByID(ctx context.Context, id int64) (*entity.Slot, error) {
 	m.ctrl.T.Helper()
@@ -51,19 +111,49 @@ func (mr *MockSlotRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSlotRepository)(nil).GetByID), ctx, id)
 }
 
+// GetByIDs mocks base method.
+func (m *MockSlotRepository) GetByIDs(ctx context.Context, ids []int64) ([]*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockSlotRepositoryMockRecorder) GetByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockSlotRepository)(nil).GetByIDs), ctx, ids)
+}
+
 // GetByModelID mocks base method.
-func (m *MockSlotRepository) GetByModelID(ctx context.Context, modelID int64) ([]*entity.Slot, error) {
+func (m *MockSlotRepository) GetByModelID(ctx context.Context, modelID int64, filter *entity.SlotFilter, opts *entity.Options) ([]*entity.Slot, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByModelID", ctx, modelID)
+	ret := m.ctrl.Call(m, "GetByModelID", ctx, modelID, filter, opts)
 	ret0, _ := ret[0].([]*entity.Slot)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByModelID indicates an expected call of GetByModelID.
-func (mr *MockSlotRepositoryMockRecorder) GetByModelID(ctx, modelID interface{}) *gomock.Call {
+func (mr *MockSlotRepositoryMockRecorder) GetByModelID(ctx, modelID, filter, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByModelID", reflect.TypeOf((*MockSlotRepository)(nil).GetByModelID), ctx, modelID, filter, opts)
+}
+
+// GetImportBlocked mocks base method.
+func (m *MockSlotRepository) GetImportBlocked(ctx context.Context, modelID int64, from time.Time) ([]*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportBlocked", ctx, modelID, from)
+	ret0, _ := ret[0].([]*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportBlocked indicates an expected call of GetImportBlocked.
+func (mr *MockSlotRepositoryMockRecorder) GetImportBlocked(ctx, modelID, from interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByModelID", reflect.TypeOf((*MockSlotRepository)(nil).GetByModelID), ctx, modelID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportBlocked", reflect.TypeOf((*MockSlotRepository)(nil).GetImportBlocked), ctx, modelID, from)
 }
 
 // GetOverlappingSlots mocks base method.
@@ -81,6 +171,66 @@ func (mr *MockSlotRepositoryMockRecorder) GetOverlappingSlots(ctx, modelID, star
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverlappingSlots", reflect.TypeOf((*MockSlotRepository)(nil).GetOverlappingSlots), ctx, modelID, start, end)
 }
 
+// HasBookings mocks base method.
+func (m *MockSlotRepository) HasBookings(ctx context.Context, ids []int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasBookings", ctx, ids)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasBookings indicates an expected call of HasBookings.
+func (mr *MockSlotRepositoryMockRecorder) HasBookings(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasBookings", reflect.TypeOf((*MockSlotRepository)(nil).HasBookings), ctx, ids)
+}
+
+// ReleaseImportBlock mocks base method.
+func (m *MockSlotRepository) ReleaseImportBlock(ctx context.Context, ids []int64) ([]*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseImportBlock", ctx, ids)
+	ret0, _ := ret[0].([]*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseImportBlock indicates an expected call of ReleaseImportBlock.
+func (mr *MockSlotRepositoryMockRecorder) ReleaseImportBlock(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseImportBlock", reflect.TypeOf((*MockSlotRepository)(nil).ReleaseImportBlock), ctx, ids)
+}
+
+// ReleaseTemplateSlots mocks base method.
+func (m *MockSlotRepository) ReleaseTemplateSlots(ctx context.Context, templateID int64, from time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseTemplateSlots", ctx, templateID, from)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseTemplateSlots indicates an expected call of ReleaseTemplateSlots.
+func (mr *MockSlotRepositoryMockRecorder) ReleaseTemplateSlots(ctx, templateID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseTemplateSlots", reflect.TypeOf((*MockSlotRepository)(nil).ReleaseTemplateSlots), ctx, templateID, from)
+}
+
+// ReleaseTimeOff mocks base method.
+func (m *MockSlotRepository) ReleaseTimeOff(ctx context.Context, timeOffID int64) ([]*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseTimeOff", ctx, timeOffID)
+	ret0, _ := ret[0].([]*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseTimeOff indicates an expected call of ReleaseTimeOff.
+func (mr *MockSlotRepositoryMockRecorder) ReleaseTimeOff(ctx, timeOffID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseTimeOff", reflect.TypeOf((*MockSlotRepository)(nil).ReleaseTimeOff), ctx, timeOffID)
+}
+
 // Save mocks base method.
 func (m *MockSlotRepository) Save(ctx context.Context, slot *entity.Slot) error {
 	m.ctrl.T.Helper()
@@ -95,6 +245,20 @@ func (mr *MockSlotRepositoryMockRecorder) Save(ctx, slot interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSlotRepository)(nil).Save), ctx, slot)
 }
 
+// SaveAll mocks base method.
+func (m *MockSlotRepository) SaveAll(ctx context.Context, slots []*entity.Slot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAll", ctx, slots)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAll indicates an expected call of SaveAll.
+func (mr *MockSlotRepositoryMockRecorder) SaveAll(ctx, slots interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAll", reflect.TypeOf((*MockSlotRepository)(nil).SaveAll), ctx, slots)
+}
+
 // Update mocks base method.
 func (m *MockSlotRepository) Update(ctx context.Context, slot *entity.Slot) (*entity.Slot, error) {
 	m.ctrl.T.Helper()
@@ -109,3 +273,18 @@ func (mr *MockSlotRepositoryMockRecorder) Update(ctx, slot interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSlotRepository)(nil).Update), ctx, slot)
 }
+
+// UpdateAvailable mocks base method.
+func (m *MockSlotRepository) UpdateAvailable(ctx context.Context, slot *entity.Slot) (*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAvailable", ctx, slot)
+	ret0, _ := ret[0].(*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAvailable indicates an expected call of UpdateAvailable.
+func (mr *MockSlotRepositoryMockRecorder) UpdateAvailable(ctx, slot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvailable", reflect.TypeOf((*MockSlotRepository)(nil).UpdateAvailable), ctx, slot)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: surcharge_calculator.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSurchargeCalculator is a mock of SurchargeCalculator interface.
+type MockSurchargeCalculator struct {
+	ctrl     *gomock.Controller
+	recorder *MockSurchargeCalculatorMockRecorder
+}
+
+// MockSurchargeCalculatorMockRecorder is the mock recorder for MockSurchargeCalculator.
+type MockSurchargeCalculatorMockRecorder struct {
+	mock *MockSurchargeCalculator
+}
+
+// NewMockSurchargeCalculator creates a new mock instance.
+func NewMockSurchargeCalculator(ctrl *gomock.Controller) *MockSurchargeCalculator {
+	mock := &MockSurchargeCalculator{ctrl: ctrl}
+	mock.recorder = &MockSurchargeCalculatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSurchargeCalculator) EXPECT() *MockSurchargeCalculatorMockRecorder {
+	return m.recorder
+}
+
+// Surcharges mocks base method.
+func (m *MockSurchargeCalculator) Surcharges(ctx context.Context, service *entity.ModelService, slot *entity.Slot) ([]entity.PriceComponent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Surcharges", ctx, service, slot)
+	ret0, _ := ret[0].([]entity.PriceComponent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Surcharges indicates an expected call of Surcharges.
+func (mr *MockSurchargeCalculatorMockRecorder) Surcharges(ctx, service, slot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Surcharges", reflect.TypeOf((*MockSurchargeCalculator)(nil).Surcharges), ctx, service, slot)
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: time_off_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTimeOffRepository is a mock of TimeOffRepository interface.
+type MockTimeOffRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeOffRepositoryMockRecorder
+}
+
+// MockTimeOffRepositoryMockRecorder is the mock recorder for MockTimeOffRepository.
+type MockTimeOffRepositoryMockRecorder struct {
+	mock *MockTimeOffRepository
+}
+
+// NewMockTimeOffRepository creates a new mock instance.
+func NewMockTimeOffRepository(ctrl *gomock.Controller) *MockTimeOffRepository {
+	mock := &MockTimeOffRepository{ctrl: ctrl}
+	mock.recorder = &MockTimeOffRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeOffRepository) EXPECT() *MockTimeOffRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockTimeOffRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTimeOffRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTimeOffRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockTimeOffRepository) GetByID(ctx context.Context, id int64) (*entity.TimeOff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.TimeOff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTimeOffRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTimeOffRepository)(nil).GetByID), ctx, id)
+}
+
+// GetByModelID mocks base method.
+func (m *MockTimeOffRepository) GetByModelID(ctx context.Context, modelID int64, from time.Time) ([]*entity.TimeOff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByModelID", ctx, modelID, from)
+	ret0, _ := ret[0].([]*entity.TimeOff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByModelID indicates an expected call of GetByModelID.
+func (mr *MockTimeOffRepositoryMockRecorder) GetByModelID(ctx, modelID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByModelID", reflect.TypeOf((*MockTimeOffRepository)(nil).GetByModelID), ctx, modelID, from)
+}
+
+// GetOverlapping mocks base method.
+func (m *MockTimeOffRepository) GetOverlapping(ctx context.Context, modelID int64, start, end time.Time) ([]*entity.TimeOff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverlapping", ctx, modelID, start, end)
+	ret0, _ := ret[0].([]*entity.TimeOff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverlapping indicates an expected call of GetOverlapping.
+func (mr *MockTimeOffRepositoryMockRecorder) GetOverlapping(ctx, modelID, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverlapping", reflect.TypeOf((*MockTimeOffRepository)(nil).GetOverlapping), ctx, modelID, start, end)
+}
+
+// Save mocks base method.
+func (m *MockTimeOffRepository) Save(ctx context.Context, timeOff *entity.TimeOff) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, timeOff)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTimeOffRepositoryMockRecorder) Save(ctx, timeOff interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTimeOffRepository)(nil).Save), ctx, timeOff)
+}
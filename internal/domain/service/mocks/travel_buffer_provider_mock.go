@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: travel_buffer_provider.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTravelBufferProvider is a mock of TravelBufferProvider interface.
+type MockTravelBufferProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockTravelBufferProviderMockRecorder
+}
+
+// MockTravelBufferProviderMockRecorder is the mock recorder for MockTravelBufferProvider.
+type MockTravelBufferProviderMockRecorder struct {
+	mock *MockTravelBufferProvider
+}
+
+// NewMockTravelBufferProvider creates a new mock instance.
+func NewMockTravelBufferProvider(ctrl *gomock.Controller) *MockTravelBufferProvider {
+	mock := &MockTravelBufferProvider{ctrl: ctrl}
+	mock.recorder = &MockTravelBufferProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTravelBufferProvider) EXPECT() *MockTravelBufferProviderMockRecorder {
+	return m.recorder
+}
+
+// BufferOf mocks base method.
+func (m *MockTravelBufferProvider) BufferOf(ctx context.Context, modelID int64) (*entity.TravelBuffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BufferOf", ctx, modelID)
+	ret0, _ := ret[0].(*entity.TravelBuffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BufferOf indicates an expected call of BufferOf.
+func (mr *MockTravelBufferProviderMockRecorder) BufferOf(ctx, modelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BufferOf", reflect.TypeOf((*MockTravelBufferProvider)(nil).BufferOf), ctx, modelID)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: travel_buffer_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTravelBufferRepository is a mock of TravelBufferRepository interface.
+type MockTravelBufferRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTravelBufferRepositoryMockRecorder
+}
+
+// MockTravelBufferRepositoryMockRecorder is the mock recorder for MockTravelBufferRepository.
+type MockTravelBufferRepositoryMockRecorder struct {
+	mock *MockTravelBufferRepository
+}
+
+// NewMockTravelBufferRepository creates a new mock instance.
+func NewMockTravelBufferRepository(ctrl *gomock.Controller) *MockTravelBufferRepository {
+	mock := &MockTravelBufferRepository{ctrl: ctrl}
+	mock.recorder = &MockTravelBufferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTravelBufferRepository) EXPECT() *MockTravelBufferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByModelID mocks base method.
+func (m *MockTravelBufferRepository) GetByModelID(ctx context.Context, modelID int64) (*entity.TravelBuffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByModelID", ctx, modelID)
+	ret0, _ := ret[0].(*entity.TravelBuffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByModelID indicates an expected call of GetByModelID.
+func (mr *MockTravelBufferRepositoryMockRecorder) GetByModelID(ctx, modelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByModelID", reflect.TypeOf((*MockTravelBufferRepository)(nil).GetByModelID), ctx, modelID)
+}
+
+// Save mocks base method.
+func (m *MockTravelBufferRepository) Save(ctx context.Context, buffer *entity.TravelBuffer) (*entity.TravelBuffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, buffer)
+	ret0, _ := ret[0].(*entity.TravelBuffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTravelBufferRepositoryMockRecorder) Save(ctx, buffer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTravelBufferRepository)(nil).Save), ctx, buffer)
+}
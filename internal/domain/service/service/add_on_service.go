@@ -0,0 +1,416 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type DefaultAddOnService struct {
+	addOnRepo        interfaces.AddOnRepository
+	modelServiceRepo interfaces.ModelServiceRepository
+	userRepo         interfaces.UserRepository
+	logger           pkg.Logger
+}
+
+func NewDefaultAddOnService(addOnRepo interfaces.AddOnRepository, modelServiceRepo interfaces.ModelServiceRepository,
+	userRepo interfaces.UserRepository, logger pkg.Logger) *DefaultAddOnService {
+	return &DefaultAddOnService{
+		addOnRepo:        addOnRepo,
+		modelServiceRepo: modelServiceRepo,
+		userRepo:         userRepo,
+		logger:           logger,
+	}
+}
+
+func (d *DefaultAddOnService) CreateAddOn(ctx context.Context, serviceID int64, title, description string,
+	price entity.Money, extraMinutes int) (*entity.AddOn, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.checkPayloadRestrictions(price, description, extraMinutes); err != nil {
+		d.logger.Error(ctx, "check payload failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := d.getOwnService(ctx, serviceID, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !service.IsActive {
+		d.logger.Error(ctx, "model service is not active",
+			option.Any("service_id", serviceID),
+			option.Error(service_errors.ErrServiceIsNotActive))
+
+		return nil, service_errors.ErrServiceIsNotActive
+	}
+
+	addOn := entity.NewAddOn(serviceID, title, description, price, extraMinutes)
+	if err = d.addOnRepo.Save(ctx, addOn); err != nil {
+		d.logger.Error(ctx, "save add-on failed",
+			option.Any("service_id", serviceID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return addOn, nil
+}
+
+func (d *DefaultAddOnService) GetAddOnsByServiceID(ctx context.Context, serviceID int64) ([]*entity.AddOn, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = d.getOwnService(ctx, serviceID, model.ID); err != nil {
+		return nil, err
+	}
+
+	return d.getAddOns(ctx, serviceID, true)
+}
+
+// GetActiveAddOns lists the add-ons a client can choose when booking the service.
+func (d *DefaultAddOnService) GetActiveAddOns(ctx context.Context, serviceID int64) ([]*entity.AddOn, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.checkClientRestrictions(ctx, authID); err != nil {
+		return nil, err
+	}
+
+	if _, err = d.modelServiceRepo.GetByID(ctx, serviceID, false); err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model service not found by id",
+				option.Any("service_id", serviceID),
+				option.Error(service_errors.ErrServiceIsNotFound))
+
+			return nil, service_errors.ErrServiceIsNotFound
+		}
+
+		d.logger.Error(ctx, "get model service failed",
+			option.Any("service_id", serviceID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return d.getAddOns(ctx, serviceID, false)
+}
+
+// UpdateAddOn changes the add-on in place, the bookings keep the copy of the add-on made at booking time.
+func (d *DefaultAddOnService) UpdateAddOn(ctx context.Context, id int64, title, description *string,
+	price *entity.Money, extraMinutes *int) (*entity.AddOn, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	addOn, err := d.getOwnAddOn(ctx, id, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !addOn.IsActive {
+		d.logger.Error(ctx, "add-on is not active",
+			option.Any("add_on_id", id),
+			option.Error(service_errors.ErrAddOnIsNotActive))
+
+		return nil, service_errors.ErrAddOnIsNotActive
+	}
+
+	if title != nil {
+		addOn.Title = *title
+	}
+	if description != nil {
+		addOn.Description = *description
+	}
+	if price != nil {
+		addOn.Price = *price
+	}
+	if extraMinutes != nil {
+		addOn.ExtraMinutes = *extraMinutes
+	}
+
+	if err = d.checkPayloadRestrictions(addOn.Price, addOn.Description, addOn.ExtraMinutes); err != nil {
+		d.logger.Error(ctx, "check payload failed",
+			option.Any("add_on_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := d.addOnRepo.Update(ctx, addOn)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "add-on not found by id",
+				option.Any("add_on_id", id),
+				option.Error(service_errors.ErrAddOnNotFound))
+
+			return nil, service_errors.ErrAddOnNotFound
+		}
+
+		d.logger.Error(ctx, "update add-on failed",
+			option.Any("add_on_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultAddOnService) DeactivateAddOn(ctx context.Context, id int64) (*entity.AddOn, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	addOn, err := d.getOwnAddOn(ctx, id, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !addOn.IsActive {
+		d.logger.Error(ctx, "add-on is not active",
+			option.Any("add_on_id", id),
+			option.Error(service_errors.ErrAddOnIsNotActive))
+
+		return nil, service_errors.ErrAddOnIsNotActive
+	}
+
+	if err = d.addOnRepo.Deactivate(ctx, id); err != nil {
+		if errors.Is(err, persistence.ErrNoRowsAffected) {
+			d.logger.Error(ctx, "add-on has not been deactivated",
+				option.Any("add_on_id", id),
+				option.Error(service_errors.ErrAddOnNotFound))
+
+			return nil, service_errors.ErrAddOnNotFound
+		}
+
+		d.logger.Error(ctx, "deactivate add-on failed",
+			option.Any("add_on_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	addOn.IsActive = false
+
+	return addOn, nil
+}
+
+func (d *DefaultAddOnService) getAddOns(ctx context.Context, serviceID int64,
+	includeInactive bool) ([]*entity.AddOn, error) {
+
+	res, err := d.addOnRepo.GetByServiceID(ctx, serviceID, includeInactive)
+	if err != nil {
+		d.logger.Error(ctx, "get add-ons failed",
+			option.Any("service_id", serviceID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultAddOnService) getOwnAddOn(ctx context.Context, id, modelID int64) (*entity.AddOn, error) {
+	addOn, err := d.addOnRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "add-on not found by id",
+				option.Any("add_on_id", id),
+				option.Error(service_errors.ErrAddOnNotFound))
+
+			return nil, service_errors.ErrAddOnNotFound
+		}
+
+		d.logger.Error(ctx, "get add-on failed",
+			option.Any("add_on_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if _, err = d.getOwnService(ctx, addOn.ModelServiceID, modelID); err != nil {
+		return nil, err
+	}
+
+	return addOn, nil
+}
+
+func (d *DefaultAddOnService) getOwnService(ctx context.Context,
+	serviceID, modelID int64) (*entity.ModelService, error) {
+
+	service, err := d.modelServiceRepo.GetByID(ctx, serviceID, true)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model service not found by id",
+				option.Any("service_id", serviceID),
+				option.Error(service_errors.ErrServiceIsNotFound))
+
+			return nil, service_errors.ErrServiceIsNotFound
+		}
+
+		d.logger.Error(ctx, "get model service failed",
+			option.Any("service_id", serviceID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if service.ModelID != modelID {
+		d.logger.Error(ctx, "model service is not owned by this model",
+			option.Any("model_id", modelID),
+			option.Any("service_id", serviceID),
+			option.Error(service_errors.ErrModelIsNotAnOwnerOfService))
+
+		return nil, service_errors.ErrModelIsNotAnOwnerOfService
+	}
+
+	return service, nil
+}
+
+func (d *DefaultAddOnService) checkPayloadRestrictions(price entity.Money, description string,
+	extraMinutes int) error {
+
+	if !price.IsPositive() || price.Amount > entity.MaxAmount {
+		return service_errors.ErrInvalidPrice
+	}
+
+	if price.Currency != entity.DefaultCurrency {
+		return service_errors.ErrUnsupportedCurrency
+	}
+
+	if len(description) > 1000 {
+		return service_errors.ErrDescriptionTooLong
+	}
+
+	if extraMinutes < 0 || extraMinutes > entity.MinutesInDay {
+		return service_errors.ErrInvalidAddOnDuration
+	}
+
+	return nil
+}
+
+func (d *DefaultAddOnService) checkClientRestrictions(ctx context.Context, authID *int64) error {
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *role != entity.RoleClient.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotClient))
+
+		return service_errors.ErrNotClient
+	}
+
+	client, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "client is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotClient))
+
+			return service_errors.ErrNotClient
+		}
+
+		d.logger.Error(ctx, "check client restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return err
+	}
+
+	if !client.IsUserVerified() {
+		d.logger.Error(ctx, "client is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedClient))
+
+		return service_errors.ErrNotVerifiedClient
+	}
+
+	return nil
+}
+
+func (d *DefaultAddOnService) checkModelRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleModel.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAModel))
+
+		return nil, service_errors.ErrNotAModel
+	}
+
+	model, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotAModel))
+
+			return nil, service_errors.ErrNotAModel
+		}
+
+		d.logger.Error(ctx, "check model restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !model.IsUserVerified() {
+		d.logger.Error(ctx, "model is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedModel))
+
+		return nil, service_errors.ErrNotVerifiedModel
+	}
+
+	return model, nil
+}
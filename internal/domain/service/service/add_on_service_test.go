@@ -0,0 +1,426 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type addOnServiceTest struct {
+	ctrl             *gomock.Controller
+	addOnRepo        *mocks.MockAddOnRepository
+	modelServiceRepo *mocks.MockModelServiceRepository
+	userRepo         *mocks.MockUserRepository
+	service          *DefaultAddOnService
+}
+
+func setUpAddOnServiceTest(t *testing.T) *addOnServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	addOnRepo := mocks.NewMockAddOnRepository(ctrl)
+	modelServiceRepo := mocks.NewMockModelServiceRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &addOnServiceTest{
+		ctrl:             ctrl,
+		addOnRepo:        addOnRepo,
+		modelServiceRepo: modelServiceRepo,
+		userRepo:         userRepo,
+		service:          NewDefaultAddOnService(addOnRepo, modelServiceRepo, userRepo, log),
+	}
+}
+
+func TestAddOnService_CreateAddOn(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(2))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		price          entity.Money
+		extraMinutes   int
+		mockModel      *entity.User
+		mockService    *entity.ModelService
+		mockServiceErr error
+		expectSave     bool
+		mockSaveErr    error
+		expectedError  error
+	}{
+		{
+			name:         "add-on is created",
+			ctx:          ctxModel,
+			price:        rub(500),
+			extraMinutes: 30,
+			mockModel:    verifiedModel,
+			mockService:  &entity.ModelService{ID: 1, ModelID: 5, IsActive: true},
+			expectSave:   true,
+		},
+		{
+			name:          "failed to save",
+			ctx:           ctxModel,
+			price:         rub(500),
+			mockModel:     verifiedModel,
+			mockService:   &entity.ModelService{ID: 1, ModelID: 5, IsActive: true},
+			expectSave:    true,
+			mockSaveErr:   errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+		{
+			name:          "price is not positive",
+			ctx:           ctxModel,
+			price:         rub(0),
+			expectedError: service_errors.ErrInvalidPrice,
+		},
+		{
+			name:          "price is over the column precision",
+			ctx:           ctxModel,
+			price:         rub(10000000),
+			expectedError: service_errors.ErrInvalidPrice,
+		},
+		{
+			name:          "extra time is over a day",
+			ctx:           ctxModel,
+			price:         rub(500),
+			extraMinutes:  entity.MinutesInDay + 1,
+			expectedError: service_errors.ErrInvalidAddOnDuration,
+		},
+		{
+			name:           "service not found",
+			ctx:            ctxModel,
+			price:          rub(500),
+			mockModel:      verifiedModel,
+			mockServiceErr: persistence.ErrNoRowsFound,
+			expectedError:  service_errors.ErrServiceIsNotFound,
+		},
+		{
+			name:          "service of another model",
+			ctx:           ctxModel,
+			price:         rub(500),
+			mockModel:     verifiedModel,
+			mockService:   &entity.ModelService{ID: 1, ModelID: 6, IsActive: true},
+			expectedError: service_errors.ErrModelIsNotAnOwnerOfService,
+		},
+		{
+			name:          "service is not active",
+			ctx:           ctxModel,
+			price:         rub(500),
+			mockModel:     verifiedModel,
+			mockService:   &entity.ModelService{ID: 1, ModelID: 5, IsActive: false},
+			expectedError: service_errors.ErrServiceIsNotActive,
+		},
+		{
+			name:          "not a model",
+			ctx:           ctxClient,
+			price:         rub(500),
+			expectedError: service_errors.ErrNotAModel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpAddOnServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.mockModel != nil {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), tt.mockModel.AuthID).
+					Return(tt.mockModel, nil).
+					Times(1)
+
+				test.modelServiceRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1), true).
+					Return(tt.mockService, tt.mockServiceErr).
+					Times(1)
+			}
+
+			if tt.expectSave {
+				test.addOnRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(tt.mockSaveErr).
+					Times(1)
+			}
+
+			res, err := test.service.CreateAddOn(tt.ctx, 1, "Makeup", "", tt.price, tt.extraMinutes)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), res.ModelServiceID)
+				assert.Equal(t, tt.extraMinutes, res.ExtraMinutes)
+				assert.True(t, res.IsActive)
+			}
+		})
+	}
+}
+
+func TestAddOnService_UpdateAddOn(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+	ownService := &entity.ModelService{ID: 1, ModelID: 5, IsActive: true}
+
+	newPrice := rub(700)
+	newMinutes := 45
+
+	tests := []struct {
+		name          string
+		mockAddOn     *entity.AddOn
+		mockAddOnErr  error
+		mockService   *entity.ModelService
+		expectUpdate  bool
+		mockUpdateErr error
+		expectedError error
+	}{
+		{
+			name:         "add-on is updated",
+			mockAddOn:    &entity.AddOn{ID: 3, ModelServiceID: 1, Title: "Makeup", Price: rub(500), IsActive: true},
+			mockService:  ownService,
+			expectUpdate: true,
+		},
+		{
+			name:          "add-on not found",
+			mockAddOnErr:  persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrAddOnNotFound,
+		},
+		{
+			name:          "add-on of another model",
+			mockAddOn:     &entity.AddOn{ID: 3, ModelServiceID: 1, Price: rub(500), IsActive: true},
+			mockService:   &entity.ModelService{ID: 1, ModelID: 6, IsActive: true},
+			expectedError: service_errors.ErrModelIsNotAnOwnerOfService,
+		},
+		{
+			name:          "add-on is not active",
+			mockAddOn:     &entity.AddOn{ID: 3, ModelServiceID: 1, Price: rub(500), IsActive: false},
+			mockService:   ownService,
+			expectedError: service_errors.ErrAddOnIsNotActive,
+		},
+		{
+			name:          "add-on is deleted concurrently",
+			mockAddOn:     &entity.AddOn{ID: 3, ModelServiceID: 1, Price: rub(500), IsActive: true},
+			mockService:   ownService,
+			expectUpdate:  true,
+			mockUpdateErr: persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrAddOnNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpAddOnServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+				Return(verifiedModel, nil).
+				Times(1)
+
+			test.addOnRepo.EXPECT().
+				GetByID(gomock.Any(), int64(3)).
+				Return(tt.mockAddOn, tt.mockAddOnErr).
+				Times(1)
+
+			if tt.mockService != nil {
+				test.modelServiceRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1), true).
+					Return(tt.mockService, nil).
+					Times(1)
+			}
+
+			if tt.expectUpdate {
+				test.addOnRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, a *entity.AddOn) (*entity.AddOn, error) {
+						if tt.mockUpdateErr != nil {
+							return nil, tt.mockUpdateErr
+						}
+
+						return a, nil
+					}).
+					Times(1)
+			}
+
+			res, err := test.service.UpdateAddOn(ctxModel, 3, nil, nil, &newPrice, &newMinutes)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Makeup", res.Title)
+				assert.Equal(t, newPrice, res.Price)
+				assert.Equal(t, newMinutes, res.ExtraMinutes)
+			}
+		})
+	}
+}
+
+func TestAddOnService_DeactivateAddOn(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+
+	tests := []struct {
+		name              string
+		mockAddOn         *entity.AddOn
+		expectDeactivate  bool
+		mockDeactivateErr error
+		expectedError     error
+	}{
+		{
+			name:             "add-on is deactivated",
+			mockAddOn:        &entity.AddOn{ID: 3, ModelServiceID: 1, IsActive: true},
+			expectDeactivate: true,
+		},
+		{
+			name:          "add-on is already inactive",
+			mockAddOn:     &entity.AddOn{ID: 3, ModelServiceID: 1, IsActive: false},
+			expectedError: service_errors.ErrAddOnIsNotActive,
+		},
+		{
+			name:              "add-on is deleted concurrently",
+			mockAddOn:         &entity.AddOn{ID: 3, ModelServiceID: 1, IsActive: true},
+			expectDeactivate:  true,
+			mockDeactivateErr: persistence.ErrNoRowsAffected,
+			expectedError:     service_errors.ErrAddOnNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpAddOnServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+				Return(verifiedModel, nil).
+				Times(1)
+
+			test.addOnRepo.EXPECT().
+				GetByID(gomock.Any(), int64(3)).
+				Return(tt.mockAddOn, nil).
+				Times(1)
+
+			test.modelServiceRepo.EXPECT().
+				GetByID(gomock.Any(), int64(1), true).
+				Return(&entity.ModelService{ID: 1, ModelID: 5, IsActive: true}, nil).
+				Times(1)
+
+			if tt.expectDeactivate {
+				test.addOnRepo.EXPECT().
+					Deactivate(gomock.Any(), int64(3)).
+					Return(tt.mockDeactivateErr).
+					Times(1)
+			}
+
+			res, err := test.service.DeactivateAddOn(ctxModel, 3)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.False(t, res.IsActive)
+			}
+		})
+	}
+}
+
+func TestAddOnService_GetActiveAddOns(t *testing.T) {
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(2))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedClient := &entity.User{ID: 3, AuthID: 2, IsVerified: true}
+	addOns := []*entity.AddOn{
+		{ID: 3, ModelServiceID: 1, Title: "Makeup", Price: rub(500), IsActive: true},
+	}
+
+	tests := []struct {
+		name           string
+		mockClient     *entity.User
+		mockServiceErr error
+		expectedError  error
+	}{
+		{
+			name:       "active add-ons are listed",
+			mockClient: verifiedClient,
+		},
+		{
+			name:           "service is not active",
+			mockClient:     verifiedClient,
+			mockServiceErr: persistence.ErrNoRowsFound,
+			expectedError:  service_errors.ErrServiceIsNotFound,
+		},
+		{
+			name:          "client is not verified",
+			mockClient:    &entity.User{ID: 3, AuthID: 2, IsVerified: false},
+			expectedError: service_errors.ErrNotVerifiedClient,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpAddOnServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), tt.mockClient.AuthID).
+				Return(tt.mockClient, nil).
+				Times(1)
+
+			if tt.mockClient.IsVerified {
+				test.modelServiceRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1), false).
+					Return(&entity.ModelService{ID: 1, IsActive: true}, tt.mockServiceErr).
+					Times(1)
+			}
+
+			if tt.expectedError == nil {
+				test.addOnRepo.EXPECT().
+					GetByServiceID(gomock.Any(), int64(1), false).
+					Return(addOns, nil).
+					Times(1)
+			}
+
+			res, err := test.service.GetActiveAddOns(ctxClient, 1)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, addOns, res)
+			}
+		})
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
-	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
 	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
@@ -20,19 +19,22 @@ type DefaultAdminService struct {
 	userRepo    interfaces.UserRepository
 	bookingRepo interfaces.BookingRepository
 	orderRepo   interfaces.OrderRepository
-	txManager   database.TxManager
+	eventBroker interfaces.OrderEventBroker
+	orders      interfaces.OrderFinisher
 	logger      pkg.Logger
 }
 
 func NewDefaultAdminService(adminRepo interfaces.AdminRepository, userRepo interfaces.UserRepository,
 	bookingRepo interfaces.BookingRepository, orderRepo interfaces.OrderRepository,
-	txManager database.TxManager, logger pkg.Logger) *DefaultAdminService {
+	eventBroker interfaces.OrderEventBroker, orders interfaces.OrderFinisher,
+	logger pkg.Logger) *DefaultAdminService {
 	return &DefaultAdminService{
 		adminRepo:   adminRepo,
 		userRepo:    userRepo,
 		bookingRepo: bookingRepo,
 		orderRepo:   orderRepo,
-		txManager:   txManager,
+		eventBroker: eventBroker,
+		orders:      orders,
 		logger:      logger,
 	}
 }
@@ -190,6 +192,7 @@ func (d *DefaultAdminService) VerifyUser(ctx context.Context, userID int64) (*en
 	return res, nil
 }
 
+// GetBookingByID falls back to the archive when the booking is not among the live ones.
 func (d *DefaultAdminService) GetBookingByID(ctx context.Context, bookingID int64) (*entity.Booking, error) {
 	authID, err := common.GetAuthIDFromContext(ctx)
 	if err != nil {
@@ -202,6 +205,9 @@ func (d *DefaultAdminService) GetBookingByID(ctx context.Context, bookingID int6
 	}
 
 	booking, err := d.bookingRepo.GetByID(ctx, bookingID)
+	if errors.Is(err, persistence.ErrNoRowsFound) {
+		booking, err = d.bookingRepo.GetArchivedByID(ctx, bookingID)
+	}
 	if err != nil {
 		if errors.Is(err, persistence.ErrNoRowsFound) {
 			d.logger.Error(ctx, "booking not found by id",
@@ -272,6 +278,7 @@ func (d *DefaultAdminService) UpdateBookingStatus(ctx context.Context,
 	return res, nil
 }
 
+// GetOrderByID falls back to the archive when the order is not among the live ones.
 func (d *DefaultAdminService) GetOrderByID(ctx context.Context, orderID int64) (*entity.Order, error) {
 	authID, err := common.GetAuthIDFromContext(ctx)
 	if err != nil {
@@ -284,6 +291,9 @@ func (d *DefaultAdminService) GetOrderByID(ctx context.Context, orderID int64) (
 	}
 
 	order, err := d.orderRepo.GetByID(ctx, orderID)
+	if errors.Is(err, persistence.ErrNoRowsFound) {
+		order, err = d.orderRepo.GetArchivedByID(ctx, orderID)
+	}
 	if err != nil {
 		if errors.Is(err, persistence.ErrNoRowsFound) {
 			d.logger.Error(ctx, "order not found by id",
@@ -333,8 +343,25 @@ func (d *DefaultAdminService) UpdateOrderStatus(ctx context.Context,
 		return nil, err
 	}
 
-	order.Status = status
+	if !order.IsCorrectAdminTransition(status) {
+		d.logger.Error(ctx, "not correct order status transition",
+			option.Any("order_id", orderID),
+			option.Any("status", order.Status),
+			option.Any("next_status", status),
+			option.Error(service_errors.ErrInvalidOrderStatusTransition))
+
+		return nil, service_errors.ErrInvalidOrderStatusTransition
+	}
+
+	// the final statuses move the money, so they go through the order flow with all its side effects
+	switch status {
+	case entity.OrderCancelled:
+		return d.orders.CancelOrderByAdmin(ctx, order)
+	case entity.OrderCompleted:
+		return d.orders.ConfirmOrderByAdmin(ctx, order)
+	}
 
+	order.Status = status
 	res, err := d.orderRepo.UpdateStatus(ctx, order)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNoRowsFound) {
@@ -352,6 +379,8 @@ func (d *DefaultAdminService) UpdateOrderStatus(ctx context.Context,
 		return nil, err
 	}
 
+	d.eventBroker.Publish(ctx, entity.NewOrderStatusEvent(res))
+
 	return res, nil
 }
 
@@ -379,7 +408,9 @@ func (d *DefaultAdminService) GetAllUsers(ctx context.Context, page, limit *int6
 	return res, nil
 }
 
-func (d *DefaultAdminService) GetAllBookings(ctx context.Context, page, limit *int64) ([]*entity.Booking, error) {
+// GetAllBookings lists the live bookings, or the ones the retention job has archived when archived is set.
+func (d *DefaultAdminService) GetAllBookings(ctx context.Context, page, limit *int64,
+	archived *bool) ([]*entity.Booking, error) {
 	authID, err := common.GetAuthIDFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -390,11 +421,17 @@ func (d *DefaultAdminService) GetAllBookings(ctx context.Context, page, limit *i
 		return nil, err
 	}
 
-	res, err := d.bookingRepo.GetAll(ctx, entity.NewOptions(common.CheckPagination(page, limit)))
+	getAll := d.bookingRepo.GetAll
+	if archived != nil && *archived {
+		getAll = d.bookingRepo.GetAllArchived
+	}
+
+	res, err := getAll(ctx, entity.NewOptions(common.CheckPagination(page, limit)))
 	if err != nil {
 		d.logger.Error(ctx, "failed to get all bookings",
 			option.Any("page", page),
 			option.Any("limit", limit),
+			option.Any("archived", archived),
 			option.Error(err))
 
 		return nil, err
@@ -403,7 +440,9 @@ func (d *DefaultAdminService) GetAllBookings(ctx context.Context, page, limit *i
 	return res, nil
 }
 
-func (d *DefaultAdminService) GetAllOrders(ctx context.Context, page, limit *int64) ([]*entity.Order, error) {
+// GetAllOrders lists the live orders, or the ones the retention job has archived when archived is set.
+func (d *DefaultAdminService) GetAllOrders(ctx context.Context, page, limit *int64,
+	archived *bool) ([]*entity.Order, error) {
 	authID, err := common.GetAuthIDFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -414,11 +453,17 @@ func (d *DefaultAdminService) GetAllOrders(ctx context.Context, page, limit *int
 		return nil, err
 	}
 
-	res, err := d.orderRepo.GetAll(ctx, entity.NewOptions(common.CheckPagination(page, limit)))
+	getAll := d.orderRepo.GetAll
+	if archived != nil && *archived {
+		getAll = d.orderRepo.GetAllArchived
+	}
+
+	res, err := getAll(ctx, entity.NewOptions(common.CheckPagination(page, limit)))
 	if err != nil {
 		d.logger.Error(ctx, "failed to get all orders",
 			option.Any("page", page),
 			option.Any("limit", limit),
+			option.Any("archived", archived),
 			option.Error(err))
 
 		return nil, err
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
@@ -23,8 +24,9 @@ type adminServiceTest struct {
 	userRepo    *mocks.MockUserRepository
 	bookingRepo *mocks.MockBookingRepository
 	orderRepo   *mocks.MockOrderRepository
+	eventBroker *mocks.MockOrderEventBroker
+	orders      *mocks.MockOrderFinisher
 	service     *DefaultAdminService
-	txManager   *mocks.MockTxManager
 }
 
 func setUpAdminServiceTest(t *testing.T) *adminServiceTest {
@@ -35,7 +37,8 @@ func setUpAdminServiceTest(t *testing.T) *adminServiceTest {
 	user := mocks.NewMockUserRepository(ctrl)
 	booking := mocks.NewMockBookingRepository(ctrl)
 	order := mocks.NewMockOrderRepository(ctrl)
-	mockTxManager := mocks.NewMockTxManager(ctrl)
+	eventBroker := mocks.NewMockOrderEventBroker(ctrl)
+	orders := mocks.NewMockOrderFinisher(ctrl)
 
 	cfg := &config.LogConfig{}
 	cfg.Logger.Level = "info"
@@ -47,7 +50,7 @@ func setUpAdminServiceTest(t *testing.T) *adminServiceTest {
 		t.Fatal(err)
 	}
 
-	adminService := NewDefaultAdminService(admin, user, booking, order, mockTxManager, log)
+	adminService := NewDefaultAdminService(admin, user, booking, order, eventBroker, orders, log)
 
 	return &adminServiceTest{
 		ctrl:        ctrl,
@@ -55,8 +58,9 @@ func setUpAdminServiceTest(t *testing.T) *adminServiceTest {
 		userRepo:    user,
 		bookingRepo: booking,
 		orderRepo:   order,
+		eventBroker: eventBroker,
+		orders:      orders,
 		service:     adminService,
-		txManager:   mockTxManager,
 	}
 }
 
@@ -375,14 +379,24 @@ func TestAdminService_GetBookingByID(t *testing.T) {
 		ModelServiceID: 3,
 		Status:         entity.BookingPending,
 	}
+	archivedAt := time.Now()
+	archivedBooking := &entity.Booking{
+		ID:             4,
+		ClientID:       2,
+		ModelServiceID: 3,
+		Status:         entity.BookingExpired,
+		ArchivedAt:     &archivedAt,
+	}
 
 	tests := []struct {
-		name          string
-		ctx           context.Context
-		bookingID     int64
-		mockBooking   *entity.Booking
-		mockErr       error
-		expectedError error
+		name            string
+		ctx             context.Context
+		bookingID       int64
+		mockBooking     *entity.Booking
+		mockErr         error
+		mockArchived    *entity.Booking
+		mockArchivedErr error
+		expectedError   error
 	}{
 		{
 			name:        "found booking",
@@ -391,11 +405,19 @@ func TestAdminService_GetBookingByID(t *testing.T) {
 			mockBooking: booking,
 		},
 		{
-			name:          "booking not found",
-			ctx:           ctxAdmin,
-			bookingID:     2,
-			mockErr:       persistence.ErrNoRowsFound,
-			expectedError: service_errors.ErrBookingNotFound,
+			name:         "found archived booking",
+			ctx:          ctxAdmin,
+			bookingID:    4,
+			mockErr:      persistence.ErrNoRowsFound,
+			mockArchived: archivedBooking,
+		},
+		{
+			name:            "booking not found",
+			ctx:             ctxAdmin,
+			bookingID:       2,
+			mockErr:         persistence.ErrNoRowsFound,
+			mockArchivedErr: persistence.ErrNoRowsFound,
+			expectedError:   service_errors.ErrBookingNotFound,
 		},
 		{
 			name:          "repo error",
@@ -415,15 +437,27 @@ func TestAdminService_GetBookingByID(t *testing.T) {
 					Times(1)
 			}
 
+			if errors.Is(tt.mockErr, persistence.ErrNoRowsFound) {
+				test.bookingRepo.EXPECT().
+					GetArchivedByID(gomock.Any(), tt.bookingID).
+					Return(tt.mockArchived, tt.mockArchivedErr).
+					Times(1)
+			}
+
 			result, err := test.service.GetBookingByID(tt.ctx, tt.bookingID)
 
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
 				assert.Nil(t, result)
 			} else {
+				expected := tt.mockBooking
+				if tt.mockArchived != nil {
+					expected = tt.mockArchived
+				}
+
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
-				assert.Equal(t, tt.mockBooking, result)
+				assert.Equal(t, expected, result)
 			}
 		})
 	}
@@ -527,14 +561,22 @@ func TestAdminService_GetOrderByID(t *testing.T) {
 		ID:     1,
 		Status: entity.OrderInTransit,
 	}
+	archivedAt := time.Now()
+	archivedOrder := &entity.Order{
+		ID:         4,
+		Status:     entity.OrderCancelled,
+		ArchivedAt: &archivedAt,
+	}
 
 	tests := []struct {
-		name          string
-		ctx           context.Context
-		orderID       int64
-		mockOrder     *entity.Order
-		mockErr       error
-		expectedError error
+		name            string
+		ctx             context.Context
+		orderID         int64
+		mockOrder       *entity.Order
+		mockErr         error
+		mockArchived    *entity.Order
+		mockArchivedErr error
+		expectedError   error
 	}{
 		{
 			name:      "successful get order",
@@ -543,11 +585,19 @@ func TestAdminService_GetOrderByID(t *testing.T) {
 			mockOrder: order,
 		},
 		{
-			name:          "order not found",
-			ctx:           ctxAdmin,
-			orderID:       2,
-			mockErr:       persistence.ErrNoRowsFound,
-			expectedError: service_errors.ErrOrderNotFound,
+			name:         "successful get archived order",
+			ctx:          ctxAdmin,
+			orderID:      4,
+			mockErr:      persistence.ErrNoRowsFound,
+			mockArchived: archivedOrder,
+		},
+		{
+			name:            "order not found",
+			ctx:             ctxAdmin,
+			orderID:         2,
+			mockErr:         persistence.ErrNoRowsFound,
+			mockArchivedErr: persistence.ErrNoRowsFound,
+			expectedError:   service_errors.ErrOrderNotFound,
 		},
 		{
 			name:          "repo error",
@@ -573,15 +623,27 @@ func TestAdminService_GetOrderByID(t *testing.T) {
 					Times(1)
 			}
 
+			if errors.Is(tt.mockErr, persistence.ErrNoRowsFound) {
+				test.orderRepo.EXPECT().
+					GetArchivedByID(gomock.Any(), tt.orderID).
+					Return(tt.mockArchived, tt.mockArchivedErr).
+					Times(1)
+			}
+
 			result, err := test.service.GetOrderByID(tt.ctx, tt.orderID)
 
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
 				assert.Nil(t, result)
 			} else {
+				expected := tt.mockOrder
+				if tt.mockArchived != nil {
+					expected = tt.mockArchived
+				}
+
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
-				assert.Equal(t, tt.mockOrder, result)
+				assert.Equal(t, expected, result)
 			}
 		})
 	}
@@ -597,16 +659,6 @@ func TestAdminService_UpdateOrderStatus(t *testing.T) {
 	ctxNotAdmin := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
 	ctxNotAdmin = context.WithValue(ctxNotAdmin, service_const.RoleKey, "USER")
 
-	existingOrder := &entity.Order{
-		ID:     1,
-		Status: entity.OrderInTransit,
-	}
-
-	updatedOrder := &entity.Order{
-		ID:     1,
-		Status: entity.OrderCompleted,
-	}
-
 	tests := []struct {
 		name          string
 		ctx           context.Context
@@ -614,17 +666,62 @@ func TestAdminService_UpdateOrderStatus(t *testing.T) {
 		status        entity.OrderStatus
 		mockGetOrder  *entity.Order
 		mockGetErr    error
-		mockUpdateRes *entity.Order
+		expectUpdate  bool
 		mockUpdateErr error
+		expectConfirm bool
+		expectCancel  bool
+		mockFinishErr error
 		expectedError error
 	}{
 		{
-			name:          "successful order status update",
+			name:         "successful order status update",
+			ctx:          ctxAdmin,
+			orderID:      1,
+			status:       entity.OrderInTransit,
+			mockGetOrder: &entity.Order{ID: 1, Status: entity.OrderConfirmed},
+			expectUpdate: true,
+		},
+		{
+			name:          "completed order goes through order confirmation",
+			ctx:           ctxAdmin,
+			orderID:       1,
+			status:        entity.OrderCompleted,
+			mockGetOrder:  &entity.Order{ID: 1, Status: entity.OrderInTransit},
+			expectConfirm: true,
+		},
+		{
+			name:         "cancelled order goes through order cancellation",
+			ctx:          ctxAdmin,
+			orderID:      1,
+			status:       entity.OrderCancelled,
+			mockGetOrder: &entity.Order{ID: 1, Status: entity.OrderConfirmed},
+			expectCancel: true,
+		},
+		{
+			name:          "payment provider error",
 			ctx:           ctxAdmin,
 			orderID:       1,
 			status:        entity.OrderCompleted,
-			mockGetOrder:  existingOrder,
-			mockUpdateRes: updatedOrder,
+			mockGetOrder:  &entity.Order{ID: 1, Status: entity.OrderPendingConfirmation},
+			expectConfirm: true,
+			mockFinishErr: service_errors.ErrPaymentProviderFailed,
+			expectedError: service_errors.ErrPaymentProviderFailed,
+		},
+		{
+			name:          "disputed order is closed only by its dispute",
+			ctx:           ctxAdmin,
+			orderID:       1,
+			status:        entity.OrderCancelled,
+			mockGetOrder:  &entity.Order{ID: 1, Status: entity.OrderDisputed},
+			expectedError: service_errors.ErrInvalidOrderStatusTransition,
+		},
+		{
+			name:          "finished order cannot be moved",
+			ctx:           ctxAdmin,
+			orderID:       1,
+			status:        entity.OrderConfirmed,
+			mockGetOrder:  &entity.Order{ID: 1, Status: entity.OrderCompleted},
+			expectedError: service_errors.ErrInvalidOrderStatusTransition,
 		},
 		{
 			name:          "order not found",
@@ -646,8 +743,9 @@ func TestAdminService_UpdateOrderStatus(t *testing.T) {
 			name:          "repo update error",
 			ctx:           ctxAdmin,
 			orderID:       1,
-			status:        entity.OrderCompleted,
-			mockGetOrder:  existingOrder,
+			status:        entity.OrderConfirmed,
+			mockGetOrder:  &entity.Order{ID: 1, Status: entity.OrderInTransit},
+			expectUpdate:  true,
 			mockUpdateErr: errors.New("update failed"),
 			expectedError: errors.New("update failed"),
 		},
@@ -667,15 +765,49 @@ func TestAdminService_UpdateOrderStatus(t *testing.T) {
 					GetByID(gomock.Any(), tt.orderID).
 					Return(tt.mockGetOrder, tt.mockGetErr).
 					Times(1)
+			}
 
-				if tt.mockGetErr == nil && tt.expectedError == nil || (tt.mockGetErr == nil && tt.mockUpdateErr != nil) {
-					test.orderRepo.EXPECT().
-						UpdateStatus(gomock.Any(), gomock.Any()).
-						Return(tt.mockUpdateRes, tt.mockUpdateErr).
+			if tt.expectUpdate {
+				test.orderRepo.EXPECT().
+					UpdateStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, o *entity.Order) (*entity.Order, error) {
+						if tt.mockUpdateErr != nil {
+							return nil, tt.mockUpdateErr
+						}
+
+						return o, nil
+					}).
+					Times(1)
+
+				if tt.mockUpdateErr == nil {
+					test.eventBroker.EXPECT().
+						Publish(gomock.Any(), gomock.Any()).
 						Times(1)
 				}
 			}
 
+			finish := func(_ context.Context, o *entity.Order) (*entity.Order, error) {
+				if tt.mockFinishErr != nil {
+					return nil, tt.mockFinishErr
+				}
+
+				return &entity.Order{ID: o.ID, Status: tt.status}, nil
+			}
+
+			if tt.expectConfirm {
+				test.orders.EXPECT().
+					ConfirmOrderByAdmin(gomock.Any(), tt.mockGetOrder).
+					DoAndReturn(finish).
+					Times(1)
+			}
+
+			if tt.expectCancel {
+				test.orders.EXPECT().
+					CancelOrderByAdmin(gomock.Any(), tt.mockGetOrder).
+					DoAndReturn(finish).
+					Times(1)
+			}
+
 			order, err := test.service.UpdateOrderStatus(tt.ctx, tt.orderID, tt.status)
 
 			if tt.expectedError != nil {
@@ -803,6 +935,7 @@ func TestAdminService_GetAllBookings(t *testing.T) {
 		ctx           context.Context
 		page          *int64
 		limit         *int64
+		archived      *bool
 		mockBookings  []*entity.Booking
 		mockErr       error
 		expectedError error
@@ -824,6 +957,15 @@ func TestAdminService_GetAllBookings(t *testing.T) {
 			mockBookings: bookings,
 			expectCall:   true,
 		},
+		{
+			name:         "successful get archived bookings",
+			ctx:          ctxAdmin,
+			page:         nil,
+			limit:        nil,
+			archived:     boolPtr(true),
+			mockBookings: bookings,
+			expectCall:   true,
+		},
 		{
 			name:          "repo error",
 			ctx:           ctxAdmin,
@@ -854,13 +996,20 @@ func TestAdminService_GetAllBookings(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.ctx.Value(service_const.RoleKey) == "ADMIN" && tt.expectCall {
-				test.bookingRepo.EXPECT().
-					GetAll(gomock.Any(), gomock.Any()).
-					Return(tt.mockBookings, tt.mockErr).
-					Times(1)
+				if tt.archived != nil && *tt.archived {
+					test.bookingRepo.EXPECT().
+						GetAllArchived(gomock.Any(), gomock.Any()).
+						Return(tt.mockBookings, tt.mockErr).
+						Times(1)
+				} else {
+					test.bookingRepo.EXPECT().
+						GetAll(gomock.Any(), gomock.Any()).
+						Return(tt.mockBookings, tt.mockErr).
+						Times(1)
+				}
 			}
 
-			result, err := test.service.GetAllBookings(tt.ctx, tt.page, tt.limit)
+			result, err := test.service.GetAllBookings(tt.ctx, tt.page, tt.limit, tt.archived)
 
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
@@ -895,6 +1044,7 @@ func TestAdminService_GetAllOrders(t *testing.T) {
 		ctx           context.Context
 		page          *int64
 		limit         *int64
+		archived      *bool
 		mockOrders    []*entity.Order
 		mockErr       error
 		expectedError error
@@ -916,6 +1066,15 @@ func TestAdminService_GetAllOrders(t *testing.T) {
 			mockOrders: orders,
 			expectCall: true,
 		},
+		{
+			name:       "successful get archived orders",
+			ctx:        ctxAdmin,
+			page:       nil,
+			limit:      nil,
+			archived:   boolPtr(true),
+			mockOrders: orders,
+			expectCall: true,
+		},
 		{
 			name:          "repo error",
 			ctx:           ctxAdmin,
@@ -954,13 +1113,20 @@ func TestAdminService_GetAllOrders(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.ctx.Value(service_const.RoleKey) == "ADMIN" && tt.expectCall {
-				test.orderRepo.EXPECT().
-					GetAll(gomock.Any(), gomock.Any()).
-					Return(tt.mockOrders, tt.mockErr).
-					Times(1)
+				if tt.archived != nil && *tt.archived {
+					test.orderRepo.EXPECT().
+						GetAllArchived(gomock.Any(), gomock.Any()).
+						Return(tt.mockOrders, tt.mockErr).
+						Times(1)
+				} else {
+					test.orderRepo.EXPECT().
+						GetAll(gomock.Any(), gomock.Any()).
+						Return(tt.mockOrders, tt.mockErr).
+						Times(1)
+				}
 			}
 
-			result, err := test.service.GetAllOrders(tt.ctx, tt.page, tt.limit)
+			result, err := test.service.GetAllOrders(tt.ctx, tt.page, tt.limit, tt.archived)
 
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
@@ -1014,7 +1180,7 @@ func TestAdminService_GetAllMethods_EdgeCases(t *testing.T) {
 			Return(bookings, nil).
 			Times(1)
 
-		result, err := test.service.GetAllBookings(ctxAdmin, page, limit)
+		result, err := test.service.GetAllBookings(ctxAdmin, page, limit, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -1032,7 +1198,7 @@ func TestAdminService_GetAllMethods_EdgeCases(t *testing.T) {
 			Return(orders, nil).
 			Times(1)
 
-		result, err := test.service.GetAllOrders(ctxAdmin, page, limit)
+		result, err := test.service.GetAllOrders(ctxAdmin, page, limit, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -1040,6 +1206,10 @@ func TestAdminService_GetAllMethods_EdgeCases(t *testing.T) {
 	})
 }
 
+func boolPtr(b bool) *bool {
+	return &b
+}
+
 func int64Ptr(i int64) *int64 {
 	return &i
 }
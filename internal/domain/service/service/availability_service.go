@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+const maxAvailabilityWindow = 31 * 24 * time.Hour
+
+type DefaultAvailabilityService struct {
+	availabilityRepo interfaces.AvailabilityRepository
+	userRepo         interfaces.UserRepository
+	logger           pkg.Logger
+}
+
+func NewDefaultAvailabilityService(availabilityRepo interfaces.AvailabilityRepository,
+	userRepo interfaces.UserRepository, logger pkg.Logger) *DefaultAvailabilityService {
+	return &DefaultAvailabilityService{
+		availabilityRepo: availabilityRepo,
+		userRepo:         userRepo,
+		logger:           logger,
+	}
+}
+
+// SearchAvailability finds the models free in the window across all models. The part of the window that
+// has already passed is left out, the models are sorted by price unless the filter says otherwise.
+func (d *DefaultAvailabilityService) SearchAvailability(ctx context.Context, filter *entity.AvailabilityFilter,
+	page, limit *int64) ([]*entity.ModelAvailability, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Sort == "" {
+		filter.Sort = entity.AvailabilitySortPrice
+	}
+	filter.ServiceQuery = strings.TrimSpace(filter.ServiceQuery)
+
+	if !filter.From.Before(filter.To) || filter.To.Sub(filter.From) > maxAvailabilityWindow ||
+		(filter.MaxPrice != nil && !filter.MaxPrice.IsPositive()) || !filter.Sort.IsValid() {
+		d.logger.Error(ctx, "invalid availability search",
+			option.Any("auth_id", authID),
+			option.Any("from", filter.From),
+			option.Any("to", filter.To),
+			option.Any("sort", filter.Sort),
+			option.Error(service_errors.ErrInvalidAvailabilitySearch))
+
+		return nil, service_errors.ErrInvalidAvailabilitySearch
+	}
+
+	if err = d.checkClientRestrictions(ctx, authID); err != nil {
+		return nil, err
+	}
+
+	if now := time.Now(); filter.From.Before(now) {
+		filter.From = now
+	}
+	if !filter.From.Before(filter.To) {
+		return []*entity.ModelAvailability{}, nil
+	}
+
+	res, err := d.availabilityRepo.Search(ctx, filter, entity.NewOptions(common.CheckPagination(page, limit)))
+	if err != nil {
+		d.logger.Error(ctx, "failed to search availability",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultAvailabilityService) checkClientRestrictions(ctx context.Context, authID *int64) error {
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *role != entity.RoleClient.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotClient))
+
+		return service_errors.ErrNotClient
+	}
+
+	client, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "client is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotClient))
+
+			return service_errors.ErrNotClient
+		}
+
+		d.logger.Error(ctx, "check client restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return err
+	}
+
+	if !client.IsUserVerified() {
+		d.logger.Error(ctx, "client is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedClient))
+
+		return service_errors.ErrNotVerifiedClient
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type availabilityServiceTest struct {
+	ctrl             *gomock.Controller
+	availabilityRepo *mocks.MockAvailabilityRepository
+	userRepo         *mocks.MockUserRepository
+	service          *DefaultAvailabilityService
+}
+
+func setUpAvailabilityServiceTest(t *testing.T) *availabilityServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	availabilityRepo := mocks.NewMockAvailabilityRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &availabilityServiceTest{
+		ctrl:             ctrl,
+		availabilityRepo: availabilityRepo,
+		userRepo:         userRepo,
+		service:          NewDefaultAvailabilityService(availabilityRepo, userRepo, log),
+	}
+}
+
+func TestAvailabilityService_SearchAvailability(t *testing.T) {
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedClient := &entity.User{ID: 7, AuthID: 1, IsVerified: true}
+
+	from := time.Now().Add(72 * time.Hour).Truncate(time.Hour)
+	to := from.Add(2 * time.Hour)
+	zeroPrice := entity.NewMoney(0, entity.DefaultCurrency)
+	found := []*entity.ModelAvailability{
+		{
+			Model:    &entity.User{ID: 3, Name: "model", IsVerified: true},
+			Slots:    []*entity.Slot{{ID: 10, ModelID: 3, StartTime: from, EndTime: to}},
+			Services: []*entity.ModelService{{ID: 20, ModelID: 3, Title: "photo"}},
+		},
+	}
+	page, limit := int64(2), int64(5)
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		filter        *entity.AvailabilityFilter
+		mockClient    *entity.User
+		expectSearch  bool
+		mockFound     []*entity.ModelAvailability
+		mockErr       error
+		expectedSort  entity.AvailabilitySort
+		expectedFound []*entity.ModelAvailability
+		expectedError error
+	}{
+		{
+			name:          "models are found sorted by price by default",
+			ctx:           ctxClient,
+			filter:        &entity.AvailabilityFilter{From: from, To: to, ServiceQuery: "  photo "},
+			mockClient:    verifiedClient,
+			expectSearch:  true,
+			mockFound:     found,
+			expectedSort:  entity.AvailabilitySortPrice,
+			expectedFound: found,
+		},
+		{
+			name: "sort by start time is kept",
+			ctx:  ctxClient,
+			filter: &entity.AvailabilityFilter{From: from, To: to, ServiceQuery: "photo",
+				Sort: entity.AvailabilitySortStartTime},
+			mockClient:    verifiedClient,
+			expectSearch:  true,
+			mockFound:     []*entity.ModelAvailability{},
+			expectedSort:  entity.AvailabilitySortStartTime,
+			expectedFound: []*entity.ModelAvailability{},
+		},
+		{
+			name:          "past part of the window is left out",
+			ctx:           ctxClient,
+			filter:        &entity.AvailabilityFilter{From: time.Now().Add(-time.Hour), To: to, ServiceQuery: "photo"},
+			mockClient:    verifiedClient,
+			expectSearch:  true,
+			mockFound:     found,
+			expectedSort:  entity.AvailabilitySortPrice,
+			expectedFound: found,
+		},
+		{
+			name:          "window in the past has nothing",
+			ctx:           ctxClient,
+			filter:        &entity.AvailabilityFilter{From: time.Now().Add(-3 * time.Hour), To: time.Now().Add(-time.Hour)},
+			mockClient:    verifiedClient,
+			expectedFound: []*entity.ModelAvailability{},
+		},
+		{
+			name:          "window ends before it starts",
+			ctx:           ctxClient,
+			filter:        &entity.AvailabilityFilter{From: to, To: from},
+			expectedError: service_errors.ErrInvalidAvailabilitySearch,
+		},
+		{
+			name:          "window is too long",
+			ctx:           ctxClient,
+			filter:        &entity.AvailabilityFilter{From: from, To: from.Add(32 * 24 * time.Hour)},
+			expectedError: service_errors.ErrInvalidAvailabilitySearch,
+		},
+		{
+			name:          "max price is not positive",
+			ctx:           ctxClient,
+			filter:        &entity.AvailabilityFilter{From: from, To: to, MaxPrice: &zeroPrice},
+			expectedError: service_errors.ErrInvalidAvailabilitySearch,
+		},
+		{
+			name:          "unknown sort",
+			ctx:           ctxClient,
+			filter:        &entity.AvailabilityFilter{From: from, To: to, Sort: "rating"},
+			expectedError: service_errors.ErrInvalidAvailabilitySearch,
+		},
+		{
+			name:          "not a client",
+			ctx:           ctxModel,
+			filter:        &entity.AvailabilityFilter{From: from, To: to},
+			expectedError: service_errors.ErrNotClient,
+		},
+		{
+			name:          "client is not verified",
+			ctx:           ctxClient,
+			filter:        &entity.AvailabilityFilter{From: from, To: to},
+			mockClient:    &entity.User{ID: 7, AuthID: 1},
+			expectedError: service_errors.ErrNotVerifiedClient,
+		},
+		{
+			name:          "repo error",
+			ctx:           ctxClient,
+			filter:        &entity.AvailabilityFilter{From: from, To: to, ServiceQuery: "photo"},
+			mockClient:    verifiedClient,
+			expectSearch:  true,
+			mockErr:       errors.New("db error"),
+			expectedSort:  entity.AvailabilitySortPrice,
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpAvailabilityServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.mockClient != nil {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), int64(1)).
+					Return(tt.mockClient, nil).
+					Times(1)
+			}
+
+			calledAt := time.Now()
+			if tt.expectSearch {
+				test.availabilityRepo.EXPECT().
+					Search(gomock.Any(), gomock.Any(), entity.NewOptions(page, limit)).
+					DoAndReturn(func(_ context.Context, filter *entity.AvailabilityFilter,
+						_ *entity.Options) ([]*entity.ModelAvailability, error) {
+						assert.Equal(t, tt.expectedSort, filter.Sort)
+						assert.False(t, filter.From.Before(calledAt))
+						assert.Equal(t, to, filter.To)
+						assert.Equal(t, "photo", filter.ServiceQuery)
+
+						return tt.mockFound, tt.mockErr
+					}).
+					Times(1)
+			}
+
+			res, err := test.service.SearchAvailability(tt.ctx, tt.filter, &page, &limit)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedFound, res)
+		})
+	}
+}
@@ -0,0 +1,716 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type DefaultAvailabilityTemplateService struct {
+	templateRepo interfaces.AvailabilityTemplateRepository
+	slotRepo     interfaces.SlotRepository
+	userRepo     interfaces.UserRepository
+	timeOffRepo  interfaces.TimeOffRepository
+	rules        interfaces.BookingRulesProvider
+	txManager    database.TxManager
+	logger       pkg.Logger
+	location     *time.Location
+	horizon      time.Duration
+}
+
+func NewDefaultAvailabilityTemplateService(templateRepo interfaces.AvailabilityTemplateRepository,
+	slotRepo interfaces.SlotRepository, userRepo interfaces.UserRepository, timeOffRepo interfaces.TimeOffRepository,
+	rules interfaces.BookingRulesProvider, txManager database.TxManager,
+	logger pkg.Logger) (*DefaultAvailabilityTemplateService, error) {
+
+	timezone := os.Getenv(service_const.DotEnvPlatformTimezone)
+	if timezone == "" {
+		return nil, service_errors.ErrLoadingPlatformTimezone
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, service_errors.ErrParsingPlatformTimezone
+	}
+
+	weeks := os.Getenv(service_const.DotEnvSlotGenerationWeeks)
+	if weeks == "" {
+		return nil, service_errors.ErrLoadingSlotGenerationWeeks
+	}
+
+	weeksAhead, err := strconv.Atoi(weeks)
+	if err != nil {
+		return nil, service_errors.ErrParsingSlotGenerationWeeks
+	}
+	if weeksAhead <= 0 {
+		return nil, service_errors.ErrNotPositiveSlotGenerationWeeks
+	}
+
+	return &DefaultAvailabilityTemplateService{
+		templateRepo: templateRepo,
+		slotRepo:     slotRepo,
+		userRepo:     userRepo,
+		timeOffRepo:  timeOffRepo,
+		rules:        rules,
+		txManager:    txManager,
+		logger:       logger,
+		location:     location,
+		horizon:      time.Duration(weeksAhead) * 7 * 24 * time.Hour,
+	}, nil
+}
+
+// CreateTemplate saves a draft, no slots are generated until the template is published.
+func (d *DefaultAvailabilityTemplateService) CreateTemplate(ctx context.Context, name string, weekdays []int,
+	startTime, endTime string, slotMinutes int, effectiveFrom time.Time, effectiveTo *time.Time,
+	exceptions []time.Time) (*entity.AvailabilityTemplate, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	startMinute, endMinute, err := parseTemplateWindow(startTime, endTime)
+	if err != nil {
+		d.logger.Error(ctx, "invalid availability template window",
+			option.Any("model_id", model.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	template := entity.NewAvailabilityTemplate(model.ID, name, weekdays, startMinute, endMinute, slotMinutes,
+		effectiveFrom, effectiveTo, exceptions)
+
+	if err = d.checkPayloadRestrictions(template); err != nil {
+		d.logger.Error(ctx, "invalid availability template",
+			option.Any("model_id", model.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if err = d.checkRules(ctx, template); err != nil {
+		return nil, err
+	}
+
+	if err = d.templateRepo.Save(ctx, template); err != nil {
+		d.logger.Error(ctx, "failed to save availability template",
+			option.Any("model_id", model.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (d *DefaultAvailabilityTemplateService) GetTemplates(ctx context.Context,
+	page, limit *int64) ([]*entity.AvailabilityTemplate, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.templateRepo.GetByModelID(ctx, model.ID, entity.NewOptions(common.CheckPagination(page, limit)))
+	if err != nil {
+		d.logger.Error(ctx, "failed to get availability templates",
+			option.Any("model_id", model.ID),
+			option.Any("page", page),
+			option.Any("limit", limit),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultAvailabilityTemplateService) GetTemplateByID(ctx context.Context,
+	id int64) (*entity.AvailabilityTemplate, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getOwnTemplate(ctx, id, model.ID)
+}
+
+// UpdateTemplate replaces the template. The slots of a published template are generated anew:
+// the available ones are taken back first, reserved and booked slots stay as they are.
+func (d *DefaultAvailabilityTemplateService) UpdateTemplate(ctx context.Context, id int64, name string,
+	weekdays []int, startTime, endTime string, slotMinutes int, effectiveFrom time.Time, effectiveTo *time.Time,
+	exceptions []time.Time) (*entity.AvailabilityTemplate, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := d.getOwnTemplate(ctx, id, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	startMinute, endMinute, err := parseTemplateWindow(startTime, endTime)
+	if err != nil {
+		d.logger.Error(ctx, "invalid availability template window",
+			option.Any("availability_template_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	template.Name = name
+	template.Weekdays = weekdays
+	template.StartMinute = startMinute
+	template.EndMinute = endMinute
+	template.SlotMinutes = slotMinutes
+	template.EffectiveFrom = effectiveFrom
+	template.EffectiveTo = effectiveTo
+	template.Exceptions = exceptions
+
+	if err = d.checkPayloadRestrictions(template); err != nil {
+		d.logger.Error(ctx, "invalid availability template",
+			option.Any("availability_template_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if err = d.checkRules(ctx, template); err != nil {
+		return nil, err
+	}
+
+	var res *entity.AvailabilityTemplate
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if res, err = d.updateTemplate(ctx, template); err != nil {
+			return err
+		}
+
+		if !res.IsPublished {
+			return nil
+		}
+
+		if err = d.releaseSlots(ctx, res); err != nil {
+			return err
+		}
+
+		_, err = d.generateSlots(ctx, res, model.Location(d.location))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// PreviewTemplate shows the slots the template would have over the generation horizon if it were
+// published now, the ones overlapping the slots or the time-offs of the model are marked as skipped.
+func (d *DefaultAvailabilityTemplateService) PreviewTemplate(ctx context.Context,
+	id int64) ([]entity.SlotPreview, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := d.getOwnTemplate(ctx, id, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := d.candidates(ctx, template, model.Location(d.location))
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := d.getExistingSlots(ctx, template.ModelID, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	timeOffs, err := d.getTimeOffs(ctx, template.ModelID, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]entity.SlotPreview, len(candidates))
+	for i, candidate := range candidates {
+		res[i] = entity.SlotPreview{
+			StartTime: candidate.StartTime,
+			EndTime:   candidate.EndTime,
+			// the available slots of the template itself are released on the update
+			Skipped: overlapsAny(candidate, existing, template.ID, true) || entity.OverlapsAny(candidate, timeOffs),
+		}
+	}
+
+	return res, nil
+}
+
+// PublishTemplate makes the template generate slots, the first ones are generated right away.
+func (d *DefaultAvailabilityTemplateService) PublishTemplate(ctx context.Context,
+	id int64) (*entity.AvailabilityTemplate, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := d.getOwnTemplate(ctx, id, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	template.IsPublished = true
+
+	var res *entity.AvailabilityTemplate
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if res, err = d.updateTemplate(ctx, template); err != nil {
+			return err
+		}
+
+		_, err = d.generateSlots(ctx, res, model.Location(d.location))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// UnpublishTemplate stops the generation and takes back the available slots of the template,
+// reserved and booked slots stay as they are.
+func (d *DefaultAvailabilityTemplateService) UnpublishTemplate(ctx context.Context,
+	id int64) (*entity.AvailabilityTemplate, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := d.getOwnTemplate(ctx, id, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	template.IsPublished = false
+
+	var res *entity.AvailabilityTemplate
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if res, err = d.updateTemplate(ctx, template); err != nil {
+			return err
+		}
+
+		return d.releaseSlots(ctx, res)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GenerateSlots extends every published template up to the generation horizon. A slot already
+// generated overlaps its candidate, so repeated runs create only the slots of the new days.
+// A template that fails is left for the next run and does not hold the others back, the count
+// covers the slots generated and the error joins the failures of all the templates.
+func (d *DefaultAvailabilityTemplateService) GenerateSlots(ctx context.Context) (int, error) {
+	templates, err := d.templateRepo.GetPublished(ctx)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get published availability templates",
+			option.Error(err))
+
+		return 0, err
+	}
+
+	generated := 0
+	var failures []error
+	for _, template := range templates {
+		count, err := d.generateTemplateSlots(ctx, template)
+		if err != nil {
+			d.logger.Error(ctx, "failed to generate slots of availability template",
+				option.Any("availability_template_id", template.ID),
+				option.Any("model_id", template.ModelID),
+				option.Error(err))
+
+			failures = append(failures, err)
+			continue
+		}
+
+		generated += count
+	}
+
+	return generated, errors.Join(failures...)
+}
+
+// generateTemplateSlots generates the slots of the template in the time zone its model has now.
+func (d *DefaultAvailabilityTemplateService) generateTemplateSlots(ctx context.Context,
+	template *entity.AvailabilityTemplate) (int, error) {
+
+	location, err := modelLocation(ctx, d.userRepo, template.ModelID, d.location)
+	if err != nil {
+		return 0, err
+	}
+
+	return d.generateSlots(ctx, template, location)
+}
+
+// generateSlots lays the slots of the template out in the time zone of its model. The candidates within
+// a time-off are skipped, they are generated by the first run after the time-off is deleted.
+func (d *DefaultAvailabilityTemplateService) generateSlots(ctx context.Context,
+	template *entity.AvailabilityTemplate, location *time.Location) (int, error) {
+
+	candidates, err := d.candidates(ctx, template, location)
+	if err != nil {
+		return 0, err
+	}
+
+	existing, err := d.getExistingSlots(ctx, template.ModelID, candidates)
+	if err != nil {
+		return 0, err
+	}
+
+	timeOffs, err := d.getTimeOffs(ctx, template.ModelID, candidates)
+	if err != nil {
+		return 0, err
+	}
+
+	slots := make([]*entity.Slot, 0, len(candidates))
+	for _, candidate := range candidates {
+		if !overlapsAny(candidate, existing, template.ID, false) && !entity.OverlapsAny(candidate, timeOffs) {
+			slots = append(slots, candidate)
+		}
+	}
+
+	if err = d.slotRepo.SaveAll(ctx, slots); err != nil {
+		d.logger.Error(ctx, "failed to save generated slots",
+			option.Any("availability_template_id", template.ID),
+			option.Error(err))
+
+		return 0, err
+	}
+
+	return len(slots), nil
+}
+
+// candidates lays the slots of the template out over the generation horizon, keeping only the ones the
+// booking rules of the model allow: the rules may have been tightened since the template was saved.
+func (d *DefaultAvailabilityTemplateService) candidates(ctx context.Context, template *entity.AvailabilityTemplate,
+	location *time.Location) ([]*entity.Slot, error) {
+
+	rules, err := d.rules.RulesOf(ctx, template.ModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	candidates := template.Slots(now, now.Add(d.horizon), location)
+
+	res := make([]*entity.Slot, 0, len(candidates))
+	for _, candidate := range candidates {
+		if rules.WithinHorizon(candidate.StartTime, now) && rules.FitsDuration(candidate.StartTime, candidate.EndTime) {
+			res = append(res, candidate)
+		}
+	}
+
+	return res, nil
+}
+
+func (d *DefaultAvailabilityTemplateService) releaseSlots(ctx context.Context,
+	template *entity.AvailabilityTemplate) error {
+
+	released, err := d.slotRepo.ReleaseTemplateSlots(ctx, template.ID, time.Now())
+	if err != nil {
+		d.logger.Error(ctx, "failed to release template slots",
+			option.Any("availability_template_id", template.ID),
+			option.Error(err))
+
+		return err
+	}
+
+	d.logger.Info(ctx, "template slots released",
+		option.Any("availability_template_id", template.ID),
+		option.Any("count", released))
+
+	return nil
+}
+
+// getExistingSlots reads the slots of the model over the whole range of the candidates at once.
+func (d *DefaultAvailabilityTemplateService) getExistingSlots(ctx context.Context, modelID int64,
+	candidates []*entity.Slot) ([]*entity.Slot, error) {
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	res, err := d.slotRepo.GetOverlappingSlots(ctx, modelID,
+		candidates[0].StartTime, candidates[len(candidates)-1].EndTime)
+	if err != nil {
+		d.logger.Error(ctx, "cannot get overlaps slot for model",
+			option.Any("model_id", modelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// getTimeOffs reads the time-offs of the model over the whole range of the candidates at once.
+func (d *DefaultAvailabilityTemplateService) getTimeOffs(ctx context.Context, modelID int64,
+	candidates []*entity.Slot) ([]*entity.TimeOff, error) {
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	res, err := d.timeOffRepo.GetOverlapping(ctx, modelID,
+		candidates[0].StartTime, candidates[len(candidates)-1].EndTime)
+	if err != nil {
+		d.logger.Error(ctx, "cannot get time-offs for model",
+			option.Any("model_id", modelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultAvailabilityTemplateService) updateTemplate(ctx context.Context,
+	template *entity.AvailabilityTemplate) (*entity.AvailabilityTemplate, error) {
+
+	res, err := d.templateRepo.Update(ctx, template)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "availability template is not found by id",
+				option.Any("availability_template_id", template.ID),
+				option.Error(service_errors.ErrTemplateNotFound))
+
+			return nil, service_errors.ErrTemplateNotFound
+		}
+
+		d.logger.Error(ctx, "failed to update availability template",
+			option.Any("availability_template_id", template.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultAvailabilityTemplateService) checkPayloadRestrictions(template *entity.AvailabilityTemplate) error {
+	if len(template.Weekdays) == 0 {
+		return service_errors.ErrInvalidTemplateSchedule
+	}
+	for _, weekday := range template.Weekdays {
+		if weekday < int(time.Sunday) || weekday > int(time.Saturday) {
+			return service_errors.ErrInvalidTemplateSchedule
+		}
+	}
+
+	if template.StartMinute >= entity.MinutesInDay || template.EndMinute == 0 {
+		return service_errors.ErrInvalidTemplateSchedule
+	}
+
+	window := template.EndMinute - template.StartMinute
+	if window <= 0 {
+		window += entity.MinutesInDay
+	}
+	if template.SlotMinutes <= 0 || template.SlotMinutes > window {
+		return service_errors.ErrInvalidTemplateSchedule
+	}
+
+	if template.EffectiveTo != nil && template.EffectiveTo.Before(template.EffectiveFrom) {
+		return service_errors.ErrInvalidTemplatePeriod
+	}
+
+	return nil
+}
+
+// checkRules makes sure the slots of the template last as long as the booking rules of the model allow.
+func (d *DefaultAvailabilityTemplateService) checkRules(ctx context.Context,
+	template *entity.AvailabilityTemplate) error {
+
+	rules, err := d.rules.RulesOf(ctx, template.ModelID)
+	if err != nil {
+		return err
+	}
+
+	slotDuration := time.Duration(template.SlotMinutes) * time.Minute
+	if !rules.FitsDuration(template.EffectiveFrom, template.EffectiveFrom.Add(slotDuration)) {
+		d.logger.Error(ctx, "availability template breaks the booking rules of model",
+			option.Any("availability_template_id", template.ID),
+			option.Any("model_id", template.ModelID),
+			option.Any("slot_minutes", template.SlotMinutes),
+			option.Error(service_errors.ErrInvalidSlotDuration))
+
+		return service_errors.ErrInvalidSlotDuration
+	}
+
+	return nil
+}
+
+func (d *DefaultAvailabilityTemplateService) getOwnTemplate(ctx context.Context,
+	id, modelID int64) (*entity.AvailabilityTemplate, error) {
+
+	template, err := d.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "availability template is not found by id",
+				option.Any("availability_template_id", id),
+				option.Error(service_errors.ErrTemplateNotFound))
+
+			return nil, service_errors.ErrTemplateNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get availability template by id",
+			option.Any("availability_template_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if template.ModelID != modelID {
+		d.logger.Error(ctx, "model is not an owner of availability template",
+			option.Any("availability_template_id", id),
+			option.Any("model_id", modelID),
+			option.Error(service_errors.ErrModelIsNotAnOwnerOfTemplate))
+
+		return nil, service_errors.ErrModelIsNotAnOwnerOfTemplate
+	}
+
+	return template, nil
+}
+
+func (d *DefaultAvailabilityTemplateService) checkModelRestrictions(ctx context.Context,
+	authID *int64) (*entity.User, error) {
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleModel.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAModel))
+
+		return nil, service_errors.ErrNotAModel
+	}
+
+	model, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotAModel))
+
+			return nil, service_errors.ErrNotAModel
+		}
+
+		d.logger.Error(ctx, "check model restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !model.IsUserVerified() {
+		d.logger.Error(ctx, "model is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedModel))
+
+		return nil, service_errors.ErrNotVerifiedModel
+	}
+
+	return model, nil
+}
+
+// overlapsAny tells whether the candidate collides with a slot of the model. A disabled slot collides
+// only while it belongs to the template, it is an occurrence the model has switched off. With releasing
+// set the available slots of the template are ignored, as they are about to be taken back.
+func overlapsAny(candidate *entity.Slot, existing []*entity.Slot, templateID int64, releasing bool) bool {
+	for _, slot := range existing {
+		own := slot.TemplateID != nil && *slot.TemplateID == templateID
+		if slot.Status == entity.SlotDisabled && !own {
+			continue
+		}
+		if releasing && own && slot.IsAvailable() {
+			continue
+		}
+
+		if candidate.Overlaps(slot) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseTemplateWindow takes the time of day as HH:MM, an end before the start runs past midnight.
+func parseTemplateWindow(startTime, endTime string) (int, int, error) {
+	startMinute, err := entity.ParseTimeOfDay(startTime)
+	if err != nil {
+		return 0, 0, service_errors.ErrInvalidTemplateSchedule
+	}
+
+	endMinute, err := entity.ParseTimeOfDay(endTime)
+	if err != nil {
+		return 0, 0, service_errors.ErrInvalidTemplateSchedule
+	}
+
+	return startMinute, endMinute, nil
+}
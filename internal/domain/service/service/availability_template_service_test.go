@@ -0,0 +1,737 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type availabilityTemplateServiceTest struct {
+	ctrl         *gomock.Controller
+	templateRepo *mocks.MockAvailabilityTemplateRepository
+	slotRepo     *mocks.MockSlotRepository
+	userRepo     *mocks.MockUserRepository
+	timeOffRepo  *mocks.MockTimeOffRepository
+	rules        *mocks.MockBookingRulesProvider
+	txManager    *mocks.MockTxManager
+	service      *DefaultAvailabilityTemplateService
+}
+
+func setUpAvailabilityTemplateServiceTest(t *testing.T) *availabilityTemplateServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	templateRepo := mocks.NewMockAvailabilityTemplateRepository(ctrl)
+	slotRepo := mocks.NewMockSlotRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	timeOffRepo := mocks.NewMockTimeOffRepository(ctrl)
+	rules := mocks.NewMockBookingRulesProvider(ctrl)
+	txManager := mocks.NewMockTxManager(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(service_const.DotEnvPlatformTimezone, "Europe/Moscow")
+	t.Setenv(service_const.DotEnvSlotGenerationWeeks, "2")
+
+	templateService, err := NewDefaultAvailabilityTemplateService(templateRepo, slotRepo, userRepo, timeOffRepo,
+		rules, txManager, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &availabilityTemplateServiceTest{
+		ctrl:         ctrl,
+		templateRepo: templateRepo,
+		slotRepo:     slotRepo,
+		userRepo:     userRepo,
+		timeOffRepo:  timeOffRepo,
+		rules:        rules,
+		txManager:    txManager,
+		service:      templateService,
+	}
+}
+
+func TestNewDefaultAvailabilityTemplateService_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		timezone      string
+		weeks         string
+		expectedError error
+	}{
+		{
+			name:     "successful creation",
+			timezone: "Europe/Moscow",
+			weeks:    "4",
+		},
+		{
+			name:          "timezone is not set",
+			weeks:         "4",
+			expectedError: service_errors.ErrLoadingPlatformTimezone,
+		},
+		{
+			name:          "weeks are not set",
+			timezone:      "Europe/Moscow",
+			expectedError: service_errors.ErrLoadingSlotGenerationWeeks,
+		},
+		{
+			name:          "weeks are not a number",
+			timezone:      "Europe/Moscow",
+			weeks:         "a month",
+			expectedError: service_errors.ErrParsingSlotGenerationWeeks,
+		},
+		{
+			name:          "weeks are zero",
+			timezone:      "Europe/Moscow",
+			weeks:         "0",
+			expectedError: service_errors.ErrNotPositiveSlotGenerationWeeks,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(service_const.DotEnvPlatformTimezone, tt.timezone)
+			t.Setenv(service_const.DotEnvSlotGenerationWeeks, tt.weeks)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfg := &config.LogConfig{}
+			cfg.Logger.Level = "info"
+			tmpDir := os.TempDir()
+			cfg.Logger.LogsDir = tmpDir
+			cfg.Logger.LogsFile = "test.log"
+			log, _ := pkg.NewDualLogger(cfg)
+
+			templateService, err := NewDefaultAvailabilityTemplateService(
+				mocks.NewMockAvailabilityTemplateRepository(ctrl),
+				mocks.NewMockSlotRepository(ctrl),
+				mocks.NewMockUserRepository(ctrl),
+				mocks.NewMockTimeOffRepository(ctrl),
+				mocks.NewMockBookingRulesProvider(ctrl),
+				mocks.NewMockTxManager(ctrl),
+				log,
+			)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, templateService)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 4*7*24*time.Hour, templateService.horizon)
+			}
+		})
+	}
+}
+
+func TestAvailabilityTemplateService_CreateTemplate(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(2))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+
+	from := time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	before := from.AddDate(0, 0, -1)
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		weekdays      []int
+		startTime     string
+		endTime       string
+		slotMinutes   int
+		effectiveTo   *time.Time
+		mockRules     *entity.BookingRules
+		expectSave    bool
+		mockSaveErr   error
+		expectedError error
+	}{
+		{
+			name:        "friday and saturday nights are saved as a draft",
+			ctx:         ctxModel,
+			weekdays:    []int{5, 6},
+			startTime:   "20:00",
+			endTime:     "02:00",
+			slotMinutes: 60,
+			mockRules:   testBookingRules(),
+			expectSave:  true,
+		},
+		{
+			name:          "failed to save",
+			ctx:           ctxModel,
+			weekdays:      []int{5, 6},
+			startTime:     "20:00",
+			endTime:       "02:00",
+			slotMinutes:   60,
+			mockRules:     testBookingRules(),
+			expectSave:    true,
+			mockSaveErr:   errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+		{
+			name:          "no weekdays",
+			ctx:           ctxModel,
+			startTime:     "20:00",
+			endTime:       "02:00",
+			slotMinutes:   60,
+			expectedError: service_errors.ErrInvalidTemplateSchedule,
+		},
+		{
+			name:          "weekday out of range",
+			ctx:           ctxModel,
+			weekdays:      []int{7},
+			startTime:     "20:00",
+			endTime:       "02:00",
+			slotMinutes:   60,
+			expectedError: service_errors.ErrInvalidTemplateSchedule,
+		},
+		{
+			name:          "invalid time of day",
+			ctx:           ctxModel,
+			weekdays:      []int{5},
+			startTime:     "8pm",
+			endTime:       "02:00",
+			slotMinutes:   60,
+			expectedError: service_errors.ErrInvalidTemplateSchedule,
+		},
+		{
+			name:          "slot is longer than the window",
+			ctx:           ctxModel,
+			weekdays:      []int{5},
+			startTime:     "20:00",
+			endTime:       "21:00",
+			slotMinutes:   90,
+			expectedError: service_errors.ErrInvalidTemplateSchedule,
+		},
+		{
+			name:          "slot is too short",
+			ctx:           ctxModel,
+			weekdays:      []int{5},
+			startTime:     "20:00",
+			endTime:       "21:00",
+			slotMinutes:   5,
+			mockRules:     testBookingRules(),
+			expectedError: service_errors.ErrInvalidSlotDuration,
+		},
+		{
+			name:          "slot is longer than the model allows",
+			ctx:           ctxModel,
+			weekdays:      []int{5},
+			startTime:     "20:00",
+			endTime:       "02:00",
+			slotMinutes:   60,
+			mockRules:     &entity.BookingRules{ModelID: 5, MaxSlotDuration: 45 * time.Minute},
+			expectedError: service_errors.ErrInvalidSlotDuration,
+		},
+		{
+			name:          "template ends before it takes effect",
+			ctx:           ctxModel,
+			weekdays:      []int{5},
+			startTime:     "20:00",
+			endTime:       "02:00",
+			slotMinutes:   60,
+			effectiveTo:   &before,
+			expectedError: service_errors.ErrInvalidTemplatePeriod,
+		},
+		{
+			name:          "client cannot create templates",
+			ctx:           ctxClient,
+			expectedError: service_errors.ErrNotAModel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpAvailabilityTemplateServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.ctx == ctxModel {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+					Return(verifiedModel, nil).
+					Times(1)
+			}
+
+			if tt.mockRules != nil {
+				test.rules.EXPECT().
+					RulesOf(gomock.Any(), int64(5)).
+					Return(tt.mockRules, nil).
+					Times(1)
+			}
+
+			if tt.expectSave {
+				test.templateRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, template *entity.AvailabilityTemplate) error {
+						assert.False(t, template.IsPublished)
+						assert.Equal(t, 20*60, template.StartMinute)
+						assert.Equal(t, 2*60, template.EndMinute)
+						return tt.mockSaveErr
+					}).
+					Times(1)
+			}
+
+			res, err := test.service.CreateTemplate(tt.ctx, "Weekend nights", tt.weekdays, tt.startTime,
+				tt.endTime, tt.slotMinutes, from, tt.effectiveTo, nil)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(5), res.ModelID)
+			}
+		})
+	}
+}
+
+func TestAvailabilityTemplateService_GenerateSlots(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now().In(moscow)
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+	dayAfter := tomorrow.AddDate(0, 0, 1)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokyoZone := tokyo.String()
+
+	at := func(day time.Time, days, hour int) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day()+days, hour, 0, 0, 0, moscow)
+	}
+	atTokyo := func(day time.Time, days, hour int) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day()+days, hour, 0, 0, 0, tokyo)
+	}
+
+	templateID := int64(3)
+	otherTemplateID := int64(4)
+	newTemplate := func(exceptions ...time.Time) *entity.AvailabilityTemplate {
+		return &entity.AvailabilityTemplate{
+			ID: templateID, ModelID: 5, Weekdays: []int{0, 1, 2, 3, 4, 5, 6},
+			StartMinute: 22 * 60, EndMinute: 60, SlotMinutes: 60,
+			EffectiveFrom: tomorrow, EffectiveTo: &dayAfter, Exceptions: exceptions, IsPublished: true,
+		}
+	}
+	existing := func(start time.Time, status entity.SlotStatus, templateID *int64) *entity.Slot {
+		return &entity.Slot{ModelID: 5, TemplateID: templateID, StartTime: start,
+			EndTime: start.Add(time.Hour), Status: status}
+	}
+
+	tests := []struct {
+		name           string
+		template       *entity.AvailabilityTemplate
+		mockModel      *entity.User
+		mockRules      *entity.BookingRules
+		mockExisting   []*entity.Slot
+		mockTimeOffs   []*entity.TimeOff
+		mockSaveErr    error
+		expectedStarts []time.Time
+		expectedError  error
+	}{
+		{
+			name:     "window runs past midnight",
+			template: newTemplate(),
+			expectedStarts: []time.Time{
+				at(tomorrow, 0, 22), at(tomorrow, 0, 23), at(tomorrow, 1, 0),
+				at(dayAfter, 0, 22), at(dayAfter, 0, 23), at(dayAfter, 1, 0),
+			},
+		},
+		{
+			name:      "slots follow the time zone of the model",
+			template:  newTemplate(),
+			mockModel: &entity.User{ID: 5, TimeZone: &tokyoZone},
+			expectedStarts: []time.Time{
+				atTokyo(tomorrow, 0, 22), atTokyo(tomorrow, 0, 23), atTokyo(tomorrow, 1, 0),
+				atTokyo(dayAfter, 0, 22), atTokyo(dayAfter, 0, 23), atTokyo(dayAfter, 1, 0),
+			},
+		},
+		{
+			name:      "slots beyond the horizon of the model are skipped",
+			template:  newTemplate(),
+			mockRules: &entity.BookingRules{ModelID: 5, Horizon: time.Until(at(tomorrow, 1, 12)), MaxSlotDuration: time.Hour},
+			expectedStarts: []time.Time{
+				at(tomorrow, 0, 22), at(tomorrow, 0, 23), at(tomorrow, 1, 0),
+			},
+		},
+		{
+			name:     "exception day is skipped",
+			template: newTemplate(dayAfter),
+			expectedStarts: []time.Time{
+				at(tomorrow, 0, 22), at(tomorrow, 0, 23), at(tomorrow, 1, 0),
+			},
+		},
+		{
+			name:     "overlapping slots are skipped",
+			template: newTemplate(),
+			mockExisting: []*entity.Slot{
+				existing(at(tomorrow, 0, 22), entity.SlotReserved, nil),
+				existing(at(tomorrow, 0, 23), entity.SlotDisabled, &templateID),
+				existing(at(tomorrow, 1, 0), entity.SlotDisabled, nil),
+				existing(at(dayAfter, 0, 22), entity.SlotAvailable, &templateID),
+				existing(at(dayAfter, 0, 23).Add(30*time.Minute), entity.SlotBooked, &otherTemplateID),
+			},
+			expectedStarts: []time.Time{
+				at(tomorrow, 1, 0),
+			},
+		},
+		{
+			name:     "slots within a time-off are skipped",
+			template: newTemplate(),
+			mockTimeOffs: []*entity.TimeOff{
+				{ModelID: 5, StartTime: at(tomorrow, 0, 23).Add(30 * time.Minute), EndTime: at(dayAfter, 0, 23)},
+			},
+			expectedStarts: []time.Time{
+				at(tomorrow, 0, 22), at(dayAfter, 0, 23), at(dayAfter, 1, 0),
+			},
+		},
+		{
+			name:           "failed to save",
+			template:       newTemplate(),
+			mockSaveErr:    errors.New("db error"),
+			expectedStarts: nil,
+			expectedError:  errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpAvailabilityTemplateServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.templateRepo.EXPECT().
+				GetPublished(gomock.Any()).
+				Return([]*entity.AvailabilityTemplate{tt.template}, nil).
+				Times(1)
+
+			model := tt.mockModel
+			if model == nil {
+				model = &entity.User{ID: 5}
+			}
+			test.userRepo.EXPECT().
+				GetByID(gomock.Any(), int64(5)).
+				Return(model, nil).
+				Times(1)
+
+			rules := tt.mockRules
+			if rules == nil {
+				rules = testBookingRules()
+			}
+			test.rules.EXPECT().
+				RulesOf(gomock.Any(), int64(5)).
+				Return(rules, nil).
+				Times(1)
+
+			test.slotRepo.EXPECT().
+				GetOverlappingSlots(gomock.Any(), int64(5), gomock.Any(), gomock.Any()).
+				Return(tt.mockExisting, nil).
+				Times(1)
+
+			test.timeOffRepo.EXPECT().
+				GetOverlapping(gomock.Any(), int64(5), gomock.Any(), gomock.Any()).
+				Return(tt.mockTimeOffs, nil).
+				Times(1)
+
+			var saved []*entity.Slot
+			test.slotRepo.EXPECT().
+				SaveAll(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, slots []*entity.Slot) error {
+					saved = slots
+					return tt.mockSaveErr
+				}).
+				Times(1)
+
+			generated, err := test.service.GenerateSlots(context.Background())
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Zero(t, generated)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.expectedStarts), generated)
+			for i, slot := range saved {
+				assert.True(t, tt.expectedStarts[i].Equal(slot.StartTime), "slot %d starts at %s", i, slot.StartTime)
+				assert.Equal(t, time.Hour, slot.EndTime.Sub(slot.StartTime))
+				assert.Equal(t, entity.SlotAvailable, slot.Status)
+				assert.Equal(t, &templateID, slot.TemplateID)
+			}
+		})
+	}
+}
+
+func TestAvailabilityTemplateService_GenerateSlots_FailingTemplate(t *testing.T) {
+	now := time.Now()
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+	newTemplate := func(id, modelID int64) *entity.AvailabilityTemplate {
+		return &entity.AvailabilityTemplate{
+			ID: id, ModelID: modelID, Weekdays: []int{0, 1, 2, 3, 4, 5, 6},
+			StartMinute: 12 * 60, EndMinute: 14 * 60, SlotMinutes: 60,
+			EffectiveFrom: tomorrow, EffectiveTo: &tomorrow, IsPublished: true,
+		}
+	}
+
+	test := setUpAvailabilityTemplateServiceTest(t)
+	defer test.ctrl.Finish()
+
+	test.templateRepo.EXPECT().
+		GetPublished(gomock.Any()).
+		Return([]*entity.AvailabilityTemplate{newTemplate(3, 5), newTemplate(4, 6), newTemplate(7, 8)}, nil).
+		Times(1)
+
+	test.userRepo.EXPECT().
+		GetByID(gomock.Any(), int64(5)).
+		Return(nil, errors.New("db error")).
+		Times(1)
+
+	for _, modelID := range []int64{6, 8} {
+		test.userRepo.EXPECT().
+			GetByID(gomock.Any(), modelID).
+			Return(&entity.User{ID: modelID}, nil).
+			Times(1)
+
+		test.rules.EXPECT().
+			RulesOf(gomock.Any(), modelID).
+			Return(testBookingRules(), nil).
+			Times(1)
+
+		test.slotRepo.EXPECT().
+			GetOverlappingSlots(gomock.Any(), modelID, gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+
+		test.timeOffRepo.EXPECT().
+			GetOverlapping(gomock.Any(), modelID, gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+	}
+
+	test.slotRepo.EXPECT().
+		SaveAll(gomock.Any(), gomock.Any()).
+		Return(persistence.ErrRangeOverlap).
+		Times(1)
+
+	test.slotRepo.EXPECT().
+		SaveAll(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	generated, err := test.service.GenerateSlots(context.Background())
+
+	assert.ErrorContains(t, err, "db error")
+	assert.ErrorIs(t, err, persistence.ErrRangeOverlap)
+	assert.Equal(t, 2, generated)
+}
+
+func TestAvailabilityTemplateService_UpdateTemplate(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+	from := time.Now().AddDate(0, 0, 1)
+
+	tests := []struct {
+		name          string
+		mockTemplate  *entity.AvailabilityTemplate
+		expectUpdate  bool
+		mockUpdateErr error
+		mockRelease   error
+		expectedError error
+	}{
+		{
+			name:         "draft is replaced without touching slots",
+			mockTemplate: &entity.AvailabilityTemplate{ID: 3, ModelID: 5},
+			expectUpdate: true,
+		},
+		{
+			name:         "published template is generated anew",
+			mockTemplate: &entity.AvailabilityTemplate{ID: 3, ModelID: 5, IsPublished: true},
+			expectUpdate: true,
+		},
+		{
+			name:          "failed to release slots",
+			mockTemplate:  &entity.AvailabilityTemplate{ID: 3, ModelID: 5, IsPublished: true},
+			expectUpdate:  true,
+			mockRelease:   errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+		{
+			name:          "template of another model",
+			mockTemplate:  &entity.AvailabilityTemplate{ID: 3, ModelID: 6},
+			expectedError: service_errors.ErrModelIsNotAnOwnerOfTemplate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpAvailabilityTemplateServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+				Return(verifiedModel, nil).
+				Times(1)
+
+			test.templateRepo.EXPECT().
+				GetByID(gomock.Any(), int64(3)).
+				Return(tt.mockTemplate, nil).
+				Times(1)
+
+			if tt.mockTemplate.ModelID == verifiedModel.ID {
+				test.rules.EXPECT().
+					RulesOf(gomock.Any(), int64(5)).
+					Return(testBookingRules(), nil).
+					Times(1)
+			}
+
+			if tt.expectUpdate {
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.templateRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context,
+						template *entity.AvailabilityTemplate) (*entity.AvailabilityTemplate, error) {
+						return template, tt.mockUpdateErr
+					}).
+					Times(1)
+			}
+
+			if tt.expectUpdate && tt.mockTemplate.IsPublished {
+				test.slotRepo.EXPECT().
+					ReleaseTemplateSlots(gomock.Any(), int64(3), gomock.Any()).
+					Return(int64(2), tt.mockRelease).
+					Times(1)
+
+				if tt.mockRelease == nil {
+					test.rules.EXPECT().
+						RulesOf(gomock.Any(), int64(5)).
+						Return(testBookingRules(), nil).
+						Times(1)
+
+					test.slotRepo.EXPECT().
+						GetOverlappingSlots(gomock.Any(), int64(5), gomock.Any(), gomock.Any()).
+						Return(nil, nil).
+						Times(1)
+
+					test.timeOffRepo.EXPECT().
+						GetOverlapping(gomock.Any(), int64(5), gomock.Any(), gomock.Any()).
+						Return(nil, nil).
+						Times(1)
+
+					test.slotRepo.EXPECT().
+						SaveAll(gomock.Any(), gomock.Any()).
+						Return(nil).
+						Times(1)
+				}
+			}
+
+			res, err := test.service.UpdateTemplate(ctxModel, 3, "Weekend nights", []int{5, 6}, "20:00", "02:00",
+				60, from, nil, nil)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []int{5, 6}, res.Weekdays)
+				assert.Equal(t, 60, res.SlotMinutes)
+			}
+		})
+	}
+}
+
+func TestAvailabilityTemplateService_PreviewTemplate(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now().In(moscow)
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+	at := func(hour int) time.Time {
+		return time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), hour, 0, 0, 0, moscow)
+	}
+
+	templateID := int64(3)
+	template := &entity.AvailabilityTemplate{
+		ID: templateID, ModelID: 5, Weekdays: []int{0, 1, 2, 3, 4, 5, 6},
+		StartMinute: 10 * 60, EndMinute: 13 * 60, SlotMinutes: 60,
+		EffectiveFrom: tomorrow, EffectiveTo: &tomorrow, IsPublished: true,
+	}
+
+	test := setUpAvailabilityTemplateServiceTest(t)
+	defer test.ctrl.Finish()
+
+	test.userRepo.EXPECT().
+		GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+		Return(verifiedModel, nil).
+		Times(1)
+
+	test.templateRepo.EXPECT().
+		GetByID(gomock.Any(), templateID).
+		Return(template, nil).
+		Times(1)
+
+	test.rules.EXPECT().
+		RulesOf(gomock.Any(), int64(5)).
+		Return(testBookingRules(), nil).
+		Times(1)
+
+	test.slotRepo.EXPECT().
+		GetOverlappingSlots(gomock.Any(), int64(5), at(10), at(13)).
+		Return([]*entity.Slot{
+			{TemplateID: &templateID, StartTime: at(10), EndTime: at(11), Status: entity.SlotAvailable},
+			{TemplateID: &templateID, StartTime: at(11), EndTime: at(12), Status: entity.SlotBooked},
+		}, nil).
+		Times(1)
+
+	test.timeOffRepo.EXPECT().
+		GetOverlapping(gomock.Any(), int64(5), at(10), at(13)).
+		Return(nil, nil).
+		Times(1)
+
+	res, err := test.service.PreviewTemplate(ctxModel, templateID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entity.SlotPreview{
+		{StartTime: at(10), EndTime: at(11)},
+		{StartTime: at(11), EndTime: at(12), Skipped: true},
+		{StartTime: at(12), EndTime: at(13)},
+	}, res)
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type DefaultBookingRulesService struct {
+	rulesRepo interfaces.BookingRulesRepository
+	userRepo  interfaces.UserRepository
+	logger    pkg.Logger
+	platform  entity.BookingRules
+}
+
+func NewDefaultBookingRulesService(rulesRepo interfaces.BookingRulesRepository,
+	userRepo interfaces.UserRepository, logger pkg.Logger) (*DefaultBookingRulesService, error) {
+
+	var values [4]int
+	for i, key := range []string{
+		service_const.DotEnvBookingMinNotice, service_const.DotEnvBookingHorizon,
+		service_const.DotEnvSlotMinDuration, service_const.DotEnvSlotMaxDuration,
+	} {
+		value := os.Getenv(key)
+		if value == "" {
+			return nil, service_errors.ErrLoadingBookingRules
+		}
+
+		var err error
+		if values[i], err = strconv.Atoi(value); err != nil {
+			return nil, service_errors.ErrParsingBookingRules
+		}
+	}
+
+	platform := bookingRulesOf(values[0], values[1], values[2], values[3])
+	if values[0] < 0 || values[2] < 0 || values[3] <= 0 || !platform.IsConsistent() {
+		return nil, service_errors.ErrInvalidPlatformRules
+	}
+
+	return &DefaultBookingRulesService{
+		rulesRepo: rulesRepo,
+		userRepo:  userRepo,
+		logger:    logger,
+		platform:  *platform,
+	}, nil
+}
+
+// GetRules gives the rules the model has set and the ones that hold for its slots.
+func (d *DefaultBookingRulesService) GetRules(ctx context.Context) (*entity.BookingRules,
+	*entity.BookingRules, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	own, err := d.ownRules(ctx, model.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return own, d.platform.Tighten(own), nil
+}
+
+// UpdateRules sets the rules of the model, a zero rule leaves the one of the platform. The rules apply
+// to the slots created and booked from now on, the slots and bookings the model already has stay.
+func (d *DefaultBookingRulesService) UpdateRules(ctx context.Context, minNoticeMinutes, horizonDays,
+	minSlotMinutes, maxSlotMinutes int) (*entity.BookingRules, *entity.BookingRules, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	own := bookingRulesOf(minNoticeMinutes, horizonDays, minSlotMinutes, maxSlotMinutes)
+	if minNoticeMinutes < 0 || horizonDays < 0 || minSlotMinutes < 0 || maxSlotMinutes < 0 ||
+		!d.platform.Tighten(own).IsConsistent() {
+		d.logger.Error(ctx, "invalid booking rules",
+			option.Any("auth_id", authID),
+			option.Any("min_notice_minutes", minNoticeMinutes),
+			option.Any("horizon_days", horizonDays),
+			option.Any("min_slot_minutes", minSlotMinutes),
+			option.Any("max_slot_minutes", maxSlotMinutes),
+			option.Error(service_errors.ErrInvalidBookingRules))
+
+		return nil, nil, service_errors.ErrInvalidBookingRules
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	own.ModelID = model.ID
+	res, err := d.rulesRepo.Save(ctx, own)
+	if err != nil {
+		d.logger.Error(ctx, "failed to save booking rules",
+			option.Any("model_id", model.ID),
+			option.Error(err))
+
+		return nil, nil, err
+	}
+
+	return res, d.platform.Tighten(res), nil
+}
+
+// RulesOf gives the rules that hold for the slots of the model, the ones of the platform
+// tightened by the ones of the model.
+func (d *DefaultBookingRulesService) RulesOf(ctx context.Context, modelID int64) (*entity.BookingRules, error) {
+	own, err := d.ownRules(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.platform.Tighten(own), nil
+}
+
+// ownRules gives the rules the model has set, a model that has not set them has none.
+func (d *DefaultBookingRulesService) ownRules(ctx context.Context, modelID int64) (*entity.BookingRules, error) {
+	res, err := d.rulesRepo.GetByModelID(ctx, modelID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			return &entity.BookingRules{ModelID: modelID}, nil
+		}
+
+		d.logger.Error(ctx, "failed to get booking rules by model id",
+			option.Any("model_id", modelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultBookingRulesService) checkModelRestrictions(ctx context.Context,
+	authID *int64) (*entity.User, error) {
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleModel.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAModel))
+
+		return nil, service_errors.ErrNotAModel
+	}
+
+	model, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotAModel))
+
+			return nil, service_errors.ErrNotAModel
+		}
+
+		d.logger.Error(ctx, "check model restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !model.IsUserVerified() {
+		d.logger.Error(ctx, "model is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedModel))
+
+		return nil, service_errors.ErrNotVerifiedModel
+	}
+
+	return model, nil
+}
+
+// bookingRulesOf builds the rules from the units they are set in, the model is filled in by the caller.
+func bookingRulesOf(minNoticeMinutes, horizonDays, minSlotMinutes, maxSlotMinutes int) *entity.BookingRules {
+	return entity.NewBookingRules(0,
+		time.Duration(minNoticeMinutes)*time.Minute,
+		time.Duration(horizonDays)*24*time.Hour,
+		time.Duration(minSlotMinutes)*time.Minute,
+		time.Duration(maxSlotMinutes)*time.Minute)
+}
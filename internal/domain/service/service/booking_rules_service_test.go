@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// testBookingRules are the rules the slot and booking tests run under unless a case sets its own.
+func testBookingRules() *entity.BookingRules {
+	return &entity.BookingRules{
+		MinNotice:       time.Hour,
+		Horizon:         365 * 24 * time.Hour,
+		MinSlotDuration: 30 * time.Minute,
+		MaxSlotDuration: 24 * time.Hour,
+	}
+}
+
+type bookingRulesServiceTest struct {
+	ctrl      *gomock.Controller
+	rulesRepo *mocks.MockBookingRulesRepository
+	userRepo  *mocks.MockUserRepository
+	service   *DefaultBookingRulesService
+}
+
+func setUpBookingRulesServiceTest(t *testing.T) *bookingRulesServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	rulesRepo := mocks.NewMockBookingRulesRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(service_const.DotEnvBookingMinNotice, "60")
+	t.Setenv(service_const.DotEnvBookingHorizon, "180")
+	t.Setenv(service_const.DotEnvSlotMinDuration, "30")
+	t.Setenv(service_const.DotEnvSlotMaxDuration, "720")
+
+	rulesService, err := NewDefaultBookingRulesService(rulesRepo, userRepo, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &bookingRulesServiceTest{
+		ctrl:      ctrl,
+		rulesRepo: rulesRepo,
+		userRepo:  userRepo,
+		service:   rulesService,
+	}
+}
+
+func TestBookingRulesService_RulesOf(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockRules     *entity.BookingRules
+		mockErr       error
+		expected      *entity.BookingRules
+		expectedError error
+	}{
+		{
+			name:    "platform rules hold for a model without its own",
+			mockErr: persistence.ErrNoRowsFound,
+			expected: &entity.BookingRules{ModelID: 5, MinNotice: time.Hour, Horizon: 180 * 24 * time.Hour,
+				MinSlotDuration: 30 * time.Minute, MaxSlotDuration: 12 * time.Hour},
+		},
+		{
+			name: "stricter rule of each pair holds",
+			mockRules: &entity.BookingRules{ModelID: 5, MinNotice: 24 * time.Hour, Horizon: 365 * 24 * time.Hour,
+				MinSlotDuration: 10 * time.Minute, MaxSlotDuration: 2 * time.Hour},
+			expected: &entity.BookingRules{ModelID: 5, MinNotice: 24 * time.Hour, Horizon: 180 * 24 * time.Hour,
+				MinSlotDuration: 30 * time.Minute, MaxSlotDuration: 2 * time.Hour},
+		},
+		{
+			name:      "zero rules of the model are not set",
+			mockRules: &entity.BookingRules{ModelID: 5, Horizon: 30 * 24 * time.Hour},
+			expected: &entity.BookingRules{ModelID: 5, MinNotice: time.Hour, Horizon: 30 * 24 * time.Hour,
+				MinSlotDuration: 30 * time.Minute, MaxSlotDuration: 12 * time.Hour},
+		},
+		{
+			name:          "failed to get rules",
+			mockErr:       errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpBookingRulesServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.rulesRepo.EXPECT().
+				GetByModelID(gomock.Any(), int64(5)).
+				Return(tt.mockRules, tt.mockErr).
+				Times(1)
+
+			res, err := test.service.RulesOf(context.Background(), 5)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func TestBookingRulesService_UpdateRules(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+
+	tests := []struct {
+		name              string
+		ctx               context.Context
+		minNotice         int
+		horizon           int
+		minSlot           int
+		maxSlot           int
+		mockModel         *entity.User
+		expectSave        bool
+		mockSaveErr       error
+		expectedEffective *entity.BookingRules
+		expectedError     error
+	}{
+		{
+			name:       "rules are saved",
+			ctx:        ctxModel,
+			minNotice:  1440,
+			horizon:    30,
+			maxSlot:    180,
+			mockModel:  verifiedModel,
+			expectSave: true,
+			expectedEffective: &entity.BookingRules{ModelID: 5, MinNotice: 24 * time.Hour,
+				Horizon: 30 * 24 * time.Hour, MinSlotDuration: 30 * time.Minute, MaxSlotDuration: 3 * time.Hour},
+		},
+		{
+			name:          "negative rule",
+			ctx:           ctxModel,
+			minNotice:     -1,
+			expectedError: service_errors.ErrInvalidBookingRules,
+		},
+		{
+			name:          "notice as long as the horizon",
+			ctx:           ctxModel,
+			minNotice:     2 * 1440,
+			horizon:       2,
+			expectedError: service_errors.ErrInvalidBookingRules,
+		},
+		{
+			name:          "shortest slot longer than the longest one of the platform",
+			ctx:           ctxModel,
+			minSlot:       780,
+			expectedError: service_errors.ErrInvalidBookingRules,
+		},
+		{
+			name:          "not a model",
+			ctx:           ctxClient,
+			maxSlot:       180,
+			expectedError: service_errors.ErrNotAModel,
+		},
+		{
+			name:          "failed to save",
+			ctx:           ctxModel,
+			maxSlot:       180,
+			mockModel:     verifiedModel,
+			expectSave:    true,
+			mockSaveErr:   errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpBookingRulesServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.mockModel != nil {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), tt.mockModel.AuthID).
+					Return(tt.mockModel, nil).
+					Times(1)
+			}
+
+			if tt.expectSave {
+				test.rulesRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, rules *entity.BookingRules) (*entity.BookingRules, error) {
+						if tt.mockSaveErr != nil {
+							return nil, tt.mockSaveErr
+						}
+						return rules, nil
+					}).
+					Times(1)
+			}
+
+			own, effective, err := test.service.UpdateRules(tt.ctx, tt.minNotice, tt.horizon, tt.minSlot,
+				tt.maxSlot)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, own)
+				assert.Nil(t, effective)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, verifiedModel.ID, own.ModelID)
+			assert.Equal(t, time.Duration(tt.minNotice)*time.Minute, own.MinNotice)
+			assert.Equal(t, time.Duration(tt.horizon)*24*time.Hour, own.Horizon)
+
+			tt.expectedEffective.UpdatedAt = own.UpdatedAt
+			assert.Equal(t, tt.expectedEffective, effective)
+		})
+	}
+}
+
+func TestNewDefaultBookingRulesService_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		minNotice     string
+		horizon       string
+		minSlot       string
+		maxSlot       string
+		expectedError error
+	}{
+		{
+			name:          "missing horizon",
+			minNotice:     "60",
+			minSlot:       "30",
+			maxSlot:       "720",
+			expectedError: service_errors.ErrLoadingBookingRules,
+		},
+		{
+			name:          "invalid notice",
+			minNotice:     "hour",
+			horizon:       "180",
+			minSlot:       "30",
+			maxSlot:       "720",
+			expectedError: service_errors.ErrParsingBookingRules,
+		},
+		{
+			name:          "zero horizon",
+			minNotice:     "0",
+			horizon:       "0",
+			minSlot:       "30",
+			maxSlot:       "720",
+			expectedError: service_errors.ErrInvalidPlatformRules,
+		},
+		{
+			name:          "shortest slot longer than the longest one",
+			minNotice:     "60",
+			horizon:       "180",
+			minSlot:       "60",
+			maxSlot:       "30",
+			expectedError: service_errors.ErrInvalidPlatformRules,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(service_const.DotEnvBookingMinNotice, tt.minNotice)
+			t.Setenv(service_const.DotEnvBookingHorizon, tt.horizon)
+			t.Setenv(service_const.DotEnvSlotMinDuration, tt.minSlot)
+			t.Setenv(service_const.DotEnvSlotMaxDuration, tt.maxSlot)
+
+			res, err := NewDefaultBookingRulesService(nil, nil, nil)
+
+			assert.EqualError(t, err, tt.expectedError.Error())
+			assert.Nil(t, res)
+		})
+	}
+}
@@ -24,14 +24,25 @@ type DefaultBookingService struct {
 	orderRepo        interfaces.OrderRepository
 	userRepo         interfaces.UserRepository
 	modelServiceRepo interfaces.ModelServiceRepository
+	addOnRepo        interfaces.AddOnRepository
+	payments         interfaces.PaymentProcessor
+	surcharges       interfaces.SurchargeCalculator
+	promoCodes       interfaces.PromoCodeRedeemer
+	quotes           interfaces.QuoteSigner
+	buffers          interfaces.TravelBufferProvider
+	rules            interfaces.BookingRulesProvider
 	txManager        database.TxManager
 	logger           pkg.Logger
 	bookingTtl       time.Duration
+	pricedDuration   time.Duration
 }
 
 func NewDefaultBookingService(bookingRepo interfaces.BookingRepository, slotRepo interfaces.SlotRepository,
 	userRepo interfaces.UserRepository, modelServiceRepo interfaces.ModelServiceRepository,
-	orderRepo interfaces.OrderRepository, txManager database.TxManager, logger pkg.Logger,
+	addOnRepo interfaces.AddOnRepository, orderRepo interfaces.OrderRepository, payments interfaces.PaymentProcessor,
+	surcharges interfaces.SurchargeCalculator, promoCodes interfaces.PromoCodeRedeemer, quotes interfaces.QuoteSigner,
+	buffers interfaces.TravelBufferProvider, rules interfaces.BookingRulesProvider, txManager database.TxManager,
+	logger pkg.Logger,
 ) (*DefaultBookingService, error) {
 
 	ttl := os.Getenv(service_const.DotEnvBookingExpiration)
@@ -47,20 +58,40 @@ func NewDefaultBookingService(bookingRepo interfaces.BookingRepository, slotRepo
 		return nil, service_errors.ErrNotPositiveTTL
 	}
 
+	// without the setting the service price stays the same for a slot of any length
+	var pricedMinutes int
+	if value := os.Getenv(service_const.DotEnvServicePriceDuration); value != "" {
+		if pricedMinutes, err = strconv.Atoi(value); err != nil {
+			return nil, service_errors.ErrParsingServicePriceDuration
+		}
+		if pricedMinutes < 0 {
+			return nil, service_errors.ErrNegativeServicePriceDuration
+		}
+	}
+
 	return &DefaultBookingService{
 		bookingRepo:      bookingRepo,
 		slotRepo:         slotRepo,
 		orderRepo:        orderRepo,
 		userRepo:         userRepo,
 		modelServiceRepo: modelServiceRepo,
+		addOnRepo:        addOnRepo,
+		payments:         payments,
+		surcharges:       surcharges,
+		promoCodes:       promoCodes,
+		quotes:           quotes,
+		buffers:          buffers,
+		rules:            rules,
 		txManager:        txManager,
 		logger:           logger,
 		bookingTtl:       time.Duration(ttlInSeconds) * time.Second,
+		pricedDuration:   time.Duration(pricedMinutes) * time.Minute,
 	}, nil
 }
 
 func (d *DefaultBookingService) CreateBooking(ctx context.Context, modelServiceID,
-	slotID int64, street string, house int, apartment, entrance, floor *int, comment *string) (*entity.Booking, error) {
+	slotID int64, street string, house int, apartment, entrance, floor *int, comment *string,
+	promoCode *string, addOnIDs []int64, quoteToken *string) (*entity.Booking, error) {
 
 	authID, err := common.GetAuthIDFromContext(ctx)
 	if err != nil {
@@ -72,6 +103,122 @@ func (d *DefaultBookingService) CreateBooking(ctx context.Context, modelServiceI
 		return nil, err
 	}
 
+	address := addressOf(street, house, apartment, entrance, floor, comment)
+
+	var quote *entity.Quote
+	if quoteToken != nil && *quoteToken != "" {
+		if quote, err = d.checkQuote(ctx, *quoteToken, client.ID, modelServiceID, slotID, address,
+			promoCode, addOnIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	booking, slot, promo, err := d.priceBooking(ctx, authID, client, modelServiceID, slotID, address,
+		promoCode, addOnIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if quote != nil {
+		quote.ApplyTo(booking)
+	}
+
+	var res *entity.Booking
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		slot.Status = entity.SlotReserved
+		if slot, err = d.slotRepo.Update(ctx, slot); err != nil {
+			if errors.Is(err, persistence.ErrNoRowsFound) {
+				d.logger.Error(ctx, "slot not found by id",
+					option.Any("slot_id", slotID),
+					option.Any("model_service_id", modelServiceID),
+					option.Any("auth_id", authID),
+					option.Error(service_errors.ErrSlotIsNotFound))
+
+				return service_errors.ErrSlotIsNotFound
+			}
+
+			d.logger.Error(ctx, "failed to update slot",
+				option.Any("slot_id", slotID),
+				option.Any("model_service_id", modelServiceID),
+				option.Any("auth_id", authID),
+				option.Error(err))
+
+			return err
+		}
+
+		if err = d.bookingRepo.Save(ctx, booking); err != nil {
+			d.logger.Error(ctx, "failed to save booking",
+				option.Any("slot_id", slotID),
+				option.Any("model_service_id", modelServiceID),
+				option.Any("auth_id", authID),
+				option.Error(err))
+
+			return err
+		}
+
+		if promo != nil {
+			if err = d.promoCodes.Redeem(ctx, promo, client.ID, booking.ID); err != nil {
+				return err
+			}
+		}
+
+		res = booking
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// QuoteBooking prices the booking the same way CreateBooking does, but nothing is reserved.
+// The returned token lets CreateBooking keep the quoted price until the quote expires.
+func (d *DefaultBookingService) QuoteBooking(ctx context.Context, modelServiceID, slotID int64,
+	street string, house int, apartment, entrance, floor *int, comment *string,
+	promoCode *string, addOnIDs []int64) (*entity.Quote, string, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	client, err := d.checkClientRestrictions(ctx, authID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	address := addressOf(street, house, apartment, entrance, floor, comment)
+	booking, _, _, err := d.priceBooking(ctx, authID, client, modelServiceID, slotID, address,
+		promoCode, addOnIDs)
+	if err != nil {
+		return nil, "", err
+	}
+
+	quote := entity.NewQuote(booking, promoCodeOf(promoCode), addOnIDs)
+	token, err := d.quotes.Sign(quote)
+	if err != nil {
+		d.logger.Error(ctx, "failed to sign quote",
+			option.Any("slot_id", slotID),
+			option.Any("model_service_id", modelServiceID),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, "", err
+	}
+
+	return quote, token, nil
+}
+
+// priceBooking checks the slot and the service and builds the booking with its price breakdown:
+// the service price scaled to the length of the slot, then surcharges, add-ons and the travel fee,
+// then the promo discount.
+func (d *DefaultBookingService) priceBooking(ctx context.Context, authID *int64, client *entity.User,
+	modelServiceID, slotID int64, address entity.Address, promoCode *string,
+	addOnIDs []int64) (*entity.Booking, *entity.Slot, *entity.PromoCode, error) {
+
 	slot, err := d.slotRepo.GetByID(ctx, slotID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNoRowsFound) {
@@ -81,7 +228,7 @@ func (d *DefaultBookingService) CreateBooking(ctx context.Context, modelServiceI
 				option.Any("auth_id", authID),
 				option.Error(service_errors.ErrSlotIsNotFound))
 
-			return nil, service_errors.ErrSlotIsNotFound
+			return nil, nil, nil, service_errors.ErrSlotIsNotFound
 		}
 
 		d.logger.Error(ctx, "failed to find slot  by id",
@@ -90,7 +237,7 @@ func (d *DefaultBookingService) CreateBooking(ctx context.Context, modelServiceI
 			option.Any("auth_id", authID),
 			option.Error(err))
 
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	if !slot.IsAvailable() {
@@ -100,7 +247,7 @@ func (d *DefaultBookingService) CreateBooking(ctx context.Context, modelServiceI
 			option.Any("auth_id", authID),
 			option.Error(service_errors.ErrSlotNotAvailable))
 
-		return nil, service_errors.ErrSlotNotAvailable
+		return nil, nil, nil, service_errors.ErrSlotNotAvailable
 	}
 
 	if !slot.IsCorrectTransition(entity.SlotReserved) {
@@ -110,70 +257,154 @@ func (d *DefaultBookingService) CreateBooking(ctx context.Context, modelServiceI
 			option.Any("auth_id", authID),
 			option.Error(service_errors.ErrInvalidSlotStatusTransition))
 
-		return nil, service_errors.ErrInvalidSlotStatusTransition
+		return nil, nil, nil, service_errors.ErrInvalidSlotStatusTransition
 	}
 
-	var resApartment, resEntrance, resFloor int
-	var resComment string
-	if apartment == nil {
-		resApartment = 0
+	if err = d.checkRules(ctx, authID, slot); err != nil {
+		return nil, nil, nil, err
 	}
-	if entrance == nil {
-		resEntrance = 0
+
+	buffer, err := d.checkTravelBuffer(ctx, authID, slot)
+	if err != nil {
+		return nil, nil, nil, err
 	}
-	if floor == nil {
-		resFloor = 0
+
+	modelService, err := d.modelServiceRepo.GetByID(ctx, modelServiceID, false)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model service not found by id",
+				option.Any("model_service_id", modelServiceID),
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrServiceIsNotFound))
+
+			return nil, nil, nil, service_errors.ErrServiceIsNotFound
+		}
+
+		d.logger.Error(ctx, "failed to find model service by id",
+			option.Any("model_service_id", modelServiceID),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, nil, nil, err
+	}
+
+	booking := entity.NewBooking(client.ID, modelServiceID, slotID, address, modelService.Price, d.bookingTtl)
+	if err = booking.ScaleToDuration(slot.EndTime.Sub(slot.StartTime), d.pricedDuration); err != nil {
+		d.logger.Error(ctx, "failed to price booking",
+			option.Any("model_service_id", modelServiceID),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, nil, nil, err
+	}
+
+	surcharges, err := d.surcharges.Surcharges(ctx, modelService, slot)
+	if err != nil {
+		return nil, nil, nil, err
 	}
-	if comment == nil {
-		resComment = ""
+	if err = booking.ApplySurcharges(surcharges); err != nil {
+		d.logger.Error(ctx, "failed to price booking",
+			option.Any("model_service_id", modelServiceID),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, nil, nil, err
 	}
 
-	address := entity.NewAddress(street, house, resApartment, resEntrance, resFloor, resComment)
-	booking := entity.NewBooking(client.ID, modelServiceID, slotID, address, d.bookingTtl)
+	addOns, err := d.selectAddOns(ctx, modelService, addOnIDs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err = booking.ApplyAddOns(addOns); err != nil {
+		d.logger.Error(ctx, "failed to price booking",
+			option.Any("model_service_id", modelServiceID),
+			option.Any("auth_id", authID),
+			option.Error(err))
 
-	var res *entity.Booking
-	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
-		slot.Status = entity.SlotReserved
-		if slot, err = d.slotRepo.Update(ctx, slot); err != nil {
-			if errors.Is(err, persistence.ErrNoRowsFound) {
-				d.logger.Error(ctx, "slot not found by id",
-					option.Any("slot_id", slotID),
-					option.Any("model_service_id", modelServiceID),
-					option.Any("auth_id", authID),
-					option.Error(service_errors.ErrSlotIsNotFound))
+		return nil, nil, nil, err
+	}
 
-				return service_errors.ErrSlotIsNotFound
-			}
+	if err = booking.ApplyTravelFee(buffer.Fee); err != nil {
+		d.logger.Error(ctx, "failed to price booking",
+			option.Any("model_service_id", modelServiceID),
+			option.Any("auth_id", authID),
+			option.Error(err))
 
-			d.logger.Error(ctx, "failed to update slot",
-				option.Any("slot_id", slotID),
-				option.Any("model_service_id", modelServiceID),
-				option.Any("auth_id", authID),
-				option.Error(err))
+		return nil, nil, nil, err
+	}
 
-			return err
+	var promo *entity.PromoCode
+	if code := promoCodeOf(promoCode); code != "" {
+		if promo, err = d.promoCodes.Validate(ctx, code, client.ID, modelService); err != nil {
+			return nil, nil, nil, err
 		}
 
-		if err = d.bookingRepo.Save(ctx, booking); err != nil {
-			d.logger.Error(ctx, "failed to save booking",
-				option.Any("slot_id", slotID),
+		if err = booking.ApplyPromoCode(promo); err != nil {
+			d.logger.Error(ctx, "failed to price booking",
 				option.Any("model_service_id", modelServiceID),
 				option.Any("auth_id", authID),
 				option.Error(err))
 
-			return err
+			return nil, nil, nil, err
 		}
+	}
 
-		res = booking
+	return booking, slot, promo, nil
+}
 
-		return nil
-	})
+// checkQuote makes sure the token is genuine, not expired and was issued for this very request.
+func (d *DefaultBookingService) checkQuote(ctx context.Context, token string, clientID, modelServiceID,
+	slotID int64, address entity.Address, promoCode *string, addOnIDs []int64) (*entity.Quote, error) {
 
+	quote, err := d.quotes.Parse(token)
 	if err != nil {
+		d.logger.Error(ctx, "failed to parse quote token",
+			option.Any("slot_id", slotID),
+			option.Any("model_service_id", modelServiceID),
+			option.Any("client_id", clientID),
+			option.Error(err))
+
 		return nil, err
 	}
 
-	return res, nil
+	if !quote.Matches(clientID, modelServiceID, slotID, address, promoCodeOf(promoCode), addOnIDs) {
+		d.logger.Error(ctx, "quote does not match booking request",
+			option.Any("slot_id", slotID),
+			option.Any("model_service_id", modelServiceID),
+			option.Any("client_id", clientID),
+			option.Error(service_errors.ErrQuoteMismatch))
+
+		return nil, service_errors.ErrQuoteMismatch
+	}
+
+	return quote, nil
+}
+
+// addressOf builds the address of the request, the optional parts left out are zero.
+func addressOf(street string, house int, apartment, entrance, floor *int, comment *string) entity.Address {
+	address := entity.NewAddress(street, house, 0, 0, 0, "")
+	if apartment != nil {
+		address.Apartment = *apartment
+	}
+	if entrance != nil {
+		address.Entrance = *entrance
+	}
+	if floor != nil {
+		address.Floor = *floor
+	}
+	if comment != nil {
+		address.Comment = *comment
+	}
+
+	return address
+}
+
+func promoCodeOf(promoCode *string) string {
+	if promoCode == nil {
+		return ""
+	}
+
+	return *promoCode
 }
 
 func (d *DefaultBookingService) ApproveBooking(ctx context.Context, bookingID int64) (*entity.Booking, error) {
@@ -206,7 +437,7 @@ func (d *DefaultBookingService) ApproveBooking(ctx context.Context, bookingID in
 		return nil, err
 	}
 
-	err = d.checkIfModelIsAnOwner(ctx, model.ID, booking.ModelServiceID)
+	_, err = d.checkIfModelIsAnOwner(ctx, model.ID, booking.ModelServiceID)
 	if err != nil {
 		return nil, err
 	}
@@ -253,6 +484,7 @@ func (d *DefaultBookingService) ApproveBooking(ctx context.Context, bookingID in
 	}
 
 	var res *entity.Booking
+	var payment *entity.Payment
 	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
 		slot.Status = entity.SlotBooked
 		if _, err = d.slotRepo.Update(ctx, slot); err != nil {
@@ -305,10 +537,31 @@ func (d *DefaultBookingService) ApproveBooking(ctx context.Context, bookingID in
 			return err
 		}
 
+		if !booking.Price.IsPositive() {
+			return nil
+		}
+
+		if payment, err = d.payments.Authorize(ctx, order.ID, booking.Price); err != nil {
+			d.logger.Error(ctx, "failed to authorize payment",
+				option.Any("order_id", order.ID),
+				option.Any("booking_id", booking.ID),
+				option.Any("auth_id", authID),
+				option.Error(err))
+
+			return err
+		}
+
 		return nil
 	})
 
 	if err != nil {
+		// the payment row is rolled back with the order, so the hold on the card is dropped as well
+		if payment != nil {
+			if voidErr := d.payments.VoidAuthorization(ctx, payment); voidErr != nil {
+				return nil, errors.Join(err, voidErr)
+			}
+		}
+
 		return nil, err
 	}
 
@@ -345,7 +598,7 @@ func (d *DefaultBookingService) RejectBooking(ctx context.Context, bookingID int
 		return nil, err
 	}
 
-	err = d.checkIfModelIsAnOwner(ctx, model.ID, booking.ModelServiceID)
+	_, err = d.checkIfModelIsAnOwner(ctx, model.ID, booking.ModelServiceID)
 	if err != nil {
 		return nil, err
 	}
@@ -438,7 +691,7 @@ func (d *DefaultBookingService) RejectBooking(ctx context.Context, bookingID int
 			return err
 		}
 
-		return nil
+		return d.releasePromoCode(ctx, booking)
 	})
 
 	if err != nil {
@@ -574,16 +827,210 @@ func (d *DefaultBookingService) CancelBookingByClient(ctx context.Context, booki
 			return err
 		}
 
+		return d.releasePromoCode(ctx, booking)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ExpireBookings expires pending bookings the model did not answer in time,
+// frees their slots and gives the promo codes back.
+func (d *DefaultBookingService) ExpireBookings(ctx context.Context) (int, error) {
+	var expired []*entity.Booking
+	err := d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if expired, err = d.bookingRepo.ExpirePending(ctx, time.Now()); err != nil {
+			d.logger.Error(ctx, "failed to expire bookings",
+				option.Error(err))
+
+			return err
+		}
+
+		for _, booking := range expired {
+			if err = d.releaseSlot(ctx, booking.SlotID); err != nil {
+				return err
+			}
+
+			if err = d.releasePromoCode(ctx, booking); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(expired), nil
+}
 
+func (d *DefaultBookingService) releaseSlot(ctx context.Context, slotID int64) error {
+	slot, err := d.slotRepo.GetByID(ctx, slotID)
 	if err != nil {
+		d.logger.Error(ctx, "failed to find slot by id",
+			option.Any("slot_id", slotID),
+			option.Error(err))
+
+		return err
+	}
+
+	if slot.Status != entity.SlotReserved {
+		return nil
+	}
+
+	slot.Status = entity.SlotAvailable
+	if _, err = d.slotRepo.Update(ctx, slot); err != nil {
+		d.logger.Error(ctx, "failed to update slot",
+			option.Any("slot_id", slotID),
+			option.Error(err))
+
+		return err
+	}
+
+	return nil
+}
+
+// checkRules makes sure the slot starts neither sooner than the model gives notice nor beyond
+// the booking horizon.
+func (d *DefaultBookingService) checkRules(ctx context.Context, authID *int64, slot *entity.Slot) error {
+	rules, err := d.rules.RulesOf(ctx, slot.ModelID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	switch {
+	case !rules.GivesNotice(slot.StartTime, now):
+		err = service_errors.ErrBookingTooSoon
+	case !rules.WithinHorizon(slot.StartTime, now):
+		err = service_errors.ErrBookingBeyondHorizon
+	}
+	if err != nil {
+		d.logger.Error(ctx, "slot breaks the booking rules of model",
+			option.Any("slot_id", slot.ID),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return err
+	}
+
+	return nil
+}
+
+// checkTravelBuffer makes sure the model has the time to get to the slot from the reserved and booked
+// slots around it and away to them, the buffer is returned for its travel fee.
+func (d *DefaultBookingService) checkTravelBuffer(ctx context.Context, authID *int64,
+	slot *entity.Slot) (*entity.TravelBuffer, error) {
+	buffer, err := d.buffers.BufferOf(ctx, slot.ModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if buffer.IsZero() {
+		return buffer, nil
+	}
+
+	from, to := buffer.Window(slot.StartTime, slot.EndTime)
+	nearby, err := d.slotRepo.GetOverlappingSlots(ctx, slot.ModelID, from, to)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get slots around slot",
+			option.Any("slot_id", slot.ID),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	for _, other := range nearby {
+		if other.ID == slot.ID || (other.Status != entity.SlotReserved && other.Status != entity.SlotBooked) {
+			continue
+		}
+
+		if buffer.Collides(slot, other) {
+			d.logger.Error(ctx, "slot lies within travel buffer of taken slot",
+				option.Any("slot_id", slot.ID),
+				option.Any("taken_slot_id", other.ID),
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrSlotWithinTravelBuffer))
+
+			return nil, service_errors.ErrSlotWithinTravelBuffer
+		}
+	}
+
+	return buffer, nil
+}
+
+// selectAddOns checks the chosen add-ons against the catalog of the booked service version.
+func (d *DefaultBookingService) selectAddOns(ctx context.Context, service *entity.ModelService,
+	addOnIDs []int64) ([]*entity.AddOn, error) {
+
+	if len(addOnIDs) == 0 {
+		return nil, nil
+	}
+
+	catalog, err := d.addOnRepo.GetByServiceID(ctx, service.ID, true)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get add-ons",
+			option.Any("model_service_id", service.ID),
+			option.Error(err))
+
 		return nil, err
 	}
 
+	byID := make(map[int64]*entity.AddOn, len(catalog))
+	for _, addOn := range catalog {
+		byID[addOn.ID] = addOn
+	}
+
+	res := make([]*entity.AddOn, 0, len(addOnIDs))
+	chosen := make(map[int64]bool, len(addOnIDs))
+	for _, id := range addOnIDs {
+		if chosen[id] {
+			d.logger.Error(ctx, "add-on is chosen more than once",
+				option.Any("add_on_id", id),
+				option.Error(service_errors.ErrDuplicateAddOn))
+
+			return nil, service_errors.ErrDuplicateAddOn
+		}
+		chosen[id] = true
+
+		addOn, ok := byID[id]
+		if !ok {
+			d.logger.Error(ctx, "add-on does not belong to service",
+				option.Any("add_on_id", id),
+				option.Any("model_service_id", service.ID),
+				option.Error(service_errors.ErrAddOnNotOfService))
+
+			return nil, service_errors.ErrAddOnNotOfService
+		}
+
+		if !addOn.IsActive {
+			d.logger.Error(ctx, "add-on is not active",
+				option.Any("add_on_id", id),
+				option.Error(service_errors.ErrAddOnIsNotActive))
+
+			return nil, service_errors.ErrAddOnIsNotActive
+		}
+
+		res = append(res, addOn)
+	}
+
 	return res, nil
 }
 
+func (d *DefaultBookingService) releasePromoCode(ctx context.Context, booking *entity.Booking) error {
+	if booking.PromoCodeID == nil {
+		return nil
+	}
+
+	return d.promoCodes.Release(ctx, booking.ID)
+}
+
 func (d *DefaultBookingService) checkModelRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
 	role, err := common.GetRoleFromContext(ctx)
 	if err != nil {
@@ -668,7 +1115,8 @@ func (d *DefaultBookingService) checkClientRestrictions(ctx context.Context, aut
 	return client, nil
 }
 
-func (d *DefaultBookingService) checkIfModelIsAnOwner(ctx context.Context, modelID, modelServiceID int64) error {
+func (d *DefaultBookingService) checkIfModelIsAnOwner(ctx context.Context,
+	modelID, modelServiceID int64) (*entity.ModelService, error) {
 	service, err := d.modelServiceRepo.GetByID(ctx, modelServiceID, false)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNoRowsFound) {
@@ -677,7 +1125,7 @@ func (d *DefaultBookingService) checkIfModelIsAnOwner(ctx context.Context, model
 				option.Any("model_id", modelID),
 				option.Error(service_errors.ErrServiceIsNotFound))
 
-			return service_errors.ErrServiceIsNotFound
+			return nil, service_errors.ErrServiceIsNotFound
 		}
 
 		d.logger.Error(ctx, "check model is an owner failed",
@@ -685,7 +1133,7 @@ func (d *DefaultBookingService) checkIfModelIsAnOwner(ctx context.Context, model
 			option.Any("model_id", modelID),
 			option.Error(err))
 
-		return err
+		return nil, err
 	}
 
 	if service.ModelID != modelID {
@@ -694,8 +1142,8 @@ func (d *DefaultBookingService) checkIfModelIsAnOwner(ctx context.Context, model
 			option.Any("model_service_id", modelServiceID),
 			option.Error(service_errors.ErrModelIsNotAnOwnerOfService))
 
-		return service_errors.ErrModelIsNotAnOwnerOfService
+		return nil, service_errors.ErrModelIsNotAnOwnerOfService
 	}
 
-	return nil
+	return service, nil
 }
@@ -25,6 +25,13 @@ type bookingServiceTest struct {
 	orderRepo        *mocks.MockOrderRepository
 	userRepo         *mocks.MockUserRepository
 	modelServiceRepo *mocks.MockModelServiceRepository
+	addOnRepo        *mocks.MockAddOnRepository
+	payments         *mocks.MockPaymentProcessor
+	surcharges       *mocks.MockSurchargeCalculator
+	promoCodes       *mocks.MockPromoCodeRedeemer
+	quotes           *mocks.MockQuoteSigner
+	buffers          *mocks.MockTravelBufferProvider
+	rules            *mocks.MockBookingRulesProvider
 	txManager        *mocks.MockTxManager
 	service          *DefaultBookingService
 }
@@ -38,6 +45,13 @@ func setUpBookingServiceTest(t *testing.T) *bookingServiceTest {
 	orderRepo := mocks.NewMockOrderRepository(ctrl)
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	modelServiceRepo := mocks.NewMockModelServiceRepository(ctrl)
+	addOnRepo := mocks.NewMockAddOnRepository(ctrl)
+	payments := mocks.NewMockPaymentProcessor(ctrl)
+	surcharges := mocks.NewMockSurchargeCalculator(ctrl)
+	promoCodes := mocks.NewMockPromoCodeRedeemer(ctrl)
+	quotes := mocks.NewMockQuoteSigner(ctrl)
+	buffers := mocks.NewMockTravelBufferProvider(ctrl)
+	rules := mocks.NewMockBookingRulesProvider(ctrl)
 	mockTxManager := mocks.NewMockTxManager(ctrl)
 
 	cfg := &config.LogConfig{}
@@ -55,7 +69,8 @@ func setUpBookingServiceTest(t *testing.T) *bookingServiceTest {
 	}
 
 	bookingService, err := NewDefaultBookingService(
-		bookingRepo, slotRepo, userRepo, modelServiceRepo, orderRepo, mockTxManager, log,
+		bookingRepo, slotRepo, userRepo, modelServiceRepo, addOnRepo, orderRepo, payments, surcharges,
+		promoCodes, quotes, buffers, rules, mockTxManager, log,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -68,6 +83,13 @@ func setUpBookingServiceTest(t *testing.T) *bookingServiceTest {
 		orderRepo:        orderRepo,
 		userRepo:         userRepo,
 		modelServiceRepo: modelServiceRepo,
+		addOnRepo:        addOnRepo,
+		payments:         payments,
+		surcharges:       surcharges,
+		promoCodes:       promoCodes,
+		quotes:           quotes,
+		buffers:          buffers,
+		rules:            rules,
 		txManager:        mockTxManager,
 		service:          bookingService,
 	}
@@ -85,9 +107,11 @@ func TestBookingService_CreateBooking(t *testing.T) {
 		IsVerified: true,
 	}
 
+	slotStart := time.Now().Add(48 * time.Hour)
 	availableSlot := &entity.Slot{
-		ID:     1,
-		Status: entity.SlotAvailable,
+		ID:        1,
+		StartTime: slotStart,
+		Status:    entity.SlotAvailable,
 	}
 
 	reservedSlot := &entity.Slot{
@@ -95,28 +119,210 @@ func TestBookingService_CreateBooking(t *testing.T) {
 		Status: entity.SlotReserved,
 	}
 
+	modelService := &entity.ModelService{
+		ID:      1,
+		ModelID: 5,
+		Price:   rub(100),
+	}
+
+	percentOff := 20
+	promo := &entity.PromoCode{
+		ID:           9,
+		Code:         "SPRING20",
+		DiscountType: entity.DiscountPercent,
+		PercentOff:   &percentOff,
+		IsActive:     true,
+	}
+	promoCode := "spring20"
+
+	nightSurcharge := []entity.PriceComponent{
+		{PricingRuleID: 3, Name: "Night", Amount: rub(50)},
+	}
+
+	addOnCatalog := []*entity.AddOn{
+		{ID: 11, ModelServiceID: 1, Title: "Makeup", Price: rub(30), ExtraMinutes: 30, IsActive: true},
+		{ID: 12, ModelServiceID: 1, Title: "Props", Price: rub(20), IsActive: true},
+		{ID: 13, ModelServiceID: 1, Title: "Old extra", Price: rub(10), IsActive: false},
+	}
+
 	tests := []struct {
-		name           string
-		ctx            context.Context
-		modelServiceID int64
-		slotID         int64
-		mockUser       *entity.User
-		mockUserErr    error
-		mockSlot       *entity.Slot
-		mockSlotErr    error
-		mockUpdateSlot *entity.Slot
-		mockUpdateErr  error
-		mockSaveErr    error
-		expectedError  error
+		name                string
+		ctx                 context.Context
+		modelServiceID      int64
+		slotID              int64
+		promoCode           *string
+		mockUser            *entity.User
+		mockUserErr         error
+		mockSlot            *entity.Slot
+		mockSlotErr         error
+		mockModelService    *entity.ModelService
+		mockModelServiceErr error
+		mockSurcharges      []entity.PriceComponent
+		mockSurchargesErr   error
+		addOnIDs            []int64
+		mockAddOnsErr       error
+		expectedAddOns      []entity.BookingAddOn
+		mockPromo           *entity.PromoCode
+		mockValidateErr     error
+		mockUpdateSlot      *entity.Slot
+		mockUpdateErr       error
+		mockSaveErr         error
+		mockRedeemErr       error
+		expectedPrice       entity.Money
+		expectedDiscount    entity.Money
+		expectedError       error
 	}{
 		{
-			name:           "successful booking creation",
-			ctx:            ctxClient,
-			modelServiceID: 1,
-			slotID:         1,
-			mockUser:       verifiedClient,
-			mockSlot:       availableSlot,
-			mockUpdateSlot: reservedSlot,
+			name:             "successful booking creation",
+			ctx:              ctxClient,
+			modelServiceID:   1,
+			slotID:           1,
+			mockUser:         verifiedClient,
+			mockSlot:         availableSlot,
+			mockModelService: modelService,
+			mockUpdateSlot:   reservedSlot,
+			expectedPrice:    rub(100),
+			expectedDiscount: rub(0),
+		},
+		{
+			name:             "booking with surcharge and promo code",
+			ctx:              ctxClient,
+			modelServiceID:   1,
+			slotID:           1,
+			promoCode:        &promoCode,
+			mockUser:         verifiedClient,
+			mockSlot:         &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			mockModelService: modelService,
+			mockSurcharges:   nightSurcharge,
+			mockPromo:        promo,
+			mockUpdateSlot:   reservedSlot,
+			expectedPrice:    rub(120),
+			expectedDiscount: rub(30),
+		},
+		{
+			name:             "booking with add-ons and promo code",
+			ctx:              ctxClient,
+			modelServiceID:   1,
+			slotID:           1,
+			promoCode:        &promoCode,
+			mockUser:         verifiedClient,
+			mockSlot:         &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			mockModelService: modelService,
+			addOnIDs:         []int64{12, 11},
+			mockPromo:        promo,
+			mockUpdateSlot:   reservedSlot,
+			expectedAddOns: []entity.BookingAddOn{
+				{AddOnID: 12, Title: "Props", Price: rub(20)},
+				{AddOnID: 11, Title: "Makeup", Price: rub(30), ExtraMinutes: 30},
+			},
+			expectedPrice:    rub(120),
+			expectedDiscount: rub(30),
+		},
+		{
+			name:             "add-on of another service",
+			ctx:              ctxClient,
+			modelServiceID:   1,
+			slotID:           1,
+			mockUser:         verifiedClient,
+			mockSlot:         &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			mockModelService: modelService,
+			addOnIDs:         []int64{11, 99},
+			expectedError:    service_errors.ErrAddOnNotOfService,
+		},
+		{
+			name:             "inactive add-on",
+			ctx:              ctxClient,
+			modelServiceID:   1,
+			slotID:           1,
+			mockUser:         verifiedClient,
+			mockSlot:         &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			mockModelService: modelService,
+			addOnIDs:         []int64{13},
+			expectedError:    service_errors.ErrAddOnIsNotActive,
+		},
+		{
+			name:             "add-on chosen twice",
+			ctx:              ctxClient,
+			modelServiceID:   1,
+			slotID:           1,
+			mockUser:         verifiedClient,
+			mockSlot:         &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			mockModelService: modelService,
+			addOnIDs:         []int64{11, 11},
+			expectedError:    service_errors.ErrDuplicateAddOn,
+		},
+		{
+			name:             "failed to get add-ons",
+			ctx:              ctxClient,
+			modelServiceID:   1,
+			slotID:           1,
+			mockUser:         verifiedClient,
+			mockSlot:         &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			mockModelService: modelService,
+			addOnIDs:         []int64{11},
+			mockAddOnsErr:    errors.New("db error"),
+			expectedError:    errors.New("db error"),
+		},
+		{
+			name:              "failed to calculate surcharges",
+			ctx:               ctxClient,
+			modelServiceID:    1,
+			slotID:            1,
+			mockUser:          verifiedClient,
+			mockSlot:          &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			mockModelService:  modelService,
+			mockSurchargesErr: errors.New("db error"),
+			expectedError:     errors.New("db error"),
+		},
+		{
+			name:             "booking with promo code",
+			ctx:              ctxClient,
+			modelServiceID:   1,
+			slotID:           1,
+			promoCode:        &promoCode,
+			mockUser:         verifiedClient,
+			mockSlot:         &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			mockModelService: modelService,
+			mockPromo:        promo,
+			mockUpdateSlot:   reservedSlot,
+			expectedPrice:    rub(80),
+			expectedDiscount: rub(20),
+		},
+		{
+			name:             "promo code is not applicable",
+			ctx:              ctxClient,
+			modelServiceID:   1,
+			slotID:           1,
+			promoCode:        &promoCode,
+			mockUser:         verifiedClient,
+			mockSlot:         &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			mockModelService: modelService,
+			mockValidateErr:  service_errors.ErrPromoCodeNotApplicable,
+			expectedError:    service_errors.ErrPromoCodeNotApplicable,
+		},
+		{
+			name:             "promo code limit is reached on redeem",
+			ctx:              ctxClient,
+			modelServiceID:   1,
+			slotID:           1,
+			promoCode:        &promoCode,
+			mockUser:         verifiedClient,
+			mockSlot:         &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			mockModelService: modelService,
+			mockPromo:        promo,
+			mockUpdateSlot:   reservedSlot,
+			mockRedeemErr:    service_errors.ErrPromoCodeUsageLimitReached,
+			expectedError:    service_errors.ErrPromoCodeUsageLimitReached,
+		},
+		{
+			name:                "model service not found",
+			ctx:                 ctxClient,
+			modelServiceID:      1,
+			slotID:              1,
+			mockUser:            verifiedClient,
+			mockSlot:            &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			mockModelServiceErr: persistence.ErrNoRowsFound,
+			expectedError:       service_errors.ErrServiceIsNotFound,
 		},
 		{
 			name:           "client not verified",
@@ -172,6 +378,50 @@ func TestBookingService_CreateBooking(t *testing.T) {
 					Times(1)
 
 				if tt.mockSlotErr == nil && tt.mockSlot != nil && tt.mockSlot.IsAvailable() {
+					test.rules.EXPECT().
+						RulesOf(gomock.Any(), tt.mockSlot.ModelID).
+						Return(testBookingRules(), nil).
+						Times(1)
+
+					test.buffers.EXPECT().
+						BufferOf(gomock.Any(), tt.mockSlot.ModelID).
+						Return(&entity.TravelBuffer{ModelID: tt.mockSlot.ModelID}, nil).
+						Times(1)
+
+					test.modelServiceRepo.EXPECT().
+						GetByID(gomock.Any(), tt.modelServiceID, false).
+						Return(tt.mockModelService, tt.mockModelServiceErr).
+						Times(1)
+				}
+
+				if tt.mockSlotErr == nil && tt.mockSlot != nil && tt.mockSlot.IsAvailable() &&
+					tt.mockModelServiceErr == nil {
+					test.surcharges.EXPECT().
+						Surcharges(gomock.Any(), tt.mockModelService, tt.mockSlot).
+						Return(tt.mockSurcharges, tt.mockSurchargesErr).
+						Times(1)
+				}
+
+				if len(tt.addOnIDs) > 0 && tt.mockModelServiceErr == nil && tt.mockSurchargesErr == nil {
+					test.addOnRepo.EXPECT().
+						GetByServiceID(gomock.Any(), tt.modelServiceID, true).
+						Return(addOnCatalog, tt.mockAddOnsErr).
+						Times(1)
+				}
+
+				addOnsSelected := tt.mockAddOnsErr == nil && (len(tt.addOnIDs) == 0 || tt.expectedAddOns != nil)
+
+				if tt.promoCode != nil && tt.mockModelServiceErr == nil && tt.mockSurchargesErr == nil &&
+					addOnsSelected {
+					test.promoCodes.EXPECT().
+						Validate(gomock.Any(), *tt.promoCode, tt.mockUser.ID, tt.mockModelService).
+						Return(tt.mockPromo, tt.mockValidateErr).
+						Times(1)
+				}
+
+				if tt.mockSlotErr == nil && tt.mockSlot != nil && tt.mockSlot.IsAvailable() &&
+					tt.mockModelServiceErr == nil && tt.mockSurchargesErr == nil && addOnsSelected &&
+					tt.mockValidateErr == nil {
 					test.txManager.EXPECT().
 						WithTransaction(gomock.Any(), gomock.Any()).
 						DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
@@ -190,6 +440,13 @@ func TestBookingService_CreateBooking(t *testing.T) {
 							Return(tt.mockSaveErr).
 							Times(1)
 					}
+
+					if tt.mockPromo != nil && tt.mockSaveErr == nil {
+						test.promoCodes.EXPECT().
+							Redeem(gomock.Any(), tt.mockPromo, tt.mockUser.ID, gomock.Any()).
+							Return(tt.mockRedeemErr).
+							Times(1)
+					}
 				}
 			}
 
@@ -208,6 +465,9 @@ func TestBookingService_CreateBooking(t *testing.T) {
 				&entrance,
 				&floor,
 				&comment,
+				tt.promoCode,
+				tt.addOnIDs,
+				nil,
 			)
 
 			if tt.expectedError != nil {
@@ -218,6 +478,597 @@ func TestBookingService_CreateBooking(t *testing.T) {
 				assert.NotNil(t, booking)
 				assert.Equal(t, tt.modelServiceID, booking.ModelServiceID)
 				assert.Equal(t, tt.slotID, booking.SlotID)
+				assert.Equal(t, modelService.Price, booking.BasePrice)
+				assert.Equal(t, tt.mockSurcharges, booking.Surcharges)
+				if tt.expectedAddOns != nil {
+					assert.Equal(t, tt.expectedAddOns, booking.AddOns)
+				} else {
+					assert.Empty(t, booking.AddOns)
+				}
+				assert.Equal(t, tt.expectedPrice, booking.Price)
+				assert.Equal(t, tt.expectedDiscount, booking.Discount)
+			}
+		})
+	}
+}
+
+func TestBookingService_QuoteBooking(t *testing.T) {
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedClient := &entity.User{ID: 1, AuthID: 1, IsVerified: true}
+	slotStart := time.Now().Add(48 * time.Hour)
+	modelService := &entity.ModelService{ID: 1, ModelID: 5, Price: rub(100)}
+
+	percentOff := 20
+	promo := &entity.PromoCode{
+		ID:           9,
+		Code:         "SPRING20",
+		DiscountType: entity.DiscountPercent,
+		PercentOff:   &percentOff,
+		IsActive:     true,
+	}
+	promoCode := "spring20"
+
+	tests := []struct {
+		name             string
+		mockUser         *entity.User
+		mockSlot         *entity.Slot
+		promoCode        *string
+		mockPromo        *entity.PromoCode
+		mockValidateErr  error
+		mockSignErr      error
+		expectedPrice    entity.Money
+		expectedDiscount entity.Money
+		expectedError    error
+	}{
+		{
+			name:             "quote with surcharge and promo code",
+			mockUser:         verifiedClient,
+			mockSlot:         &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			promoCode:        &promoCode,
+			mockPromo:        promo,
+			expectedPrice:    rub(120),
+			expectedDiscount: rub(30),
+		},
+		{
+			name:            "promo code is not valid",
+			mockUser:        verifiedClient,
+			mockSlot:        &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			promoCode:       &promoCode,
+			mockValidateErr: service_errors.ErrPromoCodeNotValid,
+			expectedError:   service_errors.ErrPromoCodeNotValid,
+		},
+		{
+			name:          "failed to sign quote",
+			mockUser:      verifiedClient,
+			mockSlot:      &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable},
+			mockSignErr:   errors.New("sign error"),
+			expectedError: errors.New("sign error"),
+		},
+		{
+			name:          "slot not available",
+			mockUser:      verifiedClient,
+			mockSlot:      &entity.Slot{ID: 1, Status: entity.SlotReserved},
+			expectedError: service_errors.ErrSlotNotAvailable,
+		},
+		{
+			name:          "client not verified",
+			mockUser:      &entity.User{ID: 1, AuthID: 1, IsVerified: false},
+			expectedError: service_errors.ErrNotVerifiedClient,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpBookingServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(1)).
+				Return(tt.mockUser, nil).
+				Times(1)
+
+			if tt.mockSlot != nil {
+				test.slotRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1)).
+					Return(tt.mockSlot, nil).
+					Times(1)
+			}
+
+			if tt.mockSlot != nil && tt.mockSlot.IsAvailable() {
+				test.rules.EXPECT().
+					RulesOf(gomock.Any(), tt.mockSlot.ModelID).
+					Return(testBookingRules(), nil).
+					Times(1)
+
+				test.buffers.EXPECT().
+					BufferOf(gomock.Any(), tt.mockSlot.ModelID).
+					Return(&entity.TravelBuffer{ModelID: tt.mockSlot.ModelID}, nil).
+					Times(1)
+
+				test.modelServiceRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1), false).
+					Return(modelService, nil).
+					Times(1)
+
+				test.surcharges.EXPECT().
+					Surcharges(gomock.Any(), modelService, tt.mockSlot).
+					Return([]entity.PriceComponent{{PricingRuleID: 3, Name: "Night", Amount: rub(50)}}, nil).
+					Times(1)
+
+				if tt.promoCode != nil {
+					test.promoCodes.EXPECT().
+						Validate(gomock.Any(), *tt.promoCode, verifiedClient.ID, modelService).
+						Return(tt.mockPromo, tt.mockValidateErr).
+						Times(1)
+				}
+
+				if tt.mockValidateErr == nil {
+					test.quotes.EXPECT().
+						Sign(gomock.Any()).
+						Return("quote-token", tt.mockSignErr).
+						Times(1)
+				}
+			}
+
+			apartment := 5
+			quote, token, err := test.service.QuoteBooking(ctxClient, 1, 1, "Tverskaya", 1, &apartment, nil, nil, nil,
+				tt.promoCode, nil)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, quote)
+				assert.Empty(t, token)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "quote-token", token)
+				assert.Equal(t, verifiedClient.ID, quote.ClientID)
+				assert.Equal(t, promoCode, quote.PromoCode)
+				assert.Equal(t, entity.NewAddress("Tverskaya", 1, 5, 0, 0, ""), quote.Address)
+				assert.Equal(t, rub(100), quote.BasePrice)
+				assert.Len(t, quote.Surcharges, 1)
+				assert.Equal(t, tt.expectedPrice, quote.Price)
+				assert.Equal(t, tt.expectedDiscount, quote.Discount)
+			}
+		})
+	}
+}
+
+func TestBookingService_QuoteBooking_DurationAndTravelFee(t *testing.T) {
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedClient := &entity.User{ID: 1, AuthID: 1, IsVerified: true}
+	slotStart := time.Now().Add(48 * time.Hour)
+	modelService := &entity.ModelService{ID: 1, ModelID: 5, Price: rub(100)}
+
+	tests := []struct {
+		name            string
+		pricedDuration  time.Duration
+		slotDuration    time.Duration
+		fee             entity.Money
+		expectedScaling entity.Money
+		expectedTravel  entity.Money
+		expectedPrice   entity.Money
+	}{
+		{
+			name:            "longer slot and travel fee",
+			pricedDuration:  time.Hour,
+			slotDuration:    2 * time.Hour,
+			fee:             rub(30),
+			expectedScaling: rub(100),
+			expectedTravel:  rub(30),
+			expectedPrice:   rub(230),
+		},
+		{
+			name:            "shorter slot",
+			pricedDuration:  time.Hour,
+			slotDuration:    30 * time.Minute,
+			expectedScaling: rub(-50),
+			expectedPrice:   rub(50),
+		},
+		{
+			name:            "price is not scaled without priced duration",
+			slotDuration:    2 * time.Hour,
+			fee:             rub(30),
+			expectedScaling: rub(0),
+			expectedTravel:  rub(30),
+			expectedPrice:   rub(130),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpBookingServiceTest(t)
+			defer test.ctrl.Finish()
+			test.service.pricedDuration = tt.pricedDuration
+
+			slot := &entity.Slot{ID: 1, ModelID: 5, StartTime: slotStart, EndTime: slotStart.Add(tt.slotDuration),
+				Status: entity.SlotAvailable}
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(1)).
+				Return(verifiedClient, nil).
+				Times(1)
+
+			test.slotRepo.EXPECT().
+				GetByID(gomock.Any(), int64(1)).
+				Return(slot, nil).
+				Times(1)
+
+			test.rules.EXPECT().
+				RulesOf(gomock.Any(), slot.ModelID).
+				Return(testBookingRules(), nil).
+				Times(1)
+
+			test.buffers.EXPECT().
+				BufferOf(gomock.Any(), slot.ModelID).
+				Return(&entity.TravelBuffer{ModelID: slot.ModelID, Fee: tt.fee}, nil).
+				Times(1)
+
+			test.modelServiceRepo.EXPECT().
+				GetByID(gomock.Any(), int64(1), false).
+				Return(modelService, nil).
+				Times(1)
+
+			test.surcharges.EXPECT().
+				Surcharges(gomock.Any(), modelService, slot).
+				Return(nil, nil).
+				Times(1)
+
+			test.quotes.EXPECT().
+				Sign(gomock.Any()).
+				Return("quote-token", nil).
+				Times(1)
+
+			quote, _, err := test.service.QuoteBooking(ctxClient, 1, 1, "Tverskaya", 1, nil, nil, nil, nil, nil, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, rub(100), quote.BasePrice)
+			assert.Equal(t, tt.expectedScaling, quote.DurationScaling)
+			assert.Equal(t, tt.expectedTravel.Amount, quote.TravelFee.Amount)
+			assert.Equal(t, tt.expectedPrice, quote.Price)
+		})
+	}
+}
+
+func TestBookingService_CreateBooking_TravelBuffer(t *testing.T) {
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedClient := &entity.User{ID: 1, AuthID: 1, IsVerified: true}
+	modelService := &entity.ModelService{ID: 1, ModelID: 5, Price: rub(100)}
+
+	start := time.Now().Add(14 * 24 * time.Hour).Truncate(time.Hour)
+	slot := &entity.Slot{ID: 1, ModelID: 5, StartTime: start, EndTime: start.Add(time.Hour),
+		Status: entity.SlotAvailable}
+	buffer := &entity.TravelBuffer{ModelID: 5, Before: 30 * time.Minute, After: 30 * time.Minute}
+
+	tests := []struct {
+		name          string
+		mockNearby    []*entity.Slot
+		expectedError error
+	}{
+		{
+			name: "adjacent slots are free",
+			mockNearby: []*entity.Slot{
+				slot,
+				{ID: 2, ModelID: 5, StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour),
+					Status: entity.SlotAvailable},
+			},
+		},
+		{
+			name: "previous slot is booked and ends right before",
+			mockNearby: []*entity.Slot{
+				{ID: 2, ModelID: 5, StartTime: start.Add(-time.Hour), EndTime: start, Status: entity.SlotBooked},
+				slot,
+			},
+			expectedError: service_errors.ErrSlotWithinTravelBuffer,
+		},
+		{
+			name: "next slot is reserved within the buffer",
+			mockNearby: []*entity.Slot{
+				slot,
+				{ID: 2, ModelID: 5, StartTime: start.Add(80 * time.Minute), EndTime: start.Add(2 * time.Hour),
+					Status: entity.SlotReserved},
+			},
+			expectedError: service_errors.ErrSlotWithinTravelBuffer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpBookingServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), verifiedClient.AuthID).
+				Return(verifiedClient, nil).
+				Times(1)
+
+			reserved := *slot
+			test.slotRepo.EXPECT().
+				GetByID(gomock.Any(), slot.ID).
+				Return(&reserved, nil).
+				Times(1)
+
+			test.rules.EXPECT().
+				RulesOf(gomock.Any(), slot.ModelID).
+				Return(testBookingRules(), nil).
+				Times(1)
+
+			test.buffers.EXPECT().
+				BufferOf(gomock.Any(), slot.ModelID).
+				Return(buffer, nil).
+				Times(1)
+
+			from, to := buffer.Window(slot.StartTime, slot.EndTime)
+			test.slotRepo.EXPECT().
+				GetOverlappingSlots(gomock.Any(), slot.ModelID, from, to).
+				Return(tt.mockNearby, nil).
+				Times(1)
+
+			if tt.expectedError == nil {
+				test.modelServiceRepo.EXPECT().
+					GetByID(gomock.Any(), modelService.ID, false).
+					Return(modelService, nil).
+					Times(1)
+
+				test.surcharges.EXPECT().
+					Surcharges(gomock.Any(), modelService, &reserved).
+					Return(nil, nil).
+					Times(1)
+
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.slotRepo.EXPECT().
+					Update(gomock.Any(), &reserved).
+					Return(&reserved, nil).
+					Times(1)
+
+				test.bookingRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			}
+
+			booking, err := test.service.CreateBooking(ctxClient, modelService.ID, slot.ID, "Tverskaya", 1,
+				nil, nil, nil, nil, nil, nil, nil)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, booking)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.SlotReserved, reserved.Status)
+		})
+	}
+}
+
+func TestBookingService_CreateBooking_BookingRules(t *testing.T) {
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedClient := &entity.User{ID: 1, AuthID: 1, IsVerified: true}
+	slotAt := func(start time.Time) *entity.Slot {
+		return &entity.Slot{ID: 1, ModelID: 5, StartTime: start, EndTime: start.Add(time.Hour),
+			Status: entity.SlotAvailable}
+	}
+	strictModel := testBookingRules()
+	strictModel.MinNotice = 72 * time.Hour
+
+	tests := []struct {
+		name          string
+		mockSlot      *entity.Slot
+		mockRules     *entity.BookingRules
+		expectedError error
+	}{
+		{
+			name:          "slot starts sooner than the notice",
+			mockSlot:      slotAt(time.Now().Add(30 * time.Minute)),
+			expectedError: service_errors.ErrBookingTooSoon,
+		},
+		{
+			name:          "slot has started",
+			mockSlot:      slotAt(time.Now().Add(-30 * time.Minute)),
+			expectedError: service_errors.ErrBookingTooSoon,
+		},
+		{
+			name:          "slot starts sooner than the notice of the model",
+			mockSlot:      slotAt(time.Now().Add(48 * time.Hour)),
+			mockRules:     strictModel,
+			expectedError: service_errors.ErrBookingTooSoon,
+		},
+		{
+			name:          "slot starts beyond the horizon",
+			mockSlot:      slotAt(time.Now().Add(400 * 24 * time.Hour)),
+			expectedError: service_errors.ErrBookingBeyondHorizon,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpBookingServiceTest(t)
+			defer test.ctrl.Finish()
+
+			rules := tt.mockRules
+			if rules == nil {
+				rules = testBookingRules()
+			}
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), verifiedClient.AuthID).
+				Return(verifiedClient, nil).
+				Times(1)
+
+			test.slotRepo.EXPECT().
+				GetByID(gomock.Any(), tt.mockSlot.ID).
+				Return(tt.mockSlot, nil).
+				Times(1)
+
+			test.rules.EXPECT().
+				RulesOf(gomock.Any(), tt.mockSlot.ModelID).
+				Return(rules, nil).
+				Times(1)
+
+			booking, err := test.service.CreateBooking(ctxClient, 1, tt.mockSlot.ID, "Tverskaya", 1,
+				nil, nil, nil, nil, nil, nil, nil)
+
+			assert.EqualError(t, err, tt.expectedError.Error())
+			assert.Nil(t, booking)
+			assert.Equal(t, entity.SlotAvailable, tt.mockSlot.Status)
+		})
+	}
+}
+
+func TestBookingService_CreateBooking_WithQuote(t *testing.T) {
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedClient := &entity.User{ID: 1, AuthID: 1, IsVerified: true}
+	slotStart := time.Now().Add(48 * time.Hour)
+	token := "quote-token"
+
+	quoted := &entity.Quote{
+		ClientID:       1,
+		ModelServiceID: 1,
+		SlotID:         1,
+		Address:        entity.NewAddress("Test Street", 10, 0, 0, 0, ""),
+		AddOnIDs:       []int64{11},
+		BasePrice:      rub(100),
+		AddOns:         []entity.BookingAddOn{{AddOnID: 11, Title: "Makeup", Price: rub(30)}},
+		Discount:       rub(0),
+		Price:          rub(130),
+	}
+	quotedElsewhere := *quoted
+	quotedElsewhere.Address = entity.NewAddress("Other Street", 3, 0, 0, 0, "")
+
+	tests := []struct {
+		name          string
+		addOnIDs      []int64
+		mockQuote     *entity.Quote
+		mockParseErr  error
+		expectedError error
+	}{
+		{
+			name:      "quoted price is kept after the service price rises",
+			addOnIDs:  []int64{11},
+			mockQuote: quoted,
+		},
+		{
+			name:          "quote made for other add-ons",
+			addOnIDs:      []int64{11, 12},
+			mockQuote:     quoted,
+			expectedError: service_errors.ErrQuoteMismatch,
+		},
+		{
+			name:          "quote made for other address",
+			addOnIDs:      []int64{11},
+			mockQuote:     &quotedElsewhere,
+			expectedError: service_errors.ErrQuoteMismatch,
+		},
+		{
+			name:          "quote is expired",
+			addOnIDs:      []int64{11},
+			mockParseErr:  service_errors.ErrQuoteExpired,
+			expectedError: service_errors.ErrQuoteExpired,
+		},
+		{
+			name:          "quote token is tampered",
+			addOnIDs:      []int64{11},
+			mockParseErr:  service_errors.ErrInvalidQuoteToken,
+			expectedError: service_errors.ErrInvalidQuoteToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpBookingServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(1)).
+				Return(verifiedClient, nil).
+				Times(1)
+
+			test.quotes.EXPECT().
+				Parse(token).
+				Return(tt.mockQuote, tt.mockParseErr).
+				Times(1)
+
+			if tt.expectedError == nil {
+				slot := &entity.Slot{ID: 1, StartTime: slotStart, Status: entity.SlotAvailable}
+				raisedService := &entity.ModelService{ID: 1, ModelID: 5, Price: rub(150)}
+
+				test.slotRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1)).
+					Return(slot, nil).
+					Times(1)
+
+				test.rules.EXPECT().
+					RulesOf(gomock.Any(), slot.ModelID).
+					Return(testBookingRules(), nil).
+					Times(1)
+
+				test.buffers.EXPECT().
+					BufferOf(gomock.Any(), slot.ModelID).
+					Return(&entity.TravelBuffer{ModelID: slot.ModelID}, nil).
+					Times(1)
+
+				test.modelServiceRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1), false).
+					Return(raisedService, nil).
+					Times(1)
+
+				test.surcharges.EXPECT().
+					Surcharges(gomock.Any(), raisedService, slot).
+					Return(nil, nil).
+					Times(1)
+
+				test.addOnRepo.EXPECT().
+					GetByServiceID(gomock.Any(), int64(1), true).
+					Return([]*entity.AddOn{
+						{ID: 11, ModelServiceID: 1, Title: "Makeup", Price: rub(40), IsActive: true},
+					}, nil).
+					Times(1)
+
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.slotRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(&entity.Slot{ID: 1, Status: entity.SlotReserved}, nil).
+					Times(1)
+
+				test.bookingRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			}
+
+			booking, err := test.service.CreateBooking(ctxClient, 1, 1, "Test Street", 10,
+				nil, nil, nil, nil, nil, tt.addOnIDs, &token)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, booking)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, quoted.BasePrice, booking.BasePrice)
+				assert.Equal(t, quoted.AddOns, booking.AddOns)
+				assert.Equal(t, quoted.Price, booking.Price)
 			}
 		})
 	}
@@ -236,8 +1087,12 @@ func TestBookingService_ApproveBooking(t *testing.T) {
 		IsVerified: true,
 	}
 
-	pendingBooking := entity.NewBooking(2, 3, 4, entity.Address{}, 5*time.Minute)
-	pendingBooking.ID = 1
+	newPendingBooking := func() *entity.Booking {
+		booking := entity.NewBooking(2, 3, 4, entity.Address{}, rub(100), 5*time.Minute)
+		booking.ID = 1
+		return booking
+	}
+	pendingBooking := newPendingBooking()
 
 	reservedSlot := &entity.Slot{
 		ID:     4,
@@ -252,6 +1107,7 @@ func TestBookingService_ApproveBooking(t *testing.T) {
 	modelService := &entity.ModelService{
 		ID:      3,
 		ModelID: 1,
+		Price:   rub(100),
 	}
 
 	tests := []struct {
@@ -271,6 +1127,9 @@ func TestBookingService_ApproveBooking(t *testing.T) {
 		mockUpdateBooking    *entity.Booking
 		mockUpdateBookingErr error
 		mockSaveOrderErr     error
+		mockAuthorizeErr     error
+		mockCommitErr        error
+		mockVoidErr          error
 		expectedError        error
 		expectTransaction    bool
 	}{
@@ -286,6 +1145,50 @@ func TestBookingService_ApproveBooking(t *testing.T) {
 			mockUpdateBooking: &entity.Booking{ID: 1, Status: entity.BookingApproved},
 			expectTransaction: true,
 		},
+		{
+			name:      "payment is declined",
+			ctx:       ctxModel,
+			bookingID: 1,
+			mockModel: verifiedModel,
+			mockBooking: &entity.Booking{ID: 1, ClientID: 2, ModelServiceID: 3, SlotID: 4,
+				Price: rub(100), Status: entity.BookingPending, ExpiresAt: time.Now().Add(5 * time.Minute)},
+			mockModelService:  modelService,
+			mockSlot:          &entity.Slot{ID: 4, Status: entity.SlotReserved},
+			mockUpdateSlot:    bookedSlot,
+			mockUpdateBooking: &entity.Booking{ID: 1, Status: entity.BookingApproved},
+			mockAuthorizeErr:  service_errors.ErrPaymentDeclined,
+			expectedError:     service_errors.ErrPaymentDeclined,
+			expectTransaction: true,
+		},
+		{
+			name:              "authorization is voided when approval is rolled back",
+			ctx:               ctxModel,
+			bookingID:         1,
+			mockModel:         verifiedModel,
+			mockBooking:       newPendingBooking(),
+			mockModelService:  modelService,
+			mockSlot:          &entity.Slot{ID: 4, Status: entity.SlotReserved},
+			mockUpdateSlot:    bookedSlot,
+			mockUpdateBooking: &entity.Booking{ID: 1, Status: entity.BookingApproved},
+			mockCommitErr:     errors.New("commit failed"),
+			expectedError:     errors.New("commit failed"),
+			expectTransaction: true,
+		},
+		{
+			name:              "void failure is reported with rollback error",
+			ctx:               ctxModel,
+			bookingID:         1,
+			mockModel:         verifiedModel,
+			mockBooking:       newPendingBooking(),
+			mockModelService:  modelService,
+			mockSlot:          &entity.Slot{ID: 4, Status: entity.SlotReserved},
+			mockUpdateSlot:    bookedSlot,
+			mockUpdateBooking: &entity.Booking{ID: 1, Status: entity.BookingApproved},
+			mockCommitErr:     errors.New("commit failed"),
+			mockVoidErr:       service_errors.ErrPaymentProviderFailed,
+			expectedError:     errors.Join(errors.New("commit failed"), service_errors.ErrPaymentProviderFailed),
+			expectTransaction: true,
+		},
 		{
 			name:              "model not verified",
 			ctx:               ctxModel,
@@ -343,7 +1246,11 @@ func TestBookingService_ApproveBooking(t *testing.T) {
 									test.txManager.EXPECT().
 										WithTransaction(gomock.Any(), gomock.Any()).
 										DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-											return fn(ctx)
+											if err := fn(ctx); err != nil {
+												return err
+											}
+
+											return tt.mockCommitErr
 										}).
 										Times(1)
 
@@ -361,8 +1268,34 @@ func TestBookingService_ApproveBooking(t *testing.T) {
 										if tt.mockUpdateBookingErr == nil {
 											test.orderRepo.EXPECT().
 												Save(gomock.Any(), gomock.Any()).
-												Return(tt.mockSaveOrderErr).
+												DoAndReturn(func(_ context.Context, o *entity.Order) error {
+													o.ID = 7
+													return tt.mockSaveOrderErr
+												}).
 												Times(1)
+
+											if tt.mockSaveOrderErr == nil && tt.mockAuthorizeErr != nil {
+												test.payments.EXPECT().
+													Authorize(gomock.Any(), int64(7), tt.mockBooking.Price).
+													Return(nil, tt.mockAuthorizeErr).
+													Times(1)
+											}
+
+											if tt.mockSaveOrderErr == nil && tt.mockAuthorizeErr == nil {
+												test.payments.EXPECT().
+													Authorize(gomock.Any(), int64(7), tt.mockBooking.Price).
+													Return(&entity.Payment{OrderID: 7}, nil).
+													Times(1)
+											}
+
+											if tt.mockSaveOrderErr == nil && tt.mockAuthorizeErr == nil &&
+												tt.mockCommitErr != nil {
+
+												test.payments.EXPECT().
+													VoidAuthorization(gomock.Any(), &entity.Payment{OrderID: 7}).
+													Return(tt.mockVoidErr).
+													Times(1)
+											}
 										}
 									}
 								}
@@ -437,7 +1370,14 @@ func TestBookingService_NewDefaultBookingService_Errors(t *testing.T) {
 				mocks.NewMockSlotRepository(ctrl),
 				mocks.NewMockUserRepository(ctrl),
 				mocks.NewMockModelServiceRepository(ctrl),
+				mocks.NewMockAddOnRepository(ctrl),
 				mocks.NewMockOrderRepository(ctrl),
+				mocks.NewMockPaymentProcessor(ctrl),
+				mocks.NewMockSurchargeCalculator(ctrl),
+				mocks.NewMockPromoCodeRedeemer(ctrl),
+				mocks.NewMockQuoteSigner(ctrl),
+				mocks.NewMockTravelBufferProvider(ctrl),
+				mocks.NewMockBookingRulesProvider(ctrl),
 				mocks.NewMockTxManager(ctrl),
 				log,
 			)
@@ -463,12 +1403,12 @@ func TestBookingService_RejectBooking(t *testing.T) {
 		IsVerified: true,
 	}
 
-	pendingBooking := entity.NewBooking(2, 3, 4, entity.Address{}, 5*time.Minute)
+	pendingBooking := entity.NewBooking(2, 3, 4, entity.Address{}, rub(100), 5*time.Minute)
 	pendingBooking.ID = 1
 	pendingBooking.Status = entity.BookingPending
 	pendingBooking.CreatedAt = time.Now().Add(-1 * time.Minute)
 
-	expiredBooking := entity.NewBooking(2, 3, 4, entity.Address{}, 1*time.Minute)
+	expiredBooking := entity.NewBooking(2, 3, 4, entity.Address{}, rub(100), 1*time.Minute)
 	expiredBooking.ID = 2
 	expiredBooking.Status = entity.BookingPending
 	expiredBooking.CreatedAt = time.Now().Add(-2 * time.Minute)
@@ -632,17 +1572,23 @@ func TestBookingService_CancelBookingByClient(t *testing.T) {
 		IsVerified: true,
 	}
 
-	pendingBooking := entity.NewBooking(1, 3, 4, entity.Address{}, 5*time.Minute)
+	pendingBooking := entity.NewBooking(1, 3, 4, entity.Address{}, rub(100), 5*time.Minute)
 	pendingBooking.ID = 1
 	pendingBooking.Status = entity.BookingPending
 	pendingBooking.CreatedAt = time.Now().Add(-1 * time.Minute)
 
-	approvedBooking := entity.NewBooking(1, 3, 4, entity.Address{}, 5*time.Minute)
+	approvedBooking := entity.NewBooking(1, 3, 4, entity.Address{}, rub(100), 5*time.Minute)
 	approvedBooking.ID = 2
 	approvedBooking.Status = entity.BookingApproved
 	approvedBooking.CreatedAt = time.Now().Add(-1 * time.Minute)
 
-	expiredBooking := entity.NewBooking(1, 3, 4, entity.Address{}, 1*time.Minute)
+	promoCodeID := int64(9)
+	promoBooking := entity.NewBooking(1, 3, 4, entity.Address{}, rub(80), 5*time.Minute)
+	promoBooking.ID = 4
+	promoBooking.PromoCodeID = &promoCodeID
+	promoBooking.CreatedAt = time.Now().Add(-1 * time.Minute)
+
+	expiredBooking := entity.NewBooking(1, 3, 4, entity.Address{}, rub(100), 1*time.Minute)
 	expiredBooking.ID = 3
 	expiredBooking.Status = entity.BookingPending
 	expiredBooking.CreatedAt = time.Now().Add(-2 * time.Minute)
@@ -685,6 +1631,17 @@ func TestBookingService_CancelBookingByClient(t *testing.T) {
 			mockUpdateBooking: &entity.Booking{ID: 1, Status: entity.BookingCancelled},
 			expectTransaction: true,
 		},
+		{
+			name:              "cancellation gives promo code back",
+			ctx:               ctxClient,
+			bookingID:         4,
+			mockClient:        verifiedClient,
+			mockBooking:       promoBooking,
+			mockSlot:          &entity.Slot{ID: 4, Status: entity.SlotReserved},
+			mockUpdateSlot:    availableSlot,
+			mockUpdateBooking: &entity.Booking{ID: 4, Status: entity.BookingCancelled},
+			expectTransaction: true,
+		},
 		{
 			name:          "not a client error",
 			ctx:           ctxNotClient,
@@ -771,6 +1728,13 @@ func TestBookingService_CancelBookingByClient(t *testing.T) {
 									Return(tt.mockUpdateBooking, tt.mockUpdateBookingErr).
 									Times(1)
 							}
+
+							if tt.mockUpdateBookingErr == nil && tt.mockBooking.PromoCodeID != nil {
+								test.promoCodes.EXPECT().
+									Release(gomock.Any(), tt.mockBooking.ID).
+									Return(nil).
+									Times(1)
+							}
 						}
 					}
 				}
@@ -790,6 +1754,111 @@ func TestBookingService_CancelBookingByClient(t *testing.T) {
 	}
 }
 
+func TestBookingService_ExpireBookings(t *testing.T) {
+	test := setUpBookingServiceTest(t)
+	defer test.ctrl.Finish()
+
+	promoCodeID := int64(9)
+	expiredWithPromo := &entity.Booking{ID: 1, SlotID: 4, PromoCodeID: &promoCodeID, Status: entity.BookingExpired}
+	expiredWithoutPromo := &entity.Booking{ID: 2, SlotID: 5, Status: entity.BookingExpired}
+
+	tests := []struct {
+		name            string
+		mockExpired     []*entity.Booking
+		mockExpireErr   error
+		mockSlots       map[int64]*entity.Slot
+		mockReleaseErr  error
+		expectedExpired int
+		expectedError   error
+	}{
+		{
+			name:        "slots and promo codes are released",
+			mockExpired: []*entity.Booking{expiredWithPromo, expiredWithoutPromo},
+			mockSlots: map[int64]*entity.Slot{
+				4: {ID: 4, Status: entity.SlotReserved},
+				5: {ID: 5, Status: entity.SlotReserved},
+			},
+			expectedExpired: 2,
+		},
+		{
+			name:        "slot that is not reserved is kept",
+			mockExpired: []*entity.Booking{expiredWithoutPromo},
+			mockSlots: map[int64]*entity.Slot{
+				5: {ID: 5, Status: entity.SlotDisabled},
+			},
+			expectedExpired: 1,
+		},
+		{
+			name:            "nothing to expire",
+			expectedExpired: 0,
+		},
+		{
+			name:          "expire fails",
+			mockExpireErr: errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+		{
+			name:        "promo code release fails",
+			mockExpired: []*entity.Booking{expiredWithPromo},
+			mockSlots: map[int64]*entity.Slot{
+				4: {ID: 4, Status: entity.SlotReserved},
+			},
+			mockReleaseErr: errors.New("db error"),
+			expectedError:  errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.txManager.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).
+				Times(1)
+
+			test.bookingRepo.EXPECT().
+				ExpirePending(gomock.Any(), gomock.Any()).
+				Return(tt.mockExpired, tt.mockExpireErr).
+				Times(1)
+
+			for _, b := range tt.mockExpired {
+				slot := tt.mockSlots[b.SlotID]
+				test.slotRepo.EXPECT().
+					GetByID(gomock.Any(), b.SlotID).
+					Return(slot, nil).
+					Times(1)
+
+				if slot.Status == entity.SlotReserved {
+					test.slotRepo.EXPECT().
+						Update(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, s *entity.Slot) (*entity.Slot, error) {
+							assert.Equal(t, entity.SlotAvailable, s.Status)
+							return s, nil
+						}).
+						Times(1)
+				}
+
+				if b.PromoCodeID != nil {
+					test.promoCodes.EXPECT().
+						Release(gomock.Any(), b.ID).
+						Return(tt.mockReleaseErr).
+						Times(1)
+				}
+			}
+
+			expired, err := test.service.ExpireBookings(context.Background())
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedExpired, expired)
+			}
+		})
+	}
+}
+
 func TestBookingService_CheckModelRestrictions_EdgeCases(t *testing.T) {
 	test := setUpBookingServiceTest(t)
 	defer test.ctrl.Finish()
@@ -982,12 +2051,14 @@ func TestBookingService_CheckIfModelIsAnOwner_EdgeCases(t *testing.T) {
 				Return(tt.mockModelService, tt.mockModelServiceErr).
 				Times(1)
 
-			err := test.service.checkIfModelIsAnOwner(ctx, tt.modelID, tt.modelServiceID)
+			res, err := test.service.checkIfModelIsAnOwner(ctx, tt.modelID, tt.modelServiceID)
 
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.mockModelService, res)
 			}
 		})
 	}
@@ -0,0 +1,567 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+const maxBusyEvents = 5000
+
+type DefaultBusyCalendarService struct {
+	calendarRepo interfaces.BusyCalendarRepository
+	slotRepo     interfaces.SlotRepository
+	userRepo     interfaces.UserRepository
+	reader       interfaces.BusyCalendarReader
+	txManager    database.TxManager
+	logger       pkg.Logger
+	location     *time.Location
+}
+
+func NewDefaultBusyCalendarService(calendarRepo interfaces.BusyCalendarRepository,
+	slotRepo interfaces.SlotRepository, userRepo interfaces.UserRepository, reader interfaces.BusyCalendarReader,
+	txManager database.TxManager, logger pkg.Logger) (*DefaultBusyCalendarService, error) {
+
+	timezone := os.Getenv(service_const.DotEnvPlatformTimezone)
+	if timezone == "" {
+		return nil, service_errors.ErrLoadingPlatformTimezone
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, service_errors.ErrParsingPlatformTimezone
+	}
+
+	return &DefaultBusyCalendarService{
+		calendarRepo: calendarRepo,
+		slotRepo:     slotRepo,
+		userRepo:     userRepo,
+		reader:       reader,
+		txManager:    txManager,
+		logger:       logger,
+		location:     location,
+	}, nil
+}
+
+func (d *DefaultBusyCalendarService) GetCalendars(ctx context.Context) ([]*entity.BusyCalendar, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendars, err := d.calendarRepo.GetByModelID(ctx, model.ID)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get busy calendars by model id",
+			option.Any("model_id", model.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return calendars, nil
+}
+
+// CreateCalendar registers an outside calendar of the model. A calendar with URL is fetched at once
+// and then regularly, the events of a calendar without URL are uploaded by the model.
+func (d *DefaultBusyCalendarService) CreateCalendar(ctx context.Context, name string,
+	url *string) (*entity.BusyCalendarSync, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := entity.NewBusyCalendar(model.ID, name, url)
+
+	var events []*entity.BusyEvent
+	if url != nil {
+		events, err = d.fetchEvents(ctx, calendar)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var res *entity.BusyCalendarSync
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err = d.calendarRepo.Save(ctx, calendar); err != nil {
+			d.logger.Error(ctx, "failed to save busy calendar",
+				option.Any("model_id", model.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		res, err = d.replaceEvents(ctx, calendar, events)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// UploadEvents replaces the events of the calendar with the ones of the uploaded .ics file.
+func (d *DefaultBusyCalendarService) UploadEvents(ctx context.Context, id int64,
+	src io.Reader) (*entity.BusyCalendarSync, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := d.getOwnCalendar(ctx, id, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if calendar.URL != nil {
+		d.logger.Error(ctx, "busy calendar is fetched from its URL",
+			option.Any("busy_calendar_id", id),
+			option.Error(service_errors.ErrBusyCalendarHasURL))
+
+		return nil, service_errors.ErrBusyCalendarHasURL
+	}
+
+	events, err := d.reader.Read(src, model.Location(d.location))
+	if err != nil {
+		d.logger.Error(ctx, "failed to read uploaded calendar",
+			option.Any("busy_calendar_id", id),
+			option.Error(err))
+
+		return nil, service_errors.ErrInvalidBusyCalendarFile
+	}
+
+	var res *entity.BusyCalendarSync
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		res, err = d.replaceEvents(ctx, calendar, events)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SyncCalendar fetches the calendar from its URL again, if it has one, and applies the events to the slots.
+func (d *DefaultBusyCalendarService) SyncCalendar(ctx context.Context, id int64) (*entity.BusyCalendarSync, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := d.getOwnCalendar(ctx, id, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.syncCalendar(ctx, calendar)
+}
+
+// DeleteCalendar forgets the calendar, the slots blocked only by its events become available again.
+func (d *DefaultBusyCalendarService) DeleteCalendar(ctx context.Context, id int64) (*entity.BusyCalendarSync, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := d.getOwnCalendar(ctx, id, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *entity.BusyCalendarSync
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err = d.calendarRepo.Delete(ctx, id); err != nil {
+			if errors.Is(err, persistence.ErrNoRowsAffected) {
+				return service_errors.ErrBusyCalendarNotFound
+			}
+
+			d.logger.Error(ctx, "failed to delete busy calendar",
+				option.Any("busy_calendar_id", id),
+				option.Error(err))
+
+			return err
+		}
+
+		res, err = d.applyEvents(ctx, calendar)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SyncAll is run by the worker: the calendars with URL are fetched again and the events of every
+// calendar are applied to the slots, which also catches the slots generated since the last run.
+// A calendar that cannot be fetched keeps its last events.
+func (d *DefaultBusyCalendarService) SyncAll(ctx context.Context) (int, error) {
+	calendars, err := d.calendarRepo.GetAll(ctx)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get busy calendars",
+			option.Error(err))
+
+		return 0, err
+	}
+
+	synced := 0
+	for _, calendar := range calendars {
+		if _, err = d.syncCalendar(ctx, calendar); err != nil {
+			if errors.Is(err, service_errors.ErrBusyCalendarUnreachable) {
+				continue
+			}
+
+			return synced, err
+		}
+
+		synced++
+	}
+
+	return synced, nil
+}
+
+func (d *DefaultBusyCalendarService) syncCalendar(ctx context.Context,
+	calendar *entity.BusyCalendar) (*entity.BusyCalendarSync, error) {
+
+	var (
+		events []*entity.BusyEvent
+		err    error
+	)
+	if calendar.URL != nil {
+		events, err = d.fetchEvents(ctx, calendar)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var res *entity.BusyCalendarSync
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if calendar.URL != nil {
+			res, err = d.replaceEvents(ctx, calendar, events)
+		} else {
+			res, err = d.applyEvents(ctx, calendar)
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultBusyCalendarService) fetchEvents(ctx context.Context,
+	calendar *entity.BusyCalendar) ([]*entity.BusyEvent, error) {
+
+	location, err := modelLocation(ctx, d.userRepo, calendar.ModelID, d.location)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get time zone of model",
+			option.Any("busy_calendar_id", calendar.ID),
+			option.Any("model_id", calendar.ModelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	events, err := d.reader.Fetch(ctx, *calendar.URL, location)
+	if err != nil {
+		d.logger.Error(ctx, "failed to fetch busy calendar",
+			option.Any("busy_calendar_id", calendar.ID),
+			option.Any("model_id", calendar.ModelID),
+			option.Error(err))
+
+		return nil, service_errors.ErrBusyCalendarUnreachable
+	}
+
+	return events, nil
+}
+
+// replaceEvents keeps the upcoming events of the calendar and applies them, the earliest ones are kept
+// when there are too many.
+func (d *DefaultBusyCalendarService) replaceEvents(ctx context.Context, calendar *entity.BusyCalendar,
+	events []*entity.BusyEvent) (*entity.BusyCalendarSync, error) {
+
+	now := time.Now()
+	upcoming := make([]*entity.BusyEvent, 0, len(events))
+	for _, e := range events {
+		if e.EndTime.After(now) {
+			upcoming = append(upcoming, e)
+		}
+	}
+
+	sort.Slice(upcoming, func(i, j int) bool {
+		return upcoming[i].StartTime.Before(upcoming[j].StartTime)
+	})
+	if len(upcoming) > maxBusyEvents {
+		upcoming = upcoming[:maxBusyEvents]
+	}
+
+	if err := d.calendarRepo.ReplaceEvents(ctx, calendar.ID, upcoming); err != nil {
+		d.logger.Error(ctx, "failed to replace busy events",
+			option.Any("busy_calendar_id", calendar.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return d.applyEvents(ctx, calendar)
+}
+
+// applyEvents brings the slots of the model in line with the events of all its calendars, so a slot
+// under the events of two calendars stays blocked until both are gone. The conflicts of every calendar
+// of the model are saved again, as a change of one calendar may settle the conflicts of another.
+func (d *DefaultBusyCalendarService) applyEvents(ctx context.Context,
+	synced *entity.BusyCalendar) (*entity.BusyCalendarSync, error) {
+
+	now := time.Now()
+	events, err := d.calendarRepo.GetModelEvents(ctx, synced.ModelID, now)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get busy events of model",
+			option.Any("model_id", synced.ModelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	slots, err := d.getAffectedSlots(ctx, synced.ModelID, events, now)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := entity.PlanBusyBlocks(slots, events)
+
+	blocked, err := d.slotRepo.BlockForImport(ctx, slotIDs(plan.Block))
+	if err != nil {
+		d.logger.Error(ctx, "failed to block slots for busy events",
+			option.Any("model_id", synced.ModelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	released, err := d.slotRepo.ReleaseImportBlock(ctx, slotIDs(plan.Release))
+	if err != nil {
+		d.logger.Error(ctx, "failed to release slots of busy events",
+			option.Any("model_id", synced.ModelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	calendars, err := d.calendarRepo.GetByModelID(ctx, synced.ModelID)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get busy calendars by model id",
+			option.Any("model_id", synced.ModelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res := &entity.BusyCalendarSync{
+		Calendar:  synced,
+		Blocked:   blocked,
+		Released:  released,
+		Conflicts: []entity.BusyConflict{},
+	}
+	for _, calendar := range calendars {
+		calendar.Conflicts = plan.Conflicts[calendar.ID]
+		if calendar.Conflicts == nil {
+			calendar.Conflicts = []entity.BusyConflict{}
+		}
+		if calendar.ID == synced.ID {
+			calendar.SyncedAt = &now
+		}
+
+		updated, err := d.calendarRepo.UpdateSync(ctx, calendar)
+		if err != nil {
+			d.logger.Error(ctx, "failed to update busy calendar sync",
+				option.Any("busy_calendar_id", calendar.ID),
+				option.Error(err))
+
+			return nil, err
+		}
+
+		if updated.ID == synced.ID {
+			res.Calendar = updated
+			res.Conflicts = updated.Conflicts
+		}
+	}
+
+	return res, nil
+}
+
+// getAffectedSlots returns the slots of the model under the events together with the slots blocked
+// by import before, which are released unless an event is still over them.
+func (d *DefaultBusyCalendarService) getAffectedSlots(ctx context.Context, modelID int64,
+	events []*entity.BusyEvent, now time.Time) ([]*entity.Slot, error) {
+
+	res, err := d.slotRepo.GetImportBlocked(ctx, modelID, now)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get slots blocked by import",
+			option.Any("model_id", modelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		return res, nil
+	}
+
+	end := events[0].EndTime
+	for _, e := range events[1:] {
+		if e.EndTime.After(end) {
+			end = e.EndTime
+		}
+	}
+
+	overlapping, err := d.slotRepo.GetOverlappingSlots(ctx, modelID, now, end)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get slots under busy events",
+			option.Any("model_id", modelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	seen := make(map[int64]bool, len(res))
+	for _, slot := range res {
+		seen[slot.ID] = true
+	}
+	for _, slot := range overlapping {
+		if !seen[slot.ID] {
+			res = append(res, slot)
+		}
+	}
+
+	return res, nil
+}
+
+func (d *DefaultBusyCalendarService) getOwnCalendar(ctx context.Context,
+	id, modelID int64) (*entity.BusyCalendar, error) {
+
+	calendar, err := d.calendarRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "busy calendar is not found by id",
+				option.Any("busy_calendar_id", id),
+				option.Error(service_errors.ErrBusyCalendarNotFound))
+
+			return nil, service_errors.ErrBusyCalendarNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get busy calendar by id",
+			option.Any("busy_calendar_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if calendar.ModelID != modelID {
+		d.logger.Error(ctx, "model is not an owner of busy calendar",
+			option.Any("busy_calendar_id", id),
+			option.Any("model_id", modelID),
+			option.Error(service_errors.ErrModelIsNotAnOwnerOfCalendar))
+
+		return nil, service_errors.ErrModelIsNotAnOwnerOfCalendar
+	}
+
+	return calendar, nil
+}
+
+func (d *DefaultBusyCalendarService) checkModelRestrictions(ctx context.Context,
+	authID *int64) (*entity.User, error) {
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleModel.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAModel))
+
+		return nil, service_errors.ErrNotAModel
+	}
+
+	model, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotAModel))
+
+			return nil, service_errors.ErrNotAModel
+		}
+
+		d.logger.Error(ctx, "check model restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !model.IsUserVerified() {
+		d.logger.Error(ctx, "model is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedModel))
+
+		return nil, service_errors.ErrNotVerifiedModel
+	}
+
+	return model, nil
+}
+
+func slotIDs(slots []*entity.Slot) []int64 {
+	ids := make([]int64, len(slots))
+	for i, slot := range slots {
+		ids[i] = slot.ID
+	}
+
+	return ids
+}
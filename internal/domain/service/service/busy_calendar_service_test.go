@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type busyCalendarServiceTest struct {
+	ctrl         *gomock.Controller
+	calendarRepo *mocks.MockBusyCalendarRepository
+	slotRepo     *mocks.MockSlotRepository
+	userRepo     *mocks.MockUserRepository
+	reader       *mocks.MockBusyCalendarReader
+	txManager    *mocks.MockTxManager
+	service      *DefaultBusyCalendarService
+}
+
+func setUpBusyCalendarServiceTest(t *testing.T) *busyCalendarServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	calendarRepo := mocks.NewMockBusyCalendarRepository(ctrl)
+	slotRepo := mocks.NewMockSlotRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	reader := mocks.NewMockBusyCalendarReader(ctrl)
+	txManager := mocks.NewMockTxManager(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(service_const.DotEnvPlatformTimezone, "Europe/Moscow")
+
+	calendarService, err := NewDefaultBusyCalendarService(calendarRepo, slotRepo, userRepo, reader, txManager, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &busyCalendarServiceTest{
+		ctrl:         ctrl,
+		calendarRepo: calendarRepo,
+		slotRepo:     slotRepo,
+		userRepo:     userRepo,
+		reader:       reader,
+		txManager:    txManager,
+		service:      calendarService,
+	}
+}
+
+func (test *busyCalendarServiceTest) expectTransaction() {
+	test.txManager.EXPECT().
+		WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Times(1)
+}
+
+// expectApply expects the events to be applied to the slots of the model, the model has only the given calendar.
+func (test *busyCalendarServiceTest) expectApply(calendar *entity.BusyCalendar, events []*entity.BusyEvent,
+	importBlocked, overlapping, blocked, released []*entity.Slot) {
+
+	test.calendarRepo.EXPECT().
+		GetModelEvents(gomock.Any(), calendar.ModelID, gomock.Any()).
+		Return(events, nil).
+		Times(1)
+
+	test.slotRepo.EXPECT().
+		GetImportBlocked(gomock.Any(), calendar.ModelID, gomock.Any()).
+		Return(importBlocked, nil).
+		Times(1)
+
+	if len(events) > 0 {
+		test.slotRepo.EXPECT().
+			GetOverlappingSlots(gomock.Any(), calendar.ModelID, gomock.Any(), gomock.Any()).
+			Return(overlapping, nil).
+			Times(1)
+	}
+
+	test.slotRepo.EXPECT().
+		BlockForImport(gomock.Any(), slotIDs(blocked)).
+		Return(blocked, nil).
+		Times(1)
+
+	test.slotRepo.EXPECT().
+		ReleaseImportBlock(gomock.Any(), slotIDs(released)).
+		Return(released, nil).
+		Times(1)
+
+	test.calendarRepo.EXPECT().
+		GetByModelID(gomock.Any(), calendar.ModelID).
+		Return([]*entity.BusyCalendar{calendar}, nil).
+		Times(1)
+
+	test.calendarRepo.EXPECT().
+		UpdateSync(gomock.Any(), calendar).
+		DoAndReturn(func(_ context.Context, c *entity.BusyCalendar) (*entity.BusyCalendar, error) {
+			return c, nil
+		}).
+		Times(1)
+}
+
+func TestBusyCalendarService_UploadEvents(t *testing.T) {
+	model := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+	url := "http://localhost/busy.ics"
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+
+	event := &entity.BusyEvent{
+		CalendarID: 3,
+		UID:        "shooting",
+		StartTime:  start,
+		EndTime:    start.Add(3 * time.Hour),
+	}
+	available := &entity.Slot{ID: 10, ModelID: 5, StartTime: start, EndTime: start.Add(time.Hour),
+		Status: entity.SlotAvailable}
+	booked := &entity.Slot{ID: 11, ModelID: 5, StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour),
+		Status: entity.SlotBooked}
+
+	tests := []struct {
+		name              string
+		mockCalendar      *entity.BusyCalendar
+		mockReadErr       error
+		expectedBlocked   []*entity.Slot
+		expectedConflicts []entity.BusyConflict
+		expectedError     error
+	}{
+		{
+			name:            "available slot under an event is blocked, booked one is a conflict",
+			mockCalendar:    &entity.BusyCalendar{ID: 3, ModelID: 5, Name: "Agency"},
+			expectedBlocked: []*entity.Slot{available},
+			expectedConflicts: []entity.BusyConflict{
+				{
+					SlotID:     booked.ID,
+					SlotStatus: entity.SlotBooked,
+					SlotStart:  booked.StartTime,
+					SlotEnd:    booked.EndTime,
+					EventUID:   event.UID,
+					EventStart: event.StartTime,
+					EventEnd:   event.EndTime,
+				},
+			},
+		},
+		{
+			name:          "calendar with URL is not uploaded",
+			mockCalendar:  &entity.BusyCalendar{ID: 3, ModelID: 5, Name: "Agency", URL: &url},
+			expectedError: service_errors.ErrBusyCalendarHasURL,
+		},
+		{
+			name:          "file is not a calendar",
+			mockCalendar:  &entity.BusyCalendar{ID: 3, ModelID: 5, Name: "Agency"},
+			mockReadErr:   errors.New("ical: not an iCalendar object"),
+			expectedError: service_errors.ErrInvalidBusyCalendarFile,
+		},
+		{
+			name:          "calendar of another model",
+			mockCalendar:  &entity.BusyCalendar{ID: 3, ModelID: 6, Name: "Agency"},
+			expectedError: service_errors.ErrModelIsNotAnOwnerOfCalendar,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpBusyCalendarServiceTest(t)
+			defer test.ctrl.Finish()
+
+			ctx := context.WithValue(context.Background(), service_const.AuthIDKey, model.AuthID)
+			ctx = context.WithValue(ctx, service_const.RoleKey, entity.RoleModel.String())
+			src := strings.NewReader("BEGIN:VCALENDAR")
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), model.AuthID).
+				Return(model, nil).
+				Times(1)
+
+			test.calendarRepo.EXPECT().
+				GetByID(gomock.Any(), tt.mockCalendar.ID).
+				Return(tt.mockCalendar, nil).
+				Times(1)
+
+			if tt.mockCalendar.ModelID == model.ID && tt.mockCalendar.URL == nil {
+				events := []*entity.BusyEvent{event}
+				if tt.mockReadErr != nil {
+					events = nil
+				}
+
+				test.reader.EXPECT().
+					Read(src, gomock.Any()).
+					Return(events, tt.mockReadErr).
+					Times(1)
+			}
+
+			if tt.expectedError == nil {
+				test.expectTransaction()
+
+				test.calendarRepo.EXPECT().
+					ReplaceEvents(gomock.Any(), tt.mockCalendar.ID, []*entity.BusyEvent{event}).
+					Return(nil).
+					Times(1)
+
+				test.expectApply(tt.mockCalendar, []*entity.BusyEvent{event},
+					nil, []*entity.Slot{available, booked}, tt.expectedBlocked, nil)
+			}
+
+			res, err := test.service.UploadEvents(ctx, tt.mockCalendar.ID, src)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedBlocked, res.Blocked)
+			assert.Empty(t, res.Released)
+			assert.Equal(t, tt.expectedConflicts, res.Conflicts)
+			assert.NotNil(t, res.Calendar.SyncedAt)
+		})
+	}
+}
+
+func TestBusyCalendarService_SyncCalendar(t *testing.T) {
+	berlin := "Europe/Berlin"
+	model := &entity.User{ID: 5, AuthID: 1, IsVerified: true, TimeZone: &berlin}
+	url := "http://localhost/busy.ics"
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+
+	blockedBefore := &entity.Slot{ID: 10, ModelID: 5, StartTime: start, EndTime: start.Add(time.Hour),
+		Status: entity.SlotDisabled, ImportBlocked: true}
+	released := &entity.Slot{ID: 10, ModelID: 5, StartTime: start, EndTime: start.Add(time.Hour),
+		Status: entity.SlotAvailable}
+
+	tests := []struct {
+		name             string
+		mockFetchErr     error
+		expectedReleased []*entity.Slot
+		expectedError    error
+	}{
+		{
+			name:             "slot is released when its event is gone",
+			expectedReleased: []*entity.Slot{released},
+		},
+		{
+			name:          "calendar URL is unreachable",
+			mockFetchErr:  errors.New("connection refused"),
+			expectedError: service_errors.ErrBusyCalendarUnreachable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpBusyCalendarServiceTest(t)
+			defer test.ctrl.Finish()
+
+			ctx := context.WithValue(context.Background(), service_const.AuthIDKey, model.AuthID)
+			ctx = context.WithValue(ctx, service_const.RoleKey, entity.RoleModel.String())
+			calendar := &entity.BusyCalendar{ID: 3, ModelID: 5, Name: "Agency", URL: &url}
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), model.AuthID).
+				Return(model, nil).
+				Times(1)
+
+			test.calendarRepo.EXPECT().
+				GetByID(gomock.Any(), calendar.ID).
+				Return(calendar, nil).
+				Times(1)
+
+			test.userRepo.EXPECT().
+				GetByID(gomock.Any(), model.ID).
+				Return(model, nil).
+				Times(1)
+
+			// floating times of the feed are read in the time zone of the model
+			test.reader.EXPECT().
+				Fetch(gomock.Any(), url, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, loc *time.Location) ([]*entity.BusyEvent, error) {
+					assert.Equal(t, berlin, loc.String())
+
+					return nil, tt.mockFetchErr
+				}).
+				Times(1)
+
+			if tt.expectedError == nil {
+				test.expectTransaction()
+
+				test.calendarRepo.EXPECT().
+					ReplaceEvents(gomock.Any(), calendar.ID, []*entity.BusyEvent{}).
+					Return(nil).
+					Times(1)
+
+				test.expectApply(calendar, nil, []*entity.Slot{blockedBefore}, nil, nil, tt.expectedReleased)
+			}
+
+			res, err := test.service.SyncCalendar(ctx, calendar.ID)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Empty(t, res.Blocked)
+			assert.Equal(t, tt.expectedReleased, res.Released)
+			assert.Empty(t, res.Conflicts)
+		})
+	}
+}
+
+func TestBusyCalendarService_SyncAll(t *testing.T) {
+	url := "http://localhost/busy.ics"
+	unreachable := &entity.BusyCalendar{ID: 3, ModelID: 5, Name: "Agency", URL: &url}
+	uploaded := &entity.BusyCalendar{ID: 4, ModelID: 6, Name: "Personal"}
+
+	test := setUpBusyCalendarServiceTest(t)
+	defer test.ctrl.Finish()
+
+	test.calendarRepo.EXPECT().
+		GetAll(gomock.Any()).
+		Return([]*entity.BusyCalendar{unreachable, uploaded}, nil).
+		Times(1)
+
+	test.userRepo.EXPECT().
+		GetByID(gomock.Any(), unreachable.ModelID).
+		Return(&entity.User{ID: unreachable.ModelID}, nil).
+		Times(1)
+
+	test.reader.EXPECT().
+		Fetch(gomock.Any(), url, gomock.Any()).
+		Return(nil, errors.New("connection refused")).
+		Times(1)
+
+	test.expectTransaction()
+	test.expectApply(uploaded, nil, nil, nil, nil, nil)
+
+	synced, err := test.service.SyncAll(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, synced)
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+const (
+	calendarTokenBytes  = 32
+	calendarFeedHistory = 30 * 24 * time.Hour
+	calendarFeedHorizon = 365 * 24 * time.Hour
+)
+
+type DefaultCalendarFeedService struct {
+	feedRepo interfaces.CalendarFeedRepository
+	slotRepo interfaces.SlotRepository
+	userRepo interfaces.UserRepository
+	logger   pkg.Logger
+}
+
+func NewDefaultCalendarFeedService(feedRepo interfaces.CalendarFeedRepository, slotRepo interfaces.SlotRepository,
+	userRepo interfaces.UserRepository, logger pkg.Logger) *DefaultCalendarFeedService {
+	return &DefaultCalendarFeedService{
+		feedRepo: feedRepo,
+		slotRepo: slotRepo,
+		userRepo: userRepo,
+		logger:   logger,
+	}
+}
+
+// GetFeed returns the calendar feed of the model or the client, it is created on the first request.
+func (d *DefaultCalendarFeedService) GetFeed(ctx context.Context) (*entity.CalendarFeed, error) {
+	user, role, err := d.getFeedOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feed, err := d.feedRepo.GetByUserID(ctx, user.ID)
+	if err == nil {
+		return feed, nil
+	}
+	if !errors.Is(err, persistence.ErrNoRowsFound) {
+		d.logger.Error(ctx, "failed to get calendar feed by user id",
+			option.Any("user_id", user.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return d.createFeed(ctx, user, role)
+}
+
+// RegenerateFeed gives the feed a new token, calendars subscribed with the old URL stop getting updates.
+func (d *DefaultCalendarFeedService) RegenerateFeed(ctx context.Context) (*entity.CalendarFeed, error) {
+	user, role, err := d.getFeedOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feed, err := d.feedRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			return d.createFeed(ctx, user, role)
+		}
+
+		d.logger.Error(ctx, "failed to get calendar feed by user id",
+			option.Any("user_id", user.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	feed.Token, err = newCalendarToken()
+	if err != nil {
+		d.logger.Error(ctx, "failed to generate calendar token",
+			option.Any("user_id", user.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := d.feedRepo.UpdateToken(ctx, feed)
+	if err != nil {
+		d.logger.Error(ctx, "failed to update calendar token",
+			option.Any("calendar_feed_id", feed.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ExportFeed builds the calendar behind the token. A model gets the slots and the orders from the last
+// 30 days up to a year ahead, a client gets the orders that are still to come.
+func (d *DefaultCalendarFeedService) ExportFeed(ctx context.Context, token string) (*entity.Calendar, error) {
+	feed, err := d.feedRepo.GetByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "calendar feed is not found by token",
+				option.Error(service_errors.ErrCalendarFeedNotFound))
+
+			return nil, service_errors.ErrCalendarFeedNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get calendar feed by token",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	user, err := d.userRepo.GetByID(ctx, feed.UserID)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get user by id",
+			option.Any("user_id", feed.UserID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	now := time.Now()
+	if feed.Role == entity.RoleClient {
+		orders, err := d.feedRepo.GetClientOrders(ctx, user.ID, now)
+		if err != nil {
+			d.logger.Error(ctx, "failed to get client orders for calendar",
+				option.Any("user_id", user.ID),
+				option.Error(err))
+
+			return nil, err
+		}
+
+		return entity.NewClientCalendar(user, orders), nil
+	}
+
+	from := now.Add(-calendarFeedHistory)
+	slots, err := d.slotRepo.GetOverlappingSlots(ctx, user.ID, from, now.Add(calendarFeedHorizon))
+	if err != nil {
+		d.logger.Error(ctx, "failed to get model slots for calendar",
+			option.Any("user_id", user.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	orders, err := d.feedRepo.GetModelOrders(ctx, user.ID, from)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get model orders for calendar",
+			option.Any("user_id", user.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return entity.NewModelCalendar(user, slots, orders), nil
+}
+
+func (d *DefaultCalendarFeedService) createFeed(ctx context.Context, user *entity.User,
+	role entity.Role) (*entity.CalendarFeed, error) {
+
+	token, err := newCalendarToken()
+	if err != nil {
+		d.logger.Error(ctx, "failed to generate calendar token",
+			option.Any("user_id", user.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	feed := entity.NewCalendarFeed(user.ID, role, token)
+	if err = d.feedRepo.Save(ctx, feed); err != nil {
+		if errors.Is(err, persistence.ErrDuplicateKey) {
+			// a concurrent request has created it first
+			return d.feedRepo.GetByUserID(ctx, user.ID)
+		}
+
+		d.logger.Error(ctx, "failed to save calendar feed",
+			option.Any("user_id", user.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return feed, nil
+}
+
+func (d *DefaultCalendarFeedService) getFeedOwner(ctx context.Context) (*entity.User, entity.Role, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var user *entity.User
+	switch entity.Role(*role) {
+	case entity.RoleClient:
+		user, err = d.checkClientRestrictions(ctx, authID)
+	case entity.RoleModel:
+		user, err = d.checkModelRestrictions(ctx, authID)
+	default:
+		d.logger.Error(ctx, "only client or model has calendar feed",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNoCalendarFeed))
+
+		return nil, "", service_errors.ErrNoCalendarFeed
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	return user, entity.Role(*role), nil
+}
+
+func (d *DefaultCalendarFeedService) checkModelRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
+	model, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotAModel))
+
+			return nil, service_errors.ErrNotAModel
+		}
+
+		d.logger.Error(ctx, "check model restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !model.IsUserVerified() {
+		d.logger.Error(ctx, "model is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedModel))
+
+		return nil, service_errors.ErrNotVerifiedModel
+	}
+
+	return model, nil
+}
+
+func (d *DefaultCalendarFeedService) checkClientRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
+	client, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "client is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotClient))
+
+			return nil, service_errors.ErrNotClient
+		}
+
+		d.logger.Error(ctx, "check client restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !client.IsUserVerified() {
+		d.logger.Error(ctx, "client is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedClient))
+
+		return nil, service_errors.ErrNotVerifiedClient
+	}
+
+	return client, nil
+}
+
+func newCalendarToken() (string, error) {
+	b := make([]byte, calendarTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
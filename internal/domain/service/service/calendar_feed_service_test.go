@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type calendarFeedServiceTest struct {
+	ctrl     *gomock.Controller
+	feedRepo *mocks.MockCalendarFeedRepository
+	slotRepo *mocks.MockSlotRepository
+	userRepo *mocks.MockUserRepository
+	service  *DefaultCalendarFeedService
+}
+
+func setUpCalendarFeedServiceTest(t *testing.T) *calendarFeedServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	feedRepo := mocks.NewMockCalendarFeedRepository(ctrl)
+	slotRepo := mocks.NewMockSlotRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &calendarFeedServiceTest{
+		ctrl:     ctrl,
+		feedRepo: feedRepo,
+		slotRepo: slotRepo,
+		userRepo: userRepo,
+		service:  NewDefaultCalendarFeedService(feedRepo, slotRepo, userRepo, log),
+	}
+}
+
+func TestCalendarFeedService_GetFeed(t *testing.T) {
+	model := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+	existing := &entity.CalendarFeed{ID: 2, UserID: 5, Role: entity.RoleModel, Token: "secret"}
+
+	tests := []struct {
+		name          string
+		role          entity.Role
+		mockFeed      *entity.CalendarFeed
+		mockSaveErr   error
+		expectedFeed  *entity.CalendarFeed
+		expectedError error
+	}{
+		{
+			name:         "existing feed is returned",
+			role:         entity.RoleModel,
+			mockFeed:     existing,
+			expectedFeed: existing,
+		},
+		{
+			name: "feed is created on the first request",
+			role: entity.RoleModel,
+		},
+		{
+			name:         "concurrent request has created the feed first",
+			role:         entity.RoleModel,
+			mockSaveErr:  persistence.ErrDuplicateKey,
+			expectedFeed: existing,
+		},
+		{
+			name:          "admin has no calendar feed",
+			role:          entity.RoleAdmin,
+			expectedError: service_errors.ErrNoCalendarFeed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpCalendarFeedServiceTest(t)
+			defer test.ctrl.Finish()
+
+			ctx := context.WithValue(context.Background(), service_const.AuthIDKey, model.AuthID)
+			ctx = context.WithValue(ctx, service_const.RoleKey, tt.role.String())
+
+			if tt.expectedError == nil {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), model.AuthID).
+					Return(model, nil).
+					Times(1)
+
+				feedErr := error(nil)
+				if tt.mockFeed == nil {
+					feedErr = persistence.ErrNoRowsFound
+				}
+
+				test.feedRepo.EXPECT().
+					GetByUserID(gomock.Any(), model.ID).
+					Return(tt.mockFeed, feedErr).
+					Times(1)
+			}
+
+			if tt.mockFeed == nil && tt.expectedError == nil {
+				test.feedRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(tt.mockSaveErr).
+					Times(1)
+
+				if tt.mockSaveErr != nil {
+					test.feedRepo.EXPECT().
+						GetByUserID(gomock.Any(), model.ID).
+						Return(existing, nil).
+						Times(1)
+				}
+			}
+
+			res, err := test.service.GetFeed(ctx)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			if tt.expectedFeed != nil {
+				assert.Equal(t, tt.expectedFeed, res)
+				return
+			}
+
+			assert.Equal(t, model.ID, res.UserID)
+			assert.Equal(t, entity.RoleModel, res.Role)
+			assert.Len(t, res.Token, 43)
+		})
+	}
+}
+
+func TestCalendarFeedService_RegenerateFeed(t *testing.T) {
+	ctx := context.WithValue(context.Background(), service_const.AuthIDKey, int64(2))
+	ctx = context.WithValue(ctx, service_const.RoleKey, "CLIENT")
+
+	client := &entity.User{ID: 3, AuthID: 2, IsVerified: true}
+
+	test := setUpCalendarFeedServiceTest(t)
+	defer test.ctrl.Finish()
+
+	test.userRepo.EXPECT().
+		GetByAuthID(gomock.Any(), client.AuthID).
+		Return(client, nil).
+		Times(1)
+
+	test.feedRepo.EXPECT().
+		GetByUserID(gomock.Any(), client.ID).
+		Return(&entity.CalendarFeed{ID: 4, UserID: 3, Role: entity.RoleClient, Token: "leaked"}, nil).
+		Times(1)
+
+	test.feedRepo.EXPECT().
+		UpdateToken(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, f *entity.CalendarFeed) (*entity.CalendarFeed, error) {
+			return f, nil
+		}).
+		Times(1)
+
+	res, err := test.service.RegenerateFeed(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), res.ID)
+	assert.NotEqual(t, "leaked", res.Token)
+	assert.Len(t, res.Token, 43)
+}
+
+func TestCalendarFeedService_ExportFeed(t *testing.T) {
+	start := time.Date(2026, 10, 20, 10, 0, 0, 0, time.UTC)
+	model := &entity.User{ID: 5, Name: "Anna"}
+	client := &entity.User{ID: 3, Name: "Kate"}
+
+	slots := []*entity.Slot{
+		{ID: 1, ModelID: 5, StartTime: start, EndTime: start.Add(time.Hour), Status: entity.SlotAvailable},
+		{ID: 2, ModelID: 5, StartTime: start.Add(2 * time.Hour), EndTime: start.Add(3 * time.Hour), Status: entity.SlotBooked},
+		{ID: 3, ModelID: 5, StartTime: start.Add(4 * time.Hour), EndTime: start.Add(5 * time.Hour), Status: entity.SlotReserved},
+	}
+	orders := []*entity.CalendarOrder{
+		{
+			OrderID:          7,
+			SlotID:           2,
+			Status:           entity.OrderConfirmed,
+			ServiceTitle:     "Photo session",
+			ModelName:        "Anna",
+			Address:          entity.NewAddress("Tverskaya", 12, 34, 2, 5, "code 1234"),
+			StartTime:        start.Add(2 * time.Hour),
+			EndTime:          start.Add(3 * time.Hour),
+			ExtensionMinutes: 30,
+		},
+	}
+	orderEvent := entity.CalendarEvent{
+		UID:         "order-7@samok-aah-t",
+		Summary:     "Photo session",
+		Description: "Order #7, CONFIRMED",
+		Location:    "Tverskaya",
+		Status:      entity.CalendarEventConfirmed,
+		StartTime:   start.Add(2 * time.Hour),
+		EndTime:     start.Add(3*time.Hour + 30*time.Minute),
+	}
+
+	tests := []struct {
+		name             string
+		mockFeed         *entity.CalendarFeed
+		mockFeedErr      error
+		expectedCalendar *entity.Calendar
+		expectedError    error
+	}{
+		{
+			name:     "model feed shows slots and orders, booked slot is shown as its order",
+			mockFeed: &entity.CalendarFeed{ID: 1, UserID: 5, Role: entity.RoleModel, Token: "model"},
+			expectedCalendar: &entity.Calendar{
+				Name: "Anna",
+				Events: []entity.CalendarEvent{
+					orderEvent,
+					{
+						UID:       "slot-1@samok-aah-t",
+						Summary:   "Available slot",
+						Status:    entity.CalendarEventConfirmed,
+						Free:      true,
+						StartTime: start,
+						EndTime:   start.Add(time.Hour),
+					},
+					{
+						UID:       "slot-3@samok-aah-t",
+						Summary:   "Reserved slot",
+						Status:    entity.CalendarEventTentative,
+						StartTime: start.Add(4 * time.Hour),
+						EndTime:   start.Add(5 * time.Hour),
+					},
+				},
+			},
+		},
+		{
+			name:     "client feed shows upcoming orders only",
+			mockFeed: &entity.CalendarFeed{ID: 2, UserID: 3, Role: entity.RoleClient, Token: "client"},
+			expectedCalendar: &entity.Calendar{
+				Name: "Kate",
+				Events: []entity.CalendarEvent{
+					{
+						UID:         "order-7@samok-aah-t",
+						Summary:     "Photo session with Anna",
+						Description: "Order #7, CONFIRMED",
+						Location:    "Tverskaya",
+						Status:      entity.CalendarEventConfirmed,
+						StartTime:   start.Add(2 * time.Hour),
+						EndTime:     start.Add(3*time.Hour + 30*time.Minute),
+					},
+				},
+			},
+		},
+		{
+			name:          "token is regenerated or never existed",
+			mockFeedErr:   persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrCalendarFeedNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpCalendarFeedServiceTest(t)
+			defer test.ctrl.Finish()
+
+			token := "unknown"
+			if tt.mockFeed != nil {
+				token = tt.mockFeed.Token
+			}
+
+			test.feedRepo.EXPECT().
+				GetByToken(gomock.Any(), token).
+				Return(tt.mockFeed, tt.mockFeedErr).
+				Times(1)
+
+			if tt.mockFeed != nil && tt.mockFeed.Role == entity.RoleModel {
+				test.userRepo.EXPECT().
+					GetByID(gomock.Any(), model.ID).
+					Return(model, nil).
+					Times(1)
+
+				test.slotRepo.EXPECT().
+					GetOverlappingSlots(gomock.Any(), model.ID, gomock.Any(), gomock.Any()).
+					Return(slots, nil).
+					Times(1)
+
+				test.feedRepo.EXPECT().
+					GetModelOrders(gomock.Any(), model.ID, gomock.Any()).
+					Return(orders, nil).
+					Times(1)
+			}
+
+			if tt.mockFeed != nil && tt.mockFeed.Role == entity.RoleClient {
+				test.userRepo.EXPECT().
+					GetByID(gomock.Any(), client.ID).
+					Return(client, nil).
+					Times(1)
+
+				test.feedRepo.EXPECT().
+					GetClientOrders(gomock.Any(), client.ID, gomock.Any()).
+					Return(orders, nil).
+					Times(1)
+			}
+
+			res, err := test.service.ExportFeed(context.Background(), token)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCalendar, res)
+		})
+	}
+}
@@ -0,0 +1,759 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type DefaultDisputeService struct {
+	disputeRepo      interfaces.DisputeRepository
+	orderRepo        interfaces.OrderRepository
+	bookingRepo      interfaces.BookingRepository
+	slotRepo         interfaces.SlotRepository
+	userRepo         interfaces.UserRepository
+	adminRepo        interfaces.AdminRepository
+	modelServiceRepo interfaces.ModelServiceRepository
+	eventBroker      interfaces.OrderEventBroker
+	payments         interfaces.PaymentProcessor
+	ledger           interfaces.LedgerPoster
+	receipts         interfaces.ReceiptIssuer
+	txManager        database.TxManager
+	logger           pkg.Logger
+	window           time.Duration
+}
+
+func NewDefaultDisputeService(disputeRepo interfaces.DisputeRepository, orderRepo interfaces.OrderRepository,
+	bookingRepo interfaces.BookingRepository, slotRepo interfaces.SlotRepository, userRepo interfaces.UserRepository,
+	adminRepo interfaces.AdminRepository, modelServiceRepo interfaces.ModelServiceRepository,
+	eventBroker interfaces.OrderEventBroker, payments interfaces.PaymentProcessor, ledger interfaces.LedgerPoster,
+	receipts interfaces.ReceiptIssuer, txManager database.TxManager, logger pkg.Logger) (*DefaultDisputeService, error) {
+
+	ttl := os.Getenv(service_const.DotEnvDisputeWindow)
+	if ttl == "" {
+		return nil, service_errors.ErrLoadingTTL
+	}
+
+	ttlInSeconds, err := strconv.Atoi(ttl)
+	if err != nil {
+		return nil, service_errors.ErrParsingTTL
+	}
+	if ttlInSeconds < 0 {
+		return nil, service_errors.ErrNotPositiveTTL
+	}
+
+	return &DefaultDisputeService{
+		disputeRepo:      disputeRepo,
+		orderRepo:        orderRepo,
+		bookingRepo:      bookingRepo,
+		slotRepo:         slotRepo,
+		userRepo:         userRepo,
+		adminRepo:        adminRepo,
+		modelServiceRepo: modelServiceRepo,
+		eventBroker:      eventBroker,
+		payments:         payments,
+		ledger:           ledger,
+		receipts:         receipts,
+		txManager:        txManager,
+		logger:           logger,
+		window:           time.Duration(ttlInSeconds) * time.Second,
+	}, nil
+}
+
+func (d *DefaultDisputeService) OpenDispute(ctx context.Context, orderID int64,
+	category entity.DisputeCategory, description string) (*entity.Dispute, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *entity.User
+	switch entity.Role(*role) {
+	case entity.RoleClient:
+		user, err = d.checkClientRestrictions(ctx, authID)
+	case entity.RoleModel:
+		user, err = d.checkModelRestrictions(ctx, authID)
+	default:
+		d.logger.Error(ctx, "only client or model can open dispute",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotDisputeParticipant))
+
+		return nil, service_errors.ErrNotDisputeParticipant
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	order, booking, modelService, err := d.getOrderDetails(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if entity.Role(*role) == entity.RoleClient && booking.ClientID != user.ID {
+		d.logger.Error(ctx, "order is not owned by this client",
+			option.Any("order_id", orderID),
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrClientIsNotOwnerOfOrder))
+
+		return nil, service_errors.ErrClientIsNotOwnerOfOrder
+	}
+
+	if entity.Role(*role) == entity.RoleModel && modelService.ModelID != user.ID {
+		d.logger.Error(ctx, "model service is not owned by this model",
+			option.Any("order_id", orderID),
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrModelIsNotAnOwnerOfService))
+
+		return nil, service_errors.ErrModelIsNotAnOwnerOfService
+	}
+
+	slot, err := d.slotRepo.GetByID(ctx, booking.SlotID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "slot is not found by id",
+				option.Any("slot_id", booking.SlotID),
+				option.Error(service_errors.ErrSlotIsNotFound))
+
+			return nil, service_errors.ErrSlotIsNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get slot by id",
+			option.Any("slot_id", booking.SlotID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !order.CanBeDisputed(time.Now(), slot.StartTime, slot.EndTime, d.window) {
+		d.logger.Error(ctx, "order cannot be disputed",
+			option.Any("order_id", orderID),
+			option.Any("status", order.Status),
+			option.Error(service_errors.ErrCannotOpenDispute))
+
+		return nil, service_errors.ErrCannotOpenDispute
+	}
+
+	_, err = d.disputeRepo.GetByOrderID(ctx, orderID)
+	if err == nil {
+		d.logger.Error(ctx, "dispute already exists",
+			option.Any("order_id", orderID),
+			option.Error(service_errors.ErrDisputeAlreadyExists))
+
+		return nil, service_errors.ErrDisputeAlreadyExists
+	}
+	if !errors.Is(err, persistence.ErrNoRowsFound) {
+		d.logger.Error(ctx, "failed to get dispute by order id",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	dispute := entity.NewDispute(order.ID, booking.ClientID, modelService.ModelID,
+		entity.Role(*role), category, description)
+
+	var updatedOrder *entity.Order
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := d.disputeRepo.Save(ctx, dispute); err != nil {
+			d.logger.Error(ctx, "failed to save dispute",
+				option.Any("order_id", orderID),
+				option.Error(err))
+
+			return err
+		}
+
+		order.OpenDispute()
+		res, err := d.orderRepo.UpdateStatus(ctx, order)
+		if err != nil {
+			if errors.Is(err, persistence.ErrNoRowsFound) {
+				d.logger.Error(ctx, "order is not found by id",
+					option.Any("order_id", orderID),
+					option.Error(service_errors.ErrOrderNotFound))
+
+				return service_errors.ErrOrderNotFound
+			}
+
+			d.logger.Error(ctx, "failed to update order status",
+				option.Any("order_id", orderID),
+				option.Error(err))
+
+			return err
+		}
+		updatedOrder = res
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.eventBroker.Publish(ctx, entity.NewOrderStatusEvent(updatedOrder))
+
+	return dispute, nil
+}
+
+func (d *DefaultDisputeService) GetDispute(ctx context.Context, id int64) (*entity.Dispute, error) {
+	return d.getAccessibleDispute(ctx, id)
+}
+
+func (d *DefaultDisputeService) GetDisputeMessages(ctx context.Context, id int64,
+	page, limit *int64) ([]*entity.DisputeMessage, error) {
+
+	dispute, err := d.getAccessibleDispute(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.disputeRepo.GetMessages(ctx, dispute.ID, entity.NewOptions(common.CheckPagination(page, limit)))
+	if err != nil {
+		d.logger.Error(ctx, "failed to get dispute messages",
+			option.Any("dispute_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultDisputeService) AddDisputeMessage(ctx context.Context, id int64,
+	body string) (*entity.DisputeMessage, error) {
+
+	dispute, err := d.getAccessibleDispute(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if dispute.IsResolved() {
+		d.logger.Error(ctx, "dispute is resolved",
+			option.Any("dispute_id", id),
+			option.Error(service_errors.ErrDisputeIsResolved))
+
+		return nil, service_errors.ErrDisputeIsResolved
+	}
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	message := entity.NewDisputeMessage(dispute.ID, *authID, entity.Role(*role), body)
+	err = d.disputeRepo.SaveMessage(ctx, message)
+	if err != nil {
+		d.logger.Error(ctx, "failed to save dispute message",
+			option.Any("dispute_id", id),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (d *DefaultDisputeService) GetAllDisputes(ctx context.Context, status *entity.DisputeStatus,
+	page, limit *int64) ([]*entity.Dispute, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.checkAdminRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.disputeRepo.GetAll(ctx, status, entity.NewOptions(common.CheckPagination(page, limit)))
+	if err != nil {
+		d.logger.Error(ctx, "failed to get all disputes",
+			option.Any("status", status),
+			option.Any("page", page),
+			option.Any("limit", limit),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// AssignDispute assigns the dispute to the given admin or to the current one when adminID is nil.
+func (d *DefaultDisputeService) AssignDispute(ctx context.Context, id int64, adminID *int64) (*entity.Dispute, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.checkAdminRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	var admin *entity.Admin
+	if adminID == nil {
+		admin, err = d.adminRepo.GetByAuthID(ctx, *authID)
+	} else {
+		admin, err = d.adminRepo.GetByID(ctx, *adminID)
+	}
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "admin is not found",
+				option.Any("admin_id", adminID),
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrAdminNotFound))
+
+			return nil, service_errors.ErrAdminNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get admin",
+			option.Any("admin_id", adminID),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	dispute, err := d.getDispute(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if dispute.IsResolved() {
+		d.logger.Error(ctx, "dispute is resolved",
+			option.Any("dispute_id", id),
+			option.Error(service_errors.ErrDisputeIsResolved))
+
+		return nil, service_errors.ErrDisputeIsResolved
+	}
+
+	dispute.Assign(admin.ID)
+
+	return d.updateDispute(ctx, dispute)
+}
+
+func (d *DefaultDisputeService) ResolveDispute(ctx context.Context, id int64, resolution entity.DisputeResolution,
+	refundAmount *entity.Money, comment *string) (*entity.Dispute, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.checkAdminRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := d.adminRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "admin is not found by auth id",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrAdminNotFound))
+
+			return nil, service_errors.ErrAdminNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get admin by auth id",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	dispute, err := d.getDispute(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if dispute.IsResolved() {
+		d.logger.Error(ctx, "dispute is resolved",
+			option.Any("dispute_id", id),
+			option.Error(service_errors.ErrDisputeIsResolved))
+
+		return nil, service_errors.ErrDisputeIsResolved
+	}
+
+	if dispute.AssignedAdminID != nil && *dispute.AssignedAdminID != admin.ID {
+		d.logger.Error(ctx, "dispute is assigned to another admin",
+			option.Any("dispute_id", id),
+			option.Any("admin_id", admin.ID),
+			option.Error(service_errors.ErrNotDisputeAssignee))
+
+		return nil, service_errors.ErrNotDisputeAssignee
+	}
+
+	order, booking, _, err := d.getOrderDetails(ctx, dispute.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var refund *entity.Money
+	switch resolution {
+	case entity.ResolutionFullRefund:
+		refund = &booking.Price
+	case entity.ResolutionPartialRefund:
+		partial := false
+		if refundAmount != nil && refundAmount.IsPositive() {
+			if partial, err = refundAmount.LessThan(booking.Price); err != nil {
+				d.logger.Error(ctx, "failed to compare refund amount",
+					option.Any("dispute_id", id),
+					option.Any("refund_amount", refundAmount),
+					option.Error(err))
+
+				return nil, err
+			}
+		}
+
+		if !partial {
+			d.logger.Error(ctx, "invalid partial refund amount",
+				option.Any("dispute_id", id),
+				option.Any("refund_amount", refundAmount),
+				option.Error(service_errors.ErrInvalidRefundAmount))
+
+			return nil, service_errors.ErrInvalidRefundAmount
+		}
+		refund = refundAmount
+	}
+
+	now := time.Now()
+	dispute.Assign(admin.ID)
+	dispute.Resolve(now, resolution, refund, comment)
+
+	var (
+		res          *entity.Dispute
+		updatedOrder *entity.Order
+	)
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if res, err = d.disputeRepo.Update(ctx, dispute); err != nil {
+			if errors.Is(err, persistence.ErrNoRowsFound) {
+				d.logger.Error(ctx, "dispute is not found by id",
+					option.Any("dispute_id", id),
+					option.Error(service_errors.ErrDisputeNotFound))
+
+				return service_errors.ErrDisputeNotFound
+			}
+
+			d.logger.Error(ctx, "failed to update dispute",
+				option.Any("dispute_id", id),
+				option.Error(err))
+
+			return err
+		}
+
+		order.CloseDispute(now, resolution.OrderStatusAfter())
+		if updatedOrder, err = d.orderRepo.UpdateStatus(ctx, order); err != nil {
+			if errors.Is(err, persistence.ErrNoRowsFound) {
+				d.logger.Error(ctx, "order is not found by id",
+					option.Any("order_id", order.ID),
+					option.Error(service_errors.ErrOrderNotFound))
+
+				return service_errors.ErrOrderNotFound
+			}
+
+			d.logger.Error(ctx, "failed to update order status",
+				option.Any("order_id", order.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		var payment *entity.Payment
+		switch {
+		case !booking.Price.IsPositive():
+			// a free order has no payment to settle
+		case resolution == entity.ResolutionFullRefund:
+			payment, err = d.payments.Release(ctx, order.ID)
+		case resolution == entity.ResolutionPartialRefund:
+			payment, err = d.payments.Refund(ctx, order.ID, *refund)
+		default:
+			payment, err = d.payments.Capture(ctx, order.ID)
+		}
+		if err != nil {
+			d.logger.Error(ctx, "failed to settle payment",
+				option.Any("order_id", order.ID),
+				option.Any("resolution", resolution),
+				option.Error(err))
+
+			return err
+		}
+
+		if payment != nil && entity.CanHaveReceipt(updatedOrder, payment) {
+			if _, err = d.receipts.IssueReceipt(ctx, updatedOrder, payment); err != nil {
+				d.logger.Error(ctx, "failed to issue receipt",
+					option.Any("order_id", order.ID),
+					option.Error(err))
+
+				return err
+			}
+		}
+
+		if resolution == entity.ResolutionPenalizeModel {
+			if err = d.ledger.PostPenalty(ctx, order.ID); err != nil {
+				d.logger.Error(ctx, "failed to post model penalty",
+					option.Any("order_id", order.ID),
+					option.Error(err))
+
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.eventBroker.Publish(ctx, entity.NewOrderStatusEvent(updatedOrder))
+
+	return res, nil
+}
+
+// getAccessibleDispute returns the dispute if the current user is an admin or one of its parties.
+func (d *DefaultDisputeService) getAccessibleDispute(ctx context.Context, id int64) (*entity.Dispute, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *entity.User
+	switch entity.Role(*role) {
+	case entity.RoleAdmin:
+		return d.getDispute(ctx, id)
+	case entity.RoleClient:
+		user, err = d.checkClientRestrictions(ctx, authID)
+	case entity.RoleModel:
+		user, err = d.checkModelRestrictions(ctx, authID)
+	default:
+		return nil, service_errors.ErrNotDisputeParticipant
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dispute, err := d.getDispute(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dispute.IsParticipant(user.ID) {
+		d.logger.Error(ctx, "user is not a participant of dispute",
+			option.Any("dispute_id", id),
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotDisputeParticipant))
+
+		return nil, service_errors.ErrNotDisputeParticipant
+	}
+
+	return dispute, nil
+}
+
+func (d *DefaultDisputeService) getDispute(ctx context.Context, id int64) (*entity.Dispute, error) {
+	dispute, err := d.disputeRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "dispute is not found by id",
+				option.Any("dispute_id", id),
+				option.Error(service_errors.ErrDisputeNotFound))
+
+			return nil, service_errors.ErrDisputeNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get dispute by id",
+			option.Any("dispute_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return dispute, nil
+}
+
+func (d *DefaultDisputeService) updateDispute(ctx context.Context, dispute *entity.Dispute) (*entity.Dispute, error) {
+	res, err := d.disputeRepo.Update(ctx, dispute)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "dispute is not found by id",
+				option.Any("dispute_id", dispute.ID),
+				option.Error(service_errors.ErrDisputeNotFound))
+
+			return nil, service_errors.ErrDisputeNotFound
+		}
+
+		d.logger.Error(ctx, "failed to update dispute",
+			option.Any("dispute_id", dispute.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultDisputeService) getOrderDetails(ctx context.Context,
+	orderID int64) (*entity.Order, *entity.Booking, *entity.ModelService, error) {
+
+	order, err := d.orderRepo.GetByID(ctx, orderID)
+	if errors.Is(err, persistence.ErrNoRowsFound) {
+		order, err = d.orderRepo.GetArchivedByID(ctx, orderID)
+	}
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "order is not found by id",
+				option.Any("order_id", orderID),
+				option.Error(service_errors.ErrOrderNotFound))
+
+			return nil, nil, nil, service_errors.ErrOrderNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get order by id",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return nil, nil, nil, err
+	}
+
+	booking, err := d.bookingRepo.GetByID(ctx, order.BookingID)
+	if errors.Is(err, persistence.ErrNoRowsFound) {
+		booking, err = d.bookingRepo.GetArchivedByID(ctx, order.BookingID)
+	}
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "booking is not found by id",
+				option.Any("booking_id", order.BookingID),
+				option.Error(service_errors.ErrBookingNotFound))
+
+			return nil, nil, nil, service_errors.ErrBookingNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get booking by id",
+			option.Any("booking_id", order.BookingID),
+			option.Error(err))
+
+		return nil, nil, nil, err
+	}
+
+	modelService, err := d.modelServiceRepo.GetByID(ctx, booking.ModelServiceID, false)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model service is not found by id",
+				option.Any("model_service_id", booking.ModelServiceID),
+				option.Error(service_errors.ErrServiceIsNotFound))
+
+			return nil, nil, nil, service_errors.ErrServiceIsNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get model service by id",
+			option.Any("model_service_id", booking.ModelServiceID),
+			option.Error(err))
+
+		return nil, nil, nil, err
+	}
+
+	return order, booking, modelService, nil
+}
+
+func (d *DefaultDisputeService) checkAdminRestrictions(ctx context.Context, authID *int64) error {
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *role != entity.RoleAdmin.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAdmin))
+
+		return service_errors.ErrNotAdmin
+	}
+
+	return nil
+}
+
+func (d *DefaultDisputeService) checkModelRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
+	model, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotAModel))
+
+			return nil, service_errors.ErrNotAModel
+		}
+
+		d.logger.Error(ctx, "check model restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !model.IsUserVerified() {
+		d.logger.Error(ctx, "model is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedModel))
+
+		return nil, service_errors.ErrNotVerifiedModel
+	}
+
+	return model, nil
+}
+
+func (d *DefaultDisputeService) checkClientRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
+	client, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "client is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotClient))
+
+			return nil, service_errors.ErrNotClient
+		}
+
+		d.logger.Error(ctx, "check client restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !client.IsUserVerified() {
+		d.logger.Error(ctx, "client is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedClient))
+
+		return nil, service_errors.ErrNotVerifiedClient
+	}
+
+	return client, nil
+}
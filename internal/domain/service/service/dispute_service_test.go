@@ -0,0 +1,754 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type disputeServiceTest struct {
+	ctrl             *gomock.Controller
+	disputeRepo      *mocks.MockDisputeRepository
+	orderRepo        *mocks.MockOrderRepository
+	bookingRepo      *mocks.MockBookingRepository
+	slotRepo         *mocks.MockSlotRepository
+	userRepo         *mocks.MockUserRepository
+	adminRepo        *mocks.MockAdminRepository
+	modelServiceRepo *mocks.MockModelServiceRepository
+	eventBroker      *mocks.MockOrderEventBroker
+	payments         *mocks.MockPaymentProcessor
+	ledger           *mocks.MockLedgerPoster
+	receipts         *mocks.MockReceiptIssuer
+	txManager        *mocks.MockTxManager
+	service          *DefaultDisputeService
+}
+
+func setUpDisputeServiceTest(t *testing.T) *disputeServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	disputeRepo := mocks.NewMockDisputeRepository(ctrl)
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	bookingRepo := mocks.NewMockBookingRepository(ctrl)
+	slotRepo := mocks.NewMockSlotRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	adminRepo := mocks.NewMockAdminRepository(ctrl)
+	modelServiceRepo := mocks.NewMockModelServiceRepository(ctrl)
+	eventBroker := mocks.NewMockOrderEventBroker(ctrl)
+	payments := mocks.NewMockPaymentProcessor(ctrl)
+	ledger := mocks.NewMockLedgerPoster(ctrl)
+	receipts := mocks.NewMockReceiptIssuer(ctrl)
+	mockTxManager := mocks.NewMockTxManager(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = os.Setenv(service_const.DotEnvDisputeWindow, "86400"); err != nil {
+		t.Fatal(err)
+	}
+
+	disputeService, err := NewDefaultDisputeService(
+		disputeRepo, orderRepo, bookingRepo, slotRepo, userRepo, adminRepo, modelServiceRepo,
+		eventBroker, payments, ledger, receipts, mockTxManager, log,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &disputeServiceTest{
+		ctrl:             ctrl,
+		disputeRepo:      disputeRepo,
+		orderRepo:        orderRepo,
+		bookingRepo:      bookingRepo,
+		slotRepo:         slotRepo,
+		userRepo:         userRepo,
+		adminRepo:        adminRepo,
+		modelServiceRepo: modelServiceRepo,
+		eventBroker:      eventBroker,
+		payments:         payments,
+		ledger:           ledger,
+		receipts:         receipts,
+		txManager:        mockTxManager,
+		service:          disputeService,
+	}
+}
+
+func TestNewDefaultDisputeService_Window(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expectedError error
+	}{
+		{
+			name:          "missing window",
+			value:         "",
+			expectedError: service_errors.ErrLoadingTTL,
+		},
+		{
+			name:          "not a number",
+			value:         "three days",
+			expectedError: service_errors.ErrParsingTTL,
+		},
+		{
+			name:          "negative window",
+			value:         "-1",
+			expectedError: service_errors.ErrNotPositiveTTL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(service_const.DotEnvDisputeWindow, tt.value)
+
+			_, err := NewDefaultDisputeService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			assert.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestDisputeService_OpenDispute(t *testing.T) {
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(2))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	ctxAdmin := context.WithValue(context.Background(), service_const.AuthIDKey, int64(3))
+	ctxAdmin = context.WithValue(ctxAdmin, service_const.RoleKey, "ADMIN")
+
+	client := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+	model := &entity.User{ID: 6, AuthID: 2, IsVerified: true}
+
+	booking := &entity.Booking{ID: 2, ClientID: 5, ModelServiceID: 3, SlotID: 4}
+	modelService := &entity.ModelService{ID: 3, ModelID: 6, Price: rub(100)}
+
+	finishedSlot := &entity.Slot{
+		ID:        4,
+		StartTime: time.Now().Add(-3 * time.Hour),
+		EndTime:   time.Now().Add(-2 * time.Hour),
+	}
+	oldSlot := &entity.Slot{
+		ID:        4,
+		StartTime: time.Now().Add(-72 * time.Hour),
+		EndTime:   time.Now().Add(-71 * time.Hour),
+	}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		mockUser       *entity.User
+		mockOrder      *entity.Order
+		mockBooking    *entity.Booking
+		mockSlot       *entity.Slot
+		mockExisting   error
+		expectSave     bool
+		expectedOpener entity.Role
+		expectedError  error
+	}{
+		{
+			name:           "client opens dispute",
+			ctx:            ctxClient,
+			mockUser:       client,
+			mockOrder:      &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderPendingConfirmation},
+			mockBooking:    booking,
+			mockSlot:       finishedSlot,
+			mockExisting:   persistence.ErrNoRowsFound,
+			expectSave:     true,
+			expectedOpener: entity.RoleClient,
+		},
+		{
+			name:           "model opens dispute",
+			ctx:            ctxModel,
+			mockUser:       model,
+			mockOrder:      &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockBooking:    booking,
+			mockSlot:       finishedSlot,
+			mockExisting:   persistence.ErrNoRowsFound,
+			expectSave:     true,
+			expectedOpener: entity.RoleModel,
+		},
+		{
+			name:          "admin cannot open dispute",
+			ctx:           ctxAdmin,
+			expectedError: service_errors.ErrNotDisputeParticipant,
+		},
+		{
+			name:          "client is not an owner",
+			ctx:           ctxClient,
+			mockUser:      client,
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderCompleted},
+			mockBooking:   &entity.Booking{ID: 2, ClientID: 42, ModelServiceID: 3, SlotID: 4},
+			expectedError: service_errors.ErrClientIsNotOwnerOfOrder,
+		},
+		{
+			name:          "dispute window is over",
+			ctx:           ctxClient,
+			mockUser:      client,
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderCompleted},
+			mockBooking:   booking,
+			mockSlot:      oldSlot,
+			expectedError: service_errors.ErrCannotOpenDispute,
+		},
+		{
+			name:          "completed order cannot be disputed",
+			ctx:           ctxClient,
+			mockUser:      client,
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderCompleted},
+			mockBooking:   booking,
+			mockSlot:      finishedSlot,
+			expectedError: service_errors.ErrCannotOpenDispute,
+		},
+		{
+			name:          "cancelled order cannot be disputed",
+			ctx:           ctxClient,
+			mockUser:      client,
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderCancelled},
+			mockBooking:   booking,
+			mockSlot:      finishedSlot,
+			expectedError: service_errors.ErrCannotOpenDispute,
+		},
+		{
+			name:          "dispute already exists",
+			ctx:           ctxClient,
+			mockUser:      client,
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderPendingConfirmation},
+			mockBooking:   booking,
+			mockSlot:      finishedSlot,
+			expectedError: service_errors.ErrDisputeAlreadyExists,
+		},
+		{
+			name:          "disputed order cannot be disputed again",
+			ctx:           ctxClient,
+			mockUser:      client,
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderDisputed},
+			mockBooking:   booking,
+			mockSlot:      finishedSlot,
+			expectedError: service_errors.ErrCannotOpenDispute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpDisputeServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.mockUser != nil {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), tt.mockUser.AuthID).
+					Return(tt.mockUser, nil).
+					Times(1)
+			}
+
+			if tt.mockOrder != nil {
+				test.orderRepo.EXPECT().
+					GetByID(gomock.Any(), tt.mockOrder.ID).
+					Return(tt.mockOrder, nil).
+					Times(1)
+
+				test.bookingRepo.EXPECT().
+					GetByID(gomock.Any(), tt.mockOrder.BookingID).
+					Return(tt.mockBooking, nil).
+					Times(1)
+
+				test.modelServiceRepo.EXPECT().
+					GetByID(gomock.Any(), tt.mockBooking.ModelServiceID, false).
+					Return(modelService, nil).
+					Times(1)
+			}
+
+			if tt.mockSlot != nil {
+				test.slotRepo.EXPECT().
+					GetByID(gomock.Any(), tt.mockBooking.SlotID).
+					Return(tt.mockSlot, nil).
+					Times(1)
+			}
+
+			if tt.mockExisting != nil || errors.Is(tt.expectedError, service_errors.ErrDisputeAlreadyExists) {
+				var existing *entity.Dispute
+				if tt.mockExisting == nil {
+					existing = &entity.Dispute{ID: 9, OrderID: 1}
+				}
+
+				test.disputeRepo.EXPECT().
+					GetByOrderID(gomock.Any(), tt.mockOrder.ID).
+					Return(existing, tt.mockExisting).
+					Times(1)
+			}
+
+			if tt.expectSave {
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.disputeRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, d *entity.Dispute) error {
+						d.ID = 10
+						return nil
+					}).
+					Times(1)
+
+				test.orderRepo.EXPECT().
+					UpdateStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, o *entity.Order) (*entity.Order, error) {
+						assert.Equal(t, entity.OrderDisputed, o.Status)
+						return o, nil
+					}).
+					Times(1)
+
+				test.eventBroker.EXPECT().
+					Publish(gomock.Any(), gomock.Any()).
+					Times(1)
+			}
+
+			result, err := test.service.OpenDispute(tt.ctx, 1, entity.DisputeNoShow, "model did not come")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(10), result.ID)
+				assert.Equal(t, tt.expectedOpener, result.OpenedBy)
+				assert.Equal(t, entity.DisputeOpen, result.Status)
+				assert.Equal(t, int64(5), result.ClientID)
+				assert.Equal(t, int64(6), result.ModelID)
+			}
+		})
+	}
+}
+
+func TestDisputeService_AddDisputeMessage(t *testing.T) {
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	ctxAdmin := context.WithValue(context.Background(), service_const.AuthIDKey, int64(3))
+	ctxAdmin = context.WithValue(ctxAdmin, service_const.RoleKey, "ADMIN")
+
+	client := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+	stranger := &entity.User{ID: 7, AuthID: 1, IsVerified: true}
+
+	resolved := entity.ResolutionNoAction
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		mockUser      *entity.User
+		mockDispute   *entity.Dispute
+		mockErr       error
+		expectSave    bool
+		expectedRole  entity.Role
+		expectedError error
+	}{
+		{
+			name:         "participant writes message",
+			ctx:          ctxClient,
+			mockUser:     client,
+			mockDispute:  &entity.Dispute{ID: 10, ClientID: 5, ModelID: 6, Status: entity.DisputeOpen},
+			expectSave:   true,
+			expectedRole: entity.RoleClient,
+		},
+		{
+			name:         "admin writes message",
+			ctx:          ctxAdmin,
+			mockDispute:  &entity.Dispute{ID: 10, ClientID: 5, ModelID: 6, Status: entity.DisputeInReview},
+			expectSave:   true,
+			expectedRole: entity.RoleAdmin,
+		},
+		{
+			name:          "not a participant",
+			ctx:           ctxClient,
+			mockUser:      stranger,
+			mockDispute:   &entity.Dispute{ID: 10, ClientID: 5, ModelID: 6, Status: entity.DisputeOpen},
+			expectedError: service_errors.ErrNotDisputeParticipant,
+		},
+		{
+			name:     "dispute is resolved",
+			ctx:      ctxClient,
+			mockUser: client,
+			mockDispute: &entity.Dispute{
+				ID: 10, ClientID: 5, ModelID: 6, Status: entity.DisputeResolved, Resolution: &resolved,
+			},
+			expectedError: service_errors.ErrDisputeIsResolved,
+		},
+		{
+			name:          "dispute not found",
+			ctx:           ctxAdmin,
+			mockErr:       persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrDisputeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpDisputeServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.mockUser != nil {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), tt.mockUser.AuthID).
+					Return(tt.mockUser, nil).
+					Times(1)
+			}
+
+			test.disputeRepo.EXPECT().
+				GetByID(gomock.Any(), int64(10)).
+				Return(tt.mockDispute, tt.mockErr).
+				Times(1)
+
+			if tt.expectSave {
+				test.disputeRepo.EXPECT().
+					SaveMessage(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			}
+
+			result, err := test.service.AddDisputeMessage(tt.ctx, 10, "please check the photos")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedRole, result.AuthorRole)
+				assert.Equal(t, "please check the photos", result.Body)
+			}
+		})
+	}
+}
+
+func TestDisputeService_AssignDispute(t *testing.T) {
+	ctxAdmin := context.WithValue(context.Background(), service_const.AuthIDKey, int64(3))
+	ctxAdmin = context.WithValue(ctxAdmin, service_const.RoleKey, "ADMIN")
+
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	anotherAdminID := int64(8)
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		adminID         *int64
+		mockAdmin       *entity.Admin
+		mockAdminErr    error
+		mockDispute     *entity.Dispute
+		expectUpdate    bool
+		expectedAdminID int64
+		expectedError   error
+	}{
+		{
+			name:            "admin takes dispute",
+			ctx:             ctxAdmin,
+			mockAdmin:       &entity.Admin{ID: 4, AuthID: 3},
+			mockDispute:     &entity.Dispute{ID: 10, Status: entity.DisputeOpen},
+			expectUpdate:    true,
+			expectedAdminID: 4,
+		},
+		{
+			name:            "admin assigns dispute to another admin",
+			ctx:             ctxAdmin,
+			adminID:         &anotherAdminID,
+			mockAdmin:       &entity.Admin{ID: 8, AuthID: 9},
+			mockDispute:     &entity.Dispute{ID: 10, Status: entity.DisputeOpen},
+			expectUpdate:    true,
+			expectedAdminID: 8,
+		},
+		{
+			name:          "assignee does not exist",
+			ctx:           ctxAdmin,
+			adminID:       &anotherAdminID,
+			mockAdminErr:  persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrAdminNotFound,
+		},
+		{
+			name:          "not admin",
+			ctx:           ctxClient,
+			expectedError: service_errors.ErrNotAdmin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpDisputeServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.mockAdmin != nil || tt.mockAdminErr != nil {
+				if tt.adminID == nil {
+					test.adminRepo.EXPECT().
+						GetByAuthID(gomock.Any(), int64(3)).
+						Return(tt.mockAdmin, tt.mockAdminErr).
+						Times(1)
+				} else {
+					test.adminRepo.EXPECT().
+						GetByID(gomock.Any(), *tt.adminID).
+						Return(tt.mockAdmin, tt.mockAdminErr).
+						Times(1)
+				}
+			}
+
+			if tt.mockDispute != nil {
+				test.disputeRepo.EXPECT().
+					GetByID(gomock.Any(), tt.mockDispute.ID).
+					Return(tt.mockDispute, nil).
+					Times(1)
+			}
+
+			if tt.expectUpdate {
+				test.disputeRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, d *entity.Dispute) (*entity.Dispute, error) {
+						return d, nil
+					}).
+					Times(1)
+			}
+
+			result, err := test.service.AssignDispute(tt.ctx, 10, tt.adminID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, entity.DisputeInReview, result.Status)
+				assert.Equal(t, tt.expectedAdminID, *result.AssignedAdminID)
+			}
+		})
+	}
+}
+
+func TestDisputeService_ResolveDispute(t *testing.T) {
+	ctxAdmin := context.WithValue(context.Background(), service_const.AuthIDKey, int64(3))
+	ctxAdmin = context.WithValue(ctxAdmin, service_const.RoleKey, "ADMIN")
+
+	admin := &entity.Admin{ID: 4, AuthID: 3}
+	anotherAdminID := int64(8)
+
+	booking := &entity.Booking{ID: 2, ClientID: 5, ModelServiceID: 3, Price: rub(100)}
+	modelService := &entity.ModelService{ID: 3, ModelID: 6, Price: rub(100)}
+
+	partial := rub(40)
+	tooMuch := rub(100)
+
+	tests := []struct {
+		name                string
+		resolution          entity.DisputeResolution
+		refundAmount        *entity.Money
+		mockDispute         *entity.Dispute
+		expectOrder         bool
+		expectUpdate        bool
+		expectedRefund      *entity.Money
+		expectedOrderStatus entity.OrderStatus
+		expectedPenalized   *int64
+		expectRelease       bool
+		expectCapture       bool
+		mockPaymentErr      error
+		expectedError       error
+	}{
+		{
+			name:                "full refund cancels order",
+			resolution:          entity.ResolutionFullRefund,
+			mockDispute:         &entity.Dispute{ID: 10, OrderID: 1, ClientID: 5, ModelID: 6, Status: entity.DisputeInReview, AssignedAdminID: &admin.ID},
+			expectOrder:         true,
+			expectUpdate:        true,
+			expectedRefund:      &modelService.Price,
+			expectedOrderStatus: entity.OrderCancelled,
+			expectRelease:       true,
+		},
+		{
+			name:                "partial refund completes order",
+			resolution:          entity.ResolutionPartialRefund,
+			refundAmount:        &partial,
+			mockDispute:         &entity.Dispute{ID: 10, OrderID: 1, ClientID: 5, ModelID: 6, Status: entity.DisputeOpen},
+			expectOrder:         true,
+			expectUpdate:        true,
+			expectedRefund:      &partial,
+			expectedOrderStatus: entity.OrderCompleted,
+		},
+		{
+			name:                "penalize model",
+			resolution:          entity.ResolutionPenalizeModel,
+			mockDispute:         &entity.Dispute{ID: 10, OrderID: 1, ClientID: 5, ModelID: 6, Status: entity.DisputeOpen},
+			expectOrder:         true,
+			expectUpdate:        true,
+			expectedOrderStatus: entity.OrderCompleted,
+			expectedPenalized:   func() *int64 { id := int64(6); return &id }(),
+			expectCapture:       true,
+		},
+		{
+			name:                "payment provider error",
+			resolution:          entity.ResolutionNoAction,
+			mockDispute:         &entity.Dispute{ID: 10, OrderID: 1, ClientID: 5, ModelID: 6, Status: entity.DisputeOpen},
+			expectOrder:         true,
+			expectUpdate:        true,
+			expectedOrderStatus: entity.OrderCompleted,
+			expectCapture:       true,
+			mockPaymentErr:      service_errors.ErrPaymentProviderFailed,
+			expectedError:       service_errors.ErrPaymentProviderFailed,
+		},
+		{
+			name:          "partial refund is not less than price",
+			resolution:    entity.ResolutionPartialRefund,
+			refundAmount:  &tooMuch,
+			mockDispute:   &entity.Dispute{ID: 10, OrderID: 1, ClientID: 5, ModelID: 6, Status: entity.DisputeOpen},
+			expectOrder:   true,
+			expectedError: service_errors.ErrInvalidRefundAmount,
+		},
+		{
+			name:          "partial refund without amount",
+			resolution:    entity.ResolutionPartialRefund,
+			mockDispute:   &entity.Dispute{ID: 10, OrderID: 1, ClientID: 5, ModelID: 6, Status: entity.DisputeOpen},
+			expectOrder:   true,
+			expectedError: service_errors.ErrInvalidRefundAmount,
+		},
+		{
+			name:          "dispute is assigned to another admin",
+			resolution:    entity.ResolutionNoAction,
+			mockDispute:   &entity.Dispute{ID: 10, OrderID: 1, Status: entity.DisputeInReview, AssignedAdminID: &anotherAdminID},
+			expectedError: service_errors.ErrNotDisputeAssignee,
+		},
+		{
+			name:          "dispute is already resolved",
+			resolution:    entity.ResolutionNoAction,
+			mockDispute:   &entity.Dispute{ID: 10, OrderID: 1, Status: entity.DisputeResolved},
+			expectedError: service_errors.ErrDisputeIsResolved,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpDisputeServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.adminRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(3)).
+				Return(admin, nil).
+				Times(1)
+
+			test.disputeRepo.EXPECT().
+				GetByID(gomock.Any(), tt.mockDispute.ID).
+				Return(tt.mockDispute, nil).
+				Times(1)
+
+			if tt.expectOrder {
+				test.orderRepo.EXPECT().
+					GetByID(gomock.Any(), tt.mockDispute.OrderID).
+					Return(&entity.Order{ID: 1, BookingID: 2, Status: entity.OrderDisputed}, nil).
+					Times(1)
+
+				test.bookingRepo.EXPECT().
+					GetByID(gomock.Any(), int64(2)).
+					Return(booking, nil).
+					Times(1)
+
+				test.modelServiceRepo.EXPECT().
+					GetByID(gomock.Any(), booking.ModelServiceID, false).
+					Return(modelService, nil).
+					Times(1)
+			}
+
+			if tt.expectUpdate {
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.disputeRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, d *entity.Dispute) (*entity.Dispute, error) {
+						return d, nil
+					}).
+					Times(1)
+
+				test.orderRepo.EXPECT().
+					UpdateStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, o *entity.Order) (*entity.Order, error) {
+						assert.Equal(t, tt.expectedOrderStatus, o.Status)
+						return o, nil
+					}).
+					Times(1)
+
+				switch {
+				case tt.expectRelease:
+					test.payments.EXPECT().
+						Release(gomock.Any(), tt.mockDispute.OrderID).
+						Return(&entity.Payment{Status: entity.PaymentVoided}, tt.mockPaymentErr).
+						Times(1)
+				case tt.expectCapture:
+					test.payments.EXPECT().
+						Capture(gomock.Any(), tt.mockDispute.OrderID).
+						Return(&entity.Payment{Status: entity.PaymentCaptured}, tt.mockPaymentErr).
+						Times(1)
+				default:
+					test.payments.EXPECT().
+						Refund(gomock.Any(), tt.mockDispute.OrderID, *tt.expectedRefund).
+						Return(&entity.Payment{Status: entity.PaymentPartiallyRefunded}, tt.mockPaymentErr).
+						Times(1)
+				}
+
+				if tt.mockPaymentErr == nil && tt.expectedOrderStatus == entity.OrderCompleted {
+					test.receipts.EXPECT().
+						IssueReceipt(gomock.Any(), gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, o *entity.Order, _ *entity.Payment) (*entity.Receipt, error) {
+							assert.Equal(t, entity.OrderCompleted, o.Status)
+							return &entity.Receipt{OrderID: o.ID}, nil
+						}).
+						Times(1)
+				}
+
+				if tt.mockPaymentErr == nil && tt.resolution == entity.ResolutionPenalizeModel {
+					test.ledger.EXPECT().
+						PostPenalty(gomock.Any(), tt.mockDispute.OrderID).
+						Return(nil).
+						Times(1)
+				}
+
+				if tt.mockPaymentErr == nil {
+					test.eventBroker.EXPECT().
+						Publish(gomock.Any(), gomock.Any()).
+						Times(1)
+				}
+			}
+
+			comment := "checked the thread"
+			result, err := test.service.ResolveDispute(ctxAdmin, 10, tt.resolution, tt.refundAmount, &comment)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, entity.DisputeResolved, result.Status)
+				assert.Equal(t, tt.resolution, *result.Resolution)
+				assert.Equal(t, admin.ID, *result.AssignedAdminID)
+				assert.Equal(t, tt.expectedRefund, result.RefundAmount)
+				assert.Equal(t, tt.expectedPenalized, result.PenalizedUserID)
+				assert.NotNil(t, result.ResolvedAt)
+			}
+		})
+	}
+}
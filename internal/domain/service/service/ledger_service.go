@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type DefaultLedgerService struct {
+	ledgerRepo       interfaces.LedgerRepository
+	orderRepo        interfaces.OrderRepository
+	bookingRepo      interfaces.BookingRepository
+	modelServiceRepo interfaces.ModelServiceRepository
+	userRepo         interfaces.UserRepository
+	txManager        database.TxManager
+	logger           pkg.Logger
+	commissionRate   float64
+}
+
+func NewDefaultLedgerService(ledgerRepo interfaces.LedgerRepository, orderRepo interfaces.OrderRepository,
+	bookingRepo interfaces.BookingRepository, modelServiceRepo interfaces.ModelServiceRepository,
+	userRepo interfaces.UserRepository, txManager database.TxManager,
+	logger pkg.Logger) (*DefaultLedgerService, error) {
+
+	rate := os.Getenv(service_const.DotEnvPlatformCommissionRate)
+	if rate == "" {
+		return nil, service_errors.ErrLoadingCommissionRate
+	}
+
+	commissionRate, err := strconv.ParseFloat(rate, 64)
+	if err != nil {
+		return nil, service_errors.ErrParsingCommissionRate
+	}
+	if commissionRate < 0 || commissionRate >= 1 {
+		return nil, service_errors.ErrInvalidCommissionRate
+	}
+
+	return &DefaultLedgerService{
+		ledgerRepo:       ledgerRepo,
+		orderRepo:        orderRepo,
+		bookingRepo:      bookingRepo,
+		modelServiceRepo: modelServiceRepo,
+		userRepo:         userRepo,
+		txManager:        txManager,
+		logger:           logger,
+		commissionRate:   commissionRate,
+	}, nil
+}
+
+// PostOrderPayment records the captured money: the client account is debited,
+// the model gets the amount without the platform commission.
+func (d *DefaultLedgerService) PostOrderPayment(ctx context.Context, orderID int64, amount entity.Money) error {
+	booking, modelService, err := d.getOrderParties(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	commission, share, err := entity.SplitCommission(amount, d.commissionRate)
+	if err != nil {
+		d.logger.Error(ctx, "failed to split commission",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return err
+	}
+
+	return d.post(ctx, entity.NewLedgerTransaction(orderID, entity.LedgerOrderPayment), booking, modelService,
+		amount.Neg(), share, commission)
+}
+
+// PostRefund reverses the refunded part of the payment in the same proportion it was split.
+func (d *DefaultLedgerService) PostRefund(ctx context.Context, orderID int64, amount entity.Money) error {
+	booking, modelService, err := d.getOrderParties(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	commission, share, err := entity.SplitCommission(amount, d.commissionRate)
+	if err != nil {
+		d.logger.Error(ctx, "failed to split commission",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return err
+	}
+
+	return d.post(ctx, entity.NewLedgerTransaction(orderID, entity.LedgerRefund), booking, modelService,
+		amount, share.Neg(), commission.Neg())
+}
+
+// PostPenalty moves the model share of the booked price to the platform revenue.
+func (d *DefaultLedgerService) PostPenalty(ctx context.Context, orderID int64) error {
+	booking, modelService, err := d.getOrderParties(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	_, share, err := entity.SplitCommission(booking.Price, d.commissionRate)
+	if err != nil {
+		d.logger.Error(ctx, "failed to split commission",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return err
+	}
+
+	return d.post(ctx, entity.NewLedgerTransaction(orderID, entity.LedgerPenalty), booking, modelService,
+		entity.Money{}, share.Neg(), share)
+}
+
+func (d *DefaultLedgerService) GetModelEarnings(ctx context.Context,
+	page, limit *int64) (*entity.LedgerStatement, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := d.ledgerRepo.GetAccount(ctx, entity.LedgerModelEarnings, &model.ID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			return &entity.LedgerStatement{}, nil
+		}
+
+		d.logger.Error(ctx, "failed to get model earnings account",
+			option.Any("model_id", model.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	balance, err := d.ledgerRepo.GetBalance(ctx, account.ID)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get account balance",
+			option.Any("account_id", account.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	entries, err := d.ledgerRepo.GetEntries(ctx, account.ID, entity.NewOptions(common.CheckPagination(page, limit)))
+	if err != nil {
+		d.logger.Error(ctx, "failed to get account entries",
+			option.Any("account_id", account.ID),
+			option.Any("page", page),
+			option.Any("limit", limit),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return &entity.LedgerStatement{
+		Balance: balance,
+		Entries: entries,
+	}, nil
+}
+
+func (d *DefaultLedgerService) GetTrialBalance(ctx context.Context) (*entity.TrialBalance, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleAdmin.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAdmin))
+
+		return nil, service_errors.ErrNotAdmin
+	}
+
+	lines, err := d.ledgerRepo.GetTrialBalance(ctx)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get trial balance",
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := entity.NewTrialBalance(lines)
+	if err != nil {
+		d.logger.Error(ctx, "failed to sum trial balance",
+			option.Error(err))
+
+		return nil, err
+	}
+	if !res.Balanced {
+		d.logger.Error(ctx, "ledger is not balanced",
+			option.Any("total_debit", res.TotalDebit),
+			option.Any("total_credit", res.TotalCredit))
+	}
+
+	return res, nil
+}
+
+// post writes one balanced transaction with signed amounts for the client, the model and the platform.
+func (d *DefaultLedgerService) post(ctx context.Context, transaction *entity.LedgerTransaction,
+	booking *entity.Booking, modelService *entity.ModelService,
+	clientAmount, modelAmount, platformAmount entity.Money) error {
+
+	return d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		postings := []struct {
+			accountType entity.LedgerAccountType
+			ownerID     *int64
+			amount      entity.Money
+		}{
+			{entity.LedgerClientPayable, &booking.ClientID, clientAmount},
+			{entity.LedgerModelEarnings, &modelService.ModelID, modelAmount},
+			{entity.LedgerPlatformRevenue, nil, platformAmount},
+		}
+
+		for _, p := range postings {
+			if p.amount.IsZero() {
+				continue
+			}
+
+			account, err := d.ledgerRepo.GetOrCreateAccount(ctx, p.accountType, p.ownerID)
+			if err != nil {
+				d.logger.Error(ctx, "failed to get ledger account",
+					option.Any("type", p.accountType),
+					option.Any("owner_id", p.ownerID),
+					option.Error(err))
+
+				return err
+			}
+
+			transaction.AddEntry(account.ID, p.amount)
+		}
+
+		if len(transaction.Entries) == 0 {
+			return nil
+		}
+
+		balanced, err := transaction.IsBalanced()
+		if err != nil {
+			d.logger.Error(ctx, "failed to check ledger transaction",
+				option.Any("order_id", transaction.OrderID),
+				option.Any("type", transaction.Type),
+				option.Error(err))
+
+			return err
+		}
+
+		if !balanced {
+			d.logger.Error(ctx, "ledger transaction is not balanced",
+				option.Any("order_id", transaction.OrderID),
+				option.Any("type", transaction.Type),
+				option.Error(service_errors.ErrUnbalancedLedgerPosting))
+
+			return service_errors.ErrUnbalancedLedgerPosting
+		}
+
+		if err = d.ledgerRepo.SaveTransaction(ctx, transaction); err != nil {
+			d.logger.Error(ctx, "failed to save ledger transaction",
+				option.Any("order_id", transaction.OrderID),
+				option.Any("type", transaction.Type),
+				option.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (d *DefaultLedgerService) getOrderParties(ctx context.Context,
+	orderID int64) (*entity.Booking, *entity.ModelService, error) {
+
+	order, err := d.orderRepo.GetByID(ctx, orderID)
+	if errors.Is(err, persistence.ErrNoRowsFound) {
+		order, err = d.orderRepo.GetArchivedByID(ctx, orderID)
+	}
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "order is not found by id",
+				option.Any("order_id", orderID),
+				option.Error(service_errors.ErrOrderNotFound))
+
+			return nil, nil, service_errors.ErrOrderNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get order by id",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return nil, nil, err
+	}
+
+	booking, err := d.bookingRepo.GetByID(ctx, order.BookingID)
+	if errors.Is(err, persistence.ErrNoRowsFound) {
+		booking, err = d.bookingRepo.GetArchivedByID(ctx, order.BookingID)
+	}
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "booking is not found by id",
+				option.Any("booking_id", order.BookingID),
+				option.Error(service_errors.ErrBookingNotFound))
+
+			return nil, nil, service_errors.ErrBookingNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get booking by id",
+			option.Any("booking_id", order.BookingID),
+			option.Error(err))
+
+		return nil, nil, err
+	}
+
+	modelService, err := d.modelServiceRepo.GetByID(ctx, booking.ModelServiceID, false)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model service is not found by id",
+				option.Any("model_service_id", booking.ModelServiceID),
+				option.Error(service_errors.ErrServiceIsNotFound))
+
+			return nil, nil, service_errors.ErrServiceIsNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get model service by id",
+			option.Any("model_service_id", booking.ModelServiceID),
+			option.Error(err))
+
+		return nil, nil, err
+	}
+
+	return booking, modelService, nil
+}
+
+func (d *DefaultLedgerService) checkModelRestrictions(ctx context.Context,
+	authID *int64) (*entity.User, error) {
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleModel.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAModel))
+
+		return nil, service_errors.ErrNotAModel
+	}
+
+	model, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotAModel))
+
+			return nil, service_errors.ErrNotAModel
+		}
+
+		d.logger.Error(ctx, "check model restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return model, nil
+}
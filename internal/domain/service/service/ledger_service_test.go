@@ -0,0 +1,417 @@
+package service
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type ledgerServiceTest struct {
+	ctrl             *gomock.Controller
+	ledgerRepo       *mocks.MockLedgerRepository
+	orderRepo        *mocks.MockOrderRepository
+	bookingRepo      *mocks.MockBookingRepository
+	modelServiceRepo *mocks.MockModelServiceRepository
+	userRepo         *mocks.MockUserRepository
+	txManager        *mocks.MockTxManager
+	service          *DefaultLedgerService
+}
+
+func setUpLedgerServiceTest(t *testing.T, rate string) *ledgerServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	ledgerRepo := mocks.NewMockLedgerRepository(ctrl)
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	bookingRepo := mocks.NewMockBookingRepository(ctrl)
+	modelServiceRepo := mocks.NewMockModelServiceRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	mockTxManager := mocks.NewMockTxManager(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(service_const.DotEnvPlatformCommissionRate, rate)
+
+	ledgerService, err := NewDefaultLedgerService(
+		ledgerRepo, orderRepo, bookingRepo, modelServiceRepo, userRepo, mockTxManager, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &ledgerServiceTest{
+		ctrl:             ctrl,
+		ledgerRepo:       ledgerRepo,
+		orderRepo:        orderRepo,
+		bookingRepo:      bookingRepo,
+		modelServiceRepo: modelServiceRepo,
+		userRepo:         userRepo,
+		txManager:        mockTxManager,
+		service:          ledgerService,
+	}
+}
+
+// expectPosting mocks the order lookup and the accounts, client account id is 1,
+// model account id is 2 and platform account id is 3.
+func (test *ledgerServiceTest) expectPosting(accountTypes ...entity.LedgerAccountType) {
+	test.orderRepo.EXPECT().
+		GetByID(gomock.Any(), int64(7)).
+		Return(&entity.Order{ID: 7, BookingID: 2}, nil).
+		Times(1)
+
+	test.bookingRepo.EXPECT().
+		GetByID(gomock.Any(), int64(2)).
+		Return(&entity.Booking{ID: 2, ClientID: 5, ModelServiceID: 3, Price: rub(100)}, nil).
+		Times(1)
+
+	test.modelServiceRepo.EXPECT().
+		GetByID(gomock.Any(), int64(3), false).
+		Return(&entity.ModelService{ID: 3, ModelID: 6, Price: rub(100)}, nil).
+		Times(1)
+
+	test.txManager.EXPECT().
+		WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Times(1)
+
+	ids := map[entity.LedgerAccountType]int64{
+		entity.LedgerClientPayable:   1,
+		entity.LedgerModelEarnings:   2,
+		entity.LedgerPlatformRevenue: 3,
+	}
+	for _, accountType := range accountTypes {
+		test.ledgerRepo.EXPECT().
+			GetOrCreateAccount(gomock.Any(), accountType, gomock.Any()).
+			Return(&entity.LedgerAccount{ID: ids[accountType], Type: accountType}, nil).
+			Times(1)
+	}
+}
+
+func entryAmounts(transaction *entity.LedgerTransaction) map[int64]entity.Money {
+	res := make(map[int64]entity.Money, len(transaction.Entries))
+	for _, e := range transaction.Entries {
+		res[e.AccountID] = e.Amount
+	}
+
+	return res
+}
+
+func TestNewDefaultLedgerService_CommissionRate(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expectedError error
+	}{
+		{
+			name:          "missing rate",
+			value:         "",
+			expectedError: service_errors.ErrLoadingCommissionRate,
+		},
+		{
+			name:          "not a number",
+			value:         "fifteen percent",
+			expectedError: service_errors.ErrParsingCommissionRate,
+		},
+		{
+			name:          "negative rate",
+			value:         "-0.1",
+			expectedError: service_errors.ErrInvalidCommissionRate,
+		},
+		{
+			name:          "whole amount as commission",
+			value:         "1",
+			expectedError: service_errors.ErrInvalidCommissionRate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(service_const.DotEnvPlatformCommissionRate, tt.value)
+
+			_, err := NewDefaultLedgerService(nil, nil, nil, nil, nil, nil, nil)
+			assert.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestLedgerService_Post(t *testing.T) {
+	tests := []struct {
+		name            string
+		rate            string
+		accounts        []entity.LedgerAccountType
+		post            func(s *DefaultLedgerService) error
+		expectedType    entity.LedgerTransactionType
+		expectedAmounts map[int64]entity.Money
+	}{
+		{
+			name: "order payment is split by commission",
+			rate: "0.15",
+			accounts: []entity.LedgerAccountType{
+				entity.LedgerClientPayable, entity.LedgerModelEarnings, entity.LedgerPlatformRevenue,
+			},
+			post: func(s *DefaultLedgerService) error {
+				return s.PostOrderPayment(context.Background(), 7, rub(100))
+			},
+			expectedType:    entity.LedgerOrderPayment,
+			expectedAmounts: map[int64]entity.Money{1: rub(-100), 2: rub(85), 3: rub(15)},
+		},
+		{
+			name: "commission is rounded to cents and the model gets the rest",
+			rate: "0.15",
+			accounts: []entity.LedgerAccountType{
+				entity.LedgerClientPayable, entity.LedgerModelEarnings, entity.LedgerPlatformRevenue,
+			},
+			post: func(s *DefaultLedgerService) error {
+				return s.PostOrderPayment(context.Background(), 7, rub(33.33))
+			},
+			expectedType:    entity.LedgerOrderPayment,
+			expectedAmounts: map[int64]entity.Money{1: rub(-33.33), 2: rub(28.33), 3: rub(5)},
+		},
+		{
+			name: "refund reverses the split",
+			rate: "0.15",
+			accounts: []entity.LedgerAccountType{
+				entity.LedgerClientPayable, entity.LedgerModelEarnings, entity.LedgerPlatformRevenue,
+			},
+			post: func(s *DefaultLedgerService) error {
+				return s.PostRefund(context.Background(), 7, rub(40))
+			},
+			expectedType:    entity.LedgerRefund,
+			expectedAmounts: map[int64]entity.Money{1: rub(40), 2: rub(-34), 3: rub(-6)},
+		},
+		{
+			name: "penalty moves the model share to the platform",
+			rate: "0.15",
+			accounts: []entity.LedgerAccountType{
+				entity.LedgerModelEarnings, entity.LedgerPlatformRevenue,
+			},
+			post: func(s *DefaultLedgerService) error {
+				return s.PostPenalty(context.Background(), 7)
+			},
+			expectedType:    entity.LedgerPenalty,
+			expectedAmounts: map[int64]entity.Money{2: rub(-85), 3: rub(85)},
+		},
+		{
+			name: "zero commission skips the platform account",
+			rate: "0",
+			accounts: []entity.LedgerAccountType{
+				entity.LedgerClientPayable, entity.LedgerModelEarnings,
+			},
+			post: func(s *DefaultLedgerService) error {
+				return s.PostOrderPayment(context.Background(), 7, rub(100))
+			},
+			expectedType:    entity.LedgerOrderPayment,
+			expectedAmounts: map[int64]entity.Money{1: rub(-100), 2: rub(100)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpLedgerServiceTest(t, tt.rate)
+			defer test.ctrl.Finish()
+
+			test.expectPosting(tt.accounts...)
+
+			test.ledgerRepo.EXPECT().
+				SaveTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, transaction *entity.LedgerTransaction) error {
+					assert.Equal(t, int64(7), transaction.OrderID)
+					assert.Equal(t, tt.expectedType, transaction.Type)
+					balanced, err := transaction.IsBalanced()
+					assert.NoError(t, err)
+					assert.True(t, balanced)
+					assert.Equal(t, tt.expectedAmounts, entryAmounts(transaction))
+
+					return nil
+				}).
+				Times(1)
+
+			assert.NoError(t, tt.post(test.service))
+		})
+	}
+}
+
+func TestLedgerService_PostOrderPayment_OrderNotFound(t *testing.T) {
+	test := setUpLedgerServiceTest(t, "0.15")
+	defer test.ctrl.Finish()
+
+	test.orderRepo.EXPECT().
+		GetByID(gomock.Any(), int64(7)).
+		Return(nil, persistence.ErrNoRowsFound).
+		Times(1)
+
+	test.orderRepo.EXPECT().
+		GetArchivedByID(gomock.Any(), int64(7)).
+		Return(nil, persistence.ErrNoRowsFound).
+		Times(1)
+
+	err := test.service.PostOrderPayment(context.Background(), 7, rub(100))
+	assert.ErrorIs(t, err, service_errors.ErrOrderNotFound)
+}
+
+func TestLedgerService_GetModelEarnings(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(2))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	model := &entity.User{ID: 6, AuthID: 1, IsVerified: true}
+	entries := []*entity.LedgerEntry{
+		{ID: 2, AccountID: 2, Amount: rub(-34), OrderID: 7, TransactionType: entity.LedgerRefund},
+		{ID: 1, AccountID: 2, Amount: rub(85), OrderID: 7, TransactionType: entity.LedgerOrderPayment},
+	}
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		mockAccount   *entity.LedgerAccount
+		mockAccErr    error
+		expected      *entity.LedgerStatement
+		expectedError error
+	}{
+		{
+			name:        "balance with entries",
+			ctx:         ctxModel,
+			mockAccount: &entity.LedgerAccount{ID: 2, Type: entity.LedgerModelEarnings, OwnerID: &model.ID},
+			expected:    &entity.LedgerStatement{Balance: rub(51), Entries: entries},
+		},
+		{
+			name:       "model without earnings yet",
+			ctx:        ctxModel,
+			mockAccErr: persistence.ErrNoRowsFound,
+			expected:   &entity.LedgerStatement{},
+		},
+		{
+			name:          "not a model",
+			ctx:           ctxClient,
+			expectedError: service_errors.ErrNotAModel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpLedgerServiceTest(t, "0.15")
+			defer test.ctrl.Finish()
+
+			if tt.ctx == ctxModel {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), int64(1)).
+					Return(model, nil).
+					Times(1)
+
+				test.ledgerRepo.EXPECT().
+					GetAccount(gomock.Any(), entity.LedgerModelEarnings, &model.ID).
+					Return(tt.mockAccount, tt.mockAccErr).
+					Times(1)
+			}
+
+			if tt.mockAccount != nil {
+				test.ledgerRepo.EXPECT().
+					GetBalance(gomock.Any(), tt.mockAccount.ID).
+					Return(rub(51), nil).
+					Times(1)
+
+				test.ledgerRepo.EXPECT().
+					GetEntries(gomock.Any(), tt.mockAccount.ID, gomock.Any()).
+					Return(entries, nil).
+					Times(1)
+			}
+
+			result, err := test.service.GetModelEarnings(tt.ctx, nil, nil)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestLedgerService_GetTrialBalance(t *testing.T) {
+	ctxAdmin := context.WithValue(context.Background(), service_const.AuthIDKey, int64(3))
+	ctxAdmin = context.WithValue(ctxAdmin, service_const.RoleKey, "ADMIN")
+
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		mockLines        []*entity.TrialBalanceLine
+		expectedBalanced bool
+		expectedError    error
+	}{
+		{
+			name: "ledger sums to zero",
+			ctx:  ctxAdmin,
+			mockLines: []*entity.TrialBalanceLine{
+				{AccountType: entity.LedgerClientPayable, Debit: rub(100), Credit: rub(40)},
+				{AccountType: entity.LedgerModelEarnings, Debit: rub(34), Credit: rub(85)},
+				{AccountType: entity.LedgerPlatformRevenue, Debit: rub(6), Credit: rub(15)},
+			},
+			expectedBalanced: true,
+		},
+		{
+			name: "unbalanced ledger is reported",
+			ctx:  ctxAdmin,
+			mockLines: []*entity.TrialBalanceLine{
+				{AccountType: entity.LedgerClientPayable, Debit: rub(100)},
+				{AccountType: entity.LedgerModelEarnings, Credit: rub(85)},
+			},
+			expectedBalanced: false,
+		},
+		{
+			name:          "not an admin",
+			ctx:           ctxModel,
+			expectedError: service_errors.ErrNotAdmin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpLedgerServiceTest(t, "0.15")
+			defer test.ctrl.Finish()
+
+			if tt.mockLines != nil {
+				test.ledgerRepo.EXPECT().
+					GetTrialBalance(gomock.Any()).
+					Return(tt.mockLines, nil).
+					Times(1)
+			}
+
+			result, err := test.service.GetTrialBalance(tt.ctx)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedBalanced, result.Balanced)
+				assert.Equal(t, tt.mockLines, result.Lines)
+			}
+		})
+	}
+}
@@ -16,15 +16,18 @@ import (
 
 type DefaultModelServiceService struct {
 	modelServiceRepo interfaces.ModelServiceRepository
+	addOnRepo        interfaces.AddOnRepository
 	userRepo         interfaces.UserRepository
 	txManager        database.TxManager
 	logger           pkg.Logger
 }
 
-func NewDefaultModelServiceService(modelServiceRepo interfaces.ModelServiceRepository, userRepo interfaces.UserRepository,
+func NewDefaultModelServiceService(modelServiceRepo interfaces.ModelServiceRepository,
+	addOnRepo interfaces.AddOnRepository, userRepo interfaces.UserRepository,
 	txManager database.TxManager, logger pkg.Logger) *DefaultModelServiceService {
 	return &DefaultModelServiceService{
 		modelServiceRepo: modelServiceRepo,
+		addOnRepo:        addOnRepo,
 		userRepo:         userRepo,
 		txManager:        txManager,
 		logger:           logger,
@@ -32,7 +35,7 @@ func NewDefaultModelServiceService(modelServiceRepo interfaces.ModelServiceRepos
 }
 
 func (d *DefaultModelServiceService) CreateService(ctx context.Context,
-	title string, description string, price float32) (*entity.ModelService, error) {
+	title string, description string, price entity.Money) (*entity.ModelService, error) {
 
 	authID, err := common.GetAuthIDFromContext(ctx)
 	if err != nil {
@@ -152,7 +155,7 @@ func (d *DefaultModelServiceService) GetAllServicesByModelID(ctx context.Context
 }
 
 func (d *DefaultModelServiceService) UpdateService(ctx context.Context, serviceID int64,
-	title, description *string, price *float32) (*entity.ModelService, error) {
+	title, description *string, price *entity.Money) (*entity.ModelService, error) {
 
 	authID, err := common.GetAuthIDFromContext(ctx)
 	if err != nil {
@@ -291,7 +294,7 @@ func (d *DefaultModelServiceService) UpdateService(ctx context.Context, serviceI
 			return err
 		}
 
-		return nil
+		return d.cloneAddOns(ctx, serviceID, newService.ID)
 	})
 
 	if err != nil {
@@ -301,6 +304,32 @@ func (d *DefaultModelServiceService) UpdateService(ctx context.Context, serviceI
 	return newService, nil
 }
 
+// cloneAddOns moves the active add-ons to the new version of the service,
+// the bookings of the old version keep their own copy of the chosen ones.
+func (d *DefaultModelServiceService) cloneAddOns(ctx context.Context, fromServiceID, toServiceID int64) error {
+	addOns, err := d.addOnRepo.GetByServiceID(ctx, fromServiceID, false)
+	if err != nil {
+		d.logger.Error(ctx, "get add-ons failed",
+			option.Any("service_id", fromServiceID),
+			option.Error(err))
+
+		return err
+	}
+
+	for _, addOn := range addOns {
+		if err = d.addOnRepo.Save(ctx, addOn.CloneFor(toServiceID)); err != nil {
+			d.logger.Error(ctx, "save add-on failed",
+				option.Any("service_id", toServiceID),
+				option.Any("add_on_id", addOn.ID),
+				option.Error(err))
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (d *DefaultModelServiceService) DeactivateService(ctx context.Context, serviceID int64) error {
 	authID, err := common.GetAuthIDFromContext(ctx)
 	if err != nil {
@@ -371,12 +400,16 @@ func (d *DefaultModelServiceService) DeactivateService(ctx context.Context, serv
 }
 
 func (d *DefaultModelServiceService) checkPayloadRestrictions(
-	price float32, description string) error {
+	price entity.Money, description string) error {
 
-	if price <= 0 {
+	if !price.IsPositive() || price.Amount > entity.MaxAmount {
 		return service_errors.ErrInvalidPrice
 	}
 
+	if price.Currency != entity.DefaultCurrency {
+		return service_errors.ErrUnsupportedCurrency
+	}
+
 	if len(description) > 1000 {
 		return service_errors.ErrDescriptionTooLong
 	}
@@ -20,6 +20,7 @@ import (
 type modelServiceServiceTest struct {
 	ctrl             *gomock.Controller
 	modelServiceRepo *mocks.MockModelServiceRepository
+	addOnRepo        *mocks.MockAddOnRepository
 	userRepo         *mocks.MockUserRepository
 	txManager        *mocks.MockTxManager
 	service          *DefaultModelServiceService
@@ -30,6 +31,7 @@ func setUpModelServiceServiceTest(t *testing.T) *modelServiceServiceTest {
 
 	ctrl := gomock.NewController(t)
 	modelServiceRepo := mocks.NewMockModelServiceRepository(ctrl)
+	addOnRepo := mocks.NewMockAddOnRepository(ctrl)
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	mockTxManager := mocks.NewMockTxManager(ctrl)
 
@@ -44,12 +46,13 @@ func setUpModelServiceServiceTest(t *testing.T) *modelServiceServiceTest {
 	}
 
 	modelServiceService := NewDefaultModelServiceService(
-		modelServiceRepo, userRepo, mockTxManager, log,
+		modelServiceRepo, addOnRepo, userRepo, mockTxManager, log,
 	)
 
 	return &modelServiceServiceTest{
 		ctrl:             ctrl,
 		modelServiceRepo: modelServiceRepo,
+		addOnRepo:        addOnRepo,
 		userRepo:         userRepo,
 		txManager:        mockTxManager,
 		service:          modelServiceService,
@@ -74,7 +77,7 @@ func TestModelServiceService_GetServiceByID(t *testing.T) {
 		ModelID:     2,
 		Title:       "Test Service",
 		Description: "Test Description",
-		Price:       100.0,
+		Price:       rub(100.0),
 		IsActive:    true,
 	}
 
@@ -266,6 +269,144 @@ func TestModelServiceService_DeactivateService(t *testing.T) {
 	}
 }
 
+func TestModelServiceService_UpdateService(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{
+		ID:         1,
+		AuthID:     int64(1),
+		IsVerified: true,
+	}
+
+	newPrice := rub(150)
+	addOns := []*entity.AddOn{
+		{ID: 7, ModelServiceID: 1, Title: "Extra hour", Price: rub(50), ExtraMinutes: 60, IsActive: true},
+	}
+
+	tests := []struct {
+		name            string
+		hasBookings     bool
+		mockAddOnsErr   error
+		mockSaveAddOn   error
+		expectedClone   bool
+		expectedAddOnTo int64
+		expectedError   error
+	}{
+		{
+			name: "service without bookings is updated in place",
+		},
+		{
+			name:            "service with bookings is cloned with its add-ons",
+			hasBookings:     true,
+			expectedClone:   true,
+			expectedAddOnTo: 2,
+		},
+		{
+			name:          "failed to get add-ons of the cloned service",
+			hasBookings:   true,
+			mockAddOnsErr: errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+		{
+			name:          "failed to clone add-on",
+			hasBookings:   true,
+			mockSaveAddOn: errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpModelServiceServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.modelServiceRepo.EXPECT().
+				GetByID(gomock.Any(), int64(1), true).
+				Return(&entity.ModelService{ID: 1, ModelID: 1, Title: "Photo", Price: rub(100), IsActive: true}, nil).
+				Times(1)
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(1)).
+				Return(verifiedModel, nil).
+				Times(1)
+
+			test.modelServiceRepo.EXPECT().
+				HasBookings(gomock.Any(), int64(1)).
+				Return(tt.hasBookings, nil).
+				Times(1)
+
+			if !tt.hasBookings {
+				test.modelServiceRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s *entity.ModelService) (*entity.ModelService, error) {
+						return s, nil
+					}).
+					Times(1)
+			} else {
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.modelServiceRepo.EXPECT().
+					Deactivate(gomock.Any(), int64(1)).
+					Return(nil).
+					Times(1)
+
+				test.modelServiceRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s *entity.ModelService) error {
+						s.ID = 2
+						return nil
+					}).
+					Times(1)
+
+				test.addOnRepo.EXPECT().
+					GetByServiceID(gomock.Any(), int64(1), false).
+					Return(addOns, tt.mockAddOnsErr).
+					Times(1)
+
+				if tt.mockAddOnsErr == nil {
+					test.addOnRepo.EXPECT().
+						Save(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, a *entity.AddOn) error {
+							if tt.mockSaveAddOn != nil {
+								return tt.mockSaveAddOn
+							}
+
+							assert.Equal(t, tt.expectedAddOnTo, a.ModelServiceID)
+							assert.Equal(t, addOns[0].Title, a.Title)
+							assert.Equal(t, addOns[0].Price, a.Price)
+							assert.Equal(t, addOns[0].ExtraMinutes, a.ExtraMinutes)
+							assert.True(t, a.IsActive)
+
+							return nil
+						}).
+						Times(1)
+				}
+			}
+
+			res, err := test.service.UpdateService(ctxModel, 1, nil, nil, &newPrice)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, newPrice, res.Price)
+				if tt.expectedClone {
+					assert.Equal(t, int64(2), res.ID)
+				} else {
+					assert.Equal(t, int64(1), res.ID)
+				}
+			}
+		})
+	}
+}
+
 func TestModelServiceService_GetAllServices(t *testing.T) {
 	test := setUpModelServiceServiceTest(t)
 	defer test.ctrl.Finish()
@@ -288,7 +429,7 @@ func TestModelServiceService_GetAllServices(t *testing.T) {
 			ModelID:     2,
 			Title:       "Service 1",
 			Description: "Description 1",
-			Price:       100.0,
+			Price:       rub(100.0),
 			IsActive:    true,
 		},
 		{
@@ -296,7 +437,7 @@ func TestModelServiceService_GetAllServices(t *testing.T) {
 			ModelID:     3,
 			Title:       "Service 2",
 			Description: "Description 2",
-			Price:       200.0,
+			Price:       rub(200.0),
 			IsActive:    true,
 		},
 		{
@@ -304,7 +445,7 @@ func TestModelServiceService_GetAllServices(t *testing.T) {
 			ModelID:     2,
 			Title:       "Service 3",
 			Description: "Description 3",
-			Price:       150.0,
+			Price:       rub(150.0),
 			IsActive:    false,
 		},
 	}
@@ -427,7 +568,7 @@ func TestModelServiceService_GetAllServicesByModelID(t *testing.T) {
 			ModelID:     1,
 			Title:       "Service 1",
 			Description: "Description 1",
-			Price:       100.0,
+			Price:       rub(100.0),
 			IsActive:    true,
 		},
 		{
@@ -435,7 +576,7 @@ func TestModelServiceService_GetAllServicesByModelID(t *testing.T) {
 			ModelID:     1,
 			Title:       "Service 2",
 			Description: "Description 2",
-			Price:       200.0,
+			Price:       rub(200.0),
 			IsActive:    false,
 		},
 		{
@@ -443,7 +584,7 @@ func TestModelServiceService_GetAllServicesByModelID(t *testing.T) {
 			ModelID:     1,
 			Title:       "Service 3",
 			Description: "Description 3",
-			Price:       150.0,
+			Price:       rub(150.0),
 			IsActive:    true,
 		},
 	}
@@ -798,67 +939,91 @@ func TestModelServiceService_CheckPayloadRestrictions(t *testing.T) {
 
 	tests := []struct {
 		name          string
-		price         float32
+		price         entity.Money
 		description   string
 		expectedError error
 	}{
 		{
 			name:          "valid payload",
-			price:         100.0,
+			price:         rub(100.0),
 			description:   "Valid description",
 			expectedError: nil,
 		},
 		{
 			name:          "zero price",
-			price:         0,
+			price:         rub(0),
 			description:   "Valid description",
 			expectedError: service_errors.ErrInvalidPrice,
 		},
 		{
 			name:          "negative price",
-			price:         -50.0,
+			price:         rub(-50.0),
 			description:   "Valid description",
 			expectedError: service_errors.ErrInvalidPrice,
 		},
 		{
 			name:          "price equals zero",
-			price:         0.0,
+			price:         rub(0.0),
 			description:   "Valid description",
 			expectedError: service_errors.ErrInvalidPrice,
 		},
 		{
 			name:          "description too long",
-			price:         100.0,
+			price:         rub(100.0),
 			description:   string(make([]byte, 1001)),
 			expectedError: service_errors.ErrDescriptionTooLong,
 		},
 		{
 			name:          "description exactly 1000 characters",
-			price:         100.0,
+			price:         rub(100.0),
 			description:   string(make([]byte, 1000)),
 			expectedError: nil,
 		},
 		{
 			name:          "empty description",
-			price:         100.0,
+			price:         rub(100.0),
 			description:   "",
 			expectedError: nil,
 		},
 		{
 			name:          "very small positive price",
-			price:         0.01,
+			price:         rub(0.01),
+			description:   "Valid description",
+			expectedError: nil,
+		},
+		{
+			name:          "price below one kopeck rounds to zero",
+			price:         rub(0.004),
+			description:   "Valid description",
+			expectedError: service_errors.ErrInvalidPrice,
+		},
+		{
+			name:          "largest price the column holds",
+			price:         rub(9999999.99),
 			description:   "Valid description",
 			expectedError: nil,
 		},
+		{
+			name:          "price over the column precision",
+			price:         rub(10000000),
+			description:   "Valid description",
+			expectedError: service_errors.ErrInvalidPrice,
+		},
+		{
+			name:          "unsupported currency",
+			price:         entity.NewMoney(10000, "USD"),
+			description:   "Valid description",
+			expectedError: service_errors.ErrUnsupportedCurrency,
+		},
 		{
 			name:          "both price and description invalid",
-			price:         0,
+			price:         rub(0),
 			description:   string(make([]byte, 1001)),
 			expectedError: service_errors.ErrInvalidPrice,
 		},
 		{
 			name:          "description with special characters",
-			price:         150.0,
+			price:         rub(150.0),
 			description:   "Description with спецсимволы: 测试 テスト тест 🚀",
 			expectedError: nil,
 		},
@@ -891,7 +1056,7 @@ func TestModelServiceService_CreateService_PayloadValidationIntegration(t *testi
 		name          string
 		title         string
 		description   string
-		price         float32
+		price         entity.Money
 		mockSaveErr   error
 		expectedError error
 		expectSave    bool
@@ -901,7 +1066,7 @@ func TestModelServiceService_CreateService_PayloadValidationIntegration(t *testi
 			name:          "successful creation with valid payload",
 			title:         "Valid Service",
 			description:   "Valid description",
-			price:         100.0,
+			price:         rub(100.0),
 			expectSave:    true,
 			expectGetUser: true,
 			expectedError: nil,
@@ -910,7 +1075,7 @@ func TestModelServiceService_CreateService_PayloadValidationIntegration(t *testi
 			name:          "creation with zero price should fail before saving",
 			title:         "Service with zero price",
 			description:   "Valid description",
-			price:         0,
+			price:         rub(0),
 			expectSave:    false,
 			expectGetUser: false,
 			expectedError: service_errors.ErrInvalidPrice,
@@ -919,7 +1084,7 @@ func TestModelServiceService_CreateService_PayloadValidationIntegration(t *testi
 			name:          "creation with too long description should fail before saving",
 			title:         "Service with long description",
 			description:   string(make([]byte, 1001)),
-			price:         100.0,
+			price:         rub(100.0),
 			expectSave:    false,
 			expectedError: service_errors.ErrDescriptionTooLong,
 		},
@@ -927,7 +1092,7 @@ func TestModelServiceService_CreateService_PayloadValidationIntegration(t *testi
 			name:          "creation with negative price should fail before saving",
 			title:         "Service with negative price",
 			description:   "Valid description",
-			price:         -10.0,
+			price:         rub(-10.0),
 			expectSave:    false,
 			expectedError: service_errors.ErrInvalidPrice,
 		},
@@ -935,7 +1100,7 @@ func TestModelServiceService_CreateService_PayloadValidationIntegration(t *testi
 			name:          "creation with valid payload but repo save fails",
 			title:         "Valid Service",
 			description:   "Valid description",
-			price:         100.0,
+			price:         rub(100.0),
 			mockSaveErr:   errors.New("database error"),
 			expectSave:    true,
 			expectGetUser: true,
@@ -978,3 +1143,7 @@ func TestModelServiceService_CreateService_PayloadValidationIntegration(t *testi
 		})
 	}
 }
+
+func rub(amount float64) entity.Money {
+	return entity.MoneyFromFloat(amount)
+}
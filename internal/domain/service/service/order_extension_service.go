@@ -0,0 +1,619 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type DefaultOrderExtensionService struct {
+	extensionRepo    interfaces.OrderExtensionRepository
+	orderRepo        interfaces.OrderRepository
+	bookingRepo      interfaces.BookingRepository
+	slotRepo         interfaces.SlotRepository
+	userRepo         interfaces.UserRepository
+	modelServiceRepo interfaces.ModelServiceRepository
+	payments         interfaces.PaymentProcessor
+	buffers          interfaces.TravelBufferProvider
+	txManager        database.TxManager
+	logger           pkg.Logger
+}
+
+func NewDefaultOrderExtensionService(extensionRepo interfaces.OrderExtensionRepository,
+	orderRepo interfaces.OrderRepository, bookingRepo interfaces.BookingRepository,
+	slotRepo interfaces.SlotRepository, userRepo interfaces.UserRepository,
+	modelServiceRepo interfaces.ModelServiceRepository, payments interfaces.PaymentProcessor,
+	buffers interfaces.TravelBufferProvider, txManager database.TxManager,
+	logger pkg.Logger) *DefaultOrderExtensionService {
+	return &DefaultOrderExtensionService{
+		extensionRepo:    extensionRepo,
+		orderRepo:        orderRepo,
+		bookingRepo:      bookingRepo,
+		slotRepo:         slotRepo,
+		userRepo:         userRepo,
+		modelServiceRepo: modelServiceRepo,
+		payments:         payments,
+		buffers:          buffers,
+		txManager:        txManager,
+		logger:           logger,
+	}
+}
+
+func (d *DefaultOrderExtensionService) RequestExtension(ctx context.Context,
+	orderID int64, minutes int) (*entity.OrderExtension, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := d.checkClientRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	order, booking, _, err := d.getOrderDetails(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if booking.ClientID != client.ID {
+		d.logger.Error(ctx, "order is not owned by this client",
+			option.Any("order_id", orderID),
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrClientIsNotOwnerOfOrder))
+
+		return nil, service_errors.ErrClientIsNotOwnerOfOrder
+	}
+
+	if !order.CanBeExtended() {
+		d.logger.Error(ctx, "order cannot be extended",
+			option.Any("order_id", orderID),
+			option.Any("status", order.Status),
+			option.Error(service_errors.ErrCannotExtendOrder))
+
+		return nil, service_errors.ErrCannotExtendOrder
+	}
+
+	_, err = d.extensionRepo.GetPendingByOrderID(ctx, orderID)
+	if err == nil {
+		d.logger.Error(ctx, "order already has pending extension",
+			option.Any("order_id", orderID),
+			option.Error(service_errors.ErrOrderExtensionAlreadyRequested))
+
+		return nil, service_errors.ErrOrderExtensionAlreadyRequested
+	}
+	if !errors.Is(err, persistence.ErrNoRowsFound) {
+		d.logger.Error(ctx, "failed to get pending extension",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	extension := entity.NewOrderExtension(order.ID, minutes)
+	if err = d.extensionRepo.Save(ctx, extension); err != nil {
+		d.logger.Error(ctx, "failed to save order extension",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return extension, nil
+}
+
+func (d *DefaultOrderExtensionService) GetOrderExtensions(ctx context.Context,
+	orderID int64) ([]*entity.OrderExtension, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch entity.Role(*role) {
+	case entity.RoleClient:
+		client, err := d.checkClientRestrictions(ctx, authID)
+		if err != nil {
+			return nil, err
+		}
+
+		_, booking, _, err := d.getOrderDetails(ctx, orderID)
+		if err != nil {
+			return nil, err
+		}
+
+		if booking.ClientID != client.ID {
+			d.logger.Error(ctx, "order is not owned by this client",
+				option.Any("order_id", orderID),
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrClientIsNotOwnerOfOrder))
+
+			return nil, service_errors.ErrClientIsNotOwnerOfOrder
+		}
+	case entity.RoleModel:
+		if _, _, _, err = d.getModelOrder(ctx, authID, orderID); err != nil {
+			return nil, err
+		}
+	default:
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotClient))
+
+		return nil, service_errors.ErrNotClient
+	}
+
+	res, err := d.extensionRepo.GetAllByOrderID(ctx, orderID)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get order extensions",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// AcceptExtension moves the end of the booked slot, available slots on the way are consumed
+// and the extra minutes are billed pro-rata to the service price. The new end keeps the travel buffer
+// before the next taken slot of the model.
+func (d *DefaultOrderExtensionService) AcceptExtension(ctx context.Context,
+	orderID, extensionID int64) (*entity.OrderExtension, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, booking, _, err := d.getModelOrder(ctx, authID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	extension, err := d.getPendingExtension(ctx, orderID, extensionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.CanBeExtended() {
+		d.logger.Error(ctx, "order cannot be extended",
+			option.Any("order_id", orderID),
+			option.Any("status", order.Status),
+			option.Error(service_errors.ErrCannotExtendOrder))
+
+		return nil, service_errors.ErrCannotExtendOrder
+	}
+
+	var res *entity.OrderExtension
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		slot, err := d.slotRepo.GetByID(ctx, booking.SlotID)
+		if err != nil {
+			if errors.Is(err, persistence.ErrNoRowsFound) {
+				d.logger.Error(ctx, "slot is not found by id",
+					option.Any("slot_id", booking.SlotID),
+					option.Error(service_errors.ErrSlotIsNotFound))
+
+				return service_errors.ErrSlotIsNotFound
+			}
+
+			d.logger.Error(ctx, "failed to get slot by id",
+				option.Any("slot_id", booking.SlotID),
+				option.Error(err))
+
+			return err
+		}
+
+		buffer, err := d.buffers.BufferOf(ctx, slot.ModelID)
+		if err != nil {
+			return err
+		}
+
+		newEnd := slot.EndTime.Add(extension.Duration())
+		extended := *slot
+		extended.EndTime = newEnd
+
+		from, to := buffer.Window(slot.EndTime, newEnd)
+		nearby, err := d.slotRepo.GetOverlappingSlots(ctx, slot.ModelID, from, to)
+		if err != nil {
+			d.logger.Error(ctx, "cannot get overlaps slot for model",
+				option.Any("model_id", slot.ModelID),
+				option.Error(err))
+
+			return err
+		}
+
+		consumed := make([]*entity.Slot, 0, len(nearby))
+		for _, s := range nearby {
+			if s.ID == slot.ID {
+				continue
+			}
+
+			if !s.StartTime.Before(newEnd) || !slot.EndTime.Before(s.EndTime) {
+				if (s.Status == entity.SlotReserved || s.Status == entity.SlotBooked) && buffer.Collides(&extended, s) {
+					d.logger.Error(ctx, "extension lies within travel buffer of taken slot",
+						option.Any("order_id", orderID),
+						option.Any("slot_id", s.ID),
+						option.Error(service_errors.ErrSlotWithinTravelBuffer))
+
+					return service_errors.ErrSlotWithinTravelBuffer
+				}
+
+				continue
+			}
+
+			if !s.IsAvailable() {
+				d.logger.Error(ctx, "extension overlaps with not available slot",
+					option.Any("order_id", orderID),
+					option.Any("slot_id", s.ID),
+					option.Error(service_errors.ErrSlotOverlap))
+
+				return service_errors.ErrSlotOverlap
+			}
+
+			consumed = append(consumed, s)
+		}
+
+		bookedDuration := slot.EndTime.Sub(slot.StartTime) - time.Duration(order.ExtensionMinutes)*time.Minute
+		bookedPrice, err := booking.BasePrice.Add(booking.DurationScaling)
+		if err != nil {
+			d.logger.Error(ctx, "failed to price extension",
+				option.Any("order_id", orderID),
+				option.Error(err))
+
+			return err
+		}
+		amount := entity.ExtensionPrice(bookedPrice, bookedDuration, extension.Minutes)
+
+		for _, s := range consumed {
+			if err = d.consumeSlot(ctx, s, newEnd); err != nil {
+				return err
+			}
+		}
+
+		slot.EndTime = newEnd
+		if _, err = d.slotRepo.Update(ctx, slot); err != nil {
+			if errors.Is(err, persistence.ErrRangeOverlap) {
+				d.logger.Error(ctx, "extension overlaps with a concurrently saved slot",
+					option.Any("order_id", orderID),
+					option.Any("slot_id", slot.ID),
+					option.Error(service_errors.ErrSlotOverlap))
+
+				return service_errors.ErrSlotOverlap
+			}
+
+			d.logger.Error(ctx, "failed to extend slot",
+				option.Any("slot_id", slot.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		extension.Accept(time.Now(), amount)
+		if res, err = d.extensionRepo.Update(ctx, extension); err != nil {
+			d.logger.Error(ctx, "failed to update order extension",
+				option.Any("extension_id", extension.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		if _, err = d.orderRepo.AddExtension(ctx, order.ID, extension.Minutes, amount); err != nil {
+			d.logger.Error(ctx, "failed to add extension to order",
+				option.Any("order_id", order.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		if !booking.Price.IsPositive() {
+			// a free order has no payment to hold the extra amount on
+			return nil
+		}
+
+		if _, err = d.payments.AuthorizeExtra(ctx, order.ID, amount); err != nil {
+			d.logger.Error(ctx, "failed to authorize extension payment",
+				option.Any("order_id", order.ID),
+				option.Any("amount", amount),
+				option.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// consumeSlot takes the time until the end from the slot only while it is still available,
+// a slot reserved in the meantime blocks the extension.
+func (d *DefaultOrderExtensionService) consumeSlot(ctx context.Context, slot *entity.Slot, end time.Time) error {
+	slot.ConsumeUntil(end)
+
+	var err error
+	if slot.Status == entity.SlotDisabled {
+		var disabled []*entity.Slot
+		disabled, err = d.slotRepo.DisableAvailable(ctx, []int64{slot.ID})
+		if err == nil && len(disabled) == 0 {
+			err = persistence.ErrNoRowsFound
+		}
+	} else {
+		_, err = d.slotRepo.UpdateAvailable(ctx, slot)
+	}
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "consumed slot is taken meanwhile",
+				option.Any("slot_id", slot.ID),
+				option.Error(service_errors.ErrSlotOverlap))
+
+			return service_errors.ErrSlotOverlap
+		}
+
+		d.logger.Error(ctx, "failed to update consumed slot",
+			option.Any("slot_id", slot.ID),
+			option.Error(err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (d *DefaultOrderExtensionService) RejectExtension(ctx context.Context,
+	orderID, extensionID int64) (*entity.OrderExtension, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, _, err = d.getModelOrder(ctx, authID, orderID); err != nil {
+		return nil, err
+	}
+
+	extension, err := d.getPendingExtension(ctx, orderID, extensionID)
+	if err != nil {
+		return nil, err
+	}
+
+	extension.Reject(time.Now())
+	res, err := d.extensionRepo.Update(ctx, extension)
+	if err != nil {
+		d.logger.Error(ctx, "failed to update order extension",
+			option.Any("extension_id", extension.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultOrderExtensionService) getPendingExtension(ctx context.Context,
+	orderID, extensionID int64) (*entity.OrderExtension, error) {
+
+	extension, err := d.extensionRepo.GetByID(ctx, extensionID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "order extension is not found by id",
+				option.Any("extension_id", extensionID),
+				option.Error(service_errors.ErrOrderExtensionNotFound))
+
+			return nil, service_errors.ErrOrderExtensionNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get order extension by id",
+			option.Any("extension_id", extensionID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if extension.OrderID != orderID {
+		d.logger.Error(ctx, "order extension belongs to another order",
+			option.Any("extension_id", extensionID),
+			option.Any("order_id", orderID),
+			option.Error(service_errors.ErrOrderExtensionNotFound))
+
+		return nil, service_errors.ErrOrderExtensionNotFound
+	}
+
+	if !extension.IsPending() {
+		d.logger.Error(ctx, "order extension already processed",
+			option.Any("extension_id", extensionID),
+			option.Any("status", extension.Status),
+			option.Error(service_errors.ErrOrderExtensionAlreadyProcessed))
+
+		return nil, service_errors.ErrOrderExtensionAlreadyProcessed
+	}
+
+	return extension, nil
+}
+
+func (d *DefaultOrderExtensionService) getModelOrder(ctx context.Context, authID *int64,
+	orderID int64) (*entity.Order, *entity.Booking, *entity.ModelService, error) {
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	order, booking, modelService, err := d.getOrderDetails(ctx, orderID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if modelService.ModelID != model.ID {
+		d.logger.Error(ctx, "model service is not owned by this model",
+			option.Any("model_id", model.ID),
+			option.Any("model_service_id", modelService.ID),
+			option.Error(service_errors.ErrModelIsNotAnOwnerOfService))
+
+		return nil, nil, nil, service_errors.ErrModelIsNotAnOwnerOfService
+	}
+
+	return order, booking, modelService, nil
+}
+
+func (d *DefaultOrderExtensionService) getOrderDetails(ctx context.Context,
+	orderID int64) (*entity.Order, *entity.Booking, *entity.ModelService, error) {
+
+	order, err := d.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "order is not found by id",
+				option.Any("order_id", orderID),
+				option.Error(service_errors.ErrOrderNotFound))
+
+			return nil, nil, nil, service_errors.ErrOrderNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get order by id",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return nil, nil, nil, err
+	}
+
+	booking, err := d.bookingRepo.GetByID(ctx, order.BookingID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "booking is not found by id",
+				option.Any("booking_id", order.BookingID),
+				option.Error(service_errors.ErrBookingNotFound))
+
+			return nil, nil, nil, service_errors.ErrBookingNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get booking by id",
+			option.Any("booking_id", order.BookingID),
+			option.Error(err))
+
+		return nil, nil, nil, err
+	}
+
+	modelService, err := d.modelServiceRepo.GetByID(ctx, booking.ModelServiceID, false)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model service is not found by id",
+				option.Any("model_service_id", booking.ModelServiceID),
+				option.Error(service_errors.ErrServiceIsNotFound))
+
+			return nil, nil, nil, service_errors.ErrServiceIsNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get model service by id",
+			option.Any("model_service_id", booking.ModelServiceID),
+			option.Error(err))
+
+		return nil, nil, nil, err
+	}
+
+	return order, booking, modelService, nil
+}
+
+func (d *DefaultOrderExtensionService) checkModelRestrictions(ctx context.Context,
+	authID *int64) (*entity.User, error) {
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleModel.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAModel))
+
+		return nil, service_errors.ErrNotAModel
+	}
+
+	model, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotAModel))
+
+			return nil, service_errors.ErrNotAModel
+		}
+
+		d.logger.Error(ctx, "check model restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !model.IsUserVerified() {
+		d.logger.Error(ctx, "model is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedModel))
+
+		return nil, service_errors.ErrNotVerifiedModel
+	}
+
+	return model, nil
+}
+
+func (d *DefaultOrderExtensionService) checkClientRestrictions(ctx context.Context,
+	authID *int64) (*entity.User, error) {
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleClient.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotClient))
+
+		return nil, service_errors.ErrNotClient
+	}
+
+	client, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "client is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotClient))
+
+			return nil, service_errors.ErrNotClient
+		}
+
+		d.logger.Error(ctx, "check client restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !client.IsUserVerified() {
+		d.logger.Error(ctx, "client is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedClient))
+
+		return nil, service_errors.ErrNotVerifiedClient
+	}
+
+	return client, nil
+}
@@ -0,0 +1,521 @@
+package service
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type orderExtensionServiceTest struct {
+	ctrl             *gomock.Controller
+	extensionRepo    *mocks.MockOrderExtensionRepository
+	orderRepo        *mocks.MockOrderRepository
+	bookingRepo      *mocks.MockBookingRepository
+	slotRepo         *mocks.MockSlotRepository
+	userRepo         *mocks.MockUserRepository
+	modelServiceRepo *mocks.MockModelServiceRepository
+	payments         *mocks.MockPaymentProcessor
+	buffers          *mocks.MockTravelBufferProvider
+	txManager        *mocks.MockTxManager
+	service          *DefaultOrderExtensionService
+}
+
+func setUpOrderExtensionServiceTest(t *testing.T) *orderExtensionServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	extensionRepo := mocks.NewMockOrderExtensionRepository(ctrl)
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	bookingRepo := mocks.NewMockBookingRepository(ctrl)
+	slotRepo := mocks.NewMockSlotRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	modelServiceRepo := mocks.NewMockModelServiceRepository(ctrl)
+	payments := mocks.NewMockPaymentProcessor(ctrl)
+	buffers := mocks.NewMockTravelBufferProvider(ctrl)
+	mockTxManager := mocks.NewMockTxManager(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &orderExtensionServiceTest{
+		ctrl:             ctrl,
+		extensionRepo:    extensionRepo,
+		orderRepo:        orderRepo,
+		bookingRepo:      bookingRepo,
+		slotRepo:         slotRepo,
+		userRepo:         userRepo,
+		modelServiceRepo: modelServiceRepo,
+		payments:         payments,
+		buffers:          buffers,
+		txManager:        mockTxManager,
+		service: NewDefaultOrderExtensionService(extensionRepo, orderRepo, bookingRepo, slotRepo,
+			userRepo, modelServiceRepo, payments, buffers, mockTxManager, log),
+	}
+}
+
+func (test *orderExtensionServiceTest) expectOrderDetails(order *entity.Order, booking *entity.Booking,
+	modelService *entity.ModelService) {
+
+	test.orderRepo.EXPECT().
+		GetByID(gomock.Any(), order.ID).
+		Return(order, nil).
+		Times(1)
+
+	test.bookingRepo.EXPECT().
+		GetByID(gomock.Any(), order.BookingID).
+		Return(booking, nil).
+		Times(1)
+
+	test.modelServiceRepo.EXPECT().
+		GetByID(gomock.Any(), booking.ModelServiceID, false).
+		Return(modelService, nil).
+		Times(1)
+}
+
+func TestOrderExtensionService_RequestExtension(t *testing.T) {
+	ctx := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctx = context.WithValue(ctx, service_const.RoleKey, "CLIENT")
+
+	client := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+	modelService := &entity.ModelService{ID: 3, ModelID: 6, Price: rub(100)}
+
+	tests := []struct {
+		name          string
+		mockOrder     *entity.Order
+		mockBooking   *entity.Booking
+		mockPending   error
+		expectSave    bool
+		expectedError error
+	}{
+		{
+			name:        "extension is requested",
+			mockOrder:   &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockBooking: &entity.Booking{ID: 2, ClientID: 5, ModelServiceID: 3, SlotID: 4},
+			mockPending: persistence.ErrNoRowsFound,
+			expectSave:  true,
+		},
+		{
+			name:          "client is not an owner",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockBooking:   &entity.Booking{ID: 2, ClientID: 42, ModelServiceID: 3, SlotID: 4},
+			expectedError: service_errors.ErrClientIsNotOwnerOfOrder,
+		},
+		{
+			name:          "completed order cannot be extended",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderPendingConfirmation},
+			mockBooking:   &entity.Booking{ID: 2, ClientID: 5, ModelServiceID: 3, SlotID: 4},
+			expectedError: service_errors.ErrCannotExtendOrder,
+		},
+		{
+			name:          "extension is already requested",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderConfirmed},
+			mockBooking:   &entity.Booking{ID: 2, ClientID: 5, ModelServiceID: 3, SlotID: 4},
+			expectedError: service_errors.ErrOrderExtensionAlreadyRequested,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpOrderExtensionServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(1)).
+				Return(client, nil).
+				Times(1)
+
+			test.expectOrderDetails(tt.mockOrder, tt.mockBooking, modelService)
+
+			if tt.mockPending != nil || tt.expectedError == service_errors.ErrOrderExtensionAlreadyRequested {
+				var pending *entity.OrderExtension
+				if tt.mockPending == nil {
+					pending = &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionPending}
+				}
+
+				test.extensionRepo.EXPECT().
+					GetPendingByOrderID(gomock.Any(), int64(1)).
+					Return(pending, tt.mockPending).
+					Times(1)
+			}
+
+			if tt.expectSave {
+				test.extensionRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e *entity.OrderExtension) error {
+						e.ID = 10
+						return nil
+					}).
+					Times(1)
+			}
+
+			result, err := test.service.RequestExtension(ctx, 1, 30)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(10), result.ID)
+			assert.Equal(t, 30, result.Minutes)
+			assert.Equal(t, entity.OrderExtensionPending, result.Status)
+		})
+	}
+}
+
+func TestOrderExtensionService_AcceptExtension(t *testing.T) {
+	ctx := context.WithValue(context.Background(), service_const.AuthIDKey, int64(2))
+	ctx = context.WithValue(ctx, service_const.RoleKey, "MODEL")
+
+	model := &entity.User{ID: 6, AuthID: 2, IsVerified: true}
+	booking := &entity.Booking{ID: 2, ClientID: 5, ModelServiceID: 3, SlotID: 4, BasePrice: rub(100), Price: rub(100)}
+	modelService := &entity.ModelService{ID: 3, ModelID: 6, Price: rub(100)}
+
+	start := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	tests := []struct {
+		name             string
+		mockOrder        *entity.Order
+		mockExtension    *entity.OrderExtension
+		mockSlotEnd      time.Time
+		mockBuffer       *entity.TravelBuffer
+		mockOverlaps     []*entity.Slot
+		mockConsumeErr   error
+		expectedAmount   entity.Money
+		expectedConsumed map[int64]*entity.Slot
+		mockPaymentErr   error
+		expectedError    error
+	}{
+		{
+			name:           "slot is extended into free time",
+			mockOrder:      &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockExtension:  &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionPending},
+			mockSlotEnd:    end,
+			expectedAmount: rub(50),
+		},
+		{
+			name:          "available slot on the way is consumed",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockExtension: &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionPending},
+			mockSlotEnd:   end,
+			mockOverlaps: []*entity.Slot{
+				{ID: 8, ModelID: 6, StartTime: end, EndTime: end.Add(2 * time.Hour), Status: entity.SlotAvailable},
+			},
+			expectedAmount: rub(50),
+			expectedConsumed: map[int64]*entity.Slot{
+				8: {ID: 8, ModelID: 6, StartTime: end.Add(30 * time.Minute),
+					EndTime: end.Add(2 * time.Hour), Status: entity.SlotAvailable},
+			},
+		},
+		{
+			name:          "fully covered slot is disabled",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderConfirmed},
+			mockExtension: &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionPending},
+			mockSlotEnd:   end,
+			mockOverlaps: []*entity.Slot{
+				{ID: 8, ModelID: 6, StartTime: end, EndTime: end.Add(30 * time.Minute), Status: entity.SlotAvailable},
+			},
+			expectedAmount: rub(50),
+			expectedConsumed: map[int64]*entity.Slot{
+				8: {ID: 8, ModelID: 6, StartTime: end,
+					EndTime: end.Add(30 * time.Minute), Status: entity.SlotDisabled},
+			},
+		},
+		{
+			name: "price is pro-rata to the originally booked duration",
+			mockOrder: &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit,
+				ExtensionMinutes: 30, ExtensionAmount: rub(50)},
+			mockExtension:  &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 20, Status: entity.OrderExtensionPending},
+			mockSlotEnd:    end.Add(30 * time.Minute),
+			expectedAmount: rub(33.33),
+		},
+		{
+			name:           "extra amount is declined",
+			mockOrder:      &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockExtension:  &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionPending},
+			mockSlotEnd:    end,
+			expectedAmount: rub(50),
+			mockPaymentErr: service_errors.ErrPaymentDeclined,
+			expectedError:  service_errors.ErrPaymentDeclined,
+		},
+		{
+			name:          "booked slot blocks the extension",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockExtension: &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionPending},
+			mockSlotEnd:   end,
+			mockOverlaps: []*entity.Slot{
+				{ID: 8, ModelID: 6, StartTime: end.Add(15 * time.Minute), EndTime: end.Add(time.Hour),
+					Status: entity.SlotBooked},
+			},
+			expectedError: service_errors.ErrSlotOverlap,
+		},
+		{
+			name:          "available slot is reserved meanwhile",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockExtension: &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionPending},
+			mockSlotEnd:   end,
+			mockOverlaps: []*entity.Slot{
+				{ID: 8, ModelID: 6, StartTime: end, EndTime: end.Add(2 * time.Hour), Status: entity.SlotAvailable},
+			},
+			mockConsumeErr: persistence.ErrNoRowsFound,
+			expectedError:  service_errors.ErrSlotOverlap,
+		},
+		{
+			name:          "new end keeps the travel buffer before the next booking",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockExtension: &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionPending},
+			mockSlotEnd:   end,
+			mockBuffer:    entity.NewTravelBuffer(6, 30*time.Minute, 30*time.Minute, rub(0)),
+			mockOverlaps: []*entity.Slot{
+				{ID: 8, ModelID: 6, StartTime: end.Add(time.Hour), EndTime: end.Add(2 * time.Hour),
+					Status: entity.SlotBooked},
+			},
+			expectedAmount: rub(50),
+		},
+		{
+			name:          "new end breaks the travel buffer before the next booking",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockExtension: &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionPending},
+			mockSlotEnd:   end,
+			mockBuffer:    entity.NewTravelBuffer(6, 30*time.Minute, 45*time.Minute, rub(0)),
+			mockOverlaps: []*entity.Slot{
+				{ID: 8, ModelID: 6, StartTime: end.Add(time.Hour), EndTime: end.Add(2 * time.Hour),
+					Status: entity.SlotBooked},
+			},
+			expectedError: service_errors.ErrSlotWithinTravelBuffer,
+		},
+		{
+			name:          "extension is already processed",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockExtension: &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionRejected},
+			expectedError: service_errors.ErrOrderExtensionAlreadyProcessed,
+		},
+		{
+			name:          "extension belongs to another order",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockExtension: &entity.OrderExtension{ID: 7, OrderID: 9, Minutes: 30, Status: entity.OrderExtensionPending},
+			expectedError: service_errors.ErrOrderExtensionNotFound,
+		},
+		{
+			name:          "finished order cannot be extended",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderCompleted},
+			mockExtension: &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionPending},
+			expectedError: service_errors.ErrCannotExtendOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpOrderExtensionServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(2)).
+				Return(model, nil).
+				Times(1)
+
+			test.expectOrderDetails(tt.mockOrder, booking, modelService)
+
+			test.extensionRepo.EXPECT().
+				GetByID(gomock.Any(), tt.mockExtension.ID).
+				Return(tt.mockExtension, nil).
+				Times(1)
+
+			if !tt.mockSlotEnd.IsZero() {
+				slot := &entity.Slot{ID: 4, ModelID: 6, StartTime: start, EndTime: tt.mockSlotEnd,
+					Status: entity.SlotBooked}
+				newEnd := tt.mockSlotEnd.Add(tt.mockExtension.Duration())
+
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.slotRepo.EXPECT().
+					GetByID(gomock.Any(), int64(4)).
+					Return(slot, nil).
+					Times(1)
+
+				buffer := tt.mockBuffer
+				if buffer == nil {
+					buffer = entity.NewTravelBuffer(6, 0, 0, rub(0))
+				}
+				test.buffers.EXPECT().
+					BufferOf(gomock.Any(), int64(6)).
+					Return(buffer, nil).
+					Times(1)
+
+				from, to := buffer.Window(tt.mockSlotEnd, newEnd)
+				test.slotRepo.EXPECT().
+					GetOverlappingSlots(gomock.Any(), int64(6), from, to).
+					Return(append([]*entity.Slot{slot}, tt.mockOverlaps...), nil).
+					Times(1)
+
+				for id, consumed := range tt.expectedConsumed {
+					if consumed.Status == entity.SlotDisabled {
+						test.slotRepo.EXPECT().
+							DisableAvailable(gomock.Any(), []int64{id}).
+							Return([]*entity.Slot{consumed}, nil).
+							Times(1)
+						continue
+					}
+
+					test.slotRepo.EXPECT().
+						UpdateAvailable(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, s *entity.Slot) (*entity.Slot, error) {
+							assert.Equal(t, consumed, s)
+							return s, nil
+						}).
+						Times(1)
+				}
+
+				if tt.mockConsumeErr != nil {
+					test.slotRepo.EXPECT().
+						UpdateAvailable(gomock.Any(), gomock.Any()).
+						Return(nil, tt.mockConsumeErr).
+						Times(1)
+				}
+
+				if tt.expectedError == nil || tt.mockPaymentErr != nil {
+					test.slotRepo.EXPECT().
+						Update(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, s *entity.Slot) (*entity.Slot, error) {
+							assert.Equal(t, slot.ID, s.ID)
+							assert.Equal(t, newEnd, s.EndTime)
+							return s, nil
+						}).
+						Times(1)
+
+					test.extensionRepo.EXPECT().
+						Update(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, e *entity.OrderExtension) (*entity.OrderExtension, error) {
+							return e, nil
+						}).
+						Times(1)
+
+					test.orderRepo.EXPECT().
+						AddExtension(gomock.Any(), int64(1), tt.mockExtension.Minutes, tt.expectedAmount).
+						Return(tt.mockOrder, nil).
+						Times(1)
+
+					test.payments.EXPECT().
+						AuthorizeExtra(gomock.Any(), int64(1), tt.expectedAmount).
+						Return(&entity.Payment{}, tt.mockPaymentErr).
+						Times(1)
+				}
+			}
+
+			result, err := test.service.AcceptExtension(ctx, 1, 7)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.OrderExtensionAccepted, result.Status)
+			assert.Equal(t, tt.expectedAmount, *result.Amount)
+			assert.NotNil(t, result.DecidedAt)
+		})
+	}
+}
+
+func TestOrderExtensionService_RejectExtension(t *testing.T) {
+	ctx := context.WithValue(context.Background(), service_const.AuthIDKey, int64(2))
+	ctx = context.WithValue(ctx, service_const.RoleKey, "MODEL")
+
+	model := &entity.User{ID: 6, AuthID: 2, IsVerified: true}
+	order := &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit}
+	booking := &entity.Booking{ID: 2, ClientID: 5, ModelServiceID: 3, SlotID: 4}
+
+	tests := []struct {
+		name             string
+		mockModelService *entity.ModelService
+		mockExtension    *entity.OrderExtension
+		expectUpdate     bool
+		expectedError    error
+	}{
+		{
+			name:             "extension is rejected",
+			mockModelService: &entity.ModelService{ID: 3, ModelID: 6, Price: rub(100)},
+			mockExtension:    &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionPending},
+			expectUpdate:     true,
+		},
+		{
+			name:             "model is not an owner",
+			mockModelService: &entity.ModelService{ID: 3, ModelID: 42, Price: rub(100)},
+			expectedError:    service_errors.ErrModelIsNotAnOwnerOfService,
+		},
+		{
+			name:             "extension is already accepted",
+			mockModelService: &entity.ModelService{ID: 3, ModelID: 6, Price: rub(100)},
+			mockExtension:    &entity.OrderExtension{ID: 7, OrderID: 1, Minutes: 30, Status: entity.OrderExtensionAccepted},
+			expectedError:    service_errors.ErrOrderExtensionAlreadyProcessed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpOrderExtensionServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(2)).
+				Return(model, nil).
+				Times(1)
+
+			test.expectOrderDetails(order, booking, tt.mockModelService)
+
+			if tt.mockExtension != nil {
+				test.extensionRepo.EXPECT().
+					GetByID(gomock.Any(), tt.mockExtension.ID).
+					Return(tt.mockExtension, nil).
+					Times(1)
+			}
+
+			if tt.expectUpdate {
+				test.extensionRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e *entity.OrderExtension) (*entity.OrderExtension, error) {
+						return e, nil
+					}).
+					Times(1)
+			}
+
+			result, err := test.service.RejectExtension(ctx, 1, 7)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.OrderExtensionRejected, result.Status)
+			assert.NotNil(t, result.DecidedAt)
+		})
+	}
+}
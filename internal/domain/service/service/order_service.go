@@ -3,12 +3,15 @@ package service
 import (
 	"context"
 	"errors"
+	"os"
+	"strconv"
 	"time"
 
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
 	metrics2 "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/metrics"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
@@ -22,24 +25,52 @@ type DefaultOrderService struct {
 	slotRepo         interfaces.SlotRepository
 	userRepo         interfaces.UserRepository
 	modelServiceRepo interfaces.ModelServiceRepository
+	eventBroker      interfaces.OrderEventBroker
+	payments         interfaces.PaymentProcessor
+	promoCodes       interfaces.PromoCodeRedeemer
+	receipts         interfaces.ReceiptIssuer
+	disputes         interfaces.DisputeOpener
 	txManager        database.TxManager
 	logger           pkg.Logger
 	metrics          *metrics2.Metrics
+	confirmationTtl  time.Duration
 }
 
 func NewDefaultOrderService(orderRepo interfaces.OrderRepository, bookingRepo interfaces.BookingRepository,
 	slotRepo interfaces.SlotRepository, userRepo interfaces.UserRepository, modelServiceRepo interfaces.ModelServiceRepository,
-	txManager database.TxManager, logger pkg.Logger, metrics *metrics2.Metrics) *DefaultOrderService {
+	eventBroker interfaces.OrderEventBroker, payments interfaces.PaymentProcessor,
+	promoCodes interfaces.PromoCodeRedeemer, receipts interfaces.ReceiptIssuer, disputes interfaces.DisputeOpener,
+	txManager database.TxManager, logger pkg.Logger, metrics *metrics2.Metrics) (*DefaultOrderService, error) {
+
+	ttl := os.Getenv(service_const.DotEnvOrderConfirmationExpiration)
+	if ttl == "" {
+		return nil, service_errors.ErrLoadingTTL
+	}
+
+	ttlInSeconds, err := strconv.Atoi(ttl)
+	if err != nil {
+		return nil, service_errors.ErrParsingTTL
+	}
+	if ttlInSeconds < 0 {
+		return nil, service_errors.ErrNotPositiveTTL
+	}
+
 	return &DefaultOrderService{
 		orderRepo:        orderRepo,
 		bookingRepo:      bookingRepo,
 		slotRepo:         slotRepo,
 		userRepo:         userRepo,
 		modelServiceRepo: modelServiceRepo,
+		eventBroker:      eventBroker,
+		payments:         payments,
+		promoCodes:       promoCodes,
+		receipts:         receipts,
+		disputes:         disputes,
 		txManager:        txManager,
 		logger:           logger,
 		metrics:          metrics,
-	}
+		confirmationTtl:  time.Duration(ttlInSeconds) * time.Second,
+	}, nil
 }
 
 func (d *DefaultOrderService) GetModelOrders(ctx context.Context, page, limit *int64) ([]*entity.Order, error) {
@@ -222,26 +253,159 @@ func (d *DefaultOrderService) CompleteOrder(ctx context.Context, orderID int64)
 		return nil, service_errors.ErrCannotCompleteOrder
 	}
 
-	order.Status = entity.OrderCompleted
-	res, err := d.orderRepo.UpdateStatus(ctx, order)
+	order.MarkCompletedByModel(time.Now(), d.confirmationTtl)
+	res, err := d.updateOrderStatus(ctx, order)
 	if err != nil {
-		if errors.Is(err, persistence.ErrNoRowsFound) {
-			d.logger.Error(ctx, "order is not found by id",
-				option.Any("order_id", order.ID),
-				option.Error(service_errors.ErrOrderNotFound))
+		return nil, err
+	}
 
-			return nil, service_errors.ErrOrderNotFound
-		}
+	d.eventBroker.Publish(ctx, entity.NewOrderStatusEvent(res))
 
-		d.logger.Error(ctx, "failed to update order status",
+	return res, nil
+}
+
+func (d *DefaultOrderService) ConfirmOrder(ctx context.Context, orderID int64) (*entity.Order, error) {
+	order, booking, err := d.getClientOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.CanBeConfirmed() {
+		d.logger.Error(ctx, "order cannot be confirmed",
 			option.Any("order_id", order.ID),
-			option.Any("auth_id", authID),
-			option.Error(err))
+			option.Any("status", order.Status),
+			option.Error(service_errors.ErrCannotConfirmOrder))
 
+		return nil, service_errors.ErrCannotConfirmOrder
+	}
+
+	res, err := d.confirmOrder(ctx, order, booking, time.Now())
+	if err != nil {
 		return nil, err
 	}
 
 	d.metrics.IncCompletedOrders()
+	d.eventBroker.Publish(ctx, entity.NewOrderStatusEvent(res))
+
+	return res, nil
+}
+
+func (d *DefaultOrderService) RaiseOrderIssue(ctx context.Context, orderID int64, reason string) (*entity.Order, error) {
+	order, _, err := d.getClientOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.CanRaiseIssue(time.Now()) {
+		d.logger.Error(ctx, "issue cannot be raised for order",
+			option.Any("order_id", order.ID),
+			option.Any("status", order.Status),
+			option.Error(service_errors.ErrCannotRaiseOrderIssue))
+
+		return nil, service_errors.ErrCannotRaiseOrderIssue
+	}
+
+	// the issue is a dispute case opened by the client, so the order is resolved and archived like any dispute
+	var res *entity.Order
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := d.disputes.OpenDispute(ctx, order.ID, entity.DisputeOther, reason); err != nil {
+			return err
+		}
+
+		order.OpenDispute()
+		order.RaiseIssue(reason)
+		res, err = d.updateOrderStatus(ctx, order)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// AutoConfirmOrders completes orders whose client did not respond within the confirmation window.
+// An order whose payment cannot be captured stays pending and is retried by the next run,
+// the error joins the failures of all the orders.
+func (d *DefaultOrderService) AutoConfirmOrders(ctx context.Context) (int, error) {
+	now := time.Now()
+	orders, err := d.orderRepo.GetExpiredConfirmations(ctx, now)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get orders to auto-confirm",
+			option.Error(err))
+
+		return 0, err
+	}
+
+	confirmed := 0
+	var failures []error
+	for _, order := range orders {
+		booking, err := d.bookingRepo.GetByID(ctx, order.BookingID)
+		if err != nil {
+			d.logger.Error(ctx, "failed to get booking of order to auto-confirm",
+				option.Any("order_id", order.ID),
+				option.Any("booking_id", order.BookingID),
+				option.Error(err))
+
+			failures = append(failures, err)
+			continue
+		}
+
+		res, err := d.confirmOrder(ctx, order, booking, now)
+		if err != nil {
+			failures = append(failures, err)
+			continue
+		}
+
+		confirmed++
+		d.metrics.IncCompletedOrders()
+		d.eventBroker.Publish(ctx, entity.NewOrderStatusEvent(res))
+	}
+
+	return confirmed, errors.Join(failures...)
+}
+
+// confirmOrder completes the order, captures its payment and issues the receipt, the model is paid only
+// once the client has accepted the work or let the confirmation window pass. A free order has no payment.
+func (d *DefaultOrderService) confirmOrder(ctx context.Context, order *entity.Order, booking *entity.Booking,
+	now time.Time) (*entity.Order, error) {
+
+	order.Confirm(now)
+
+	var res *entity.Order
+	err := d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if res, err = d.updateOrderStatus(ctx, order); err != nil {
+			return err
+		}
+
+		if !booking.Price.IsPositive() {
+			return nil
+		}
+
+		payment, err := d.payments.Capture(ctx, order.ID)
+		if err != nil {
+			d.logger.Error(ctx, "failed to capture payment",
+				option.Any("order_id", order.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		if _, err = d.receipts.IssueReceipt(ctx, res, payment); err != nil {
+			d.logger.Error(ctx, "failed to issue receipt",
+				option.Any("order_id", order.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return res, nil
 }
@@ -332,6 +496,155 @@ func (d *DefaultOrderService) CancelOrderByClient(ctx context.Context, orderID i
 	return d.cancelOrder(ctx, booking, slot, order)
 }
 
+// CancelOrderByAdmin cancels the order without the 24h rule, the slot, the payment and the promo code
+// are released as for a cancellation by the client or the model.
+func (d *DefaultOrderService) CancelOrderByAdmin(ctx context.Context, order *entity.Order) (*entity.Order, error) {
+	booking, err := d.getOrderBooking(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := d.slotRepo.GetByID(ctx, booking.SlotID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "slot is not found by id",
+				option.Any("slot_id", booking.SlotID),
+				option.Error(service_errors.ErrSlotIsNotFound))
+
+			return nil, service_errors.ErrSlotIsNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get slot by id",
+			option.Any("slot_id", booking.SlotID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return d.cancelOrder(ctx, booking, slot, order)
+}
+
+// ConfirmOrderByAdmin completes the order on behalf of the client, the payment is captured
+// and the receipt is issued as for a confirmation by the client.
+func (d *DefaultOrderService) ConfirmOrderByAdmin(ctx context.Context, order *entity.Order) (*entity.Order, error) {
+	booking, err := d.getOrderBooking(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.confirmOrder(ctx, order, booking, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	d.metrics.IncCompletedOrders()
+	d.eventBroker.Publish(ctx, entity.NewOrderStatusEvent(res))
+
+	return res, nil
+}
+
+func (d *DefaultOrderService) getOrderBooking(ctx context.Context, order *entity.Order) (*entity.Booking, error) {
+	booking, err := d.bookingRepo.GetByID(ctx, order.BookingID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "booking is not found by id",
+				option.Any("booking_id", order.BookingID),
+				option.Error(service_errors.ErrBookingNotFound))
+
+			return nil, service_errors.ErrBookingNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get booking by id",
+			option.Any("booking_id", order.BookingID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+func (d *DefaultOrderService) getClientOrder(ctx context.Context,
+	orderID int64) (*entity.Order, *entity.Booking, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := d.checkClientRestrictions(ctx, authID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	order, err := d.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "order is not found by id",
+				option.Any("order_id", orderID),
+				option.Error(service_errors.ErrOrderNotFound))
+
+			return nil, nil, service_errors.ErrOrderNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get order by id",
+			option.Any("order_id", orderID),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, nil, err
+	}
+
+	booking, err := d.bookingRepo.GetByID(ctx, order.BookingID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "booking is not found by id",
+				option.Any("booking_id", order.BookingID),
+				option.Error(service_errors.ErrBookingNotFound))
+
+			return nil, nil, service_errors.ErrBookingNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get booking by id",
+			option.Any("booking_id", order.BookingID),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, nil, err
+	}
+
+	if booking.ClientID != client.ID {
+		d.logger.Error(ctx, "order is not owned by this client",
+			option.Any("booking_id", order.BookingID),
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrClientIsNotOwnerOfOrder))
+
+		return nil, nil, service_errors.ErrClientIsNotOwnerOfOrder
+	}
+
+	return order, booking, nil
+}
+
+func (d *DefaultOrderService) updateOrderStatus(ctx context.Context, order *entity.Order) (*entity.Order, error) {
+	res, err := d.orderRepo.UpdateStatus(ctx, order)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "order is not found by id",
+				option.Any("order_id", order.ID),
+				option.Error(service_errors.ErrOrderNotFound))
+
+			return nil, service_errors.ErrOrderNotFound
+		}
+
+		d.logger.Error(ctx, "failed to update order status",
+			option.Any("order_id", order.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (d *DefaultOrderService) checkModelRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
 	role, err := common.GetRoleFromContext(ctx)
 	if err != nil {
@@ -505,6 +818,24 @@ func (d *DefaultOrderService) cancelOrder(ctx context.Context, booking *entity.B
 			return err
 		}
 
+		if booking.Price.IsPositive() {
+			if _, err = d.payments.Release(ctx, order.ID); err != nil {
+				d.logger.Error(ctx, "failed to release payment",
+					option.Any("order_id", order.ID),
+					option.Error(err))
+
+				return err
+			}
+		}
+
+		if err = d.releasePromoCode(ctx, booking); err != nil {
+			d.logger.Error(ctx, "failed to release promo code",
+				option.Any("booking_id", booking.ID),
+				option.Error(err))
+
+			return err
+		}
+
 		return nil
 	})
 
@@ -512,5 +843,15 @@ func (d *DefaultOrderService) cancelOrder(ctx context.Context, booking *entity.B
 		return nil, err
 	}
 
+	d.eventBroker.Publish(ctx, entity.NewOrderStatusEvent(res))
+
 	return res, nil
 }
+
+func (d *DefaultOrderService) releasePromoCode(ctx context.Context, booking *entity.Booking) error {
+	if booking.PromoCodeID == nil {
+		return nil
+	}
+
+	return d.promoCodes.Release(ctx, booking.ID)
+}
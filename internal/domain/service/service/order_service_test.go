@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -18,6 +19,9 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// metrics are registered in the default prometheus registry, so they are created once per package
+var orderServiceTestMetrics = metrics2.NewMetrics()
+
 type orderServiceTest struct {
 	ctrl             *gomock.Controller
 	orderRepo        *mocks.MockOrderRepository
@@ -25,6 +29,11 @@ type orderServiceTest struct {
 	slotRepo         *mocks.MockSlotRepository
 	userRepo         *mocks.MockUserRepository
 	modelServiceRepo *mocks.MockModelServiceRepository
+	eventBroker      *mocks.MockOrderEventBroker
+	payments         *mocks.MockPaymentProcessor
+	promoCodes       *mocks.MockPromoCodeRedeemer
+	receipts         *mocks.MockReceiptIssuer
+	disputes         *mocks.MockDisputeOpener
 	txManager        *mocks.MockTxManager
 	metrics          *metrics2.Metrics
 	service          *DefaultOrderService
@@ -39,8 +48,13 @@ func setUpOrderServiceTest(t *testing.T) *orderServiceTest {
 	slotRepo := mocks.NewMockSlotRepository(ctrl)
 	userRepo := mocks.NewMockUserRepository(ctrl)
 	modelServiceRepo := mocks.NewMockModelServiceRepository(ctrl)
+	eventBroker := mocks.NewMockOrderEventBroker(ctrl)
+	payments := mocks.NewMockPaymentProcessor(ctrl)
+	promoCodes := mocks.NewMockPromoCodeRedeemer(ctrl)
+	receipts := mocks.NewMockReceiptIssuer(ctrl)
+	disputes := mocks.NewMockDisputeOpener(ctrl)
 	mockTxManager := mocks.NewMockTxManager(ctrl)
-	metrics := metrics2.NewMetrics()
+	metrics := orderServiceTestMetrics
 
 	cfg := &config.LogConfig{}
 	cfg.Logger.Level = "info"
@@ -52,10 +66,17 @@ func setUpOrderServiceTest(t *testing.T) *orderServiceTest {
 		t.Fatal(err)
 	}
 
-	orderService := NewDefaultOrderService(
+	if err = os.Setenv(service_const.DotEnvOrderConfirmationExpiration, "3600"); err != nil {
+		t.Fatal(err)
+	}
+
+	orderService, err := NewDefaultOrderService(
 		orderRepo, bookingRepo, slotRepo, userRepo, modelServiceRepo,
-		mockTxManager, log, metrics,
+		eventBroker, payments, promoCodes, receipts, disputes, mockTxManager, log, metrics,
 	)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	return &orderServiceTest{
 		ctrl:             ctrl,
@@ -64,6 +85,11 @@ func setUpOrderServiceTest(t *testing.T) *orderServiceTest {
 		slotRepo:         slotRepo,
 		userRepo:         userRepo,
 		modelServiceRepo: modelServiceRepo,
+		eventBroker:      eventBroker,
+		payments:         payments,
+		promoCodes:       promoCodes,
+		receipts:         receipts,
+		disputes:         disputes,
 		txManager:        mockTxManager,
 		metrics:          metrics,
 		service:          orderService,
@@ -113,7 +139,7 @@ func TestOrderService_CompleteOrder(t *testing.T) {
 	completedOrder := &entity.Order{
 		ID:        1,
 		BookingID: 2,
-		Status:    entity.OrderCompleted,
+		Status:    entity.OrderPendingConfirmation,
 	}
 
 	tests := []struct {
@@ -132,11 +158,10 @@ func TestOrderService_CompleteOrder(t *testing.T) {
 		mockSlotErr         error
 		mockUpdateOrder     *entity.Order
 		mockUpdateErr       error
-		expectMetrics       bool
 		expectedError       error
 	}{
 		{
-			name:             "successful completion",
+			name:             "successful completion waits for client confirmation",
 			ctx:              ctxModel,
 			orderID:          1,
 			mockModel:        verifiedModel,
@@ -145,7 +170,6 @@ func TestOrderService_CompleteOrder(t *testing.T) {
 			mockModelService: modelService,
 			mockSlot:         slot,
 			mockUpdateOrder:  completedOrder,
-			expectMetrics:    true,
 		},
 		{
 			name:          "order not found",
@@ -211,8 +235,20 @@ func TestOrderService_CompleteOrder(t *testing.T) {
 									if tt.mockOrder.CanBeCompleted(time.Now(), tt.mockSlot.EndTime) {
 										test.orderRepo.EXPECT().
 											UpdateStatus(gomock.Any(), gomock.Any()).
-											Return(tt.mockUpdateOrder, tt.mockUpdateErr).
+											DoAndReturn(func(_ context.Context, o *entity.Order) (*entity.Order, error) {
+												assert.Equal(t, entity.OrderPendingConfirmation, o.Status)
+												assert.NotNil(t, o.CompletedAt)
+												assert.True(t, o.ConfirmationDeadline.After(*o.CompletedAt))
+
+												return tt.mockUpdateOrder, tt.mockUpdateErr
+											}).
 											Times(1)
+
+										if tt.mockUpdateErr == nil {
+											test.eventBroker.EXPECT().
+												Publish(gomock.Any(), gomock.Any()).
+												Times(1)
+										}
 									}
 								}
 							}
@@ -229,8 +265,624 @@ func TestOrderService_CompleteOrder(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
+				assert.Equal(t, entity.OrderPendingConfirmation, result.Status)
+			}
+		})
+	}
+}
+
+func TestOrderService_ConfirmOrder(t *testing.T) {
+	test := setUpOrderServiceTest(t)
+	defer test.ctrl.Finish()
+
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedClient := &entity.User{
+		ID:         5,
+		AuthID:     int64(1),
+		IsVerified: true,
+	}
+
+	booking := &entity.Booking{
+		ID:       2,
+		ClientID: 5,
+		Price:    rub(100),
+	}
+	freeBooking := &entity.Booking{ID: 2, ClientID: 5}
+
+	deadline := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name           string
+		orderID        int64
+		mockOrder      *entity.Order
+		mockOrderErr   error
+		mockBooking    *entity.Booking
+		mockUpdateErr  error
+		mockCaptureErr error
+		expectUpdate   bool
+		expectedError  error
+	}{
+		{
+			name:         "successful confirmation",
+			orderID:      1,
+			mockOrder:    &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderPendingConfirmation, ConfirmationDeadline: &deadline},
+			mockBooking:  booking,
+			expectUpdate: true,
+		},
+		{
+			name:         "free order is confirmed without payment",
+			orderID:      1,
+			mockOrder:    &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderPendingConfirmation},
+			mockBooking:  freeBooking,
+			expectUpdate: true,
+		},
+		{
+			name:          "order not found",
+			orderID:       1,
+			mockOrderErr:  persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrOrderNotFound,
+		},
+		{
+			name:          "client is not an owner",
+			orderID:       1,
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderPendingConfirmation},
+			mockBooking:   &entity.Booking{ID: 2, ClientID: 42},
+			expectedError: service_errors.ErrClientIsNotOwnerOfOrder,
+		},
+		{
+			name:          "order is not completed by model yet",
+			orderID:       1,
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockBooking:   booking,
+			expectedError: service_errors.ErrCannotConfirmOrder,
+		},
+		{
+			name:          "update error",
+			orderID:       1,
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderPendingConfirmation},
+			mockBooking:   booking,
+			expectUpdate:  true,
+			mockUpdateErr: errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+		{
+			name:           "payment capture fails",
+			orderID:        1,
+			mockOrder:      &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderPendingConfirmation},
+			mockBooking:    booking,
+			expectUpdate:   true,
+			mockCaptureErr: service_errors.ErrPaymentProviderFailed,
+			expectedError:  service_errors.ErrPaymentProviderFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(1)).
+				Return(verifiedClient, nil).
+				Times(1)
+
+			test.orderRepo.EXPECT().
+				GetByID(gomock.Any(), tt.orderID).
+				Return(tt.mockOrder, tt.mockOrderErr).
+				Times(1)
+
+			if tt.mockOrderErr == nil {
+				test.bookingRepo.EXPECT().
+					GetByID(gomock.Any(), tt.mockOrder.BookingID).
+					Return(tt.mockBooking, nil).
+					Times(1)
+			}
+
+			if tt.expectUpdate {
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.orderRepo.EXPECT().
+					UpdateStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, o *entity.Order) (*entity.Order, error) {
+						if tt.mockUpdateErr != nil {
+							return nil, tt.mockUpdateErr
+						}
+
+						return o, nil
+					}).
+					Times(1)
+
+				paid := tt.mockBooking.Price.IsPositive()
+				if tt.mockUpdateErr == nil && paid {
+					test.payments.EXPECT().
+						Capture(gomock.Any(), tt.orderID).
+						Return(&entity.Payment{OrderID: tt.orderID, Status: entity.PaymentCaptured}, tt.mockCaptureErr).
+						Times(1)
+				}
+
+				if tt.mockUpdateErr == nil && tt.mockCaptureErr == nil && paid {
+					test.receipts.EXPECT().
+						IssueReceipt(gomock.Any(), gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, o *entity.Order, p *entity.Payment) (*entity.Receipt, error) {
+							assert.Equal(t, entity.OrderCompleted, o.Status)
+							assert.Equal(t, entity.PaymentCaptured, p.Status)
+							return &entity.Receipt{OrderID: o.ID}, nil
+						}).
+						Times(1)
+				}
+
+				if tt.mockUpdateErr == nil && tt.mockCaptureErr == nil {
+					test.eventBroker.EXPECT().
+						Publish(gomock.Any(), gomock.Any()).
+						Times(1)
+				}
+			}
+
+			result, err := test.service.ConfirmOrder(ctxClient, tt.orderID)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
 				assert.Equal(t, entity.OrderCompleted, result.Status)
+				assert.NotNil(t, result.ConfirmedAt)
+			}
+		})
+	}
+}
+
+func TestOrderService_CancelOrderByClient(t *testing.T) {
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedClient := &entity.User{ID: 5, AuthID: int64(1), IsVerified: true}
+	start := time.Now().Add(72 * time.Hour)
+	promoID := int64(9)
+
+	tests := []struct {
+		name          string
+		promoCodeID   *int64
+		mockPromoErr  error
+		expectedError error
+	}{
+		{
+			name: "order without promo code is cancelled",
+		},
+		{
+			name:        "promo code is released with the order",
+			promoCodeID: &promoID,
+		},
+		{
+			name:          "promo code cannot be released",
+			promoCodeID:   &promoID,
+			mockPromoErr:  errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpOrderServiceTest(t)
+			defer test.ctrl.Finish()
+
+			order := &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderConfirmed}
+			booking := &entity.Booking{ID: 2, ClientID: 5, SlotID: 3, Price: rub(100), PromoCodeID: tt.promoCodeID}
+			slot := &entity.Slot{ID: 3, StartTime: start, EndTime: start.Add(time.Hour), Status: entity.SlotBooked}
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(1)).
+				Return(verifiedClient, nil).
+				Times(1)
+
+			test.orderRepo.EXPECT().
+				GetByID(gomock.Any(), order.ID).
+				Return(order, nil).
+				Times(1)
+
+			test.bookingRepo.EXPECT().
+				GetByID(gomock.Any(), order.BookingID).
+				Return(booking, nil).
+				Times(1)
+
+			test.slotRepo.EXPECT().
+				GetByID(gomock.Any(), booking.SlotID).
+				Return(slot, nil).
+				Times(1)
+
+			test.txManager.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).
+				Times(1)
+
+			test.orderRepo.EXPECT().
+				UpdateStatus(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, o *entity.Order) (*entity.Order, error) {
+					return o, nil
+				}).
+				Times(1)
+
+			test.bookingRepo.EXPECT().
+				Update(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, b *entity.Booking) (*entity.Booking, error) {
+					return b, nil
+				}).
+				Times(1)
+
+			test.slotRepo.EXPECT().
+				Update(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, s *entity.Slot) (*entity.Slot, error) {
+					return s, nil
+				}).
+				Times(1)
+
+			test.payments.EXPECT().
+				Release(gomock.Any(), order.ID).
+				Return(&entity.Payment{}, nil).
+				Times(1)
+
+			if tt.promoCodeID != nil {
+				test.promoCodes.EXPECT().
+					Release(gomock.Any(), booking.ID).
+					Return(tt.mockPromoErr).
+					Times(1)
+			}
+
+			if tt.expectedError == nil {
+				test.eventBroker.EXPECT().
+					Publish(gomock.Any(), gomock.Any()).
+					Times(1)
+			}
+
+			result, err := test.service.CancelOrderByClient(ctxClient, order.ID)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.OrderCancelled, result.Status)
+			assert.Equal(t, entity.BookingCancelled, booking.Status)
+			assert.Equal(t, entity.SlotAvailable, slot.Status)
+		})
+	}
+}
+
+func TestOrderService_CancelOrderByAdmin(t *testing.T) {
+	test := setUpOrderServiceTest(t)
+	defer test.ctrl.Finish()
+
+	promoID := int64(9)
+	order := &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit}
+	booking := &entity.Booking{ID: 2, SlotID: 3, Price: rub(100), PromoCodeID: &promoID}
+	slot := &entity.Slot{ID: 3, Status: entity.SlotBooked}
+
+	test.bookingRepo.EXPECT().
+		GetByID(gomock.Any(), order.BookingID).
+		Return(booking, nil).
+		Times(1)
+
+	test.slotRepo.EXPECT().
+		GetByID(gomock.Any(), booking.SlotID).
+		Return(slot, nil).
+		Times(1)
+
+	test.txManager.EXPECT().
+		WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Times(1)
+
+	test.orderRepo.EXPECT().
+		UpdateStatus(gomock.Any(), order).
+		Return(order, nil).
+		Times(1)
+
+	test.bookingRepo.EXPECT().
+		Update(gomock.Any(), booking).
+		Return(booking, nil).
+		Times(1)
+
+	test.slotRepo.EXPECT().
+		Update(gomock.Any(), slot).
+		Return(slot, nil).
+		Times(1)
+
+	test.payments.EXPECT().
+		Release(gomock.Any(), order.ID).
+		Return(&entity.Payment{OrderID: order.ID, Status: entity.PaymentVoided}, nil).
+		Times(1)
+
+	test.promoCodes.EXPECT().
+		Release(gomock.Any(), booking.ID).
+		Return(nil).
+		Times(1)
+
+	test.eventBroker.EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		Times(1)
+
+	result, err := test.service.CancelOrderByAdmin(context.Background(), order)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.OrderCancelled, result.Status)
+	assert.Equal(t, entity.BookingCancelled, booking.Status)
+	assert.Equal(t, entity.SlotAvailable, slot.Status)
+}
+
+func TestOrderService_ConfirmOrderByAdmin(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockBookingErr error
+		expectedError  error
+	}{
+		{
+			name: "order is completed and payment is captured",
+		},
+		{
+			name:           "booking not found",
+			mockBookingErr: persistence.ErrNoRowsFound,
+			expectedError:  service_errors.ErrBookingNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpOrderServiceTest(t)
+			defer test.ctrl.Finish()
+
+			order := &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit}
+			booking := &entity.Booking{ID: 2, Price: rub(100)}
+
+			test.bookingRepo.EXPECT().
+				GetByID(gomock.Any(), order.BookingID).
+				Return(booking, tt.mockBookingErr).
+				Times(1)
+
+			if tt.mockBookingErr == nil {
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.orderRepo.EXPECT().
+					UpdateStatus(gomock.Any(), order).
+					Return(order, nil).
+					Times(1)
+
+				test.payments.EXPECT().
+					Capture(gomock.Any(), order.ID).
+					Return(&entity.Payment{OrderID: order.ID, Status: entity.PaymentCaptured}, nil).
+					Times(1)
+
+				test.receipts.EXPECT().
+					IssueReceipt(gomock.Any(), order, gomock.Any()).
+					Return(&entity.Receipt{OrderID: order.ID}, nil).
+					Times(1)
+
+				test.eventBroker.EXPECT().
+					Publish(gomock.Any(), gomock.Any()).
+					Times(1)
+			}
+
+			result, err := test.service.ConfirmOrderByAdmin(context.Background(), order)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.OrderCompleted, result.Status)
+			assert.NotNil(t, result.ConfirmedAt)
+		})
+	}
+}
+
+func TestOrderService_RaiseOrderIssue(t *testing.T) {
+	test := setUpOrderServiceTest(t)
+	defer test.ctrl.Finish()
+
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedClient := &entity.User{
+		ID:         5,
+		AuthID:     int64(1),
+		IsVerified: true,
+	}
+
+	booking := &entity.Booking{
+		ID:       2,
+		ClientID: 5,
+	}
+
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		mockOrder     *entity.Order
+		expectUpdate  bool
+		expectedError error
+	}{
+		{
+			name:         "successful issue",
+			mockOrder:    &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderPendingConfirmation, ConfirmationDeadline: &future},
+			expectUpdate: true,
+		},
+		{
+			name:          "confirmation window is over",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderPendingConfirmation, ConfirmationDeadline: &past},
+			expectedError: service_errors.ErrCannotRaiseOrderIssue,
+		},
+		{
+			name:          "order is already completed",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderCompleted, ConfirmationDeadline: &future},
+			expectedError: service_errors.ErrCannotRaiseOrderIssue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(1)).
+				Return(verifiedClient, nil).
+				Times(1)
+
+			test.orderRepo.EXPECT().
+				GetByID(gomock.Any(), tt.mockOrder.ID).
+				Return(tt.mockOrder, nil).
+				Times(1)
+
+			test.bookingRepo.EXPECT().
+				GetByID(gomock.Any(), tt.mockOrder.BookingID).
+				Return(booking, nil).
+				Times(1)
+
+			if tt.expectUpdate {
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.disputes.EXPECT().
+					OpenDispute(gomock.Any(), tt.mockOrder.ID, entity.DisputeOther, "model did not arrive").
+					Return(&entity.Dispute{ID: 10, OrderID: tt.mockOrder.ID}, nil).
+					Times(1)
+
+				test.orderRepo.EXPECT().
+					UpdateStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, o *entity.Order) (*entity.Order, error) {
+						return o, nil
+					}).
+					Times(1)
+			}
+
+			result, err := test.service.RaiseOrderIssue(ctxClient, tt.mockOrder.ID, "model did not arrive")
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, entity.OrderDisputed, result.Status)
+				assert.Equal(t, "model did not arrive", *result.IssueReason)
+			}
+		})
+	}
+}
+
+func TestOrderService_AutoConfirmOrders(t *testing.T) {
+	test := setUpOrderServiceTest(t)
+	defer test.ctrl.Finish()
+
+	deadline := time.Now().Add(-time.Minute)
+	expired := func(id int64) *entity.Order {
+		return &entity.Order{ID: id, BookingID: id + 1, Status: entity.OrderPendingConfirmation,
+			ConfirmationDeadline: &deadline}
+	}
+
+	tests := []struct {
+		name           string
+		mockOrders     []*entity.Order
+		mockErr        error
+		mockCaptureErr map[int64]error
+		expectedCount  int
+		expectedError  error
+	}{
+		{
+			name:          "expired orders are confirmed and captured",
+			mockOrders:    []*entity.Order{expired(1), expired(3)},
+			expectedCount: 2,
+		},
+		{
+			name:           "failed capture does not hold the other orders back",
+			mockOrders:     []*entity.Order{expired(1), expired(3)},
+			mockCaptureErr: map[int64]error{1: service_errors.ErrPaymentProviderFailed},
+			expectedCount:  1,
+			expectedError:  service_errors.ErrPaymentProviderFailed,
+		},
+		{
+			name:          "nothing to confirm",
+			expectedCount: 0,
+		},
+		{
+			name:          "repo error",
+			mockErr:       errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.orderRepo.EXPECT().
+				GetExpiredConfirmations(gomock.Any(), gomock.Any()).
+				Return(tt.mockOrders, tt.mockErr).
+				Times(1)
+
+			for _, order := range tt.mockOrders {
+				test.bookingRepo.EXPECT().
+					GetByID(gomock.Any(), order.BookingID).
+					Return(&entity.Booking{ID: order.BookingID, Price: rub(100)}, nil).
+					Times(1)
+
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.orderRepo.EXPECT().
+					UpdateStatus(gomock.Any(), order).
+					DoAndReturn(func(_ context.Context, o *entity.Order) (*entity.Order, error) {
+						assert.Equal(t, entity.OrderCompleted, o.Status)
+						assert.NotNil(t, o.ConfirmedAt)
+
+						return o, nil
+					}).
+					Times(1)
+
+				test.payments.EXPECT().
+					Capture(gomock.Any(), order.ID).
+					Return(&entity.Payment{OrderID: order.ID, Status: entity.PaymentCaptured}, tt.mockCaptureErr[order.ID]).
+					Times(1)
+
+				if tt.mockCaptureErr[order.ID] == nil {
+					test.receipts.EXPECT().
+						IssueReceipt(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(&entity.Receipt{OrderID: order.ID}, nil).
+						Times(1)
+				}
+			}
+
+			test.eventBroker.EXPECT().
+				Publish(gomock.Any(), gomock.Any()).
+				Times(tt.expectedCount)
+
+			count, err := test.service.AutoConfirmOrders(context.Background())
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
 			}
+			assert.Equal(t, tt.expectedCount, count)
 		})
 	}
 }
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/broker"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type DefaultOrderTrackingService struct {
+	orderRepo        interfaces.OrderRepository
+	bookingRepo      interfaces.BookingRepository
+	userRepo         interfaces.UserRepository
+	modelServiceRepo interfaces.ModelServiceRepository
+	eventBroker      interfaces.OrderEventBroker
+	logger           pkg.Logger
+}
+
+func NewDefaultOrderTrackingService(orderRepo interfaces.OrderRepository, bookingRepo interfaces.BookingRepository,
+	userRepo interfaces.UserRepository, modelServiceRepo interfaces.ModelServiceRepository,
+	eventBroker interfaces.OrderEventBroker, logger pkg.Logger) *DefaultOrderTrackingService {
+	return &DefaultOrderTrackingService{
+		orderRepo:        orderRepo,
+		bookingRepo:      bookingRepo,
+		userRepo:         userRepo,
+		modelServiceRepo: modelServiceRepo,
+		eventBroker:      eventBroker,
+		logger:           logger,
+	}
+}
+
+func (d *DefaultOrderTrackingService) SubscribeOrderEvents(ctx context.Context, orderID int64,
+	lastEventID *int64) (*entity.Order, <-chan *entity.OrderEvent, func(), error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	client, err := d.checkClientRestrictions(ctx, authID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	order, booking, err := d.getOrderWithBooking(ctx, orderID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if booking.ClientID != client.ID {
+		d.logger.Error(ctx, "order is not owned by this client",
+			option.Any("order_id", orderID),
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrClientIsNotOwnerOfOrder))
+
+		return nil, nil, nil, service_errors.ErrClientIsNotOwnerOfOrder
+	}
+
+	events, unsubscribe, err := d.eventBroker.Subscribe(ctx, order.ID, lastEventID)
+	if err != nil {
+		if errors.Is(err, broker.ErrBrokerClosed) {
+			d.logger.Error(ctx, "order events broker is closed",
+				option.Any("order_id", orderID),
+				option.Error(service_errors.ErrOrderTrackingUnavailable))
+
+			return nil, nil, nil, service_errors.ErrOrderTrackingUnavailable
+		}
+
+		d.logger.Error(ctx, "failed to subscribe to order events",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return nil, nil, nil, err
+	}
+
+	return order, events, unsubscribe, nil
+}
+
+func (d *DefaultOrderTrackingService) PostOrderLocation(ctx context.Context, orderID int64,
+	latitude, longitude float64, eta *time.Time) (*entity.OrderEvent, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	order, booking, err := d.getOrderWithBooking(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.checkIfModelIsAnOwner(ctx, model.ID, booking.ModelServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.CanBeTracked() {
+		d.logger.Error(ctx, "order cannot be tracked",
+			option.Any("order_id", order.ID),
+			option.Any("status", order.Status),
+			option.Error(service_errors.ErrCannotTrackOrder))
+
+		return nil, service_errors.ErrCannotTrackOrder
+	}
+
+	event := entity.NewOrderLocationEvent(order, latitude, longitude, eta)
+	d.eventBroker.Publish(ctx, event)
+
+	return event, nil
+}
+
+func (d *DefaultOrderTrackingService) getOrderWithBooking(ctx context.Context,
+	orderID int64) (*entity.Order, *entity.Booking, error) {
+
+	order, err := d.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "order is not found by id",
+				option.Any("order_id", orderID),
+				option.Error(service_errors.ErrOrderNotFound))
+
+			return nil, nil, service_errors.ErrOrderNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get order by id",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return nil, nil, err
+	}
+
+	booking, err := d.bookingRepo.GetByID(ctx, order.BookingID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "booking is not found by id",
+				option.Any("booking_id", order.BookingID),
+				option.Error(service_errors.ErrBookingNotFound))
+
+			return nil, nil, service_errors.ErrBookingNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get booking by id",
+			option.Any("booking_id", order.BookingID),
+			option.Error(err))
+
+		return nil, nil, err
+	}
+
+	return order, booking, nil
+}
+
+func (d *DefaultOrderTrackingService) checkModelRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleModel.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAModel))
+
+		return nil, service_errors.ErrNotAModel
+	}
+
+	model, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotAModel))
+
+			return nil, service_errors.ErrNotAModel
+		}
+
+		d.logger.Error(ctx, "check model restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !model.IsUserVerified() {
+		d.logger.Error(ctx, "model is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedModel))
+
+		return nil, service_errors.ErrNotVerifiedModel
+	}
+
+	return model, nil
+}
+
+func (d *DefaultOrderTrackingService) checkClientRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleClient.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotClient))
+
+		return nil, service_errors.ErrNotClient
+	}
+
+	client, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "client is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotClient))
+
+			return nil, service_errors.ErrNotClient
+		}
+
+		d.logger.Error(ctx, "check client restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !client.IsUserVerified() {
+		d.logger.Error(ctx, "client is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedClient))
+
+		return nil, service_errors.ErrNotVerifiedClient
+	}
+
+	return client, nil
+}
+
+func (d *DefaultOrderTrackingService) checkIfModelIsAnOwner(ctx context.Context, modelID, modelServiceID int64) error {
+	service, err := d.modelServiceRepo.GetByID(ctx, modelServiceID, false)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model service is not found by id",
+				option.Any("model_service_id", modelServiceID),
+				option.Any("model_id", modelID),
+				option.Error(service_errors.ErrServiceIsNotFound))
+
+			return service_errors.ErrServiceIsNotFound
+		}
+
+		d.logger.Error(ctx, "check model is an owner failed",
+			option.Any("model_service_id", modelServiceID),
+			option.Any("model_id", modelID),
+			option.Error(err))
+
+		return err
+	}
+
+	if service.ModelID != modelID {
+		d.logger.Error(ctx, "model service is not owned by this model",
+			option.Any("model_id", modelID),
+			option.Any("model_service_id", modelServiceID),
+			option.Error(service_errors.ErrModelIsNotAnOwnerOfService))
+
+		return service_errors.ErrModelIsNotAnOwnerOfService
+	}
+
+	return nil
+}
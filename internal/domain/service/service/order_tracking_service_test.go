@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/broker"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type orderTrackingServiceTest struct {
+	ctrl             *gomock.Controller
+	orderRepo        *mocks.MockOrderRepository
+	bookingRepo      *mocks.MockBookingRepository
+	userRepo         *mocks.MockUserRepository
+	modelServiceRepo *mocks.MockModelServiceRepository
+	eventBroker      *mocks.MockOrderEventBroker
+	service          *DefaultOrderTrackingService
+}
+
+func setUpOrderTrackingServiceTest(t *testing.T) *orderTrackingServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	bookingRepo := mocks.NewMockBookingRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	modelServiceRepo := mocks.NewMockModelServiceRepository(ctrl)
+	eventBroker := mocks.NewMockOrderEventBroker(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orderTrackingService := NewDefaultOrderTrackingService(
+		orderRepo, bookingRepo, userRepo, modelServiceRepo, eventBroker, log,
+	)
+
+	return &orderTrackingServiceTest{
+		ctrl:             ctrl,
+		orderRepo:        orderRepo,
+		bookingRepo:      bookingRepo,
+		userRepo:         userRepo,
+		modelServiceRepo: modelServiceRepo,
+		eventBroker:      eventBroker,
+		service:          orderTrackingService,
+	}
+}
+
+func TestOrderTrackingService_SubscribeOrderEvents(t *testing.T) {
+	test := setUpOrderTrackingServiceTest(t)
+	defer test.ctrl.Finish()
+
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedClient := &entity.User{
+		ID:         5,
+		AuthID:     int64(1),
+		IsVerified: true,
+	}
+
+	order := &entity.Order{
+		ID:        1,
+		BookingID: 2,
+		Status:    entity.OrderConfirmed,
+	}
+
+	booking := &entity.Booking{
+		ID:       2,
+		ClientID: 5,
+	}
+
+	lastEventID := int64(3)
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		orderID        int64
+		lastEventID    *int64
+		mockClient     *entity.User
+		mockOrder      *entity.Order
+		mockOrderErr   error
+		mockBooking    *entity.Booking
+		mockSubscribe  error
+		expectedError  error
+		expectedStatus entity.OrderStatus
+	}{
+		{
+			name:           "successful subscription",
+			ctx:            ctxClient,
+			orderID:        1,
+			mockClient:     verifiedClient,
+			mockOrder:      order,
+			mockBooking:    booking,
+			expectedStatus: entity.OrderConfirmed,
+		},
+		{
+			name:           "successful reconnection with last event id",
+			ctx:            ctxClient,
+			orderID:        1,
+			lastEventID:    &lastEventID,
+			mockClient:     verifiedClient,
+			mockOrder:      order,
+			mockBooking:    booking,
+			expectedStatus: entity.OrderConfirmed,
+		},
+		{
+			name:          "not a client",
+			ctx:           ctxModel,
+			orderID:       1,
+			expectedError: service_errors.ErrNotClient,
+		},
+		{
+			name:          "order not found",
+			ctx:           ctxClient,
+			orderID:       1,
+			mockClient:    verifiedClient,
+			mockOrderErr:  persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrOrderNotFound,
+		},
+		{
+			name:          "client is not an owner",
+			ctx:           ctxClient,
+			orderID:       1,
+			mockClient:    verifiedClient,
+			mockOrder:     order,
+			mockBooking:   &entity.Booking{ID: 2, ClientID: 42},
+			expectedError: service_errors.ErrClientIsNotOwnerOfOrder,
+		},
+		{
+			name:          "broker is closed",
+			ctx:           ctxClient,
+			orderID:       1,
+			mockClient:    verifiedClient,
+			mockOrder:     order,
+			mockBooking:   booking,
+			mockSubscribe: broker.ErrBrokerClosed,
+			expectedError: service_errors.ErrOrderTrackingUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.ctx.Value(service_const.RoleKey) == "CLIENT" {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), int64(1)).
+					Return(tt.mockClient, nil).
+					Times(1)
+
+				test.orderRepo.EXPECT().
+					GetByID(gomock.Any(), tt.orderID).
+					Return(tt.mockOrder, tt.mockOrderErr).
+					Times(1)
+
+				if tt.mockOrderErr == nil {
+					test.bookingRepo.EXPECT().
+						GetByID(gomock.Any(), tt.mockOrder.BookingID).
+						Return(tt.mockBooking, nil).
+						Times(1)
+
+					if tt.mockBooking.ClientID == tt.mockClient.ID {
+						var events chan *entity.OrderEvent
+						var unsubscribe func()
+						if tt.mockSubscribe == nil {
+							events = make(chan *entity.OrderEvent)
+							unsubscribe = func() {}
+						}
+
+						test.eventBroker.EXPECT().
+							Subscribe(gomock.Any(), tt.orderID, tt.lastEventID).
+							Return(events, unsubscribe, tt.mockSubscribe).
+							Times(1)
+					}
+				}
+			}
+
+			res, events, unsubscribe, err := test.service.SubscribeOrderEvents(tt.ctx, tt.orderID, tt.lastEventID)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				assert.Nil(t, events)
+				assert.Nil(t, unsubscribe)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, events)
+				assert.NotNil(t, unsubscribe)
+				assert.Equal(t, tt.expectedStatus, res.Status)
+			}
+		})
+	}
+}
+
+func TestOrderTrackingService_PostOrderLocation(t *testing.T) {
+	test := setUpOrderTrackingServiceTest(t)
+	defer test.ctrl.Finish()
+
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{
+		ID:         1,
+		AuthID:     int64(1),
+		IsVerified: true,
+	}
+
+	booking := &entity.Booking{
+		ID:             2,
+		ModelServiceID: 3,
+		ClientID:       5,
+	}
+
+	tests := []struct {
+		name             string
+		orderID          int64
+		mockOrder        *entity.Order
+		mockModelService *entity.ModelService
+		expectPublish    bool
+		expectedError    error
+	}{
+		{
+			name:             "successful location ping",
+			orderID:          1,
+			mockOrder:        &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockModelService: &entity.ModelService{ID: 3, ModelID: 1},
+			expectPublish:    true,
+		},
+		{
+			name:             "model is not an owner",
+			orderID:          1,
+			mockOrder:        &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderInTransit},
+			mockModelService: &entity.ModelService{ID: 3, ModelID: 42},
+			expectedError:    service_errors.ErrModelIsNotAnOwnerOfService,
+		},
+		{
+			name:             "order is already completed",
+			orderID:          1,
+			mockOrder:        &entity.Order{ID: 1, BookingID: 2, Status: entity.OrderCompleted},
+			mockModelService: &entity.ModelService{ID: 3, ModelID: 1},
+			expectedError:    service_errors.ErrCannotTrackOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(1)).
+				Return(verifiedModel, nil).
+				Times(1)
+
+			test.orderRepo.EXPECT().
+				GetByID(gomock.Any(), tt.orderID).
+				Return(tt.mockOrder, nil).
+				Times(1)
+
+			test.bookingRepo.EXPECT().
+				GetByID(gomock.Any(), tt.mockOrder.BookingID).
+				Return(booking, nil).
+				Times(1)
+
+			test.modelServiceRepo.EXPECT().
+				GetByID(gomock.Any(), booking.ModelServiceID, false).
+				Return(tt.mockModelService, nil).
+				Times(1)
+
+			if tt.expectPublish {
+				test.eventBroker.EXPECT().
+					Publish(gomock.Any(), gomock.Any()).
+					Times(1)
+			}
+
+			res, err := test.service.PostOrderLocation(ctxModel, tt.orderID, 55.75, 37.61, nil)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, entity.OrderEventLocation, res.Type)
+				assert.Equal(t, tt.mockOrder.ID, res.OrderID)
+				assert.Equal(t, 55.75, *res.Latitude)
+				assert.Equal(t, 37.61, *res.Longitude)
+			}
+		})
+	}
+}
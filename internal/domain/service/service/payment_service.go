@@ -0,0 +1,505 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type DefaultPaymentService struct {
+	paymentRepo   interfaces.PaymentRepository
+	provider      interfaces.PaymentProvider
+	ledger        interfaces.LedgerPoster
+	txManager     database.TxManager
+	logger        pkg.Logger
+	webhookSecret []byte
+}
+
+func NewDefaultPaymentService(paymentRepo interfaces.PaymentRepository, provider interfaces.PaymentProvider,
+	ledger interfaces.LedgerPoster, txManager database.TxManager, logger pkg.Logger) (*DefaultPaymentService, error) {
+
+	secret := os.Getenv(service_const.DotEnvPaymentWebhookSecret)
+	if secret == "" {
+		return nil, service_errors.ErrLoadingWebhookSecret
+	}
+
+	return &DefaultPaymentService{
+		paymentRepo:   paymentRepo,
+		provider:      provider,
+		ledger:        ledger,
+		txManager:     txManager,
+		logger:        logger,
+		webhookSecret: []byte(secret),
+	}, nil
+}
+
+// Authorize holds the amount on the client's payment method when the order is created.
+func (d *DefaultPaymentService) Authorize(ctx context.Context, orderID int64, amount entity.Money) (*entity.Payment, error) {
+	providerPaymentID, err := d.provider.Authorize(ctx, "order_"+strconv.FormatInt(orderID, 10), amount)
+	if err != nil {
+		d.logger.Error(ctx, "payment is declined",
+			option.Any("order_id", orderID),
+			option.Any("provider", d.provider.Name()),
+			option.Error(err))
+
+		return nil, service_errors.ErrPaymentDeclined
+	}
+
+	payment := entity.NewPayment(orderID, d.provider.Name(), providerPaymentID, amount)
+	if err = d.paymentRepo.Save(ctx, payment); err != nil {
+		d.logger.Error(ctx, "failed to save payment",
+			option.Any("order_id", orderID),
+			option.Any("provider_payment_id", providerPaymentID),
+			option.Error(err))
+
+		if voidErr := d.VoidAuthorization(ctx, payment); voidErr != nil {
+			return nil, errors.Join(err, voidErr)
+		}
+
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// VoidAuthorization drops the hold of a payment whose row is not stored,
+// e.g. when the transaction saving it is rolled back after the provider has authorized the amount.
+func (d *DefaultPaymentService) VoidAuthorization(ctx context.Context, payment *entity.Payment) error {
+	if err := d.provider.Void(ctx, payment.ProviderPaymentID); err != nil {
+		d.logger.Error(ctx, "failed to void authorization",
+			option.Any("order_id", payment.OrderID),
+			option.Any("provider_payment_id", payment.ProviderPaymentID),
+			option.Error(err))
+
+		return service_errors.ErrPaymentProviderFailed
+	}
+
+	return nil
+}
+
+// AuthorizeExtra holds the amount on top of the authorized one, e.g. for an approved extension of the order.
+func (d *DefaultPaymentService) AuthorizeExtra(ctx context.Context, orderID int64,
+	amount entity.Money) (*entity.Payment, error) {
+
+	var res *entity.Payment
+	err := d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		payment, err := d.getOrderPayment(ctx, orderID)
+		if err != nil {
+			return err
+		}
+
+		if !payment.IsAuthorized() {
+			d.logger.Error(ctx, "payment cannot be increased",
+				option.Any("payment_id", payment.ID),
+				option.Any("status", payment.Status),
+				option.Error(service_errors.ErrInvalidPaymentState))
+
+			return service_errors.ErrInvalidPaymentState
+		}
+
+		if err = d.provider.IncreaseAuthorization(ctx, payment.ProviderPaymentID, amount); err != nil {
+			d.logger.Error(ctx, "extra amount is declined",
+				option.Any("payment_id", payment.ID),
+				option.Any("amount", amount),
+				option.Any("provider", d.provider.Name()),
+				option.Error(err))
+
+			return service_errors.ErrPaymentDeclined
+		}
+
+		if err = payment.AuthorizeExtra(amount); err != nil {
+			d.logger.Error(ctx, "failed to add extra amount",
+				option.Any("payment_id", payment.ID),
+				option.Any("amount", amount),
+				option.Error(err))
+
+			return err
+		}
+
+		res, err = d.updatePayment(ctx, payment)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Capture takes the authorized amount, an already captured payment is returned as is.
+func (d *DefaultPaymentService) Capture(ctx context.Context, orderID int64) (*entity.Payment, error) {
+	var res *entity.Payment
+	err := d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		payment, err := d.getOrderPayment(ctx, orderID)
+		if err != nil {
+			return err
+		}
+
+		if payment.IsCaptured() {
+			res = payment
+			return nil
+		}
+
+		if !payment.IsAuthorized() {
+			d.logger.Error(ctx, "payment cannot be captured",
+				option.Any("payment_id", payment.ID),
+				option.Any("status", payment.Status),
+				option.Error(service_errors.ErrInvalidPaymentState))
+
+			return service_errors.ErrInvalidPaymentState
+		}
+
+		if err = d.capture(ctx, payment); err != nil {
+			return err
+		}
+
+		res, err = d.updatePayment(ctx, payment)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Refund returns a part of the money, an authorized payment is captured first.
+func (d *DefaultPaymentService) Refund(ctx context.Context, orderID int64, amount entity.Money) (*entity.Payment, error) {
+	var res *entity.Payment
+	err := d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		payment, err := d.getOrderPayment(ctx, orderID)
+		if err != nil {
+			return err
+		}
+
+		if payment.IsAuthorized() {
+			if err = d.capture(ctx, payment); err != nil {
+				return err
+			}
+		}
+
+		refundable, err := payment.RefundableAmount()
+		if err != nil {
+			d.logger.Error(ctx, "failed to get refundable amount",
+				option.Any("payment_id", payment.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		exceeds, err := refundable.LessThan(amount)
+		if err != nil {
+			d.logger.Error(ctx, "failed to compare refund amount",
+				option.Any("payment_id", payment.ID),
+				option.Any("amount", amount),
+				option.Error(err))
+
+			return err
+		}
+
+		if !amount.IsPositive() || exceeds {
+			d.logger.Error(ctx, "refund exceeds the refundable amount",
+				option.Any("payment_id", payment.ID),
+				option.Any("amount", amount),
+				option.Any("refundable", refundable),
+				option.Error(service_errors.ErrInvalidRefundAmount))
+
+			return service_errors.ErrInvalidRefundAmount
+		}
+
+		if err = d.refund(ctx, payment, amount); err != nil {
+			return err
+		}
+
+		res, err = d.updatePayment(ctx, payment)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Release gives all the money back: an authorized payment is voided and a captured one is refunded.
+func (d *DefaultPaymentService) Release(ctx context.Context, orderID int64) (*entity.Payment, error) {
+	var res *entity.Payment
+	err := d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		payment, err := d.getOrderPayment(ctx, orderID)
+		if err != nil {
+			return err
+		}
+
+		refundable, err := payment.RefundableAmount()
+		if err != nil {
+			d.logger.Error(ctx, "failed to get refundable amount",
+				option.Any("payment_id", payment.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		switch {
+		case payment.IsAuthorized():
+			if err = d.provider.Void(ctx, payment.ProviderPaymentID); err != nil {
+				d.logger.Error(ctx, "failed to void payment",
+					option.Any("payment_id", payment.ID),
+					option.Error(err))
+
+				return service_errors.ErrPaymentProviderFailed
+			}
+			payment.Void()
+		case refundable.IsPositive():
+			if err = d.refund(ctx, payment, refundable); err != nil {
+				return err
+			}
+		default:
+			res = payment
+			return nil
+		}
+
+		res, err = d.updatePayment(ctx, payment)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// HandleWebhook applies a provider callback. Every event is stored by its id first,
+// so a repeated delivery does not change the payment again.
+func (d *DefaultPaymentService) HandleWebhook(ctx context.Context, secret string,
+	event *entity.PaymentEvent) (*entity.Payment, error) {
+
+	if subtle.ConstantTimeCompare([]byte(secret), d.webhookSecret) != 1 {
+		d.logger.Error(ctx, "invalid webhook secret",
+			option.Any("event_id", event.EventID),
+			option.Error(service_errors.ErrInvalidWebhookSecret))
+
+		return nil, service_errors.ErrInvalidWebhookSecret
+	}
+
+	event.Provider = d.provider.Name()
+
+	var res *entity.Payment
+	err := d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		duplicate := false
+		if err := d.paymentRepo.SaveEvent(ctx, event); err != nil {
+			if !errors.Is(err, persistence.ErrDuplicateKey) {
+				d.logger.Error(ctx, "failed to save payment event",
+					option.Any("event_id", event.EventID),
+					option.Error(err))
+
+				return err
+			}
+
+			d.logger.Info(ctx, "payment event is already handled",
+				option.Any("event_id", event.EventID))
+			duplicate = true
+		}
+
+		payment, err := d.paymentRepo.GetByProviderPaymentID(ctx, event.Provider, event.ProviderPaymentID)
+		if err != nil {
+			if errors.Is(err, persistence.ErrNoRowsFound) {
+				d.logger.Error(ctx, "payment is not found by provider payment id",
+					option.Any("provider_payment_id", event.ProviderPaymentID),
+					option.Error(service_errors.ErrPaymentNotFound))
+
+				return service_errors.ErrPaymentNotFound
+			}
+
+			d.logger.Error(ctx, "failed to get payment by provider payment id",
+				option.Any("provider_payment_id", event.ProviderPaymentID),
+				option.Error(err))
+
+			return err
+		}
+
+		capturedBefore, refundedBefore := payment.CapturedAmount, payment.RefundedAmount
+		if duplicate {
+			res = payment
+			return nil
+		}
+
+		applied, err := payment.Apply(event)
+		if err != nil {
+			d.logger.Error(ctx, "failed to apply payment event",
+				option.Any("event_id", event.EventID),
+				option.Any("payment_id", payment.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		if !applied {
+			res = payment
+			return nil
+		}
+
+		if err = d.postMovement(ctx, payment, capturedBefore, refundedBefore); err != nil {
+			return err
+		}
+
+		res, err = d.updatePayment(ctx, payment)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultPaymentService) GetOrderPayment(ctx context.Context, orderID int64) (*entity.Payment, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleAdmin.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAdmin))
+
+		return nil, service_errors.ErrNotAdmin
+	}
+
+	payment, err := d.getOrderPayment(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// capture and refund post to the ledger before calling the provider,
+// so a provider failure rolls the entries back together with the transaction.
+func (d *DefaultPaymentService) capture(ctx context.Context, payment *entity.Payment) error {
+	if err := d.ledger.PostOrderPayment(ctx, payment.OrderID, payment.Amount); err != nil {
+		return err
+	}
+
+	if err := d.provider.Capture(ctx, payment.ProviderPaymentID, payment.Amount); err != nil {
+		d.logger.Error(ctx, "failed to capture payment",
+			option.Any("payment_id", payment.ID),
+			option.Error(err))
+
+		return service_errors.ErrPaymentProviderFailed
+	}
+
+	payment.Capture(payment.Amount)
+
+	return nil
+}
+
+func (d *DefaultPaymentService) refund(ctx context.Context, payment *entity.Payment, amount entity.Money) error {
+	if err := d.ledger.PostRefund(ctx, payment.OrderID, amount); err != nil {
+		return err
+	}
+
+	if err := d.provider.Refund(ctx, payment.ProviderPaymentID, amount); err != nil {
+		d.logger.Error(ctx, "failed to refund payment",
+			option.Any("payment_id", payment.ID),
+			option.Any("amount", amount),
+			option.Error(err))
+
+		return service_errors.ErrPaymentProviderFailed
+	}
+
+	return payment.Refund(amount)
+}
+
+// postMovement records the money moved by a provider callback.
+func (d *DefaultPaymentService) postMovement(ctx context.Context, payment *entity.Payment,
+	capturedBefore, refundedBefore entity.Money) error {
+
+	captured, err := payment.CapturedAmount.Sub(capturedBefore)
+	if err != nil {
+		return err
+	}
+
+	if captured.IsPositive() {
+		if err = d.ledger.PostOrderPayment(ctx, payment.OrderID, captured); err != nil {
+			return err
+		}
+	}
+
+	refunded, err := payment.RefundedAmount.Sub(refundedBefore)
+	if err != nil {
+		return err
+	}
+
+	if refunded.IsPositive() {
+		if err = d.ledger.PostRefund(ctx, payment.OrderID, refunded); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *DefaultPaymentService) getOrderPayment(ctx context.Context, orderID int64) (*entity.Payment, error) {
+	payment, err := d.paymentRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "order has no payment",
+				option.Any("order_id", orderID),
+				option.Error(service_errors.ErrPaymentNotFound))
+
+			return nil, service_errors.ErrPaymentNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get payment by order id",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+func (d *DefaultPaymentService) updatePayment(ctx context.Context, payment *entity.Payment) (*entity.Payment, error) {
+	res, err := d.paymentRepo.Update(ctx, payment)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "payment is not found by id",
+				option.Any("payment_id", payment.ID),
+				option.Error(service_errors.ErrPaymentNotFound))
+
+			return nil, service_errors.ErrPaymentNotFound
+		}
+
+		d.logger.Error(ctx, "failed to update payment",
+			option.Any("payment_id", payment.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,756 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const paymentTestWebhookSecret = "webhook_secret"
+
+type paymentServiceTest struct {
+	ctrl        *gomock.Controller
+	paymentRepo *mocks.MockPaymentRepository
+	provider    *mocks.MockPaymentProvider
+	ledger      *mocks.MockLedgerPoster
+	txManager   *mocks.MockTxManager
+	service     *DefaultPaymentService
+}
+
+func setUpPaymentServiceTest(t *testing.T) *paymentServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	paymentRepo := mocks.NewMockPaymentRepository(ctrl)
+	provider := mocks.NewMockPaymentProvider(ctrl)
+	ledger := mocks.NewMockLedgerPoster(ctrl)
+	mockTxManager := mocks.NewMockTxManager(ctrl)
+
+	provider.EXPECT().Name().Return("fake").AnyTimes()
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(service_const.DotEnvPaymentWebhookSecret, paymentTestWebhookSecret)
+
+	paymentService, err := NewDefaultPaymentService(paymentRepo, provider, ledger, mockTxManager, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &paymentServiceTest{
+		ctrl:        ctrl,
+		paymentRepo: paymentRepo,
+		provider:    provider,
+		ledger:      ledger,
+		txManager:   mockTxManager,
+		service:     paymentService,
+	}
+}
+
+func TestNewDefaultPaymentService_MissingSecret(t *testing.T) {
+	t.Setenv(service_const.DotEnvPaymentWebhookSecret, "")
+
+	_, err := NewDefaultPaymentService(nil, nil, nil, nil, nil)
+	assert.ErrorIs(t, err, service_errors.ErrLoadingWebhookSecret)
+}
+
+func (test *paymentServiceTest) expectTransaction() {
+	test.txManager.EXPECT().
+		WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Times(1)
+}
+
+func TestPaymentService_Authorize(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        entity.Money
+		mockAuthErr   error
+		mockSaveErr   error
+		mockVoidErr   error
+		expectedError error
+	}{
+		{
+			name:   "successful authorization",
+			amount: rub(100),
+		},
+		{
+			name:          "provider declines",
+			amount:        rub(100),
+			mockAuthErr:   errors.New("card declined"),
+			expectedError: service_errors.ErrPaymentDeclined,
+		},
+		{
+			name:          "authorization is voided when payment is not saved",
+			amount:        rub(100),
+			mockSaveErr:   errors.New("database error"),
+			expectedError: errors.New("database error"),
+		},
+		{
+			name:          "void failure is reported with save error",
+			amount:        rub(100),
+			mockSaveErr:   errors.New("database error"),
+			mockVoidErr:   errors.New("provider is unavailable"),
+			expectedError: service_errors.ErrPaymentProviderFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPaymentServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.provider.EXPECT().
+				Authorize(gomock.Any(), "order_7", tt.amount).
+				Return("fake_order_7", tt.mockAuthErr).
+				Times(1)
+
+			if tt.mockAuthErr == nil {
+				test.paymentRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(tt.mockSaveErr).
+					Times(1)
+			}
+
+			if tt.mockSaveErr != nil {
+				test.provider.EXPECT().
+					Void(gomock.Any(), "fake_order_7").
+					Return(tt.mockVoidErr).
+					Times(1)
+			}
+
+			result, err := test.service.Authorize(context.Background(), 7, tt.amount)
+
+			if tt.expectedError != nil {
+				assert.ErrorContains(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, entity.PaymentAuthorized, result.Status)
+				assert.Equal(t, "fake_order_7", result.ProviderPaymentID)
+				assert.Equal(t, tt.amount, result.Amount)
+			}
+		})
+	}
+}
+
+func TestPaymentService_Capture(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockPayment    *entity.Payment
+		mockPaymentErr error
+		expectCapture  bool
+		mockLedgerErr  error
+		expectedStatus *entity.PaymentStatus
+		expectedError  error
+	}{
+		{
+			name:           "authorized payment is captured",
+			mockPayment:    &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), Status: entity.PaymentAuthorized},
+			expectCapture:  true,
+			expectedStatus: func() *entity.PaymentStatus { s := entity.PaymentCaptured; return &s }(),
+		},
+		{
+			name:           "captured payment is returned as is",
+			mockPayment:    &entity.Payment{ID: 1, OrderID: 7, Amount: rub(100), CapturedAmount: rub(100), Status: entity.PaymentCaptured},
+			expectedStatus: func() *entity.PaymentStatus { s := entity.PaymentCaptured; return &s }(),
+		},
+		{
+			name:          "ledger failure does not reach the provider",
+			mockPayment:   &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), Status: entity.PaymentAuthorized},
+			expectCapture: true,
+			mockLedgerErr: errors.New("database error"),
+			expectedError: errors.New("database error"),
+		},
+		{
+			name:           "order without payment",
+			mockPaymentErr: persistence.ErrNoRowsFound,
+			expectedError:  service_errors.ErrPaymentNotFound,
+		},
+		{
+			name:          "voided payment cannot be captured",
+			mockPayment:   &entity.Payment{ID: 1, OrderID: 7, Amount: rub(100), Status: entity.PaymentVoided},
+			expectedError: service_errors.ErrInvalidPaymentState,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPaymentServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.expectTransaction()
+
+			test.paymentRepo.EXPECT().
+				GetByOrderID(gomock.Any(), int64(7)).
+				Return(tt.mockPayment, tt.mockPaymentErr).
+				Times(1)
+
+			if tt.expectCapture {
+				test.ledger.EXPECT().
+					PostOrderPayment(gomock.Any(), int64(7), tt.mockPayment.Amount).
+					Return(tt.mockLedgerErr).
+					Times(1)
+			}
+
+			if tt.expectCapture && tt.mockLedgerErr == nil {
+				test.provider.EXPECT().
+					Capture(gomock.Any(), tt.mockPayment.ProviderPaymentID, tt.mockPayment.Amount).
+					Return(nil).
+					Times(1)
+
+				test.paymentRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *entity.Payment) (*entity.Payment, error) {
+						assert.Equal(t, p.Amount, p.CapturedAmount)
+						return p, nil
+					}).
+					Times(1)
+			}
+
+			result, err := test.service.Capture(context.Background(), 7)
+
+			switch {
+			case tt.expectedError != nil:
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, result)
+			case tt.expectedStatus == nil:
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedStatus, result.Status)
+			}
+		})
+	}
+}
+
+func TestPaymentService_AuthorizeExtra(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockPayment     *entity.Payment
+		mockPaymentErr  error
+		expectIncrease  bool
+		mockIncreaseErr error
+		expectedAmount  *entity.Money
+		expectedError   error
+	}{
+		{
+			name:           "extra amount is added to the authorized one",
+			mockPayment:    &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), Status: entity.PaymentAuthorized},
+			expectIncrease: true,
+			expectedAmount: func() *entity.Money { m := rub(150); return &m }(),
+		},
+		{
+			name:            "provider declines",
+			mockPayment:     &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), Status: entity.PaymentAuthorized},
+			expectIncrease:  true,
+			mockIncreaseErr: errors.New("card declined"),
+			expectedError:   service_errors.ErrPaymentDeclined,
+		},
+		{
+			name:          "captured payment cannot be increased",
+			mockPayment:   &entity.Payment{ID: 1, OrderID: 7, Amount: rub(100), CapturedAmount: rub(100), Status: entity.PaymentCaptured},
+			expectedError: service_errors.ErrInvalidPaymentState,
+		},
+		{
+			name:           "order without payment",
+			mockPaymentErr: persistence.ErrNoRowsFound,
+			expectedError:  service_errors.ErrPaymentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPaymentServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.expectTransaction()
+
+			test.paymentRepo.EXPECT().
+				GetByOrderID(gomock.Any(), int64(7)).
+				Return(tt.mockPayment, tt.mockPaymentErr).
+				Times(1)
+
+			if tt.expectIncrease {
+				test.provider.EXPECT().
+					IncreaseAuthorization(gomock.Any(), "fake_order_7", rub(50)).
+					Return(tt.mockIncreaseErr).
+					Times(1)
+			}
+
+			if tt.expectIncrease && tt.mockIncreaseErr == nil {
+				test.paymentRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *entity.Payment) (*entity.Payment, error) {
+						return p, nil
+					}).
+					Times(1)
+			}
+
+			result, err := test.service.AuthorizeExtra(context.Background(), 7, rub(50))
+
+			switch {
+			case tt.expectedError != nil:
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			case tt.expectedAmount == nil:
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, entity.PaymentAuthorized, result.Status)
+				assert.Equal(t, *tt.expectedAmount, result.Amount)
+			}
+		})
+	}
+}
+
+func TestPaymentService_CaptureWithExtension(t *testing.T) {
+	test := setUpPaymentServiceTest(t)
+	defer test.ctrl.Finish()
+
+	payment := &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100),
+		Status: entity.PaymentAuthorized}
+
+	test.txManager.EXPECT().
+		WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Times(2)
+
+	test.paymentRepo.EXPECT().
+		GetByOrderID(gomock.Any(), int64(7)).
+		Return(payment, nil).
+		Times(2)
+
+	test.paymentRepo.EXPECT().
+		Update(gomock.Any(), payment).
+		Return(payment, nil).
+		Times(2)
+
+	test.provider.EXPECT().
+		IncreaseAuthorization(gomock.Any(), "fake_order_7", rub(50)).
+		Return(nil).
+		Times(1)
+
+	test.ledger.EXPECT().
+		PostOrderPayment(gomock.Any(), int64(7), rub(150)).
+		Return(nil).
+		Times(1)
+
+	test.provider.EXPECT().
+		Capture(gomock.Any(), "fake_order_7", rub(150)).
+		Return(nil).
+		Times(1)
+
+	_, err := test.service.AuthorizeExtra(context.Background(), 7, rub(50))
+	assert.NoError(t, err)
+
+	result, err := test.service.Capture(context.Background(), 7)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.PaymentCaptured, result.Status)
+	assert.Equal(t, rub(150), result.CapturedAmount)
+}
+
+func TestPaymentService_Refund(t *testing.T) {
+	tests := []struct {
+		name           string
+		amount         entity.Money
+		mockPayment    *entity.Payment
+		expectCapture  bool
+		expectRefund   bool
+		expectedStatus entity.PaymentStatus
+		expectedError  error
+	}{
+		{
+			name:           "authorized payment is captured before refund",
+			amount:         rub(40),
+			mockPayment:    &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), Status: entity.PaymentAuthorized},
+			expectCapture:  true,
+			expectRefund:   true,
+			expectedStatus: entity.PaymentPartiallyRefunded,
+		},
+		{
+			name:   "rest of the partially refunded payment",
+			amount: rub(60),
+			mockPayment: &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), CapturedAmount: rub(100),
+				RefundedAmount: rub(40), Status: entity.PaymentPartiallyRefunded},
+			expectRefund:   true,
+			expectedStatus: entity.PaymentRefunded,
+		},
+		{
+			name:   "refund exceeds the captured amount",
+			amount: rub(70),
+			mockPayment: &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), CapturedAmount: rub(100),
+				RefundedAmount: rub(40), Status: entity.PaymentPartiallyRefunded},
+			expectedError: service_errors.ErrInvalidRefundAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPaymentServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.expectTransaction()
+
+			test.paymentRepo.EXPECT().
+				GetByOrderID(gomock.Any(), int64(7)).
+				Return(tt.mockPayment, nil).
+				Times(1)
+
+			if tt.expectCapture {
+				test.ledger.EXPECT().
+					PostOrderPayment(gomock.Any(), int64(7), tt.mockPayment.Amount).
+					Return(nil).
+					Times(1)
+
+				test.provider.EXPECT().
+					Capture(gomock.Any(), tt.mockPayment.ProviderPaymentID, tt.mockPayment.Amount).
+					Return(nil).
+					Times(1)
+			}
+
+			if tt.expectRefund {
+				test.ledger.EXPECT().
+					PostRefund(gomock.Any(), int64(7), tt.amount).
+					Return(nil).
+					Times(1)
+
+				test.provider.EXPECT().
+					Refund(gomock.Any(), tt.mockPayment.ProviderPaymentID, tt.amount).
+					Return(nil).
+					Times(1)
+
+				test.paymentRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *entity.Payment) (*entity.Payment, error) {
+						return p, nil
+					}).
+					Times(1)
+			}
+
+			result, err := test.service.Refund(context.Background(), 7, tt.amount)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, result.Status)
+			}
+		})
+	}
+}
+
+func TestPaymentService_Release(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockPayment    *entity.Payment
+		mockPaymentErr error
+		expectVoid     bool
+		expectRefund   *entity.Money
+		mockProvideErr error
+		expectedStatus entity.PaymentStatus
+		expectedError  error
+	}{
+		{
+			name:           "authorized payment is voided",
+			mockPayment:    &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), Status: entity.PaymentAuthorized},
+			expectVoid:     true,
+			expectedStatus: entity.PaymentVoided,
+		},
+		{
+			name: "captured payment is refunded in full",
+			mockPayment: &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), CapturedAmount: rub(100),
+				RefundedAmount: rub(30), Status: entity.PaymentPartiallyRefunded},
+			expectRefund:   func() *entity.Money { a := rub(70); return &a }(),
+			expectedStatus: entity.PaymentRefunded,
+		},
+		{
+			name:           "refunded payment is returned as is",
+			mockPayment:    &entity.Payment{ID: 1, OrderID: 7, Amount: rub(100), CapturedAmount: rub(100), RefundedAmount: rub(100), Status: entity.PaymentRefunded},
+			expectedStatus: entity.PaymentRefunded,
+		},
+		{
+			name:           "provider fails to void",
+			mockPayment:    &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), Status: entity.PaymentAuthorized},
+			expectVoid:     true,
+			mockProvideErr: errors.New("provider is unavailable"),
+			expectedError:  service_errors.ErrPaymentProviderFailed,
+		},
+		{
+			name:           "order without payment",
+			mockPaymentErr: persistence.ErrNoRowsFound,
+			expectedError:  service_errors.ErrPaymentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPaymentServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.expectTransaction()
+
+			test.paymentRepo.EXPECT().
+				GetByOrderID(gomock.Any(), int64(7)).
+				Return(tt.mockPayment, tt.mockPaymentErr).
+				Times(1)
+
+			if tt.expectVoid {
+				test.provider.EXPECT().
+					Void(gomock.Any(), tt.mockPayment.ProviderPaymentID).
+					Return(tt.mockProvideErr).
+					Times(1)
+			}
+
+			if tt.expectRefund != nil {
+				test.ledger.EXPECT().
+					PostRefund(gomock.Any(), int64(7), *tt.expectRefund).
+					Return(nil).
+					Times(1)
+
+				test.provider.EXPECT().
+					Refund(gomock.Any(), tt.mockPayment.ProviderPaymentID, *tt.expectRefund).
+					Return(tt.mockProvideErr).
+					Times(1)
+			}
+
+			if (tt.expectVoid || tt.expectRefund != nil) && tt.mockProvideErr == nil {
+				test.paymentRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *entity.Payment) (*entity.Payment, error) {
+						return p, nil
+					}).
+					Times(1)
+			}
+
+			result, err := test.service.Release(context.Background(), 7)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, result.Status)
+			}
+		})
+	}
+}
+
+func TestPaymentService_HandleWebhook(t *testing.T) {
+	refundAmount := rub(25)
+
+	tests := []struct {
+		name           string
+		secret         string
+		event          *entity.PaymentEvent
+		mockSaveErr    error
+		mockPayment    *entity.Payment
+		mockPaymentErr error
+		expectCaptured *entity.Money
+		expectRefunded *entity.Money
+		expectUpdate   bool
+		expectedStatus entity.PaymentStatus
+		expectedError  error
+	}{
+		{
+			name:   "capture event is applied",
+			secret: paymentTestWebhookSecret,
+			event:  &entity.PaymentEvent{EventID: "evt_1", ProviderPaymentID: "fake_order_7", Type: entity.PaymentEventCaptured},
+			mockPayment: &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100),
+				Status: entity.PaymentAuthorized},
+			expectCaptured: func() *entity.Money { a := rub(100); return &a }(),
+			expectUpdate:   true,
+			expectedStatus: entity.PaymentCaptured,
+		},
+		{
+			name:   "partial refund event is applied",
+			secret: paymentTestWebhookSecret,
+			event: &entity.PaymentEvent{EventID: "evt_2", ProviderPaymentID: "fake_order_7",
+				Type: entity.PaymentEventRefunded, Amount: &refundAmount},
+			mockPayment: &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), CapturedAmount: rub(100),
+				Status: entity.PaymentCaptured},
+			expectRefunded: &refundAmount,
+			expectUpdate:   true,
+			expectedStatus: entity.PaymentPartiallyRefunded,
+		},
+		{
+			name:        "repeated event does not change payment",
+			secret:      paymentTestWebhookSecret,
+			event:       &entity.PaymentEvent{EventID: "evt_1", ProviderPaymentID: "fake_order_7", Type: entity.PaymentEventCaptured},
+			mockSaveErr: persistence.ErrDuplicateKey,
+			mockPayment: &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), CapturedAmount: rub(100),
+				Status: entity.PaymentCaptured},
+			expectedStatus: entity.PaymentCaptured,
+		},
+		{
+			name:   "late void event is ignored",
+			secret: paymentTestWebhookSecret,
+			event:  &entity.PaymentEvent{EventID: "evt_3", ProviderPaymentID: "fake_order_7", Type: entity.PaymentEventVoided},
+			mockPayment: &entity.Payment{ID: 1, OrderID: 7, ProviderPaymentID: "fake_order_7", Amount: rub(100), CapturedAmount: rub(100),
+				Status: entity.PaymentCaptured},
+			expectedStatus: entity.PaymentCaptured,
+		},
+		{
+			name:           "payment is not found",
+			secret:         paymentTestWebhookSecret,
+			event:          &entity.PaymentEvent{EventID: "evt_4", ProviderPaymentID: "unknown", Type: entity.PaymentEventCaptured},
+			mockPaymentErr: persistence.ErrNoRowsFound,
+			expectedError:  service_errors.ErrPaymentNotFound,
+		},
+		{
+			name:          "invalid secret",
+			secret:        "guess",
+			event:         &entity.PaymentEvent{EventID: "evt_5", ProviderPaymentID: "fake_order_7", Type: entity.PaymentEventCaptured},
+			expectedError: service_errors.ErrInvalidWebhookSecret,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPaymentServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.secret == paymentTestWebhookSecret {
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.paymentRepo.EXPECT().
+					SaveEvent(gomock.Any(), tt.event).
+					DoAndReturn(func(_ context.Context, e *entity.PaymentEvent) error {
+						assert.Equal(t, "fake", e.Provider)
+						return tt.mockSaveErr
+					}).
+					Times(1)
+
+				test.paymentRepo.EXPECT().
+					GetByProviderPaymentID(gomock.Any(), "fake", tt.event.ProviderPaymentID).
+					Return(tt.mockPayment, tt.mockPaymentErr).
+					Times(1)
+			}
+
+			if tt.expectCaptured != nil {
+				test.ledger.EXPECT().
+					PostOrderPayment(gomock.Any(), int64(7), *tt.expectCaptured).
+					Return(nil).
+					Times(1)
+			}
+
+			if tt.expectRefunded != nil {
+				test.ledger.EXPECT().
+					PostRefund(gomock.Any(), int64(7), *tt.expectRefunded).
+					Return(nil).
+					Times(1)
+			}
+
+			if tt.expectUpdate {
+				test.paymentRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *entity.Payment) (*entity.Payment, error) {
+						return p, nil
+					}).
+					Times(1)
+			}
+
+			result, err := test.service.HandleWebhook(context.Background(), tt.secret, tt.event)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, result.Status)
+			}
+		})
+	}
+}
+
+func TestPaymentService_GetOrderPayment(t *testing.T) {
+	ctxAdmin := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxAdmin = context.WithValue(ctxAdmin, service_const.RoleKey, "ADMIN")
+
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(2))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		expectGet      bool
+		mockPayment    *entity.Payment
+		mockPaymentErr error
+		expectedError  error
+	}{
+		{
+			name:        "admin gets order payment",
+			ctx:         ctxAdmin,
+			expectGet:   true,
+			mockPayment: &entity.Payment{ID: 1, OrderID: 7, Status: entity.PaymentAuthorized},
+		},
+		{
+			name:           "order has no payment",
+			ctx:            ctxAdmin,
+			expectGet:      true,
+			mockPaymentErr: persistence.ErrNoRowsFound,
+			expectedError:  service_errors.ErrPaymentNotFound,
+		},
+		{
+			name:          "not an admin",
+			ctx:           ctxClient,
+			expectedError: service_errors.ErrNotAdmin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPaymentServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.expectGet {
+				test.paymentRepo.EXPECT().
+					GetByOrderID(gomock.Any(), int64(7)).
+					Return(tt.mockPayment, tt.mockPaymentErr).
+					Times(1)
+			}
+
+			result, err := test.service.GetOrderPayment(tt.ctx, 7)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockPayment, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,396 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type DefaultPricingRuleService struct {
+	pricingRuleRepo interfaces.PricingRuleRepository
+	userRepo        interfaces.UserRepository
+	logger          pkg.Logger
+	location        *time.Location
+}
+
+func NewDefaultPricingRuleService(pricingRuleRepo interfaces.PricingRuleRepository,
+	userRepo interfaces.UserRepository, logger pkg.Logger) (*DefaultPricingRuleService, error) {
+
+	timezone := os.Getenv(service_const.DotEnvPlatformTimezone)
+	if timezone == "" {
+		return nil, service_errors.ErrLoadingPlatformTimezone
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, service_errors.ErrParsingPlatformTimezone
+	}
+
+	return &DefaultPricingRuleService{
+		pricingRuleRepo: pricingRuleRepo,
+		userRepo:        userRepo,
+		logger:          logger,
+		location:        location,
+	}, nil
+}
+
+func (d *DefaultPricingRuleService) CreatePricingRule(ctx context.Context, name string,
+	ruleType entity.PricingRuleType, multiplier *float64, amount *entity.Money, weekdays []int,
+	startTime, endTime *string, holidays []time.Time, isActive bool) (*entity.PricingRule, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	startMinute, endMinute, err := parseWindow(startTime, endTime)
+	if err != nil {
+		d.logger.Error(ctx, "invalid pricing rule window",
+			option.Any("model_id", model.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	rule := entity.NewPricingRule(model.ID, name, ruleType, multiplier, amount,
+		weekdays, startMinute, endMinute, holidays)
+	rule.IsActive = isActive
+
+	if err = d.checkPayloadRestrictions(rule); err != nil {
+		d.logger.Error(ctx, "invalid pricing rule",
+			option.Any("model_id", model.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if err = d.pricingRuleRepo.Save(ctx, rule); err != nil {
+		d.logger.Error(ctx, "failed to save pricing rule",
+			option.Any("model_id", model.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (d *DefaultPricingRuleService) GetPricingRules(ctx context.Context,
+	page, limit *int64) ([]*entity.PricingRule, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.pricingRuleRepo.GetByModelID(ctx, model.ID, entity.NewOptions(common.CheckPagination(page, limit)))
+	if err != nil {
+		d.logger.Error(ctx, "failed to get pricing rules",
+			option.Any("model_id", model.ID),
+			option.Any("page", page),
+			option.Any("limit", limit),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultPricingRuleService) GetPricingRuleByID(ctx context.Context, id int64) (*entity.PricingRule, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getOwnPricingRule(ctx, id, model.ID)
+}
+
+// UpdatePricingRule replaces the rule, the bookings already made keep the surcharges they were priced with.
+func (d *DefaultPricingRuleService) UpdatePricingRule(ctx context.Context, id int64, name string,
+	ruleType entity.PricingRuleType, multiplier *float64, amount *entity.Money, weekdays []int,
+	startTime, endTime *string, holidays []time.Time, isActive bool) (*entity.PricingRule, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := d.getOwnPricingRule(ctx, id, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	startMinute, endMinute, err := parseWindow(startTime, endTime)
+	if err != nil {
+		d.logger.Error(ctx, "invalid pricing rule window",
+			option.Any("pricing_rule_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	rule.Name = name
+	rule.Type = ruleType
+	rule.Multiplier = multiplier
+	rule.Amount = amount
+	rule.Weekdays = weekdays
+	rule.StartMinute = startMinute
+	rule.EndMinute = endMinute
+	rule.Holidays = holidays
+	rule.IsActive = isActive
+
+	if err = d.checkPayloadRestrictions(rule); err != nil {
+		d.logger.Error(ctx, "invalid pricing rule",
+			option.Any("pricing_rule_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	res, err := d.pricingRuleRepo.Update(ctx, rule)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "pricing rule is not found by id",
+				option.Any("pricing_rule_id", id),
+				option.Error(service_errors.ErrPricingRuleNotFound))
+
+			return nil, service_errors.ErrPricingRuleNotFound
+		}
+
+		d.logger.Error(ctx, "failed to update pricing rule",
+			option.Any("pricing_rule_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultPricingRuleService) DeletePricingRule(ctx context.Context, id int64) error {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return err
+	}
+
+	if _, err = d.getOwnPricingRule(ctx, id, model.ID); err != nil {
+		return err
+	}
+
+	if err = d.pricingRuleRepo.Delete(ctx, id); err != nil {
+		if errors.Is(err, persistence.ErrNoRowsAffected) {
+			d.logger.Error(ctx, "pricing rule is not found by id",
+				option.Any("pricing_rule_id", id),
+				option.Error(service_errors.ErrPricingRuleNotFound))
+
+			return service_errors.ErrPricingRuleNotFound
+		}
+
+		d.logger.Error(ctx, "failed to delete pricing rule",
+			option.Any("pricing_rule_id", id),
+			option.Error(err))
+
+		return err
+	}
+
+	return nil
+}
+
+// Surcharges evaluates the active rules of the model against the slot in the time zone of the model.
+// Every rule is charged off the base price of the service, so the rules do not compound.
+func (d *DefaultPricingRuleService) Surcharges(ctx context.Context, service *entity.ModelService,
+	slot *entity.Slot) ([]entity.PriceComponent, error) {
+
+	rules, err := d.pricingRuleRepo.GetActiveByModelID(ctx, service.ModelID)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get active pricing rules",
+			option.Any("model_id", service.ModelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	location, err := modelLocation(ctx, d.userRepo, service.ModelID, d.location)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get time zone of model",
+			option.Any("model_id", service.ModelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	var res []entity.PriceComponent
+	for _, rule := range rules {
+		if rule.AppliesTo(slot.StartTime, slot.EndTime, location) {
+			res = append(res, entity.NewPriceComponent(rule, service.Price))
+		}
+	}
+
+	return res, nil
+}
+
+func (d *DefaultPricingRuleService) checkPayloadRestrictions(rule *entity.PricingRule) error {
+	switch rule.Type {
+	case entity.PricingRuleMultiplier:
+		if rule.Multiplier == nil || rule.Amount != nil || *rule.Multiplier <= 1 {
+			return service_errors.ErrInvalidPricingRuleCharge
+		}
+	case entity.PricingRuleSurcharge:
+		if rule.Amount == nil || rule.Multiplier != nil || !rule.Amount.IsPositive() {
+			return service_errors.ErrInvalidPricingRuleCharge
+		}
+		if rule.Amount.Currency != entity.DefaultCurrency {
+			return service_errors.ErrUnsupportedCurrency
+		}
+	default:
+		return service_errors.ErrInvalidPricingRuleCharge
+	}
+
+	for _, weekday := range rule.Weekdays {
+		if weekday < int(time.Sunday) || weekday > int(time.Saturday) {
+			return service_errors.ErrInvalidPricingRuleWindow
+		}
+	}
+
+	if rule.StartMinute != nil && (*rule.StartMinute >= entity.MinutesInDay || *rule.EndMinute == 0) {
+		return service_errors.ErrInvalidPricingRuleWindow
+	}
+
+	return nil
+}
+
+func (d *DefaultPricingRuleService) getOwnPricingRule(ctx context.Context,
+	id, modelID int64) (*entity.PricingRule, error) {
+
+	rule, err := d.pricingRuleRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "pricing rule is not found by id",
+				option.Any("pricing_rule_id", id),
+				option.Error(service_errors.ErrPricingRuleNotFound))
+
+			return nil, service_errors.ErrPricingRuleNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get pricing rule by id",
+			option.Any("pricing_rule_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if rule.ModelID != modelID {
+		d.logger.Error(ctx, "model is not an owner of pricing rule",
+			option.Any("pricing_rule_id", id),
+			option.Any("model_id", modelID),
+			option.Error(service_errors.ErrModelIsNotAnOwnerOfPricingRule))
+
+		return nil, service_errors.ErrModelIsNotAnOwnerOfPricingRule
+	}
+
+	return rule, nil
+}
+
+func (d *DefaultPricingRuleService) checkModelRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleModel.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAModel))
+
+		return nil, service_errors.ErrNotAModel
+	}
+
+	model, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotAModel))
+
+			return nil, service_errors.ErrNotAModel
+		}
+
+		d.logger.Error(ctx, "check model restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !model.IsUserVerified() {
+		d.logger.Error(ctx, "model is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedModel))
+
+		return nil, service_errors.ErrNotVerifiedModel
+	}
+
+	return model, nil
+}
+
+// parseWindow takes the time of day as HH:MM, a rule without both bounds lasts the whole day.
+func parseWindow(startTime, endTime *string) (*int, *int, error) {
+	if startTime == nil && endTime == nil {
+		return nil, nil, nil
+	}
+	if startTime == nil || endTime == nil {
+		return nil, nil, service_errors.ErrInvalidPricingRuleWindow
+	}
+
+	startMinute, err := entity.ParseTimeOfDay(*startTime)
+	if err != nil {
+		return nil, nil, service_errors.ErrInvalidPricingRuleWindow
+	}
+
+	endMinute, err := entity.ParseTimeOfDay(*endTime)
+	if err != nil {
+		return nil, nil, service_errors.ErrInvalidPricingRuleWindow
+	}
+
+	return &startMinute, &endMinute, nil
+}
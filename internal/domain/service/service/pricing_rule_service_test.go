@@ -0,0 +1,625 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type pricingRuleServiceTest struct {
+	ctrl            *gomock.Controller
+	pricingRuleRepo *mocks.MockPricingRuleRepository
+	userRepo        *mocks.MockUserRepository
+	service         *DefaultPricingRuleService
+}
+
+func setUpPricingRuleServiceTest(t *testing.T) *pricingRuleServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	pricingRuleRepo := mocks.NewMockPricingRuleRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = os.Setenv(service_const.DotEnvPlatformTimezone, "Europe/Moscow"); err != nil {
+		t.Fatal(err)
+	}
+
+	pricingRuleService, err := NewDefaultPricingRuleService(pricingRuleRepo, userRepo, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &pricingRuleServiceTest{
+		ctrl:            ctrl,
+		pricingRuleRepo: pricingRuleRepo,
+		userRepo:        userRepo,
+		service:         pricingRuleService,
+	}
+}
+
+func TestNewDefaultPricingRuleService_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		envValue      string
+		expectedError error
+	}{
+		{
+			name:          "timezone is not set",
+			envValue:      "",
+			expectedError: service_errors.ErrLoadingPlatformTimezone,
+		},
+		{
+			name:          "timezone is unknown",
+			envValue:      "Mars/Olympus",
+			expectedError: service_errors.ErrParsingPlatformTimezone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := os.Setenv(service_const.DotEnvPlatformTimezone, tt.envValue); err != nil {
+				t.Fatal(err)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfg := &config.LogConfig{}
+			cfg.Logger.Level = "info"
+			tmpDir := os.TempDir()
+			cfg.Logger.LogsDir = tmpDir
+			cfg.Logger.LogsFile = "test.log"
+			log, _ := pkg.NewDualLogger(cfg)
+
+			_, err := NewDefaultPricingRuleService(
+				mocks.NewMockPricingRuleRepository(ctrl),
+				mocks.NewMockUserRepository(ctrl),
+				log,
+			)
+
+			assert.EqualError(t, err, tt.expectedError.Error())
+		})
+	}
+}
+
+func TestPricingRuleService_CreatePricingRule(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(2))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+	unverifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: false}
+
+	multiplier := func(v float64) *float64 { return &v }
+	amount := func(v float64) *entity.Money {
+		m := rub(v)
+		return &m
+	}
+	clock := func(v string) *string { return &v }
+
+	tests := []struct {
+		name                string
+		ctx                 context.Context
+		mockUser            *entity.User
+		ruleType            entity.PricingRuleType
+		multiplier          *float64
+		amount              *entity.Money
+		weekdays            []int
+		startTime           *string
+		endTime             *string
+		expectSave          bool
+		mockSaveErr         error
+		expectedStartMinute *int
+		expectedEndMinute   *int
+		expectedError       error
+	}{
+		{
+			name:                "night multiplier is created",
+			ctx:                 ctxModel,
+			mockUser:            verifiedModel,
+			ruleType:            entity.PricingRuleMultiplier,
+			multiplier:          multiplier(1.5),
+			weekdays:            []int{5, 6},
+			startTime:           clock("22:00"),
+			endTime:             clock("06:00"),
+			expectSave:          true,
+			expectedStartMinute: func() *int { v := 22 * 60; return &v }(),
+			expectedEndMinute:   func() *int { v := 6 * 60; return &v }(),
+		},
+		{
+			name:       "whole day surcharge is created",
+			ctx:        ctxModel,
+			mockUser:   verifiedModel,
+			ruleType:   entity.PricingRuleSurcharge,
+			amount:     amount(500),
+			expectSave: true,
+		},
+		{
+			name:          "failed to save",
+			ctx:           ctxModel,
+			mockUser:      verifiedModel,
+			ruleType:      entity.PricingRuleSurcharge,
+			amount:        amount(500),
+			expectSave:    true,
+			mockSaveErr:   errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+		{
+			name:          "multiplier not above one",
+			ctx:           ctxModel,
+			mockUser:      verifiedModel,
+			ruleType:      entity.PricingRuleMultiplier,
+			multiplier:    multiplier(1),
+			expectedError: service_errors.ErrInvalidPricingRuleCharge,
+		},
+		{
+			name:          "surcharge with multiplier",
+			ctx:           ctxModel,
+			mockUser:      verifiedModel,
+			ruleType:      entity.PricingRuleSurcharge,
+			multiplier:    multiplier(1.5),
+			amount:        amount(500),
+			expectedError: service_errors.ErrInvalidPricingRuleCharge,
+		},
+		{
+			name:          "only start of window is given",
+			ctx:           ctxModel,
+			mockUser:      verifiedModel,
+			ruleType:      entity.PricingRuleSurcharge,
+			amount:        amount(500),
+			startTime:     clock("22:00"),
+			expectedError: service_errors.ErrInvalidPricingRuleWindow,
+		},
+		{
+			name:          "time of day out of range",
+			ctx:           ctxModel,
+			mockUser:      verifiedModel,
+			ruleType:      entity.PricingRuleSurcharge,
+			amount:        amount(500),
+			startTime:     clock("22:00"),
+			endTime:       clock("25:00"),
+			expectedError: service_errors.ErrInvalidPricingRuleWindow,
+		},
+		{
+			name:          "weekday out of range",
+			ctx:           ctxModel,
+			mockUser:      verifiedModel,
+			ruleType:      entity.PricingRuleSurcharge,
+			amount:        amount(500),
+			weekdays:      []int{7},
+			expectedError: service_errors.ErrInvalidPricingRuleWindow,
+		},
+		{
+			name:          "model is not verified",
+			ctx:           ctxModel,
+			mockUser:      unverifiedModel,
+			ruleType:      entity.PricingRuleSurcharge,
+			amount:        amount(500),
+			expectedError: service_errors.ErrNotVerifiedModel,
+		},
+		{
+			name:          "not a model",
+			ctx:           ctxClient,
+			ruleType:      entity.PricingRuleSurcharge,
+			amount:        amount(500),
+			expectedError: service_errors.ErrNotAModel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPricingRuleServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.mockUser != nil {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), tt.mockUser.AuthID).
+					Return(tt.mockUser, nil).
+					Times(1)
+			}
+
+			if tt.expectSave {
+				test.pricingRuleRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(tt.mockSaveErr).
+					Times(1)
+			}
+
+			res, err := test.service.CreatePricingRule(tt.ctx, "Night", tt.ruleType, tt.multiplier, tt.amount,
+				tt.weekdays, tt.startTime, tt.endTime, nil, true)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, verifiedModel.ID, res.ModelID)
+				assert.Equal(t, tt.expectedStartMinute, res.StartMinute)
+				assert.Equal(t, tt.expectedEndMinute, res.EndMinute)
+				assert.True(t, res.IsActive)
+			}
+		})
+	}
+}
+
+func TestPricingRuleService_UpdatePricingRule(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+	multiplier := 2.0
+
+	tests := []struct {
+		name          string
+		mockRule      *entity.PricingRule
+		mockGetErr    error
+		expectUpdate  bool
+		mockUpdateErr error
+		expectedError error
+	}{
+		{
+			name:         "rule is updated",
+			mockRule:     &entity.PricingRule{ID: 1, ModelID: 5, Type: entity.PricingRuleSurcharge},
+			expectUpdate: true,
+		},
+		{
+			name:          "rule is not found",
+			mockGetErr:    persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrPricingRuleNotFound,
+		},
+		{
+			name:          "rule of another model",
+			mockRule:      &entity.PricingRule{ID: 1, ModelID: 6, Type: entity.PricingRuleSurcharge},
+			expectedError: service_errors.ErrModelIsNotAnOwnerOfPricingRule,
+		},
+		{
+			name:          "rule is deleted concurrently",
+			mockRule:      &entity.PricingRule{ID: 1, ModelID: 5, Type: entity.PricingRuleSurcharge},
+			expectUpdate:  true,
+			mockUpdateErr: persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrPricingRuleNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPricingRuleServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+				Return(verifiedModel, nil).
+				Times(1)
+
+			test.pricingRuleRepo.EXPECT().
+				GetByID(gomock.Any(), int64(1)).
+				Return(tt.mockRule, tt.mockGetErr).
+				Times(1)
+
+			if tt.expectUpdate {
+				test.pricingRuleRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, rule *entity.PricingRule) (*entity.PricingRule, error) {
+						if tt.mockUpdateErr != nil {
+							return nil, tt.mockUpdateErr
+						}
+
+						return rule, nil
+					}).
+					Times(1)
+			}
+
+			res, err := test.service.UpdatePricingRule(ctxModel, 1, "Weekend", entity.PricingRuleMultiplier,
+				&multiplier, nil, []int{0, 6}, nil, nil, nil, false)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, entity.PricingRuleMultiplier, res.Type)
+				assert.Equal(t, []int{0, 6}, res.Weekdays)
+				assert.False(t, res.IsActive)
+			}
+		})
+	}
+}
+
+func TestPricingRuleService_DeletePricingRule(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+
+	tests := []struct {
+		name          string
+		mockRule      *entity.PricingRule
+		expectDelete  bool
+		mockDeleteErr error
+		expectedError error
+	}{
+		{
+			name:         "rule is deleted",
+			mockRule:     &entity.PricingRule{ID: 1, ModelID: 5},
+			expectDelete: true,
+		},
+		{
+			name:          "rule is already deleted",
+			mockRule:      &entity.PricingRule{ID: 1, ModelID: 5},
+			expectDelete:  true,
+			mockDeleteErr: persistence.ErrNoRowsAffected,
+			expectedError: service_errors.ErrPricingRuleNotFound,
+		},
+		{
+			name:          "rule of another model",
+			mockRule:      &entity.PricingRule{ID: 1, ModelID: 6},
+			expectedError: service_errors.ErrModelIsNotAnOwnerOfPricingRule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPricingRuleServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+				Return(verifiedModel, nil).
+				Times(1)
+
+			test.pricingRuleRepo.EXPECT().
+				GetByID(gomock.Any(), int64(1)).
+				Return(tt.mockRule, nil).
+				Times(1)
+
+			if tt.expectDelete {
+				test.pricingRuleRepo.EXPECT().
+					Delete(gomock.Any(), int64(1)).
+					Return(tt.mockDeleteErr).
+					Times(1)
+			}
+
+			err := test.service.DeletePricingRule(ctxModel, 1)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPricingRuleService_Surcharges(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	berlinZone := berlin.String()
+
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.October, day, hour, minute, 0, 0, moscow)
+	}
+	// Berlin clocks go forward on 29 March 2026 and back on 25 October 2026.
+	atBerlin := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2026, month, day, hour, minute, 0, 0, berlin)
+	}
+	minute := func(hour, minute int) *int {
+		v := hour*60 + minute
+		return &v
+	}
+	multiplier := 1.5
+	surcharge := rub(300)
+
+	// 23 October 2026 is a Friday.
+	fridayNight := &entity.PricingRule{
+		ID: 1, ModelID: 5, Name: "Friday night", Type: entity.PricingRuleMultiplier, Multiplier: &multiplier,
+		Weekdays: []int{int(time.Friday)}, StartMinute: minute(22, 0), EndMinute: minute(6, 0), IsActive: true,
+	}
+	weekend := &entity.PricingRule{
+		ID: 2, ModelID: 5, Name: "Weekend", Type: entity.PricingRuleSurcharge, Amount: &surcharge,
+		Weekdays: []int{int(time.Saturday), int(time.Sunday)}, IsActive: true,
+	}
+	saturdayNight := &entity.PricingRule{
+		ID: 4, ModelID: 5, Name: "Saturday night", Type: entity.PricingRuleMultiplier, Multiplier: &multiplier,
+		Weekdays: []int{int(time.Saturday)}, StartMinute: minute(22, 0), EndMinute: minute(6, 0), IsActive: true,
+	}
+	holiday := &entity.PricingRule{
+		ID: 3, ModelID: 5, Name: "Holiday", Type: entity.PricingRuleSurcharge, Amount: &surcharge,
+		Holidays: []time.Time{time.Date(2026, time.October, 21, 0, 0, 0, 0, time.UTC)}, IsActive: true,
+	}
+
+	modelService := &entity.ModelService{ID: 1, ModelID: 5, Price: rub(1000)}
+
+	tests := []struct {
+		name          string
+		slotStart     time.Time
+		slotEnd       time.Time
+		modelZone     *string
+		mockRules     []*entity.PricingRule
+		mockErr       error
+		expected      []entity.PriceComponent
+		expectedError error
+	}{
+		{
+			name:      "night window crosses midnight",
+			slotStart: at(24, 1, 0),
+			slotEnd:   at(24, 2, 0),
+			mockRules: []*entity.PricingRule{fridayNight},
+			expected: []entity.PriceComponent{
+				{PricingRuleID: 1, Name: "Friday night", Amount: rub(500)},
+			},
+		},
+		{
+			name:      "slot overlaps the start of the window",
+			slotStart: at(23, 21, 0),
+			slotEnd:   at(23, 23, 0),
+			mockRules: []*entity.PricingRule{fridayNight},
+			expected: []entity.PriceComponent{
+				{PricingRuleID: 1, Name: "Friday night", Amount: rub(500)},
+			},
+		},
+		{
+			name:      "slot ends when the window starts",
+			slotStart: at(23, 20, 0),
+			slotEnd:   at(23, 22, 0),
+			mockRules: []*entity.PricingRule{fridayNight},
+		},
+		{
+			name:      "saturday early hours are not a saturday window",
+			slotStart: at(24, 6, 0),
+			slotEnd:   at(24, 7, 0),
+			mockRules: []*entity.PricingRule{fridayNight},
+		},
+		{
+			name:      "multiplier and surcharge are charged off the base price",
+			slotStart: at(24, 5, 0),
+			slotEnd:   at(24, 7, 0),
+			mockRules: []*entity.PricingRule{fridayNight, weekend},
+			expected: []entity.PriceComponent{
+				{PricingRuleID: 1, Name: "Friday night", Amount: rub(500)},
+				{PricingRuleID: 2, Name: "Weekend", Amount: rub(300)},
+			},
+		},
+		{
+			name:      "weekday does not match",
+			slotStart: at(22, 12, 0),
+			slotEnd:   at(22, 13, 0),
+			mockRules: []*entity.PricingRule{weekend},
+		},
+		{
+			name:      "holiday is compared by platform date",
+			slotStart: at(21, 0, 30),
+			slotEnd:   at(21, 1, 30),
+			mockRules: []*entity.PricingRule{holiday},
+			expected: []entity.PriceComponent{
+				{PricingRuleID: 3, Name: "Holiday", Amount: rub(300)},
+			},
+		},
+		{
+			name:      "slot in utc is evaluated in platform time",
+			slotStart: at(23, 22, 30).UTC(),
+			slotEnd:   at(23, 23, 30).UTC(),
+			mockRules: []*entity.PricingRule{fridayNight},
+			expected: []entity.PriceComponent{
+				{PricingRuleID: 1, Name: "Friday night", Amount: rub(500)},
+			},
+		},
+		{
+			name:      "weekend is judged in the time zone of the model",
+			slotStart: atBerlin(time.October, 23, 23, 0),
+			slotEnd:   atBerlin(time.October, 23, 23, 30),
+			modelZone: &berlinZone,
+			mockRules: []*entity.PricingRule{weekend},
+		},
+		{
+			name:      "same slot is a weekend one in platform time",
+			slotStart: atBerlin(time.October, 23, 23, 0),
+			slotEnd:   atBerlin(time.October, 23, 23, 30),
+			mockRules: []*entity.PricingRule{weekend},
+			expected: []entity.PriceComponent{
+				{PricingRuleID: 2, Name: "Weekend", Amount: rub(300)},
+			},
+		},
+		{
+			name:      "night window keeps its local end when clocks go back",
+			slotStart: atBerlin(time.October, 25, 5, 15),
+			slotEnd:   atBerlin(time.October, 25, 5, 45),
+			modelZone: &berlinZone,
+			mockRules: []*entity.PricingRule{saturdayNight},
+			expected: []entity.PriceComponent{
+				{PricingRuleID: 4, Name: "Saturday night", Amount: rub(500)},
+			},
+		},
+		{
+			name:      "slot after the local end of the longer night",
+			slotStart: atBerlin(time.October, 25, 6, 0),
+			slotEnd:   atBerlin(time.October, 25, 7, 0),
+			modelZone: &berlinZone,
+			mockRules: []*entity.PricingRule{saturdayNight},
+		},
+		{
+			name:      "night window keeps its local end when clocks go forward",
+			slotStart: atBerlin(time.March, 29, 5, 15),
+			slotEnd:   atBerlin(time.March, 29, 5, 45),
+			modelZone: &berlinZone,
+			mockRules: []*entity.PricingRule{saturdayNight},
+			expected: []entity.PriceComponent{
+				{PricingRuleID: 4, Name: "Saturday night", Amount: rub(500)},
+			},
+		},
+		{
+			name:      "slot after the local end of the shorter night",
+			slotStart: atBerlin(time.March, 29, 6, 15),
+			slotEnd:   atBerlin(time.March, 29, 6, 45),
+			modelZone: &berlinZone,
+			mockRules: []*entity.PricingRule{saturdayNight},
+		},
+		{
+			name:          "failed to get rules",
+			slotStart:     at(23, 22, 30),
+			slotEnd:       at(23, 23, 30),
+			mockErr:       errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPricingRuleServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.pricingRuleRepo.EXPECT().
+				GetActiveByModelID(gomock.Any(), modelService.ModelID).
+				Return(tt.mockRules, tt.mockErr).
+				Times(1)
+
+			if len(tt.mockRules) > 0 {
+				test.userRepo.EXPECT().
+					GetByID(gomock.Any(), modelService.ModelID).
+					Return(&entity.User{ID: modelService.ModelID, TimeZone: tt.modelZone}, nil).
+					Times(1)
+			}
+
+			slot := &entity.Slot{ID: 1, StartTime: tt.slotStart, EndTime: tt.slotEnd}
+			res, err := test.service.Surcharges(context.Background(), modelService, slot)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type DefaultPromoCodeService struct {
+	promoCodeRepo interfaces.PromoCodeRepository
+	txManager     database.TxManager
+	logger        pkg.Logger
+}
+
+func NewDefaultPromoCodeService(promoCodeRepo interfaces.PromoCodeRepository,
+	txManager database.TxManager, logger pkg.Logger) *DefaultPromoCodeService {
+	return &DefaultPromoCodeService{
+		promoCodeRepo: promoCodeRepo,
+		txManager:     txManager,
+		logger:        logger,
+	}
+}
+
+func (d *DefaultPromoCodeService) CreatePromoCode(ctx context.Context, code string, discountType entity.DiscountType,
+	percentOff *int, amountOff *entity.Money, validFrom, validUntil *time.Time, maxUses, maxUsesPerClient *int,
+	serviceIDs, modelIDs []int64) (*entity.PromoCode, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.checkAdminRestrictions(ctx, authID); err != nil {
+		return nil, err
+	}
+
+	promo := entity.NewPromoCode(code, discountType, percentOff, amountOff,
+		validFrom, validUntil, maxUses, maxUsesPerClient, serviceIDs, modelIDs)
+
+	if err = d.checkPayloadRestrictions(promo); err != nil {
+		d.logger.Error(ctx, "invalid promo code",
+			option.Any("code", promo.Code),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if err = d.promoCodeRepo.Save(ctx, promo); err != nil {
+		if errors.Is(err, persistence.ErrDuplicateKey) {
+			d.logger.Error(ctx, "promo code already exists",
+				option.Any("code", promo.Code),
+				option.Error(service_errors.ErrPromoCodeAlreadyExists))
+
+			return nil, service_errors.ErrPromoCodeAlreadyExists
+		}
+
+		d.logger.Error(ctx, "failed to save promo code",
+			option.Any("code", promo.Code),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+func (d *DefaultPromoCodeService) GetPromoCodes(ctx context.Context,
+	page, limit *int64) ([]*entity.PromoCode, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.checkAdminRestrictions(ctx, authID); err != nil {
+		return nil, err
+	}
+
+	res, err := d.promoCodeRepo.GetAll(ctx, entity.NewOptions(common.CheckPagination(page, limit)))
+	if err != nil {
+		d.logger.Error(ctx, "failed to get promo codes",
+			option.Any("page", page),
+			option.Any("limit", limit),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultPromoCodeService) GetPromoCodeByID(ctx context.Context, id int64) (*entity.PromoCode, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.checkAdminRestrictions(ctx, authID); err != nil {
+		return nil, err
+	}
+
+	return d.getPromoCode(ctx, id)
+}
+
+// DeactivatePromoCode stops new bookings with the code, the bookings already made keep their discount.
+func (d *DefaultPromoCodeService) DeactivatePromoCode(ctx context.Context, id int64) (*entity.PromoCode, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.checkAdminRestrictions(ctx, authID); err != nil {
+		return nil, err
+	}
+
+	promo, err := d.getPromoCode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	promo.IsActive = false
+	res, err := d.promoCodeRepo.Update(ctx, promo)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "promo code is not found by id",
+				option.Any("promo_code_id", id),
+				option.Error(service_errors.ErrPromoCodeNotFound))
+
+			return nil, service_errors.ErrPromoCodeNotFound
+		}
+
+		d.logger.Error(ctx, "failed to update promo code",
+			option.Any("promo_code_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Validate checks the code before the booking is made, the limits are checked
+// once more under the lock when the code is redeemed.
+func (d *DefaultPromoCodeService) Validate(ctx context.Context, code string, clientID int64,
+	service *entity.ModelService) (*entity.PromoCode, error) {
+
+	promo, err := d.promoCodeRepo.GetByCode(ctx, entity.NormalizePromoCode(code))
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "promo code is not found by code",
+				option.Any("client_id", clientID),
+				option.Error(service_errors.ErrPromoCodeNotFound))
+
+			return nil, service_errors.ErrPromoCodeNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get promo code by code",
+			option.Any("client_id", clientID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !promo.IsValidAt(time.Now()) {
+		d.logger.Error(ctx, "promo code is not valid",
+			option.Any("promo_code_id", promo.ID),
+			option.Any("client_id", clientID),
+			option.Error(service_errors.ErrPromoCodeNotValid))
+
+		return nil, service_errors.ErrPromoCodeNotValid
+	}
+
+	if !promo.IsApplicableTo(service) {
+		d.logger.Error(ctx, "promo code is not applicable to service",
+			option.Any("promo_code_id", promo.ID),
+			option.Any("model_service_id", service.ID),
+			option.Error(service_errors.ErrPromoCodeNotApplicable))
+
+		return nil, service_errors.ErrPromoCodeNotApplicable
+	}
+
+	if promo.IsExhausted() {
+		d.logger.Error(ctx, "promo code is exhausted",
+			option.Any("promo_code_id", promo.ID),
+			option.Error(service_errors.ErrPromoCodeUsageLimitReached))
+
+		return nil, service_errors.ErrPromoCodeUsageLimitReached
+	}
+
+	if err = d.checkClientLimit(ctx, promo, clientID); err != nil {
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+// Redeem counts the use of the code. It is called in the booking transaction: the usage counter
+// is taken first, so the row lock serializes concurrent redemptions before the client limit is counted.
+func (d *DefaultPromoCodeService) Redeem(ctx context.Context, promo *entity.PromoCode, clientID, bookingID int64) error {
+	return d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := d.promoCodeRepo.IncrementUsage(ctx, promo.ID); err != nil {
+			if errors.Is(err, persistence.ErrNoRowsFound) {
+				d.logger.Error(ctx, "promo code usage limit is reached",
+					option.Any("promo_code_id", promo.ID),
+					option.Any("booking_id", bookingID),
+					option.Error(service_errors.ErrPromoCodeUsageLimitReached))
+
+				return service_errors.ErrPromoCodeUsageLimitReached
+			}
+
+			d.logger.Error(ctx, "failed to increment promo code usage",
+				option.Any("promo_code_id", promo.ID),
+				option.Any("booking_id", bookingID),
+				option.Error(err))
+
+			return err
+		}
+
+		if err := d.checkClientLimit(ctx, promo, clientID); err != nil {
+			return err
+		}
+
+		if err := d.promoCodeRepo.SaveRedemption(ctx, entity.NewPromoRedemption(promo.ID, clientID, bookingID)); err != nil {
+			d.logger.Error(ctx, "failed to save promo redemption",
+				option.Any("promo_code_id", promo.ID),
+				option.Any("booking_id", bookingID),
+				option.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+// Release gives the use of the code back when the booking is rejected, cancelled or expired.
+func (d *DefaultPromoCodeService) Release(ctx context.Context, bookingID int64) error {
+	if err := d.promoCodeRepo.ReleaseRedemption(ctx, bookingID, time.Now()); err != nil {
+		d.logger.Error(ctx, "failed to release promo redemption",
+			option.Any("booking_id", bookingID),
+			option.Error(err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (d *DefaultPromoCodeService) checkClientLimit(ctx context.Context, promo *entity.PromoCode, clientID int64) error {
+	if promo.MaxUsesPerClient == nil {
+		return nil
+	}
+
+	used, err := d.promoCodeRepo.CountActiveRedemptions(ctx, promo.ID, clientID)
+	if err != nil {
+		d.logger.Error(ctx, "failed to count promo redemptions",
+			option.Any("promo_code_id", promo.ID),
+			option.Any("client_id", clientID),
+			option.Error(err))
+
+		return err
+	}
+
+	if used >= *promo.MaxUsesPerClient {
+		d.logger.Error(ctx, "promo code client limit is reached",
+			option.Any("promo_code_id", promo.ID),
+			option.Any("client_id", clientID),
+			option.Error(service_errors.ErrPromoCodeClientLimitReached))
+
+		return service_errors.ErrPromoCodeClientLimitReached
+	}
+
+	return nil
+}
+
+func (d *DefaultPromoCodeService) checkPayloadRestrictions(promo *entity.PromoCode) error {
+	switch promo.DiscountType {
+	case entity.DiscountPercent:
+		if promo.PercentOff == nil || promo.AmountOff != nil || *promo.PercentOff < 1 || *promo.PercentOff > 100 {
+			return service_errors.ErrInvalidPromoCodeDiscount
+		}
+	case entity.DiscountFixed:
+		if promo.AmountOff == nil || promo.PercentOff != nil || !promo.AmountOff.IsPositive() {
+			return service_errors.ErrInvalidPromoCodeDiscount
+		}
+		if promo.AmountOff.Currency != entity.DefaultCurrency {
+			return service_errors.ErrUnsupportedCurrency
+		}
+	default:
+		return service_errors.ErrInvalidPromoCodeDiscount
+	}
+
+	if promo.ValidFrom != nil && promo.ValidUntil != nil && !promo.ValidFrom.Before(*promo.ValidUntil) {
+		return service_errors.ErrInvalidPromoCodeWindow
+	}
+
+	if (promo.MaxUses != nil && *promo.MaxUses < 1) ||
+		(promo.MaxUsesPerClient != nil && *promo.MaxUsesPerClient < 1) {
+		return service_errors.ErrInvalidPromoCodeLimit
+	}
+
+	return nil
+}
+
+func (d *DefaultPromoCodeService) getPromoCode(ctx context.Context, id int64) (*entity.PromoCode, error) {
+	promo, err := d.promoCodeRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "promo code is not found by id",
+				option.Any("promo_code_id", id),
+				option.Error(service_errors.ErrPromoCodeNotFound))
+
+			return nil, service_errors.ErrPromoCodeNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get promo code by id",
+			option.Any("promo_code_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+func (d *DefaultPromoCodeService) checkAdminRestrictions(ctx context.Context, authID *int64) error {
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *role != entity.RoleAdmin.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAdmin))
+
+		return service_errors.ErrNotAdmin
+	}
+
+	return nil
+}
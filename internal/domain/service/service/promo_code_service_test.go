@@ -0,0 +1,442 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type promoCodeServiceTest struct {
+	ctrl          *gomock.Controller
+	promoCodeRepo *mocks.MockPromoCodeRepository
+	txManager     *mocks.MockTxManager
+	service       *DefaultPromoCodeService
+}
+
+func setUpPromoCodeServiceTest(t *testing.T) *promoCodeServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	promoCodeRepo := mocks.NewMockPromoCodeRepository(ctrl)
+	mockTxManager := mocks.NewMockTxManager(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &promoCodeServiceTest{
+		ctrl:          ctrl,
+		promoCodeRepo: promoCodeRepo,
+		txManager:     mockTxManager,
+		service:       NewDefaultPromoCodeService(promoCodeRepo, mockTxManager, log),
+	}
+}
+
+func TestPromoCodeService_CreatePromoCode(t *testing.T) {
+	ctxAdmin := context.WithValue(context.Background(), service_const.AuthIDKey, int64(3))
+	ctxAdmin = context.WithValue(ctxAdmin, service_const.RoleKey, "ADMIN")
+
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	percent := func(v int) *int { return &v }
+	amount := func(v float64) *entity.Money {
+		m := rub(v)
+		return &m
+	}
+	now := time.Now()
+	later := now.Add(24 * time.Hour)
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		code          string
+		discountType  entity.DiscountType
+		percentOff    *int
+		amountOff     *entity.Money
+		validFrom     *time.Time
+		validUntil    *time.Time
+		maxUses       *int
+		expectSave    bool
+		mockSaveErr   error
+		expectedCode  string
+		expectedError error
+	}{
+		{
+			name:         "percent code is created upper-cased",
+			ctx:          ctxAdmin,
+			code:         " spring20 ",
+			discountType: entity.DiscountPercent,
+			percentOff:   percent(20),
+			validFrom:    &now,
+			validUntil:   &later,
+			expectSave:   true,
+			expectedCode: "SPRING20",
+		},
+		{
+			name:         "fixed code is created",
+			ctx:          ctxAdmin,
+			code:         "MINUS500",
+			discountType: entity.DiscountFixed,
+			amountOff:    amount(500),
+			maxUses:      percent(10),
+			expectSave:   true,
+			expectedCode: "MINUS500",
+		},
+		{
+			name:          "code already exists",
+			ctx:           ctxAdmin,
+			code:          "SPRING20",
+			discountType:  entity.DiscountPercent,
+			percentOff:    percent(20),
+			expectSave:    true,
+			mockSaveErr:   persistence.ErrDuplicateKey,
+			expectedError: service_errors.ErrPromoCodeAlreadyExists,
+		},
+		{
+			name:          "percent over 100",
+			ctx:           ctxAdmin,
+			code:          "TOOMUCH",
+			discountType:  entity.DiscountPercent,
+			percentOff:    percent(101),
+			expectedError: service_errors.ErrInvalidPromoCodeDiscount,
+		},
+		{
+			name:          "percent code with amount",
+			ctx:           ctxAdmin,
+			code:          "BOTH",
+			discountType:  entity.DiscountPercent,
+			percentOff:    percent(10),
+			amountOff:     amount(100),
+			expectedError: service_errors.ErrInvalidPromoCodeDiscount,
+		},
+		{
+			name:          "fixed code without amount",
+			ctx:           ctxAdmin,
+			code:          "NOAMOUNT",
+			discountType:  entity.DiscountFixed,
+			expectedError: service_errors.ErrInvalidPromoCodeDiscount,
+		},
+		{
+			name:          "window ends before it starts",
+			ctx:           ctxAdmin,
+			code:          "BACKWARDS",
+			discountType:  entity.DiscountPercent,
+			percentOff:    percent(10),
+			validFrom:     &later,
+			validUntil:    &now,
+			expectedError: service_errors.ErrInvalidPromoCodeWindow,
+		},
+		{
+			name:          "zero max uses",
+			ctx:           ctxAdmin,
+			code:          "ZEROUSES",
+			discountType:  entity.DiscountPercent,
+			percentOff:    percent(10),
+			maxUses:       percent(0),
+			expectedError: service_errors.ErrInvalidPromoCodeLimit,
+		},
+		{
+			name:          "not an admin",
+			ctx:           ctxClient,
+			code:          "SPRING20",
+			discountType:  entity.DiscountPercent,
+			percentOff:    percent(20),
+			expectedError: service_errors.ErrNotAdmin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPromoCodeServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.expectSave {
+				test.promoCodeRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *entity.PromoCode) error {
+						p.ID = 1
+						return tt.mockSaveErr
+					}).
+					Times(1)
+			}
+
+			result, err := test.service.CreatePromoCode(tt.ctx, tt.code, tt.discountType, tt.percentOff, tt.amountOff,
+				tt.validFrom, tt.validUntil, tt.maxUses, nil, nil, nil)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCode, result.Code)
+				assert.True(t, result.IsActive)
+			}
+		})
+	}
+}
+
+func TestPromoCodeService_DeactivatePromoCode(t *testing.T) {
+	ctxAdmin := context.WithValue(context.Background(), service_const.AuthIDKey, int64(3))
+	ctxAdmin = context.WithValue(ctxAdmin, service_const.RoleKey, "ADMIN")
+
+	tests := []struct {
+		name          string
+		mockGetErr    error
+		expectedError error
+	}{
+		{
+			name: "code is deactivated",
+		},
+		{
+			name:          "code not found",
+			mockGetErr:    persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrPromoCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPromoCodeServiceTest(t)
+			defer test.ctrl.Finish()
+
+			var mockPromo *entity.PromoCode
+			if tt.mockGetErr == nil {
+				mockPromo = &entity.PromoCode{ID: 1, Code: "SPRING20", IsActive: true}
+			}
+
+			test.promoCodeRepo.EXPECT().
+				GetByID(gomock.Any(), int64(1)).
+				Return(mockPromo, tt.mockGetErr).
+				Times(1)
+
+			if tt.mockGetErr == nil {
+				test.promoCodeRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *entity.PromoCode) (*entity.PromoCode, error) {
+						return p, nil
+					}).
+					Times(1)
+			}
+
+			result, err := test.service.DeactivatePromoCode(ctxAdmin, 1)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.False(t, result.IsActive)
+			}
+		})
+	}
+}
+
+func TestPromoCodeService_Validate(t *testing.T) {
+	percentOff := 20
+	one := 1
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	service := &entity.ModelService{ID: 3, ModelID: 6, Price: rub(100)}
+
+	tests := []struct {
+		name          string
+		mockPromo     *entity.PromoCode
+		mockGetErr    error
+		mockUsed      *int
+		expectedError error
+	}{
+		{
+			name: "valid code",
+			mockPromo: &entity.PromoCode{ID: 1, DiscountType: entity.DiscountPercent, PercentOff: &percentOff,
+				IsActive: true, ServiceIDs: []int64{3}, ModelIDs: []int64{6}},
+		},
+		{
+			name:          "code not found",
+			mockGetErr:    persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrPromoCodeNotFound,
+		},
+		{
+			name:          "deactivated code",
+			mockPromo:     &entity.PromoCode{ID: 1, IsActive: false},
+			expectedError: service_errors.ErrPromoCodeNotValid,
+		},
+		{
+			name:          "code is expired",
+			mockPromo:     &entity.PromoCode{ID: 1, IsActive: true, ValidUntil: &past},
+			expectedError: service_errors.ErrPromoCodeNotValid,
+		},
+		{
+			name:          "code is not started yet",
+			mockPromo:     &entity.PromoCode{ID: 1, IsActive: true, ValidFrom: &future},
+			expectedError: service_errors.ErrPromoCodeNotValid,
+		},
+		{
+			name:          "code is for another service",
+			mockPromo:     &entity.PromoCode{ID: 1, IsActive: true, ServiceIDs: []int64{4}},
+			expectedError: service_errors.ErrPromoCodeNotApplicable,
+		},
+		{
+			name:          "code is for another model",
+			mockPromo:     &entity.PromoCode{ID: 1, IsActive: true, ModelIDs: []int64{7}},
+			expectedError: service_errors.ErrPromoCodeNotApplicable,
+		},
+		{
+			name:          "code is exhausted",
+			mockPromo:     &entity.PromoCode{ID: 1, IsActive: true, MaxUses: &one, UsedCount: 1},
+			expectedError: service_errors.ErrPromoCodeUsageLimitReached,
+		},
+		{
+			name:          "client already used the code",
+			mockPromo:     &entity.PromoCode{ID: 1, IsActive: true, MaxUsesPerClient: &one},
+			mockUsed:      &one,
+			expectedError: service_errors.ErrPromoCodeClientLimitReached,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPromoCodeServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.promoCodeRepo.EXPECT().
+				GetByCode(gomock.Any(), "SPRING20").
+				Return(tt.mockPromo, tt.mockGetErr).
+				Times(1)
+
+			if tt.mockUsed != nil {
+				test.promoCodeRepo.EXPECT().
+					CountActiveRedemptions(gomock.Any(), tt.mockPromo.ID, int64(5)).
+					Return(*tt.mockUsed, nil).
+					Times(1)
+			}
+
+			result, err := test.service.Validate(context.Background(), "spring20", 5, service)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockPromo, result)
+			}
+		})
+	}
+}
+
+func TestPromoCodeService_Redeem(t *testing.T) {
+	two := 2
+
+	tests := []struct {
+		name            string
+		promo           *entity.PromoCode
+		mockIncrErr     error
+		mockUsed        int
+		expectCount     bool
+		expectSave      bool
+		mockSaveErr     error
+		expectedError   error
+		expectedErrText string
+	}{
+		{
+			name:       "code is redeemed",
+			promo:      &entity.PromoCode{ID: 1},
+			expectSave: true,
+		},
+		{
+			name:        "redeemed within client limit",
+			promo:       &entity.PromoCode{ID: 1, MaxUsesPerClient: &two},
+			mockUsed:    1,
+			expectCount: true,
+			expectSave:  true,
+		},
+		{
+			name:          "usage limit is reached by a concurrent booking",
+			promo:         &entity.PromoCode{ID: 1},
+			mockIncrErr:   persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrPromoCodeUsageLimitReached,
+		},
+		{
+			name:          "client limit is reached by a concurrent booking",
+			promo:         &entity.PromoCode{ID: 1, MaxUsesPerClient: &two},
+			mockUsed:      2,
+			expectCount:   true,
+			expectedError: service_errors.ErrPromoCodeClientLimitReached,
+		},
+		{
+			name:            "redemption is not saved",
+			promo:           &entity.PromoCode{ID: 1},
+			expectSave:      true,
+			mockSaveErr:     errors.New("db error"),
+			expectedErrText: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpPromoCodeServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.txManager.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).
+				Times(1)
+
+			test.promoCodeRepo.EXPECT().
+				IncrementUsage(gomock.Any(), tt.promo.ID).
+				Return(tt.mockIncrErr).
+				Times(1)
+
+			if tt.expectCount {
+				test.promoCodeRepo.EXPECT().
+					CountActiveRedemptions(gomock.Any(), tt.promo.ID, int64(5)).
+					Return(tt.mockUsed, nil).
+					Times(1)
+			}
+
+			if tt.expectSave {
+				test.promoCodeRepo.EXPECT().
+					SaveRedemption(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, r *entity.PromoRedemption) error {
+						assert.Equal(t, tt.promo.ID, r.PromoCodeID)
+						assert.Equal(t, int64(5), r.ClientID)
+						assert.Equal(t, int64(8), r.BookingID)
+						return tt.mockSaveErr
+					}).
+					Times(1)
+			}
+
+			err := test.service.Redeem(context.Background(), tt.promo, 5, 8)
+
+			switch {
+			case tt.expectedError != nil:
+				assert.ErrorIs(t, err, tt.expectedError)
+			case tt.expectedErrText != "":
+				assert.EqualError(t, err, tt.expectedErrText)
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
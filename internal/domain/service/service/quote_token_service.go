@@ -0,0 +1,85 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type QuoteTokenService struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+type quoteClaims struct {
+	Quote entity.Quote `json:"quote"`
+	jwt.RegisteredClaims
+}
+
+func NewQuoteTokenService() (*QuoteTokenService, error) {
+	secret := os.Getenv(service_const.DotEnvQuoteSecret)
+	if secret == "" {
+		return nil, service_errors.ErrLoadingQuoteSecret
+	}
+
+	ttl := os.Getenv(service_const.DotEnvQuoteExpiration)
+	if ttl == "" {
+		return nil, service_errors.ErrLoadingTTL
+	}
+
+	ttlInSeconds, err := strconv.Atoi(ttl)
+	if err != nil {
+		return nil, service_errors.ErrParsingTTL
+	}
+	if ttlInSeconds <= 0 {
+		return nil, service_errors.ErrNotPositiveTTL
+	}
+
+	return &QuoteTokenService{
+		secret: []byte(secret),
+		ttl:    time.Duration(ttlInSeconds) * time.Second,
+	}, nil
+}
+
+// Sign sets the expiry of the quote and returns the token carrying it.
+func (s *QuoteTokenService) Sign(quote *entity.Quote) (string, error) {
+	now := time.Now()
+	quote.ExpiresAt = now.Add(s.ttl)
+
+	claims := quoteClaims{
+		Quote: *quote,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(quote.ExpiresAt),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+}
+
+func (s *QuoteTokenService) Parse(token string) (*entity.Quote, error) {
+	var claims quoteClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+
+		return s.secret, nil
+	})
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, service_errors.ErrQuoteExpired
+		}
+
+		return nil, service_errors.ErrInvalidQuoteToken
+	}
+
+	return &claims.Quote, nil
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteTokenService_NewQuoteTokenService(t *testing.T) {
+	tests := []struct {
+		name          string
+		secret        string
+		ttl           string
+		expectedError error
+	}{
+		{
+			name:   "successful creation",
+			secret: "quote-secret",
+			ttl:    "600",
+		},
+		{
+			name:          "missing secret",
+			ttl:           "600",
+			expectedError: service_errors.ErrLoadingQuoteSecret,
+		},
+		{
+			name:          "missing ttl",
+			secret:        "quote-secret",
+			expectedError: service_errors.ErrLoadingTTL,
+		},
+		{
+			name:          "ttl is not a number",
+			secret:        "quote-secret",
+			ttl:           "ten minutes",
+			expectedError: service_errors.ErrParsingTTL,
+		},
+		{
+			name:          "ttl is zero",
+			secret:        "quote-secret",
+			ttl:           "0",
+			expectedError: service_errors.ErrNotPositiveTTL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(service_const.DotEnvQuoteSecret, tt.secret)
+			t.Setenv(service_const.DotEnvQuoteExpiration, tt.ttl)
+
+			quoteService, err := NewQuoteTokenService()
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, quoteService)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 600*time.Second, quoteService.ttl)
+			}
+		})
+	}
+}
+
+func TestQuoteTokenService_SignAndParse(t *testing.T) {
+	t.Setenv(service_const.DotEnvQuoteSecret, "quote-secret")
+	t.Setenv(service_const.DotEnvQuoteExpiration, "600")
+
+	quoteService, err := NewQuoteTokenService()
+	require.NoError(t, err)
+
+	newQuote := func() *entity.Quote {
+		return &entity.Quote{
+			ClientID:       1,
+			ModelServiceID: 2,
+			SlotID:         3,
+			Address:        entity.NewAddress("Tverskaya", 1, 5, 2, 3, "Intercom 5"),
+			PromoCode:      "SPRING20",
+			AddOnIDs:       []int64{11},
+			BasePrice:      rub(100),
+			Surcharges:     []entity.PriceComponent{{PricingRuleID: 3, Name: "Night", Amount: rub(50)}},
+			AddOns:         []entity.BookingAddOn{{AddOnID: 11, Title: "Makeup", Price: rub(30)}},
+			Discount:       rub(36),
+			Price:          rub(144),
+		}
+	}
+
+	t.Run("quote survives the round trip", func(t *testing.T) {
+		quote := newQuote()
+
+		token, err := quoteService.Sign(quote)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(600*time.Second), quote.ExpiresAt, 5*time.Second)
+
+		parsed, err := quoteService.Parse(token)
+		require.NoError(t, err)
+		assert.Equal(t, quote.Price, parsed.Price)
+		assert.Equal(t, quote.Surcharges, parsed.Surcharges)
+		assert.Equal(t, quote.AddOns, parsed.AddOns)
+		assert.True(t, parsed.Matches(1, 2, 3, quote.Address, "SPRING20", []int64{11}))
+	})
+
+	t.Run("tampered token", func(t *testing.T) {
+		token, err := quoteService.Sign(newQuote())
+		require.NoError(t, err)
+
+		parsed, err := quoteService.Parse(token + "tampered")
+		assert.ErrorIs(t, err, service_errors.ErrInvalidQuoteToken)
+		assert.Nil(t, parsed)
+	})
+
+	t.Run("token signed with another secret", func(t *testing.T) {
+		other := &QuoteTokenService{secret: []byte("other-secret"), ttl: time.Minute}
+		token, err := other.Sign(newQuote())
+		require.NoError(t, err)
+
+		parsed, err := quoteService.Parse(token)
+		assert.ErrorIs(t, err, service_errors.ErrInvalidQuoteToken)
+		assert.Nil(t, parsed)
+	})
+
+	t.Run("expired quote", func(t *testing.T) {
+		expired := &QuoteTokenService{secret: []byte("quote-secret"), ttl: -time.Minute}
+		token, err := expired.Sign(newQuote())
+		require.NoError(t, err)
+
+		parsed, err := quoteService.Parse(token)
+		assert.ErrorIs(t, err, service_errors.ErrQuoteExpired)
+		assert.Nil(t, parsed)
+	})
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type DefaultReceiptService struct {
+	receiptRepo      interfaces.ReceiptRepository
+	orderRepo        interfaces.OrderRepository
+	bookingRepo      interfaces.BookingRepository
+	modelServiceRepo interfaces.ModelServiceRepository
+	userRepo         interfaces.UserRepository
+	logger           pkg.Logger
+}
+
+func NewDefaultReceiptService(receiptRepo interfaces.ReceiptRepository, orderRepo interfaces.OrderRepository,
+	bookingRepo interfaces.BookingRepository, modelServiceRepo interfaces.ModelServiceRepository,
+	userRepo interfaces.UserRepository, logger pkg.Logger,
+) *DefaultReceiptService {
+	return &DefaultReceiptService{
+		receiptRepo:      receiptRepo,
+		orderRepo:        orderRepo,
+		bookingRepo:      bookingRepo,
+		modelServiceRepo: modelServiceRepo,
+		userRepo:         userRepo,
+		logger:           logger,
+	}
+}
+
+// GetOrderReceipt returns the receipt of the client's order, it is issued when the payment is captured.
+func (d *DefaultReceiptService) GetOrderReceipt(ctx context.Context, orderID int64) (*entity.Receipt, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := d.checkClientRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := d.orderRepo.GetByID(ctx, orderID)
+	if errors.Is(err, persistence.ErrNoRowsFound) {
+		order, err = d.orderRepo.GetArchivedByID(ctx, orderID)
+	}
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "order is not found by id",
+				option.Any("order_id", orderID),
+				option.Error(service_errors.ErrOrderNotFound))
+
+			return nil, service_errors.ErrOrderNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get order by id",
+			option.Any("order_id", orderID),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	booking, err := d.bookingRepo.GetByID(ctx, order.BookingID)
+	if errors.Is(err, persistence.ErrNoRowsFound) {
+		booking, err = d.bookingRepo.GetArchivedByID(ctx, order.BookingID)
+	}
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "booking is not found by id",
+				option.Any("booking_id", order.BookingID),
+				option.Error(service_errors.ErrBookingNotFound))
+
+			return nil, service_errors.ErrBookingNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get booking by id",
+			option.Any("booking_id", order.BookingID),
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if booking.ClientID != client.ID {
+		d.logger.Error(ctx, "order is not owned by this client",
+			option.Any("order_id", orderID),
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrClientIsNotOwnerOfOrder))
+
+		return nil, service_errors.ErrClientIsNotOwnerOfOrder
+	}
+
+	receipt, err := d.receiptRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "order has no receipt",
+				option.Any("order_id", orderID),
+				option.Any("status", order.Status),
+				option.Error(service_errors.ErrReceiptNotAvailable))
+
+			return nil, service_errors.ErrReceiptNotAvailable
+		}
+
+		d.logger.Error(ctx, "failed to get receipt by order id",
+			option.Any("order_id", orderID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+// IssueReceipt saves the receipt of the completed order together with the capture of its payment,
+// so it is called in the transaction capturing the payment.
+func (d *DefaultReceiptService) IssueReceipt(ctx context.Context, order *entity.Order,
+	payment *entity.Payment) (*entity.Receipt, error) {
+
+	if payment == nil || !entity.CanHaveReceipt(order, payment) {
+		d.logger.Error(ctx, "order is not paid",
+			option.Any("order_id", order.ID),
+			option.Any("status", order.Status),
+			option.Error(service_errors.ErrReceiptNotAvailable))
+
+		return nil, service_errors.ErrReceiptNotAvailable
+	}
+
+	booking, err := d.bookingRepo.GetByID(ctx, order.BookingID)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get booking by id",
+			option.Any("booking_id", order.BookingID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	modelService, err := d.modelServiceRepo.GetByID(ctx, booking.ModelServiceID, true)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get model service by id",
+			option.Any("model_service_id", booking.ModelServiceID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	model, err := d.userRepo.GetByID(ctx, modelService.ModelID)
+	if err != nil {
+		d.logger.Error(ctx, "failed to get model by id",
+			option.Any("model_id", modelService.ModelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	receipt, err := entity.NewReceipt(order, booking, modelService, model, payment)
+	if err != nil {
+		d.logger.Error(ctx, "failed to itemize receipt",
+			option.Any("order_id", order.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if err = d.receiptRepo.Save(ctx, receipt); err != nil {
+		d.logger.Error(ctx, "failed to save receipt",
+			option.Any("order_id", order.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+func (d *DefaultReceiptService) checkClientRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleClient.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotClient))
+
+		return nil, service_errors.ErrNotClient
+	}
+
+	client, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "client is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotClient))
+
+			return nil, service_errors.ErrNotClient
+		}
+
+		d.logger.Error(ctx, "check client restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !client.IsUserVerified() {
+		d.logger.Error(ctx, "client is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedClient))
+
+		return nil, service_errors.ErrNotVerifiedClient
+	}
+
+	return client, nil
+}
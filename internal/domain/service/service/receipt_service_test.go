@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type receiptServiceTest struct {
+	ctrl             *gomock.Controller
+	receiptRepo      *mocks.MockReceiptRepository
+	orderRepo        *mocks.MockOrderRepository
+	bookingRepo      *mocks.MockBookingRepository
+	modelServiceRepo *mocks.MockModelServiceRepository
+	userRepo         *mocks.MockUserRepository
+	service          *DefaultReceiptService
+}
+
+func setUpReceiptServiceTest(t *testing.T) *receiptServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	receiptRepo := mocks.NewMockReceiptRepository(ctrl)
+	orderRepo := mocks.NewMockOrderRepository(ctrl)
+	bookingRepo := mocks.NewMockBookingRepository(ctrl)
+	modelServiceRepo := mocks.NewMockModelServiceRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &receiptServiceTest{
+		ctrl:             ctrl,
+		receiptRepo:      receiptRepo,
+		orderRepo:        orderRepo,
+		bookingRepo:      bookingRepo,
+		modelServiceRepo: modelServiceRepo,
+		userRepo:         userRepo,
+		service: NewDefaultReceiptService(
+			receiptRepo, orderRepo, bookingRepo, modelServiceRepo, userRepo, log),
+	}
+}
+
+func TestReceiptService_GetOrderReceipt(t *testing.T) {
+	ctxClient := context.WithValue(context.Background(), service_const.AuthIDKey, int64(2))
+	ctxClient = context.WithValue(ctxClient, service_const.RoleKey, "CLIENT")
+
+	client := &entity.User{ID: 3, AuthID: 2, IsVerified: true}
+	booking := &entity.Booking{ID: 4, ClientID: 3, ModelServiceID: 7}
+	completedOrder := &entity.Order{ID: 1, BookingID: 4, Status: entity.OrderCompleted}
+	existing := &entity.Receipt{ID: 8, Number: 42, OrderID: 1}
+
+	tests := []struct {
+		name            string
+		mockOrder       *entity.Order
+		mockOrderErr    error
+		archived        bool
+		mockBooking     *entity.Booking
+		mockReceipt     *entity.Receipt
+		expectedReceipt *entity.Receipt
+		expectedError   error
+	}{
+		{
+			name:            "issued receipt is returned",
+			mockOrder:       completedOrder,
+			mockBooking:     booking,
+			mockReceipt:     existing,
+			expectedReceipt: existing,
+		},
+		{
+			name:            "receipt of archived order is found",
+			mockOrder:       completedOrder,
+			archived:        true,
+			mockBooking:     booking,
+			mockReceipt:     existing,
+			expectedReceipt: existing,
+		},
+		{
+			name:          "receipt is not issued before the payment is captured",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 4, Status: entity.OrderPendingConfirmation},
+			mockBooking:   booking,
+			expectedError: service_errors.ErrReceiptNotAvailable,
+		},
+		{
+			name:          "order of another client",
+			mockOrder:     completedOrder,
+			mockBooking:   &entity.Booking{ID: 4, ClientID: 99},
+			expectedError: service_errors.ErrClientIsNotOwnerOfOrder,
+		},
+		{
+			name:          "order not found",
+			mockOrderErr:  persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrOrderNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpReceiptServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), client.AuthID).
+				Return(client, nil).
+				Times(1)
+
+			if tt.archived || errors.Is(tt.mockOrderErr, persistence.ErrNoRowsFound) {
+				test.orderRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1)).
+					Return(nil, persistence.ErrNoRowsFound).
+					Times(1)
+
+				test.orderRepo.EXPECT().
+					GetArchivedByID(gomock.Any(), int64(1)).
+					Return(tt.mockOrder, tt.mockOrderErr).
+					Times(1)
+			} else {
+				test.orderRepo.EXPECT().
+					GetByID(gomock.Any(), int64(1)).
+					Return(tt.mockOrder, tt.mockOrderErr).
+					Times(1)
+			}
+
+			if tt.mockBooking != nil && tt.archived {
+				test.bookingRepo.EXPECT().
+					GetByID(gomock.Any(), int64(4)).
+					Return(nil, persistence.ErrNoRowsFound).
+					Times(1)
+
+				test.bookingRepo.EXPECT().
+					GetArchivedByID(gomock.Any(), int64(4)).
+					Return(tt.mockBooking, nil).
+					Times(1)
+			} else if tt.mockBooking != nil {
+				test.bookingRepo.EXPECT().
+					GetByID(gomock.Any(), int64(4)).
+					Return(tt.mockBooking, nil).
+					Times(1)
+			}
+
+			if tt.mockBooking != nil && tt.mockBooking.ClientID == client.ID {
+				receiptErr := error(nil)
+				if tt.mockReceipt == nil {
+					receiptErr = persistence.ErrNoRowsFound
+				}
+
+				test.receiptRepo.EXPECT().
+					GetByOrderID(gomock.Any(), int64(1)).
+					Return(tt.mockReceipt, receiptErr).
+					Times(1)
+			}
+
+			res, err := test.service.GetOrderReceipt(ctxClient, 1)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedReceipt, res)
+		})
+	}
+}
+
+func TestReceiptService_IssueReceipt(t *testing.T) {
+	model := &entity.User{ID: 5, Name: "Anna", IsVerified: true}
+	modelService := &entity.ModelService{ID: 7, ModelID: 5, Title: "Photo session", Price: rub(100)}
+
+	promoID := int64(9)
+	booking := &entity.Booking{
+		ID:             4,
+		ClientID:       3,
+		ModelServiceID: 7,
+		BasePrice:      rub(100),
+		Surcharges:     []entity.PriceComponent{{PricingRuleID: 1, Name: "Night", Amount: rub(50)}},
+		AddOns:         []entity.BookingAddOn{{AddOnID: 11, Title: "Makeup", Price: rub(30)}},
+		Discount:       rub(36),
+		Price:          rub(144),
+		PromoCodeID:    &promoID,
+	}
+
+	completedOrder := &entity.Order{ID: 1, BookingID: 4, Status: entity.OrderCompleted}
+
+	tests := []struct {
+		name          string
+		mockOrder     *entity.Order
+		mockPayment   *entity.Payment
+		mockSaveErr   error
+		expectedLines []entity.ReceiptLine
+		expectedTotal entity.Money
+		expectedError error
+	}{
+		{
+			name:      "receipt is issued for partially refunded order",
+			mockOrder: completedOrder,
+			mockPayment: &entity.Payment{
+				OrderID:        1,
+				Provider:       "mock",
+				CapturedAmount: rub(144),
+				RefundedAmount: rub(44),
+				Status:         entity.PaymentPartiallyRefunded,
+			},
+			expectedLines: []entity.ReceiptLine{
+				{Title: "Photo session", Amount: rub(100)},
+				{Title: "Night", Amount: rub(50)},
+				{Title: "Makeup", Amount: rub(30)},
+				{Title: "Promo discount", Amount: rub(-36)},
+				{Title: "Refund", Amount: rub(-44)},
+			},
+			expectedTotal: rub(100),
+		},
+		{
+			name: "extension is billed on its own line",
+			mockOrder: &entity.Order{ID: 1, BookingID: 4, Status: entity.OrderCompleted,
+				ExtensionMinutes: 30, ExtensionAmount: rub(50)},
+			mockPayment: &entity.Payment{
+				OrderID:        1,
+				Provider:       "mock",
+				CapturedAmount: rub(194),
+				Status:         entity.PaymentCaptured,
+			},
+			expectedLines: []entity.ReceiptLine{
+				{Title: "Photo session", Amount: rub(100)},
+				{Title: "Night", Amount: rub(50)},
+				{Title: "Makeup", Amount: rub(30)},
+				{Title: "Promo discount", Amount: rub(-36)},
+				{Title: "Extension, 30 min", Amount: rub(50)},
+			},
+			expectedTotal: rub(194),
+		},
+		{
+			name:          "receipt is already issued",
+			mockOrder:     completedOrder,
+			mockPayment:   &entity.Payment{OrderID: 1, Provider: "mock", CapturedAmount: rub(144), Status: entity.PaymentCaptured},
+			mockSaveErr:   persistence.ErrDuplicateKey,
+			expectedError: persistence.ErrDuplicateKey,
+		},
+		{
+			name:          "order is waiting for confirmation",
+			mockOrder:     &entity.Order{ID: 1, BookingID: 4, Status: entity.OrderPendingConfirmation},
+			mockPayment:   &entity.Payment{OrderID: 1, Status: entity.PaymentCaptured},
+			expectedError: service_errors.ErrReceiptNotAvailable,
+		},
+		{
+			name:          "payment is refunded in full",
+			mockOrder:     completedOrder,
+			mockPayment:   &entity.Payment{OrderID: 1, Status: entity.PaymentRefunded},
+			expectedError: service_errors.ErrReceiptNotAvailable,
+		},
+		{
+			name:          "order has no payment",
+			mockOrder:     completedOrder,
+			expectedError: service_errors.ErrReceiptNotAvailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpReceiptServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.mockPayment != nil && entity.CanHaveReceipt(tt.mockOrder, tt.mockPayment) {
+				test.bookingRepo.EXPECT().
+					GetByID(gomock.Any(), int64(4)).
+					Return(booking, nil).
+					Times(1)
+
+				test.modelServiceRepo.EXPECT().
+					GetByID(gomock.Any(), int64(7), true).
+					Return(modelService, nil).
+					Times(1)
+
+				test.userRepo.EXPECT().
+					GetByID(gomock.Any(), int64(5)).
+					Return(model, nil).
+					Times(1)
+
+				test.receiptRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, r *entity.Receipt) error {
+						r.Number = 43
+						return tt.mockSaveErr
+					}).
+					Times(1)
+			}
+
+			res, err := test.service.IssueReceipt(context.Background(), tt.mockOrder, tt.mockPayment)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "R-00000043", res.Code())
+			assert.Equal(t, tt.expectedLines, res.Lines)
+			assert.Equal(t, tt.expectedTotal, res.Total)
+			assert.Equal(t, "Anna", res.ModelName)
+			assert.Equal(t, "mock", res.PaymentMethod)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	metrics2 "github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/metrics"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+type DefaultRetentionService struct {
+	retentionRepo interfaces.RetentionRepository
+	txManager     database.TxManager
+	logger        pkg.Logger
+	metrics       *metrics2.Metrics
+	months        int
+}
+
+func NewDefaultRetentionService(retentionRepo interfaces.RetentionRepository, txManager database.TxManager,
+	logger pkg.Logger, metrics *metrics2.Metrics) (*DefaultRetentionService, error) {
+
+	months := os.Getenv(service_const.DotEnvRetentionMonths)
+	if months == "" {
+		return nil, service_errors.ErrLoadingRetentionMonths
+	}
+
+	retentionMonths, err := strconv.Atoi(months)
+	if err != nil {
+		return nil, service_errors.ErrParsingRetentionMonths
+	}
+	if retentionMonths <= 0 {
+		return nil, service_errors.ErrNotPositiveRetentionMonths
+	}
+
+	return &DefaultRetentionService{
+		retentionRepo: retentionRepo,
+		txManager:     txManager,
+		logger:        logger,
+		metrics:       metrics,
+		months:        retentionMonths,
+	}, nil
+}
+
+// Archive moves what is older than the retention period out of the live tables in one transaction.
+// The orders go first so that their bookings follow them, and the bookings go before the slots so that
+// the slots they have released are archived in the same run.
+func (d *DefaultRetentionService) Archive(ctx context.Context) (*entity.Archived, error) {
+	before := time.Now().AddDate(0, -d.months, 0)
+
+	var res entity.Archived
+	err := d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if res.Orders, err = d.retentionRepo.ArchiveOrders(ctx, before); err != nil {
+			d.logger.Error(ctx, "cannot archive orders",
+				option.Any("before", before),
+				option.Error(err))
+
+			return err
+		}
+
+		if res.Bookings, err = d.retentionRepo.ArchiveBookings(ctx, before); err != nil {
+			d.logger.Error(ctx, "cannot archive bookings",
+				option.Any("before", before),
+				option.Error(err))
+
+			return err
+		}
+
+		if res.Slots, err = d.retentionRepo.ArchiveSlots(ctx, before); err != nil {
+			d.logger.Error(ctx, "cannot archive slots",
+				option.Any("before", before),
+				option.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.metrics.AddArchived("orders", res.Orders)
+	d.metrics.AddArchived("bookings", res.Bookings)
+	d.metrics.AddArchived("slots", res.Slots)
+
+	return &res, nil
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type retentionServiceTest struct {
+	ctrl          *gomock.Controller
+	retentionRepo *mocks.MockRetentionRepository
+	txManager     *mocks.MockTxManager
+	service       *DefaultRetentionService
+}
+
+func setUpRetentionServiceTest(t *testing.T) *retentionServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	retentionRepo := mocks.NewMockRetentionRepository(ctrl)
+	txManager := mocks.NewMockTxManager(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(service_const.DotEnvRetentionMonths, "6")
+
+	retentionService, err := NewDefaultRetentionService(retentionRepo, txManager, log, orderServiceTestMetrics)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &retentionServiceTest{
+		ctrl:          ctrl,
+		retentionRepo: retentionRepo,
+		txManager:     txManager,
+		service:       retentionService,
+	}
+}
+
+func TestNewDefaultRetentionService_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		months        string
+		expectedError error
+	}{
+		{
+			name:   "successful creation",
+			months: "6",
+		},
+		{
+			name:          "months are not set",
+			expectedError: service_errors.ErrLoadingRetentionMonths,
+		},
+		{
+			name:          "months are not a number",
+			months:        "half a year",
+			expectedError: service_errors.ErrParsingRetentionMonths,
+		},
+		{
+			name:          "months are zero",
+			months:        "0",
+			expectedError: service_errors.ErrNotPositiveRetentionMonths,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(service_const.DotEnvRetentionMonths, tt.months)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfg := &config.LogConfig{}
+			cfg.Logger.Level = "info"
+			tmpDir := os.TempDir()
+			cfg.Logger.LogsDir = tmpDir
+			cfg.Logger.LogsFile = "test.log"
+			log, _ := pkg.NewDualLogger(cfg)
+
+			retentionService, err := NewDefaultRetentionService(
+				mocks.NewMockRetentionRepository(ctrl),
+				mocks.NewMockTxManager(ctrl),
+				log,
+				orderServiceTestMetrics,
+			)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, retentionService)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 6, retentionService.months)
+			}
+		})
+	}
+}
+
+func TestRetentionService_Archive(t *testing.T) {
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name             string
+		mockOrders       int64
+		mockOrdersErr    error
+		mockBookings     int64
+		mockBookingsErr  error
+		mockSlots        int64
+		mockSlotsErr     error
+		expectedArchived *entity.Archived
+		expectedError    error
+	}{
+		{
+			name:             "everything stale is archived",
+			mockOrders:       1,
+			mockBookings:     3,
+			mockSlots:        5,
+			expectedArchived: &entity.Archived{Orders: 1, Bookings: 3, Slots: 5},
+		},
+		{
+			name:             "nothing to archive",
+			expectedArchived: &entity.Archived{},
+		},
+		{
+			name:          "failed to archive orders",
+			mockOrdersErr: dbErr,
+			expectedError: dbErr,
+		},
+		{
+			name:            "failed to archive bookings",
+			mockOrders:      1,
+			mockBookingsErr: dbErr,
+			expectedError:   dbErr,
+		},
+		{
+			name:          "failed to archive slots",
+			mockOrders:    1,
+			mockBookings:  3,
+			mockSlotsErr:  dbErr,
+			expectedError: dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpRetentionServiceTest(t)
+			defer test.ctrl.Finish()
+
+			cutoff := time.Now().AddDate(0, -6, 0)
+			checkCutoff := func(_ context.Context, before time.Time) {
+				assert.WithinDuration(t, cutoff, before, time.Minute)
+			}
+
+			test.txManager.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).
+				Times(1)
+
+			test.retentionRepo.EXPECT().
+				ArchiveOrders(gomock.Any(), gomock.Any()).
+				Do(checkCutoff).
+				Return(tt.mockOrders, tt.mockOrdersErr).
+				Times(1)
+
+			if tt.mockOrdersErr == nil {
+				test.retentionRepo.EXPECT().
+					ArchiveBookings(gomock.Any(), gomock.Any()).
+					Do(checkCutoff).
+					Return(tt.mockBookings, tt.mockBookingsErr).
+					Times(1)
+			}
+
+			if tt.mockOrdersErr == nil && tt.mockBookingsErr == nil {
+				test.retentionRepo.EXPECT().
+					ArchiveSlots(gomock.Any(), gomock.Any()).
+					Do(checkCutoff).
+					Return(tt.mockSlots, tt.mockSlotsErr).
+					Times(1)
+			}
+
+			slotsBefore := testutil.ToFloat64(orderServiceTestMetrics.ArchivedRows.WithLabelValues("slots"))
+
+			res, err := test.service.Archive(context.Background())
+
+			slotsAfter := testutil.ToFloat64(orderServiceTestMetrics.ArchivedRows.WithLabelValues("slots"))
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				assert.Equal(t, slotsBefore, slotsAfter, "failed run reports nothing")
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedArchived, res)
+			assert.Equal(t, float64(tt.mockSlots), slotsAfter-slotsBefore)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
@@ -15,20 +16,30 @@ import (
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
 )
 
+const maxSlotBatchSize = 100
+
 type DefaultSlotService struct {
 	slotRepo    interfaces.SlotRepository
 	bookingRepo interfaces.BookingRepository
 	userRepo    interfaces.UserRepository
+	timeOffRepo interfaces.TimeOffRepository
+	buffers     interfaces.TravelBufferProvider
+	rules       interfaces.BookingRulesProvider
 	txManager   database.TxManager
 	logger      pkg.Logger
 }
 
 func NewDefaultSlotService(slotRepo interfaces.SlotRepository, bookingRepo interfaces.BookingRepository,
-	userRepo interfaces.UserRepository, txManager database.TxManager, logger pkg.Logger) *DefaultSlotService {
+	userRepo interfaces.UserRepository, timeOffRepo interfaces.TimeOffRepository,
+	buffers interfaces.TravelBufferProvider, rules interfaces.BookingRulesProvider, txManager database.TxManager,
+	logger pkg.Logger) *DefaultSlotService {
 	return &DefaultSlotService{
 		slotRepo:    slotRepo,
 		bookingRepo: bookingRepo,
 		userRepo:    userRepo,
+		timeOffRepo: timeOffRepo,
+		buffers:     buffers,
+		rules:       rules,
 		txManager:   txManager,
 		logger:      logger,
 	}
@@ -53,24 +64,23 @@ func (d *DefaultSlotService) CreateSlot(ctx context.Context, start, end time.Tim
 		return nil, err
 	}
 
-	overlaps, err := d.slotRepo.GetOverlappingSlots(ctx, model.ID, start, end)
-	if err != nil {
-		d.logger.Error(ctx, "cannot get overlaps slot for model",
-			option.Any("model_id", model.ID),
-			option.Error(err))
-
+	slot := entity.NewSlot(model.ID, start, end)
+	if err = d.checkRules(ctx, slot); err != nil {
 		return nil, err
 	}
-	if len(overlaps) > 0 {
-		d.logger.Error(ctx, "there are overlapping slots for model",
-			option.Any("model_id", model.ID),
-			option.Error(service_errors.ErrSlotOverlap))
-
-		return nil, service_errors.ErrSlotOverlap
+	if err = d.checkPlacement(ctx, slot); err != nil {
+		return nil, err
 	}
 
-	slot := entity.NewSlot(model.ID, start, end)
 	if err = d.slotRepo.Save(ctx, slot); err != nil {
+		if errors.Is(err, persistence.ErrRangeOverlap) {
+			d.logger.Error(ctx, "slot overlaps with a concurrently saved slot",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrSlotOverlap))
+
+			return nil, service_errors.ErrSlotOverlap
+		}
+
 		d.logger.Error(ctx, "cannot save slot for model",
 			option.Any("auth_id", authID),
 			option.Error(err))
@@ -142,26 +152,15 @@ func (d *DefaultSlotService) UpdateSlot(ctx context.Context,
 	}
 
 	if slot.StartTime != newStart || slot.EndTime != newEnd {
-		overlaps, err := d.slotRepo.GetOverlappingSlots(ctx, slot.ModelID, newStart, newEnd)
-		if err != nil {
-			d.logger.Error(ctx, "cannot get overlaps slot for model",
-				option.Any("model_id", model.ID),
-				option.Error(err))
+		slot.StartTime = newStart
+		slot.EndTime = newEnd
 
+		if err = d.checkRules(ctx, slot); err != nil {
 			return nil, err
 		}
-		filtered := make([]*entity.Slot, 0, len(overlaps))
-		for _, s := range overlaps {
-			if s.ID != slot.ID {
-				filtered = append(filtered, s)
-			}
-		}
-		if len(filtered) > 0 {
-			return nil, service_errors.ErrSlotOverlap
+		if err = d.checkPlacement(ctx, slot); err != nil {
+			return nil, err
 		}
-
-		slot.StartTime = newStart
-		slot.EndTime = newEnd
 	}
 
 	res, err := d.slotRepo.Update(ctx, slot)
@@ -174,6 +173,14 @@ func (d *DefaultSlotService) UpdateSlot(ctx context.Context,
 
 			return nil, service_errors.ErrSlotIsNotFound
 		}
+		if errors.Is(err, persistence.ErrRangeOverlap) {
+			d.logger.Error(ctx, "slot overlaps with a concurrently saved slot",
+				option.Any("auth_id", authID),
+				option.Any("slot_id", slotID),
+				option.Error(service_errors.ErrSlotOverlap))
+
+			return nil, service_errors.ErrSlotOverlap
+		}
 
 		d.logger.Error(ctx, "cannot update slot for model",
 			option.Any("auth_id", authID),
@@ -259,18 +266,403 @@ func (d *DefaultSlotService) DeactivateSlot(ctx context.Context, slotID int64) (
 	return res, nil
 }
 
-func (d *DefaultSlotService) GetSlotsWithModelIDByModel(ctx context.Context) ([]*entity.Slot, error) {
+// CreateSlots creates the batch in one transaction, all or nothing. Every slot is checked against the other
+// slots of the batch and the slots the model already has, a rejected batch reports the error of each slot.
+func (d *DefaultSlotService) CreateSlots(ctx context.Context, periods []entity.SlotPeriod) ([]*entity.Slot, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(periods) == 0 || len(periods) > maxSlotBatchSize {
+		d.logger.Error(ctx, "invalid slot batch size",
+			option.Any("auth_id", authID),
+			option.Any("size", len(periods)),
+			option.Error(service_errors.ErrInvalidSlotBatchSize))
+
+		return nil, service_errors.ErrInvalidSlotBatchSize
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := make([]*entity.Slot, len(periods))
+	from, to := periods[0].Start, periods[0].End
+	for i, period := range periods {
+		slots[i] = entity.NewSlot(model.ID, period.Start, period.End)
+
+		if period.Start.Before(from) {
+			from = period.Start
+		}
+		if period.End.After(to) {
+			to = period.End
+		}
+	}
+
+	rules, err := d.rules.RulesOf(ctx, model.ID)
+	if err != nil {
+		return nil, err
+	}
+	buffer, err := d.buffers.BufferOf(ctx, model.ID)
+	if err != nil {
+		return nil, err
+	}
+	timeOffs, err := d.getTimeOffs(ctx, model.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	from, to = buffer.Window(from, to)
+	now := time.Now()
+
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		existing, err := d.slotRepo.GetOverlappingSlots(ctx, model.ID, from, to)
+		if err != nil {
+			d.logger.Error(ctx, "cannot get overlaps slot for model",
+				option.Any("model_id", model.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		batchErr := &service_errors.SlotBatchError{}
+		for i := range slots {
+			if err = checkBatchSlot(i, slots, existing, timeOffs, buffer, rules, now); err != nil {
+				batchErr.Items = append(batchErr.Items, service_errors.SlotBatchItemError{Index: i, Err: err})
+			}
+		}
+		if len(batchErr.Items) > 0 {
+			d.logger.Error(ctx, "slot batch is rejected",
+				option.Any("model_id", model.ID),
+				option.Any("rejected", len(batchErr.Items)),
+				option.Error(batchErr))
+
+			return batchErr
+		}
+
+		if err = d.slotRepo.SaveAll(ctx, slots); err != nil {
+			if errors.Is(err, persistence.ErrRangeOverlap) {
+				d.logger.Error(ctx, "slot batch overlaps with concurrently saved slots",
+					option.Any("model_id", model.ID),
+					option.Error(service_errors.ErrSlotOverlap))
+
+				return service_errors.ErrSlotOverlap
+			}
+
+			d.logger.Error(ctx, "cannot save slots for model",
+				option.Any("model_id", model.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slots, nil
+}
+
+// DisableSlotsInRange disables the available slots of the model lying entirely inside [from, to]
+// in one transaction. The slots that are reserved, booked or already disabled are skipped and returned.
+func (d *DefaultSlotService) DisableSlotsInRange(ctx context.Context,
+	from, to time.Time) ([]*entity.Slot, []*entity.Slot, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !from.Before(to) {
+		d.logger.Error(ctx, "range start must be before its end",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrInvalidSlotRange))
+
+		return nil, nil, service_errors.ErrInvalidSlotRange
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var disabled, skipped []*entity.Slot
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		overlaps, err := d.slotRepo.GetOverlappingSlots(ctx, model.ID, from, to)
+		if err != nil {
+			d.logger.Error(ctx, "cannot get slots in range for model",
+				option.Any("model_id", model.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		var ids []int64
+		for _, slot := range overlaps {
+			if slot.Within(from, to) && slot.IsAvailable() {
+				ids = append(ids, slot.ID)
+			}
+		}
+
+		if disabled, err = d.slotRepo.DisableAvailable(ctx, ids); err != nil {
+			d.logger.Error(ctx, "cannot disable slots for model",
+				option.Any("model_id", model.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		done := make(map[int64]bool, len(disabled))
+		for _, slot := range disabled {
+			done[slot.ID] = true
+		}
+		for _, slot := range overlaps {
+			if slot.Within(from, to) && !done[slot.ID] {
+				skipped = append(skipped, slot)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return disabled, skipped, nil
+}
+
+// SplitSlot cuts the available slot either into pieces of the length, the last one keeping the rest,
+// or at the cut points. The slot keeps its id as the first piece and every piece must be as long as the
+// booking rules allow. The pieces cover the time of the slot, so the travel buffer between them is checked
+// only when they are booked.
+func (d *DefaultSlotService) SplitSlot(ctx context.Context, slotID int64, length *time.Duration,
+	cuts []time.Time) ([]*entity.Slot, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := d.rules.RulesOf(ctx, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*entity.Slot
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		slot, err := d.getOwnAvailableSlot(ctx, model.ID, slotID)
+		if err != nil {
+			return err
+		}
+
+		points, err := splitPoints(slot, length, cuts)
+		if err != nil {
+			d.logger.Error(ctx, "invalid slot split",
+				option.Any("model_id", model.ID),
+				option.Any("slot_id", slotID),
+				option.Error(err))
+
+			return err
+		}
+
+		pieces := slot.Split(points)
+		if slices.ContainsFunc(pieces, func(piece *entity.Slot) bool {
+			return !rules.FitsDuration(piece.StartTime, piece.EndTime)
+		}) {
+			d.logger.Error(ctx, "split slot pieces break the booking rules of model",
+				option.Any("model_id", model.ID),
+				option.Any("slot_id", slotID),
+				option.Error(service_errors.ErrInvalidSlotDuration))
+
+			return service_errors.ErrInvalidSlotDuration
+		}
+
+		if err = d.checkNoBookings(ctx, model.ID, []int64{slot.ID}); err != nil {
+			return err
+		}
+
+		if pieces[0], err = d.slotRepo.UpdateAvailable(ctx, pieces[0]); err != nil {
+			if errors.Is(err, persistence.ErrNoRowsFound) {
+				d.logger.Error(ctx, "split slot is taken meanwhile",
+					option.Any("model_id", model.ID),
+					option.Any("slot_id", slotID),
+					option.Error(service_errors.ErrSlotNotAvailable))
+
+				return service_errors.ErrSlotNotAvailable
+			}
+
+			d.logger.Error(ctx, "cannot shorten split slot",
+				option.Any("model_id", model.ID),
+				option.Any("slot_id", slotID),
+				option.Error(err))
+
+			return err
+		}
+
+		if err = d.slotRepo.SaveAll(ctx, pieces[1:]); err != nil {
+			if errors.Is(err, persistence.ErrRangeOverlap) {
+				d.logger.Error(ctx, "split slot overlaps with a concurrently saved slot",
+					option.Any("model_id", model.ID),
+					option.Any("slot_id", slotID),
+					option.Error(service_errors.ErrSlotOverlap))
+
+				return service_errors.ErrSlotOverlap
+			}
+
+			d.logger.Error(ctx, "cannot save pieces of split slot",
+				option.Any("model_id", model.ID),
+				option.Any("slot_id", slotID),
+				option.Error(err))
+
+			return err
+		}
+
+		res = pieces
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MergeSlots joins the available slots following each other without gaps into the earliest of them,
+// the others are deleted. Only the slots no booking has ever referred to can be merged, and only into a slot
+// not longer than the booking rules allow.
+func (d *DefaultSlotService) MergeSlots(ctx context.Context, slotIDs []int64) (*entity.Slot, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(slotIDs) < 2 || len(slotIDs) > maxSlotBatchSize || hasDuplicates(slotIDs) {
+		d.logger.Error(ctx, "invalid slot merge",
+			option.Any("auth_id", authID),
+			option.Any("slot_ids", slotIDs),
+			option.Error(service_errors.ErrInvalidSlotMerge))
+
+		return nil, service_errors.ErrInvalidSlotMerge
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := d.rules.RulesOf(ctx, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *entity.Slot
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		slots, err := d.slotRepo.GetByIDs(ctx, slotIDs)
+		if err != nil {
+			d.logger.Error(ctx, "failed to find slots to merge",
+				option.Any("model_id", model.ID),
+				option.Any("slot_ids", slotIDs),
+				option.Error(err))
+
+			return err
+		}
+
+		if err = d.checkMergedSlots(ctx, model.ID, slotIDs, slots); err != nil {
+			return err
+		}
+
+		if !rules.FitsDuration(slots[0].StartTime, slots[len(slots)-1].EndTime) {
+			d.logger.Error(ctx, "merged slot breaks the booking rules of model",
+				option.Any("model_id", model.ID),
+				option.Any("slot_ids", slotIDs),
+				option.Error(service_errors.ErrInvalidSlotDuration))
+
+			return service_errors.ErrInvalidSlotDuration
+		}
+
+		if err = d.checkNoBookings(ctx, model.ID, slotIDs); err != nil {
+			return err
+		}
+
+		merged, rest := slots[0], make([]int64, 0, len(slots)-1)
+		for _, slot := range slots[1:] {
+			rest = append(rest, slot.ID)
+		}
+
+		deleted, err := d.slotRepo.DeleteUnbooked(ctx, rest)
+		if err != nil {
+			d.logger.Error(ctx, "cannot delete merged slots",
+				option.Any("model_id", model.ID),
+				option.Any("slot_ids", rest),
+				option.Error(err))
+
+			return err
+		}
+		if deleted != int64(len(rest)) {
+			d.logger.Error(ctx, "merged slots are taken meanwhile",
+				option.Any("model_id", model.ID),
+				option.Any("slot_ids", rest),
+				option.Error(service_errors.ErrSlotNotAvailable))
+
+			return service_errors.ErrSlotNotAvailable
+		}
+
+		merged.EndTime = slots[len(slots)-1].EndTime
+		if res, err = d.slotRepo.UpdateAvailable(ctx, merged); err != nil {
+			if errors.Is(err, persistence.ErrNoRowsFound) {
+				d.logger.Error(ctx, "merged slot is taken meanwhile",
+					option.Any("model_id", model.ID),
+					option.Any("slot_id", merged.ID),
+					option.Error(service_errors.ErrSlotNotAvailable))
+
+				return service_errors.ErrSlotNotAvailable
+			}
+
+			d.logger.Error(ctx, "cannot extend merged slot",
+				option.Any("model_id", model.ID),
+				option.Any("slot_id", merged.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultSlotService) GetSlotsWithModelIDByModel(ctx context.Context, filter *entity.SlotFilter,
+	page, limit *int64) ([]*entity.Slot, error) {
+
 	authID, err := common.GetAuthIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = d.checkSlotFilter(ctx, authID, filter); err != nil {
+		return nil, err
+	}
+
 	model, err := d.checkModelRestrictions(ctx, authID)
 	if err != nil {
 		return nil, err
 	}
 
-	slots, err := d.slotRepo.GetByModelID(ctx, model.ID)
+	slots, err := d.slotRepo.GetByModelID(ctx, model.ID, filter,
+		entity.NewOptions(common.CheckPagination(page, limit)))
 	if err != nil {
 		d.logger.Error(ctx, "failed to find slots for model",
 			option.Any("auth_id", authID),
@@ -283,12 +675,38 @@ func (d *DefaultSlotService) GetSlotsWithModelIDByModel(ctx context.Context) ([]
 	return slots, nil
 }
 
-func (d *DefaultSlotService) GetSlotsWithModelIDByClient(ctx context.Context, modelID int64) ([]*entity.Slot, error) {
+// GetSlotsWithModelIDByClient shows the future available slots unless the filter says otherwise,
+// the disabled slots are never shown to a client.
+func (d *DefaultSlotService) GetSlotsWithModelIDByClient(ctx context.Context, modelID int64,
+	filter *entity.SlotFilter, page, limit *int64) ([]*entity.Slot, error) {
+
 	authID, err := common.GetAuthIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if filter.Status == nil {
+		status := entity.SlotAvailable
+		filter.Status = &status
+	}
+	if filter.From == nil {
+		now := time.Now()
+		filter.From = &now
+	}
+
+	if err = d.checkSlotFilter(ctx, authID, filter); err != nil {
+		return nil, err
+	}
+
+	if *filter.Status == entity.SlotDisabled {
+		d.logger.Error(ctx, "disabled slots are asked by client",
+			option.Any("auth_id", authID),
+			option.Any("model_id", modelID),
+			option.Error(service_errors.ErrInvalidSlotStatusFilter))
+
+		return nil, service_errors.ErrInvalidSlotStatusFilter
+	}
+
 	err = d.checkClientRestrictions(ctx, authID)
 	if err != nil {
 		return nil, err
@@ -319,7 +737,8 @@ func (d *DefaultSlotService) GetSlotsWithModelIDByClient(ctx context.Context, mo
 		return nil, service_errors.ErrNotAModel
 	}
 
-	slots, err := d.slotRepo.GetByModelID(ctx, modelID)
+	slots, err := d.slotRepo.GetByModelID(ctx, modelID, filter,
+		entity.NewOptions(common.CheckPagination(page, limit)))
 	if err != nil {
 		d.logger.Error(ctx, "failed to find slots for model",
 			option.Any("auth_id", authID),
@@ -329,14 +748,30 @@ func (d *DefaultSlotService) GetSlotsWithModelIDByClient(ctx context.Context, mo
 		return nil, err
 	}
 
-	activeSlots := make([]*entity.Slot, 0, len(slots))
-	for _, slot := range slots {
-		if slot.Status != entity.SlotDisabled {
-			activeSlots = append(activeSlots, slot)
-		}
+	return slots, nil
+}
+
+func (d *DefaultSlotService) checkSlotFilter(ctx context.Context, authID *int64, filter *entity.SlotFilter) error {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		d.logger.Error(ctx, "slot filter range start must be before its end",
+			option.Any("auth_id", authID),
+			option.Any("from", filter.From),
+			option.Any("to", filter.To),
+			option.Error(service_errors.ErrInvalidSlotRange))
+
+		return service_errors.ErrInvalidSlotRange
+	}
+
+	if filter.Status != nil && !filter.Status.IsValid() {
+		d.logger.Error(ctx, "unknown slot status in filter",
+			option.Any("auth_id", authID),
+			option.Any("status", filter.Status),
+			option.Error(service_errors.ErrInvalidSlotStatusFilter))
+
+		return service_errors.ErrInvalidSlotStatusFilter
 	}
 
-	return activeSlots, nil
+	return nil
 }
 
 func (d *DefaultSlotService) checkModelRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
@@ -422,3 +857,303 @@ func (d *DefaultSlotService) checkClientRestrictions(ctx context.Context, authID
 
 	return nil
 }
+
+func (d *DefaultSlotService) getOwnAvailableSlot(ctx context.Context, modelID, slotID int64) (*entity.Slot, error) {
+	slot, err := d.slotRepo.GetByID(ctx, slotID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "slot is not found by id",
+				option.Any("model_id", modelID),
+				option.Any("slot_id", slotID),
+				option.Error(service_errors.ErrSlotIsNotFound))
+
+			return nil, service_errors.ErrSlotIsNotFound
+		}
+
+		d.logger.Error(ctx, "failed to find slot",
+			option.Any("model_id", modelID),
+			option.Any("slot_id", slotID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if slot.ModelID != modelID {
+		d.logger.Error(ctx, "slot is not owned by this model",
+			option.Any("model_id", modelID),
+			option.Any("slot_id", slotID),
+			option.Error(service_errors.ErrModelIsNotAnOwnerOfSlot))
+
+		return nil, service_errors.ErrModelIsNotAnOwnerOfSlot
+	}
+
+	if !slot.IsAvailable() {
+		d.logger.Error(ctx, "slot is not available",
+			option.Any("model_id", modelID),
+			option.Any("slot_id", slotID),
+			option.Error(service_errors.ErrSlotNotAvailable))
+
+		return nil, service_errors.ErrSlotNotAvailable
+	}
+
+	return slot, nil
+}
+
+// checkMergedSlots makes sure every slot asked for is found, belongs to the model, is available
+// and starts when the previous one ends, the slots go by the start time.
+func (d *DefaultSlotService) checkMergedSlots(ctx context.Context, modelID int64, slotIDs []int64,
+	slots []*entity.Slot) error {
+
+	var err error
+	switch {
+	case len(slots) != len(slotIDs):
+		err = service_errors.ErrSlotIsNotFound
+	case slices.ContainsFunc(slots, func(slot *entity.Slot) bool { return slot.ModelID != modelID }):
+		err = service_errors.ErrModelIsNotAnOwnerOfSlot
+	case slices.ContainsFunc(slots, func(slot *entity.Slot) bool { return !slot.IsAvailable() }):
+		err = service_errors.ErrSlotNotAvailable
+	default:
+		for i := 1; i < len(slots); i++ {
+			if !slots[i-1].EndTime.Equal(slots[i].StartTime) {
+				err = service_errors.ErrSlotsNotAdjacent
+				break
+			}
+		}
+	}
+
+	if err != nil {
+		d.logger.Error(ctx, "slots cannot be merged",
+			option.Any("model_id", modelID),
+			option.Any("slot_ids", slotIDs),
+			option.Error(err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (d *DefaultSlotService) checkNoBookings(ctx context.Context, modelID int64, slotIDs []int64) error {
+	booked, err := d.slotRepo.HasBookings(ctx, slotIDs)
+	if err != nil {
+		d.logger.Error(ctx, "failed to check bookings of slots",
+			option.Any("model_id", modelID),
+			option.Any("slot_ids", slotIDs),
+			option.Error(err))
+
+		return err
+	}
+
+	if booked {
+		d.logger.Error(ctx, "slots have bookings",
+			option.Any("model_id", modelID),
+			option.Any("slot_ids", slotIDs),
+			option.Error(service_errors.ErrSlotHasBookings))
+
+		return service_errors.ErrSlotHasBookings
+	}
+
+	return nil
+}
+
+// checkRules makes sure the slot keeps to the booking rules of the model.
+func (d *DefaultSlotService) checkRules(ctx context.Context, slot *entity.Slot) error {
+	rules, err := d.rules.RulesOf(ctx, slot.ModelID)
+	if err != nil {
+		return err
+	}
+
+	if err = checkSlotRules(slot, rules, time.Now()); err != nil {
+		d.logger.Error(ctx, "slot breaks the booking rules of model",
+			option.Any("model_id", slot.ModelID),
+			option.Any("slot_id", slot.ID),
+			option.Error(err))
+
+		return err
+	}
+
+	return nil
+}
+
+// checkPlacement makes sure the slot neither lies within a time-off of the model, nor overlaps the other
+// slots of the model, nor lies within the travel buffer of one of them.
+func (d *DefaultSlotService) checkPlacement(ctx context.Context, slot *entity.Slot) error {
+	timeOffs, err := d.getTimeOffs(ctx, slot.ModelID, slot.StartTime, slot.EndTime)
+	if err != nil {
+		return err
+	}
+
+	if len(timeOffs) > 0 {
+		d.logger.Error(ctx, "slot lies within a time-off of model",
+			option.Any("model_id", slot.ModelID),
+			option.Any("slot_id", slot.ID),
+			option.Any("time_off_id", timeOffs[0].ID),
+			option.Error(service_errors.ErrSlotWithinTimeOff))
+
+		return service_errors.ErrSlotWithinTimeOff
+	}
+
+	buffer, err := d.buffers.BufferOf(ctx, slot.ModelID)
+	if err != nil {
+		return err
+	}
+
+	from, to := buffer.Window(slot.StartTime, slot.EndTime)
+	nearby, err := d.slotRepo.GetOverlappingSlots(ctx, slot.ModelID, from, to)
+	if err != nil {
+		d.logger.Error(ctx, "cannot get overlaps slot for model",
+			option.Any("model_id", slot.ModelID),
+			option.Error(err))
+
+		return err
+	}
+
+	others := make([]*entity.Slot, 0, len(nearby))
+	for _, other := range nearby {
+		if other.ID != slot.ID {
+			others = append(others, other)
+		}
+	}
+
+	if err = checkSlotPlacement(slot, others, buffer); err != nil {
+		d.logger.Error(ctx, "slot cannot be placed among the slots of model",
+			option.Any("model_id", slot.ModelID),
+			option.Any("slot_id", slot.ID),
+			option.Error(err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (d *DefaultSlotService) getTimeOffs(ctx context.Context, modelID int64,
+	start, end time.Time) ([]*entity.TimeOff, error) {
+
+	res, err := d.timeOffRepo.GetOverlapping(ctx, modelID, start, end)
+	if err != nil {
+		d.logger.Error(ctx, "cannot get time-offs for model",
+			option.Any("model_id", modelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// checkSlotRules gives ErrSlotInThePast for a slot that has started, ErrSlotBeyondHorizon for a slot starting
+// too far ahead and ErrInvalidSlotDuration for a slot too short or too long.
+func checkSlotRules(slot *entity.Slot, rules *entity.BookingRules, now time.Time) error {
+	switch {
+	case slot.StartTime.Before(now):
+		return service_errors.ErrSlotInThePast
+	case !rules.WithinHorizon(slot.StartTime, now):
+		return service_errors.ErrSlotBeyondHorizon
+	case !rules.FitsDuration(slot.StartTime, slot.EndTime):
+		return service_errors.ErrInvalidSlotDuration
+	}
+
+	return nil
+}
+
+// checkSlotPlacement gives ErrSlotOverlap for a slot sharing time with another one and ErrSlotWithinTravelBuffer
+// for a slot too close to it. A disabled slot needs no buffer, the model goes nowhere for it.
+func checkSlotPlacement(slot *entity.Slot, others []*entity.Slot, buffer *entity.TravelBuffer) error {
+	var res error
+	for _, other := range others {
+		if slot.Overlaps(other) {
+			return service_errors.ErrSlotOverlap
+		}
+
+		if other.Status != entity.SlotDisabled && buffer.Collides(slot, other) {
+			res = service_errors.ErrSlotWithinTravelBuffer
+		}
+	}
+
+	return res
+}
+
+// checkBatchSlot gives the error of the slot at index i of the batch, a slot overlapping an existing one
+// is reported as such even if it collides within the batch as well.
+func checkBatchSlot(i int, batch, existing []*entity.Slot, timeOffs []*entity.TimeOff,
+	buffer *entity.TravelBuffer, rules *entity.BookingRules, now time.Time) error {
+
+	slot := batch[i]
+	if !slot.StartTime.Before(slot.EndTime) {
+		return service_errors.ErrIncorrectSlotTime
+	}
+
+	if err := checkSlotRules(slot, rules, now); err != nil {
+		return err
+	}
+
+	if entity.OverlapsAny(slot, timeOffs) {
+		return service_errors.ErrSlotWithinTimeOff
+	}
+
+	if err := checkSlotPlacement(slot, existing, buffer); err != nil {
+		return err
+	}
+
+	var res error
+	for j, other := range batch {
+		if j == i || !other.StartTime.Before(other.EndTime) {
+			continue
+		}
+
+		if slot.Overlaps(other) {
+			return service_errors.ErrSlotOverlapInBatch
+		}
+		if buffer.Collides(slot, other) {
+			res = service_errors.ErrSlotWithinTravelBuffer
+		}
+	}
+
+	return res
+}
+
+// splitPoints checks the way the slot is asked to be split and gives the cut points,
+// the slot must end up in 2 to maxSlotBatchSize pieces.
+func splitPoints(slot *entity.Slot, length *time.Duration, cuts []time.Time) ([]time.Time, error) {
+	if (length == nil) == (len(cuts) == 0) {
+		return nil, service_errors.ErrInvalidSlotSplit
+	}
+
+	if length != nil {
+		total := slot.EndTime.Sub(slot.StartTime)
+		if *length < time.Minute || *length >= total || total > maxSlotBatchSize*(*length) {
+			return nil, service_errors.ErrInvalidSlotSplit
+		}
+
+		return slot.SplitPoints(*length), nil
+	}
+
+	if len(cuts) >= maxSlotBatchSize {
+		return nil, service_errors.ErrInvalidSlotSplit
+	}
+
+	prev := slot.StartTime
+	for _, cut := range cuts {
+		if !cut.After(prev) || !cut.Before(slot.EndTime) {
+			return nil, service_errors.ErrInvalidSlotSplit
+		}
+
+		prev = cut
+	}
+
+	return cuts, nil
+}
+
+func hasDuplicates(ids []int64) bool {
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return true
+		}
+
+		seen[id] = true
+	}
+
+	return false
+}
@@ -23,6 +23,9 @@ type slotServiceTest struct {
 	slotRepo    *mocks.MockSlotRepository
 	bookingRepo *mocks.MockBookingRepository
 	userRepo    *mocks.MockUserRepository
+	timeOffRepo *mocks.MockTimeOffRepository
+	buffers     *mocks.MockTravelBufferProvider
+	rules       *mocks.MockBookingRulesProvider
 	txManager   *mocks.MockTxManager
 	service     *DefaultSlotService
 }
@@ -34,6 +37,9 @@ func setUpSlotServiceTest(t *testing.T) *slotServiceTest {
 	slotRepo := mocks.NewMockSlotRepository(ctrl)
 	bookingRepo := mocks.NewMockBookingRepository(ctrl)
 	userRepo := mocks.NewMockUserRepository(ctrl)
+	timeOffRepo := mocks.NewMockTimeOffRepository(ctrl)
+	buffers := mocks.NewMockTravelBufferProvider(ctrl)
+	rules := mocks.NewMockBookingRulesProvider(ctrl)
 	mockTxManager := mocks.NewMockTxManager(ctrl)
 
 	cfg := &config.LogConfig{}
@@ -47,7 +53,7 @@ func setUpSlotServiceTest(t *testing.T) *slotServiceTest {
 	}
 
 	slotService := NewDefaultSlotService(
-		slotRepo, bookingRepo, userRepo, mockTxManager, log,
+		slotRepo, bookingRepo, userRepo, timeOffRepo, buffers, rules, mockTxManager, log,
 	)
 
 	return &slotServiceTest{
@@ -55,6 +61,9 @@ func setUpSlotServiceTest(t *testing.T) *slotServiceTest {
 		slotRepo:    slotRepo,
 		bookingRepo: bookingRepo,
 		userRepo:    userRepo,
+		timeOffRepo: timeOffRepo,
+		buffers:     buffers,
+		rules:       rules,
 		txManager:   mockTxManager,
 		service:     slotService,
 	}
@@ -83,6 +92,8 @@ func TestSlotService_UpdateSlot(t *testing.T) {
 
 	newStart := existingSlot.StartTime.Add(1 * time.Hour)
 	newEnd := existingSlot.EndTime.Add(1 * time.Hour)
+	pastStart := time.Now().Add(-time.Hour)
+	longEnd := existingSlot.StartTime.Add(25 * time.Hour)
 
 	updatedSlot := &entity.Slot{
 		ID:        1,
@@ -106,6 +117,7 @@ func TestSlotService_UpdateSlot(t *testing.T) {
 		mockOverlapsErr error
 		mockUpdateSlot  *entity.Slot
 		mockUpdateErr   error
+		breaksRules     bool
 		expectedError   error
 	}{
 		{
@@ -158,6 +170,41 @@ func TestSlotService_UpdateSlot(t *testing.T) {
 			mockUpdateErr: errors.New("update failed"),
 			expectedError: errors.New("update failed"),
 		},
+		{
+			name:      "slot moved to the past",
+			ctx:       ctxModel,
+			slotID:    1,
+			start:     &pastStart,
+			mockModel: verifiedModel,
+			mockSlot: &entity.Slot{ID: 1, ModelID: 1, StartTime: existingSlot.StartTime,
+				EndTime: existingSlot.EndTime, Status: entity.SlotAvailable},
+			breaksRules:   true,
+			expectedError: service_errors.ErrSlotInThePast,
+		},
+		{
+			name:      "slot stretched beyond the longest slot",
+			ctx:       ctxModel,
+			slotID:    1,
+			end:       &longEnd,
+			mockModel: verifiedModel,
+			mockSlot: &entity.Slot{ID: 1, ModelID: 1, StartTime: existingSlot.StartTime,
+				EndTime: existingSlot.EndTime, Status: entity.SlotAvailable},
+			breaksRules:   true,
+			expectedError: service_errors.ErrInvalidSlotDuration,
+		},
+		{
+			name:      "overlapping slot saved concurrently",
+			ctx:       ctxModel,
+			slotID:    1,
+			start:     &newStart,
+			end:       &newEnd,
+			mockModel: verifiedModel,
+			mockSlot: &entity.Slot{ID: 1, ModelID: 1, StartTime: existingSlot.StartTime,
+				EndTime: existingSlot.EndTime, Status: entity.SlotAvailable},
+			mockOverlaps:  []*entity.Slot{},
+			mockUpdateErr: persistence.ErrRangeOverlap,
+			expectedError: service_errors.ErrSlotOverlap,
+		},
 	}
 
 	for _, tt := range tests {
@@ -191,16 +238,33 @@ func TestSlotService_UpdateSlot(t *testing.T) {
 								newEndTime = *tt.end
 							}
 
-							test.slotRepo.EXPECT().
-								GetOverlappingSlots(gomock.Any(), tt.mockSlot.ModelID, newStartTime, newEndTime).
-								Return(tt.mockOverlaps, tt.mockOverlapsErr).
+							test.rules.EXPECT().
+								RulesOf(gomock.Any(), tt.mockSlot.ModelID).
+								Return(testBookingRules(), nil).
 								Times(1)
 
-							if tt.mockOverlapsErr == nil && len(tt.mockOverlaps) == 0 {
+							if !tt.breaksRules {
+								test.timeOffRepo.EXPECT().
+									GetOverlapping(gomock.Any(), tt.mockSlot.ModelID, newStartTime, newEndTime).
+									Return(nil, nil).
+									Times(1)
+
+								test.buffers.EXPECT().
+									BufferOf(gomock.Any(), tt.mockSlot.ModelID).
+									Return(&entity.TravelBuffer{ModelID: tt.mockSlot.ModelID}, nil).
+									Times(1)
+
 								test.slotRepo.EXPECT().
-									Update(gomock.Any(), gomock.Any()).
-									Return(tt.mockUpdateSlot, tt.mockUpdateErr).
+									GetOverlappingSlots(gomock.Any(), tt.mockSlot.ModelID, newStartTime, newEndTime).
+									Return(tt.mockOverlaps, tt.mockOverlapsErr).
 									Times(1)
+
+								if tt.mockOverlapsErr == nil && len(tt.mockOverlaps) == 0 {
+									test.slotRepo.EXPECT().
+										Update(gomock.Any(), gomock.Any()).
+										Return(tt.mockUpdateSlot, tt.mockUpdateErr).
+										Times(1)
+								}
 							}
 						} else {
 							test.slotRepo.EXPECT().
@@ -340,6 +404,465 @@ func TestSlotService_DeactivateSlot(t *testing.T) {
 	}
 }
 
+func TestSlotService_CreateSlot(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 1, AuthID: 1, IsVerified: true}
+
+	start := time.Now().Add(14 * 24 * time.Hour).Truncate(time.Hour)
+	end := start.Add(2 * time.Hour)
+	buffer := &entity.TravelBuffer{ModelID: 1, Before: 30 * time.Minute, After: time.Hour}
+
+	tests := []struct {
+		name          string
+		mockTimeOffs  []*entity.TimeOff
+		mockBuffer    *entity.TravelBuffer
+		mockNearby    []*entity.Slot
+		mockSaveErr   error
+		expectedError error
+	}{
+		{
+			name:       "adjacent slot is fine without buffer",
+			mockBuffer: &entity.TravelBuffer{ModelID: 1},
+			mockNearby: []*entity.Slot{
+				{ID: 2, ModelID: 1, StartTime: end, EndTime: end.Add(time.Hour), Status: entity.SlotAvailable},
+			},
+		},
+		{
+			name:       "slot far enough from the taken one",
+			mockBuffer: buffer,
+			mockNearby: []*entity.Slot{
+				{ID: 2, ModelID: 1, StartTime: end.Add(time.Hour), EndTime: end.Add(2 * time.Hour),
+					Status: entity.SlotBooked},
+			},
+		},
+		{
+			name:       "disabled slot needs no buffer",
+			mockBuffer: buffer,
+			mockNearby: []*entity.Slot{
+				{ID: 2, ModelID: 1, StartTime: end, EndTime: end.Add(time.Hour), Status: entity.SlotDisabled},
+			},
+		},
+		{
+			name:       "slot starts within the buffer after the previous slot",
+			mockBuffer: buffer,
+			mockNearby: []*entity.Slot{
+				{ID: 2, ModelID: 1, StartTime: start.Add(-2 * time.Hour), EndTime: start.Add(-30 * time.Minute),
+					Status: entity.SlotBooked},
+			},
+			expectedError: service_errors.ErrSlotWithinTravelBuffer,
+		},
+		{
+			name:       "overlap is reported before the buffer",
+			mockBuffer: buffer,
+			mockNearby: []*entity.Slot{
+				{ID: 2, ModelID: 1, StartTime: end, EndTime: end.Add(time.Hour), Status: entity.SlotReserved},
+				{ID: 3, ModelID: 1, StartTime: start, EndTime: end, Status: entity.SlotDisabled},
+			},
+			expectedError: service_errors.ErrSlotOverlap,
+		},
+		{
+			name:          "overlapping slot saved concurrently",
+			mockBuffer:    &entity.TravelBuffer{ModelID: 1},
+			mockSaveErr:   persistence.ErrRangeOverlap,
+			expectedError: service_errors.ErrSlotOverlap,
+		},
+		{
+			name: "slot within a time-off",
+			mockTimeOffs: []*entity.TimeOff{
+				{ID: 4, ModelID: 1, StartTime: start.Add(time.Hour), EndTime: end.Add(24 * time.Hour)},
+			},
+			expectedError: service_errors.ErrSlotWithinTimeOff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpSlotServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+				Return(verifiedModel, nil).
+				Times(1)
+
+			test.rules.EXPECT().
+				RulesOf(gomock.Any(), verifiedModel.ID).
+				Return(testBookingRules(), nil).
+				Times(1)
+
+			test.timeOffRepo.EXPECT().
+				GetOverlapping(gomock.Any(), verifiedModel.ID, start, end).
+				Return(tt.mockTimeOffs, nil).
+				Times(1)
+
+			if tt.mockBuffer != nil {
+				test.buffers.EXPECT().
+					BufferOf(gomock.Any(), verifiedModel.ID).
+					Return(tt.mockBuffer, nil).
+					Times(1)
+
+				from, to := tt.mockBuffer.Window(start, end)
+				test.slotRepo.EXPECT().
+					GetOverlappingSlots(gomock.Any(), verifiedModel.ID, from, to).
+					Return(tt.mockNearby, nil).
+					Times(1)
+			}
+
+			if tt.expectedError == nil || tt.mockSaveErr != nil {
+				test.slotRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					Return(tt.mockSaveErr).
+					Times(1)
+			}
+
+			slot, err := test.service.CreateSlot(ctxModel, start, end)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, slot)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, start, slot.StartTime)
+			assert.Equal(t, end, slot.EndTime)
+		})
+	}
+}
+
+func TestSlotService_CreateSlot_BookingRules(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 1, AuthID: 1, IsVerified: true}
+
+	start := time.Now().Add(14 * 24 * time.Hour).Truncate(time.Hour)
+	tighter := &entity.BookingRules{ModelID: 1, Horizon: 7 * 24 * time.Hour, MinSlotDuration: time.Hour,
+		MaxSlotDuration: 3 * time.Hour}
+
+	tests := []struct {
+		name          string
+		start         time.Time
+		end           time.Time
+		mockRules     *entity.BookingRules
+		expectedError error
+	}{
+		{
+			name:          "slot in the past",
+			start:         time.Now().Add(-2 * time.Hour),
+			end:           time.Now().Add(-time.Hour),
+			expectedError: service_errors.ErrSlotInThePast,
+		},
+		{
+			name:          "slot beyond the horizon",
+			start:         start.Add(400 * 24 * time.Hour),
+			end:           start.Add(400*24*time.Hour + time.Hour),
+			expectedError: service_errors.ErrSlotBeyondHorizon,
+		},
+		{
+			name:          "slot shorter than the shortest one",
+			start:         start,
+			end:           start.Add(10 * time.Minute),
+			expectedError: service_errors.ErrInvalidSlotDuration,
+		},
+		{
+			name:          "slot longer than the longest one",
+			start:         start,
+			end:           start.Add(25 * time.Hour),
+			expectedError: service_errors.ErrInvalidSlotDuration,
+		},
+		{
+			name:          "slot beyond the horizon of the model",
+			start:         start,
+			end:           start.Add(time.Hour),
+			mockRules:     tighter,
+			expectedError: service_errors.ErrSlotBeyondHorizon,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpSlotServiceTest(t)
+			defer test.ctrl.Finish()
+
+			rules := tt.mockRules
+			if rules == nil {
+				rules = testBookingRules()
+			}
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+				Return(verifiedModel, nil).
+				Times(1)
+
+			test.rules.EXPECT().
+				RulesOf(gomock.Any(), verifiedModel.ID).
+				Return(rules, nil).
+				Times(1)
+
+			slot, err := test.service.CreateSlot(ctxModel, tt.start, tt.end)
+
+			assert.EqualError(t, err, tt.expectedError.Error())
+			assert.Nil(t, slot)
+		})
+	}
+}
+
+func TestSlotService_CreateSlots(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 1, AuthID: 1, IsVerified: true}
+
+	base := time.Now().Add(14 * 24 * time.Hour).Truncate(time.Hour)
+	period := func(startHour, endHour int) entity.SlotPeriod {
+		return entity.SlotPeriod{
+			Start: base.Add(time.Duration(startHour) * time.Hour),
+			End:   base.Add(time.Duration(endHour) * time.Hour),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		periods       []entity.SlotPeriod
+		mockBuffer    *entity.TravelBuffer
+		mockTimeOffs  []*entity.TimeOff
+		mockExisting  []*entity.Slot
+		expectTx      bool
+		expectSave    bool
+		mockSaveErr   error
+		expectedItems []service_errors.SlotBatchItemError
+		expectedError error
+	}{
+		{
+			name:       "whole batch is created",
+			periods:    []entity.SlotPeriod{period(0, 1), period(1, 2), period(3, 4)},
+			expectTx:   true,
+			expectSave: true,
+		},
+		{
+			name:     "every rejected slot is reported",
+			periods:  []entity.SlotPeriod{period(0, 2), period(1, 3), period(5, 4), period(6, 7), period(8, 9)},
+			expectTx: true,
+			mockExisting: []*entity.Slot{
+				{ID: 9, ModelID: 1, StartTime: base.Add(6 * time.Hour), EndTime: base.Add(7 * time.Hour)},
+			},
+			expectedItems: []service_errors.SlotBatchItemError{
+				{Index: 0, Err: service_errors.ErrSlotOverlapInBatch},
+				{Index: 1, Err: service_errors.ErrSlotOverlapInBatch},
+				{Index: 2, Err: service_errors.ErrIncorrectSlotTime},
+				{Index: 3, Err: service_errors.ErrSlotOverlap},
+			},
+			expectedError: service_errors.ErrSlotBatchRejected,
+		},
+		{
+			name:       "slots too close to each other or to a taken slot are rejected",
+			periods:    []entity.SlotPeriod{period(0, 1), period(1, 2), period(5, 6), period(8, 9)},
+			mockBuffer: &entity.TravelBuffer{ModelID: 1, Before: 30 * time.Minute, After: 30 * time.Minute},
+			expectTx:   true,
+			mockExisting: []*entity.Slot{
+				{ID: 8, ModelID: 1, StartTime: base.Add(4 * time.Hour), EndTime: base.Add(5 * time.Hour),
+					Status: entity.SlotBooked},
+				{ID: 9, ModelID: 1, StartTime: base.Add(7 * time.Hour), EndTime: base.Add(8 * time.Hour),
+					Status: entity.SlotDisabled},
+			},
+			expectedItems: []service_errors.SlotBatchItemError{
+				{Index: 0, Err: service_errors.ErrSlotWithinTravelBuffer},
+				{Index: 1, Err: service_errors.ErrSlotWithinTravelBuffer},
+				{Index: 2, Err: service_errors.ErrSlotWithinTravelBuffer},
+			},
+			expectedError: service_errors.ErrSlotBatchRejected,
+		},
+		{
+			name:     "slots within a time-off are rejected",
+			periods:  []entity.SlotPeriod{period(0, 1), period(2, 3), period(4, 5)},
+			expectTx: true,
+			mockTimeOffs: []*entity.TimeOff{
+				{ID: 4, ModelID: 1, StartTime: base.Add(150 * time.Minute), EndTime: base.Add(6 * time.Hour)},
+			},
+			expectedItems: []service_errors.SlotBatchItemError{
+				{Index: 1, Err: service_errors.ErrSlotWithinTimeOff},
+				{Index: 2, Err: service_errors.ErrSlotWithinTimeOff},
+			},
+			expectedError: service_errors.ErrSlotBatchRejected,
+		},
+		{
+			name: "slots breaking the booking rules are rejected",
+			periods: []entity.SlotPeriod{
+				period(-14*24-2, -14*24-1), period(0, 1), period(400*24, 400*24+1), period(2, 27),
+			},
+			expectTx: true,
+			expectedItems: []service_errors.SlotBatchItemError{
+				{Index: 0, Err: service_errors.ErrSlotInThePast},
+				{Index: 2, Err: service_errors.ErrSlotBeyondHorizon},
+				{Index: 3, Err: service_errors.ErrInvalidSlotDuration},
+			},
+			expectedError: service_errors.ErrSlotBatchRejected,
+		},
+		{
+			name:          "failed to save",
+			periods:       []entity.SlotPeriod{period(0, 1)},
+			expectTx:      true,
+			expectSave:    true,
+			mockSaveErr:   errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+		{
+			name:          "overlapping slots saved concurrently",
+			periods:       []entity.SlotPeriod{period(0, 1), period(1, 2)},
+			expectTx:      true,
+			expectSave:    true,
+			mockSaveErr:   persistence.ErrRangeOverlap,
+			expectedError: service_errors.ErrSlotOverlap,
+		},
+		{
+			name:          "empty batch",
+			expectedError: service_errors.ErrInvalidSlotBatchSize,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpSlotServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.expectTx {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+					Return(verifiedModel, nil).
+					Times(1)
+
+				test.rules.EXPECT().
+					RulesOf(gomock.Any(), verifiedModel.ID).
+					Return(testBookingRules(), nil).
+					Times(1)
+
+				buffer := tt.mockBuffer
+				if buffer == nil {
+					buffer = &entity.TravelBuffer{ModelID: verifiedModel.ID}
+				}
+				test.buffers.EXPECT().
+					BufferOf(gomock.Any(), verifiedModel.ID).
+					Return(buffer, nil).
+					Times(1)
+
+				test.timeOffRepo.EXPECT().
+					GetOverlapping(gomock.Any(), verifiedModel.ID, gomock.Any(), gomock.Any()).
+					Return(tt.mockTimeOffs, nil).
+					Times(1)
+
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.slotRepo.EXPECT().
+					GetOverlappingSlots(gomock.Any(), verifiedModel.ID, gomock.Any(), gomock.Any()).
+					Return(tt.mockExisting, nil).
+					Times(1)
+			}
+
+			if tt.expectSave {
+				test.slotRepo.EXPECT().
+					SaveAll(gomock.Any(), gomock.Len(len(tt.periods))).
+					Return(tt.mockSaveErr).
+					Times(1)
+			}
+
+			slots, err := test.service.CreateSlots(ctxModel, tt.periods)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, slots)
+
+				var batchErr *service_errors.SlotBatchError
+				if tt.expectedItems != nil && assert.ErrorAs(t, err, &batchErr) {
+					assert.Equal(t, tt.expectedItems, batchErr.Items)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, slots, len(tt.periods))
+			for _, slot := range slots {
+				assert.Equal(t, verifiedModel.ID, slot.ModelID)
+				assert.Equal(t, entity.SlotAvailable, slot.Status)
+			}
+		})
+	}
+}
+
+func TestSlotService_DisableSlotsInRange(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 1, AuthID: 1, IsVerified: true}
+
+	from := time.Date(2026, time.November, 2, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	slot := func(id int64, startHour int, status entity.SlotStatus) *entity.Slot {
+		return &entity.Slot{
+			ID: id, ModelID: 1, Status: status,
+			StartTime: from.Add(time.Duration(startHour) * time.Hour),
+			EndTime:   from.Add(time.Duration(startHour+2) * time.Hour),
+		}
+	}
+
+	t.Run("available slots are disabled, others are skipped", func(t *testing.T) {
+		test := setUpSlotServiceTest(t)
+		defer test.ctrl.Finish()
+
+		available := slot(1, 10, entity.SlotAvailable)
+		reservedMeanwhile := slot(2, 12, entity.SlotAvailable)
+		booked := slot(3, 14, entity.SlotBooked)
+		disabled := slot(4, 16, entity.SlotDisabled)
+		partlyOutside := slot(5, 23, entity.SlotAvailable)
+
+		test.userRepo.EXPECT().
+			GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+			Return(verifiedModel, nil).
+			Times(1)
+
+		test.txManager.EXPECT().
+			WithTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).
+			Times(1)
+
+		test.slotRepo.EXPECT().
+			GetOverlappingSlots(gomock.Any(), verifiedModel.ID, from, to).
+			Return([]*entity.Slot{available, reservedMeanwhile, booked, disabled, partlyOutside}, nil).
+			Times(1)
+
+		test.slotRepo.EXPECT().
+			DisableAvailable(gomock.Any(), []int64{1, 2}).
+			Return([]*entity.Slot{{ID: 1, ModelID: 1, Status: entity.SlotDisabled}}, nil).
+			Times(1)
+
+		res, skipped, err := test.service.DisableSlotsInRange(ctxModel, from, to)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entity.Slot{{ID: 1, ModelID: 1, Status: entity.SlotDisabled}}, res)
+		assert.Equal(t, []*entity.Slot{reservedMeanwhile, booked, disabled}, skipped)
+	})
+
+	t.Run("range ends before it starts", func(t *testing.T) {
+		test := setUpSlotServiceTest(t)
+		defer test.ctrl.Finish()
+
+		res, skipped, err := test.service.DisableSlotsInRange(ctxModel, to, from)
+
+		assert.ErrorIs(t, err, service_errors.ErrInvalidSlotRange)
+		assert.Nil(t, res)
+		assert.Nil(t, skipped)
+	})
+}
+
 func TestSlotService_GetSlotsWithModelIDByClient(t *testing.T) {
 	test := setUpSlotServiceTest(t)
 	defer test.ctrl.Finish()
@@ -361,36 +884,72 @@ func TestSlotService_GetSlotsWithModelIDByClient(t *testing.T) {
 
 	slots := []*entity.Slot{
 		{ID: 1, ModelID: modelID, Status: entity.SlotAvailable},
-		{ID: 2, ModelID: modelID, Status: entity.SlotDisabled},
-		{ID: 3, ModelID: modelID, Status: entity.SlotBooked},
+		{ID: 3, ModelID: modelID, Status: entity.SlotAvailable},
 	}
 
+	from := time.Now().Add(24 * time.Hour)
+	to := from.Add(48 * time.Hour)
+	booked := entity.SlotBooked
+	disabled := entity.SlotDisabled
+
 	tests := []struct {
-		name          string
-		ctx           context.Context
-		modelID       int64
-		mockClient    *entity.User
-		mockClientErr error
-		mockModel     *entity.User
-		mockModelErr  error
-		mockSlots     []*entity.Slot
-		mockSlotsErr  error
-		expectedError error
-		expectedCount int
+		name           string
+		ctx            context.Context
+		modelID        int64
+		filter         *entity.SlotFilter
+		mockClient     *entity.User
+		mockClientErr  error
+		mockModel      *entity.User
+		mockModelErr   error
+		mockSlots      []*entity.Slot
+		mockSlotsErr   error
+		expectedFrom   *time.Time
+		expectedStatus entity.SlotStatus
+		expectedError  error
+		expectedCount  int
 	}{
 		{
-			name:          "successful get slots",
+			name:           "future available slots by default",
+			ctx:            ctxClient,
+			modelID:        modelID,
+			filter:         &entity.SlotFilter{},
+			mockClient:     verifiedClient,
+			mockModel:      verifiedModel,
+			mockSlots:      slots,
+			expectedStatus: entity.SlotAvailable,
+			expectedCount:  2,
+		},
+		{
+			name:           "filter is passed to the repo",
+			ctx:            ctxClient,
+			modelID:        modelID,
+			filter:         &entity.SlotFilter{From: &from, To: &to, Status: &booked},
+			mockClient:     verifiedClient,
+			mockModel:      verifiedModel,
+			mockSlots:      []*entity.Slot{{ID: 4, ModelID: modelID, Status: entity.SlotBooked}},
+			expectedFrom:   &from,
+			expectedStatus: entity.SlotBooked,
+			expectedCount:  1,
+		},
+		{
+			name:          "disabled slots are not shown to client",
 			ctx:           ctxClient,
 			modelID:       modelID,
-			mockClient:    verifiedClient,
-			mockModel:     verifiedModel,
-			mockSlots:     slots,
-			expectedCount: 2,
+			filter:        &entity.SlotFilter{Status: &disabled},
+			expectedError: service_errors.ErrInvalidSlotStatusFilter,
+		},
+		{
+			name:          "range ends before it starts",
+			ctx:           ctxClient,
+			modelID:       modelID,
+			filter:        &entity.SlotFilter{From: &to, To: &from},
+			expectedError: service_errors.ErrInvalidSlotRange,
 		},
 		{
 			name:          "client not verified",
 			ctx:           ctxClient,
 			modelID:       modelID,
+			filter:        &entity.SlotFilter{},
 			mockClient:    &entity.User{ID: 1, IsVerified: false},
 			expectedError: service_errors.ErrNotVerifiedClient,
 		},
@@ -398,6 +957,7 @@ func TestSlotService_GetSlotsWithModelIDByClient(t *testing.T) {
 			name:          "model not found",
 			ctx:           ctxClient,
 			modelID:       modelID,
+			filter:        &entity.SlotFilter{},
 			mockClient:    verifiedClient,
 			mockModelErr:  persistence.ErrNoRowsFound,
 			expectedError: service_errors.ErrNotAModel,
@@ -406,24 +966,27 @@ func TestSlotService_GetSlotsWithModelIDByClient(t *testing.T) {
 			name:          "model not verified",
 			ctx:           ctxClient,
 			modelID:       modelID,
+			filter:        &entity.SlotFilter{},
 			mockClient:    verifiedClient,
 			mockModel:     &entity.User{ID: modelID, IsVerified: false},
 			expectedError: service_errors.ErrNotAModel,
 		},
 		{
-			name:          "slots repo error",
-			ctx:           ctxClient,
-			modelID:       modelID,
-			mockClient:    verifiedClient,
-			mockModel:     verifiedModel,
-			mockSlotsErr:  errors.New("db error"),
-			expectedError: errors.New("db error"),
+			name:           "slots repo error",
+			ctx:            ctxClient,
+			modelID:        modelID,
+			filter:         &entity.SlotFilter{},
+			mockClient:     verifiedClient,
+			mockModel:      verifiedModel,
+			mockSlotsErr:   errors.New("db error"),
+			expectedStatus: entity.SlotAvailable,
+			expectedError:  errors.New("db error"),
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if tt.ctx.Value(service_const.RoleKey) == "CLIENT" {
+			if tt.mockClient != nil {
 				authID := tt.ctx.Value(service_const.AuthIDKey).(int64)
 				test.userRepo.EXPECT().
 					GetByAuthID(gomock.Any(), authID).
@@ -438,14 +1001,25 @@ func TestSlotService_GetSlotsWithModelIDByClient(t *testing.T) {
 					Times(1)
 
 				if tt.mockModelErr == nil && tt.mockModel != nil && tt.mockModel.IsVerified {
+					calledAt := time.Now()
 					test.slotRepo.EXPECT().
-						GetByModelID(gomock.Any(), tt.modelID).
-						Return(tt.mockSlots, tt.mockSlotsErr).
+						GetByModelID(gomock.Any(), tt.modelID, gomock.Any(), entity.NewOptions(0, 0)).
+						DoAndReturn(func(_ context.Context, _ int64, filter *entity.SlotFilter,
+							_ *entity.Options) ([]*entity.Slot, error) {
+							assert.Equal(t, tt.expectedStatus, *filter.Status)
+							if tt.expectedFrom != nil {
+								assert.Equal(t, tt.expectedFrom, filter.From)
+							} else {
+								assert.False(t, filter.From.Before(calledAt))
+							}
+
+							return tt.mockSlots, tt.mockSlotsErr
+						}).
 						Times(1)
 				}
 			}
 
-			result, err := test.service.GetSlotsWithModelIDByClient(tt.ctx, tt.modelID)
+			result, err := test.service.GetSlotsWithModelIDByClient(tt.ctx, tt.modelID, tt.filter, nil, nil)
 
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
@@ -454,11 +1028,78 @@ func TestSlotService_GetSlotsWithModelIDByClient(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
 				assert.Len(t, result, tt.expectedCount)
+			}
+		})
+	}
+}
 
-				for _, slot := range result {
-					assert.NotEqual(t, entity.SlotDisabled, slot.Status)
-				}
+func TestSlotService_GetSlotsWithModelIDByModel(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 1, AuthID: 1, IsVerified: true}
+
+	from := time.Date(2026, time.November, 2, 0, 0, 0, 0, time.UTC)
+	to := from.Add(7 * 24 * time.Hour)
+	disabled := entity.SlotDisabled
+	unknown := entity.SlotStatus("LOST")
+	page, limit := int64(3), int64(20)
+
+	tests := []struct {
+		name          string
+		filter        *entity.SlotFilter
+		expectGet     bool
+		expectedError error
+	}{
+		{
+			name:      "model sees every status",
+			filter:    &entity.SlotFilter{},
+			expectGet: true,
+		},
+		{
+			name:      "model sees its disabled slots",
+			filter:    &entity.SlotFilter{From: &from, To: &to, Status: &disabled},
+			expectGet: true,
+		},
+		{
+			name:          "range ends before it starts",
+			filter:        &entity.SlotFilter{From: &to, To: &from},
+			expectedError: service_errors.ErrInvalidSlotRange,
+		},
+		{
+			name:          "unknown status",
+			filter:        &entity.SlotFilter{Status: &unknown},
+			expectedError: service_errors.ErrInvalidSlotStatusFilter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpSlotServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.expectGet {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+					Return(verifiedModel, nil).
+					Times(1)
+
+				test.slotRepo.EXPECT().
+					GetByModelID(gomock.Any(), verifiedModel.ID, tt.filter, entity.NewOptions(page, limit)).
+					Return([]*entity.Slot{}, nil).
+					Times(1)
 			}
+
+			slots, err := test.service.GetSlotsWithModelIDByModel(ctxModel, tt.filter, &page, &limit)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, slots)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, slots)
 		})
 	}
 }
@@ -621,3 +1262,412 @@ func TestSlotService_CheckClientRestrictions_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestSlotService_SplitSlot(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 1, AuthID: 1, IsVerified: true}
+
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+	templateID := int64(7)
+	newSlot := func(status entity.SlotStatus, modelID int64) *entity.Slot {
+		return &entity.Slot{ID: 10, ModelID: modelID, TemplateID: &templateID,
+			StartTime: at(0), EndTime: at(240), Status: status}
+	}
+	minutes := func(m int) *time.Duration {
+		d := time.Duration(m) * time.Minute
+		return &d
+	}
+
+	tests := []struct {
+		name          string
+		mockSlot      *entity.Slot
+		mockGetErr    error
+		length        *time.Duration
+		cuts          []time.Time
+		expectCheck   bool
+		mockBooked    bool
+		expectSplit   bool
+		mockUpdateErr error
+		mockSaveErr   error
+		expectedEdges []time.Time
+		expectedError error
+	}{
+		{
+			name:          "split into pieces of the length",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			length:        minutes(60),
+			expectCheck:   true,
+			expectSplit:   true,
+			expectedEdges: []time.Time{at(0), at(60), at(120), at(180), at(240)},
+		},
+		{
+			name:          "last piece keeps the rest",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			length:        minutes(90),
+			expectCheck:   true,
+			expectSplit:   true,
+			expectedEdges: []time.Time{at(0), at(90), at(180), at(240)},
+		},
+		{
+			name:          "split at the cut points",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			cuts:          []time.Time{at(60), at(180)},
+			expectCheck:   true,
+			expectSplit:   true,
+			expectedEdges: []time.Time{at(0), at(60), at(180), at(240)},
+		},
+		{
+			name:          "both length and cut points",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			length:        minutes(60),
+			cuts:          []time.Time{at(60)},
+			expectedError: service_errors.ErrInvalidSlotSplit,
+		},
+		{
+			name:          "neither length nor cut points",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			expectedError: service_errors.ErrInvalidSlotSplit,
+		},
+		{
+			name:          "length is not shorter than the slot",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			length:        minutes(240),
+			expectedError: service_errors.ErrInvalidSlotSplit,
+		},
+		{
+			name:          "too many pieces",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			length:        minutes(2),
+			expectedError: service_errors.ErrInvalidSlotSplit,
+		},
+		{
+			name:          "cut points are not ascending",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			cuts:          []time.Time{at(180), at(60)},
+			expectedError: service_errors.ErrInvalidSlotSplit,
+		},
+		{
+			name:          "cut point at the end of the slot",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			cuts:          []time.Time{at(60), at(240)},
+			expectedError: service_errors.ErrInvalidSlotSplit,
+		},
+		{
+			name:          "pieces shorter than the shortest slot",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			length:        minutes(20),
+			expectedError: service_errors.ErrInvalidSlotDuration,
+		},
+		{
+			name:          "slot has bookings",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			length:        minutes(60),
+			expectCheck:   true,
+			mockBooked:    true,
+			expectedError: service_errors.ErrSlotHasBookings,
+		},
+		{
+			name:          "slot is not available",
+			mockSlot:      newSlot(entity.SlotReserved, 1),
+			length:        minutes(60),
+			expectedError: service_errors.ErrSlotNotAvailable,
+		},
+		{
+			name:          "slot of another model",
+			mockSlot:      newSlot(entity.SlotAvailable, 2),
+			length:        minutes(60),
+			expectedError: service_errors.ErrModelIsNotAnOwnerOfSlot,
+		},
+		{
+			name:          "slot not found",
+			mockGetErr:    persistence.ErrNoRowsFound,
+			length:        minutes(60),
+			expectedError: service_errors.ErrSlotIsNotFound,
+		},
+		{
+			name:          "pieces overlap a concurrently saved slot",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			length:        minutes(60),
+			expectCheck:   true,
+			expectSplit:   true,
+			mockSaveErr:   persistence.ErrRangeOverlap,
+			expectedError: service_errors.ErrSlotOverlap,
+		},
+		{
+			name:          "slot is reserved meanwhile",
+			mockSlot:      newSlot(entity.SlotAvailable, 1),
+			length:        minutes(60),
+			expectCheck:   true,
+			expectSplit:   true,
+			mockUpdateErr: persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrSlotNotAvailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpSlotServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), int64(1)).
+				Return(verifiedModel, nil).
+				Times(1)
+
+			test.rules.EXPECT().
+				RulesOf(gomock.Any(), verifiedModel.ID).
+				Return(testBookingRules(), nil).
+				Times(1)
+
+			test.txManager.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).
+				Times(1)
+
+			test.slotRepo.EXPECT().
+				GetByID(gomock.Any(), int64(10)).
+				Return(tt.mockSlot, tt.mockGetErr).
+				Times(1)
+
+			if tt.expectCheck {
+				test.slotRepo.EXPECT().
+					HasBookings(gomock.Any(), []int64{10}).
+					Return(tt.mockBooked, nil).
+					Times(1)
+			}
+
+			if tt.expectSplit {
+				test.slotRepo.EXPECT().
+					UpdateAvailable(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, slot *entity.Slot) (*entity.Slot, error) {
+						if tt.mockUpdateErr != nil {
+							return nil, tt.mockUpdateErr
+						}
+						return slot, nil
+					}).
+					Times(1)
+			}
+
+			if tt.expectSplit && tt.mockUpdateErr == nil {
+				test.slotRepo.EXPECT().
+					SaveAll(gomock.Any(), gomock.Any()).
+					Return(tt.mockSaveErr).
+					Times(1)
+			}
+
+			res, err := test.service.SplitSlot(ctxModel, 10, tt.length, tt.cuts)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, res, len(tt.expectedEdges)-1)
+			assert.Equal(t, int64(10), res[0].ID)
+			for i, piece := range res {
+				assert.Equal(t, tt.expectedEdges[i], piece.StartTime)
+				assert.Equal(t, tt.expectedEdges[i+1], piece.EndTime)
+				assert.Equal(t, entity.SlotAvailable, piece.Status)
+				assert.Equal(t, &templateID, piece.TemplateID)
+			}
+		})
+	}
+}
+
+func TestSlotService_MergeSlots(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 1, AuthID: 1, IsVerified: true}
+
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	slot := func(id int64, fromHour, toHour int) *entity.Slot {
+		return &entity.Slot{ID: id, ModelID: 1, StartTime: start.Add(time.Duration(fromHour) * time.Hour),
+			EndTime: start.Add(time.Duration(toHour) * time.Hour), Status: entity.SlotAvailable}
+	}
+	adjacent := func() []*entity.Slot {
+		return []*entity.Slot{slot(1, 0, 1), slot(2, 1, 2), slot(3, 2, 3)}
+	}
+
+	tests := []struct {
+		name          string
+		ids           []int64
+		mockSlots     []*entity.Slot
+		expectCheck   bool
+		mockBooked    bool
+		expectDelete  bool
+		mockDeleted   int64
+		mockUpdateErr error
+		mockRules     *entity.BookingRules
+		expectedError error
+	}{
+		{
+			name:         "adjacent slots are merged into the earliest",
+			ids:          []int64{3, 1, 2},
+			mockSlots:    adjacent(),
+			expectCheck:  true,
+			expectDelete: true,
+			mockDeleted:  2,
+		},
+		{
+			name:          "single slot",
+			ids:           []int64{1},
+			expectedError: service_errors.ErrInvalidSlotMerge,
+		},
+		{
+			name:          "repeated slot",
+			ids:           []int64{1, 2, 1},
+			expectedError: service_errors.ErrInvalidSlotMerge,
+		},
+		{
+			name:          "slot not found",
+			ids:           []int64{1, 2, 4},
+			mockSlots:     adjacent()[:2],
+			expectedError: service_errors.ErrSlotIsNotFound,
+		},
+		{
+			name: "slot of another model",
+			ids:  []int64{1, 2},
+			mockSlots: func() []*entity.Slot {
+				slots := adjacent()[:2]
+				slots[1].ModelID = 2
+				return slots
+			}(),
+			expectedError: service_errors.ErrModelIsNotAnOwnerOfSlot,
+		},
+		{
+			name: "slot is not available",
+			ids:  []int64{1, 2},
+			mockSlots: func() []*entity.Slot {
+				slots := adjacent()[:2]
+				slots[0].Status = entity.SlotBooked
+				return slots
+			}(),
+			expectedError: service_errors.ErrSlotNotAvailable,
+		},
+		{
+			name:          "gap between slots",
+			ids:           []int64{1, 3},
+			mockSlots:     []*entity.Slot{slot(1, 0, 1), slot(3, 2, 3)},
+			expectedError: service_errors.ErrSlotsNotAdjacent,
+		},
+		{
+			name:      "merged slot longer than the longest one",
+			ids:       []int64{1, 2, 3},
+			mockSlots: adjacent(),
+			mockRules: &entity.BookingRules{ModelID: 1, Horizon: 365 * 24 * time.Hour,
+				MaxSlotDuration: 2 * time.Hour},
+			expectedError: service_errors.ErrInvalidSlotDuration,
+		},
+		{
+			name:          "slots have bookings",
+			ids:           []int64{1, 2, 3},
+			mockSlots:     adjacent(),
+			expectCheck:   true,
+			mockBooked:    true,
+			expectedError: service_errors.ErrSlotHasBookings,
+		},
+		{
+			name:          "slot is taken meanwhile",
+			ids:           []int64{1, 2, 3},
+			mockSlots:     adjacent(),
+			expectCheck:   true,
+			expectDelete:  true,
+			mockDeleted:   1,
+			expectedError: service_errors.ErrSlotNotAvailable,
+		},
+		{
+			name:          "earliest slot is reserved meanwhile",
+			ids:           []int64{1, 2, 3},
+			mockSlots:     adjacent(),
+			expectCheck:   true,
+			expectDelete:  true,
+			mockDeleted:   2,
+			mockUpdateErr: persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrSlotNotAvailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpSlotServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.mockSlots != nil {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), int64(1)).
+					Return(verifiedModel, nil).
+					Times(1)
+
+				rules := tt.mockRules
+				if rules == nil {
+					rules = testBookingRules()
+				}
+				test.rules.EXPECT().
+					RulesOf(gomock.Any(), verifiedModel.ID).
+					Return(rules, nil).
+					Times(1)
+
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.slotRepo.EXPECT().
+					GetByIDs(gomock.Any(), tt.ids).
+					Return(tt.mockSlots, nil).
+					Times(1)
+			}
+
+			if tt.expectCheck {
+				test.slotRepo.EXPECT().
+					HasBookings(gomock.Any(), tt.ids).
+					Return(tt.mockBooked, nil).
+					Times(1)
+			}
+
+			if tt.expectDelete {
+				test.slotRepo.EXPECT().
+					DeleteUnbooked(gomock.Any(), []int64{2, 3}).
+					Return(tt.mockDeleted, nil).
+					Times(1)
+			}
+
+			if tt.expectDelete && (tt.expectedError == nil || tt.mockUpdateErr != nil) {
+				test.slotRepo.EXPECT().
+					UpdateAvailable(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, slot *entity.Slot) (*entity.Slot, error) {
+						if tt.mockUpdateErr != nil {
+							return nil, tt.mockUpdateErr
+						}
+						return slot, nil
+					}).
+					Times(1)
+			}
+
+			res, err := test.service.MergeSlots(ctxModel, tt.ids)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), res.ID)
+			assert.Equal(t, start, res.StartTime)
+			assert.Equal(t, start.Add(3*time.Hour), res.EndTime)
+		})
+	}
+}
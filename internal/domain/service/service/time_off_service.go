@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+const maxTimeOffLength = 366 * 24 * time.Hour
+
+type DefaultTimeOffService struct {
+	timeOffRepo interfaces.TimeOffRepository
+	slotRepo    interfaces.SlotRepository
+	userRepo    interfaces.UserRepository
+	txManager   database.TxManager
+	logger      pkg.Logger
+}
+
+func NewDefaultTimeOffService(timeOffRepo interfaces.TimeOffRepository, slotRepo interfaces.SlotRepository,
+	userRepo interfaces.UserRepository, txManager database.TxManager, logger pkg.Logger) *DefaultTimeOffService {
+	return &DefaultTimeOffService{
+		timeOffRepo: timeOffRepo,
+		slotRepo:    slotRepo,
+		userRepo:    userRepo,
+		txManager:   txManager,
+		logger:      logger,
+	}
+}
+
+// GetTimeOffs returns the time-offs of the model that are not over yet.
+func (d *DefaultTimeOffService) GetTimeOffs(ctx context.Context) ([]*entity.TimeOff, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.timeOffRepo.GetByModelID(ctx, model.ID, time.Now())
+	if err != nil {
+		d.logger.Error(ctx, "failed to get time-offs by model id",
+			option.Any("model_id", model.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CreateTimeOff disables the available slots sharing time with the time-off in one transaction.
+// The reserved and booked slots there are left as they are and returned as conflicts.
+func (d *DefaultTimeOffService) CreateTimeOff(ctx context.Context, start, end time.Time,
+	reason *string) (*entity.TimeOffChange, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !start.Before(end) || !end.After(time.Now()) || end.Sub(start) > maxTimeOffLength {
+		d.logger.Error(ctx, "invalid time-off",
+			option.Any("auth_id", authID),
+			option.Any("start", start),
+			option.Any("end", end),
+			option.Error(service_errors.ErrInvalidTimeOff))
+
+		return nil, service_errors.ErrInvalidTimeOff
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &entity.TimeOffChange{
+		TimeOff:   entity.NewTimeOff(model.ID, start, end, reason),
+		Conflicts: []*entity.Slot{},
+	}
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err = d.timeOffRepo.Save(ctx, res.TimeOff); err != nil {
+			if errors.Is(err, persistence.ErrRangeOverlap) {
+				d.logger.Error(ctx, "time-off overlaps another time-off of model",
+					option.Any("model_id", model.ID),
+					option.Error(service_errors.ErrTimeOffOverlap))
+
+				return service_errors.ErrTimeOffOverlap
+			}
+
+			d.logger.Error(ctx, "failed to save time-off",
+				option.Any("model_id", model.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		overlaps, err := d.slotRepo.GetOverlappingSlots(ctx, model.ID, start, end)
+		if err != nil {
+			d.logger.Error(ctx, "cannot get slots under time-off for model",
+				option.Any("model_id", model.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		var ids []int64
+		for _, slot := range overlaps {
+			switch slot.Status {
+			case entity.SlotAvailable:
+				ids = append(ids, slot.ID)
+			case entity.SlotReserved, entity.SlotBooked:
+				res.Conflicts = append(res.Conflicts, slot)
+			}
+		}
+
+		if res.Disabled, err = d.slotRepo.DisableForTimeOff(ctx, res.TimeOff.ID, ids); err != nil {
+			d.logger.Error(ctx, "cannot disable slots for time-off",
+				option.Any("time_off_id", res.TimeOff.ID),
+				option.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteTimeOff makes the slots the time-off has disabled available again, except the ones whose time
+// another slot of the model has taken meanwhile and the ones under an imported busy event.
+func (d *DefaultTimeOffService) DeleteTimeOff(ctx context.Context, id int64) (*entity.TimeOffChange, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeOff, err := d.getOwnTimeOff(ctx, id, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &entity.TimeOffChange{
+		TimeOff:   timeOff,
+		Conflicts: []*entity.Slot{},
+	}
+	err = d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if res.Enabled, err = d.slotRepo.ReleaseTimeOff(ctx, id); err != nil {
+			d.logger.Error(ctx, "cannot enable slots of time-off",
+				option.Any("time_off_id", id),
+				option.Error(err))
+
+			return err
+		}
+
+		if err = d.timeOffRepo.Delete(ctx, id); err != nil {
+			if errors.Is(err, persistence.ErrNoRowsAffected) {
+				return service_errors.ErrTimeOffNotFound
+			}
+
+			d.logger.Error(ctx, "failed to delete time-off",
+				option.Any("time_off_id", id),
+				option.Error(err))
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultTimeOffService) getOwnTimeOff(ctx context.Context, id, modelID int64) (*entity.TimeOff, error) {
+	timeOff, err := d.timeOffRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "time-off is not found by id",
+				option.Any("time_off_id", id),
+				option.Error(service_errors.ErrTimeOffNotFound))
+
+			return nil, service_errors.ErrTimeOffNotFound
+		}
+
+		d.logger.Error(ctx, "failed to get time-off by id",
+			option.Any("time_off_id", id),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if timeOff.ModelID != modelID {
+		d.logger.Error(ctx, "model is not an owner of time-off",
+			option.Any("time_off_id", id),
+			option.Any("model_id", modelID),
+			option.Error(service_errors.ErrModelIsNotAnOwnerOfTimeOff))
+
+		return nil, service_errors.ErrModelIsNotAnOwnerOfTimeOff
+	}
+
+	return timeOff, nil
+}
+
+func (d *DefaultTimeOffService) checkModelRestrictions(ctx context.Context, authID *int64) (*entity.User, error) {
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleModel.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAModel))
+
+		return nil, service_errors.ErrNotAModel
+	}
+
+	model, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotAModel))
+
+			return nil, service_errors.ErrNotAModel
+		}
+
+		d.logger.Error(ctx, "check model restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !model.IsUserVerified() {
+		d.logger.Error(ctx, "model is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedModel))
+
+		return nil, service_errors.ErrNotVerifiedModel
+	}
+
+	return model, nil
+}
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type timeOffServiceTest struct {
+	ctrl        *gomock.Controller
+	timeOffRepo *mocks.MockTimeOffRepository
+	slotRepo    *mocks.MockSlotRepository
+	userRepo    *mocks.MockUserRepository
+	txManager   *mocks.MockTxManager
+	service     *DefaultTimeOffService
+}
+
+func setUpTimeOffServiceTest(t *testing.T) *timeOffServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	timeOffRepo := mocks.NewMockTimeOffRepository(ctrl)
+	slotRepo := mocks.NewMockSlotRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+	txManager := mocks.NewMockTxManager(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &timeOffServiceTest{
+		ctrl:        ctrl,
+		timeOffRepo: timeOffRepo,
+		slotRepo:    slotRepo,
+		userRepo:    userRepo,
+		txManager:   txManager,
+		service:     NewDefaultTimeOffService(timeOffRepo, slotRepo, userRepo, txManager, log),
+	}
+}
+
+func TestTimeOffService_CreateTimeOff(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	end := start.Add(7 * 24 * time.Hour)
+	slot := func(id int64, status entity.SlotStatus) *entity.Slot {
+		slotStart := start.Add(time.Duration(id) * time.Hour)
+		return &entity.Slot{ID: id, ModelID: 5, StartTime: slotStart, EndTime: slotStart.Add(time.Hour),
+			Status: status}
+	}
+	reason := "vacation"
+
+	tests := []struct {
+		name              string
+		start             time.Time
+		end               time.Time
+		expectTx          bool
+		mockSaveErr       error
+		mockSlots         []*entity.Slot
+		expectedDisabled  []int64
+		expectedConflicts []int64
+		expectedError     error
+	}{
+		{
+			name:     "available slots are disabled and taken ones are conflicts",
+			start:    start,
+			end:      end,
+			expectTx: true,
+			mockSlots: []*entity.Slot{
+				slot(1, entity.SlotAvailable), slot(2, entity.SlotReserved), slot(3, entity.SlotDisabled),
+				slot(4, entity.SlotBooked), slot(5, entity.SlotAvailable),
+			},
+			expectedDisabled:  []int64{1, 5},
+			expectedConflicts: []int64{2, 4},
+		},
+		{
+			name:     "no slots within the time-off",
+			start:    start,
+			end:      end,
+			expectTx: true,
+		},
+		{
+			name:          "time-off overlaps another one",
+			start:         start,
+			end:           end,
+			expectTx:      true,
+			mockSaveErr:   persistence.ErrRangeOverlap,
+			expectedError: service_errors.ErrTimeOffOverlap,
+		},
+		{
+			name:          "failed to save",
+			start:         start,
+			end:           end,
+			expectTx:      true,
+			mockSaveErr:   errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+		{
+			name:          "end before start",
+			start:         end,
+			end:           start,
+			expectedError: service_errors.ErrInvalidTimeOff,
+		},
+		{
+			name:          "time-off is over",
+			start:         start.Add(-30 * 24 * time.Hour),
+			end:           start.Add(-25 * 24 * time.Hour),
+			expectedError: service_errors.ErrInvalidTimeOff,
+		},
+		{
+			name:          "time-off is too long",
+			start:         start,
+			end:           start.Add(367 * 24 * time.Hour),
+			expectedError: service_errors.ErrInvalidTimeOff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpTimeOffServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.expectTx {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+					Return(verifiedModel, nil).
+					Times(1)
+
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.timeOffRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, timeOff *entity.TimeOff) error {
+						timeOff.ID = 7
+						return tt.mockSaveErr
+					}).
+					Times(1)
+			}
+
+			if tt.expectTx && tt.mockSaveErr == nil {
+				test.slotRepo.EXPECT().
+					GetOverlappingSlots(gomock.Any(), verifiedModel.ID, tt.start, tt.end).
+					Return(tt.mockSlots, nil).
+					Times(1)
+
+				test.slotRepo.EXPECT().
+					DisableForTimeOff(gomock.Any(), int64(7), tt.expectedDisabled).
+					DoAndReturn(func(_ context.Context, _ int64, ids []int64) ([]*entity.Slot, error) {
+						res := make([]*entity.Slot, len(ids))
+						for i, id := range ids {
+							res[i] = slot(id, entity.SlotDisabled)
+						}
+						return res, nil
+					}).
+					Times(1)
+			}
+
+			res, err := test.service.CreateTimeOff(ctxModel, tt.start, tt.end, &reason)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(7), res.TimeOff.ID)
+			assert.Equal(t, verifiedModel.ID, res.TimeOff.ModelID)
+			assert.Equal(t, &reason, res.TimeOff.Reason)
+			assert.ElementsMatch(t, tt.expectedDisabled, slotIDs(res.Disabled))
+			assert.ElementsMatch(t, tt.expectedConflicts, slotIDs(res.Conflicts))
+			assert.NotNil(t, res.Conflicts)
+		})
+	}
+}
+
+func TestTimeOffService_DeleteTimeOff(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	timeOff := &entity.TimeOff{ID: 7, ModelID: 5, StartTime: start, EndTime: start.Add(48 * time.Hour)}
+	enabled := []*entity.Slot{
+		{ID: 1, ModelID: 5, StartTime: start, EndTime: start.Add(time.Hour), Status: entity.SlotAvailable},
+	}
+
+	tests := []struct {
+		name          string
+		mockTimeOff   *entity.TimeOff
+		mockGetErr    error
+		expectTx      bool
+		mockDeleteErr error
+		expectedError error
+	}{
+		{
+			name:        "disabled slots are enabled again",
+			mockTimeOff: timeOff,
+			expectTx:    true,
+		},
+		{
+			name:          "time-off not found",
+			mockGetErr:    persistence.ErrNoRowsFound,
+			expectedError: service_errors.ErrTimeOffNotFound,
+		},
+		{
+			name:          "time-off of another model",
+			mockTimeOff:   &entity.TimeOff{ID: 7, ModelID: 6, StartTime: start, EndTime: start.Add(time.Hour)},
+			expectedError: service_errors.ErrModelIsNotAnOwnerOfTimeOff,
+		},
+		{
+			name:          "time-off deleted concurrently",
+			mockTimeOff:   timeOff,
+			expectTx:      true,
+			mockDeleteErr: persistence.ErrNoRowsAffected,
+			expectedError: service_errors.ErrTimeOffNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpTimeOffServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.userRepo.EXPECT().
+				GetByAuthID(gomock.Any(), verifiedModel.AuthID).
+				Return(verifiedModel, nil).
+				Times(1)
+
+			test.timeOffRepo.EXPECT().
+				GetByID(gomock.Any(), int64(7)).
+				Return(tt.mockTimeOff, tt.mockGetErr).
+				Times(1)
+
+			if tt.expectTx {
+				test.txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).
+					Times(1)
+
+				test.slotRepo.EXPECT().
+					ReleaseTimeOff(gomock.Any(), int64(7)).
+					Return(enabled, nil).
+					Times(1)
+
+				test.timeOffRepo.EXPECT().
+					Delete(gomock.Any(), int64(7)).
+					Return(tt.mockDeleteErr).
+					Times(1)
+			}
+
+			res, err := test.service.DeleteTimeOff(ctxModel, 7)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, timeOff, res.TimeOff)
+			assert.Equal(t, enabled, res.Enabled)
+			assert.Empty(t, res.Conflicts)
+		})
+	}
+}
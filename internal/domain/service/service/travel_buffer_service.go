@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+const maxTravelBuffer = 12 * time.Hour
+
+type DefaultTravelBufferService struct {
+	bufferRepo interfaces.TravelBufferRepository
+	userRepo   interfaces.UserRepository
+	logger     pkg.Logger
+}
+
+func NewDefaultTravelBufferService(bufferRepo interfaces.TravelBufferRepository,
+	userRepo interfaces.UserRepository, logger pkg.Logger) *DefaultTravelBufferService {
+	return &DefaultTravelBufferService{
+		bufferRepo: bufferRepo,
+		userRepo:   userRepo,
+		logger:     logger,
+	}
+}
+
+func (d *DefaultTravelBufferService) GetBuffer(ctx context.Context) (*entity.TravelBuffer, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.BufferOf(ctx, model.ID)
+}
+
+// UpdateBuffer sets the buffer and the travel fee for the slots created and booked from now on, the slots
+// and the bookings the model already has are left as they are.
+func (d *DefaultTravelBufferService) UpdateBuffer(ctx context.Context,
+	beforeMinutes, afterMinutes int, fee entity.Money) (*entity.TravelBuffer, error) {
+
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	before := time.Duration(beforeMinutes) * time.Minute
+	after := time.Duration(afterMinutes) * time.Minute
+	if before < 0 || after < 0 || before > maxTravelBuffer || after > maxTravelBuffer || fee.IsNegative() {
+		d.logger.Error(ctx, "invalid travel buffer",
+			option.Any("auth_id", authID),
+			option.Any("before_minutes", beforeMinutes),
+			option.Any("after_minutes", afterMinutes),
+			option.Any("fee", fee),
+			option.Error(service_errors.ErrInvalidTravelBuffer))
+
+		return nil, service_errors.ErrInvalidTravelBuffer
+	}
+
+	model, err := d.checkModelRestrictions(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.bufferRepo.Save(ctx, entity.NewTravelBuffer(model.ID, before, after, fee))
+	if err != nil {
+		d.logger.Error(ctx, "failed to save travel buffer",
+			option.Any("model_id", model.ID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// BufferOf gives the buffer of the model, a model that has not set it has no buffer and no fee.
+func (d *DefaultTravelBufferService) BufferOf(ctx context.Context, modelID int64) (*entity.TravelBuffer, error) {
+	res, err := d.bufferRepo.GetByModelID(ctx, modelID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			return &entity.TravelBuffer{ModelID: modelID}, nil
+		}
+
+		d.logger.Error(ctx, "failed to get travel buffer by model id",
+			option.Any("model_id", modelID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultTravelBufferService) checkModelRestrictions(ctx context.Context,
+	authID *int64) (*entity.User, error) {
+
+	role, err := common.GetRoleFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if *role != entity.RoleModel.String() {
+		d.logger.Error(ctx, "access denied",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotAModel))
+
+		return nil, service_errors.ErrNotAModel
+	}
+
+	model, err := d.userRepo.GetByAuthID(ctx, *authID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			d.logger.Error(ctx, "model is not found by authID",
+				option.Any("auth_id", authID),
+				option.Error(service_errors.ErrNotAModel))
+
+			return nil, service_errors.ErrNotAModel
+		}
+
+		d.logger.Error(ctx, "check model restrictions failed",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	if !model.IsUserVerified() {
+		d.logger.Error(ctx, "model is not verified",
+			option.Any("auth_id", authID),
+			option.Error(service_errors.ErrNotVerifiedModel))
+
+		return nil, service_errors.ErrNotVerifiedModel
+	}
+
+	return model, nil
+}
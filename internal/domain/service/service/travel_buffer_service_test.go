@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/mocks"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type travelBufferServiceTest struct {
+	ctrl       *gomock.Controller
+	bufferRepo *mocks.MockTravelBufferRepository
+	userRepo   *mocks.MockUserRepository
+	service    *DefaultTravelBufferService
+}
+
+func setUpTravelBufferServiceTest(t *testing.T) *travelBufferServiceTest {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	bufferRepo := mocks.NewMockTravelBufferRepository(ctrl)
+	userRepo := mocks.NewMockUserRepository(ctrl)
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	tmpDir := os.TempDir()
+	cfg.Logger.LogsDir = tmpDir
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &travelBufferServiceTest{
+		ctrl:       ctrl,
+		bufferRepo: bufferRepo,
+		userRepo:   userRepo,
+		service:    NewDefaultTravelBufferService(bufferRepo, userRepo, log),
+	}
+}
+
+func TestTravelBufferService_UpdateBuffer(t *testing.T) {
+	ctxModel := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+	ctxModel = context.WithValue(ctxModel, service_const.RoleKey, "MODEL")
+
+	verifiedModel := &entity.User{ID: 5, AuthID: 1, IsVerified: true}
+
+	tests := []struct {
+		name           string
+		beforeMinutes  int
+		afterMinutes   int
+		fee            entity.Money
+		mockModel      *entity.User
+		mockSaveErr    error
+		expectedBefore time.Duration
+		expectedAfter  time.Duration
+		expectedError  error
+	}{
+		{
+			name:           "buffer is saved",
+			beforeMinutes:  30,
+			afterMinutes:   45,
+			fee:            rub(500),
+			mockModel:      verifiedModel,
+			expectedBefore: 30 * time.Minute,
+			expectedAfter:  45 * time.Minute,
+		},
+		{
+			name:          "negative buffer",
+			beforeMinutes: -5,
+			expectedError: service_errors.ErrInvalidTravelBuffer,
+		},
+		{
+			name:          "buffer longer than half a day",
+			afterMinutes:  721,
+			expectedError: service_errors.ErrInvalidTravelBuffer,
+		},
+		{
+			name:          "negative fee",
+			fee:           rub(-1),
+			expectedError: service_errors.ErrInvalidTravelBuffer,
+		},
+		{
+			name:          "model not verified",
+			beforeMinutes: 30,
+			mockModel:     &entity.User{ID: 5, AuthID: 1},
+			expectedError: service_errors.ErrNotVerifiedModel,
+		},
+		{
+			name:          "failed to save",
+			beforeMinutes: 30,
+			mockModel:     verifiedModel,
+			mockSaveErr:   errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpTravelBufferServiceTest(t)
+			defer test.ctrl.Finish()
+
+			if tt.mockModel != nil {
+				test.userRepo.EXPECT().
+					GetByAuthID(gomock.Any(), int64(1)).
+					Return(tt.mockModel, nil).
+					Times(1)
+			}
+
+			if tt.mockModel != nil && tt.mockModel.IsVerified {
+				test.bufferRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, b *entity.TravelBuffer) (*entity.TravelBuffer, error) {
+						if tt.mockSaveErr != nil {
+							return nil, tt.mockSaveErr
+						}
+
+						return b, nil
+					}).
+					Times(1)
+			}
+
+			res, err := test.service.UpdateBuffer(ctxModel, tt.beforeMinutes, tt.afterMinutes, tt.fee)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, verifiedModel.ID, res.ModelID)
+			assert.Equal(t, tt.expectedBefore, res.Before)
+			assert.Equal(t, tt.expectedAfter, res.After)
+			assert.Equal(t, tt.fee, res.Fee)
+		})
+	}
+}
+
+func TestTravelBufferService_BufferOf(t *testing.T) {
+	saved := &entity.TravelBuffer{ModelID: 5, Before: 15 * time.Minute, After: time.Hour}
+
+	tests := []struct {
+		name           string
+		mockBuffer     *entity.TravelBuffer
+		mockErr        error
+		expectedBuffer *entity.TravelBuffer
+		expectedError  error
+	}{
+		{
+			name:           "saved buffer",
+			mockBuffer:     saved,
+			expectedBuffer: saved,
+		},
+		{
+			name:           "model without buffer has none",
+			mockErr:        persistence.ErrNoRowsFound,
+			expectedBuffer: &entity.TravelBuffer{ModelID: 5},
+		},
+		{
+			name:          "repo error",
+			mockErr:       errors.New("db error"),
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := setUpTravelBufferServiceTest(t)
+			defer test.ctrl.Finish()
+
+			test.bufferRepo.EXPECT().
+				GetByModelID(gomock.Any(), int64(5)).
+				Return(tt.mockBuffer, tt.mockErr).
+				Times(1)
+
+			res, err := test.service.BufferOf(context.Background(), 5)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedBuffer, res)
+		})
+	}
+}
@@ -3,11 +3,14 @@ package service
 import (
 	"context"
 	"errors"
+	"os"
+	"sync"
 	"time"
 
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/common"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/interfaces"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_const"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/service/service_errors"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
@@ -15,30 +18,63 @@ import (
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
 )
 
+// viewerZoneTTL bounds how late a zone changed through another instance is shown to the caller.
+const viewerZoneTTL = 5 * time.Minute
+
 type DefaultUserService struct {
 	userRepo  interfaces.UserRepository
 	txManager database.TxManager
 	logger    pkg.Logger
+	location  *time.Location
+	// zones keeps the viewerZone of the callers by auth id, so the responses do not read the profile every time.
+	zones sync.Map
+}
+
+type viewerZone struct {
+	location  *time.Location
+	expiresAt time.Time
 }
 
 func NewDefaultUserService(repo interfaces.UserRepository, txManager database.TxManager,
-	logger pkg.Logger) *DefaultUserService {
+	logger pkg.Logger) (*DefaultUserService, error) {
+
+	timezone := os.Getenv(service_const.DotEnvPlatformTimezone)
+	if timezone == "" {
+		return nil, service_errors.ErrLoadingPlatformTimezone
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, service_errors.ErrParsingPlatformTimezone
+	}
+
 	return &DefaultUserService{
 		userRepo:  repo,
 		txManager: txManager,
 		logger:    logger,
-	}
+		location:  location,
+	}, nil
 }
 
 func (d *DefaultUserService) Create(ctx context.Context,
-	name string, birthDate time.Time) (*entity.User, error) {
+	name string, birthDate time.Time, timeZone *string) (*entity.User, error) {
 
 	authID, err := common.GetAuthIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if timeZone != nil && !entity.IsValidTimeZone(*timeZone) {
+		d.logger.Error(ctx, "invalid time zone",
+			option.Any("auth_id", authID),
+			option.Any("time_zone", *timeZone),
+			option.Error(service_errors.ErrInvalidTimeZone))
+
+		return nil, service_errors.ErrInvalidTimeZone
+	}
+
 	res := entity.NewUser(*authID, name, birthDate)
+	res.TimeZone = timeZone
 	err = d.userRepo.Save(ctx, res)
 	if err != nil {
 		d.logger.Error(ctx, "failed to save user",
@@ -47,6 +83,7 @@ func (d *DefaultUserService) Create(ctx context.Context,
 
 		return nil, err
 	}
+	d.zones.Delete(*authID)
 
 	return res, nil
 }
@@ -98,12 +135,22 @@ func (d *DefaultUserService) GetByAuthID(ctx context.Context) (*entity.User, err
 	return user, nil
 }
 
-func (d *DefaultUserService) Update(ctx context.Context, name string) (*entity.User, error) {
+// Update renames the user, the time zone is changed only if it is given.
+func (d *DefaultUserService) Update(ctx context.Context, name string, timeZone *string) (*entity.User, error) {
 	authID, err := common.GetAuthIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if timeZone != nil && !entity.IsValidTimeZone(*timeZone) {
+		d.logger.Error(ctx, "invalid time zone",
+			option.Any("auth_id", authID),
+			option.Any("time_zone", *timeZone),
+			option.Error(service_errors.ErrInvalidTimeZone))
+
+		return nil, service_errors.ErrInvalidTimeZone
+	}
+
 	user, err := d.userRepo.GetByAuthID(ctx, *authID)
 	if err != nil {
 		if errors.Is(err, persistence.ErrNoRowsFound) {
@@ -122,6 +169,9 @@ func (d *DefaultUserService) Update(ctx context.Context, name string) (*entity.U
 	}
 
 	user.Name = name
+	if timeZone != nil {
+		user.TimeZone = timeZone
+	}
 
 	res, err := d.userRepo.Update(ctx, user)
 	if err != nil {
@@ -139,6 +189,63 @@ func (d *DefaultUserService) Update(ctx context.Context, name string) (*entity.U
 
 		return nil, err
 	}
+	d.zones.Delete(*authID)
 
 	return res, nil
 }
+
+// Location is the zone of the user, the platform one unless the user has chosen theirs.
+func (d *DefaultUserService) Location(user *entity.User) *time.Location {
+	return user.Location(d.location)
+}
+
+// ViewerLocation is the zone the times are shown to the caller in, the callers without a profile
+// such as the admins and the users who have just signed up see the platform zone. The zone is kept
+// for viewerZoneTTL, the profile changes made here drop it at once.
+func (d *DefaultUserService) ViewerLocation(ctx context.Context) (*time.Location, error) {
+	authID, err := common.GetAuthIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if cached, ok := d.zones.Load(*authID); ok && now.Before(cached.(viewerZone).expiresAt) {
+		return cached.(viewerZone).location, nil
+	}
+
+	location := d.location
+	user, err := d.userRepo.GetByAuthID(ctx, *authID)
+	switch {
+	case err == nil:
+		location = user.Location(d.location)
+	case !errors.Is(err, persistence.ErrNoRowsFound):
+		d.logger.Error(ctx, "failed to get user",
+			option.Any("auth_id", authID),
+			option.Error(err))
+
+		return nil, err
+	}
+
+	d.zones.Store(*authID, viewerZone{
+		location:  location,
+		expiresAt: now.Add(viewerZoneTTL),
+	})
+
+	return location, nil
+}
+
+// modelLocation is the zone the schedule and the prices of the model are laid out in.
+func modelLocation(ctx context.Context, userRepo interfaces.UserRepository, modelID int64,
+	platform *time.Location) (*time.Location, error) {
+
+	model, err := userRepo.GetByID(ctx, modelID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrNoRowsFound) {
+			return nil, service_errors.ErrUserNotFound
+		}
+
+		return nil, err
+	}
+
+	return model.Location(platform), nil
+}
@@ -42,7 +42,12 @@ func setUpUserServiceTest(t *testing.T) *userServiceTest {
 		t.Fatal(err)
 	}
 
-	userService := NewDefaultUserService(userRepo, mockTxManager, log)
+	t.Setenv(service_const.DotEnvPlatformTimezone, "Europe/Moscow")
+
+	userService, err := NewDefaultUserService(userRepo, mockTxManager, log)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	return &userServiceTest{
 		ctrl:      ctrl,
@@ -60,12 +65,16 @@ func TestUserService_Create(t *testing.T) {
 
 	name := "John Doe"
 	birthDate := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	berlin := "Europe/Berlin"
+	unknown := "Europe/Atlantis"
+	local := "Local"
 
 	tests := []struct {
 		name          string
 		ctx           context.Context
 		nameParam     string
 		birthDate     time.Time
+		timeZone      *string
 		mockSaveErr   error
 		expectedError error
 	}{
@@ -75,6 +84,29 @@ func TestUserService_Create(t *testing.T) {
 			nameParam: name,
 			birthDate: birthDate,
 		},
+		{
+			name:      "user chooses time zone",
+			ctx:       ctx,
+			nameParam: name,
+			birthDate: birthDate,
+			timeZone:  &berlin,
+		},
+		{
+			name:          "unknown time zone",
+			ctx:           ctx,
+			nameParam:     name,
+			birthDate:     birthDate,
+			timeZone:      &unknown,
+			expectedError: service_errors.ErrInvalidTimeZone,
+		},
+		{
+			name:          "server time zone is not accepted",
+			ctx:           ctx,
+			nameParam:     name,
+			birthDate:     birthDate,
+			timeZone:      &local,
+			expectedError: service_errors.ErrInvalidTimeZone,
+		},
 		{
 			name:          "repo save error",
 			ctx:           ctx,
@@ -94,14 +126,14 @@ func TestUserService_Create(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if tt.ctx.Value(service_const.AuthIDKey) != nil {
+			if tt.ctx.Value(service_const.AuthIDKey) != nil && !errors.Is(tt.expectedError, service_errors.ErrInvalidTimeZone) {
 				test.userRepo.EXPECT().
 					Save(gomock.Any(), gomock.Any()).
 					Return(tt.mockSaveErr).
 					Times(1)
 			}
 
-			result, err := test.service.Create(tt.ctx, tt.nameParam, tt.birthDate)
+			result, err := test.service.Create(tt.ctx, tt.nameParam, tt.birthDate, tt.timeZone)
 
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
@@ -112,6 +144,7 @@ func TestUserService_Create(t *testing.T) {
 				assert.Equal(t, tt.nameParam, result.Name)
 				assert.Equal(t, tt.birthDate, result.BirthDate)
 				assert.Equal(t, int64(1), result.AuthID)
+				assert.Equal(t, tt.timeZone, result.TimeZone)
 			}
 		})
 	}
@@ -255,11 +288,18 @@ func TestUserService_Update(t *testing.T) {
 
 	ctx := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
 
-	existingUser := &entity.User{
-		ID:         1,
-		AuthID:     1,
-		Name:       "Old Name",
-		IsVerified: true,
+	moscow := "Europe/Moscow"
+	berlin := "Europe/Berlin"
+	unknown := "Europe/Atlantis"
+
+	existingUser := func() *entity.User {
+		return &entity.User{
+			ID:         1,
+			AuthID:     1,
+			Name:       "Old Name",
+			IsVerified: true,
+			TimeZone:   &moscow,
+		}
 	}
 
 	updatedUser := &entity.User{
@@ -273,18 +313,37 @@ func TestUserService_Update(t *testing.T) {
 		name           string
 		ctx            context.Context
 		nameParam      string
+		timeZone       *string
 		mockGetUser    *entity.User
 		mockGetErr     error
 		mockUpdateUser *entity.User
 		mockUpdateErr  error
+		expectedZone   *string
 		expectedError  error
 	}{
 		{
 			name:           "successful update",
 			ctx:            ctx,
 			nameParam:      "New Name",
-			mockGetUser:    existingUser,
+			mockGetUser:    existingUser(),
+			mockUpdateUser: updatedUser,
+			expectedZone:   &moscow,
+		},
+		{
+			name:           "time zone is changed",
+			ctx:            ctx,
+			nameParam:      "New Name",
+			timeZone:       &berlin,
+			mockGetUser:    existingUser(),
 			mockUpdateUser: updatedUser,
+			expectedZone:   &berlin,
+		},
+		{
+			name:          "unknown time zone",
+			ctx:           ctx,
+			nameParam:     "New Name",
+			timeZone:      &unknown,
+			expectedError: service_errors.ErrInvalidTimeZone,
 		},
 		{
 			name:          "user not found by auth id",
@@ -304,8 +363,9 @@ func TestUserService_Update(t *testing.T) {
 			name:          "update repo error",
 			ctx:           ctx,
 			nameParam:     "New Name",
-			mockGetUser:   existingUser,
+			mockGetUser:   existingUser(),
 			mockUpdateErr: errors.New("update failed"),
+			expectedZone:  &moscow,
 			expectedError: errors.New("update failed"),
 		},
 		{
@@ -318,7 +378,7 @@ func TestUserService_Update(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if tt.ctx.Value(service_const.AuthIDKey) != nil {
+			if tt.ctx.Value(service_const.AuthIDKey) != nil && !errors.Is(tt.expectedError, service_errors.ErrInvalidTimeZone) {
 				authID := tt.ctx.Value(service_const.AuthIDKey).(int64)
 				test.userRepo.EXPECT().
 					GetByAuthID(gomock.Any(), authID).
@@ -328,12 +388,17 @@ func TestUserService_Update(t *testing.T) {
 				if tt.mockGetErr == nil && tt.mockGetUser != nil {
 					test.userRepo.EXPECT().
 						Update(gomock.Any(), gomock.Any()).
-						Return(tt.mockUpdateUser, tt.mockUpdateErr).
+						DoAndReturn(func(_ context.Context, user *entity.User) (*entity.User, error) {
+							assert.Equal(t, tt.nameParam, user.Name)
+							assert.Equal(t, tt.expectedZone, user.TimeZone)
+
+							return tt.mockUpdateUser, tt.mockUpdateErr
+						}).
 						Times(1)
 				}
 			}
 
-			result, err := test.service.Update(tt.ctx, tt.nameParam)
+			result, err := test.service.Update(tt.ctx, tt.nameParam, tt.timeZone)
 
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
@@ -346,3 +411,60 @@ func TestUserService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestUserService_ViewerLocation(t *testing.T) {
+	test := setUpUserServiceTest(t)
+	defer test.ctrl.Finish()
+
+	ctx := context.WithValue(context.Background(), service_const.AuthIDKey, int64(1))
+
+	berlin := "Europe/Berlin"
+	tokyo := "Asia/Tokyo"
+	user := &entity.User{ID: 1, AuthID: 1, Name: "Name", TimeZone: &berlin}
+
+	test.userRepo.EXPECT().
+		GetByAuthID(gomock.Any(), int64(1)).
+		Return(user, nil).
+		Times(1)
+
+	location, err := test.service.ViewerLocation(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, berlin, location.String())
+
+	location, err = test.service.ViewerLocation(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, berlin, location.String(), "the zone is kept without reading the profile again")
+
+	updated := &entity.User{ID: 1, AuthID: 1, Name: "Name", TimeZone: &tokyo}
+	test.userRepo.EXPECT().
+		GetByAuthID(gomock.Any(), int64(1)).
+		Return(user, nil).
+		Times(1)
+	test.userRepo.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		Return(updated, nil).
+		Times(1)
+	test.userRepo.EXPECT().
+		GetByAuthID(gomock.Any(), int64(1)).
+		Return(updated, nil).
+		Times(1)
+
+	_, err = test.service.Update(ctx, "Name", &tokyo)
+	assert.NoError(t, err)
+
+	location, err = test.service.ViewerLocation(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, tokyo, location.String(), "the profile change drops the kept zone")
+
+	other := context.WithValue(context.Background(), service_const.AuthIDKey, int64(2))
+	test.userRepo.EXPECT().
+		GetByAuthID(gomock.Any(), int64(2)).
+		Return(nil, errors.New("db error")).
+		Times(2)
+
+	_, err = test.service.ViewerLocation(other)
+	assert.EqualError(t, err, "db error")
+
+	_, err = test.service.ViewerLocation(other)
+	assert.EqualError(t, err, "db error", "a failed lookup is not kept")
+}
@@ -13,5 +13,19 @@ const (
 )
 
 const (
-	DotEnvBookingExpiration = "BOOKING_TTL"
+	DotEnvBookingExpiration           = "BOOKING_TTL"
+	DotEnvOrderConfirmationExpiration = "ORDER_CONFIRMATION_TTL"
+	DotEnvDisputeWindow               = "DISPUTE_WINDOW_TTL"
+	DotEnvPaymentWebhookSecret        = "PAYMENT_WEBHOOK_SECRET"
+	DotEnvPlatformCommissionRate      = "PLATFORM_COMMISSION_RATE"
+	DotEnvPlatformTimezone            = "PLATFORM_TIMEZONE"
+	DotEnvQuoteSecret                 = "QUOTE_SECRET"
+	DotEnvQuoteExpiration             = "QUOTE_TTL"
+	DotEnvSlotGenerationWeeks         = "SLOT_GENERATION_WEEKS"
+	DotEnvRetentionMonths             = "RETENTION_MONTHS"
+	DotEnvBookingMinNotice            = "BOOKING_MIN_NOTICE_MINUTES"
+	DotEnvBookingHorizon              = "BOOKING_HORIZON_DAYS"
+	DotEnvSlotMinDuration             = "SLOT_MIN_MINUTES"
+	DotEnvSlotMaxDuration             = "SLOT_MAX_MINUTES"
+	DotEnvServicePriceDuration        = "SERVICE_PRICE_MINUTES"
 )
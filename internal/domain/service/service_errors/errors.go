@@ -33,11 +33,12 @@ var (
 )
 
 var (
-	ErrNotVerifiedModel   = errors.New("only verified model can do this action")
-	ErrNotVerifiedClient  = errors.New("only verified client can do this action")
-	ErrInvalidPrice       = errors.New("price should be greater than zero")
-	ErrDescriptionTooLong = errors.New("description too long, must be not greater than 1000 characters")
-	ErrServiceIsNotActive = errors.New("service is not active")
+	ErrNotVerifiedModel    = errors.New("only verified model can do this action")
+	ErrNotVerifiedClient   = errors.New("only verified client can do this action")
+	ErrInvalidPrice        = errors.New("price should be greater than zero and at most 9999999.99")
+	ErrUnsupportedCurrency = errors.New("currency is not supported")
+	ErrDescriptionTooLong  = errors.New("description too long, must be not greater than 1000 characters")
+	ErrServiceIsNotActive  = errors.New("service is not active")
 )
 
 var (
@@ -66,9 +67,176 @@ var (
 	ErrCannotCancelOrderNow    = errors.New("cannot cancel order less than 24h before slot start")
 	ErrCannotCompleteOrder     = errors.New("cannot complete order: either wrong status or slot not finished")
 	ErrClientIsNotOwnerOfOrder = errors.New("client is not owner of this order")
+	ErrCannotConfirmOrder      = errors.New("cannot confirm order: it is not waiting for confirmation")
+	ErrCannotRaiseOrderIssue   = errors.New("cannot raise issue: order is not waiting for confirmation or the window is over")
+
+	ErrInvalidOrderStatusTransition = errors.New("invalid order status transition")
+)
+
+var (
+	ErrCannotTrackOrder         = errors.New("order can be tracked only while it is confirmed or in transit")
+	ErrOrderTrackingUnavailable = errors.New("order tracking is temporarily unavailable")
+)
+
+var (
+	ErrCannotExtendOrder              = errors.New("order can be extended only while it is confirmed or in transit")
+	ErrOrderExtensionNotFound         = errors.New("order extension does not exist")
+	ErrOrderExtensionAlreadyRequested = errors.New("order already has a pending extension request")
+	ErrOrderExtensionAlreadyProcessed = errors.New("order extension already processed")
+)
+
+var (
+	ErrDisputeNotFound       = errors.New("dispute does not exist")
+	ErrDisputeAlreadyExists  = errors.New("dispute for this order already exists")
+	ErrCannotOpenDispute     = errors.New("cannot open dispute: order is cancelled or the dispute window is over")
+	ErrNotDisputeParticipant = errors.New("user is not a participant of this dispute")
+	ErrDisputeIsResolved     = errors.New("dispute is already resolved")
+	ErrNotDisputeAssignee    = errors.New("dispute is assigned to another admin")
+	ErrInvalidRefundAmount   = errors.New("partial refund should be greater than zero and less than the booking price")
+)
+
+var (
+	ErrPaymentNotFound       = errors.New("payment does not exist")
+	ErrPaymentDeclined       = errors.New("payment is declined by the provider")
+	ErrPaymentProviderFailed = errors.New("payment provider failed to process the operation")
+	ErrInvalidPaymentState   = errors.New("payment cannot be processed in its current state")
+	ErrInvalidWebhookSecret  = errors.New("invalid payment webhook secret")
+	ErrLoadingWebhookSecret  = errors.New("error loading PAYMENT_WEBHOOK_SECRET environment variable")
+)
+
+var (
+	ErrLoadingCommissionRate   = errors.New("error loading PLATFORM_COMMISSION_RATE environment variable")
+	ErrParsingCommissionRate   = errors.New("error parsing PLATFORM_COMMISSION_RATE environment variable")
+	ErrInvalidCommissionRate   = errors.New("PLATFORM_COMMISSION_RATE environment variable should be in [0, 1)")
+	ErrUnbalancedLedgerPosting = errors.New("ledger entries of a transaction do not sum to zero")
+)
+
+var (
+	ErrPromoCodeNotFound           = errors.New("promo code does not exist")
+	ErrPromoCodeAlreadyExists      = errors.New("promo code with this code already exists")
+	ErrInvalidPromoCodeDiscount    = errors.New("percent discount should be in [1, 100], fixed discount should be positive")
+	ErrInvalidPromoCodeWindow      = errors.New("promo code validity start must be before its end")
+	ErrInvalidPromoCodeLimit       = errors.New("promo code usage limits should be positive")
+	ErrPromoCodeNotValid           = errors.New("promo code is inactive or outside of its validity window")
+	ErrPromoCodeNotApplicable      = errors.New("promo code does not apply to this service")
+	ErrPromoCodeUsageLimitReached  = errors.New("promo code usage limit is reached")
+	ErrPromoCodeClientLimitReached = errors.New("promo code usage limit for this client is reached")
+)
+
+var (
+	ErrPricingRuleNotFound            = errors.New("pricing rule does not exist")
+	ErrModelIsNotAnOwnerOfPricingRule = errors.New("model is not an owner of this pricing rule")
+	ErrInvalidPricingRuleCharge       = errors.New("multiplier should be above 1, surcharge should be positive")
+	ErrInvalidPricingRuleWindow       = errors.New("weekdays should be in [0, 6], time of day should be given as HH:MM for both start and end")
+	ErrLoadingPlatformTimezone        = errors.New("error loading PLATFORM_TIMEZONE environment variable")
+	ErrParsingPlatformTimezone        = errors.New("PLATFORM_TIMEZONE environment variable should be an IANA time zone")
+)
+
+var (
+	ErrAddOnNotFound        = errors.New("add-on does not exist")
+	ErrAddOnIsNotActive     = errors.New("add-on is not active")
+	ErrAddOnNotOfService    = errors.New("add-on does not belong to this service")
+	ErrDuplicateAddOn       = errors.New("add-on is chosen more than once")
+	ErrInvalidAddOnDuration = errors.New("add-on extra time should be in [0, 1440] minutes")
 )
 
 var (
 	ErrNotAdmin  = errors.New("this is not an admin")
 	ErrNotClient = errors.New("this is not a client")
 )
+
+var (
+	ErrLoadingQuoteSecret = errors.New("error loading QUOTE_SECRET environment variable")
+	ErrInvalidQuoteToken  = errors.New("quote token is invalid")
+	ErrQuoteExpired       = errors.New("quote is expired, request a new one")
+	ErrQuoteMismatch      = errors.New("quote was made for another booking request")
+)
+
+var (
+	ErrReceiptNotAvailable = errors.New("receipt is available only for a completed and paid order")
+)
+
+var (
+	ErrTemplateNotFound               = errors.New("availability template does not exist")
+	ErrModelIsNotAnOwnerOfTemplate    = errors.New("model is not an owner of this availability template")
+	ErrInvalidTemplateSchedule        = errors.New("weekdays should be in [0, 6] and not empty, time of day should be given as HH:MM, slots should not outlast the window")
+	ErrInvalidTemplatePeriod          = errors.New("template should not end before it takes effect")
+	ErrLoadingSlotGenerationWeeks     = errors.New("error loading SLOT_GENERATION_WEEKS environment variable")
+	ErrParsingSlotGenerationWeeks     = errors.New("error parsing SLOT_GENERATION_WEEKS environment variable")
+	ErrNotPositiveSlotGenerationWeeks = errors.New("SLOT_GENERATION_WEEKS environment variable should be positive")
+)
+
+var (
+	ErrSlotBatchRejected    = errors.New("no slot of the batch is created, see the errors of the items")
+	ErrInvalidSlotBatchSize = errors.New("batch should have from 1 to 100 slots")
+	ErrSlotOverlapInBatch   = errors.New("slot overlaps with another slot of the batch")
+	ErrInvalidSlotRange     = errors.New("range start must be before its end")
+)
+
+var (
+	ErrCalendarFeedNotFound = errors.New("calendar feed does not exist, its link may have been regenerated")
+	ErrNoCalendarFeed       = errors.New("only models and clients have a calendar feed")
+)
+
+var (
+	ErrBusyCalendarNotFound        = errors.New("busy calendar does not exist")
+	ErrModelIsNotAnOwnerOfCalendar = errors.New("model is not an owner of this busy calendar")
+	ErrInvalidBusyCalendarFile     = errors.New("file is not a valid iCalendar object")
+	ErrBusyCalendarUnreachable     = errors.New("calendar could not be fetched or read from its URL")
+	ErrBusyCalendarHasURL          = errors.New("calendar is fetched from its URL, an upload would be overwritten by the next fetch")
+)
+
+var (
+	ErrSlotWithinTravelBuffer = errors.New("slot lies within the travel buffer of another slot of the model")
+	ErrInvalidTravelBuffer    = errors.New("travel buffer should be from 0 to 720 minutes and travel fee should not be negative")
+)
+
+var (
+	ErrInvalidAvailabilitySearch = errors.New("window should start before it ends and last at most 31 days, max price should be positive, sort should be price or start_time")
+)
+
+var (
+	ErrInvalidSlotStatusFilter = errors.New("slot status should be AVAILABLE, RESERVED, BOOKED or DISABLED, disabled slots are shown to their model only")
+)
+
+var (
+	ErrInvalidTimeZone = errors.New("time zone should be an IANA name such as Europe/Moscow")
+)
+
+var (
+	ErrInvalidSlotSplit = errors.New("split needs either a length of at least a minute shorter than the slot or ascending cut points inside it, into at most 100 pieces")
+	ErrInvalidSlotMerge = errors.New("merge needs from 2 to 100 distinct slots")
+	ErrSlotsNotAdjacent = errors.New("slots to merge should follow each other without gaps")
+	ErrSlotHasBookings  = errors.New("slot has bookings, it cannot be split or merged")
+)
+
+var (
+	ErrTimeOffNotFound            = errors.New("time-off does not exist")
+	ErrModelIsNotAnOwnerOfTimeOff = errors.New("model is not an owner of this time-off")
+	ErrInvalidTimeOff             = errors.New("time-off should start before it ends, end in the future and last at most 366 days")
+	ErrTimeOffOverlap             = errors.New("time-off overlaps another time-off of the model")
+	ErrSlotWithinTimeOff          = errors.New("slot lies within a time-off of the model")
+)
+
+var (
+	ErrLoadingRetentionMonths     = errors.New("error loading RETENTION_MONTHS environment variable")
+	ErrParsingRetentionMonths     = errors.New("error parsing RETENTION_MONTHS environment variable")
+	ErrNotPositiveRetentionMonths = errors.New("RETENTION_MONTHS environment variable should be positive")
+)
+
+var (
+	ErrLoadingBookingRules  = errors.New("error loading BOOKING_MIN_NOTICE_MINUTES, BOOKING_HORIZON_DAYS, SLOT_MIN_MINUTES or SLOT_MAX_MINUTES environment variable")
+	ErrParsingBookingRules  = errors.New("error parsing BOOKING_MIN_NOTICE_MINUTES, BOOKING_HORIZON_DAYS, SLOT_MIN_MINUTES or SLOT_MAX_MINUTES environment variable")
+	ErrInvalidPlatformRules = errors.New("booking rules environment variables should not be negative, the horizon and the longest slot should be positive, the notice shorter than the horizon and the shortest slot not longer than the longest one")
+	ErrInvalidBookingRules  = errors.New("booking rules should not be negative and should leave a slot that can be booked: the notice shorter than the horizon and the shortest slot not longer than the longest one")
+	ErrSlotInThePast        = errors.New("slot should start in the future")
+	ErrSlotBeyondHorizon    = errors.New("slot starts too far ahead, beyond the booking horizon")
+	ErrInvalidSlotDuration  = errors.New("slot is shorter or longer than the booking rules allow")
+	ErrBookingTooSoon       = errors.New("slot starts too soon to be booked, the model asks for more notice")
+	ErrBookingBeyondHorizon = errors.New("slot starts too far ahead to be booked, beyond the booking horizon")
+)
+
+var (
+	ErrParsingServicePriceDuration  = errors.New("error parsing SERVICE_PRICE_MINUTES environment variable")
+	ErrNegativeServicePriceDuration = errors.New("SERVICE_PRICE_MINUTES environment variable should not be negative")
+)
@@ -0,0 +1,20 @@
+package service_errors
+
+// SlotBatchError rejects a whole batch of slots and tells what is wrong with each rejected slot.
+type SlotBatchError struct {
+	Items []SlotBatchItemError
+}
+
+// SlotBatchItemError is the error of the slot at Index of the batch.
+type SlotBatchItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *SlotBatchError) Error() string {
+	return ErrSlotBatchRejected.Error()
+}
+
+func (e *SlotBatchError) Unwrap() error {
+	return ErrSlotBatchRejected
+}
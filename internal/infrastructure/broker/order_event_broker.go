@@ -0,0 +1,195 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/pkg/logger/option"
+)
+
+const (
+	defaultHistorySize   = 64
+	subscriberBufferSize = 16
+)
+
+var ErrBrokerClosed = errors.New("order event broker is closed")
+
+type topic struct {
+	history     []*entity.OrderEvent
+	subscribers map[chan *entity.OrderEvent]struct{}
+}
+
+// OrderEventBroker is an in-process pub/sub with a fan-out per order.
+// Every order keeps a short history of events, so a reconnecting
+// subscriber can continue from its Last-Event-ID.
+// Event ids are unix microseconds bumped to stay unique, so they keep growing
+// after a restart or after a topic is forgotten and a known Last-Event-ID never hides new events.
+type OrderEventBroker struct {
+	mu          sync.Mutex
+	lastID      int64
+	topics      map[int64]*topic
+	historySize int
+	closed      bool
+	logger      pkg.Logger
+}
+
+func NewOrderEventBroker(historySize int, logger pkg.Logger) *OrderEventBroker {
+	if historySize <= 0 {
+		historySize = defaultHistorySize
+	}
+
+	return &OrderEventBroker{
+		topics:      make(map[int64]*topic),
+		historySize: historySize,
+		logger:      logger,
+	}
+}
+
+func (b *OrderEventBroker) Publish(ctx context.Context, event *entity.OrderEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	t := b.getTopic(event.OrderID)
+	event.ID = b.nextID()
+
+	t.history = append(t.history, event)
+	if len(t.history) > b.historySize {
+		t.history = t.history[len(t.history)-b.historySize:]
+	}
+
+	for ch := range t.subscribers {
+		select {
+		case ch <- event:
+		default:
+			// slow subscriber is dropped, it can reconnect with Last-Event-ID
+			delete(t.subscribers, ch)
+			close(ch)
+
+			b.logger.Warn(ctx, "order event subscriber is too slow, dropped",
+				option.Any("order_id", event.OrderID),
+				option.Any("event_id", event.ID))
+		}
+	}
+
+	b.pruneTopic(event.OrderID, t)
+}
+
+func (b *OrderEventBroker) Subscribe(ctx context.Context, orderID int64,
+	lastEventID *int64) (<-chan *entity.OrderEvent, func(), error) {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, nil, ErrBrokerClosed
+	}
+
+	t := b.getTopic(orderID)
+
+	var replay []*entity.OrderEvent
+	if lastEventID != nil {
+		for _, e := range t.history {
+			if e.ID > *lastEventID {
+				replay = append(replay, e)
+			}
+		}
+	}
+
+	ch := make(chan *entity.OrderEvent, subscriberBufferSize+len(replay))
+	for _, e := range replay {
+		ch <- e
+	}
+	t.subscribers[ch] = struct{}{}
+
+	b.logger.Debug(ctx, "subscribed to order events",
+		option.Any("order_id", orderID),
+		option.Any("replayed", len(replay)))
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			t, ok := b.topics[orderID]
+			if !ok {
+				return
+			}
+
+			if _, ok = t.subscribers[ch]; ok {
+				delete(t.subscribers, ch)
+				close(ch)
+			}
+
+			b.pruneTopic(orderID, t)
+		})
+	}
+
+	return ch, unsubscribe, nil
+}
+
+// Close disconnects all subscribers, so open streams can finish before server shutdown.
+func (b *OrderEventBroker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.closed = true
+	for _, t := range b.topics {
+		for ch := range t.subscribers {
+			close(ch)
+		}
+	}
+	b.topics = make(map[int64]*topic)
+}
+
+func (b *OrderEventBroker) nextID() int64 {
+	id := time.Now().UnixMicro()
+	if id <= b.lastID {
+		id = b.lastID + 1
+	}
+	b.lastID = id
+
+	return id
+}
+
+func (b *OrderEventBroker) getTopic(orderID int64) *topic {
+	t, ok := b.topics[orderID]
+	if !ok {
+		t = &topic{
+			subscribers: make(map[chan *entity.OrderEvent]struct{}),
+		}
+		b.topics[orderID] = t
+	}
+
+	return t
+}
+
+// pruneTopic forgets finished orders nobody listens to anymore.
+func (b *OrderEventBroker) pruneTopic(orderID int64, t *topic) {
+	if len(t.subscribers) > 0 {
+		return
+	}
+
+	if len(t.history) == 0 {
+		delete(b.topics, orderID)
+		return
+	}
+
+	last := t.history[len(t.history)-1]
+	if last.Type == entity.OrderEventStatus &&
+		(last.Status == entity.OrderCompleted || last.Status == entity.OrderCancelled) {
+		delete(b.topics, orderID)
+	}
+}
@@ -0,0 +1,63 @@
+package broker
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	pkg "github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/logger/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBroker(t *testing.T) *OrderEventBroker {
+	t.Helper()
+
+	cfg := &config.LogConfig{}
+	cfg.Logger.Level = "info"
+	cfg.Logger.LogsDir = os.TempDir()
+	cfg.Logger.LogsFile = "test.log"
+	log, err := pkg.NewDualLogger(cfg)
+	require.NoError(t, err)
+
+	return NewOrderEventBroker(0, log)
+}
+
+func TestOrderEventBroker_EventIDs(t *testing.T) {
+	ctx := context.Background()
+	broker := newTestBroker(t)
+
+	started := time.Now().UnixMicro()
+
+	first := entity.NewOrderStatusEvent(&entity.Order{ID: 1, Status: entity.OrderInTransit})
+	broker.Publish(ctx, first)
+	assert.GreaterOrEqual(t, first.ID, started)
+
+	// the order is finished and nobody listens, so its topic is forgotten
+	finished := entity.NewOrderStatusEvent(&entity.Order{ID: 1, Status: entity.OrderCompleted})
+	broker.Publish(ctx, finished)
+	assert.Greater(t, finished.ID, first.ID)
+
+	lastEventID := finished.ID
+	events, unsubscribe, err := broker.Subscribe(ctx, 1, &lastEventID)
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	next := entity.NewOrderStatusEvent(&entity.Order{ID: 1, Status: entity.OrderCancelled})
+	broker.Publish(ctx, next)
+	assert.Greater(t, next.ID, finished.ID)
+
+	select {
+	case e := <-events:
+		assert.Equal(t, next.ID, e.ID)
+	default:
+		t.Fatal("event published after the known id is not delivered")
+	}
+
+	other := entity.NewOrderStatusEvent(&entity.Order{ID: 2, Status: entity.OrderConfirmed})
+	broker.Publish(ctx, other)
+	assert.Greater(t, other.ID, next.ID)
+}
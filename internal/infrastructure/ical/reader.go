@@ -0,0 +1,285 @@
+package ical
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+const (
+	maxCalendarBytes = 5 << 20
+
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+)
+
+var (
+	ErrInvalidCalendar = errors.New("ical: not an iCalendar object")
+	ErrCalendarTooBig  = errors.New("ical: calendar is larger than 5 MB")
+	ErrUnsupportedURL  = errors.New("ical: only http and https URLs are supported")
+)
+
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// Reader reads the busy events of an iCalendar object (RFC 5545). Transparent and cancelled events
+// do not make the time busy and are skipped. Recurrence rules are not expanded, only the first
+// occurrence of a recurring event is read. Times without zone are taken in the given location.
+type Reader struct {
+	client *http.Client
+}
+
+func NewReader(client *http.Client) *Reader {
+	return &Reader{
+		client: client,
+	}
+}
+
+func (r *Reader) Fetch(ctx context.Context, rawURL string, loc *time.Location) ([]*entity.BusyEvent, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, ErrUnsupportedURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ical: calendar URL responded with %s", resp.Status)
+	}
+
+	return r.Read(resp.Body, loc)
+}
+
+func (r *Reader) Read(src io.Reader, loc *time.Location) ([]*entity.BusyEvent, error) {
+	data, err := io.ReadAll(io.LimitReader(src, maxCalendarBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxCalendarBytes {
+		return nil, ErrCalendarTooBig
+	}
+
+	lines := unfold(strings.TrimPrefix(string(data), "\ufeff"))
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, ErrInvalidCalendar
+	}
+
+	var (
+		res    []*entity.BusyEvent
+		event  map[string]property
+		nested int
+	)
+	for _, line := range lines {
+		prop, ok := parseProperty(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT"):
+			event = make(map[string]property)
+			nested = 0
+		case event == nil:
+			continue
+		case prop.name == "BEGIN":
+			// an alarm or another component inside the event
+			nested++
+		case prop.name == "END" && nested > 0:
+			nested--
+		case prop.name == "END" && strings.EqualFold(prop.value, "VEVENT"):
+			busy, err := toBusyEvent(event, loc)
+			if err != nil {
+				return nil, err
+			}
+			if busy != nil {
+				res = append(res, busy)
+			}
+			event = nil
+		case nested == 0:
+			if _, seen := event[prop.name]; !seen {
+				event[prop.name] = prop
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func toBusyEvent(event map[string]property, loc *time.Location) (*entity.BusyEvent, error) {
+	if strings.EqualFold(event["TRANSP"].value, "TRANSPARENT") ||
+		strings.EqualFold(event["STATUS"].value, "CANCELLED") {
+		return nil, nil
+	}
+
+	dtStart, ok := event["DTSTART"]
+	if !ok {
+		return nil, fmt.Errorf("%w: event without DTSTART", ErrInvalidCalendar)
+	}
+
+	start, allDay, err := parseTime(dtStart, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var end time.Time
+	if dtEnd, ok := event["DTEND"]; ok {
+		end, _, err = parseTime(dtEnd, loc)
+		if err != nil {
+			return nil, err
+		}
+	} else if duration, ok := event["DURATION"]; ok {
+		d, err := parseDuration(duration.value)
+		if err != nil {
+			return nil, err
+		}
+		end = start.Add(d)
+	} else if allDay {
+		end = start.AddDate(0, 0, 1)
+	}
+
+	if !start.Before(end) {
+		return nil, nil
+	}
+
+	uid := event["UID"].value
+	if uid == "" {
+		uid = start.UTC().Format(dateTimeLayout + "Z")
+	}
+
+	return &entity.BusyEvent{
+		UID:       uid,
+		StartTime: start,
+		EndTime:   end,
+	}, nil
+}
+
+// parseTime reads a DATE or DATE-TIME value, it tells whether the value is a whole day.
+func parseTime(prop property, loc *time.Location) (time.Time, bool, error) {
+	if tzid, ok := prop.params["TZID"]; ok {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+
+	value := prop.value
+	switch {
+	case prop.params["VALUE"] == "DATE" || len(value) == len(dateLayout):
+		t, err := time.ParseInLocation(dateLayout, value, loc)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("%w: bad date %q", ErrInvalidCalendar, value)
+		}
+		return t, true, nil
+	case strings.HasSuffix(value, "Z"):
+		loc = time.UTC
+		value = strings.TrimSuffix(value, "Z")
+	}
+
+	t, err := time.ParseInLocation(dateTimeLayout, value, loc)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("%w: bad date-time %q", ErrInvalidCalendar, prop.value)
+	}
+
+	return t, false, nil
+}
+
+func parseDuration(value string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(value)
+	if m == nil || value == "P" || value == "PT" {
+		return 0, fmt.Errorf("%w: bad duration %q", ErrInvalidCalendar, value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var res time.Duration
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("%w: bad duration %q", ErrInvalidCalendar, value)
+		}
+		res += time.Duration(n) * unit
+	}
+
+	if m[1] == "-" {
+		res = -res
+	}
+
+	return res, nil
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseProperty splits a content line into its name, parameters and value,
+// the colon inside a quoted parameter value does not end the name part.
+func parseProperty(line string) (property, bool) {
+	quoted := false
+	colon := -1
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		}
+		if c == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return property{}, false
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	prop := property{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string, len(parts)-1),
+		value:  strings.TrimSpace(line[colon+1:]),
+	}
+	for _, p := range parts[1:] {
+		key, value, ok := strings.Cut(p, "=")
+		if ok {
+			prop.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+		}
+	}
+
+	return prop, true
+}
+
+// unfold joins the folded lines back, a line starting with a space or a tab continues the previous one.
+func unfold(data string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		line = strings.TrimRight(line, "\r")
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,170 @@
+package ical
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const busyCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:shooting\r\n" +
+	"DTSTART;TZID=Europe/Moscow:20261020T100000\r\n" +
+	"DTEND;TZID=Europe/Moscow:20261020T130000\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"TRIGGER:-PT15M\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:call\r\n" +
+	"DTSTART:20261021T090000Z\r\n" +
+	"DURATION:PT1H30M\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:day-off\r\n" +
+	"DTSTART;VALUE=DATE:20261022\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:free\r\n" +
+	"DTSTART:20261023T090000Z\r\n" +
+	"DTEND:20261023T100000Z\r\n" +
+	"TRANSP:TRANSPARENT\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:cancelled\r\n" +
+	"DTSTART:20261024T090000Z\r\n" +
+	"DTEND:20261024T100000Z\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestReader_Read(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		src           string
+		expectedUIDs  []string
+		expectedStart []time.Time
+		expectedEnd   []time.Time
+		expectedError error
+	}{
+		{
+			name:         "busy events are read, transparent and cancelled are skipped",
+			src:          busyCalendar,
+			expectedUIDs: []string{"shooting", "call", "day-off"},
+			expectedStart: []time.Time{
+				time.Date(2026, 10, 20, 10, 0, 0, 0, moscow),
+				time.Date(2026, 10, 21, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, 10, 22, 0, 0, 0, 0, moscow),
+			},
+			expectedEnd: []time.Time{
+				time.Date(2026, 10, 20, 13, 0, 0, 0, moscow),
+				time.Date(2026, 10, 21, 10, 30, 0, 0, time.UTC),
+				time.Date(2026, 10, 23, 0, 0, 0, 0, moscow),
+			},
+		},
+		{
+			name: "folded lines are joined",
+			src: "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:long-\n uid\nDTSTART:20261021T090000Z\n" +
+				"DTEND:20261021T100000Z\nEND:VEVENT\nEND:VCALENDAR\n",
+			expectedUIDs:  []string{"long-uid"},
+			expectedStart: []time.Time{time.Date(2026, 10, 21, 9, 0, 0, 0, time.UTC)},
+			expectedEnd:   []time.Time{time.Date(2026, 10, 21, 10, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:          "not a calendar",
+			src:           "<html></html>",
+			expectedError: ErrInvalidCalendar,
+		},
+		{
+			name:          "event without start",
+			src:           "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:x\nEND:VEVENT\nEND:VCALENDAR\n",
+			expectedError: ErrInvalidCalendar,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := NewReader(http.DefaultClient).Read(strings.NewReader(tt.src), moscow)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, res, len(tt.expectedUIDs))
+			for i, e := range res {
+				assert.Equal(t, tt.expectedUIDs[i], e.UID)
+				assert.True(t, tt.expectedStart[i].Equal(e.StartTime), e.StartTime)
+				assert.True(t, tt.expectedEnd[i].Equal(e.EndTime), e.EndTime)
+			}
+		})
+	}
+}
+
+func TestReader_Fetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/busy.ics" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/calendar")
+		_, _ = w.Write([]byte(busyCalendar))
+	}))
+	defer server.Close()
+
+	reader := NewReader(server.Client())
+
+	tests := []struct {
+		name          string
+		url           string
+		expectedCount int
+		expectedError error
+	}{
+		{
+			name:          "calendar is served",
+			url:           server.URL + "/busy.ics",
+			expectedCount: 3,
+		},
+		{
+			name:          "unsupported scheme",
+			url:           "file:///etc/passwd",
+			expectedError: ErrUnsupportedURL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := reader.Fetch(context.Background(), tt.url, time.UTC)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, res, tt.expectedCount)
+		})
+	}
+
+	t.Run("missing calendar", func(t *testing.T) {
+		res, err := reader.Fetch(context.Background(), server.URL+"/missing.ics", time.UTC)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
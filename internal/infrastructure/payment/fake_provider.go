@@ -0,0 +1,64 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+)
+
+const FakeProviderName = "fake"
+
+var (
+	ErrDeclined      = errors.New("fake provider: payment declined")
+	ErrInvalidAmount = errors.New("fake provider: amount should be positive")
+)
+
+// FakeProvider is an in-process acquirer for development and tests, no money is moved.
+// It is stateless, so payments authorized before a restart can still be captured or refunded,
+// the payment state itself is validated by the domain.
+type FakeProvider struct{}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{}
+}
+
+func (p *FakeProvider) Name() string {
+	return FakeProviderName
+}
+
+func (p *FakeProvider) Authorize(_ context.Context, reference string, amount entity.Money) (string, error) {
+	if !amount.IsPositive() {
+		return "", ErrDeclined
+	}
+
+	return FakeProviderName + "_" + reference, nil
+}
+
+func (p *FakeProvider) IncreaseAuthorization(_ context.Context, _ string, amount entity.Money) error {
+	if !amount.IsPositive() {
+		return ErrDeclined
+	}
+
+	return nil
+}
+
+func (p *FakeProvider) Capture(_ context.Context, _ string, amount entity.Money) error {
+	if !amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
+
+func (p *FakeProvider) Refund(_ context.Context, _ string, amount entity.Money) error {
+	if !amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
+
+func (p *FakeProvider) Void(_ context.Context, _ string) error {
+	return nil
+}
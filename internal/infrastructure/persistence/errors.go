@@ -3,11 +3,13 @@ package persistence
 import "errors"
 
 const (
-	UniqueViolationCode = "23505"
+	UniqueViolationCode    = "23505"
+	ExclusionViolationCode = "23P01"
 )
 
 var (
 	ErrNoRowsFound    = errors.New("no rows found")
 	ErrNoRowsAffected = errors.New("no rows affected")
 	ErrDuplicateKey   = errors.New("duplicate key")
+	ErrRangeOverlap   = errors.New("range overlap")
 )
@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+)
+
+type DefaultAddOnRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultAddOnRepository(db *postgres.PostgresDb) *DefaultAddOnRepository {
+	return &DefaultAddOnRepository{
+		db: db,
+	}
+}
+
+func (d *DefaultAddOnRepository) Save(ctx context.Context, addOn *entity.AddOn) error {
+	query, args, err := sq.Insert("add_ons").
+		Columns("model_service_id", "title", "description", "price", "extra_minutes", "is_active").
+		Values(addOn.ModelServiceID, addOn.Title, addOn.Description, addOn.Price, addOn.ExtraMinutes,
+			addOn.IsActive).
+		Suffix("RETURNING add_on_id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&addOn.ID, &addOn.CreatedAt)
+}
+
+func (d *DefaultAddOnRepository) GetByID(ctx context.Context, id int64) (*entity.AddOn, error) {
+	query, args, err := sq.Select("add_on_id", "model_service_id", "title", "description",
+		"price", "extra_minutes", "is_active", "created_at").
+		From("add_ons").
+		Where(sq.Eq{
+			"add_on_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.AddOn
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&res.ID, &res.ModelServiceID, &res.Title, &res.Description, &res.Price, &res.ExtraMinutes,
+			&res.IsActive, &res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultAddOnRepository) GetByServiceID(ctx context.Context, serviceID int64,
+	includeInactive bool) ([]*entity.AddOn, error) {
+
+	builder := sq.Select("add_on_id", "model_service_id", "title", "description",
+		"price", "extra_minutes", "is_active", "created_at").
+		From("add_ons").
+		Where(sq.Eq{
+			"model_service_id": serviceID,
+		}).
+		OrderBy("add_on_id")
+
+	if !includeInactive {
+		builder = builder.Where(sq.Eq{
+			"is_active": true,
+		})
+	}
+
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.AddOn
+	for rows.Next() {
+		var addOn entity.AddOn
+		if err = rows.Scan(&addOn.ID, &addOn.ModelServiceID, &addOn.Title, &addOn.Description, &addOn.Price,
+			&addOn.ExtraMinutes, &addOn.IsActive, &addOn.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &addOn)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultAddOnRepository) Update(ctx context.Context, addOn *entity.AddOn) (*entity.AddOn, error) {
+	query, args, err := sq.Update("add_ons").
+		SetMap(map[string]interface{}{
+			"title":         addOn.Title,
+			"description":   addOn.Description,
+			"price":         addOn.Price,
+			"extra_minutes": addOn.ExtraMinutes,
+		}).
+		Where(sq.Eq{
+			"add_on_id": addOn.ID,
+		}).
+		Suffix("RETURNING add_on_id, model_service_id, title, description, price, extra_minutes, " +
+			"is_active, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.AddOn
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&res.ID, &res.ModelServiceID, &res.Title, &res.Description, &res.Price, &res.ExtraMinutes,
+			&res.IsActive, &res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultAddOnRepository) Deactivate(ctx context.Context, id int64) error {
+	query, args, err := sq.Update("add_ons").
+		Set("is_active", false).
+		Where(sq.Eq{
+			"add_on_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := d.getExecutor(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return persistence.ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (d *DefaultAddOnRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
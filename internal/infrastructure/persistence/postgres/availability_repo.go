@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+)
+
+var availabilityModelColumns = []string{
+	"u.user_id", "u.auth_id", "u.name", "u.birth_date", "u.is_verified", "u.time_zone",
+}
+
+var availabilityServiceColumns = []string{
+	"model_service_id", "model_id", "title", "description", "price", "is_active", "created_at",
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+type DefaultAvailabilityRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultAvailabilityRepository(db *postgres.PostgresDb) *DefaultAvailabilityRepository {
+	return &DefaultAvailabilityRepository{
+		db: db,
+	}
+}
+
+// Search pages over the models having both an available slot in the window and a matching service.
+// The slots and services of the models on the page are read by two more queries.
+func (d *DefaultAvailabilityRepository) Search(ctx context.Context, filter *entity.AvailabilityFilter,
+	opts *entity.Options) ([]*entity.ModelAvailability, error) {
+	query, args, err := sq.Select(availabilityModelColumns...).
+		From("users u").
+		Join("slots s ON s.model_id = u.user_id").
+		Join("model_services ms ON ms.model_id = u.user_id").
+		Where(sq.Eq{
+			"u.is_verified": true,
+		}).
+		Where(availableSlotsIn(filter, "s.")).
+		Where(matchingServices(filter, "ms.")).
+		GroupBy("u.user_id").
+		OrderBy(availabilityOrder(filter.Sort)...).
+		Limit(uint64(opts.Limit)).
+		Offset(uint64(opts.Offset)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.getModels(ctx, query, args)
+	if err != nil || len(res) == 0 {
+		return res, err
+	}
+
+	byModel := make(map[int64]*entity.ModelAvailability, len(res))
+	modelIDs := make([]int64, len(res))
+	for i, availability := range res {
+		byModel[availability.Model.ID] = availability
+		modelIDs[i] = availability.Model.ID
+	}
+
+	if err = d.fillSlots(ctx, filter, modelIDs, byModel); err != nil {
+		return nil, err
+	}
+
+	if err = d.fillServices(ctx, filter, modelIDs, byModel); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultAvailabilityRepository) getModels(ctx context.Context, query string,
+	args []interface{}) ([]*entity.ModelAvailability, error) {
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*entity.ModelAvailability, 0)
+	for rows.Next() {
+		var model entity.User
+		if err = rows.Scan(
+			&model.ID, &model.AuthID, &model.Name, &model.BirthDate, &model.IsVerified, &model.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &entity.ModelAvailability{
+			Model:    &model,
+			Slots:    []*entity.Slot{},
+			Services: []*entity.ModelService{},
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultAvailabilityRepository) fillSlots(ctx context.Context, filter *entity.AvailabilityFilter,
+	modelIDs []int64, byModel map[int64]*entity.ModelAvailability) error {
+	query, args, err := sq.Select(slotColumns...).
+		From("slots").
+		Where(sq.Eq{
+			"model_id": modelIDs,
+		}).
+		Where(availableSlotsIn(filter, "")).
+		OrderBy("start_time ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		slot, err := scanSlot(rows)
+		if err != nil {
+			return err
+		}
+
+		availability := byModel[slot.ModelID]
+		availability.Slots = append(availability.Slots, slot)
+	}
+
+	return rows.Err()
+}
+
+func (d *DefaultAvailabilityRepository) fillServices(ctx context.Context, filter *entity.AvailabilityFilter,
+	modelIDs []int64, byModel map[int64]*entity.ModelAvailability) error {
+	query, args, err := sq.Select(availabilityServiceColumns...).
+		From("model_services").
+		Where(sq.Eq{
+			"model_id": modelIDs,
+		}).
+		Where(matchingServices(filter, "")).
+		OrderBy("price ASC", "model_service_id ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var service entity.ModelService
+		if err = rows.Scan(
+			&service.ID, &service.ModelID, &service.Title, &service.Description,
+			&service.Price, &service.IsActive, &service.CreatedAt,
+		); err != nil {
+			return err
+		}
+
+		availability := byModel[service.ModelID]
+		availability.Services = append(availability.Services, &service)
+	}
+
+	return rows.Err()
+}
+
+// availableSlotsIn matches the available slots lying entirely inside the window of the filter,
+// prefix is the alias of the slots table with its dot or empty.
+func availableSlotsIn(filter *entity.AvailabilityFilter, prefix string) sq.And {
+	return sq.And{
+		sq.Eq{
+			prefix + "status": entity.SlotAvailable,
+		},
+		sq.GtOrEq{
+			prefix + "start_time": filter.From,
+		},
+		sq.LtOrEq{
+			prefix + "end_time": filter.To,
+		},
+	}
+}
+
+// matchingServices matches the active services within the price whose title or description contains the query.
+func matchingServices(filter *entity.AvailabilityFilter, prefix string) sq.And {
+	res := sq.And{
+		sq.Eq{
+			prefix + "is_active": true,
+		},
+	}
+
+	if filter.MaxPrice != nil {
+		res = append(res, sq.LtOrEq{
+			prefix + "price": *filter.MaxPrice,
+		})
+	}
+
+	if filter.ServiceQuery != "" {
+		pattern := "%" + likeEscaper.Replace(filter.ServiceQuery) + "%"
+		res = append(res, sq.Or{
+			sq.ILike{prefix + "title": pattern},
+			sq.ILike{prefix + "description": pattern},
+		})
+	}
+
+	return res
+}
+
+// availabilityOrder sorts the models by the cheapest matching service or by the earliest slot,
+// the other key and the id break the ties so the pages do not shift.
+func availabilityOrder(sort entity.AvailabilitySort) []string {
+	if sort == entity.AvailabilitySortStartTime {
+		return []string{"MIN(s.start_time) ASC", "MIN(ms.price) ASC", "u.user_id ASC"}
+	}
+
+	return []string{"MIN(ms.price) ASC", "MIN(s.start_time) ASC", "u.user_id ASC"}
+}
+
+func (d *DefaultAvailabilityRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+)
+
+var availabilityTemplateColumns = []string{
+	"availability_template_id", "model_id", "name", "weekdays", "start_minute", "end_minute", "slot_minutes",
+	"effective_from", "effective_to", "exceptions", "is_published", "created_at", "updated_at",
+}
+
+type DefaultAvailabilityTemplateRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultAvailabilityTemplateRepository(db *postgres.PostgresDb) *DefaultAvailabilityTemplateRepository {
+	return &DefaultAvailabilityTemplateRepository{
+		db: db,
+	}
+}
+
+func (d *DefaultAvailabilityTemplateRepository) Save(ctx context.Context,
+	template *entity.AvailabilityTemplate) error {
+	query, args, err := sq.Insert("availability_templates").
+		Columns("model_id", "name", "weekdays", "start_minute", "end_minute", "slot_minutes",
+			"effective_from", "effective_to", "exceptions", "is_published").
+		Values(template.ModelID, template.Name, intArray(template.Weekdays), template.StartMinute,
+			template.EndMinute, template.SlotMinutes, template.EffectiveFrom, template.EffectiveTo,
+			dateArray(template.Exceptions), template.IsPublished).
+		Suffix("RETURNING availability_template_id, created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&template.ID, &template.CreatedAt, &template.UpdatedAt)
+}
+
+func (d *DefaultAvailabilityTemplateRepository) GetByID(ctx context.Context,
+	id int64) (*entity.AvailabilityTemplate, error) {
+	query, args, err := sq.Select(availabilityTemplateColumns...).
+		From("availability_templates").
+		Where(sq.Eq{
+			"availability_template_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanAvailabilityTemplate(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultAvailabilityTemplateRepository) GetByModelID(ctx context.Context, modelID int64,
+	opts *entity.Options) ([]*entity.AvailabilityTemplate, error) {
+	query, args, err := sq.Select(availabilityTemplateColumns...).
+		From("availability_templates").
+		Where(sq.Eq{
+			"model_id": modelID,
+		}).
+		OrderBy("availability_template_id").
+		Limit(uint64(opts.Limit)).
+		Offset(uint64(opts.Offset)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+func (d *DefaultAvailabilityTemplateRepository) GetPublished(ctx context.Context) ([]*entity.AvailabilityTemplate, error) {
+	query, args, err := sq.Select(availabilityTemplateColumns...).
+		From("availability_templates").
+		Where(sq.Eq{
+			"is_published": true,
+		}).
+		OrderBy("availability_template_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+func (d *DefaultAvailabilityTemplateRepository) Update(ctx context.Context,
+	template *entity.AvailabilityTemplate) (*entity.AvailabilityTemplate, error) {
+	query, args, err := sq.Update("availability_templates").
+		SetMap(map[string]interface{}{
+			"name":           template.Name,
+			"weekdays":       intArray(template.Weekdays),
+			"start_minute":   template.StartMinute,
+			"end_minute":     template.EndMinute,
+			"slot_minutes":   template.SlotMinutes,
+			"effective_from": template.EffectiveFrom,
+			"effective_to":   template.EffectiveTo,
+			"exceptions":     dateArray(template.Exceptions),
+			"is_published":   template.IsPublished,
+			"updated_at":     sq.Expr("now()"),
+		}).
+		Where(sq.Eq{
+			"availability_template_id": template.ID,
+		}).
+		Suffix("RETURNING " + strings.Join(availabilityTemplateColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanAvailabilityTemplate(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultAvailabilityTemplateRepository) getMany(ctx context.Context, query string,
+	args []interface{}) ([]*entity.AvailabilityTemplate, error) {
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.AvailabilityTemplate
+	for rows.Next() {
+		template, err := scanAvailabilityTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, template)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func scanAvailabilityTemplate(row pgx.Row) (*entity.AvailabilityTemplate, error) {
+	var res entity.AvailabilityTemplate
+	err := row.Scan(
+		&res.ID, &res.ModelID, &res.Name, &res.Weekdays, &res.StartMinute, &res.EndMinute, &res.SlotMinutes,
+		&res.EffectiveFrom, &res.EffectiveTo, &res.Exceptions, &res.IsPublished, &res.CreatedAt, &res.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultAvailabilityTemplateRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
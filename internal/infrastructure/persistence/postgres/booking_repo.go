@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
@@ -23,8 +24,13 @@ func NewDefaultBookingRepository(db *postgres.PostgresDb) *DefaultBookingReposit
 
 func (d *DefaultBookingRepository) Save(ctx context.Context, b *entity.Booking) error {
 	query, args, err := sq.Insert("bookings").
-		Columns("client_id", "model_service_id", "slot_id", "address", "status", "expires_at").
-		Values(b.ClientID, b.ModelServiceID, b.SlotID, b.Address, b.Status, b.ExpiresAt).
+		Columns("client_id", "model_service_id", "slot_id", "address", "status",
+			"base_price", "duration_scaling", "surcharges", "add_ons", "travel_fee",
+			"price", "discount", "promo_code_id", "expires_at").
+		Values(b.ClientID, b.ModelServiceID, b.SlotID, b.Address, b.Status,
+			b.BasePrice, b.DurationScaling, priceComponents(b.Surcharges), bookingAddOns(b.AddOns), b.TravelFee,
+			b.Price, b.Discount,
+			b.PromoCodeID, b.ExpiresAt).
 		Suffix("RETURNING booking_id, created_at").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
@@ -39,8 +45,9 @@ func (d *DefaultBookingRepository) Save(ctx context.Context, b *entity.Booking)
 
 func (d *DefaultBookingRepository) GetByID(ctx context.Context, id int64) (*entity.Booking, error) {
 	query, args, err := sq.Select(
-		"booking_id", "client_id", "model_service_id",
-		"slot_id", "address", "status", "expires_at", "created_at").
+		"booking_id", "client_id", "model_service_id", "slot_id", "address", "status",
+		"base_price", "duration_scaling", "surcharges", "add_ons", "travel_fee",
+		"price", "discount", "promo_code_id", "expires_at", "created_at").
 		From("bookings").
 		Where(sq.Eq{
 			"booking_id": id,
@@ -56,7 +63,11 @@ func (d *DefaultBookingRepository) GetByID(ctx context.Context, id int64) (*enti
 		QueryRow(ctx, query, args...).
 		Scan(
 			&res.ID, &res.ClientID, &res.ModelServiceID, &res.SlotID,
-			&res.Address, &res.Status, &res.ExpiresAt, &res.CreatedAt,
+			&res.Address, &res.Status, &res.BasePrice, &res.DurationScaling, &res.Surcharges, &res.AddOns,
+			&res.TravelFee, &res.Price,
+			&res.Discount,
+			&res.PromoCodeID,
+			&res.ExpiresAt, &res.CreatedAt,
 		)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -77,13 +88,22 @@ func (d *DefaultBookingRepository) Update(ctx context.Context, b *entity.Booking
 			"slot_id":          b.SlotID,
 			"address":          b.Address,
 			"status":           b.Status,
+			"base_price":       b.BasePrice,
+			"duration_scaling": b.DurationScaling,
+			"surcharges":       priceComponents(b.Surcharges),
+			"add_ons":          bookingAddOns(b.AddOns),
+			"travel_fee":       b.TravelFee,
+			"price":            b.Price,
+			"discount":         b.Discount,
+			"promo_code_id":    b.PromoCodeID,
 			"expires_at":       b.ExpiresAt,
 		}).
 		Where(sq.Eq{
 			"booking_id": b.ID,
 		}).
-		Suffix("RETURNING booking_id, client_id, model_service_id, " +
-			"slot_id, address, status, expires_at, created_at").
+		Suffix("RETURNING booking_id, client_id, model_service_id, slot_id, address, status, " +
+			"base_price, duration_scaling, surcharges, add_ons, travel_fee, " +
+			"price, discount, promo_code_id, expires_at, created_at").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -95,7 +115,11 @@ func (d *DefaultBookingRepository) Update(ctx context.Context, b *entity.Booking
 		QueryRow(ctx, query, args...).
 		Scan(
 			&res.ID, &res.ClientID, &res.ModelServiceID, &res.SlotID,
-			&res.Address, &res.Status, &res.ExpiresAt, &res.CreatedAt,
+			&res.Address, &res.Status, &res.BasePrice, &res.DurationScaling, &res.Surcharges, &res.AddOns,
+			&res.TravelFee, &res.Price,
+			&res.Discount,
+			&res.PromoCodeID,
+			&res.ExpiresAt, &res.CreatedAt,
 		)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -115,8 +139,9 @@ func (d *DefaultBookingRepository) GetAll(
 
 	query, args, err :=
 		sq.Select(
-			"booking_id", "client_id", "model_service_id", "slot_id",
-			"address", "status", "expires_at", "created_at",
+			"booking_id", "client_id", "model_service_id", "slot_id", "address", "status",
+			"base_price", "duration_scaling", "surcharges", "add_ons", "travel_fee",
+			"price", "discount", "promo_code_id", "expires_at", "created_at",
 		).
 			From("bookings").
 			Limit(uint64(opts.Limit)).
@@ -138,7 +163,11 @@ func (d *DefaultBookingRepository) GetAll(
 		var booking entity.Booking
 		if err = rows.Scan(
 			&booking.ID, &booking.ClientID, &booking.ModelServiceID, &booking.SlotID,
-			&booking.Address, &booking.Status, &booking.ExpiresAt, &booking.CreatedAt,
+			&booking.Address, &booking.Status, &booking.BasePrice, &booking.DurationScaling, &booking.Surcharges,
+			&booking.AddOns, &booking.TravelFee,
+			&booking.Price, &booking.Discount,
+			&booking.PromoCodeID,
+			&booking.ExpiresAt, &booking.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -153,6 +182,164 @@ func (d *DefaultBookingRepository) GetAll(
 	return res, nil
 }
 
+func (d *DefaultBookingRepository) ExpirePending(ctx context.Context, now time.Time) ([]*entity.Booking, error) {
+	query, args, err := sq.Update("bookings").
+		Set("status", entity.BookingExpired).
+		Where(sq.Eq{
+			"status": entity.BookingPending,
+		}).
+		Where(sq.LtOrEq{
+			"expires_at": now,
+		}).
+		Suffix("RETURNING booking_id, client_id, model_service_id, slot_id, address, status, " +
+			"base_price, duration_scaling, surcharges, add_ons, travel_fee, " +
+			"price, discount, promo_code_id, expires_at, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.Booking
+	for rows.Next() {
+		var booking entity.Booking
+		if err = rows.Scan(
+			&booking.ID, &booking.ClientID, &booking.ModelServiceID, &booking.SlotID,
+			&booking.Address, &booking.Status, &booking.BasePrice, &booking.DurationScaling, &booking.Surcharges,
+			&booking.AddOns, &booking.TravelFee,
+			&booking.Price, &booking.Discount,
+			&booking.PromoCodeID,
+			&booking.ExpiresAt, &booking.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &booking)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetArchivedByID looks the booking up among the ones the retention job has archived.
+func (d *DefaultBookingRepository) GetArchivedByID(ctx context.Context, id int64) (*entity.Booking, error) {
+	query, args, err := sq.Select(
+		"booking_id", "client_id", "model_service_id", "slot_id", "address", "status",
+		"base_price", "duration_scaling", "surcharges", "add_ons", "travel_fee",
+		"price", "discount", "promo_code_id", "expires_at", "created_at",
+		"archived_at").
+		From("bookings_archive").
+		Where(sq.Eq{
+			"booking_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.Booking
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(
+			&res.ID, &res.ClientID, &res.ModelServiceID, &res.SlotID,
+			&res.Address, &res.Status, &res.BasePrice, &res.DurationScaling, &res.Surcharges, &res.AddOns,
+			&res.TravelFee, &res.Price,
+			&res.Discount,
+			&res.PromoCodeID,
+			&res.ExpiresAt, &res.CreatedAt, &res.ArchivedAt,
+		)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetAllArchived returns the archived bookings, the most recently archived first.
+func (d *DefaultBookingRepository) GetAllArchived(
+	ctx context.Context,
+	opts *entity.Options,
+) ([]*entity.Booking, error) {
+
+	query, args, err :=
+		sq.Select(
+			"booking_id", "client_id", "model_service_id", "slot_id", "address", "status",
+			"base_price", "duration_scaling", "surcharges", "add_ons", "travel_fee",
+			"price", "discount", "promo_code_id", "expires_at", "created_at",
+			"archived_at",
+		).
+			From("bookings_archive").
+			OrderBy("archived_at DESC", "booking_id DESC").
+			Limit(uint64(opts.Limit)).
+			Offset(uint64(opts.Offset)).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.Booking
+	for rows.Next() {
+		var booking entity.Booking
+		if err = rows.Scan(
+			&booking.ID, &booking.ClientID, &booking.ModelServiceID, &booking.SlotID,
+			&booking.Address, &booking.Status, &booking.BasePrice, &booking.DurationScaling, &booking.Surcharges,
+			&booking.AddOns, &booking.TravelFee,
+			&booking.Price, &booking.Discount,
+			&booking.PromoCodeID,
+			&booking.ExpiresAt, &booking.CreatedAt, &booking.ArchivedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &booking)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// priceComponents keeps a booking without surcharges an empty JSON array instead of null.
+func priceComponents(components []entity.PriceComponent) []entity.PriceComponent {
+	if components == nil {
+		return []entity.PriceComponent{}
+	}
+
+	return components
+}
+
+// bookingAddOns keeps a booking without add-ons an empty JSON array instead of null.
+func bookingAddOns(addOns []entity.BookingAddOn) []entity.BookingAddOn {
+	if addOns == nil {
+		return []entity.BookingAddOn{}
+	}
+
+	return addOns
+}
+
 func (d *DefaultBookingRepository) getExecutor(ctx context.Context) postgres.Executor {
 	if tx := postgres.TxFromContext(ctx); tx != nil {
 		return tx
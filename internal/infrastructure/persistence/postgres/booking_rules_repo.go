@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+)
+
+var bookingRulesColumns = []string{
+	"model_id", "min_notice_minutes", "horizon_days", "min_slot_minutes", "max_slot_minutes", "updated_at",
+}
+
+type DefaultBookingRulesRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultBookingRulesRepository(db *postgres.PostgresDb) *DefaultBookingRulesRepository {
+	return &DefaultBookingRulesRepository{
+		db: db,
+	}
+}
+
+func (d *DefaultBookingRulesRepository) GetByModelID(ctx context.Context,
+	modelID int64) (*entity.BookingRules, error) {
+	query, args, err := sq.Select(bookingRulesColumns...).
+		From("booking_rules").
+		Where(sq.Eq{
+			"model_id": modelID,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanBookingRules(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Save creates the rules of the model or replaces the ones it has.
+func (d *DefaultBookingRulesRepository) Save(ctx context.Context,
+	rules *entity.BookingRules) (*entity.BookingRules, error) {
+	query, args, err := sq.Insert("booking_rules").
+		Columns("model_id", "min_notice_minutes", "horizon_days", "min_slot_minutes", "max_slot_minutes").
+		Values(rules.ModelID, int(rules.MinNotice/time.Minute), int(rules.Horizon/(24*time.Hour)),
+			int(rules.MinSlotDuration/time.Minute), int(rules.MaxSlotDuration/time.Minute)).
+		Suffix("ON CONFLICT (model_id) DO UPDATE SET " +
+			"min_notice_minutes = EXCLUDED.min_notice_minutes, " +
+			"horizon_days = EXCLUDED.horizon_days, " +
+			"min_slot_minutes = EXCLUDED.min_slot_minutes, " +
+			"max_slot_minutes = EXCLUDED.max_slot_minutes, " +
+			"updated_at = now() " +
+			"RETURNING model_id, min_notice_minutes, horizon_days, min_slot_minutes, max_slot_minutes, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanBookingRules(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+}
+
+func scanBookingRules(row pgx.Row) (*entity.BookingRules, error) {
+	var (
+		res                            entity.BookingRules
+		minNoticeMinutes, horizonDays  int
+		minSlotMinutes, maxSlotMinutes int
+	)
+	err := row.Scan(&res.ModelID, &minNoticeMinutes, &horizonDays, &minSlotMinutes, &maxSlotMinutes,
+		&res.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	res.MinNotice = time.Duration(minNoticeMinutes) * time.Minute
+	res.Horizon = time.Duration(horizonDays) * 24 * time.Hour
+	res.MinSlotDuration = time.Duration(minSlotMinutes) * time.Minute
+	res.MaxSlotDuration = time.Duration(maxSlotMinutes) * time.Minute
+
+	return &res, nil
+}
+
+func (d *DefaultBookingRulesRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
@@ -0,0 +1,272 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+)
+
+var busyCalendarColumns = []string{
+	"busy_calendar_id", "model_id", "name", "url", "conflicts", "synced_at", "created_at",
+}
+
+type DefaultBusyCalendarRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultBusyCalendarRepository(db *postgres.PostgresDb) *DefaultBusyCalendarRepository {
+	return &DefaultBusyCalendarRepository{
+		db: db,
+	}
+}
+
+func (d *DefaultBusyCalendarRepository) Save(ctx context.Context, calendar *entity.BusyCalendar) error {
+	query, args, err := sq.Insert("busy_calendars").
+		Columns("model_id", "name", "url", "conflicts").
+		Values(calendar.ModelID, calendar.Name, calendar.URL, calendar.Conflicts).
+		Suffix("RETURNING busy_calendar_id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&calendar.ID, &calendar.CreatedAt)
+}
+
+func (d *DefaultBusyCalendarRepository) GetByID(ctx context.Context, id int64) (*entity.BusyCalendar, error) {
+	query, args, err := sq.Select(busyCalendarColumns...).
+		From("busy_calendars").
+		Where(sq.Eq{
+			"busy_calendar_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanBusyCalendar(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultBusyCalendarRepository) GetByModelID(ctx context.Context,
+	modelID int64) ([]*entity.BusyCalendar, error) {
+	query, args, err := sq.Select(busyCalendarColumns...).
+		From("busy_calendars").
+		Where(sq.Eq{
+			"model_id": modelID,
+		}).
+		OrderBy("busy_calendar_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+// GetAll returns the calendars of all the models, the ones synced longest ago go first.
+func (d *DefaultBusyCalendarRepository) GetAll(ctx context.Context) ([]*entity.BusyCalendar, error) {
+	query, args, err := sq.Select(busyCalendarColumns...).
+		From("busy_calendars").
+		OrderBy("synced_at ASC NULLS FIRST", "busy_calendar_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+func (d *DefaultBusyCalendarRepository) UpdateSync(ctx context.Context,
+	calendar *entity.BusyCalendar) (*entity.BusyCalendar, error) {
+	query, args, err := sq.Update("busy_calendars").
+		Set("conflicts", calendar.Conflicts).
+		Set("synced_at", calendar.SyncedAt).
+		Where(sq.Eq{
+			"busy_calendar_id": calendar.ID,
+		}).
+		Suffix("RETURNING " + strings.Join(busyCalendarColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanBusyCalendar(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Delete removes the calendar together with its events.
+func (d *DefaultBusyCalendarRepository) Delete(ctx context.Context, id int64) error {
+	query, args, err := sq.Delete("busy_calendars").
+		Where(sq.Eq{
+			"busy_calendar_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := d.getExecutor(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return persistence.ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// ReplaceEvents drops the events read before and keeps the given ones instead.
+func (d *DefaultBusyCalendarRepository) ReplaceEvents(ctx context.Context, calendarID int64,
+	events []*entity.BusyEvent) error {
+	query, args, err := sq.Delete("busy_events").
+		Where(sq.Eq{
+			"busy_calendar_id": calendarID,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err = d.getExecutor(ctx).Exec(ctx, query, args...); err != nil {
+		return err
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	insert := sq.Insert("busy_events").
+		Columns("busy_calendar_id", "uid", "start_time", "end_time")
+	for _, e := range events {
+		insert = insert.Values(calendarID, e.UID, e.StartTime, e.EndTime)
+	}
+
+	query, args, err = insert.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = d.getExecutor(ctx).Exec(ctx, query, args...)
+
+	return err
+}
+
+// GetModelEvents returns the events of all the calendars of the model that end after from.
+func (d *DefaultBusyCalendarRepository) GetModelEvents(ctx context.Context, modelID int64,
+	from time.Time) ([]*entity.BusyEvent, error) {
+	query, args, err := sq.Select("e.busy_calendar_id", "e.uid", "e.start_time", "e.end_time").
+		From("busy_events e").
+		Join("busy_calendars c ON e.busy_calendar_id = c.busy_calendar_id").
+		Where(sq.Eq{
+			"c.model_id": modelID,
+		}).
+		Where(sq.Expr("e.end_time > ?", from)).
+		OrderBy("e.start_time ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.BusyEvent
+	for rows.Next() {
+		var event entity.BusyEvent
+		if err = rows.Scan(&event.CalendarID, &event.UID, &event.StartTime, &event.EndTime); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultBusyCalendarRepository) getMany(ctx context.Context, query string,
+	args []interface{}) ([]*entity.BusyCalendar, error) {
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.BusyCalendar
+	for rows.Next() {
+		calendar, err := scanBusyCalendar(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, calendar)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func scanBusyCalendar(row pgx.Row) (*entity.BusyCalendar, error) {
+	var res entity.BusyCalendar
+	err := row.Scan(
+		&res.ID, &res.ModelID, &res.Name, &res.URL, &res.Conflicts, &res.SyncedAt, &res.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultBusyCalendarRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
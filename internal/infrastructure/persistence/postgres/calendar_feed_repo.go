@@ -0,0 +1,214 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var calendarFeedColumns = []string{
+	"calendar_feed_id", "user_id", "role", "token", "created_at",
+}
+
+var calendarOrderColumns = []string{
+	"o.order_id", "b.slot_id", "o.status", "ms.title", "u.name", "b.address",
+	"s.start_time", "s.end_time", "o.extension_minutes",
+}
+
+type DefaultCalendarFeedRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultCalendarFeedRepository(db *postgres.PostgresDb) *DefaultCalendarFeedRepository {
+	return &DefaultCalendarFeedRepository{
+		db: db,
+	}
+}
+
+func (d *DefaultCalendarFeedRepository) Save(ctx context.Context, feed *entity.CalendarFeed) error {
+	query, args, err := sq.Insert("calendar_feeds").
+		Columns("user_id", "role", "token").
+		Values(feed.UserID, feed.Role, feed.Token).
+		Suffix("RETURNING calendar_feed_id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&feed.ID, &feed.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == persistence.UniqueViolationCode {
+			return persistence.ErrDuplicateKey
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (d *DefaultCalendarFeedRepository) GetByUserID(ctx context.Context, userID int64) (*entity.CalendarFeed, error) {
+	return d.getOne(ctx, sq.Eq{
+		"user_id": userID,
+	})
+}
+
+func (d *DefaultCalendarFeedRepository) GetByToken(ctx context.Context, token string) (*entity.CalendarFeed, error) {
+	return d.getOne(ctx, sq.Eq{
+		"token": token,
+	})
+}
+
+// UpdateToken replaces the token, the URL with the old one stops working at once.
+func (d *DefaultCalendarFeedRepository) UpdateToken(ctx context.Context,
+	feed *entity.CalendarFeed) (*entity.CalendarFeed, error) {
+	query, args, err := sq.Update("calendar_feeds").
+		Set("token", feed.Token).
+		Set("created_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"calendar_feed_id": feed.ID,
+		}).
+		Suffix("RETURNING " + strings.Join(calendarFeedColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanCalendarFeed(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetModelOrders returns every order of the model which is not cancelled and has not ended before from.
+func (d *DefaultCalendarFeedRepository) GetModelOrders(ctx context.Context, modelID int64,
+	from time.Time) ([]*entity.CalendarOrder, error) {
+	query, args, err := d.selectOrders("ms.model_id", modelID, from).
+		Where(sq.NotEq{
+			"o.status": entity.OrderCancelled,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getOrders(ctx, query, args)
+}
+
+// GetClientOrders returns the orders of the client which are still to be carried out.
+func (d *DefaultCalendarFeedRepository) GetClientOrders(ctx context.Context, clientID int64,
+	from time.Time) ([]*entity.CalendarOrder, error) {
+	query, args, err := d.selectOrders("b.client_id", clientID, from).
+		Where(sq.Eq{
+			"o.status": []entity.OrderStatus{entity.OrderConfirmed, entity.OrderInTransit},
+		}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getOrders(ctx, query, args)
+}
+
+func (d *DefaultCalendarFeedRepository) selectOrders(userColumn string, userID int64,
+	from time.Time) sq.SelectBuilder {
+	return sq.Select(calendarOrderColumns...).
+		From("orders o").
+		Join("bookings b ON o.booking_id = b.booking_id").
+		Join("model_services ms ON b.model_service_id = ms.model_service_id").
+		Join("users u ON ms.model_id = u.user_id").
+		Join("slots s ON b.slot_id = s.slot_id").
+		Where(sq.Eq{
+			userColumn: userID,
+		}).
+		Where(sq.Expr("s.end_time >= ?", from)).
+		OrderBy("s.start_time ASC").
+		PlaceholderFormat(sq.Dollar)
+}
+
+func (d *DefaultCalendarFeedRepository) getOne(ctx context.Context, where sq.Eq) (*entity.CalendarFeed, error) {
+	query, args, err := sq.Select(calendarFeedColumns...).
+		From("calendar_feeds").
+		Where(where).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanCalendarFeed(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultCalendarFeedRepository) getOrders(ctx context.Context, query string,
+	args []interface{}) ([]*entity.CalendarOrder, error) {
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.CalendarOrder
+	for rows.Next() {
+		var order entity.CalendarOrder
+		if err = rows.Scan(
+			&order.OrderID, &order.SlotID, &order.Status, &order.ServiceTitle, &order.ModelName,
+			&order.Address, &order.StartTime, &order.EndTime, &order.ExtensionMinutes,
+		); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &order)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func scanCalendarFeed(row pgx.Row) (*entity.CalendarFeed, error) {
+	var res entity.CalendarFeed
+	err := row.Scan(&res.ID, &res.UserID, &res.Role, &res.Token, &res.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultCalendarFeedRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
@@ -0,0 +1,266 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+)
+
+type DefaultDisputeRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultDisputeRepository(db *postgres.PostgresDb) *DefaultDisputeRepository {
+	return &DefaultDisputeRepository{
+		db: db,
+	}
+}
+
+func (d *DefaultDisputeRepository) Save(ctx context.Context, dispute *entity.Dispute) error {
+	query, args, err := sq.Insert("disputes").
+		Columns("order_id", "client_id", "model_id", "opened_by", "category", "description", "status").
+		Values(dispute.OrderID, dispute.ClientID, dispute.ModelID, dispute.OpenedBy,
+			dispute.Category, dispute.Description, dispute.Status).
+		Suffix("RETURNING dispute_id, created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&dispute.ID, &dispute.CreatedAt, &dispute.UpdatedAt)
+}
+
+func (d *DefaultDisputeRepository) GetByID(ctx context.Context, id int64) (*entity.Dispute, error) {
+	query, args, err := sq.Select(
+		"dispute_id", "order_id", "client_id", "model_id", "opened_by", "category",
+		"description", "status", "assigned_admin_id", "resolution", "refund_amount",
+		"penalized_user_id", "resolution_comment", "resolved_at", "created_at", "updated_at").
+		From("disputes").
+		Where(sq.Eq{
+			"dispute_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.Dispute
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(
+			&res.ID, &res.OrderID, &res.ClientID, &res.ModelID, &res.OpenedBy, &res.Category,
+			&res.Description, &res.Status, &res.AssignedAdminID, &res.Resolution, &res.RefundAmount,
+			&res.PenalizedUserID, &res.ResolutionComment, &res.ResolvedAt, &res.CreatedAt, &res.UpdatedAt,
+		)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultDisputeRepository) GetByOrderID(ctx context.Context, orderID int64) (*entity.Dispute, error) {
+	query, args, err := sq.Select(
+		"dispute_id", "order_id", "client_id", "model_id", "opened_by", "category",
+		"description", "status", "assigned_admin_id", "resolution", "refund_amount",
+		"penalized_user_id", "resolution_comment", "resolved_at", "created_at", "updated_at").
+		From("disputes").
+		Where(sq.Eq{
+			"order_id": orderID,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.Dispute
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(
+			&res.ID, &res.OrderID, &res.ClientID, &res.ModelID, &res.OpenedBy, &res.Category,
+			&res.Description, &res.Status, &res.AssignedAdminID, &res.Resolution, &res.RefundAmount,
+			&res.PenalizedUserID, &res.ResolutionComment, &res.ResolvedAt, &res.CreatedAt, &res.UpdatedAt,
+		)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultDisputeRepository) GetAll(ctx context.Context, status *entity.DisputeStatus,
+	opts *entity.Options) ([]*entity.Dispute, error) {
+	builder := sq.Select(
+		"dispute_id", "order_id", "client_id", "model_id", "opened_by", "category",
+		"description", "status", "assigned_admin_id", "resolution", "refund_amount",
+		"penalized_user_id", "resolution_comment", "resolved_at", "created_at", "updated_at").
+		From("disputes")
+
+	if status != nil {
+		builder = builder.Where(sq.Eq{
+			"status": *status,
+		})
+	}
+
+	query, args, err := builder.
+		OrderBy("created_at").
+		Limit(uint64(opts.Limit)).
+		Offset(uint64(opts.Offset)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.Dispute
+	for rows.Next() {
+		var dispute entity.Dispute
+		if err = rows.Scan(
+			&dispute.ID, &dispute.OrderID, &dispute.ClientID, &dispute.ModelID, &dispute.OpenedBy,
+			&dispute.Category, &dispute.Description, &dispute.Status, &dispute.AssignedAdminID,
+			&dispute.Resolution, &dispute.RefundAmount, &dispute.PenalizedUserID,
+			&dispute.ResolutionComment, &dispute.ResolvedAt, &dispute.CreatedAt, &dispute.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &dispute)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultDisputeRepository) Update(ctx context.Context, dispute *entity.Dispute) (*entity.Dispute, error) {
+	query, args, err := sq.Update("disputes").
+		SetMap(map[string]interface{}{
+			"status":             dispute.Status,
+			"assigned_admin_id":  dispute.AssignedAdminID,
+			"resolution":         dispute.Resolution,
+			"refund_amount":      dispute.RefundAmount,
+			"penalized_user_id":  dispute.PenalizedUserID,
+			"resolution_comment": dispute.ResolutionComment,
+			"resolved_at":        dispute.ResolvedAt,
+			"updated_at":         sq.Expr("now()"),
+		}).
+		Where(sq.Eq{
+			"dispute_id": dispute.ID,
+		}).
+		Suffix("RETURNING dispute_id, order_id, client_id, model_id, opened_by, category, " +
+			"description, status, assigned_admin_id, resolution, refund_amount, " +
+			"penalized_user_id, resolution_comment, resolved_at, created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.Dispute
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(
+			&res.ID, &res.OrderID, &res.ClientID, &res.ModelID, &res.OpenedBy, &res.Category,
+			&res.Description, &res.Status, &res.AssignedAdminID, &res.Resolution, &res.RefundAmount,
+			&res.PenalizedUserID, &res.ResolutionComment, &res.ResolvedAt, &res.CreatedAt, &res.UpdatedAt,
+		)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultDisputeRepository) SaveMessage(ctx context.Context, message *entity.DisputeMessage) error {
+	query, args, err := sq.Insert("dispute_messages").
+		Columns("dispute_id", "author_auth_id", "author_role", "body").
+		Values(message.DisputeID, message.AuthorAuthID, message.AuthorRole, message.Body).
+		Suffix("RETURNING dispute_message_id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&message.ID, &message.CreatedAt)
+}
+
+func (d *DefaultDisputeRepository) GetMessages(ctx context.Context, disputeID int64,
+	opts *entity.Options) ([]*entity.DisputeMessage, error) {
+	query, args, err := sq.Select(
+		"dispute_message_id", "dispute_id", "author_auth_id", "author_role", "body", "created_at").
+		From("dispute_messages").
+		Where(sq.Eq{
+			"dispute_id": disputeID,
+		}).
+		OrderBy("created_at", "dispute_message_id").
+		Limit(uint64(opts.Limit)).
+		Offset(uint64(opts.Offset)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.DisputeMessage
+	for rows.Next() {
+		var message entity.DisputeMessage
+		if err = rows.Scan(
+			&message.ID, &message.DisputeID, &message.AuthorAuthID,
+			&message.AuthorRole, &message.Body, &message.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &message)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultDisputeRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type DefaultLedgerRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultLedgerRepository(db *postgres.PostgresDb) *DefaultLedgerRepository {
+	return &DefaultLedgerRepository{
+		db: db,
+	}
+}
+
+func (d *DefaultLedgerRepository) GetAccount(ctx context.Context, accountType entity.LedgerAccountType,
+	ownerID *int64) (*entity.LedgerAccount, error) {
+	builder := sq.Select("account_id", "type", "owner_id", "created_at").
+		From("ledger_accounts").
+		Where(sq.Eq{
+			"type": accountType,
+		})
+
+	if ownerID != nil {
+		builder = builder.Where(sq.Eq{
+			"owner_id": *ownerID,
+		})
+	} else {
+		builder = builder.Where("owner_id IS NULL")
+	}
+
+	query, args, err := builder.
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.LedgerAccount
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&res.ID, &res.Type, &res.OwnerID, &res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetOrCreateAccount opens the account on the first posting, the no-op update makes
+// RETURNING work for an account which already exists.
+func (d *DefaultLedgerRepository) GetOrCreateAccount(ctx context.Context, accountType entity.LedgerAccountType,
+	ownerID *int64) (*entity.LedgerAccount, error) {
+	query, args, err := sq.Insert("ledger_accounts").
+		Columns("type", "owner_id").
+		Values(accountType, ownerID).
+		Suffix("ON CONFLICT (type, owner_id) DO UPDATE SET type = EXCLUDED.type " +
+			"RETURNING account_id, type, owner_id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.LedgerAccount
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&res.ID, &res.Type, &res.OwnerID, &res.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultLedgerRepository) SaveTransaction(ctx context.Context, transaction *entity.LedgerTransaction) error {
+	query, args, err := sq.Insert("ledger_transactions").
+		Columns("order_id", "type").
+		Values(transaction.OrderID, transaction.Type).
+		Suffix("RETURNING transaction_id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&transaction.ID, &transaction.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == persistence.UniqueViolationCode {
+			return persistence.ErrDuplicateKey
+		}
+		return err
+	}
+
+	builder := sq.Insert("ledger_entries").
+		Columns("transaction_id", "account_id", "amount")
+	for _, e := range transaction.Entries {
+		builder = builder.Values(transaction.ID, e.AccountID, e.Amount)
+	}
+
+	query, args, err = builder.
+		Suffix("RETURNING entry_id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for i := 0; rows.Next(); i++ {
+		e := transaction.Entries[i]
+		if err = rows.Scan(&e.ID, &e.CreatedAt); err != nil {
+			return err
+		}
+
+		e.TransactionID = transaction.ID
+		e.OrderID = transaction.OrderID
+		e.TransactionType = transaction.Type
+	}
+
+	return rows.Err()
+}
+
+func (d *DefaultLedgerRepository) GetBalance(ctx context.Context, accountID int64) (entity.Money, error) {
+	query, args, err := sq.Select("COALESCE(SUM(amount), 0)").
+		From("ledger_entries").
+		Where(sq.Eq{
+			"account_id": accountID,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.Money{}, err
+	}
+
+	var res entity.Money
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&res)
+	if err != nil {
+		return entity.Money{}, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultLedgerRepository) GetEntries(ctx context.Context, accountID int64,
+	opts *entity.Options) ([]*entity.LedgerEntry, error) {
+	query, args, err := sq.Select(
+		"e.entry_id", "e.transaction_id", "e.account_id", "e.amount", "t.order_id", "t.type", "e.created_at").
+		From("ledger_entries e").
+		Join("ledger_transactions t ON t.transaction_id = e.transaction_id").
+		Where(sq.Eq{
+			"e.account_id": accountID,
+		}).
+		OrderBy("e.created_at DESC", "e.entry_id DESC").
+		Limit(uint64(opts.Limit)).
+		Offset(uint64(opts.Offset)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.LedgerEntry
+	for rows.Next() {
+		var entry entity.LedgerEntry
+		if err = rows.Scan(
+			&entry.ID, &entry.TransactionID, &entry.AccountID, &entry.Amount,
+			&entry.OrderID, &entry.TransactionType, &entry.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultLedgerRepository) GetTrialBalance(ctx context.Context) ([]*entity.TrialBalanceLine, error) {
+	query, args, err := sq.Select(
+		"a.type",
+		"COALESCE(SUM(-e.amount) FILTER (WHERE e.amount < 0), 0)",
+		"COALESCE(SUM(e.amount) FILTER (WHERE e.amount > 0), 0)").
+		From("ledger_accounts a").
+		LeftJoin("ledger_entries e ON e.account_id = a.account_id").
+		GroupBy("a.type").
+		OrderBy("a.type").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.TrialBalanceLine
+	for rows.Next() {
+		var line entity.TrialBalanceLine
+		if err = rows.Scan(&line.AccountType, &line.Debit, &line.Credit); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &line)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultLedgerRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+)
+
+type DefaultOrderExtensionRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultOrderExtensionRepository(db *postgres.PostgresDb) *DefaultOrderExtensionRepository {
+	return &DefaultOrderExtensionRepository{
+		db: db,
+	}
+}
+
+func (d *DefaultOrderExtensionRepository) Save(ctx context.Context, extension *entity.OrderExtension) error {
+	query, args, err := sq.Insert("order_extensions").
+		Columns("order_id", "minutes", "status").
+		Values(extension.OrderID, extension.Minutes, extension.Status).
+		Suffix("RETURNING order_extension_id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&extension.ID, &extension.CreatedAt)
+}
+
+func (d *DefaultOrderExtensionRepository) GetByID(ctx context.Context, id int64) (*entity.OrderExtension, error) {
+	query, args, err := sq.Select(
+		"order_extension_id", "order_id", "minutes", "status", "amount", "decided_at", "created_at").
+		From("order_extensions").
+		Where(sq.Eq{
+			"order_extension_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.OrderExtension
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&res.ID, &res.OrderID, &res.Minutes, &res.Status, &res.Amount, &res.DecidedAt, &res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultOrderExtensionRepository) GetAllByOrderID(ctx context.Context,
+	orderID int64) ([]*entity.OrderExtension, error) {
+	query, args, err := sq.Select(
+		"order_extension_id", "order_id", "minutes", "status", "amount", "decided_at", "created_at").
+		From("order_extensions").
+		Where(sq.Eq{
+			"order_id": orderID,
+		}).
+		OrderBy("created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.OrderExtension
+	for rows.Next() {
+		var extension entity.OrderExtension
+		if err = rows.Scan(
+			&extension.ID, &extension.OrderID, &extension.Minutes, &extension.Status,
+			&extension.Amount, &extension.DecidedAt, &extension.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &extension)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultOrderExtensionRepository) GetPendingByOrderID(ctx context.Context,
+	orderID int64) (*entity.OrderExtension, error) {
+	query, args, err := sq.Select(
+		"order_extension_id", "order_id", "minutes", "status", "amount", "decided_at", "created_at").
+		From("order_extensions").
+		Where(sq.Eq{
+			"order_id": orderID,
+			"status":   entity.OrderExtensionPending,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.OrderExtension
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&res.ID, &res.OrderID, &res.Minutes, &res.Status, &res.Amount, &res.DecidedAt, &res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultOrderExtensionRepository) Update(ctx context.Context,
+	extension *entity.OrderExtension) (*entity.OrderExtension, error) {
+	query, args, err := sq.Update("order_extensions").
+		SetMap(map[string]interface{}{
+			"status":     extension.Status,
+			"amount":     extension.Amount,
+			"decided_at": extension.DecidedAt,
+		}).
+		Where(sq.Eq{
+			"order_extension_id": extension.ID,
+		}).
+		Suffix("RETURNING order_extension_id, order_id, minutes, status, amount, decided_at, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.OrderExtension
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&res.ID, &res.OrderID, &res.Minutes, &res.Status, &res.Amount, &res.DecidedAt, &res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultOrderExtensionRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
@@ -38,7 +39,10 @@ func (d *DefaultOrderRepository) Save(ctx context.Context, order *entity.Order)
 }
 
 func (d *DefaultOrderRepository) GetByID(ctx context.Context, id int64) (*entity.Order, error) {
-	query, args, err := sq.Select("order_id", "booking_id", "status", "created_at").
+	query, args, err := sq.Select(
+		"order_id", "booking_id", "status", "completed_at",
+		"confirmation_deadline", "confirmed_at", "issue_reason",
+		"extension_minutes", "extension_amount", "created_at").
 		From("orders").
 		Where(sq.Eq{
 			"order_id": id,
@@ -52,7 +56,11 @@ func (d *DefaultOrderRepository) GetByID(ctx context.Context, id int64) (*entity
 	var res entity.Order
 	err = d.getExecutor(ctx).
 		QueryRow(ctx, query, args...).
-		Scan(&res.ID, &res.BookingID, &res.Status, &res.CreatedAt)
+		Scan(
+			&res.ID, &res.BookingID, &res.Status, &res.CompletedAt,
+			&res.ConfirmationDeadline, &res.ConfirmedAt, &res.IssueReason,
+			&res.ExtensionMinutes, &res.ExtensionAmount, &res.CreatedAt,
+		)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, persistence.ErrNoRowsFound
@@ -64,7 +72,10 @@ func (d *DefaultOrderRepository) GetByID(ctx context.Context, id int64) (*entity
 }
 
 func (d *DefaultOrderRepository) GetByBookingID(ctx context.Context, bookingID int64) (*entity.Order, error) {
-	query, args, err := sq.Select("order_id", "booking_id", "status", "created_at").
+	query, args, err := sq.Select(
+		"order_id", "booking_id", "status", "completed_at",
+		"confirmation_deadline", "confirmed_at", "issue_reason",
+		"extension_minutes", "extension_amount", "created_at").
 		From("orders").
 		Where(sq.Eq{
 			"booking_id": bookingID,
@@ -78,7 +89,11 @@ func (d *DefaultOrderRepository) GetByBookingID(ctx context.Context, bookingID i
 	var res entity.Order
 	err = d.getExecutor(ctx).
 		QueryRow(ctx, query, args...).
-		Scan(&res.ID, &res.BookingID, &res.Status, &res.CreatedAt)
+		Scan(
+			&res.ID, &res.BookingID, &res.Status, &res.CompletedAt,
+			&res.ConfirmationDeadline, &res.ConfirmedAt, &res.IssueReason,
+			&res.ExtensionMinutes, &res.ExtensionAmount, &res.CreatedAt,
+		)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, persistence.ErrNoRowsFound
@@ -91,11 +106,19 @@ func (d *DefaultOrderRepository) GetByBookingID(ctx context.Context, bookingID i
 
 func (d *DefaultOrderRepository) UpdateStatus(ctx context.Context, order *entity.Order) (*entity.Order, error) {
 	query, args, err := sq.Update("orders").
-		Set("status", order.Status).
+		SetMap(map[string]interface{}{
+			"status":                order.Status,
+			"completed_at":          order.CompletedAt,
+			"confirmation_deadline": order.ConfirmationDeadline,
+			"confirmed_at":          order.ConfirmedAt,
+			"issue_reason":          order.IssueReason,
+		}).
 		Where(sq.Eq{
 			"order_id": order.ID,
 		}).
-		Suffix("RETURNING order_id, booking_id, status, created_at").
+		Suffix("RETURNING order_id, booking_id, status, completed_at, " +
+			"confirmation_deadline, confirmed_at, issue_reason, " +
+			"extension_minutes, extension_amount, created_at").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -105,7 +128,11 @@ func (d *DefaultOrderRepository) UpdateStatus(ctx context.Context, order *entity
 	var res entity.Order
 	err = d.getExecutor(ctx).
 		QueryRow(ctx, query, args...).
-		Scan(&res.ID, &res.BookingID, &res.Status, &res.CreatedAt)
+		Scan(
+			&res.ID, &res.BookingID, &res.Status, &res.CompletedAt,
+			&res.ConfirmationDeadline, &res.ConfirmedAt, &res.IssueReason,
+			&res.ExtensionMinutes, &res.ExtensionAmount, &res.CreatedAt,
+		)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, persistence.ErrNoRowsFound
@@ -119,7 +146,9 @@ func (d *DefaultOrderRepository) UpdateStatus(ctx context.Context, order *entity
 func (d *DefaultOrderRepository) GetAllByModelID(ctx context.Context, modelID int64,
 	opts *entity.Options) ([]*entity.Order, error) {
 	query, args, err := sq.Select(
-		"o.order_id", "o.booking_id", "o.status", "o.created_at").
+		"o.order_id", "o.booking_id", "o.status", "o.completed_at",
+		"o.confirmation_deadline", "o.confirmed_at", "o.issue_reason",
+		"o.extension_minutes", "o.extension_amount", "o.created_at").
 		From("orders o").
 		Join("bookings b ON o.booking_id = b.booking_id").
 		Join("model_services ms ON b.model_service_id = ms.model_service_id").
@@ -143,7 +172,11 @@ func (d *DefaultOrderRepository) GetAllByModelID(ctx context.Context, modelID in
 	var res []*entity.Order
 	for rows.Next() {
 		var order entity.Order
-		if err = rows.Scan(&order.ID, &order.BookingID, &order.Status, &order.CreatedAt); err != nil {
+		if err = rows.Scan(
+			&order.ID, &order.BookingID, &order.Status, &order.CompletedAt,
+			&order.ConfirmationDeadline, &order.ConfirmedAt, &order.IssueReason,
+			&order.ExtensionMinutes, &order.ExtensionAmount, &order.CreatedAt,
+		); err != nil {
 			return nil, err
 		}
 
@@ -158,7 +191,10 @@ func (d *DefaultOrderRepository) GetAllByModelID(ctx context.Context, modelID in
 }
 
 func (d *DefaultOrderRepository) GetAllByClientID(ctx context.Context, clientID int64) ([]*entity.Order, error) {
-	query, args, err := sq.Select("o.order_id", "o.booking_id", "o.status", "o.created_at").
+	query, args, err := sq.Select(
+		"o.order_id", "o.booking_id", "o.status", "o.completed_at",
+		"o.confirmation_deadline", "o.confirmed_at", "o.issue_reason",
+		"o.extension_minutes", "o.extension_amount", "o.created_at").
 		From("orders o").
 		Join("bookings b ON o.booking_id = b.booking_id").
 		Where(sq.Eq{
@@ -179,7 +215,11 @@ func (d *DefaultOrderRepository) GetAllByClientID(ctx context.Context, clientID
 	var res []*entity.Order
 	for rows.Next() {
 		var order entity.Order
-		if err = rows.Scan(&order.ID, &order.BookingID, &order.Status, &order.CreatedAt); err != nil {
+		if err = rows.Scan(
+			&order.ID, &order.BookingID, &order.Status, &order.CompletedAt,
+			&order.ConfirmationDeadline, &order.ConfirmedAt, &order.IssueReason,
+			&order.ExtensionMinutes, &order.ExtensionAmount, &order.CreatedAt,
+		); err != nil {
 			return nil, err
 		}
 
@@ -194,7 +234,10 @@ func (d *DefaultOrderRepository) GetAllByClientID(ctx context.Context, clientID
 }
 
 func (d *DefaultOrderRepository) GetAll(ctx context.Context, opts *entity.Options) ([]*entity.Order, error) {
-	query, args, err := sq.Select("order_id", "booking_id", "status", "created_at").
+	query, args, err := sq.Select(
+		"order_id", "booking_id", "status", "completed_at",
+		"confirmation_deadline", "confirmed_at", "issue_reason",
+		"extension_minutes", "extension_amount", "created_at").
 		From("orders").
 		Limit(uint64(opts.Limit)).
 		Offset(uint64(opts.Offset)).
@@ -213,7 +256,171 @@ func (d *DefaultOrderRepository) GetAll(ctx context.Context, opts *entity.Option
 	var res []*entity.Order
 	for rows.Next() {
 		var order entity.Order
-		if err = rows.Scan(&order.ID, &order.BookingID, &order.Status, &order.CreatedAt); err != nil {
+		if err = rows.Scan(
+			&order.ID, &order.BookingID, &order.Status, &order.CompletedAt,
+			&order.ConfirmationDeadline, &order.ConfirmedAt, &order.IssueReason,
+			&order.ExtensionMinutes, &order.ExtensionAmount, &order.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &order)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetExpiredConfirmations gives the orders still waiting for the client after the confirmation deadline.
+func (d *DefaultOrderRepository) GetExpiredConfirmations(ctx context.Context,
+	now time.Time) ([]*entity.Order, error) {
+	query, args, err := sq.Select(
+		"order_id", "booking_id", "status", "completed_at",
+		"confirmation_deadline", "confirmed_at", "issue_reason",
+		"extension_minutes", "extension_amount", "created_at").
+		From("orders").
+		Where(sq.Eq{
+			"status": entity.OrderPendingConfirmation,
+		}).
+		Where(sq.LtOrEq{
+			"confirmation_deadline": now,
+		}).
+		OrderBy("confirmation_deadline").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.Order
+	for rows.Next() {
+		var order entity.Order
+		if err = rows.Scan(
+			&order.ID, &order.BookingID, &order.Status, &order.CompletedAt,
+			&order.ConfirmationDeadline, &order.ConfirmedAt, &order.IssueReason,
+			&order.ExtensionMinutes, &order.ExtensionAmount, &order.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &order)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultOrderRepository) AddExtension(ctx context.Context, orderID int64,
+	minutes int, amount entity.Money) (*entity.Order, error) {
+	query, args, err := sq.Update("orders").
+		Set("extension_minutes", sq.Expr("extension_minutes + ?", minutes)).
+		Set("extension_amount", sq.Expr("extension_amount + ?", amount)).
+		Where(sq.Eq{
+			"order_id": orderID,
+		}).
+		Suffix("RETURNING order_id, booking_id, status, completed_at, " +
+			"confirmation_deadline, confirmed_at, issue_reason, " +
+			"extension_minutes, extension_amount, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.Order
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(
+			&res.ID, &res.BookingID, &res.Status, &res.CompletedAt,
+			&res.ConfirmationDeadline, &res.ConfirmedAt, &res.IssueReason,
+			&res.ExtensionMinutes, &res.ExtensionAmount, &res.CreatedAt,
+		)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetArchivedByID looks the order up among the ones the retention job has archived.
+func (d *DefaultOrderRepository) GetArchivedByID(ctx context.Context, id int64) (*entity.Order, error) {
+	query, args, err := sq.Select(
+		"order_id", "booking_id", "status", "completed_at",
+		"confirmation_deadline", "confirmed_at", "issue_reason",
+		"extension_minutes", "extension_amount", "created_at", "archived_at").
+		From("orders_archive").
+		Where(sq.Eq{
+			"order_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.Order
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(
+			&res.ID, &res.BookingID, &res.Status, &res.CompletedAt,
+			&res.ConfirmationDeadline, &res.ConfirmedAt, &res.IssueReason,
+			&res.ExtensionMinutes, &res.ExtensionAmount, &res.CreatedAt, &res.ArchivedAt,
+		)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetAllArchived returns the archived orders, the most recently archived first.
+func (d *DefaultOrderRepository) GetAllArchived(ctx context.Context, opts *entity.Options) ([]*entity.Order, error) {
+	query, args, err := sq.Select(
+		"order_id", "booking_id", "status", "completed_at",
+		"confirmation_deadline", "confirmed_at", "issue_reason",
+		"extension_minutes", "extension_amount", "created_at", "archived_at").
+		From("orders_archive").
+		OrderBy("archived_at DESC", "order_id DESC").
+		Limit(uint64(opts.Limit)).
+		Offset(uint64(opts.Offset)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.Order
+	for rows.Next() {
+		var order entity.Order
+		if err = rows.Scan(
+			&order.ID, &order.BookingID, &order.Status, &order.CompletedAt,
+			&order.ConfirmationDeadline, &order.ConfirmedAt, &order.IssueReason,
+			&order.ExtensionMinutes, &order.ExtensionAmount, &order.CreatedAt, &order.ArchivedAt,
+		); err != nil {
 			return nil, err
 		}
 
@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+)
+
+type DefaultPaymentRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultPaymentRepository(db *postgres.PostgresDb) *DefaultPaymentRepository {
+	return &DefaultPaymentRepository{
+		db: db,
+	}
+}
+
+func (d *DefaultPaymentRepository) Save(ctx context.Context, payment *entity.Payment) error {
+	query, args, err := sq.Insert("payments").
+		Columns("order_id", "provider", "provider_payment_id", "amount", "status").
+		Values(payment.OrderID, payment.Provider, payment.ProviderPaymentID, payment.Amount, payment.Status).
+		Suffix("RETURNING payment_id, created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&payment.ID, &payment.CreatedAt, &payment.UpdatedAt)
+}
+
+func (d *DefaultPaymentRepository) GetByOrderID(ctx context.Context, orderID int64) (*entity.Payment, error) {
+	query, args, err := sq.Select(
+		"payment_id", "order_id", "provider", "provider_payment_id", "amount", "captured_amount",
+		"refunded_amount", "status", "failure_reason", "created_at", "updated_at").
+		From("payments").
+		Where(sq.Eq{
+			"order_id": orderID,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.Payment
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(
+			&res.ID, &res.OrderID, &res.Provider, &res.ProviderPaymentID, &res.Amount, &res.CapturedAmount,
+			&res.RefundedAmount, &res.Status, &res.FailureReason, &res.CreatedAt, &res.UpdatedAt,
+		)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultPaymentRepository) GetByProviderPaymentID(ctx context.Context,
+	provider, providerPaymentID string) (*entity.Payment, error) {
+	query, args, err := sq.Select(
+		"payment_id", "order_id", "provider", "provider_payment_id", "amount", "captured_amount",
+		"refunded_amount", "status", "failure_reason", "created_at", "updated_at").
+		From("payments").
+		Where(sq.Eq{
+			"provider":            provider,
+			"provider_payment_id": providerPaymentID,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.Payment
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(
+			&res.ID, &res.OrderID, &res.Provider, &res.ProviderPaymentID, &res.Amount, &res.CapturedAmount,
+			&res.RefundedAmount, &res.Status, &res.FailureReason, &res.CreatedAt, &res.UpdatedAt,
+		)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultPaymentRepository) Update(ctx context.Context, payment *entity.Payment) (*entity.Payment, error) {
+	query, args, err := sq.Update("payments").
+		SetMap(map[string]interface{}{
+			"amount":          payment.Amount,
+			"captured_amount": payment.CapturedAmount,
+			"refunded_amount": payment.RefundedAmount,
+			"status":          payment.Status,
+			"failure_reason":  payment.FailureReason,
+			"updated_at":      sq.Expr("now()"),
+		}).
+		Where(sq.Eq{
+			"payment_id": payment.ID,
+		}).
+		Suffix("RETURNING payment_id, order_id, provider, provider_payment_id, amount, captured_amount, " +
+			"refunded_amount, status, failure_reason, created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.Payment
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(
+			&res.ID, &res.OrderID, &res.Provider, &res.ProviderPaymentID, &res.Amount, &res.CapturedAmount,
+			&res.RefundedAmount, &res.Status, &res.FailureReason, &res.CreatedAt, &res.UpdatedAt,
+		)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// SaveEvent returns persistence.ErrDuplicateKey for an event which was already received,
+// the conflict is skipped instead of raised to keep the surrounding transaction usable.
+func (d *DefaultPaymentRepository) SaveEvent(ctx context.Context, event *entity.PaymentEvent) error {
+	query, args, err := sq.Insert("payment_events").
+		Columns("provider", "event_id", "provider_payment_id", "type", "amount", "reason").
+		Values(event.Provider, event.EventID, event.ProviderPaymentID, event.Type, event.Amount, event.Reason).
+		Suffix("ON CONFLICT (provider, event_id) DO NOTHING RETURNING payment_event_id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return persistence.ErrDuplicateKey
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (d *DefaultPaymentRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
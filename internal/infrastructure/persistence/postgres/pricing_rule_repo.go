@@ -0,0 +1,232 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+)
+
+var pricingRuleColumns = []string{
+	"pricing_rule_id", "model_id", "name", "rule_type", "multiplier", "amount", "weekdays",
+	"start_minute", "end_minute", "holidays", "is_active", "created_at", "updated_at",
+}
+
+type DefaultPricingRuleRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultPricingRuleRepository(db *postgres.PostgresDb) *DefaultPricingRuleRepository {
+	return &DefaultPricingRuleRepository{
+		db: db,
+	}
+}
+
+func (d *DefaultPricingRuleRepository) Save(ctx context.Context, rule *entity.PricingRule) error {
+	query, args, err := sq.Insert("pricing_rules").
+		Columns("model_id", "name", "rule_type", "multiplier", "amount", "weekdays",
+			"start_minute", "end_minute", "holidays", "is_active").
+		Values(rule.ModelID, rule.Name, rule.Type, rule.Multiplier, rule.Amount, intArray(rule.Weekdays),
+			rule.StartMinute, rule.EndMinute, dateArray(rule.Holidays), rule.IsActive).
+		Suffix("RETURNING pricing_rule_id, created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&rule.ID, &rule.CreatedAt, &rule.UpdatedAt)
+}
+
+func (d *DefaultPricingRuleRepository) GetByID(ctx context.Context, id int64) (*entity.PricingRule, error) {
+	query, args, err := sq.Select(pricingRuleColumns...).
+		From("pricing_rules").
+		Where(sq.Eq{
+			"pricing_rule_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanPricingRule(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultPricingRuleRepository) GetByModelID(ctx context.Context, modelID int64,
+	opts *entity.Options) ([]*entity.PricingRule, error) {
+	query, args, err := sq.Select(pricingRuleColumns...).
+		From("pricing_rules").
+		Where(sq.Eq{
+			"model_id": modelID,
+		}).
+		OrderBy("pricing_rule_id").
+		Limit(uint64(opts.Limit)).
+		Offset(uint64(opts.Offset)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+func (d *DefaultPricingRuleRepository) GetActiveByModelID(ctx context.Context,
+	modelID int64) ([]*entity.PricingRule, error) {
+	query, args, err := sq.Select(pricingRuleColumns...).
+		From("pricing_rules").
+		Where(sq.Eq{
+			"model_id":  modelID,
+			"is_active": true,
+		}).
+		OrderBy("pricing_rule_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+func (d *DefaultPricingRuleRepository) Update(ctx context.Context,
+	rule *entity.PricingRule) (*entity.PricingRule, error) {
+	query, args, err := sq.Update("pricing_rules").
+		SetMap(map[string]interface{}{
+			"name":         rule.Name,
+			"rule_type":    rule.Type,
+			"multiplier":   rule.Multiplier,
+			"amount":       rule.Amount,
+			"weekdays":     intArray(rule.Weekdays),
+			"start_minute": rule.StartMinute,
+			"end_minute":   rule.EndMinute,
+			"holidays":     dateArray(rule.Holidays),
+			"is_active":    rule.IsActive,
+			"updated_at":   sq.Expr("now()"),
+		}).
+		Where(sq.Eq{
+			"pricing_rule_id": rule.ID,
+		}).
+		Suffix("RETURNING " + strings.Join(pricingRuleColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanPricingRule(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultPricingRuleRepository) Delete(ctx context.Context, id int64) error {
+	query, args, err := sq.Delete("pricing_rules").
+		Where(sq.Eq{
+			"pricing_rule_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := d.getExecutor(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return persistence.ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (d *DefaultPricingRuleRepository) getMany(ctx context.Context, query string,
+	args []interface{}) ([]*entity.PricingRule, error) {
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.PricingRule
+	for rows.Next() {
+		rule, err := scanPricingRule(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, rule)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func scanPricingRule(row pgx.Row) (*entity.PricingRule, error) {
+	var res entity.PricingRule
+	err := row.Scan(
+		&res.ID, &res.ModelID, &res.Name, &res.Type, &res.Multiplier, &res.Amount, &res.Weekdays,
+		&res.StartMinute, &res.EndMinute, &res.Holidays, &res.IsActive, &res.CreatedAt, &res.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// intArray keeps an empty weekday list an empty array instead of NULL.
+func intArray(values []int) []int {
+	if values == nil {
+		return []int{}
+	}
+
+	return values
+}
+
+// dateArray keeps an empty holiday calendar an empty array instead of NULL.
+func dateArray(dates []time.Time) []time.Time {
+	if dates == nil {
+		return []time.Time{}
+	}
+
+	return dates
+}
+
+func (d *DefaultPricingRuleRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
@@ -0,0 +1,293 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var promoCodeColumns = []string{
+	"promo_code_id", "code", "discount_type", "percent_off", "amount_off", "valid_from", "valid_until",
+	"max_uses", "max_uses_per_client", "used_count", "service_ids", "model_ids", "is_active", "created_at",
+}
+
+type DefaultPromoCodeRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultPromoCodeRepository(db *postgres.PostgresDb) *DefaultPromoCodeRepository {
+	return &DefaultPromoCodeRepository{
+		db: db,
+	}
+}
+
+func (d *DefaultPromoCodeRepository) Save(ctx context.Context, promo *entity.PromoCode) error {
+	query, args, err := sq.Insert("promo_codes").
+		Columns("code", "discount_type", "percent_off", "amount_off", "valid_from", "valid_until",
+			"max_uses", "max_uses_per_client", "service_ids", "model_ids", "is_active").
+		Values(promo.Code, promo.DiscountType, promo.PercentOff, promo.AmountOff, promo.ValidFrom, promo.ValidUntil,
+			promo.MaxUses, promo.MaxUsesPerClient, int64Array(promo.ServiceIDs), int64Array(promo.ModelIDs),
+			promo.IsActive).
+		Suffix("RETURNING promo_code_id, used_count, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&promo.ID, &promo.UsedCount, &promo.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == persistence.UniqueViolationCode {
+			return persistence.ErrDuplicateKey
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (d *DefaultPromoCodeRepository) GetByID(ctx context.Context, id int64) (*entity.PromoCode, error) {
+	return d.getOne(ctx, sq.Eq{
+		"promo_code_id": id,
+	})
+}
+
+func (d *DefaultPromoCodeRepository) GetByCode(ctx context.Context, code string) (*entity.PromoCode, error) {
+	return d.getOne(ctx, sq.Eq{
+		"code": code,
+	})
+}
+
+func (d *DefaultPromoCodeRepository) GetAll(ctx context.Context,
+	opts *entity.Options) ([]*entity.PromoCode, error) {
+	query, args, err := sq.Select(promoCodeColumns...).
+		From("promo_codes").
+		OrderBy("created_at DESC", "promo_code_id DESC").
+		Limit(uint64(opts.Limit)).
+		Offset(uint64(opts.Offset)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.PromoCode
+	for rows.Next() {
+		promo, err := scanPromoCode(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, promo)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultPromoCodeRepository) Update(ctx context.Context,
+	promo *entity.PromoCode) (*entity.PromoCode, error) {
+	query, args, err := sq.Update("promo_codes").
+		SetMap(map[string]interface{}{
+			"valid_from":          promo.ValidFrom,
+			"valid_until":         promo.ValidUntil,
+			"max_uses":            promo.MaxUses,
+			"max_uses_per_client": promo.MaxUsesPerClient,
+			"service_ids":         int64Array(promo.ServiceIDs),
+			"model_ids":           int64Array(promo.ModelIDs),
+			"is_active":           promo.IsActive,
+		}).
+		Where(sq.Eq{
+			"promo_code_id": promo.ID,
+		}).
+		Suffix("RETURNING " + strings.Join(promoCodeColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanPromoCode(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// IncrementUsage takes one use of the code, the row lock it holds until the end of the
+// transaction serializes concurrent bookings with the same code.
+func (d *DefaultPromoCodeRepository) IncrementUsage(ctx context.Context, id int64) error {
+	query, args, err := sq.Update("promo_codes").
+		Set("used_count", sq.Expr("used_count + 1")).
+		Where(sq.Eq{
+			"promo_code_id": id,
+		}).
+		Where("(max_uses IS NULL OR used_count < max_uses)").
+		Suffix("RETURNING promo_code_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var promoCodeID int64
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&promoCodeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return persistence.ErrNoRowsFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (d *DefaultPromoCodeRepository) CountActiveRedemptions(ctx context.Context, id, clientID int64) (int, error) {
+	query, args, err := sq.Select("COUNT(*)").
+		From("promo_redemptions").
+		Where(sq.Eq{
+			"promo_code_id": id,
+			"client_id":     clientID,
+			"released_at":   nil,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var res int
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&res)
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+func (d *DefaultPromoCodeRepository) SaveRedemption(ctx context.Context, redemption *entity.PromoRedemption) error {
+	query, args, err := sq.Insert("promo_redemptions").
+		Columns("promo_code_id", "client_id", "booking_id").
+		Values(redemption.PromoCodeID, redemption.ClientID, redemption.BookingID).
+		Suffix("RETURNING promo_redemption_id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&redemption.ID, &redemption.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == persistence.UniqueViolationCode {
+			return persistence.ErrDuplicateKey
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ReleaseRedemption gives the use of the code back, releasing a booking without a code is a no-op.
+func (d *DefaultPromoCodeRepository) ReleaseRedemption(ctx context.Context, bookingID int64, now time.Time) error {
+	const query = `
+		WITH released AS (
+			UPDATE promo_redemptions
+			SET released_at = $2
+			WHERE booking_id = $1 AND released_at IS NULL
+			RETURNING promo_code_id
+		)
+		UPDATE promo_codes p
+		SET used_count = p.used_count - 1
+		FROM released r
+		WHERE p.promo_code_id = r.promo_code_id`
+
+	_, err := d.getExecutor(ctx).Exec(ctx, query, bookingID, now)
+
+	return err
+}
+
+func (d *DefaultPromoCodeRepository) getOne(ctx context.Context, where sq.Eq) (*entity.PromoCode, error) {
+	query, args, err := sq.Select(promoCodeColumns...).
+		From("promo_codes").
+		Where(where).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanPromoCode(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func scanPromoCode(row pgx.Row) (*entity.PromoCode, error) {
+	var res entity.PromoCode
+	err := row.Scan(
+		&res.ID, &res.Code, &res.DiscountType, &res.PercentOff, &res.AmountOff, &res.ValidFrom, &res.ValidUntil,
+		&res.MaxUses, &res.MaxUsesPerClient, &res.UsedCount, &res.ServiceIDs, &res.ModelIDs,
+		&res.IsActive, &res.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// int64Array keeps an empty restriction list an empty array instead of NULL.
+func int64Array(ids []int64) []int64 {
+	if ids == nil {
+		return []int64{}
+	}
+
+	return ids
+}
+
+func (d *DefaultPromoCodeRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type DefaultReceiptRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultReceiptRepository(db *postgres.PostgresDb) *DefaultReceiptRepository {
+	return &DefaultReceiptRepository{
+		db: db,
+	}
+}
+
+// Save takes the next receipt number and inserts the receipt in one statement. The counter row stays
+// locked until the insert is committed and its increment is rolled back together with a failed insert,
+// so the numbers have no gaps even under concurrent requests.
+func (d *DefaultReceiptRepository) Save(ctx context.Context, receipt *entity.Receipt) error {
+	query, args, err := sq.Insert("receipts").
+		Prefix("WITH next AS (UPDATE receipt_counter SET last_number = last_number + 1 RETURNING last_number)").
+		Columns("number", "order_id", "client_id", "model_id", "model_name", "service_title", "lines",
+			"total", "payment_method", "issued_at").
+		Values(sq.Expr("(SELECT last_number FROM next)"), receipt.OrderID, receipt.ClientID, receipt.ModelID,
+			receipt.ModelName, receipt.ServiceTitle, receipt.Lines, receipt.Total, receipt.PaymentMethod,
+			receipt.IssuedAt).
+		Suffix("RETURNING receipt_id, number").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&receipt.ID, &receipt.Number)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == persistence.UniqueViolationCode {
+			return persistence.ErrDuplicateKey
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (d *DefaultReceiptRepository) GetByOrderID(ctx context.Context, orderID int64) (*entity.Receipt, error) {
+	query, args, err := sq.Select("receipt_id", "number", "order_id", "client_id", "model_id", "model_name",
+		"service_title", "lines", "total", "payment_method", "issued_at").
+		From("receipts").
+		Where(sq.Eq{
+			"order_id": orderID,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res entity.Receipt
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&res.ID, &res.Number, &res.OrderID, &res.ClientID, &res.ModelID, &res.ModelName,
+			&res.ServiceTitle, &res.Lines, &res.Total, &res.PaymentMethod, &res.IssuedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultReceiptRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+)
+
+// the columns moved to the archives, a column added to a live table has to be listed here as well
+var (
+	archivedOrderColumns = []string{
+		"order_id", "booking_id", "status", "completed_at", "confirmation_deadline", "confirmed_at",
+		"issue_reason", "extension_minutes", "extension_amount", "created_at",
+	}
+	archivedBookingColumns = []string{
+		"booking_id", "client_id", "model_service_id", "slot_id", "address", "status", "price", "discount",
+		"promo_code_id", "base_price", "surcharges", "add_ons", "duration_scaling", "travel_fee",
+		"expires_at", "created_at",
+	}
+)
+
+type DefaultRetentionRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultRetentionRepository(db *postgres.PostgresDb) *DefaultRetentionRepository {
+	return &DefaultRetentionRepository{
+		db: db,
+	}
+}
+
+// ArchiveOrders moves the completed and cancelled orders created before the moment to the archive.
+// An order with a payment still authorized or a dispute not resolved yet stays where it is,
+// the payments, the disputes, the extensions, the ledger and the receipts of an archived order stay in place.
+func (d *DefaultRetentionRepository) ArchiveOrders(ctx context.Context, before time.Time) (int64, error) {
+	return d.archive(ctx, "orders", archivedOrderColumns, sq.Delete("orders").
+		Where(sq.Eq{
+			"status": []entity.OrderStatus{entity.OrderCompleted, entity.OrderCancelled},
+		}).
+		Where(sq.Lt{
+			"created_at": before,
+		}).
+		Where("NOT EXISTS (SELECT 1 FROM payments p WHERE p.order_id = orders.order_id AND p.status = ?)",
+			entity.PaymentAuthorized).
+		Where("NOT EXISTS (SELECT 1 FROM disputes di WHERE di.order_id = orders.order_id AND di.status <> ?)",
+			entity.DisputeResolved))
+}
+
+// ArchiveBookings moves the rejected, cancelled and expired bookings created before the moment to the
+// archive, along with the bookings of the orders archived already. A promo code redemption of an archived booking
+// stays in place.
+func (d *DefaultRetentionRepository) ArchiveBookings(ctx context.Context, before time.Time) (int64, error) {
+	return d.archive(ctx, "bookings", archivedBookingColumns, sq.Delete("bookings").
+		Where(sq.Or{
+			sq.And{
+				sq.Eq{
+					"status": []entity.BookingStatus{
+						entity.BookingRejected, entity.BookingCancelled, entity.BookingExpired,
+					},
+				},
+				sq.Lt{
+					"created_at": before,
+				},
+			},
+			sq.Expr("EXISTS (SELECT 1 FROM orders_archive oa WHERE oa.booking_id = bookings.booking_id)"),
+		}).
+		Where("NOT EXISTS (SELECT 1 FROM orders o WHERE o.booking_id = bookings.booking_id)"))
+}
+
+// ArchiveSlots moves the slots ended before the moment no booking refers to anymore to the archive.
+func (d *DefaultRetentionRepository) ArchiveSlots(ctx context.Context, before time.Time) (int64, error) {
+	return d.archive(ctx, "slots", slotColumns, sq.Delete("slots").
+		Where(sq.Lt{
+			"end_time": before,
+		}).
+		Where("NOT EXISTS (SELECT 1 FROM bookings b WHERE b.slot_id = slots.slot_id)"))
+}
+
+// archive deletes the rows and inserts the columns of them into the archive of the table in one statement,
+// archived_at is filled by its default.
+func (d *DefaultRetentionRepository) archive(ctx context.Context, table string, columns []string,
+	deletion sq.DeleteBuilder) (int64, error) {
+	list := strings.Join(columns, ", ")
+	query, args, err := deletion.
+		Prefix("WITH moved AS (").
+		Suffix("RETURNING " + list + ") INSERT INTO " + table + "_archive (" + list + ") SELECT " + list +
+			" FROM moved").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := d.getExecutor(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (d *DefaultRetentionRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
@@ -0,0 +1,202 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionRepository_ArchiveSlots(t *testing.T) {
+	db := setUpIntegrationDb(t)
+	model := createTestModel(t, db)
+	t.Cleanup(func() {
+		_, _ = db.Pool.Exec(context.Background(), "DELETE FROM slots_archive WHERE model_id = $1", model.ID)
+	})
+
+	slotRepo := NewDefaultSlotRepository(db)
+	retentionRepo := NewDefaultRetentionRepository(db)
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Hour)
+	old := entity.NewSlot(model.ID, now.Add(-72*time.Hour), now.Add(-71*time.Hour))
+	recent := entity.NewSlot(model.ID, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	require.NoError(t, slotRepo.SaveAll(ctx, []*entity.Slot{old, recent}))
+
+	archived, err := retentionRepo.ArchiveSlots(ctx, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, archived, int64(1))
+
+	_, err = slotRepo.GetByID(ctx, old.ID)
+	assert.ErrorIs(t, err, persistence.ErrNoRowsFound, "archived slot leaves the live table")
+
+	var archivedAt time.Time
+	err = db.Pool.QueryRow(ctx, "SELECT archived_at FROM slots_archive WHERE slot_id = $1", old.ID).
+		Scan(&archivedAt)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), archivedAt, time.Minute)
+
+	_, err = slotRepo.GetByID(ctx, recent.ID)
+	assert.NoError(t, err, "slot within the retention period stays")
+}
+
+func TestRetentionRepository_ArchiveOrders(t *testing.T) {
+	db := setUpIntegrationDb(t)
+	model := createTestModel(t, db)
+
+	orderRepo := NewDefaultOrderRepository(db)
+	paymentRepo := NewDefaultPaymentRepository(db)
+	retentionRepo := NewDefaultRetentionRepository(db)
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Hour)
+	old := now.Add(-72 * time.Hour)
+
+	paid := createTestOrder(t, db, model, old, entity.BookingApproved, entity.OrderCompleted)
+	captured := createTestPayment(t, db, paid, entity.PaymentCaptured)
+	pending := createTestOrder(t, db, model, old, entity.BookingApproved, entity.OrderCompleted)
+	createTestPayment(t, db, pending, entity.PaymentAuthorized)
+	recent := createTestOrder(t, db, model, now, entity.BookingApproved, entity.OrderCompleted)
+
+	archived, err := retentionRepo.ArchiveOrders(ctx, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, archived, int64(1))
+
+	_, err = orderRepo.GetByID(ctx, paid.ID)
+	assert.ErrorIs(t, err, persistence.ErrNoRowsFound, "paid order leaves the live table")
+
+	order, err := orderRepo.GetArchivedByID(ctx, paid.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.OrderCompleted, order.Status)
+	assert.NotNil(t, order.ArchivedAt)
+
+	payment, err := paymentRepo.GetByOrderID(ctx, paid.ID)
+	require.NoError(t, err, "payment of the archived order stays in place")
+	assert.Equal(t, captured.ID, payment.ID)
+
+	_, err = orderRepo.GetByID(ctx, pending.ID)
+	assert.NoError(t, err, "order with the payment still authorized stays")
+
+	_, err = orderRepo.GetByID(ctx, recent.ID)
+	assert.NoError(t, err, "order within the retention period stays")
+}
+
+func TestRetentionRepository_ArchiveBookings(t *testing.T) {
+	db := setUpIntegrationDb(t)
+	model := createTestModel(t, db)
+
+	bookingRepo := NewDefaultBookingRepository(db)
+	retentionRepo := NewDefaultRetentionRepository(db)
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Hour)
+	old := now.Add(-72 * time.Hour)
+
+	ordered := createTestOrder(t, db, model, old, entity.BookingApproved, entity.OrderCompleted)
+	live := createTestOrder(t, db, model, now, entity.BookingApproved, entity.OrderCompleted)
+	rejected := createTestBooking(t, db, model, old, entity.BookingRejected)
+	recent := createTestBooking(t, db, model, now, entity.BookingRejected)
+
+	_, err := retentionRepo.ArchiveOrders(ctx, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+
+	archived, err := retentionRepo.ArchiveBookings(ctx, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, archived, int64(2))
+
+	for _, id := range []int64{ordered.BookingID, rejected.ID} {
+		_, err = bookingRepo.GetByID(ctx, id)
+		assert.ErrorIs(t, err, persistence.ErrNoRowsFound, "archived booking leaves the live table")
+
+		booking, err := bookingRepo.GetArchivedByID(ctx, id)
+		require.NoError(t, err)
+		assert.NotNil(t, booking.ArchivedAt)
+	}
+
+	_, err = bookingRepo.GetByID(ctx, live.BookingID)
+	assert.NoError(t, err, "booking of the live order stays")
+
+	_, err = bookingRepo.GetByID(ctx, recent.ID)
+	assert.NoError(t, err, "booking within the retention period stays")
+}
+
+// createTestBooking books a new slot of the model a day after the moment and backdates the booking to it.
+func createTestBooking(t *testing.T, db *postgres.PostgresDb, model *entity.User, createdAt time.Time,
+	status entity.BookingStatus) *entity.Booking {
+	t.Helper()
+
+	ctx := context.Background()
+	t.Cleanup(func() {
+		_, _ = db.Pool.Exec(context.Background(),
+			"DELETE FROM bookings_archive WHERE client_id = $1", model.ID)
+		_, _ = db.Pool.Exec(context.Background(),
+			"DELETE FROM slots_archive WHERE model_id = $1", model.ID)
+	})
+
+	price := entity.NewMoney(150000, entity.CurrencyRUB)
+	service := entity.NewModelService(model.ID, "service", "description", price)
+	require.NoError(t, NewDefaultModelServiceRepository(db).Save(ctx, service))
+
+	// every booking gets a slot of its own hour, the slots of a model must not overlap
+	var slots int
+	require.NoError(t, db.Pool.QueryRow(ctx, "SELECT count(*) FROM slots WHERE model_id = $1", model.ID).
+		Scan(&slots))
+	start := createdAt.Add(24*time.Hour + time.Duration(slots)*time.Hour)
+	slot := entity.NewSlot(model.ID, start, start.Add(time.Hour))
+	require.NoError(t, NewDefaultSlotRepository(db).Save(ctx, slot))
+
+	booking := entity.NewBooking(model.ID, service.ID, slot.ID, entity.NewAddress("street", 1, 1, 1, 1, ""),
+		price, time.Hour)
+	booking.Status = status
+	require.NoError(t, NewDefaultBookingRepository(db).Save(ctx, booking))
+
+	_, err := db.Pool.Exec(ctx, "UPDATE bookings SET created_at = $1 WHERE booking_id = $2", createdAt, booking.ID)
+	require.NoError(t, err)
+
+	return booking
+}
+
+// createTestOrder places an order for a new booking and backdates both to the moment.
+func createTestOrder(t *testing.T, db *postgres.PostgresDb, model *entity.User, createdAt time.Time,
+	bookingStatus entity.BookingStatus, status entity.OrderStatus) *entity.Order {
+	t.Helper()
+
+	ctx := context.Background()
+	booking := createTestBooking(t, db, model, createdAt, bookingStatus)
+
+	order := entity.NewOrder(booking.ID)
+	order.Status = status
+	require.NoError(t, NewDefaultOrderRepository(db).Save(ctx, order))
+	t.Cleanup(func() {
+		_, _ = db.Pool.Exec(context.Background(), "DELETE FROM orders_archive WHERE order_id = $1", order.ID)
+	})
+
+	_, err := db.Pool.Exec(ctx, "UPDATE orders SET created_at = $1 WHERE order_id = $2", createdAt, order.ID)
+	require.NoError(t, err)
+
+	return order
+}
+
+// createTestPayment pays for the order, the payment outlives the order, so it is removed on its own.
+func createTestPayment(t *testing.T, db *postgres.PostgresDb, order *entity.Order,
+	status entity.PaymentStatus) *entity.Payment {
+	t.Helper()
+
+	payment := entity.NewPayment(order.ID, "test", fmt.Sprintf("payment-%d", time.Now().UnixNano()),
+		entity.NewMoney(150000, entity.CurrencyRUB))
+	payment.Status = status
+	require.NoError(t, NewDefaultPaymentRepository(db).Save(context.Background(), payment))
+	t.Cleanup(func() {
+		_, _ = db.Pool.Exec(context.Background(), "DELETE FROM payments WHERE payment_id = $1", payment.ID)
+	})
+
+	return payment
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -10,8 +11,14 @@ import (
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
 	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+var slotColumns = []string{
+	"slot_id", "model_id", "availability_template_id", "start_time", "end_time", "status", "blocked_by_import",
+	"time_off_id", "created_at",
+}
+
 type DefaultSlotRepository struct {
 	db *postgres.PostgresDb
 }
@@ -22,10 +29,12 @@ func NewDefaultSlotRepository(db *postgres.PostgresDb) *DefaultSlotRepository {
 	}
 }
 
+// Save fails with persistence.ErrRangeOverlap when the slot overlaps another slot of the model that is not
+// disabled, the database rejects it even if the overlap was created by a concurrent transaction.
 func (d *DefaultSlotRepository) Save(ctx context.Context, slot *entity.Slot) error {
 	query, args, err := sq.Insert("slots").
-		Columns("model_id", "start_time", "end_time", "status").
-		Values(slot.ModelID, slot.StartTime, slot.EndTime, slot.Status).
+		Columns("model_id", "availability_template_id", "start_time", "end_time", "status").
+		Values(slot.ModelID, slot.TemplateID, slot.StartTime, slot.EndTime, slot.Status).
 		Suffix("RETURNING slot_id, created_at").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
@@ -33,13 +42,57 @@ func (d *DefaultSlotRepository) Save(ctx context.Context, slot *entity.Slot) err
 		return err
 	}
 
-	return d.getExecutor(ctx).
+	err = d.getExecutor(ctx).
 		QueryRow(ctx, query, args...).
 		Scan(&slot.ID, &slot.CreatedAt)
+	if err != nil {
+		return mapRangeOverlap(err)
+	}
+
+	return nil
+}
+
+// SaveAll inserts the slots in one statement, the ids are returned in the order of the values.
+func (d *DefaultSlotRepository) SaveAll(ctx context.Context, slots []*entity.Slot) error {
+	if len(slots) == 0 {
+		return nil
+	}
+
+	insert := sq.Insert("slots").
+		Columns("model_id", "availability_template_id", "start_time", "end_time", "status")
+	for _, slot := range slots {
+		insert = insert.Values(slot.ModelID, slot.TemplateID, slot.StartTime, slot.EndTime, slot.Status)
+	}
+
+	query, args, err := insert.
+		Suffix("RETURNING slot_id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return mapRangeOverlap(err)
+	}
+	defer rows.Close()
+
+	for i := 0; rows.Next(); i++ {
+		if err = rows.Scan(&slots[i].ID, &slots[i].CreatedAt); err != nil {
+			return mapRangeOverlap(err)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return mapRangeOverlap(err)
+	}
+
+	return nil
 }
 
 func (d *DefaultSlotRepository) GetByID(ctx context.Context, id int64) (*entity.Slot, error) {
-	query, args, err := sq.Select("slot_id", "model_id", "start_time", "end_time", "status", "created_at").
+	query, args, err := sq.Select(slotColumns...).
 		From("slots").
 		Where(sq.Eq{
 			"slot_id": id,
@@ -50,10 +103,7 @@ func (d *DefaultSlotRepository) GetByID(ctx context.Context, id int64) (*entity.
 		return nil, err
 	}
 
-	var res entity.Slot
-	err = d.getExecutor(ctx).
-		QueryRow(ctx, query, args...).
-		Scan(&res.ID, &res.ModelID, &res.StartTime, &res.EndTime, &res.Status, &res.CreatedAt)
+	res, err := scanSlot(d.getExecutor(ctx).QueryRow(ctx, query, args...))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, persistence.ErrNoRowsFound
@@ -62,14 +112,15 @@ func (d *DefaultSlotRepository) GetByID(ctx context.Context, id int64) (*entity.
 		return nil, err
 	}
 
-	return &res, nil
+	return res, nil
 }
 
-func (d *DefaultSlotRepository) GetByModelID(ctx context.Context, modelID int64) ([]*entity.Slot, error) {
-	query, args, err := sq.Select("slot_id", "model_id", "start_time", "end_time", "status", "created_at").
+// GetByIDs returns the slots found among ids ordered by the start time, the missing ones are left out.
+func (d *DefaultSlotRepository) GetByIDs(ctx context.Context, ids []int64) ([]*entity.Slot, error) {
+	query, args, err := sq.Select(slotColumns...).
 		From("slots").
 		Where(sq.Eq{
-			"model_id": modelID,
+			"slot_id": ids,
 		}).
 		OrderBy("start_time ASC").
 		PlaceholderFormat(sq.Dollar).
@@ -78,33 +129,48 @@ func (d *DefaultSlotRepository) GetByModelID(ctx context.Context, modelID int64)
 		return nil, err
 	}
 
-	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
-	if err != nil {
-		return nil, err
+	return d.getMany(ctx, query, args)
+}
+
+func (d *DefaultSlotRepository) GetByModelID(ctx context.Context, modelID int64, filter *entity.SlotFilter,
+	opts *entity.Options) ([]*entity.Slot, error) {
+	builder := sq.Select(slotColumns...).
+		From("slots").
+		Where(sq.Eq{
+			"model_id": modelID,
+		}).
+		OrderBy("start_time ASC", "slot_id ASC").
+		Limit(uint64(opts.Limit)).
+		Offset(uint64(opts.Offset))
+
+	if filter.From != nil {
+		builder = builder.Where(sq.GtOrEq{
+			"start_time": *filter.From,
+		})
 	}
-	defer rows.Close()
 
-	var res []*entity.Slot
-	for rows.Next() {
-		var slot entity.Slot
-		if err = rows.Scan(
-			&slot.ID, &slot.ModelID, &slot.StartTime, &slot.EndTime,
-			&slot.Status, &slot.CreatedAt); err != nil {
-			return nil, err
-		}
+	if filter.To != nil {
+		builder = builder.Where(sq.Lt{
+			"start_time": *filter.To,
+		})
+	}
 
-		res = append(res, &slot)
+	if filter.Status != nil {
+		builder = builder.Where(sq.Eq{
+			"status": *filter.Status,
+		})
 	}
 
-	if err = rows.Err(); err != nil {
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
 		return nil, err
 	}
 
-	return res, nil
+	return d.getMany(ctx, query, args)
 }
 
 func (d *DefaultSlotRepository) GetOverlappingSlots(ctx context.Context, modelID int64, start, end time.Time) ([]*entity.Slot, error) {
-	query, args, err := sq.Select("slot_id", "model_id", "start_time", "end_time", "status", "created_at").
+	query, args, err := sq.Select(slotColumns...).
 		From("slots").
 		Where(sq.Eq{
 			"model_id": modelID,
@@ -113,66 +179,386 @@ func (d *DefaultSlotRepository) GetOverlappingSlots(ctx context.Context, modelID
 			sq.Expr("start_time < ?", end),
 			sq.Expr("? < end_time", start),
 		}).
+		OrderBy("start_time ASC").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	return d.getMany(ctx, query, args)
+}
+
+// Update keeps the import block only while the slot stays disabled, a slot enabled by the model is its own again.
+func (d *DefaultSlotRepository) Update(ctx context.Context, slot *entity.Slot) (*entity.Slot, error) {
+	query, args, err := sq.Update("slots").
+		Set("start_time", slot.StartTime).
+		Set("end_time", slot.EndTime).
+		Set("status", slot.Status).
+		Set("blocked_by_import", slot.ImportBlocked && slot.Status == entity.SlotDisabled).
+		Where(sq.Eq{
+			"slot_id": slot.ID,
+		}).
+		Suffix("RETURNING " + strings.Join(slotColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	var res []*entity.Slot
-	for rows.Next() {
-		var slot entity.Slot
-		if err = rows.Scan(
-			&slot.ID,
-			&slot.ModelID,
-			&slot.StartTime,
-			&slot.EndTime,
-			&slot.Status,
-			&slot.CreatedAt,
-		); err != nil {
-			return nil, err
+	res, err := scanSlot(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
 		}
 
-		res = append(res, &slot)
+		return nil, mapRangeOverlap(err)
 	}
 
-	return res, nil
+	return res, err
 }
 
-func (d *DefaultSlotRepository) Update(ctx context.Context, slot *entity.Slot) (*entity.Slot, error) {
+// UpdateAvailable moves the bounds of the slot only while it is still available,
+// a slot reserved or disabled in the meantime is not found.
+func (d *DefaultSlotRepository) UpdateAvailable(ctx context.Context, slot *entity.Slot) (*entity.Slot, error) {
 	query, args, err := sq.Update("slots").
 		Set("start_time", slot.StartTime).
 		Set("end_time", slot.EndTime).
-		Set("status", slot.Status).
 		Where(sq.Eq{
 			"slot_id": slot.ID,
+			"status":  entity.SlotAvailable,
 		}).
-		Suffix("RETURNING slot_id, model_id, start_time, end_time, status, created_at").
+		Suffix("RETURNING " + strings.Join(slotColumns, ", ")).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	var res entity.Slot
-	err = d.getExecutor(ctx).
-		QueryRow(ctx, query, args...).
-		Scan(&res.ID, &res.ModelID, &res.StartTime, &res.EndTime, &res.Status, &res.CreatedAt)
+	res, err := scanSlot(d.getExecutor(ctx).QueryRow(ctx, query, args...))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, persistence.ErrNoRowsFound
 		}
 
+		return nil, mapRangeOverlap(err)
+	}
+
+	return res, nil
+}
+
+// DisableAvailable disables the slots among ids that are still available and returns them,
+// a slot reserved in the meantime is left as it is.
+func (d *DefaultSlotRepository) DisableAvailable(ctx context.Context, ids []int64) ([]*entity.Slot, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sq.Update("slots").
+		Set("status", entity.SlotDisabled).
+		Where(sq.Eq{
+			"slot_id": ids,
+			"status":  entity.SlotAvailable,
+		}).
+		Suffix("RETURNING " + strings.Join(slotColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+// GetImportBlocked returns the slots of the model ending after from that are disabled by imported busy events.
+func (d *DefaultSlotRepository) GetImportBlocked(ctx context.Context, modelID int64,
+	from time.Time) ([]*entity.Slot, error) {
+	query, args, err := sq.Select(slotColumns...).
+		From("slots").
+		Where(sq.Eq{
+			"model_id":          modelID,
+			"blocked_by_import": true,
+		}).
+		Where(sq.Expr("end_time > ?", from)).
+		OrderBy("start_time ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
 		return nil, err
 	}
 
-	return &res, err
+	return d.getMany(ctx, query, args)
+}
+
+// BlockForImport disables the slots among ids that are still available and marks them as blocked by import.
+func (d *DefaultSlotRepository) BlockForImport(ctx context.Context, ids []int64) ([]*entity.Slot, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sq.Update("slots").
+		Set("status", entity.SlotDisabled).
+		Set("blocked_by_import", true).
+		Where(sq.Eq{
+			"slot_id": ids,
+			"status":  entity.SlotAvailable,
+		}).
+		Suffix("RETURNING " + strings.Join(slotColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+// ReleaseImportBlock makes the slots among ids that are still blocked by import available again. A slot that
+// another slot of the model has taken the time of in the meantime or that lies under a time-off stays disabled.
+func (d *DefaultSlotRepository) ReleaseImportBlock(ctx context.Context, ids []int64) ([]*entity.Slot, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sq.Update("slots").
+		Set("status", entity.SlotAvailable).
+		Set("blocked_by_import", false).
+		Where(sq.Eq{
+			"slot_id":           ids,
+			"status":            entity.SlotDisabled,
+			"blocked_by_import": true,
+		}).
+		Where("NOT EXISTS (SELECT 1 FROM slots other WHERE other.model_id = slots.model_id " +
+			"AND other.slot_id <> slots.slot_id AND other.status <> 'DISABLED' " +
+			"AND tstzrange(other.start_time, other.end_time) && tstzrange(slots.start_time, slots.end_time))").
+		Where("NOT EXISTS (SELECT 1 FROM time_offs t WHERE t.model_id = slots.model_id " +
+			"AND tstzrange(t.start_time, t.end_time) && tstzrange(slots.start_time, slots.end_time))").
+		Suffix("RETURNING " + strings.Join(slotColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+// DisableForTimeOff disables the slots among ids that are still available and marks them as disabled
+// by the time-off.
+func (d *DefaultSlotRepository) DisableForTimeOff(ctx context.Context, timeOffID int64,
+	ids []int64) ([]*entity.Slot, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sq.Update("slots").
+		Set("status", entity.SlotDisabled).
+		Set("time_off_id", timeOffID).
+		Where(sq.Eq{
+			"slot_id": ids,
+			"status":  entity.SlotAvailable,
+		}).
+		Suffix("RETURNING " + strings.Join(slotColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+// ReleaseTimeOff makes the slots disabled by the time-off available again. A slot that another slot of
+// the model has taken the time of in the meantime stays disabled and loses the mark once the time-off
+// is deleted. A slot under an imported busy event stays disabled as blocked by import instead, so the
+// calendar sync releases it once the event is gone.
+func (d *DefaultSlotRepository) ReleaseTimeOff(ctx context.Context, timeOffID int64) ([]*entity.Slot, error) {
+	busy := "EXISTS (SELECT 1 FROM busy_events e " +
+		"JOIN busy_calendars c ON e.busy_calendar_id = c.busy_calendar_id WHERE c.model_id = slots.model_id " +
+		"AND tstzrange(e.start_time, e.end_time) && tstzrange(slots.start_time, slots.end_time))"
+
+	query, args, err := sq.Update("slots").
+		Set("time_off_id", nil).
+		Set("blocked_by_import", true).
+		Where(sq.Eq{
+			"time_off_id": timeOffID,
+			"status":      entity.SlotDisabled,
+		}).
+		Where(busy).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = d.getExecutor(ctx).Exec(ctx, query, args...); err != nil {
+		return nil, err
+	}
+
+	query, args, err = sq.Update("slots").
+		Set("status", entity.SlotAvailable).
+		Set("time_off_id", nil).
+		Where(sq.Eq{
+			"time_off_id": timeOffID,
+			"status":      entity.SlotDisabled,
+		}).
+		Where("NOT EXISTS (SELECT 1 FROM slots other WHERE other.model_id = slots.model_id " +
+			"AND other.slot_id <> slots.slot_id AND other.status <> 'DISABLED' " +
+			"AND tstzrange(other.start_time, other.end_time) && tstzrange(slots.start_time, slots.end_time))").
+		Suffix("RETURNING " + strings.Join(slotColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+// ReleaseTemplateSlots takes back the available slots of the template starting after from. The slots no
+// booking has ever referred to are deleted, the rest are disabled and detached from the template.
+// Reserved and booked slots are left as they are.
+func (d *DefaultSlotRepository) ReleaseTemplateSlots(ctx context.Context, templateID int64,
+	from time.Time) (int64, error) {
+	released := sq.And{
+		sq.Eq{
+			"availability_template_id": templateID,
+			"status":                   entity.SlotAvailable,
+		},
+		sq.Gt{
+			"start_time": from,
+		},
+	}
+
+	query, args, err := sq.Delete("slots").
+		Where(released).
+		Where("NOT EXISTS (SELECT 1 FROM bookings WHERE bookings.slot_id = slots.slot_id)").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := d.getExecutor(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	query, args, err = sq.Update("slots").
+		Set("status", entity.SlotDisabled).
+		Set("availability_template_id", nil).
+		Where(released).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	disabled, err := d.getExecutor(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted.RowsAffected() + disabled.RowsAffected(), nil
+}
+
+// HasBookings reports whether a booking of any status has ever referred to one of the slots.
+func (d *DefaultSlotRepository) HasBookings(ctx context.Context, ids []int64) (bool, error) {
+	query, args, err := sq.Select("1").
+		Prefix("SELECT EXISTS (").
+		From("bookings").
+		Where(sq.Eq{
+			"slot_id": ids,
+		}).
+		Suffix(")").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var res bool
+	if err = d.getExecutor(ctx).QueryRow(ctx, query, args...).Scan(&res); err != nil {
+		return false, err
+	}
+
+	return res, nil
+}
+
+// DeleteUnbooked deletes the available slots among ids no booking has ever referred to
+// and returns how many of them are gone.
+func (d *DefaultSlotRepository) DeleteUnbooked(ctx context.Context, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	query, args, err := sq.Delete("slots").
+		Where(sq.Eq{
+			"slot_id": ids,
+			"status":  entity.SlotAvailable,
+		}).
+		Where("NOT EXISTS (SELECT 1 FROM bookings WHERE bookings.slot_id = slots.slot_id)").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := d.getExecutor(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted.RowsAffected(), nil
+}
+
+func (d *DefaultSlotRepository) getMany(ctx context.Context, query string,
+	args []interface{}) ([]*entity.Slot, error) {
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.Slot
+	for rows.Next() {
+		slot, err := scanSlot(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, slot)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func scanSlot(row pgx.Row) (*entity.Slot, error) {
+	var res entity.Slot
+	err := row.Scan(
+		&res.ID, &res.ModelID, &res.TemplateID, &res.StartTime, &res.EndTime, &res.Status, &res.ImportBlocked,
+		&res.TimeOffID, &res.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// mapRangeOverlap turns the violation of a no-overlap constraint, such as slots_no_overlap or time_offs_no_overlap,
+// into persistence.ErrRangeOverlap.
+func mapRangeOverlap(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == persistence.ExclusionViolationCode {
+		return persistence.ErrRangeOverlap
+	}
+
+	return err
 }
 
 func (d *DefaultSlotRepository) getExecutor(ctx context.Context) postgres.Executor {
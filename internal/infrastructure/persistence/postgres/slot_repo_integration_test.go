@@ -0,0 +1,179 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDSNEnv points to a database migrated up to the latest version.
+const testDSNEnv = "TEST_POSTGRES_DSN"
+
+func setUpIntegrationDb(t *testing.T) *postgres.PostgresDb {
+	t.Helper()
+
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+
+	cfg, err := pgxpool.ParseConfig(dsn)
+	require.NoError(t, err)
+	// every concurrent transaction holds its own connection until all of them have checked for overlaps
+	cfg.MaxConns = 16
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), cfg)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return &postgres.PostgresDb{Pool: pool}
+}
+
+func createTestModel(t *testing.T, db *postgres.PostgresDb) *entity.User {
+	t.Helper()
+
+	ctx := context.Background()
+	auth := entity.NewAuth(fmt.Sprintf("model-%d@test.local", time.Now().UnixNano()), "hash", entity.RoleModel)
+	require.NoError(t, NewDefaultAuthRepository(db).Save(ctx, auth))
+	t.Cleanup(func() {
+		_, _ = db.Pool.Exec(context.Background(), "DELETE FROM auth WHERE auth_id = $1", auth.ID)
+	})
+
+	model := entity.NewUser(auth.ID, "model", time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, NewDefaultUserRepository(db).Save(ctx, model))
+
+	return model
+}
+
+func TestSlotRepository_ConcurrentOverlappingSaves(t *testing.T) {
+	db := setUpIntegrationDb(t)
+	model := createTestModel(t, db)
+
+	repo := NewDefaultSlotRepository(db)
+	txManager := postgres.NewPostgresTxManager(db.Pool)
+
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+
+	const workers = 8
+	var (
+		checked sync.WaitGroup
+		done    sync.WaitGroup
+		errs    = make([]error, workers)
+	)
+	checked.Add(workers)
+	done.Add(workers)
+
+	for i := 0; i < workers; i++ {
+		go func(i int) {
+			defer done.Done()
+
+			// every slot overlaps all the others, each transaction sees no overlap before any of them saves
+			slotStart := start.Add(time.Duration(i) * time.Minute)
+			errs[i] = txManager.WithTransaction(context.Background(), func(ctx context.Context) error {
+				overlaps, err := repo.GetOverlappingSlots(ctx, model.ID, slotStart, slotStart.Add(time.Hour))
+				checked.Done()
+				if err != nil {
+					return err
+				}
+				if len(overlaps) > 0 {
+					return errors.New("overlap is seen before the saves")
+				}
+
+				checked.Wait()
+
+				return repo.Save(ctx, entity.NewSlot(model.ID, slotStart, slotStart.Add(time.Hour)))
+			})
+		}(i)
+	}
+	done.Wait()
+
+	saved := 0
+	for _, err := range errs {
+		if err == nil {
+			saved++
+			continue
+		}
+
+		assert.ErrorIs(t, err, persistence.ErrRangeOverlap)
+	}
+	assert.Equal(t, 1, saved)
+
+	slots, err := repo.GetByModelID(context.Background(), model.ID, &entity.SlotFilter{}, entity.NewOptions(1, entity.LimitMaxValue))
+	require.NoError(t, err)
+	assert.Len(t, slots, 1)
+}
+
+func TestSlotRepository_OverlapConstraint(t *testing.T) {
+	db := setUpIntegrationDb(t)
+	model := createTestModel(t, db)
+
+	repo := NewDefaultSlotRepository(db)
+	ctx := context.Background()
+
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	taken := entity.NewSlot(model.ID, start, start.Add(2*time.Hour))
+	require.NoError(t, repo.Save(ctx, taken))
+
+	adjacent := entity.NewSlot(model.ID, taken.EndTime, taken.EndTime.Add(time.Hour))
+	assert.NoError(t, repo.Save(ctx, adjacent), "slots touching at the bound do not overlap")
+
+	disabled := entity.NewSlot(model.ID, start.Add(time.Hour), start.Add(3*time.Hour))
+	disabled.Status = entity.SlotDisabled
+	assert.NoError(t, repo.Save(ctx, disabled), "disabled slots may overlap")
+
+	disabled.Status = entity.SlotAvailable
+	_, err := repo.Update(ctx, disabled)
+	assert.ErrorIs(t, err, persistence.ErrRangeOverlap)
+
+	batch := []*entity.Slot{
+		entity.NewSlot(model.ID, start.Add(-2*time.Hour), start.Add(-time.Hour)),
+		entity.NewSlot(model.ID, start.Add(-30*time.Minute), start.Add(30*time.Minute)),
+	}
+	assert.ErrorIs(t, repo.SaveAll(ctx, batch), persistence.ErrRangeOverlap)
+
+	slots, err := repo.GetByModelID(ctx, model.ID, &entity.SlotFilter{}, entity.NewOptions(1, entity.LimitMaxValue))
+	require.NoError(t, err)
+	assert.Len(t, slots, 3, "rejected batch is not saved partly")
+}
+
+func TestSlotRepository_UpdateAvailable(t *testing.T) {
+	db := setUpIntegrationDb(t)
+	model := createTestModel(t, db)
+
+	repo := NewDefaultSlotRepository(db)
+	ctx := context.Background()
+
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	slot := entity.NewSlot(model.ID, start, start.Add(2*time.Hour))
+	require.NoError(t, repo.Save(ctx, slot))
+
+	slot.EndTime = start.Add(time.Hour)
+	res, err := repo.UpdateAvailable(ctx, slot)
+	require.NoError(t, err)
+	assert.True(t, res.EndTime.Equal(start.Add(time.Hour)))
+
+	slot.Status = entity.SlotReserved
+	_, err = repo.Update(ctx, slot)
+	require.NoError(t, err)
+
+	slot.EndTime = start.Add(2 * time.Hour)
+	_, err = repo.UpdateAvailable(ctx, slot)
+	assert.ErrorIs(t, err, persistence.ErrNoRowsFound, "reserved slot is not moved")
+
+	stored, err := repo.GetByID(ctx, slot.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.EndTime.Equal(start.Add(time.Hour)))
+}
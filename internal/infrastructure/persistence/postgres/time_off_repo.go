@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+)
+
+var timeOffColumns = []string{
+	"time_off_id", "model_id", "start_time", "end_time", "reason", "created_at",
+}
+
+type DefaultTimeOffRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultTimeOffRepository(db *postgres.PostgresDb) *DefaultTimeOffRepository {
+	return &DefaultTimeOffRepository{
+		db: db,
+	}
+}
+
+// Save fails with persistence.ErrRangeOverlap when the time-off overlaps another time-off of the model.
+func (d *DefaultTimeOffRepository) Save(ctx context.Context, timeOff *entity.TimeOff) error {
+	query, args, err := sq.Insert("time_offs").
+		Columns("model_id", "start_time", "end_time", "reason").
+		Values(timeOff.ModelID, timeOff.StartTime, timeOff.EndTime, timeOff.Reason).
+		Suffix("RETURNING time_off_id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = d.getExecutor(ctx).
+		QueryRow(ctx, query, args...).
+		Scan(&timeOff.ID, &timeOff.CreatedAt)
+	if err != nil {
+		return mapRangeOverlap(err)
+	}
+
+	return nil
+}
+
+func (d *DefaultTimeOffRepository) GetByID(ctx context.Context, id int64) (*entity.TimeOff, error) {
+	query, args, err := sq.Select(timeOffColumns...).
+		From("time_offs").
+		Where(sq.Eq{
+			"time_off_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanTimeOff(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetByModelID returns the time-offs of the model ending after from, the earliest first.
+func (d *DefaultTimeOffRepository) GetByModelID(ctx context.Context, modelID int64,
+	from time.Time) ([]*entity.TimeOff, error) {
+	query, args, err := sq.Select(timeOffColumns...).
+		From("time_offs").
+		Where(sq.Eq{
+			"model_id": modelID,
+		}).
+		Where(sq.Expr("end_time > ?", from)).
+		OrderBy("start_time ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+// GetOverlapping returns the time-offs of the model sharing time with [start, end).
+func (d *DefaultTimeOffRepository) GetOverlapping(ctx context.Context, modelID int64,
+	start, end time.Time) ([]*entity.TimeOff, error) {
+	query, args, err := sq.Select(timeOffColumns...).
+		From("time_offs").
+		Where(sq.Eq{
+			"model_id": modelID,
+		}).
+		Where(sq.And{
+			sq.Expr("start_time < ?", end),
+			sq.Expr("? < end_time", start),
+		}).
+		OrderBy("start_time ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getMany(ctx, query, args)
+}
+
+// Delete removes the time-off, the slots still marked as disabled by it lose the mark.
+func (d *DefaultTimeOffRepository) Delete(ctx context.Context, id int64) error {
+	query, args, err := sq.Delete("time_offs").
+		Where(sq.Eq{
+			"time_off_id": id,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := d.getExecutor(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return persistence.ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (d *DefaultTimeOffRepository) getMany(ctx context.Context, query string,
+	args []interface{}) ([]*entity.TimeOff, error) {
+	rows, err := d.getExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entity.TimeOff
+	for rows.Next() {
+		timeOff, err := scanTimeOff(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, timeOff)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func scanTimeOff(row pgx.Row) (*entity.TimeOff, error) {
+	var res entity.TimeOff
+	err := row.Scan(
+		&res.ID, &res.ModelID, &res.StartTime, &res.EndTime, &res.Reason, &res.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (d *DefaultTimeOffRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
@@ -0,0 +1,110 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeOffRepository_OverlapConstraint(t *testing.T) {
+	db := setUpIntegrationDb(t)
+	model := createTestModel(t, db)
+
+	repo := NewDefaultTimeOffRepository(db)
+	ctx := context.Background()
+
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	require.NoError(t, repo.Save(ctx, entity.NewTimeOff(model.ID, start, start.Add(72*time.Hour), nil)))
+
+	following := entity.NewTimeOff(model.ID, start.Add(72*time.Hour), start.Add(96*time.Hour), nil)
+	assert.NoError(t, repo.Save(ctx, following), "time-offs touching at the bound do not overlap")
+
+	overlapping := entity.NewTimeOff(model.ID, start.Add(24*time.Hour), start.Add(48*time.Hour), nil)
+	assert.ErrorIs(t, repo.Save(ctx, overlapping), persistence.ErrRangeOverlap)
+}
+
+func TestTimeOffRepository_DisableAndRelease(t *testing.T) {
+	db := setUpIntegrationDb(t)
+	model := createTestModel(t, db)
+
+	slotRepo := NewDefaultSlotRepository(db)
+	timeOffRepo := NewDefaultTimeOffRepository(db)
+	ctx := context.Background()
+
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	kept := entity.NewSlot(model.ID, start, start.Add(time.Hour))
+	taken := entity.NewSlot(model.ID, start.Add(2*time.Hour), start.Add(3*time.Hour))
+	require.NoError(t, slotRepo.SaveAll(ctx, []*entity.Slot{kept, taken}))
+
+	timeOff := entity.NewTimeOff(model.ID, start, start.Add(24*time.Hour), nil)
+	require.NoError(t, timeOffRepo.Save(ctx, timeOff))
+
+	disabled, err := slotRepo.DisableForTimeOff(ctx, timeOff.ID, []int64{kept.ID, taken.ID})
+	require.NoError(t, err)
+	require.Len(t, disabled, 2)
+	assert.Equal(t, &timeOff.ID, disabled[0].TimeOffID)
+
+	// the time of a disabled slot is free, another slot may take it meanwhile
+	other := entity.NewSlot(model.ID, taken.StartTime, taken.EndTime)
+	require.NoError(t, slotRepo.Save(ctx, other))
+
+	enabled, err := slotRepo.ReleaseTimeOff(ctx, timeOff.ID)
+	require.NoError(t, err)
+	require.Len(t, enabled, 1)
+	assert.Equal(t, kept.ID, enabled[0].ID)
+	assert.Equal(t, entity.SlotAvailable, enabled[0].Status)
+	assert.Nil(t, enabled[0].TimeOffID)
+
+	require.NoError(t, timeOffRepo.Delete(ctx, timeOff.ID))
+
+	left, err := slotRepo.GetByID(ctx, taken.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.SlotDisabled, left.Status)
+	assert.Nil(t, left.TimeOffID, "deleted time-off leaves no mark")
+}
+
+func TestTimeOffRepository_ReleaseUnderBusyEvent(t *testing.T) {
+	db := setUpIntegrationDb(t)
+	model := createTestModel(t, db)
+
+	slotRepo := NewDefaultSlotRepository(db)
+	timeOffRepo := NewDefaultTimeOffRepository(db)
+	calendarRepo := NewDefaultBusyCalendarRepository(db)
+	ctx := context.Background()
+
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	free := entity.NewSlot(model.ID, start, start.Add(time.Hour))
+	busy := entity.NewSlot(model.ID, start.Add(2*time.Hour), start.Add(3*time.Hour))
+	require.NoError(t, slotRepo.SaveAll(ctx, []*entity.Slot{free, busy}))
+
+	timeOff := entity.NewTimeOff(model.ID, start, start.Add(24*time.Hour), nil)
+	require.NoError(t, timeOffRepo.Save(ctx, timeOff))
+
+	_, err := slotRepo.DisableForTimeOff(ctx, timeOff.ID, []int64{free.ID, busy.ID})
+	require.NoError(t, err)
+
+	// the calendar imported during the time-off could not block the slots disabled already
+	calendar := entity.NewBusyCalendar(model.ID, "work", nil)
+	require.NoError(t, calendarRepo.Save(ctx, calendar))
+	require.NoError(t, calendarRepo.ReplaceEvents(ctx, calendar.ID, []*entity.BusyEvent{
+		{CalendarID: calendar.ID, UID: "event", StartTime: busy.StartTime, EndTime: busy.EndTime},
+	}))
+
+	enabled, err := slotRepo.ReleaseTimeOff(ctx, timeOff.ID)
+	require.NoError(t, err)
+	require.Len(t, enabled, 1)
+	assert.Equal(t, free.ID, enabled[0].ID)
+
+	left, err := slotRepo.GetByID(ctx, busy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.SlotDisabled, left.Status)
+	assert.True(t, left.ImportBlocked, "slot under the busy event is left to the calendar sync")
+	assert.Nil(t, left.TimeOffID)
+}
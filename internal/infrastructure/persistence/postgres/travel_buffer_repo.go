@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/domain/entity"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/database/postgres"
+	"github.com/alishashelby/Samok-Aah-t/backend/internal/infrastructure/persistence"
+	"github.com/jackc/pgx/v5"
+)
+
+var travelBufferColumns = []string{
+	"model_id", "before_minutes", "after_minutes", "fee", "updated_at",
+}
+
+type DefaultTravelBufferRepository struct {
+	db *postgres.PostgresDb
+}
+
+func NewDefaultTravelBufferRepository(db *postgres.PostgresDb) *DefaultTravelBufferRepository {
+	return &DefaultTravelBufferRepository{
+		db: db,
+	}
+}
+
+func (d *DefaultTravelBufferRepository) GetByModelID(ctx context.Context,
+	modelID int64) (*entity.TravelBuffer, error) {
+	query, args, err := sq.Select(travelBufferColumns...).
+		From("travel_buffers").
+		Where(sq.Eq{
+			"model_id": modelID,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := scanTravelBuffer(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, persistence.ErrNoRowsFound
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Save creates the buffer of the model or replaces the one it has.
+func (d *DefaultTravelBufferRepository) Save(ctx context.Context,
+	buffer *entity.TravelBuffer) (*entity.TravelBuffer, error) {
+	query, args, err := sq.Insert("travel_buffers").
+		Columns("model_id", "before_minutes", "after_minutes", "fee").
+		Values(buffer.ModelID, int(buffer.Before/time.Minute), int(buffer.After/time.Minute), buffer.Fee).
+		Suffix("ON CONFLICT (model_id) DO UPDATE SET " +
+			"before_minutes = EXCLUDED.before_minutes, " +
+			"after_minutes = EXCLUDED.after_minutes, " +
+			"fee = EXCLUDED.fee, " +
+			"updated_at = now() " +
+			"RETURNING model_id, before_minutes, after_minutes, fee, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanTravelBuffer(d.getExecutor(ctx).QueryRow(ctx, query, args...))
+}
+
+func scanTravelBuffer(row pgx.Row) (*entity.TravelBuffer, error) {
+	var (
+		res                         entity.TravelBuffer
+		beforeMinutes, afterMinutes int
+	)
+	err := row.Scan(&res.ModelID, &beforeMinutes, &afterMinutes, &res.Fee, &res.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Before = time.Duration(beforeMinutes) * time.Minute
+	res.After = time.Duration(afterMinutes) * time.Minute
+
+	return &res, nil
+}
+
+func (d *DefaultTravelBufferRepository) getExecutor(ctx context.Context) postgres.Executor {
+	if tx := postgres.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return d.db.Pool
+}
@@ -23,8 +23,8 @@ func NewDefaultUserRepository(db *postgres.PostgresDb) *DefaultUserRepository {
 
 func (d *DefaultUserRepository) Save(ctx context.Context, user *entity.User) error {
 	query, args, err := sq.Insert("users").
-		Columns("auth_id", "name", "birth_date", "is_verified").
-		Values(user.AuthID, user.Name, user.BirthDate, user.IsVerified).
+		Columns("auth_id", "name", "birth_date", "is_verified", "time_zone").
+		Values(user.AuthID, user.Name, user.BirthDate, user.IsVerified, user.TimeZone).
 		Suffix("RETURNING user_id").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
@@ -37,7 +37,7 @@ func (d *DefaultUserRepository) Save(ctx context.Context, user *entity.User) err
 }
 
 func (d *DefaultUserRepository) GetByID(ctx context.Context, id int64) (*entity.User, error) {
-	query, args, err := sq.Select("user_id", "auth_id", "name", "birth_date", "is_verified").
+	query, args, err := sq.Select("user_id", "auth_id", "name", "birth_date", "is_verified", "time_zone").
 		From("users").
 		Where(sq.Eq{
 			"user_id": id,
@@ -51,7 +51,7 @@ func (d *DefaultUserRepository) GetByID(ctx context.Context, id int64) (*entity.
 	var res entity.User
 	err = d.getExecutor(ctx).
 		QueryRow(ctx, query, args...).
-		Scan(&res.ID, &res.AuthID, &res.Name, &res.BirthDate, &res.IsVerified)
+		Scan(&res.ID, &res.AuthID, &res.Name, &res.BirthDate, &res.IsVerified, &res.TimeZone)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, persistence.ErrNoRowsFound
@@ -64,7 +64,7 @@ func (d *DefaultUserRepository) GetByID(ctx context.Context, id int64) (*entity.
 }
 
 func (d *DefaultUserRepository) GetByAuthID(ctx context.Context, authID int64) (*entity.User, error) {
-	query, args, err := sq.Select("user_id", "auth_id", "name", "birth_date", "is_verified").
+	query, args, err := sq.Select("user_id", "auth_id", "name", "birth_date", "is_verified", "time_zone").
 		From("users").
 		Where(sq.Eq{
 			"auth_id": authID,
@@ -78,7 +78,7 @@ func (d *DefaultUserRepository) GetByAuthID(ctx context.Context, authID int64) (
 	var res entity.User
 	err = d.getExecutor(ctx).
 		QueryRow(ctx, query, args...).
-		Scan(&res.ID, &res.AuthID, &res.Name, &res.BirthDate, &res.IsVerified)
+		Scan(&res.ID, &res.AuthID, &res.Name, &res.BirthDate, &res.IsVerified, &res.TimeZone)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, persistence.ErrNoRowsFound
@@ -94,10 +94,11 @@ func (d *DefaultUserRepository) Update(ctx context.Context, user *entity.User) (
 	query, args, err := sq.Update("users").
 		Set("name", user.Name).
 		Set("is_verified", user.IsVerified).
+		Set("time_zone", user.TimeZone).
 		Where(sq.Eq{
 			"user_id": user.ID,
 		}).
-		Suffix("RETURNING user_id, auth_id, name, birth_date, is_verified").
+		Suffix("RETURNING user_id, auth_id, name, birth_date, is_verified, time_zone").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -107,7 +108,7 @@ func (d *DefaultUserRepository) Update(ctx context.Context, user *entity.User) (
 	var res entity.User
 	err = d.getExecutor(ctx).
 		QueryRow(ctx, query, args...).
-		Scan(&res.ID, &res.AuthID, &res.Name, &res.BirthDate, &res.IsVerified)
+		Scan(&res.ID, &res.AuthID, &res.Name, &res.BirthDate, &res.IsVerified, &res.TimeZone)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, persistence.ErrNoRowsFound
@@ -125,7 +126,7 @@ func (d *DefaultUserRepository) GetAll(
 ) ([]*entity.User, error) {
 
 	query, args, err := sq.Select("user_id", "name",
-		"birth_date", "auth_id", "is_verified").
+		"birth_date", "auth_id", "is_verified", "time_zone").
 		From("users").
 		Limit(uint64(opts.Limit)).
 		Offset(uint64(opts.Offset)).
@@ -144,7 +145,7 @@ func (d *DefaultUserRepository) GetAll(
 	var res []*entity.User
 	for rows.Next() {
 		var user entity.User
-		if err = rows.Scan(&user.ID, &user.Name, &user.BirthDate, &user.AuthID, &user.IsVerified); err != nil {
+		if err = rows.Scan(&user.ID, &user.Name, &user.BirthDate, &user.AuthID, &user.IsVerified, &user.TimeZone); err != nil {
 			return nil, err
 		}
 
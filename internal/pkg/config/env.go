@@ -12,6 +12,13 @@ const (
 	defaultAppPort         = "8080"
 	defaultShutdownTimeout = "10s"
 	defaultMetricsInterval = "30s"
+	defaultSSEHeartbeat    = "15s"
+
+	defaultOrderConfirmationInterval = "1m"
+	defaultBookingExpiryInterval     = "1m"
+	defaultSlotGenerationInterval    = "1h"
+	defaultBusyCalendarSyncInterval  = "15m"
+	defaultRetentionInterval         = "24h"
 )
 
 type EnvConfig struct {
@@ -23,6 +30,13 @@ type EnvConfig struct {
 	PostgresDB       string
 	ShutdownTimeout  time.Duration
 	MetricsInterval  time.Duration
+	SSEHeartbeat     time.Duration
+
+	OrderConfirmationInterval time.Duration
+	BookingExpiryInterval     time.Duration
+	SlotGenerationInterval    time.Duration
+	BusyCalendarSyncInterval  time.Duration
+	RetentionInterval         time.Duration
 }
 
 func LoadEnv() (*EnvConfig, error) {
@@ -62,6 +76,47 @@ func LoadEnv() (*EnvConfig, error) {
 		return nil, fmt.Errorf("invalid value for SHUTDOWN_TIMEOUT: %w", err)
 	}
 
+	sseHeartbeatStr := config.GetEnvVariableOrDefault("SSE_HEARTBEAT_INTERVAL", defaultSSEHeartbeat)
+	sseHeartbeat, err := time.ParseDuration(sseHeartbeatStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for SSE_HEARTBEAT_INTERVAL: %w", err)
+	}
+
+	orderConfirmationIntervalStr := config.GetEnvVariableOrDefault(
+		"ORDER_CONFIRMATION_INTERVAL", defaultOrderConfirmationInterval)
+	orderConfirmationInterval, err := time.ParseDuration(orderConfirmationIntervalStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for ORDER_CONFIRMATION_INTERVAL: %w", err)
+	}
+
+	bookingExpiryIntervalStr := config.GetEnvVariableOrDefault(
+		"BOOKING_EXPIRY_INTERVAL", defaultBookingExpiryInterval)
+	bookingExpiryInterval, err := time.ParseDuration(bookingExpiryIntervalStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for BOOKING_EXPIRY_INTERVAL: %w", err)
+	}
+
+	slotGenerationIntervalStr := config.GetEnvVariableOrDefault(
+		"SLOT_GENERATION_INTERVAL", defaultSlotGenerationInterval)
+	slotGenerationInterval, err := time.ParseDuration(slotGenerationIntervalStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for SLOT_GENERATION_INTERVAL: %w", err)
+	}
+
+	busyCalendarSyncIntervalStr := config.GetEnvVariableOrDefault(
+		"BUSY_CALENDAR_SYNC_INTERVAL", defaultBusyCalendarSyncInterval)
+	busyCalendarSyncInterval, err := time.ParseDuration(busyCalendarSyncIntervalStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for BUSY_CALENDAR_SYNC_INTERVAL: %w", err)
+	}
+
+	retentionIntervalStr := config.GetEnvVariableOrDefault(
+		"RETENTION_INTERVAL", defaultRetentionInterval)
+	retentionInterval, err := time.ParseDuration(retentionIntervalStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for RETENTION_INTERVAL: %w", err)
+	}
+
 	return &EnvConfig{
 		Port:             port,
 		PostgresUser:     postgresUser,
@@ -71,5 +126,12 @@ func LoadEnv() (*EnvConfig, error) {
 		PostgresDB:       postgresDB,
 		ShutdownTimeout:  shutdownTimeout,
 		MetricsInterval:  metricsInterval,
+		SSEHeartbeat:     sseHeartbeat,
+
+		OrderConfirmationInterval: orderConfirmationInterval,
+		BookingExpiryInterval:     bookingExpiryInterval,
+		SlotGenerationInterval:    slotGenerationInterval,
+		BusyCalendarSyncInterval:  busyCalendarSyncInterval,
+		RetentionInterval:         retentionInterval,
 	}, nil
 }
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN completed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN confirmation_deadline TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN confirmed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN issue_reason TEXT;
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check CHECK (
+    status IN ('CONFIRMED', 'IN_TRANSIT', 'INTRANSIT', 'PENDING_CONFIRMATION', 'DISPUTED', 'COMPLETED', 'CANCELLED')
+);
+
+CREATE INDEX IF NOT EXISTS idx_orders_confirmation_deadline
+    ON orders(confirmation_deadline)
+    WHERE status = 'PENDING_CONFIRMATION';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_confirmation_deadline;
+
+UPDATE orders SET status = 'COMPLETED' WHERE status IN ('PENDING_CONFIRMATION', 'DISPUTED');
+UPDATE orders SET status = 'IN_TRANSIT' WHERE status = 'INTRANSIT';
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check CHECK (
+    status IN ('CONFIRMED', 'IN_TRANSIT', 'COMPLETED', 'CANCELLED')
+);
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS completed_at,
+    DROP COLUMN IF EXISTS confirmation_deadline,
+    DROP COLUMN IF EXISTS confirmed_at,
+    DROP COLUMN IF EXISTS issue_reason;
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS disputes (
+    dispute_id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL UNIQUE REFERENCES orders(order_id) ON DELETE CASCADE,
+    client_id BIGINT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    model_id BIGINT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    opened_by VARCHAR(10) NOT NULL CHECK (
+        opened_by IN ('CLIENT', 'MODEL')
+    ),
+    category VARCHAR(20) NOT NULL CHECK (
+        category IN ('NO_SHOW', 'LATE_ARRIVAL', 'QUALITY', 'BEHAVIOUR', 'PAYMENT', 'OTHER')
+    ),
+    description VARCHAR(2000) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (
+        status IN ('OPEN', 'IN_REVIEW', 'RESOLVED')
+    ),
+    assigned_admin_id BIGINT REFERENCES admins(admin_id) ON DELETE SET NULL,
+    resolution VARCHAR(20) CHECK (
+        resolution IN ('FULL_REFUND', 'PARTIAL_REFUND', 'NO_ACTION', 'PENALIZE_CLIENT', 'PENALIZE_MODEL')
+    ),
+    refund_amount DECIMAL(9,2) CHECK (refund_amount >= 0),
+    penalized_user_id BIGINT REFERENCES users(user_id) ON DELETE SET NULL,
+    resolution_comment VARCHAR(1000),
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS dispute_messages (
+    dispute_message_id BIGSERIAL PRIMARY KEY,
+    dispute_id BIGINT NOT NULL REFERENCES disputes(dispute_id) ON DELETE CASCADE,
+    author_auth_id BIGINT NOT NULL REFERENCES auth(auth_id) ON DELETE CASCADE,
+    author_role VARCHAR(10) NOT NULL,
+    body VARCHAR(2000) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_disputes_status ON disputes(status);
+CREATE INDEX idx_disputes_assigned_admin_id ON disputes(assigned_admin_id);
+CREATE INDEX idx_dispute_messages_dispute_id_created_at ON dispute_messages(dispute_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_dispute_messages_dispute_id_created_at;
+DROP INDEX IF EXISTS idx_disputes_assigned_admin_id;
+DROP INDEX IF EXISTS idx_disputes_status;
+
+DROP TABLE IF EXISTS dispute_messages;
+DROP TABLE IF EXISTS disputes;
+-- +goose StatementEnd
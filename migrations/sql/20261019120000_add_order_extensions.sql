@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN extension_minutes INT NOT NULL DEFAULT 0 CHECK (extension_minutes >= 0),
+    ADD COLUMN extension_amount DECIMAL(9,2) NOT NULL DEFAULT 0 CHECK (extension_amount >= 0);
+
+CREATE TABLE IF NOT EXISTS order_extensions (
+    order_extension_id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES orders(order_id) ON DELETE CASCADE,
+    minutes INT NOT NULL CHECK (minutes > 0),
+    status VARCHAR(10) NOT NULL CHECK (
+        status IN ('PENDING', 'ACCEPTED', 'REJECTED')
+    ),
+    amount DECIMAL(9,2) CHECK (amount >= 0),
+    decided_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_order_extensions_order_id ON order_extensions(order_id);
+CREATE UNIQUE INDEX idx_order_extensions_one_pending
+    ON order_extensions(order_id)
+    WHERE status = 'PENDING';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_order_extensions_one_pending;
+DROP INDEX IF EXISTS idx_order_extensions_order_id;
+DROP TABLE IF EXISTS order_extensions;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS extension_minutes,
+    DROP COLUMN IF EXISTS extension_amount;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS payments (
+    payment_id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL UNIQUE REFERENCES orders(order_id) ON DELETE CASCADE,
+    provider VARCHAR(50) NOT NULL,
+    provider_payment_id VARCHAR(255) NOT NULL,
+    amount DECIMAL(9,2) NOT NULL CHECK (amount > 0),
+    captured_amount DECIMAL(9,2) NOT NULL DEFAULT 0 CHECK (captured_amount >= 0),
+    refunded_amount DECIMAL(9,2) NOT NULL DEFAULT 0 CHECK (refunded_amount >= 0),
+    status VARCHAR(20) NOT NULL CHECK (
+        status IN ('AUTHORIZED', 'CAPTURED', 'PARTIALLY_REFUNDED', 'REFUNDED', 'VOIDED', 'FAILED')
+    ),
+    failure_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    UNIQUE (provider, provider_payment_id),
+    CHECK (refunded_amount <= captured_amount)
+);
+
+CREATE TABLE IF NOT EXISTS payment_events (
+    payment_event_id BIGSERIAL PRIMARY KEY,
+    provider VARCHAR(50) NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    provider_payment_id VARCHAR(255) NOT NULL,
+    type VARCHAR(10) NOT NULL CHECK (
+        type IN ('CAPTURED', 'REFUNDED', 'VOIDED', 'FAILED')
+    ),
+    amount DECIMAL(9,2),
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    UNIQUE (provider, event_id)
+);
+
+CREATE INDEX idx_payments_status ON payments(status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_payments_status;
+DROP TABLE IF EXISTS payment_events;
+DROP TABLE IF EXISTS payments;
+-- +goose StatementEnd
@@ -0,0 +1,84 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS ledger_accounts (
+    account_id BIGSERIAL PRIMARY KEY,
+    type VARCHAR(20) NOT NULL CHECK (
+        type IN ('MODEL_EARNINGS', 'PLATFORM_REVENUE', 'CLIENT_PAYABLE')
+    ),
+    owner_id BIGINT REFERENCES users(user_id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    UNIQUE NULLS NOT DISTINCT (type, owner_id),
+    CHECK ((type = 'PLATFORM_REVENUE') = (owner_id IS NULL))
+);
+
+CREATE TABLE IF NOT EXISTS ledger_transactions (
+    transaction_id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES orders(order_id),
+    type VARCHAR(20) NOT NULL CHECK (
+        type IN ('ORDER_PAYMENT', 'REFUND', 'PENALTY')
+    ),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    entry_id BIGSERIAL PRIMARY KEY,
+    transaction_id BIGINT NOT NULL REFERENCES ledger_transactions(transaction_id),
+    account_id BIGINT NOT NULL REFERENCES ledger_accounts(account_id),
+    amount DECIMAL(9,2) NOT NULL CHECK (amount <> 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX uq_ledger_transactions_order_once ON ledger_transactions(order_id, type)
+    WHERE type IN ('ORDER_PAYMENT', 'PENALTY');
+CREATE INDEX idx_ledger_entries_account ON ledger_entries(account_id, created_at DESC);
+CREATE INDEX idx_ledger_entries_transaction ON ledger_entries(transaction_id);
+
+CREATE OR REPLACE FUNCTION forbid_ledger_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'ledger is append-only, % on % is not allowed', TG_OP, TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_ledger_transactions_immutable
+    BEFORE UPDATE OR DELETE ON ledger_transactions
+    FOR EACH ROW
+    EXECUTE FUNCTION forbid_ledger_change();
+
+CREATE TRIGGER trg_ledger_entries_immutable
+    BEFORE UPDATE OR DELETE ON ledger_entries
+    FOR EACH ROW
+    EXECUTE FUNCTION forbid_ledger_change();
+
+CREATE OR REPLACE FUNCTION check_ledger_transaction_balance() RETURNS trigger AS $$
+BEGIN
+    IF (SELECT SUM(amount) FROM ledger_entries WHERE transaction_id = NEW.transaction_id) <> 0 THEN
+        RAISE EXCEPTION 'ledger transaction % is not balanced', NEW.transaction_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER trg_ledger_entries_balanced
+    AFTER INSERT ON ledger_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+    EXECUTE FUNCTION check_ledger_transaction_balance();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_ledger_entries_balanced ON ledger_entries;
+DROP TRIGGER IF EXISTS trg_ledger_entries_immutable ON ledger_entries;
+DROP TRIGGER IF EXISTS trg_ledger_transactions_immutable ON ledger_transactions;
+
+DROP FUNCTION IF EXISTS check_ledger_transaction_balance();
+DROP FUNCTION IF EXISTS forbid_ledger_change();
+
+DROP INDEX IF EXISTS idx_ledger_entries_transaction;
+DROP INDEX IF EXISTS idx_ledger_entries_account;
+DROP INDEX IF EXISTS uq_ledger_transactions_order_once;
+
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS ledger_transactions;
+DROP TABLE IF EXISTS ledger_accounts;
+-- +goose StatementEnd
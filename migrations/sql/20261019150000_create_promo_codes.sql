@@ -0,0 +1,69 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS promo_codes (
+    promo_code_id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    discount_type VARCHAR(10) NOT NULL CHECK (
+        discount_type IN ('PERCENT', 'FIXED')
+    ),
+    percent_off INT CHECK (percent_off BETWEEN 1 AND 100),
+    amount_off DECIMAL(9,2) CHECK (amount_off > 0),
+    valid_from TIMESTAMP WITH TIME ZONE,
+    valid_until TIMESTAMP WITH TIME ZONE,
+    max_uses INT CHECK (max_uses > 0),
+    max_uses_per_client INT CHECK (max_uses_per_client > 0),
+    used_count INT NOT NULL DEFAULT 0 CHECK (used_count >= 0),
+    service_ids BIGINT[] NOT NULL DEFAULT '{}',
+    model_ids BIGINT[] NOT NULL DEFAULT '{}',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    CHECK (
+        (discount_type = 'PERCENT' AND percent_off IS NOT NULL AND amount_off IS NULL) OR
+        (discount_type = 'FIXED' AND amount_off IS NOT NULL AND percent_off IS NULL)
+    ),
+    CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_from < valid_until),
+    CHECK (max_uses IS NULL OR used_count <= max_uses)
+);
+
+CREATE TABLE IF NOT EXISTS promo_redemptions (
+    promo_redemption_id BIGSERIAL PRIMARY KEY,
+    promo_code_id BIGINT NOT NULL REFERENCES promo_codes(promo_code_id) ON DELETE CASCADE,
+    client_id BIGINT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    booking_id BIGINT NOT NULL UNIQUE REFERENCES bookings(booking_id) ON DELETE CASCADE,
+    released_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_promo_redemptions_client ON promo_redemptions(promo_code_id, client_id)
+    WHERE released_at IS NULL;
+
+ALTER TABLE bookings
+    ADD COLUMN price DECIMAL(9,2),
+    ADD COLUMN discount DECIMAL(9,2) NOT NULL DEFAULT 0 CHECK (discount >= 0),
+    ADD COLUMN promo_code_id BIGINT REFERENCES promo_codes(promo_code_id) ON DELETE SET NULL;
+
+UPDATE bookings b
+SET price = s.price
+FROM model_services s
+WHERE s.model_service_id = b.model_service_id;
+
+ALTER TABLE bookings
+    ALTER COLUMN price SET NOT NULL,
+    ADD CONSTRAINT bookings_price_check CHECK (price >= 0);
+
+CREATE INDEX idx_bookings_pending_expires_at ON bookings(expires_at) WHERE status = 'PENDING';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_bookings_pending_expires_at;
+
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS promo_code_id,
+    DROP COLUMN IF EXISTS discount,
+    DROP COLUMN IF EXISTS price;
+
+DROP INDEX IF EXISTS idx_promo_redemptions_client;
+DROP TABLE IF EXISTS promo_redemptions;
+DROP TABLE IF EXISTS promo_codes;
+-- +goose StatementEnd
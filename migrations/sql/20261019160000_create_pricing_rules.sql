@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS pricing_rules (
+    pricing_rule_id BIGSERIAL PRIMARY KEY,
+    model_id BIGINT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    rule_type VARCHAR(20) NOT NULL CHECK (
+        rule_type IN ('MULTIPLIER', 'SURCHARGE')
+    ),
+    multiplier DECIMAL(4,2) CHECK (multiplier > 1),
+    amount DECIMAL(9,2) CHECK (amount > 0),
+    weekdays INT[] NOT NULL DEFAULT '{}',
+    start_minute INT CHECK (start_minute BETWEEN 0 AND 1439),
+    end_minute INT CHECK (end_minute BETWEEN 1 AND 1440),
+    holidays DATE[] NOT NULL DEFAULT '{}',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    CHECK (
+        (rule_type = 'MULTIPLIER' AND multiplier IS NOT NULL AND amount IS NULL) OR
+        (rule_type = 'SURCHARGE' AND amount IS NOT NULL AND multiplier IS NULL)
+    ),
+    CHECK ((start_minute IS NULL) = (end_minute IS NULL)),
+    CHECK (weekdays <@ ARRAY[0, 1, 2, 3, 4, 5, 6])
+);
+
+CREATE INDEX idx_pricing_rules_model_active ON pricing_rules(model_id) WHERE is_active;
+
+ALTER TABLE bookings
+    ADD COLUMN base_price DECIMAL(9,2),
+    ADD COLUMN surcharges JSONB NOT NULL DEFAULT '[]';
+
+UPDATE bookings
+SET base_price = price + discount;
+
+ALTER TABLE bookings
+    ALTER COLUMN base_price SET NOT NULL,
+    ADD CONSTRAINT bookings_base_price_check CHECK (base_price >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS surcharges,
+    DROP COLUMN IF EXISTS base_price;
+
+DROP INDEX IF EXISTS idx_pricing_rules_model_active;
+DROP TABLE IF EXISTS pricing_rules;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS add_ons (
+    add_on_id BIGSERIAL PRIMARY KEY,
+    model_service_id BIGINT NOT NULL REFERENCES model_services(model_service_id) ON DELETE CASCADE,
+    title VARCHAR(100) NOT NULL,
+    description VARCHAR(1000) NOT NULL DEFAULT '',
+    price DECIMAL(9,2) NOT NULL CHECK (price > 0),
+    extra_minutes INT NOT NULL DEFAULT 0 CHECK (extra_minutes BETWEEN 0 AND 1440),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_add_ons_model_service_id ON add_ons(model_service_id);
+
+ALTER TABLE bookings
+    ADD COLUMN add_ons JSONB NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS add_ons;
+
+DROP INDEX IF EXISTS idx_add_ons_model_service_id;
+DROP TABLE IF EXISTS add_ons;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS receipt_counter (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    last_number BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO receipt_counter (id, last_number) VALUES (TRUE, 0);
+
+CREATE TABLE IF NOT EXISTS receipts (
+    receipt_id BIGSERIAL PRIMARY KEY,
+    number BIGINT NOT NULL UNIQUE,
+    order_id BIGINT NOT NULL UNIQUE REFERENCES orders(order_id),
+    client_id BIGINT NOT NULL REFERENCES users(user_id),
+    model_id BIGINT NOT NULL REFERENCES users(user_id),
+    model_name VARCHAR(100) NOT NULL,
+    service_title VARCHAR(100) NOT NULL,
+    lines JSONB NOT NULL,
+    total DECIMAL(9,2) NOT NULL CHECK (total >= 0),
+    payment_method VARCHAR(50) NOT NULL,
+    issued_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_receipts_client_id ON receipts(client_id);
+
+CREATE OR REPLACE FUNCTION forbid_receipt_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'receipts are never changed once issued, % is not allowed', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_receipts_immutable
+    BEFORE UPDATE OR DELETE ON receipts
+    FOR EACH ROW
+    EXECUTE FUNCTION forbid_receipt_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_receipts_immutable ON receipts;
+DROP FUNCTION IF EXISTS forbid_receipt_change();
+DROP INDEX IF EXISTS idx_receipts_client_id;
+DROP TABLE IF EXISTS receipts;
+DROP TABLE IF EXISTS receipt_counter;
+-- +goose StatementEnd
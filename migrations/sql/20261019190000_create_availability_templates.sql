@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS availability_templates (
+    availability_template_id BIGSERIAL PRIMARY KEY,
+    model_id BIGINT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    weekdays INT[] NOT NULL CHECK (
+        cardinality(weekdays) > 0 AND weekdays <@ ARRAY[0, 1, 2, 3, 4, 5, 6]
+    ),
+    start_minute INT NOT NULL CHECK (start_minute BETWEEN 0 AND 1439),
+    end_minute INT NOT NULL CHECK (end_minute BETWEEN 1 AND 1440),
+    slot_minutes INT NOT NULL CHECK (slot_minutes BETWEEN 15 AND 1440),
+    effective_from DATE NOT NULL,
+    effective_to DATE CHECK (effective_to >= effective_from),
+    exceptions DATE[] NOT NULL DEFAULT '{}',
+    is_published BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_availability_templates_model ON availability_templates(model_id);
+CREATE INDEX idx_availability_templates_published ON availability_templates(availability_template_id)
+    WHERE is_published;
+
+ALTER TABLE slots
+    ADD COLUMN availability_template_id BIGINT
+        REFERENCES availability_templates(availability_template_id) ON DELETE SET NULL;
+
+CREATE INDEX idx_slots_availability_template ON slots(availability_template_id)
+    WHERE availability_template_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_slots_availability_template;
+
+ALTER TABLE slots
+    DROP COLUMN IF EXISTS availability_template_id;
+
+DROP INDEX IF EXISTS idx_availability_templates_published;
+DROP INDEX IF EXISTS idx_availability_templates_model;
+DROP TABLE IF EXISTS availability_templates;
+-- +goose StatementEnd
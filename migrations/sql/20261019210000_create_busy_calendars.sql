@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS busy_calendars (
+    busy_calendar_id BIGSERIAL PRIMARY KEY,
+    model_id BIGINT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    url VARCHAR(2048),
+    conflicts JSONB NOT NULL DEFAULT '[]',
+    synced_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_busy_calendars_model_id ON busy_calendars(model_id);
+
+CREATE TABLE IF NOT EXISTS busy_events (
+    busy_event_id BIGSERIAL PRIMARY KEY,
+    busy_calendar_id BIGINT NOT NULL REFERENCES busy_calendars(busy_calendar_id) ON DELETE CASCADE,
+    uid VARCHAR(255) NOT NULL,
+    start_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    CHECK (start_time < end_time)
+);
+
+CREATE INDEX idx_busy_events_busy_calendar_id ON busy_events(busy_calendar_id);
+
+ALTER TABLE slots
+    ADD COLUMN blocked_by_import BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX idx_slots_blocked_by_import ON slots(model_id) WHERE blocked_by_import;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_slots_blocked_by_import;
+ALTER TABLE slots
+    DROP COLUMN IF EXISTS blocked_by_import;
+DROP INDEX IF EXISTS idx_busy_events_busy_calendar_id;
+DROP TABLE IF EXISTS busy_events;
+DROP INDEX IF EXISTS idx_busy_calendars_model_id;
+DROP TABLE IF EXISTS busy_calendars;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS travel_buffers (
+    model_id BIGINT PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    before_minutes INT NOT NULL DEFAULT 0 CHECK (before_minutes BETWEEN 0 AND 720),
+    after_minutes INT NOT NULL DEFAULT 0 CHECK (after_minutes BETWEEN 0 AND 720),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS travel_buffers;
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- reserved and booked slots belong to clients and are not changed here, their overlaps are reported with the ids
+-- to resolve by hand before the migration is run again
+DO $$
+DECLARE
+    overlaps TEXT;
+BEGIN
+    SELECT string_agg(format('%s and %s', s.slot_id, other.slot_id), ', ' ORDER BY s.slot_id, other.slot_id)
+    INTO overlaps
+    FROM slots s
+             JOIN slots other ON other.model_id = s.model_id
+        AND other.slot_id > s.slot_id
+        AND tstzrange(other.start_time, other.end_time) && tstzrange(s.start_time, s.end_time)
+    WHERE s.status IN ('RESERVED', 'BOOKED')
+      AND other.status IN ('RESERVED', 'BOOKED');
+
+    IF overlaps IS NOT NULL THEN
+        RAISE EXCEPTION 'reserved or booked slots overlap: %', overlaps;
+    END IF;
+END;
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- this changes data: an available slot overlapping a reserved or booked one, or an available one created earlier,
+-- is disabled so the constraint below can be added, which slots were disabled is not recorded,
+-- so Down leaves them disabled
+UPDATE slots SET status = 'DISABLED'
+WHERE status = 'AVAILABLE' AND EXISTS (
+    SELECT 1 FROM slots other
+    WHERE other.model_id = slots.model_id
+      AND other.slot_id <> slots.slot_id
+      AND other.status <> 'DISABLED'
+      AND (other.status <> 'AVAILABLE' OR other.slot_id < slots.slot_id)
+      AND tstzrange(other.start_time, other.end_time) && tstzrange(slots.start_time, slots.end_time)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE slots ADD CONSTRAINT slots_no_overlap EXCLUDE USING gist (
+    model_id WITH =,
+    tstzrange(start_time, end_time) WITH &&
+) WHERE (status <> 'DISABLED');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- the slots disabled by Up stay disabled
+ALTER TABLE slots DROP CONSTRAINT IF EXISTS slots_no_overlap;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_slots_status_start_time ON slots(status, start_time);
+CREATE INDEX IF NOT EXISTS idx_model_services_active_model_id_price ON model_services(model_id, price) WHERE is_active;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_model_services_active_model_id_price;
+DROP INDEX IF EXISTS idx_slots_status_start_time;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS time_offs (
+    time_off_id BIGSERIAL PRIMARY KEY,
+    model_id BIGINT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    start_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    CHECK (start_time < end_time),
+    CONSTRAINT time_offs_no_overlap EXCLUDE USING gist (
+        model_id WITH =,
+        tstzrange(start_time, end_time) WITH &&
+    )
+);
+
+ALTER TABLE slots
+    ADD COLUMN time_off_id BIGINT REFERENCES time_offs(time_off_id) ON DELETE SET NULL;
+
+CREATE INDEX idx_slots_time_off_id ON slots(time_off_id) WHERE time_off_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_slots_time_off_id;
+ALTER TABLE slots
+    DROP COLUMN IF EXISTS time_off_id;
+DROP TABLE IF EXISTS time_offs;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the archive tables keep the columns of the live ones in the same order followed by archived_at,
+-- a column added to a live table has to be added to its archive as well
+CREATE TABLE IF NOT EXISTS slots_archive (LIKE slots);
+ALTER TABLE slots_archive
+    ADD COLUMN archived_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    ADD PRIMARY KEY (slot_id);
+
+CREATE TABLE IF NOT EXISTS bookings_archive (LIKE bookings);
+ALTER TABLE bookings_archive
+    ADD COLUMN archived_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    ADD PRIMARY KEY (booking_id);
+
+CREATE TABLE IF NOT EXISTS orders_archive (LIKE orders);
+ALTER TABLE orders_archive
+    ADD COLUMN archived_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    ADD PRIMARY KEY (order_id);
+
+CREATE INDEX idx_slots_archive_model_id ON slots_archive(model_id);
+CREATE INDEX idx_bookings_archive_slot_id ON bookings_archive(slot_id);
+CREATE INDEX idx_orders_archive_booking_id ON orders_archive(booking_id);
+
+CREATE INDEX idx_slots_end_time ON slots(end_time);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_slots_end_time;
+DROP TABLE IF EXISTS orders_archive;
+DROP TABLE IF EXISTS bookings_archive;
+DROP TABLE IF EXISTS slots_archive;
+-- +goose StatementEnd
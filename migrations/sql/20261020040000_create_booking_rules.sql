@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- a zero rule is not set by the model, the one of the platform holds
+CREATE TABLE IF NOT EXISTS booking_rules (
+    model_id BIGINT PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    min_notice_minutes INT NOT NULL DEFAULT 0 CHECK (min_notice_minutes >= 0),
+    horizon_days INT NOT NULL DEFAULT 0 CHECK (horizon_days >= 0),
+    min_slot_minutes INT NOT NULL DEFAULT 0 CHECK (min_slot_minutes >= 0),
+    max_slot_minutes INT NOT NULL DEFAULT 0 CHECK (max_slot_minutes >= 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS booking_rules;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- payments, disputes, extensions, the ledger, receipts and promo code redemptions stay in place when their order
+-- or booking is moved to the archive, the ledger and receipts can not be deleted at all, so the id they keep
+-- refers either to a live row or to an archived one
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_order_id_fkey;
+ALTER TABLE disputes DROP CONSTRAINT IF EXISTS disputes_order_id_fkey;
+ALTER TABLE order_extensions DROP CONSTRAINT IF EXISTS order_extensions_order_id_fkey;
+ALTER TABLE ledger_transactions DROP CONSTRAINT IF EXISTS ledger_transactions_order_id_fkey;
+ALTER TABLE receipts DROP CONSTRAINT IF EXISTS receipts_order_id_fkey;
+ALTER TABLE promo_redemptions DROP CONSTRAINT IF EXISTS promo_redemptions_booking_id_fkey;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- the rows of the archived orders and bookings do not satisfy the constraints, so they are not validated
+ALTER TABLE payments ADD CONSTRAINT payments_order_id_fkey
+    FOREIGN KEY (order_id) REFERENCES orders(order_id) ON DELETE CASCADE NOT VALID;
+ALTER TABLE disputes ADD CONSTRAINT disputes_order_id_fkey
+    FOREIGN KEY (order_id) REFERENCES orders(order_id) ON DELETE CASCADE NOT VALID;
+ALTER TABLE order_extensions ADD CONSTRAINT order_extensions_order_id_fkey
+    FOREIGN KEY (order_id) REFERENCES orders(order_id) ON DELETE CASCADE NOT VALID;
+ALTER TABLE ledger_transactions ADD CONSTRAINT ledger_transactions_order_id_fkey
+    FOREIGN KEY (order_id) REFERENCES orders(order_id) NOT VALID;
+ALTER TABLE receipts ADD CONSTRAINT receipts_order_id_fkey
+    FOREIGN KEY (order_id) REFERENCES orders(order_id) NOT VALID;
+ALTER TABLE promo_redemptions ADD CONSTRAINT promo_redemptions_booking_id_fkey
+    FOREIGN KEY (booking_id) REFERENCES bookings(booking_id) ON DELETE CASCADE NOT VALID;
+-- +goose StatementEnd
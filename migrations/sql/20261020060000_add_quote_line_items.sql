@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE bookings
+    ADD COLUMN duration_scaling DECIMAL(9,2) NOT NULL DEFAULT 0,
+    ADD COLUMN travel_fee DECIMAL(9,2) NOT NULL DEFAULT 0 CHECK (travel_fee >= 0);
+
+-- the archive keeps the columns of the live table followed by archived_at, so archived_at is moved to the end
+ALTER TABLE bookings_archive
+    ADD COLUMN duration_scaling DECIMAL(9,2) NOT NULL DEFAULT 0,
+    ADD COLUMN travel_fee DECIMAL(9,2) NOT NULL DEFAULT 0,
+    ADD COLUMN moved_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now();
+UPDATE bookings_archive SET moved_at = archived_at;
+ALTER TABLE bookings_archive DROP COLUMN archived_at;
+ALTER TABLE bookings_archive RENAME COLUMN moved_at TO archived_at;
+
+ALTER TABLE travel_buffers
+    ADD COLUMN fee DECIMAL(9,2) NOT NULL DEFAULT 0 CHECK (fee >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE travel_buffers DROP COLUMN IF EXISTS fee;
+
+ALTER TABLE bookings_archive
+    DROP COLUMN IF EXISTS travel_fee,
+    DROP COLUMN IF EXISTS duration_scaling;
+
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS travel_fee,
+    DROP COLUMN IF EXISTS duration_scaling;
+-- +goose StatementEnd